@@ -0,0 +1,22 @@
+// Command migrate 不依赖 TDLib 的数据库迁移命令，与主程序的 migrate 子命令相同，
+// 用于在无法运行主程序的环境中查看、执行或回滚迁移：go run ./cmd/migrate [status|up [n]|restore <备份文件>]
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/fachebot/talk-trace-bot/internal/dbmigrate"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/svc"
+)
+
+var dbFile = flag.String("db", svc.DatabasePath, "the sqlite database file")
+
+func main() {
+	flag.Parse()
+	if err := dbmigrate.Run(context.Background(), *dbFile, flag.Args(), os.Stdout); err != nil {
+		logger.Fatalf("[Migrate] %s", err)
+	}
+}
@@ -0,0 +1,99 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// adminNotifier 向管理员发送消息（由 notify.Notifier 实现）
+type adminNotifier interface {
+	NotifyAdmins(ctx context.Context, content string) error
+}
+
+// Alerter 发送故障告警：私聊管理员，并可选推送到 Webhook
+type Alerter struct {
+	config     *config.Alert
+	notifier   adminNotifier
+	httpClient *http.Client
+}
+
+// webhookPayload Webhook 推送的 JSON 内容
+type webhookPayload struct {
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+func NewAlerter(cfg *config.Alert, notifier adminNotifier, transport *http.Transport) *Alerter {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	if transport != nil {
+		httpClient.Transport = transport
+	}
+	return &Alerter{
+		config:     cfg,
+		notifier:   notifier,
+		httpClient: httpClient,
+	}
+}
+
+// Enabled 是否启用告警
+func (a *Alerter) Enabled() bool {
+	return a != nil && a.config.Enable
+}
+
+// ChatFailureThreshold 同一群组连续失败多少次触发告警
+func (a *Alerter) ChatFailureThreshold() int {
+	return a.config.ChatFailureThreshold
+}
+
+// Alert 发送告警，失败只记录日志
+func (a *Alerter) Alert(ctx context.Context, title, message string) {
+	if !a.Enabled() {
+		return
+	}
+	logger.Warnf("[Alert] %s: %s", title, message)
+
+	content := fmt.Sprintf("🚨 <b>%s</b>\n%s", html.EscapeString(title), html.EscapeString(message))
+	if err := a.notifier.NotifyAdmins(ctx, content); err != nil {
+		logger.Errorf("[Alert] 发送告警给管理员失败: %v", err)
+	}
+
+	if a.config.WebhookURL != "" {
+		if err := a.postWebhook(ctx, title, message); err != nil {
+			logger.Errorf("[Alert] 推送告警到 Webhook 失败: %v", err)
+		}
+	}
+}
+
+// postWebhook 以 JSON 格式 POST 告警到 Webhook
+func (a *Alerter) postWebhook(ctx context.Context, title, message string) error {
+	body, err := json.Marshal(webhookPayload{Title: title, Message: message, Time: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.config.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 返回状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockAdminNotifier 记录发送给管理员的内容
+type mockAdminNotifier struct {
+	contents []string
+}
+
+func (m *mockAdminNotifier) NotifyAdmins(ctx context.Context, content string) error {
+	m.contents = append(m.contents, content)
+	return nil
+}
+
+func TestAlert_Disabled(t *testing.T) {
+	notifier := &mockAdminNotifier{}
+	a := NewAlerter(&config.Alert{Enable: false}, notifier, nil)
+	a.Alert(context.Background(), "title", "message")
+	assert.Empty(t, notifier.contents)
+}
+
+func TestAlert_NotifiesAdminsAndWebhook(t *testing.T) {
+	var got webhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := &mockAdminNotifier{}
+	a := NewAlerter(&config.Alert{Enable: true, WebhookURL: server.URL}, notifier, nil)
+	a.Alert(context.Background(), "DailyRun 失败", "<err>")
+
+	if assert.Len(t, notifier.contents, 1) {
+		assert.Equal(t, "🚨 <b>DailyRun 失败</b>\n&lt;err&gt;", notifier.contents[0])
+	}
+	assert.Equal(t, "DailyRun 失败", got.Title)
+	assert.Equal(t, "<err>", got.Message)
+}
@@ -0,0 +1,152 @@
+// Package botapp 机器人模式：以 Bot 身份向群组发送带话题按钮的精简总结，成员点击按钮后私聊发送话题详情；
+// 成员可私聊机器人关注关键词，每日总结中的相关话题会私聊推送。
+package botapp
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/svc"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+type BotApp struct {
+	svcCtx     *svc.ServiceContext
+	me         *client.User
+	tdClient   *client.Client
+	listener   *client.Listener
+	parameters *client.SetTdlibParametersRequest
+	formatter  *display.Formatter
+	sends      *notify.SendTracker
+	members    chatMemberChecker
+	ctx        context.Context
+	cancel     context.CancelFunc
+	ctxMu      sync.Mutex
+}
+
+// NewApp 创建机器人客户端，会话数据与用户账号分开保存在 <dataDir>/.tdlib/bot
+func NewApp(svcCtx *svc.ServiceContext, apiId int32, apiHash, dataDir string) *BotApp {
+	parameters := &client.SetTdlibParametersRequest{
+		UseTestDc:           false,
+		DatabaseDirectory:   filepath.Join(dataDir, ".tdlib", "bot", "database"),
+		FilesDirectory:      filepath.Join(dataDir, ".tdlib", "bot", "files"),
+		UseFileDatabase:     false,
+		UseChatInfoDatabase: true,
+		UseMessageDatabase:  false,
+		UseSecretChats:      false,
+		ApiId:               apiId,
+		ApiHash:             apiHash,
+		SystemLanguageCode:  "en",
+		DeviceModel:         "Server",
+		SystemVersion:       "1.0.0",
+		ApplicationVersion:  "1.0.0",
+	}
+
+	app := &BotApp{
+		svcCtx:     svcCtx,
+		parameters: parameters,
+		sends:      notify.NewSendTracker(),
+	}
+	app.members = app
+	return app
+}
+
+// chatMemberChecker 查询用户是否为群组成员，默认由机器人自身查询
+type chatMemberChecker interface {
+	IsChatMember(ctx context.Context, chatID, userID int64) (bool, error)
+}
+
+// Login 使用机器人 Token 登录并开始处理按钮点击
+func (app *BotApp) Login(token string, options ...client.Option) (*client.User, error) {
+	if app.me != nil {
+		return app.me, nil
+	}
+
+	formatter, err := display.NewFormatter(&app.svcCtx.Config.Summary.Display)
+	if err != nil {
+		return nil, err
+	}
+	app.formatter = formatter
+
+	tdlibClient, err := client.NewClient(client.BotAuthorizer(app.parameters, token), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	me, err := tdlibClient.GetMe()
+	if err != nil {
+		return nil, err
+	}
+
+	app.me = me
+	app.tdClient = tdlibClient
+	app.listener = tdlibClient.GetListener()
+
+	app.ctxMu.Lock()
+	app.ctx, app.cancel = context.WithCancel(context.Background())
+	app.ctxMu.Unlock()
+
+	go app.getUpdates(app.listener)
+
+	return me, nil
+}
+
+// Username 机器人用户名（不含 "@"），未设置时返回空字符串
+func (app *BotApp) Username() string {
+	if app.me == nil || app.me.Usernames == nil || len(app.me.Usernames.ActiveUsernames) == 0 {
+		return ""
+	}
+	return app.me.Usernames.ActiveUsernames[0]
+}
+
+func (app *BotApp) Close() error {
+	if app.tdClient == nil {
+		return nil
+	}
+
+	app.ctxMu.Lock()
+	if app.cancel != nil {
+		app.cancel()
+	}
+	app.ctxMu.Unlock()
+
+	if app.listener != nil && app.listener.IsActive() {
+		app.listener.Close()
+	}
+
+	_, err := app.tdClient.Close()
+	return err
+}
+
+func (app *BotApp) getUpdates(listener *client.Listener) {
+	app.ctxMu.Lock()
+	ctx := app.ctx
+	app.ctxMu.Unlock()
+
+	for listener.IsActive() {
+		select {
+		case <-ctx.Done():
+			logger.Infof("[BotApp] 更新循环已取消，退出")
+			return
+		case update, ok := <-listener.Updates:
+			if !ok {
+				return
+			}
+			switch u := update.(type) {
+			case *client.UpdateNewCallbackQuery:
+				go app.handleCallbackQuery(ctx, u)
+			case *client.UpdateNewMessage:
+				go app.handleMessage(ctx, u.Message)
+			case *client.UpdateMessageSendSucceeded:
+				app.sends.Succeeded(u.Message.ChatId, u.OldMessageId, u.Message.Id)
+			case *client.UpdateMessageSendFailed:
+				app.sends.Failed(u.Message.ChatId, u.OldMessageId, notify.SendError(u.Error))
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package botapp
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// handleMessage 处理成员私聊机器人发送的命令
+func (app *BotApp) handleMessage(ctx context.Context, message *client.Message) {
+	if message == nil || message.IsOutgoing {
+		return
+	}
+	sender, ok := message.SenderId.(*client.MessageSenderUser)
+	if !ok || sender.UserId != message.ChatId {
+		return
+	}
+	text, ok := message.Content.(*client.MessageText)
+	if !ok || text.Text == nil {
+		return
+	}
+
+	fields := strings.Fields(text.Text.Text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return
+	}
+	name, _, _ := strings.Cut(strings.TrimPrefix(fields[0], "/"), "@")
+	args := fields[1:]
+	userID := sender.UserId
+
+	var reply string
+	var err error
+	switch name {
+	case "start":
+		reply, err = app.cmdStart(ctx, userID, args)
+	case "follow":
+		reply, err = app.cmdFollow(ctx, userID, args)
+	case "unfollow":
+		reply, err = app.cmdUnfollow(ctx, userID, args)
+	case "following":
+		reply, err = app.cmdFollowing(ctx, userID)
+	default:
+		return
+	}
+	if err != nil {
+		logger.Warnf("[BotApp] 执行命令 /%s 失败 (user=%d): %v", name, userID, err)
+		reply = app.formatter.T(display.TextBotFailed)
+	}
+	if reply == "" {
+		return
+	}
+	if _, err := app.tdClient.SendMessage(&client.SendMessageRequest{
+		ChatId: userID,
+		InputMessageContent: &client.InputMessageText{
+			Text: &client.FormattedText{Text: reply},
+		},
+	}); err != nil {
+		logger.Warnf("[BotApp] 回复命令 /%s 失败: %v", name, err)
+	}
+}
+
+// cmdStart 无参数时发送帮助；参数为话题按钮的跳转数据（t_<taskID>_<话题序号>）时发送话题详情
+func (app *BotApp) cmdStart(ctx context.Context, userID int64, args []string) (string, error) {
+	if len(args) == 0 {
+		return app.formatter.T(display.TextBotHelp), nil
+	}
+	taskID, topic, ok := parseTopicPayload(args[0])
+	if !ok {
+		return app.formatter.T(display.TextBotHelp), nil
+	}
+	err := app.sendTopicDetail(ctx, userID, taskID, topic)
+	if errors.Is(err, errNotMember) {
+		return app.formatter.T(display.TextNotMember), nil
+	}
+	return "", err
+}
+
+// cmdFollow 关注关键词
+func (app *BotApp) cmdFollow(ctx context.Context, userID int64, args []string) (string, error) {
+	keyword, err := normalizeKeyword(args, app.formatter)
+	if err != nil {
+		return err.Error() + "\n" + app.formatter.T(display.TextFollowUsage), nil
+	}
+	count, err := app.svcCtx.FollowModel.CountByUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if count >= maxFollowKeywords {
+		return app.formatter.Tf(display.TextFollowLimit, maxFollowKeywords), nil
+	}
+
+	created, err := app.svcCtx.FollowModel.Add(ctx, userID, keyword)
+	if err != nil {
+		return "", err
+	}
+	if !created {
+		return app.formatter.Tf(display.TextFollowExists, keyword), nil
+	}
+	logger.Infof("[BotApp] 用户 %d 关注关键词: %s", userID, keyword)
+	return app.formatter.Tf(display.TextFollowAdded, keyword), nil
+}
+
+// cmdUnfollow 取消关注关键词
+func (app *BotApp) cmdUnfollow(ctx context.Context, userID int64, args []string) (string, error) {
+	keyword, err := normalizeKeyword(args, app.formatter)
+	if err != nil {
+		return err.Error() + "\n" + app.formatter.T(display.TextUnfollowUsage), nil
+	}
+	removed, err := app.svcCtx.FollowModel.Remove(ctx, userID, keyword)
+	if err != nil {
+		return "", err
+	}
+	if !removed {
+		return app.formatter.Tf(display.TextUnfollowMissing, keyword), nil
+	}
+	logger.Infof("[BotApp] 用户 %d 取消关注关键词: %s", userID, keyword)
+	return app.formatter.Tf(display.TextUnfollowed, keyword), nil
+}
+
+// cmdFollowing 列出已关注的关键词
+func (app *BotApp) cmdFollowing(ctx context.Context, userID int64) (string, error) {
+	keywords, err := app.svcCtx.FollowModel.ListByUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(keywords) == 0 {
+		return app.formatter.T(display.TextFollowingEmpty), nil
+	}
+	return app.formatter.T(display.TextFollowingList) + "\n" + strings.Join(keywords, "\n"), nil
+}
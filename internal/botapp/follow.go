@@ -0,0 +1,141 @@
+package botapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+const (
+	maxFollowKeywords = 20 // 每位成员最多关注的关键词数
+	maxKeywordRunes   = 64 // 关键词最大字数
+)
+
+// normalizeKeyword 将命令参数合并为关键词（小写、单个空格分隔），无效时返回的说明使用 formatter 的语言
+func normalizeKeyword(args []string, formatter *display.Formatter) (string, error) {
+	keyword := strings.ToLower(strings.Join(args, " "))
+	if keyword == "" {
+		return "", errors.New(formatter.T(display.TextKeywordEmpty))
+	}
+	if len([]rune(keyword)) > maxKeywordRunes {
+		return "", errors.New(formatter.Tf(display.TextKeywordTooLong, maxKeywordRunes))
+	}
+	return keyword, nil
+}
+
+// FollowSections 返回关注了匹配关键词、且为该群组成员的用户及其匹配的话题（实现 notify.FollowSender）
+func (app *BotApp) FollowSections(ctx context.Context, chatID int64, taskID int) ([]notify.FollowSection, error) {
+	follows, err := app.svcCtx.FollowModel.KeywordsByUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询关注关键词失败: %w", err)
+	}
+	if len(follows) == 0 {
+		return nil, nil
+	}
+
+	t, result, err := app.loadResult(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(follows))
+	for userID := range follows {
+		userIDs = append(userIDs, userID)
+	}
+	slices.Sort(userIDs)
+
+	var sections []notify.FollowSection
+	for _, userID := range userIDs {
+		var matched []string
+		for _, keyword := range follows[userID] {
+			if summarizer.FilterTopics(result, []string{keyword}) != nil {
+				matched = append(matched, keyword)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+		// 只推送给群组成员，避免泄露用户不在的群组的内容
+		if !app.isMember(ctx, chatID, userID) {
+			logger.Debugf("[BotApp] 用户 %d 不是群组 %d 的成员，跳过关注推送", userID, chatID)
+			continue
+		}
+
+		filtered := summarizer.FilterTopics(result, matched)
+		formatter := app.formatter.ForChat(chatID)
+		content := formatter.Tf(display.TextFollowMatched, html.EscapeString(strings.Join(matched, ", "))) + "\n\n" +
+			summarizer.FormatSummaryForDisplay(filtered, chatID, t.StartTime, t.EndTime, formatter)
+		sections = append(sections, notify.FollowSection{UserID: userID, Content: content})
+	}
+	return sections, nil
+}
+
+// SendPrivate 私聊发送 HTML 内容，超长时拆分（实现 notify.FollowSender）
+func (app *BotApp) SendPrivate(ctx context.Context, userID int64, content string) error {
+	if _, err := app.tdClient.CreatePrivateChat(&client.CreatePrivateChatRequest{UserId: userID}); err != nil {
+		return &privateSendError{err: err}
+	}
+	// 多段时编号，第 2 段起回复第 1 段；每段发送完成后再发送下一段，保证顺序
+	var firstID int64
+	for _, msg := range notify.SplitNumbered(content) {
+		req := &client.SendMessageRequest{
+			ChatId: userID,
+			InputMessageContent: &client.InputMessageText{
+				Text: notify.ParseHTMLText(msg),
+			},
+		}
+		if firstID != 0 {
+			req.ReplyTo = &client.InputMessageReplyToMessage{MessageId: firstID}
+		}
+		message, err := app.tdClient.SendMessage(req)
+		if err != nil {
+			return &privateSendError{err: err}
+		}
+		messageID := message.Id
+		if message.SendingState != nil {
+			messageID, err = app.sends.Wait(ctx, userID, message.Id)
+			if errors.Is(err, notify.ErrSendTimeout) {
+				logger.Warnf("[BotApp] 用户 %d: %v，继续发送", userID, err)
+				messageID = message.Id
+			} else if err != nil {
+				return &privateSendError{err: err}
+			}
+		}
+		if firstID == 0 {
+			firstID = messageID
+		}
+	}
+	return nil
+}
+
+// isMember 判断用户当前是否为群组成员，查询失败时视为非成员
+func (app *BotApp) isMember(ctx context.Context, chatID, userID int64) bool {
+	ok, err := app.members.IsChatMember(ctx, chatID, userID)
+	if err != nil {
+		logger.Debugf("[BotApp] 查询用户 %d 在群组 %d 的成员状态失败: %v", userID, chatID, err)
+		return false
+	}
+	return ok
+}
+
+// IsChatMember 由机器人查询用户是否为群组成员
+func (app *BotApp) IsChatMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	member, err := app.tdClient.GetChatMember(&client.GetChatMemberRequest{
+		ChatId:   chatID,
+		MemberId: &client.MessageSenderUser{UserId: userID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return notify.IsMemberStatus(member.Status), nil
+}
@@ -0,0 +1,34 @@
+package botapp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeKeyword(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{"转为小写", []string{"Kubernetes"}, "kubernetes", false},
+		{"多个参数合并", []string{"Machine", "Learning"}, "machine learning", false},
+		{"中文", []string{"数据库"}, "数据库", false},
+		{"空关键词", nil, "", true},
+		{"超长关键词", []string{strings.Repeat("长", maxKeywordRunes+1)}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeKeyword(tt.args, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
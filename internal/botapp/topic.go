@@ -0,0 +1,206 @@
+package botapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+const (
+	topicPayloadPrefix  = "t_" // 话题按钮回调数据及 /start 参数的前缀，格式 t_<taskID>_<话题序号>
+	buttonTitleMaxRunes = 32   // 话题按钮上标题的最大字数
+)
+
+// topicPayload 编码话题按钮的回调数据，topic 为 -1 表示全部话题；同时用作 /start 参数（仅含字母、数字、_ 和 -）
+func topicPayload(taskID, topic int) string {
+	return fmt.Sprintf("%s%d_%d", topicPayloadPrefix, taskID, topic)
+}
+
+// parseTopicPayload 解析话题按钮的回调数据，格式不正确时 ok 为 false
+func parseTopicPayload(payload string) (taskID, topic int, ok bool) {
+	rest, found := strings.CutPrefix(payload, topicPayloadPrefix)
+	if !found {
+		return 0, 0, false
+	}
+	taskPart, topicPart, found := strings.Cut(rest, "_")
+	if !found {
+		return 0, 0, false
+	}
+	taskID, err := strconv.Atoi(taskPart)
+	if err != nil || taskID <= 0 {
+		return 0, 0, false
+	}
+	topic, err = strconv.Atoi(topicPart)
+	if err != nil || topic < -1 {
+		return 0, 0, false
+	}
+	return taskID, topic, true
+}
+
+// topicKeyboard 精简总结的按钮：每个话题一行，最后一行为全部话题
+func topicKeyboard(taskID int, result *summarizer.SummaryResult, formatter *display.Formatter) *client.ReplyMarkupInlineKeyboard {
+	rows := make([][]*client.InlineKeyboardButton, 0, len(result.Topics)+1)
+	for i, topic := range result.Topics {
+		title := []rune(topic.Title)
+		if len(title) > buttonTitleMaxRunes {
+			title = append(title[:buttonTitleMaxRunes], '…')
+		}
+		rows = append(rows, []*client.InlineKeyboardButton{topicButton(fmt.Sprintf("%d. %s", i+1, string(title)), taskID, i)})
+	}
+	rows = append(rows, []*client.InlineKeyboardButton{topicButton(formatter.T(display.TextAllTopics), taskID, -1)})
+	return &client.ReplyMarkupInlineKeyboard{Rows: rows}
+}
+
+func topicButton(text string, taskID, topic int) *client.InlineKeyboardButton {
+	return &client.InlineKeyboardButton{
+		Text: text,
+		Type: &client.InlineKeyboardButtonTypeCallback{Data: []byte(topicPayload(taskID, topic))},
+	}
+}
+
+// SendDigest 向群组发送任务的精简总结，每个话题附一个展开按钮（实现 notify.DigestSender）
+func (app *BotApp) SendDigest(ctx context.Context, chatID int64, taskID int) error {
+	t, result, err := app.loadResult(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	formatter := app.formatter.ForChat(chatID)
+	text := summarizer.FormatDigest(result, t.StartTime, t.EndTime, formatter)
+	if text == "" {
+		return nil
+	}
+
+	// 确保机器人已加载该群组
+	if _, err := app.tdClient.GetChat(&client.GetChatRequest{ChatId: chatID}); err != nil {
+		return fmt.Errorf("机器人无法访问群组（是否已加入群组）: %w", err)
+	}
+	_, err = app.tdClient.SendMessage(&client.SendMessageRequest{
+		ChatId:          chatID,
+		MessageThreadId: result.ThreadID,
+		ReplyMarkup:     topicKeyboard(taskID, result, formatter),
+		InputMessageContent: &client.InputMessageText{
+			Text: notify.ParseHTMLText(text),
+		},
+	})
+	return err
+}
+
+// loadResult 读取任务保存的结构化总结结果，并补充群聊名称
+func (app *BotApp) loadResult(ctx context.Context, taskID int) (*ent.Task, *summarizer.SummaryResult, error) {
+	t, err := app.svcCtx.TaskModel.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询任务失败: %w", err)
+	}
+	if t.SummaryJSON == "" {
+		return nil, nil, fmt.Errorf("任务 %d 没有保存总结结果", taskID)
+	}
+
+	var result summarizer.SummaryResult
+	if err := json.Unmarshal([]byte(t.SummaryJSON), &result); err != nil {
+		return nil, nil, fmt.Errorf("解析任务 %d 的总结结果失败: %w", taskID, err)
+	}
+	title, err := app.svcCtx.ChatModel.GetTitle(ctx, t.ChatID)
+	if err != nil {
+		logger.Warnf("[BotApp] 群组 %d: 获取群聊名称失败: %v", t.ChatID, err)
+	}
+	result.ChatTitle = title
+	return t, &result, nil
+}
+
+// handleCallbackQuery 处理话题按钮点击：私聊发送话题详情；机器人无法私聊该用户时引导用户开启私聊
+func (app *BotApp) handleCallbackQuery(ctx context.Context, query *client.UpdateNewCallbackQuery) {
+	answer := &client.AnswerCallbackQueryRequest{CallbackQueryId: query.Id}
+	defer func() {
+		if _, err := app.tdClient.AnswerCallbackQuery(answer); err != nil {
+			logger.Warnf("[BotApp] 应答按钮点击失败: %v", err)
+		}
+	}()
+
+	data, ok := query.Payload.(*client.CallbackQueryPayloadData)
+	if !ok {
+		return
+	}
+	taskID, topic, ok := parseTopicPayload(string(data.Data))
+	if !ok {
+		logger.Debugf("[BotApp] 忽略未知的按钮数据: %q", data.Data)
+		return
+	}
+
+	err := app.sendTopicDetail(ctx, query.SenderUserId, taskID, topic)
+	if err == nil {
+		answer.Text = app.formatter.ForChat(query.ChatId).T(display.TextDetailSent)
+		return
+	}
+	if errors.Is(err, errNotMember) {
+		answer.Text = app.formatter.ForChat(query.ChatId).T(display.TextNotMember)
+		answer.ShowAlert = true
+		return
+	}
+	if !isPrivateSendError(err) {
+		logger.Warnf("[BotApp] 发送话题详情失败 (taskID=%d, topic=%d): %v", taskID, topic, err)
+		return
+	}
+
+	// 用户尚未私聊过机器人：打开机器人私聊，用户点击「开始」后由 cmdStart 发送详情
+	logger.Infof("[BotApp] 无法私聊用户 %d，引导开启私聊: %v", query.SenderUserId, err)
+	if username := app.Username(); username != "" {
+		answer.Url = fmt.Sprintf("https://t.me/%s?start=%s", username, topicPayload(taskID, topic))
+		return
+	}
+	answer.Text = app.formatter.ForChat(query.ChatId).T(display.TextStartBotFirst)
+	answer.ShowAlert = true
+}
+
+// privateSendError 私聊发送失败（通常是用户尚未私聊过机器人）
+type privateSendError struct {
+	err error
+}
+
+func (e *privateSendError) Error() string { return "私聊发送失败: " + e.err.Error() }
+func (e *privateSendError) Unwrap() error { return e.err }
+
+func isPrivateSendError(err error) bool {
+	var sendErr *privateSendError
+	return errors.As(err, &sendErr)
+}
+
+// errNotMember 用户不是总结所属群组的成员
+var errNotMember = errors.New("用户不是该群组的成员")
+
+// sendTopicDetail 私聊发送话题详情（topic 为 -1 时发送完整总结），发送成功后记录一次查看；
+// 只发送给总结所属群组的成员，避免他人通过任务ID获取其他群组的总结
+func (app *BotApp) sendTopicDetail(ctx context.Context, userID int64, taskID, topic int) error {
+	t, result, err := app.loadResult(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if !app.isMember(ctx, t.ChatID, userID) {
+		logger.Infof("[BotApp] 用户 %d 不是群组 %d 的成员，拒绝发送话题详情 (taskID=%d)", userID, t.ChatID, taskID)
+		return errNotMember
+	}
+	text := summarizer.FormatTopicDetail(result, t.ChatID, topic, t.StartTime, t.EndTime, app.formatter.ForChat(t.ChatID))
+	if text == "" {
+		return fmt.Errorf("话题 %d 不存在", topic)
+	}
+
+	if err := app.SendPrivate(ctx, userID, text); err != nil {
+		return err
+	}
+	logger.Infof("[BotApp] 已私聊发送话题详情: user=%d, taskID=%d, topic=%d", userID, taskID, topic)
+
+	if err := app.svcCtx.ViewModel.Record(ctx, taskID, t.ChatID, userID, topic); err != nil {
+		logger.Warnf("[BotApp] 记录查看失败 (taskID=%d): %v", taskID, err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package botapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+	"github.com/fachebot/talk-trace-bot/internal/svc"
+	"github.com/fachebot/talk-trace-bot/internal/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestParseTopicPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		wantTask  int
+		wantTopic int
+		wantOK    bool
+	}{
+		{"单个话题", "t_12_3", 12, 3, true},
+		{"全部话题", "t_12_-1", 12, -1, true},
+		{"缺少前缀", "12_3", 0, 0, false},
+		{"缺少话题序号", "t_12", 0, 0, false},
+		{"任务ID无效", "t_0_1", 0, 0, false},
+		{"话题序号无效", "t_12_-2", 0, 0, false},
+		{"非数字", "t_a_b", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskID, topic, ok := parseTopicPayload(tt.payload)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantTask, taskID)
+			assert.Equal(t, tt.wantTopic, topic)
+		})
+	}
+}
+
+func TestTopicPayload_RoundTrip(t *testing.T) {
+	for _, topic := range []int{-1, 0, 7} {
+		taskID, got, ok := parseTopicPayload(topicPayload(42, topic))
+		require.True(t, ok)
+		assert.Equal(t, 42, taskID)
+		assert.Equal(t, topic, got)
+	}
+}
+
+func TestTopicKeyboard(t *testing.T) {
+	result := &summarizer.SummaryResult{
+		Topics: []summarizer.TopicItem{
+			{Title: "发布计划"},
+			{Title: "这是一个非常非常长的话题标题，超过了按钮能够完整展示的字数上限，所以会被截断"},
+		},
+	}
+
+	keyboard := topicKeyboard(5, result, nil)
+	require.Len(t, keyboard.Rows, 3)
+
+	texts := make([]string, 0, len(keyboard.Rows))
+	payloads := make([]string, 0, len(keyboard.Rows))
+	for _, row := range keyboard.Rows {
+		require.Len(t, row, 1)
+		texts = append(texts, row[0].Text)
+		payloads = append(payloads, string(row[0].Type.(*client.InlineKeyboardButtonTypeCallback).Data))
+	}
+	assert.Equal(t, "1. 发布计划", texts[0])
+	assert.Equal(t, "2. 这是一个非常非常长的话题标题，超过了按钮能够完整展示的字数上限，…", texts[1])
+	assert.Equal(t, "📄 全部话题", texts[2])
+	assert.Equal(t, []string{"t_5_0", "t_5_1", "t_5_-1"}, payloads)
+}
+
+// stubMembers 测试用的成员查询：members 中的用户为群组成员
+type stubMembers struct {
+	members map[int64]bool
+}
+
+func (s stubMembers) IsChatMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	return s.members[userID], nil
+}
+
+func TestSendTopicDetail_NotMember(t *testing.T) {
+	ctx := context.Background()
+	db := testkit.NewEntClient(t)
+	tasks := model.NewTaskModel(db.Task)
+	app := &BotApp{
+		svcCtx:  &svc.ServiceContext{TaskModel: tasks, ChatModel: model.NewChatModel(db.Chat)},
+		members: stubMembers{members: map[int64]bool{1: true}},
+	}
+
+	start := testkit.Today()
+	tk, err := tasks.CreateTask(ctx, -100, start, start.AddDate(0, 0, 1), task.KindScheduled, task.StatusCompleted)
+	require.NoError(t, err)
+	require.NoError(t, tasks.SetSummaryJSON(ctx, tk.ID, `{"topics":[]}`))
+
+	err = app.sendTopicDetail(ctx, 2, tk.ID, -1)
+	assert.ErrorIs(t, err, errNotMember, "非群组成员不能通过任务ID获取总结")
+}
@@ -0,0 +1,43 @@
+package botapp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// ViewStatsProvider 各群组总结的查看统计（默认实现为 model.SummaryViewModel）
+type ViewStatsProvider interface {
+	StatsSince(ctx context.Context, since time.Time) ([]model.ViewStats, error)
+}
+
+// ChatTitleProvider 群聊名称（默认实现为 model.ChatModel），未记录时返回空字符串
+type ChatTitleProvider interface {
+	GetTitle(ctx context.Context, chatID int64) (string, error)
+}
+
+// ViewStatsText 最近 days 天各群组总结的查看（话题按钮点击）统计，群聊名称未知时显示群组ID，文本按 f 的语言输出
+func ViewStatsText(ctx context.Context, views ViewStatsProvider, chats ChatTitleProvider, days int, f *display.Formatter) (string, error) {
+	stats, err := views.StatsSince(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(f.Tf(display.TextViewsTitle, days) + "\n")
+	if len(stats) == 0 {
+		sb.WriteString(f.T(display.TextViewsEmpty) + "\n")
+	}
+	for _, st := range stats {
+		title, err := chats.GetTitle(ctx, st.ChatID)
+		if err != nil || title == "" {
+			title = strconv.FormatInt(st.ChatID, 10)
+		}
+		sb.WriteString(f.Tf(display.TextViewsEntry, title, st.Summaries, st.Clicks, st.Viewers) + "\n")
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,63 @@
+package botapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeViewStats struct {
+	stats []model.ViewStats
+	err   error
+	since time.Time
+}
+
+func (f *fakeViewStats) StatsSince(ctx context.Context, since time.Time) ([]model.ViewStats, error) {
+	f.since = since
+	return f.stats, f.err
+}
+
+type fakeChatTitles map[int64]string
+
+func (f fakeChatTitles) GetTitle(ctx context.Context, chatID int64) (string, error) {
+	if chatID == -999 {
+		return "", errors.New("数据库错误")
+	}
+	return f[chatID], nil
+}
+
+func TestViewStatsText(t *testing.T) {
+	ctx := context.Background()
+	titles := fakeChatTitles{-100: "产品讨论群"}
+
+	t.Run("按群组列出", func(t *testing.T) {
+		views := &fakeViewStats{stats: []model.ViewStats{
+			{ChatID: -100, Summaries: 3, Clicks: 12, Viewers: 5},
+			{ChatID: -200, Summaries: 1, Clicks: 1, Viewers: 1},
+			{ChatID: -999, Summaries: 2, Clicks: 4, Viewers: 2},
+		}}
+		text, err := ViewStatsText(ctx, views, titles, 7, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "👀 近 7 天总结查看统计\n"+
+			"产品讨论群: 3 份总结被查看，点击 12 次，5 人\n"+
+			"-200: 1 份总结被查看，点击 1 次，1 人\n"+
+			"-999: 2 份总结被查看，点击 4 次，2 人\n", text, "群聊名称未知或查询失败时显示群组ID")
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, -7), views.since, time.Minute)
+	})
+
+	t.Run("暂无记录", func(t *testing.T) {
+		text, err := ViewStatsText(ctx, &fakeViewStats{}, titles, 7, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "👀 近 7 天总结查看统计\n暂无查看记录\n", text)
+	})
+
+	t.Run("查询失败", func(t *testing.T) {
+		_, err := ViewStatsText(ctx, &fakeViewStats{err: errors.New("数据库错误")}, titles, 7, nil)
+		assert.Error(t, err)
+	})
+}
@@ -2,11 +2,25 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/lang"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
 
 	"gopkg.in/yaml.v3"
 )
 
+// ChatTypes Summary.ChatTypeEngines 支持的聊天类型
+var ChatTypes = []string{"group", "supergroup", "channel"}
+
 type Sock5Proxy struct {
 	Host   string `yaml:"Host"`
 	Port   int32  `yaml:"Port"`
@@ -14,8 +28,48 @@ type Sock5Proxy struct {
 }
 
 type TelegramApp struct {
-	ApiId   int32  `yaml:"ApiId"`
-	ApiHash string `yaml:"ApiHash"`
+	ApiId           int32  `yaml:"ApiId"`
+	ApiHash         string `yaml:"ApiHash"`
+	WatchdogTimeout int    `yaml:"WatchdogTimeout"` // 超过该秒数未收到任何更新时检查连接，连接失败或监听器已关闭时重建监听器，0 表示禁用
+
+	Login    TelegramLogin     `yaml:"Login"`    // 非交互式登录，用于 systemd、Docker 等没有终端的环境
+	Accounts []TelegramAccount `yaml:"Accounts"` // 额外登录的账号，用于监听主账号未加入的群组，消息保存到同一个数据库
+}
+
+// TelegramLogin 非交互式登录：会话不存在或已失效时使用配置的手机号和两步验证密码登录，验证码从文件读取；
+// 或开启 QRCode 在已登录的设备上扫码登录。未启用且在终端中运行时仍在终端输入
+type TelegramLogin struct {
+	PhoneNumber  string `yaml:"PhoneNumber"`  // 登录手机号（含国家代码，如 +8613800000000），会话已保存时不使用
+	Password     string `yaml:"Password"`     // 两步验证密码，账号未开启两步验证时留空
+	CodeFile     string `yaml:"CodeFile"`     // 登录验证码文件，将 Telegram 发送的验证码写入该文件完成登录，默认为数据目录下的 login_code
+	CodeTimeout  int    `yaml:"CodeTimeout"`  // 等待验证码或扫码的最长时间（秒），默认 300
+	QRCode       bool   `yaml:"QRCode"`       // 使用二维码登录（不需要手机号和短信验证码），与 PhoneNumber 二选一
+	QRWebhookURL string `yaml:"QRWebhookURL"` // 可选，二维码登录链接以 JSON POST 到该地址，链接同时输出到日志
+}
+
+// Enabled 是否启用非交互式登录（配置了手机号或开启了二维码登录）
+func (l *TelegramLogin) Enabled() bool {
+	return l.PhoneNumber != "" || l.QRCode
+}
+
+// TelegramAccount 额外登录的 Telegram 账号
+type TelegramAccount struct {
+	DataDir string        `yaml:"DataDir"` // TDLib 数据目录（登录会话、缓存），各账号不能相同，也不能为主账号使用的 data
+	ApiId   int32         `yaml:"ApiId"`   // 可选，默认与 TelegramApp.ApiId 相同
+	ApiHash string        `yaml:"ApiHash"` // 可选，默认与 TelegramApp.ApiHash 相同
+	Login   TelegramLogin `yaml:"Login"`   // 该账号的非交互式登录配置
+}
+
+// Credentials 额外账号登录使用的 ApiId 和 ApiHash，未配置时与主账号相同
+func (t *TelegramApp) Credentials(account TelegramAccount) (int32, string) {
+	apiId, apiHash := t.ApiId, t.ApiHash
+	if account.ApiId != 0 {
+		apiId = account.ApiId
+	}
+	if account.ApiHash != "" {
+		apiHash = account.ApiHash
+	}
+	return apiId, apiHash
 }
 
 type LLM struct {
@@ -23,23 +77,351 @@ type LLM struct {
 	APIKey    string `yaml:"APIKey"`
 	Model     string `yaml:"Model"`     // 如 gpt-4o, deepseek-chat, qwen-plus
 	MaxTokens int    `yaml:"MaxTokens"` // 模型上下文窗口大小
+
+	PromptPrice     float64 `yaml:"PromptPrice"`     // 输入单价（每百万 token），用于费用估算
+	CompletionPrice float64 `yaml:"CompletionPrice"` // 输出单价（每百万 token），用于费用估算
+
+	TopicMergeThreshold float64 `yaml:"TopicMergeThreshold"` // 分块总结合并话题时的标题相似度阈值（0~1），0 表示仅合并完全相同的标题
+	PromptTimestamps    string  `yaml:"PromptTimestamps"`    // 提交给 LLM 的消息时间精度："minute"（默认）/ "hour" / "none"
+
+	RequestTimeout   int `yaml:"RequestTimeout"`   // 单次 LLM 请求的超时时间（秒），默认 300
+	SummarizeTimeout int `yaml:"SummarizeTimeout"` // 一次群聊总结（消息过长分块时含所有 chunk 的请求）的超时时间（秒），0 表示不限制
+
+	Capture LLMCapture `yaml:"Capture"` // 调试用：将 LLM 请求和响应脱敏后保存到磁盘
+	Shadow  LLMShadow  `yaml:"Shadow"`  // 对比模式：抽样群组同时用对比模型总结，保存两者输出及差异，用于评估切换模型
+}
+
+type LLMShadow struct {
+	Model      string  `yaml:"Model"`      // 对比模型名称，为空表示禁用
+	BaseURL    string  `yaml:"BaseURL"`    // 可选，默认与 LLM.BaseURL 相同
+	APIKey     string  `yaml:"APIKey"`     // 可选，默认与 LLM.APIKey 相同
+	SampleRate float64 `yaml:"SampleRate"` // 参与对比的群组比例（0~1），按群组ID固定抽样，默认 0.1
+}
+
+// ShadowLLM 返回对比模型的 LLM 配置：未配置的端点和密钥与主模型相同，不保存请求记录
+func (l *LLM) ShadowLLM() *LLM {
+	shadow := *l
+	shadow.Model = l.Shadow.Model
+	if l.Shadow.BaseURL != "" {
+		shadow.BaseURL = l.Shadow.BaseURL
+	}
+	if l.Shadow.APIKey != "" {
+		shadow.APIKey = l.Shadow.APIKey
+	}
+	shadow.Capture = LLMCapture{}
+	shadow.Shadow = LLMShadow{}
+	return &shadow
+}
+
+type LLMCapture struct {
+	Dir      string `yaml:"Dir"`      // 保存目录，如 "data/llm_capture"，为空表示禁用
+	MaxBytes int    `yaml:"MaxBytes"` // 单条消息/响应内容的最大字节数，超出部分截断，默认 65536
+	MaxFiles int    `yaml:"MaxFiles"` // 最多保留的文件数，超出时删除最早的文件，默认 200
 }
 
 type Summary struct {
 	Cron          string  `yaml:"Cron"`          // cron 表达式，如 "0 23 * * *"
 	RetentionDays int     `yaml:"RetentionDays"` // 消息保留天数
-	RangeDays     int     `yaml:"RangeDays"`     // 总结天数，1=仅昨天，7=最近7天
+	RangeDays     int     `yaml:"RangeDays"`     // 总结天数，区间为触发日之前的整天 [触发日-RangeDays, 触发日)，1=仅昨天，7=最近7天
 	NotifyMode    string  `yaml:"NotifyMode"`    // "private" / "group" / "both"
 	NotifyUserIds []int64 `yaml:"NotifyUserIds"` // 私聊通知的目标用户ID列表
-	RetryTimes    int     `yaml:"RetryTimes"`    // 总结失败重试次数，默认 3
-	RetryInterval int     `yaml:"RetryInterval"` // 重试间隔（秒），默认 60
+	RetryTimes    int     `yaml:"RetryTimes"`    // 已弃用，改用 Retry；仍作为 Retry.LLM 和 Retry.DB 未配置项的默认值
+	RetryInterval int     `yaml:"RetryInterval"` // 已弃用，改用 Retry；仍作为 Retry 各类别未配置间隔的默认值
+	AdminReport   bool    `yaml:"AdminReport"`   // 每次运行结束后是否向 AdminUserIds 发送运行报告
+
+	SelfTestOnStartup bool `yaml:"SelfTestOnStartup"` // 启动时用示例对话自检总结、格式化及通知流程（不实际发送），结果私聊发送给 AdminUserIds
+
+	Retry       Retry `yaml:"Retry"`       // 按错误类别配置的重试策略
+	TaskTimeout int   `yaml:"TaskTimeout"` // 单个群组任务（生成总结及发送通知，含重试）的超时时间（秒），默认 1200
+
+	// ChatPriorities 群组处理优先级：群组ID => 优先级，数值越大越先处理，未配置的群组为 0
+	ChatPriorities  map[int64]int `yaml:"ChatPriorities"`
+	OrderByMessages bool          `yaml:"OrderByMessages"` // 优先级相同的群组是否按区间内消息数从多到少处理
+
+	PurgeCron        string `yaml:"PurgeCron"`        // 物理删除过期消息的 cron 表达式，默认 "30 * * * *"（每小时）
+	CleanupBatchSize int    `yaml:"CleanupBatchSize"` // 清理过期消息时每批（每个事务）软删除/物理删除的消息数，默认 1000
+
+	// Subscriptions 私聊订阅：用户ID => 订阅的群组ID列表；未配置的用户接收所有群组的总结
+	Subscriptions map[int64][]int64 `yaml:"Subscriptions"`
+	// SkipPrivateIfMember NotifyMode 为 "both" 时，这些用户若是群组成员（群内已能看到总结）则不再私信发送该群组的总结
+	SkipPrivateIfMember []int64 `yaml:"SkipPrivateIfMember"`
+
+	IncludeChatIds []int64 `yaml:"IncludeChatIds"` // 仅收集和总结这些群组的消息，为空表示不限制
+	ExcludeChatIds []int64 `yaml:"ExcludeChatIds"` // 不收集、不总结这些群组的消息，优先于 IncludeChatIds
+
+	WeekdaysOnly     bool   `yaml:"WeekdaysOnly"`     // 仅总结工作日（周一至周五，UTC）：按被总结的日期判断，而不是触发日
+	HolidayFile      string `yaml:"HolidayFile"`      // 节假日文件，每行一个日期 YYYY-MM-DD，被总结的日期为节假日时跳过
+	CoverSkippedDays bool   `yaml:"CoverSkippedDays"` // 跳过后的首次运行是否将区间向前扩展覆盖被跳过的日期（如总结周一时覆盖整个周末）
+
+	Engine          string            `yaml:"Engine"`          // 默认总结引擎，默认 "llm"
+	ChatEngines     map[int64]string  `yaml:"ChatEngines"`     // 按群组指定总结引擎：群组ID => 引擎名称
+	ChatTypeEngines map[string]string `yaml:"ChatTypeEngines"` // 按聊天类型指定总结引擎：group（普通群组）、supergroup（超级群组）、channel（频道） => 引擎名称，优先级低于 ChatEngines
+	FallbackEngines []string          `yaml:"FallbackEngines"` // 所选引擎重试耗尽后依次尝试的降级引擎，未配置时默认 ["extractive"]，配置为 [] 表示不降级
+
+	// Windows 同一天的多个总结窗口；为空时使用 Cron + RangeDays 作为单个每日窗口
+	Windows []SummaryWindow `yaml:"Windows"`
+
+	Display Display `yaml:"Display"` // 总结头部的日期展示
+
+	Anomaly Anomaly `yaml:"Anomaly"` // 群组活跃度异常检测
+
+	ChatContext bool   `yaml:"ChatContext"` // 是否将群简介和置顶消息作为背景提供给 LLM，帮助理解群聊主题和术语
+	Language    string `yaml:"Language"`    // 总结输出语言：为空时使用中文，"auto" 使用群聊中占比最高的语言，或指定语言代码（zh、en、ja、ko、ru、ar、th、hi）
+
+	QuoteMaxRunes    int  `yaml:"QuoteMaxRunes"`    // 每个子项下引用关键消息原文的最大字数，0 表示不引用
+	MinMessageRunes  int  `yaml:"MinMessageRunes"`  // 字数（去除首尾空白后）少于该值的消息（如“哈哈”“+1”、单个表情）不提交给总结引擎，但仍计入消息数等统计；0 表示不过滤
+	CompressMaxRunes *int `yaml:"CompressMaxRunes"` // 子项过长、拆分后仍超过单条消息长度时，请 LLM 压缩到的字数上限，未配置时为 800，0 表示不压缩、直接截断
+
+	Glossary       []GlossaryTerm           `yaml:"Glossary"`       // 术语表，对所有群组生效
+	ChatGlossaries map[int64][]GlossaryTerm `yaml:"ChatGlossaries"` // 按群组追加的术语表：群组ID => 术语列表，同名术语覆盖全局术语
+}
+
+// Retry 按错误类别配置的重试策略
+type Retry struct {
+	LLM      RetryPolicy `yaml:"LLM"`      // 生成总结失败（LLM 及其他总结引擎），默认 3 次、间隔 60 秒
+	Telegram RetryPolicy `yaml:"Telegram"` // 发送通知失败，默认 2 次、间隔 30 秒
+	DB       RetryPolicy `yaml:"DB"`       // 查询待总结群组失败，默认 3 次、间隔 60 秒
+}
+
+// RetryPolicy 单个错误类别的重试策略
+type RetryPolicy struct {
+	Times    int `yaml:"Times"`    // 最多尝试次数（含首次）
+	Interval int `yaml:"Interval"` // 重试间隔（秒），频率超限时按服务端要求等待
+}
+
+// Delay 返回重试间隔
+func (p RetryPolicy) Delay() time.Duration {
+	return time.Duration(p.Interval) * time.Second
+}
+
+type GlossaryTerm struct {
+	Term        string   `yaml:"Term"`        // 标准写法，如 "Kubernetes"、"BTC"
+	Aliases     []string `yaml:"Aliases"`     // 其他写法，总结中统一替换为 Term，如 ["k8s", "kube"]
+	Explanation string   `yaml:"Explanation"` // 可选，术语解释，提供给 LLM 理解含义
+}
+
+type Anomaly struct {
+	Enable       bool    `yaml:"Enable"`       // 是否将消息量、发言人数与近期均值比较，异常时在总结头部提示并告警
+	BaselineDays int     `yaml:"BaselineDays"` // 基线取最近多少天内同一时段的总结，默认 7
+	MinSamples   int     `yaml:"MinSamples"`   // 基线样本少于该数时不检测，默认 3
+	Ratio        float64 `yaml:"Ratio"`        // 高于均值 Ratio 倍或低于均值 1/Ratio 时视为异常，默认 3
+}
+
+type Display struct {
+	Locale         string `yaml:"Locale"`         // 展示语言："zh"（默认）/ "en"，用于总结、命令回复及告警
+	Timezone       string `yaml:"Timezone"`       // 展示时区（IANA 名称，如 "Asia/Shanghai"），默认 UTC；仅影响展示，不影响调度
+	TimezoneLabel  string `yaml:"TimezoneLabel"`  // 时区标签，如 "北京时间"，默认使用时区缩写
+	DateFormat     string `yaml:"DateFormat"`     // 日期格式（Go 时间格式），默认 "2006-01-02"
+	TimeFormat     string `yaml:"TimeFormat"`     // 区间不是整天时附加的时间格式，默认 "15:04"
+	ShowWeekday    bool   `yaml:"ShowWeekday"`    // 日期后是否显示星期
+	FirstDayOfWeek string `yaml:"FirstDayOfWeek"` // 每周第一天："monday"（默认）/ "sunday"，区间恰为整周时显示周次
+	RangeEnd       string `yaml:"RangeEnd"`       // 区间结束的展示方式："inclusive"（默认，显示最后包含的日期）/ "exclusive"（显示不包含的结束时刻）
+
+	TitleEmoji *string           `yaml:"TitleEmoji"` // 标题前的 emoji，默认 "📊"，设为 "" 不显示
+	DateEmoji  *string           `yaml:"DateEmoji"`  // 日期前的 emoji，默认 "📅"，设为 "" 不显示
+	Footer     string            `yaml:"Footer"`     // 总结末尾的页脚，如 "generated by talk-trace-bot"，为空不显示
+	Texts      map[string]string `yaml:"Texts"`      // 覆盖界面文本：文本键（如 "summary_title"）=> 替换内容，用于自定义品牌，对所有展示语言生效
+
+	// ChatLocales 按群组指定展示语言：群组ID => "zh" / "en"，影响该群组的总结及群聊命令回复；未配置的群组使用 Locale
+	ChatLocales map[int64]string `yaml:"ChatLocales"`
+}
+
+// Location 返回展示时区
+func (d *Display) Location() (*time.Location, error) {
+	if d.Timezone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(d.Timezone)
+}
+
+type SummaryWindow struct {
+	Name        string `yaml:"Name"`        // 窗口名称（唯一），如 "morning"
+	Cron        string `yaml:"Cron"`        // cron 表达式，如 "0 12 * * *"
+	StartOffset string `yaml:"StartOffset"` // 区间开始相对触发当日 0 点的偏移，如 "0h"、"-24h"
+	EndOffset   string `yaml:"EndOffset"`   // 区间结束相对触发当日 0 点的偏移，如 "12h"
+}
+
+// Offsets 解析区间偏移
+func (w *SummaryWindow) Offsets() (start, end time.Duration, err error) {
+	start, err = time.ParseDuration(w.StartOffset)
+	if err != nil {
+		return 0, 0, fmt.Errorf("StartOffset 无效: %w", err)
+	}
+	end, err = time.ParseDuration(w.EndOffset)
+	if err != nil {
+		return 0, 0, fmt.Errorf("EndOffset 无效: %w", err)
+	}
+	return start, end, nil
+}
+
+type HTTPServer struct {
+	Enable bool   `yaml:"Enable"` // 是否启用 HTTP 服务（健康检查等）
+	Addr   string `yaml:"Addr"`   // 监听地址，如 "127.0.0.1:8080"
+	Token  string `yaml:"Token"`  // 访问 /v1/topics 需携带的 Bearer Token，为空表示不校验，此时只能监听本机地址
+}
+
+// Loopback 监听地址是否只接受本机连接（localhost 或回环 IP），未指定主机（如 ":8080"）时监听所有地址
+func (h *HTTPServer) Loopback() bool {
+	host, _, err := net.SplitHostPort(h.Addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+type Alert struct {
+	Enable               bool   `yaml:"Enable"`               // 是否启用故障告警
+	ChatFailureThreshold int    `yaml:"ChatFailureThreshold"` // 同一群组连续失败多少天触发告警，默认 3
+	DisconnectThreshold  *int   `yaml:"DisconnectThreshold"`  // Telegram 连接中断超过多少分钟触发告警，恢复后补录中断期间的消息，默认 10，0 表示不告警
+	WebhookURL           string `yaml:"WebhookURL"`           // 可选，告警以 JSON POST 到该地址
+}
+
+type TDLibStorage struct {
+	OptimizeCron        string `yaml:"OptimizeCron"`        // 定期清理 TDLib 文件缓存的 cron 表达式，为空表示禁用
+	MaxSizeMB           int64  `yaml:"MaxSizeMB"`           // 清理后文件总大小上限（MB），0 表示使用 TDLib 默认值
+	TTLDays             int    `yaml:"TTLDays"`             // 删除超过该天数未访问的文件，0 表示使用 TDLib 默认值
+	UseFileDatabase     *bool  `yaml:"UseFileDatabase"`     // 是否持久化文件信息，默认 true
+	UseChatInfoDatabase *bool  `yaml:"UseChatInfoDatabase"` // 是否持久化用户、群组信息，默认 true（开启时隐含 UseFileDatabase）
+	UseMessageDatabase  *bool  `yaml:"UseMessageDatabase"`  // 是否持久化消息和聊天列表，默认 true（开启时隐含 UseChatInfoDatabase）
+}
+
+// Databases 返回 TDLib 各数据库开关，未配置时默认开启；按 TDLib 语义，上层数据库开启时隐含开启下层数据库
+func (s *TDLibStorage) Databases() (useFile, useChatInfo, useMessage bool) {
+	enabled := func(v *bool) bool { return v == nil || *v }
+	useMessage = enabled(s.UseMessageDatabase)
+	useChatInfo = useMessage || enabled(s.UseChatInfoDatabase)
+	useFile = useChatInfo || enabled(s.UseFileDatabase)
+	return useFile, useChatInfo, useMessage
+}
+
+// IngestContentTypes 可保存的消息内容类型
+var IngestContentTypes = []string{"text", "caption", "poll", "contact", "location", "venue"}
+
+type Ingest struct {
+	// ContentTypes 保存的消息内容类型：text（文本）、caption（图片、视频、文件、音频等的说明文字）、poll（投票）、contact（联系人）、location（位置）、venue（地点），默认 ["text"]
+	ContentTypes []string `yaml:"ContentTypes"`
+
+	QueueSize *int `yaml:"QueueSize"` // 入库队列容量：消息先入队，由单个协程顺序写入数据库，队列已满时接收消息阻塞等待，未配置时为 1000，至少为 1
+
+	// RecordEvents 将不保存内容的非文字消息（贴纸、动图、图片、语音等）记录为事件：仅保存发送者、类型和时间，
+	// 不参与总结，只计入总结末尾的成员活跃度统计
+	RecordEvents bool `yaml:"RecordEvents"`
+
+	RateLimit IngestRateLimit `yaml:"RateLimit"` // 每个群组每小时保存的消息数上限，用于消息量极大的群组
+}
+
+// IngestRateLimitModes 超过每小时上限时的保留方式
+var IngestRateLimitModes = []string{"first", "sample"}
+
+// IngestRateLimit 限制每个群组每小时（按消息发送时间）保存的新消息数，超出的消息不保存、不参与总结，
+// 使数据库大小和总结的 LLM 费用保持有界
+type IngestRateLimit struct {
+	MaxPerHour int    `yaml:"MaxPerHour"` // 每个群组每小时最多保存的消息数，0 表示不限制
+	Mode       string `yaml:"Mode"`       // first（保留每小时最先的消息）或 sample（按上一小时的消息量等间隔采样，保存的消息分布在整个小时内），默认 sample
+}
+
+// Accepts 是否保存该类型的消息内容
+func (i *Ingest) Accepts(contentType string) bool {
+	return slices.Contains(i.ContentTypes, contentType)
+}
+
+// AllowsChat 是否收集和总结该群组的消息（IncludeChatIds / ExcludeChatIds）
+func (s *Summary) AllowsChat(chatID int64) bool {
+	if slices.Contains(s.ExcludeChatIds, chatID) {
+		return false
+	}
+	return len(s.IncludeChatIds) == 0 || slices.Contains(s.IncludeChatIds, chatID)
+}
+
+type Heartbeat struct {
+	Cron string `yaml:"Cron"` // 更新收藏夹中状态消息的 cron 表达式，如 "*/10 * * * *"，为空表示禁用
+}
+
+type MetadataRefresh struct {
+	Cron        string `yaml:"Cron"`        // 刷新群聊信息（名称、用户名、成员数）及发言用户用户名的 cron 表达式，如 "0 4 * * *"，为空表示禁用
+	MaxAgeHours int    `yaml:"MaxAgeHours"` // 用户信息超过该小时数未更新时重新获取，默认 24
 }
 
+type Bot struct {
+	Token string `yaml:"Token"` // 机器人 Token（@BotFather 获取），配置后启用机器人模式：群聊发送带话题按钮的精简总结，为空表示禁用
+}
+
+type Onboarding struct {
+	Enable bool   `yaml:"Enable"` // 加入新群组时发送介绍消息，说明数据收集方式及如何退出
+	Text   string `yaml:"Text"`   // 介绍消息内容（纯文本），为空时使用默认文本
+}
+
+// defaultOnboardingText 默认的入群介绍消息
+const defaultOnboardingText = `👋 大家好，我是本群的聊天总结助手。
+我会保存群内的文字消息，用于定期生成话题总结，过期消息会自动删除。
+群管理员可发送 /optout 停止收集本群消息并删除已保存的记录，发送 /optin 恢复收集。`
+
+type TLDR struct {
+	Enable          bool `yaml:"Enable"`          // 启用群聊 /tldr：回复某条消息发送 /tldr，即时总结该消息所在的讨论，所有成员可用
+	MaxMessages     int  `yaml:"MaxMessages"`     // 单次最多总结的消息数，默认 200
+	CooldownSeconds int  `yaml:"CooldownSeconds"` // 同一群聊两次 /tldr 的最短间隔（秒），默认 60
+}
+
+type OnDemand struct {
+	Enable          bool `yaml:"Enable"`          // 启用 /summary：在群聊中或私聊本账号（仅管理员）发送 "/summary today"、"/summary 2025-02-10 2025-02-12"，即时总结指定区间
+	MaxDays         int  `yaml:"MaxDays"`         // 单次最多总结的天数，默认 7
+	CooldownSeconds int  `yaml:"CooldownSeconds"` // 同一群聊两次 /summary 的最短间隔（秒），默认 300
+}
+
+type WeeklyReview struct {
+	Cron    string  `yaml:"Cron"`    // 私聊发送每周个人回顾的 cron 表达式，如 "0 1 * * 1"，为空表示禁用
+	UserIds []int64 `yaml:"UserIds"` // 接收回顾的用户ID：汇总过去 7 天参与的话题、待跟进事项及提及该用户的消息
+}
+
+type MessageCache struct {
+	Enable             bool `yaml:"Enable"`             // 在内存中缓存各群组当天（UTC）的消息，当天区间的总结（如日内总结窗口）无需重复查询数据库
+	MaxMessagesPerChat int  `yaml:"MaxMessagesPerChat"` // 每个群组最多缓存的消息数，超出时丢弃最早的消息，默认 5000
+}
+
+type MessageCompression struct {
+	Enable   bool `yaml:"Enable"`   // 使用 zstd 压缩保存较长的消息文本以缩小 SQLite 数据库文件，仅对启用后写入的消息生效；使用 ClickHouse 时不生效（由 ClickHouse 自行压缩）
+	MinBytes int  `yaml:"MinBytes"` // 文本不短于该字节数的消息才压缩，过短的消息压缩收益很小，默认 256
+}
+
+type ClickHouse struct {
+	URL      string `yaml:"URL"`      // HTTP 接口地址，如 "http://127.0.0.1:8123"，配置后消息改为保存到 ClickHouse（任务和运行记录仍保存在 SQLite），为空表示禁用
+	Database string `yaml:"Database"` // 数据库名，默认 default
+	Table    string `yaml:"Table"`    // 消息表名，不存在时自动创建，默认 messages
+	User     string `yaml:"User"`     // 用户名，默认 default
+	Password string `yaml:"Password"` // 密码
+}
+
+// identifierPattern ClickHouse 数据库名和表名，直接拼接到 SQL 中，因此限制字符
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type Config struct {
-	Sock5Proxy  Sock5Proxy  `yaml:"Sock5Proxy"`
-	TelegramApp TelegramApp `yaml:"TelegramApp"`
-	LLM         LLM         `yaml:"LLM"`
-	Summary     Summary     `yaml:"Summary"`
+	Profile            string             `yaml:"Profile"` // 配置预设："small-group" / "large-community" / "compliance"，为空表示不使用；预设只填充未配置的字段
+	Sock5Proxy         Sock5Proxy         `yaml:"Sock5Proxy"`
+	TelegramApp        TelegramApp        `yaml:"TelegramApp"`
+	TDLibStorage       TDLibStorage       `yaml:"TDLibStorage"`
+	Ingest             Ingest             `yaml:"Ingest"`
+	LLM                LLM                `yaml:"LLM"`
+	Summary            Summary            `yaml:"Summary"`
+	HTTPServer         HTTPServer         `yaml:"HTTPServer"`
+	Alert              Alert              `yaml:"Alert"`
+	Heartbeat          Heartbeat          `yaml:"Heartbeat"`
+	MetadataRefresh    MetadataRefresh    `yaml:"MetadataRefresh"`
+	UserRefresh        MetadataRefresh    `yaml:"UserRefresh"` // 已弃用，改用 MetadataRefresh；未配置 MetadataRefresh 时沿用
+	Bot                Bot                `yaml:"Bot"`
+	Onboarding         Onboarding         `yaml:"Onboarding"`
+	TLDR               TLDR               `yaml:"TLDR"`
+	OnDemand           OnDemand           `yaml:"OnDemand"`
+	WeeklyReview       WeeklyReview       `yaml:"WeeklyReview"`
+	ClickHouse         ClickHouse         `yaml:"ClickHouse"`
+	MessageCache       MessageCache       `yaml:"MessageCache"`
+	MessageCompression MessageCompression `yaml:"MessageCompression"`
+	AdminUserIds       []int64            `yaml:"AdminUserIds"` // 管理员用户ID列表，可私聊发送 /status 等命令
+
+	ShutdownTimeout int `yaml:"ShutdownTimeout"` // 优雅关闭超时（秒），超时后强制退出，默认 30
 }
 
 func LoadFromFile(filename string) (*Config, error) {
@@ -48,7 +430,20 @@ func LoadFromFile(filename string) (*Config, error) {
 		return nil, err
 	}
 
+	// 先读取 Profile：预设值作为配置文件中未出现字段的初始值
+	var header struct {
+		Profile string `yaml:"Profile"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
 	var c Config
+	if header.Profile != "" {
+		if err := applyProfile(&c, header.Profile); err != nil {
+			return nil, err
+		}
+		logger.Infof("[Config] 使用配置预设 %s，配置文件中的字段覆盖预设值", header.Profile)
+	}
 	err = yaml.Unmarshal([]byte(data), &c)
 	if err != nil {
 		return nil, err
@@ -62,8 +457,135 @@ func LoadFromFile(filename string) (*Config, error) {
 	return &c, nil
 }
 
-// Validate 验证配置的有效性
+// setDefault 未配置（零值）时使用默认值并记录警告
+func setDefault[T comparable](field *T, value T, name string) {
+	var zero T
+	if *field == zero {
+		logger.Warnf("[Config] %s 未配置，使用默认值 %v", name, value)
+		*field = value
+	}
+}
+
+// applyDefaults 填充文档中约定的默认值，各组件直接使用配置值，无需各自处理缺省情况
+func (c *Config) applyDefaults() {
+	// 兼容旧配置：未配置的重试策略沿用 RetryTimes / RetryInterval
+	retryTimes, retryInterval := c.Summary.RetryTimes, c.Summary.RetryInterval
+	if retryTimes == 0 {
+		retryTimes = 3
+	}
+	if retryInterval == 0 {
+		retryInterval = 60
+	}
+	setDefault(&c.Summary.Retry.LLM.Times, retryTimes, "Summary.Retry.LLM.Times")
+	setDefault(&c.Summary.Retry.LLM.Interval, retryInterval, "Summary.Retry.LLM.Interval")
+	setDefault(&c.Summary.Retry.Telegram.Times, 2, "Summary.Retry.Telegram.Times")
+	setDefault(&c.Summary.Retry.Telegram.Interval, retryInterval/2, "Summary.Retry.Telegram.Interval")
+	setDefault(&c.Summary.Retry.DB.Times, retryTimes, "Summary.Retry.DB.Times")
+	setDefault(&c.Summary.Retry.DB.Interval, retryInterval, "Summary.Retry.DB.Interval")
+	setDefault(&c.Summary.TaskTimeout, 1200, "Summary.TaskTimeout")
+	setDefault(&c.Summary.Engine, "llm", "Summary.Engine")
+	if c.Summary.CompressMaxRunes == nil {
+		// 显式配置的 0 表示不压缩，因此使用指针区分未配置
+		compressMaxRunes := 800
+		logger.Warnf("[Config] Summary.CompressMaxRunes 未配置，使用默认值 %d", compressMaxRunes)
+		c.Summary.CompressMaxRunes = &compressMaxRunes
+	}
+	if len(c.Ingest.ContentTypes) == 0 {
+		logger.Warnf("[Config] Ingest.ContentTypes 未配置，使用默认值 [text]")
+		c.Ingest.ContentTypes = []string{"text"}
+	}
+	if c.Ingest.QueueSize == nil {
+		// 使用指针区分未配置与显式配置的 0（无效值，由 Validate 报错）
+		queueSize := 1000
+		logger.Warnf("[Config] Ingest.QueueSize 未配置，使用默认值 %d", queueSize)
+		c.Ingest.QueueSize = &queueSize
+	}
+	if c.Ingest.RateLimit.MaxPerHour > 0 {
+		setDefault(&c.Ingest.RateLimit.Mode, "sample", "Ingest.RateLimit.Mode")
+	}
+	if c.Summary.FallbackEngines == nil {
+		logger.Warnf("[Config] Summary.FallbackEngines 未配置，使用默认值 [extractive]")
+		c.Summary.FallbackEngines = []string{"extractive"}
+	}
+	setDefault(&c.Summary.PurgeCron, "30 * * * *", "Summary.PurgeCron")
+	setDefault(&c.Summary.CleanupBatchSize, 1000, "Summary.CleanupBatchSize")
+	if len(c.Summary.Windows) == 0 {
+		// 配置 Windows 时不使用 RangeDays
+		setDefault(&c.Summary.RangeDays, 1, "Summary.RangeDays")
+	}
+	if c.Summary.Anomaly.Enable {
+		setDefault(&c.Summary.Anomaly.BaselineDays, 7, "Summary.Anomaly.BaselineDays")
+		setDefault(&c.Summary.Anomaly.MinSamples, 3, "Summary.Anomaly.MinSamples")
+		setDefault(&c.Summary.Anomaly.Ratio, 3, "Summary.Anomaly.Ratio")
+	}
+	setDefault(&c.LLM.PromptTimestamps, "minute", "LLM.PromptTimestamps")
+	setDefault(&c.LLM.RequestTimeout, 300, "LLM.RequestTimeout")
+	if c.LLM.Shadow.Model != "" {
+		setDefault(&c.LLM.Shadow.SampleRate, 0.1, "LLM.Shadow.SampleRate")
+	}
+	if c.LLM.Capture.Dir != "" {
+		setDefault(&c.LLM.Capture.MaxBytes, 65536, "LLM.Capture.MaxBytes")
+		setDefault(&c.LLM.Capture.MaxFiles, 200, "LLM.Capture.MaxFiles")
+	}
+	// 兼容旧配置：UserRefresh 已更名为 MetadataRefresh
+	if c.UserRefresh != (MetadataRefresh{}) {
+		if c.MetadataRefresh == (MetadataRefresh{}) {
+			logger.Warnf("[Config] UserRefresh 已弃用，请改用 MetadataRefresh，当前沿用 UserRefresh 的配置")
+			c.MetadataRefresh = c.UserRefresh
+		} else {
+			logger.Warnf("[Config] UserRefresh 已弃用，已配置 MetadataRefresh，忽略 UserRefresh")
+		}
+	}
+	if c.MetadataRefresh.Cron != "" {
+		setDefault(&c.MetadataRefresh.MaxAgeHours, 24, "MetadataRefresh.MaxAgeHours")
+	}
+	if c.Onboarding.Enable && c.Onboarding.Text == "" {
+		logger.Warnf("[Config] Onboarding.Text 未配置，使用默认介绍消息")
+		c.Onboarding.Text = defaultOnboardingText
+	}
+	if c.TLDR.Enable {
+		setDefault(&c.TLDR.MaxMessages, 200, "TLDR.MaxMessages")
+		setDefault(&c.TLDR.CooldownSeconds, 60, "TLDR.CooldownSeconds")
+	}
+	if c.OnDemand.Enable {
+		setDefault(&c.OnDemand.MaxDays, 7, "OnDemand.MaxDays")
+		setDefault(&c.OnDemand.CooldownSeconds, 300, "OnDemand.CooldownSeconds")
+	}
+	if c.MessageCache.Enable {
+		setDefault(&c.MessageCache.MaxMessagesPerChat, 5000, "MessageCache.MaxMessagesPerChat")
+	}
+	if c.MessageCompression.Enable {
+		setDefault(&c.MessageCompression.MinBytes, 256, "MessageCompression.MinBytes")
+	}
+	if c.ClickHouse.URL != "" {
+		setDefault(&c.ClickHouse.Database, "default", "ClickHouse.Database")
+		setDefault(&c.ClickHouse.Table, "messages", "ClickHouse.Table")
+		setDefault(&c.ClickHouse.User, "default", "ClickHouse.User")
+	}
+	if c.TelegramApp.Login.Enabled() {
+		setDefault(&c.TelegramApp.Login.CodeTimeout, 300, "TelegramApp.Login.CodeTimeout")
+	}
+	for i := range c.TelegramApp.Accounts {
+		if login := &c.TelegramApp.Accounts[i].Login; login.Enabled() {
+			setDefault(&login.CodeTimeout, 300, fmt.Sprintf("TelegramApp.Accounts[%d].Login.CodeTimeout", i))
+		}
+	}
+	setDefault(&c.Alert.ChatFailureThreshold, 3, "Alert.ChatFailureThreshold")
+	if c.Alert.DisconnectThreshold == nil {
+		// 使用指针区分未配置与显式配置的 0（不发送连接中断告警）
+		threshold := 10
+		logger.Warnf("[Config] Alert.DisconnectThreshold 未配置，使用默认值 %d", threshold)
+		c.Alert.DisconnectThreshold = &threshold
+	}
+	setDefault(&c.ShutdownTimeout, 30, "ShutdownTimeout")
+}
+
+// Validate 验证配置的有效性，并填充未配置项的默认值（负数等无效值直接报错，不会被默认值覆盖）
 func (c *Config) Validate() error {
+	if c.Profile != "" && !slices.Contains(Profiles, c.Profile) {
+		return fmt.Errorf("未知的配置预设 Profile %q，可选值: %s", c.Profile, strings.Join(Profiles, "、"))
+	}
+
 	// 验证 TelegramApp
 	if c.TelegramApp.ApiId == 0 {
 		return fmt.Errorf("TelegramApp.ApiId 不能为空")
@@ -71,6 +593,61 @@ func (c *Config) Validate() error {
 	if c.TelegramApp.ApiHash == "" {
 		return fmt.Errorf("TelegramApp.ApiHash 不能为空")
 	}
+	if c.TelegramApp.WatchdogTimeout < 0 {
+		return fmt.Errorf("TelegramApp.WatchdogTimeout 必须 >= 0")
+	}
+	if c.TelegramApp.Login.CodeTimeout < 0 {
+		return fmt.Errorf("TelegramApp.Login.CodeTimeout 必须 >= 0")
+	}
+	if c.TelegramApp.Login.QRCode && c.TelegramApp.Login.PhoneNumber != "" {
+		return fmt.Errorf("TelegramApp.Login.QRCode 与 PhoneNumber 只能配置一个")
+	}
+	dataDirs := map[string]bool{"data": true}
+	for i, account := range c.TelegramApp.Accounts {
+		if account.DataDir == "" {
+			return fmt.Errorf("TelegramApp.Accounts[%d].DataDir 不能为空", i)
+		}
+		if account.Login.CodeTimeout < 0 {
+			return fmt.Errorf("TelegramApp.Accounts[%d].Login.CodeTimeout 必须 >= 0", i)
+		}
+		if account.Login.QRCode && account.Login.PhoneNumber != "" {
+			return fmt.Errorf("TelegramApp.Accounts[%d].Login.QRCode 与 PhoneNumber 只能配置一个", i)
+		}
+		dir := filepath.Clean(account.DataDir)
+		if dataDirs[dir] {
+			return fmt.Errorf("TelegramApp.Accounts[%d].DataDir %q 与其他账号相同", i, account.DataDir)
+		}
+		dataDirs[dir] = true
+	}
+
+	// 验证 Ingest
+	for _, contentType := range c.Ingest.ContentTypes {
+		if !slices.Contains(IngestContentTypes, contentType) {
+			return fmt.Errorf("Ingest.ContentTypes 包含未知的内容类型 %q，可选值: %s", contentType, strings.Join(IngestContentTypes, "、"))
+		}
+	}
+	if c.Alert.DisconnectThreshold != nil && *c.Alert.DisconnectThreshold < 0 {
+		return fmt.Errorf("Alert.DisconnectThreshold 必须 >= 0")
+	}
+	if c.Ingest.QueueSize != nil && *c.Ingest.QueueSize < 1 {
+		return fmt.Errorf("Ingest.QueueSize 必须 >= 1")
+	}
+	if c.Ingest.RateLimit.MaxPerHour < 0 {
+		return fmt.Errorf("Ingest.RateLimit.MaxPerHour 必须 >= 0")
+	}
+	if mode := c.Ingest.RateLimit.Mode; mode != "" && !slices.Contains(IngestRateLimitModes, mode) {
+		return fmt.Errorf("未知的 Ingest.RateLimit.Mode %q，可选值: %s", mode, strings.Join(IngestRateLimitModes, "、"))
+	}
+
+	// 验证 TDLibStorage
+	if c.TDLibStorage.MaxSizeMB < 0 || c.TDLibStorage.TTLDays < 0 {
+		return fmt.Errorf("TDLibStorage.MaxSizeMB 和 TDLibStorage.TTLDays 必须 >= 0")
+	}
+	if c.TDLibStorage.OptimizeCron != "" {
+		if err := validateCron("TDLibStorage.OptimizeCron", c.TDLibStorage.OptimizeCron); err != nil {
+			return err
+		}
+	}
 
 	// 验证 LLM
 	if c.LLM.APIKey == "" {
@@ -85,22 +662,115 @@ func (c *Config) Validate() error {
 	if c.LLM.MaxTokens <= 0 {
 		return fmt.Errorf("LLM.MaxTokens 必须大于 0")
 	}
+	if c.LLM.PromptPrice < 0 || c.LLM.CompletionPrice < 0 {
+		return fmt.Errorf("LLM.PromptPrice 和 LLM.CompletionPrice 必须 >= 0")
+	}
+	if c.LLM.TopicMergeThreshold < 0 || c.LLM.TopicMergeThreshold > 1 {
+		return fmt.Errorf("LLM.TopicMergeThreshold 必须在 0 到 1 之间")
+	}
+	if c.LLM.Shadow.SampleRate < 0 || c.LLM.Shadow.SampleRate > 1 {
+		return fmt.Errorf("LLM.Shadow.SampleRate 必须在 0 到 1 之间")
+	}
+	switch c.LLM.PromptTimestamps {
+	case "", "none", "hour", "minute":
+	default:
+		return fmt.Errorf("LLM.PromptTimestamps 必须为 none、hour 或 minute")
+	}
+	if c.LLM.RequestTimeout < 0 || c.LLM.SummarizeTimeout < 0 {
+		return fmt.Errorf("LLM.RequestTimeout 和 LLM.SummarizeTimeout 必须 >= 0")
+	}
+	if c.LLM.Capture.MaxBytes < 0 || c.LLM.Capture.MaxFiles < 0 {
+		return fmt.Errorf("LLM.Capture.MaxBytes 和 LLM.Capture.MaxFiles 必须 >= 0")
+	}
 
 	// 验证 Summary
-	if c.Summary.Cron == "" {
-		return fmt.Errorf("Summary.Cron 不能为空")
+	if c.Summary.RetentionDays < 0 || c.Summary.RangeDays < 0 || c.Summary.RetryTimes < 0 || c.Summary.RetryInterval < 0 || c.Summary.CleanupBatchSize < 0 {
+		return fmt.Errorf("Summary.RetentionDays、RangeDays、RetryTimes、RetryInterval 和 CleanupBatchSize 必须 >= 0")
+	}
+	if r := c.Summary.Retry; r.LLM.Times < 0 || r.LLM.Interval < 0 || r.Telegram.Times < 0 || r.Telegram.Interval < 0 || r.DB.Times < 0 || r.DB.Interval < 0 {
+		return fmt.Errorf("Summary.Retry 各类别的 Times 和 Interval 必须 >= 0")
+	}
+	if c.Summary.TaskTimeout < 0 {
+		return fmt.Errorf("Summary.TaskTimeout 必须 >= 0")
+	}
+	if a := c.Summary.Anomaly; a.BaselineDays < 0 || a.MinSamples < 0 || a.Ratio < 0 {
+		return fmt.Errorf("Summary.Anomaly.BaselineDays、MinSamples 和 Ratio 必须 >= 0")
+	}
+	if a := c.Summary.Anomaly; a.Ratio != 0 && a.Ratio <= 1 {
+		return fmt.Errorf("Summary.Anomaly.Ratio 必须大于 1")
+	}
+	if c.Summary.QuoteMaxRunes < 0 {
+		return fmt.Errorf("Summary.QuoteMaxRunes 必须 >= 0")
+	}
+	if c.Summary.MinMessageRunes < 0 {
+		return fmt.Errorf("Summary.MinMessageRunes 必须 >= 0")
+	}
+	if r := c.Summary.CompressMaxRunes; r != nil && (*r < 0 || *r > 1500) {
+		return fmt.Errorf("Summary.CompressMaxRunes 必须在 0 到 1500 之间")
+	}
+	if c.Alert.ChatFailureThreshold < 0 {
+		return fmt.Errorf("Alert.ChatFailureThreshold 必须 >= 0")
+	}
+	if c.ShutdownTimeout < 0 {
+		return fmt.Errorf("ShutdownTimeout 必须 >= 0")
+	}
+	c.applyDefaults()
+
+	if len(c.Summary.Windows) == 0 {
+		if c.Summary.Cron == "" {
+			return fmt.Errorf("Summary.Cron 不能为空")
+		}
+		if err := validateCron("Summary.Cron", c.Summary.Cron); err != nil {
+			return err
+		}
+	}
+	if err := validateCron("Summary.PurgeCron", c.Summary.PurgeCron); err != nil {
+		return err
 	}
-	if c.Summary.RetentionDays < 0 {
-		return fmt.Errorf("Summary.RetentionDays 必须 >= 0")
+	windowNames := make(map[string]bool, len(c.Summary.Windows))
+	for i := range c.Summary.Windows {
+		w := &c.Summary.Windows[i]
+		if w.Name == "" || w.Cron == "" {
+			return fmt.Errorf("Summary.Windows[%d] 的 Name 和 Cron 不能为空", i)
+		}
+		if windowNames[w.Name] {
+			return fmt.Errorf("Summary.Windows 名称重复: %s", w.Name)
+		}
+		if err := validateCron(fmt.Sprintf("Summary.Windows[%s].Cron", w.Name), w.Cron); err != nil {
+			return err
+		}
+		windowNames[w.Name] = true
+		start, end, err := w.Offsets()
+		if err != nil {
+			return fmt.Errorf("Summary.Windows[%s] %w", w.Name, err)
+		}
+		if end <= start {
+			return fmt.Errorf("Summary.Windows[%s] 的 EndOffset 必须大于 StartOffset", w.Name)
+		}
+		// 在 EndOffset 之前触发时，总结的区间尚未结束，之后的消息不会被总结
+		if at, ok := cronFiresBefore(w.Cron, time.Now(), end); ok {
+			return fmt.Errorf("Summary.Windows[%s] 的 Cron 在 %s（UTC）触发，早于区间结束 EndOffset %s", w.Name, at.Format("15:04"), w.EndOffset)
+		}
+	}
+	if c.Summary.RangeDays > c.Summary.RetentionDays+1 {
+		// 清理任务保留 RetentionDays + 1 天的消息，超出部分总结时已被删除
+		return fmt.Errorf("Summary.RangeDays (%d) 不能超过 RetentionDays + 1 (%d)", c.Summary.RangeDays, c.Summary.RetentionDays+1)
+	}
+	if l := c.Summary.Language; l != "" && l != "auto" && !lang.Supported(l) {
+		return fmt.Errorf("Summary.Language 必须为空、'auto' 或支持的语言代码，当前为 %q", l)
 	}
-	if c.Summary.RangeDays < 0 {
-		return fmt.Errorf("Summary.RangeDays 必须 >= 0")
+	if err := validateGlossary("Summary.Glossary", c.Summary.Glossary); err != nil {
+		return err
 	}
-	if c.Summary.RetryTimes < 0 {
-		return fmt.Errorf("Summary.RetryTimes 必须 >= 0")
+	for _, chatType := range slices.Sorted(maps.Keys(c.Summary.ChatTypeEngines)) {
+		if !slices.Contains(ChatTypes, chatType) {
+			return fmt.Errorf("Summary.ChatTypeEngines 的聊天类型必须是 %s 之一，当前为 %q", strings.Join(ChatTypes, "、"), chatType)
+		}
 	}
-	if c.Summary.RetryInterval < 0 {
-		return fmt.Errorf("Summary.RetryInterval 必须 >= 0")
+	for chatID, terms := range c.Summary.ChatGlossaries {
+		if err := validateGlossary(fmt.Sprintf("Summary.ChatGlossaries[%d]", chatID), terms); err != nil {
+			return err
+		}
 	}
 	if c.Summary.NotifyMode != "private" && c.Summary.NotifyMode != "group" && c.Summary.NotifyMode != "both" {
 		return fmt.Errorf("Summary.NotifyMode 必须是 'private', 'group' 或 'both'")
@@ -110,6 +780,145 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("Summary.NotifyUserIds 不能为空（当 NotifyMode 为 'private' 或 'both' 时）")
 		}
 	}
+	if d := c.Summary.Display; d.Locale != "" && !validLocale(d.Locale) {
+		return fmt.Errorf("Summary.Display.Locale 必须是 'zh' 或 'en'")
+	}
+	for chatID, locale := range c.Summary.Display.ChatLocales {
+		if !validLocale(locale) {
+			return fmt.Errorf("Summary.Display.ChatLocales[%d] 必须是 'zh' 或 'en'", chatID)
+		}
+	}
+	if _, err := c.Summary.Display.Location(); err != nil {
+		return fmt.Errorf("Summary.Display.Timezone 无效: %w", err)
+	}
+	if d := c.Summary.Display; d.FirstDayOfWeek != "" && d.FirstDayOfWeek != "monday" && d.FirstDayOfWeek != "sunday" {
+		return fmt.Errorf("Summary.Display.FirstDayOfWeek 必须是 'monday' 或 'sunday'")
+	}
+	if d := c.Summary.Display; d.RangeEnd != "" && d.RangeEnd != "inclusive" && d.RangeEnd != "exclusive" {
+		return fmt.Errorf("Summary.Display.RangeEnd 必须是 'inclusive' 或 'exclusive'")
+	}
+	for userID := range c.Summary.Subscriptions {
+		if !slices.Contains(c.Summary.NotifyUserIds, userID) {
+			return fmt.Errorf("Summary.Subscriptions 中的用户 %d 不在 NotifyUserIds 中", userID)
+		}
+	}
+	for _, userID := range c.Summary.SkipPrivateIfMember {
+		if !slices.Contains(c.Summary.NotifyUserIds, userID) {
+			return fmt.Errorf("Summary.SkipPrivateIfMember 中的用户 %d 不在 NotifyUserIds 中", userID)
+		}
+	}
+	for _, chatID := range c.Summary.ExcludeChatIds {
+		if slices.Contains(c.Summary.IncludeChatIds, chatID) {
+			return fmt.Errorf("Summary.IncludeChatIds 与 ExcludeChatIds 同时包含群组 %d", chatID)
+		}
+	}
+	if c.Summary.SelfTestOnStartup && len(c.AdminUserIds) == 0 {
+		logger.Warnf("[Config] 已启用 Summary.SelfTestOnStartup，但未配置 AdminUserIds，自检结果仅记录到日志")
+	}
+	if len(c.Summary.SkipPrivateIfMember) > 0 && c.Summary.NotifyMode != "both" {
+		logger.Warnf("[Config] Summary.SkipPrivateIfMember 仅在 NotifyMode 为 both 时生效")
+	}
+
+	// 验证 Heartbeat
+	if c.Heartbeat.Cron != "" {
+		if err := validateCron("Heartbeat.Cron", c.Heartbeat.Cron); err != nil {
+			return err
+		}
+	}
+
+	// 验证 MetadataRefresh
+	if c.MetadataRefresh.Cron != "" {
+		if err := validateCron("MetadataRefresh.Cron", c.MetadataRefresh.Cron); err != nil {
+			return err
+		}
+	}
+	if c.MetadataRefresh.MaxAgeHours < 0 {
+		return fmt.Errorf("MetadataRefresh.MaxAgeHours 必须 >= 0")
+	}
+
+	// 验证 TLDR
+	if c.TLDR.MaxMessages < 0 {
+		return fmt.Errorf("TLDR.MaxMessages 必须 >= 0")
+	}
+	if c.TLDR.CooldownSeconds < 0 {
+		return fmt.Errorf("TLDR.CooldownSeconds 必须 >= 0")
+	}
+
+	// 验证 OnDemand
+	if c.OnDemand.MaxDays < 0 {
+		return fmt.Errorf("OnDemand.MaxDays 必须 >= 0")
+	}
+	if c.OnDemand.CooldownSeconds < 0 {
+		return fmt.Errorf("OnDemand.CooldownSeconds 必须 >= 0")
+	}
+
+	// 验证 WeeklyReview
+	if c.WeeklyReview.Cron != "" {
+		if err := validateCron("WeeklyReview.Cron", c.WeeklyReview.Cron); err != nil {
+			return err
+		}
+		if len(c.WeeklyReview.UserIds) == 0 {
+			return fmt.Errorf("WeeklyReview.UserIds 不能为空（当 WeeklyReview.Cron 已配置时）")
+		}
+	}
+
+	// 验证 ClickHouse
+	if c.ClickHouse.URL != "" {
+		u, err := url.Parse(c.ClickHouse.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("ClickHouse.URL 无效，应为 HTTP 接口地址，如 http://127.0.0.1:8123: %s", c.ClickHouse.URL)
+		}
+		if !identifierPattern.MatchString(c.ClickHouse.Database) {
+			return fmt.Errorf("ClickHouse.Database 只能包含字母、数字和下划线，且不能以数字开头: %s", c.ClickHouse.Database)
+		}
+		if !identifierPattern.MatchString(c.ClickHouse.Table) {
+			return fmt.Errorf("ClickHouse.Table 只能包含字母、数字和下划线，且不能以数字开头: %s", c.ClickHouse.Table)
+		}
+	}
+
+	// 验证 MessageCache
+	if c.MessageCache.MaxMessagesPerChat < 0 {
+		return fmt.Errorf("MessageCache.MaxMessagesPerChat 必须 >= 0")
+	}
+
+	// 验证 MessageCompression
+	if c.MessageCompression.MinBytes < 0 {
+		return fmt.Errorf("MessageCompression.MinBytes 必须 >= 0")
+	}
+
+	// 验证 Bot
+	if c.Bot.Token != "" && c.Summary.NotifyMode == "private" {
+		logger.Warnf("[Config] 已配置 Bot.Token，但 NotifyMode 为 private，机器人模式仅作用于群聊通知")
+	}
+
+	// 验证 HTTPServer
+	if c.HTTPServer.Enable && c.HTTPServer.Addr == "" {
+		return fmt.Errorf("HTTPServer.Addr 不能为空（当 HTTPServer.Enable 为 true 时）")
+	}
+	// 未配置 Token 时总结内容不经校验即可导出，只允许本机访问
+	if c.HTTPServer.Enable && c.HTTPServer.Token == "" && !c.HTTPServer.Loopback() {
+		return fmt.Errorf("HTTPServer.Addr %q 不是本机地址，需配置 HTTPServer.Token，或改为监听 127.0.0.1", c.HTTPServer.Addr)
+	}
 
 	return nil
 }
+
+// validateGlossary 校验术语表：Term 和 Aliases 不能为空
+func validateGlossary(name string, terms []GlossaryTerm) error {
+	for i, term := range terms {
+		if strings.TrimSpace(term.Term) == "" {
+			return fmt.Errorf("%s[%d] 的 Term 不能为空", name, i)
+		}
+		for _, alias := range term.Aliases {
+			if strings.TrimSpace(alias) == "" {
+				return fmt.Errorf("%s[%s] 的 Aliases 不能包含空字符串", name, term.Term)
+			}
+		}
+	}
+	return nil
+}
+
+// validLocale 是否为支持的展示语言（与 display 包的文本目录一致）
+func validLocale(locale string) bool {
+	return locale == "zh" || locale == "en"
+}
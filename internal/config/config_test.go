@@ -0,0 +1,401 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig() Config {
+	return Config{
+		TelegramApp: TelegramApp{ApiId: 1, ApiHash: "hash"},
+		LLM:         LLM{BaseURL: "https://api.openai.com/v1", APIKey: "key", Model: "gpt-4o", MaxTokens: 128000},
+		Summary:     Summary{Cron: "0 0 * * *", RetentionDays: 7, RangeDays: 1, NotifyMode: "group"},
+	}
+}
+
+func TestValidate_Summary(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		wantErr string
+	}{
+		{"默认配置有效", func(c *Config) {}, ""},
+		{"配置预设未知", func(c *Config) { c.Profile = "huge" }, "Profile"},
+		{"RangeDays 等于保留天数加一", func(c *Config) { c.Summary.RangeDays = 8 }, ""},
+		{"RangeDays 超过保留天数", func(c *Config) { c.Summary.RangeDays = 9 }, "RangeDays"},
+		{"RetentionDays 为零时只能总结一天", func(c *Config) { c.Summary.RetentionDays = 0; c.Summary.RangeDays = 2 }, "RetentionDays"},
+		{"RetryTimes 为负数不使用默认值", func(c *Config) { c.Summary.RetryTimes = -1 }, "RetryTimes"},
+		{"重试间隔为负数", func(c *Config) { c.Summary.Retry.Telegram.Interval = -1 }, "Summary.Retry"},
+		{"任务超时为负数", func(c *Config) { c.Summary.TaskTimeout = -1 }, "TaskTimeout"},
+		{"LLM 请求超时为负数", func(c *Config) { c.LLM.RequestTimeout = -1 }, "LLM.RequestTimeout"},
+		{"私信去重的用户不在通知列表", func(c *Config) {
+			c.Summary.NotifyMode = "both"
+			c.Summary.NotifyUserIds = []int64{1}
+			c.Summary.SkipPrivateIfMember = []int64{2}
+		}, "SkipPrivateIfMember"},
+		{"群组同时在允许和排除列表", func(c *Config) {
+			c.Summary.IncludeChatIds = []int64{-100, -200}
+			c.Summary.ExcludeChatIds = []int64{-200}
+		}, "IncludeChatIds"},
+		{"RangeDays 为负数", func(c *Config) { c.Summary.RangeDays = -1 }, "RangeDays"},
+		{"引用字数为负数", func(c *Config) { c.Summary.QuoteMaxRunes = -1 }, "QuoteMaxRunes"},
+		{"最短消息字数为负数", func(c *Config) { c.Summary.MinMessageRunes = -1 }, "MinMessageRunes"},
+		{"未知的聊天类型", func(c *Config) { c.Summary.ChatTypeEngines = map[string]string{"private": "llm"} }, "ChatTypeEngines"},
+		{"压缩字数超过子项长度上限", func(c *Config) { runes := 2000; c.Summary.CompressMaxRunes = &runes }, "CompressMaxRunes"},
+		{"tldr 消息数为负数", func(c *Config) { c.TLDR.MaxMessages = -1 }, "TLDR.MaxMessages"},
+		{"按需总结天数为负数", func(c *Config) { c.OnDemand.MaxDays = -1 }, "OnDemand.MaxDays"},
+		{"ClickHouse 地址无效", func(c *Config) { c.ClickHouse.URL = "127.0.0.1:8123" }, "ClickHouse.URL"},
+		{"ClickHouse 表名无效", func(c *Config) {
+			c.ClickHouse = ClickHouse{URL: "http://127.0.0.1:8123", Database: "default", Table: "messages;drop"}
+		}, "ClickHouse.Table"},
+		{"消息缓存上限为负数", func(c *Config) { c.MessageCache.MaxMessagesPerChat = -1 }, "MessageCache.MaxMessagesPerChat"},
+		{"保存的内容类型有效", func(c *Config) { c.Ingest.ContentTypes = []string{"text", "caption", "poll"} }, ""},
+		{"保存的内容类型未知", func(c *Config) { c.Ingest.ContentTypes = []string{"text", "sticker"} }, "Ingest.ContentTypes"},
+		{"HTTP 服务监听本机无需 Token", func(c *Config) { c.HTTPServer = HTTPServer{Enable: true, Addr: "127.0.0.1:8080"} }, ""},
+		{"HTTP 服务监听所有地址需要 Token", func(c *Config) { c.HTTPServer = HTTPServer{Enable: true, Addr: ":8080"} }, "HTTPServer.Token"},
+		{"HTTP 服务监听外部地址并配置 Token", func(c *Config) { c.HTTPServer = HTTPServer{Enable: true, Addr: "0.0.0.0:8080", Token: "secret"} }, ""},
+		{"连接中断告警阈值为负数", func(c *Config) { threshold := -1; c.Alert.DisconnectThreshold = &threshold }, "Alert.DisconnectThreshold"},
+		{"入库队列容量为负数", func(c *Config) { size := -1; c.Ingest.QueueSize = &size }, "Ingest.QueueSize"},
+		{"入库队列容量为 0", func(c *Config) { size := 0; c.Ingest.QueueSize = &size }, "Ingest.QueueSize"},
+		{"消息压缩阈值为负数", func(c *Config) { c.MessageCompression.MinBytes = -1 }, "MessageCompression.MinBytes"},
+		{"每周回顾未配置用户", func(c *Config) { c.WeeklyReview.Cron = "0 1 * * 1" }, "WeeklyReview.UserIds"},
+		{"对比抽样比例超过 1", func(c *Config) { c.LLM.Shadow.SampleRate = 1.5 }, "Shadow"},
+		{"消息时间精度无效", func(c *Config) { c.LLM.PromptTimestamps = "second" }, "PromptTimestamps"},
+		{"请求记录文件数为负数", func(c *Config) { c.LLM.Capture.MaxFiles = -1 }, "Capture"},
+		{"RangeEnd 有效值", func(c *Config) { c.Summary.Display.RangeEnd = "exclusive" }, ""},
+		{"RangeEnd 无效值", func(c *Config) { c.Summary.Display.RangeEnd = "open" }, "RangeEnd"},
+		{"展示时区无效", func(c *Config) { c.Summary.Display.Timezone = "Mars/Olympus" }, "Timezone"},
+		{"群组展示语言有效", func(c *Config) { c.Summary.Display.ChatLocales = map[int64]string{-100: "en"} }, ""},
+		{"群组展示语言无效", func(c *Config) { c.Summary.Display.ChatLocales = map[int64]string{-100: "fr"} }, "ChatLocales[-100]"},
+		{"总结语言自动", func(c *Config) { c.Summary.Language = "auto" }, ""},
+		{"总结语言代码", func(c *Config) { c.Summary.Language = "en" }, ""},
+		{"总结语言无效", func(c *Config) { c.Summary.Language = "english" }, "Language"},
+		{"术语为空", func(c *Config) { c.Summary.Glossary = []GlossaryTerm{{Term: " "}} }, "Glossary[0]"},
+		{"群组术语别名为空", func(c *Config) {
+			c.Summary.ChatGlossaries = map[int64][]GlossaryTerm{-100: {{Term: "BTC", Aliases: []string{""}}}}
+		}, "ChatGlossaries[-100]"},
+		{"异常倍数不大于 1", func(c *Config) { c.Summary.Anomaly.Ratio = 1 }, "Anomaly.Ratio"},
+		{"异常基线天数为负数", func(c *Config) { c.Summary.Anomaly.BaselineDays = -1 }, "Anomaly"},
+		{"窗口结束早于开始", func(c *Config) {
+			c.Summary.Windows = []SummaryWindow{{Name: "w", Cron: "0 12 * * *", StartOffset: "12h", EndOffset: "0h"}}
+		}, "EndOffset"},
+		{"窗口在区间结束后触发", func(c *Config) {
+			c.Summary.Windows = []SummaryWindow{
+				{Name: "morning", Cron: "0 12 * * *", StartOffset: "0h", EndOffset: "12h"},
+				{Name: "yesterday", Cron: "0 1 * * 1-5", StartOffset: "-24h", EndOffset: "0h"},
+			}
+		}, ""},
+		{"窗口在区间结束前触发", func(c *Config) {
+			c.Summary.Windows = []SummaryWindow{{Name: "w", Cron: "0 11,18 * * *", StartOffset: "12h", EndOffset: "18h"}}
+		}, "Windows[w] 的 Cron 在 11:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			tt.modify(&c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHTTPServer_Loopback(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"127.0.0.1:8080": true,
+		"localhost:8080": true,
+		"[::1]:8080":     true,
+		":8080":          false,
+		"0.0.0.0:8080":   false,
+		"10.0.0.5:8080":  false,
+		"127.0.0.1":      false,
+	} {
+		assert.Equal(t, want, (&HTTPServer{Addr: addr}).Loopback(), addr)
+	}
+}
+
+func TestValidate_Defaults(t *testing.T) {
+	c := validConfig()
+	c.Summary.RangeDays = 0
+	c.Summary.Engine = ""
+	require.NoError(t, c.Validate())
+
+	assert.Equal(t, Retry{
+		LLM:      RetryPolicy{Times: 3, Interval: 60},
+		Telegram: RetryPolicy{Times: 2, Interval: 30},
+		DB:       RetryPolicy{Times: 3, Interval: 60},
+	}, c.Summary.Retry)
+	assert.Equal(t, 1, c.Summary.RangeDays)
+	assert.Equal(t, "llm", c.Summary.Engine)
+	assert.Equal(t, []string{"extractive"}, c.Summary.FallbackEngines)
+	assert.Equal(t, []string{"text"}, c.Ingest.ContentTypes)
+	assert.Equal(t, 1000, *c.Ingest.QueueSize)
+	assert.Equal(t, "30 * * * *", c.Summary.PurgeCron)
+	assert.Equal(t, 1000, c.Summary.CleanupBatchSize)
+	assert.Equal(t, 1200, c.Summary.TaskTimeout)
+	assert.Equal(t, 300, c.LLM.RequestTimeout)
+	assert.Equal(t, 0, c.LLM.SummarizeTimeout, "默认不限制总结总时长")
+	assert.Equal(t, 3, c.Alert.ChatFailureThreshold)
+	assert.Equal(t, 10, *c.Alert.DisconnectThreshold)
+	assert.Equal(t, "minute", c.LLM.PromptTimestamps)
+	assert.Zero(t, c.Summary.Anomaly.Ratio, "未启用异常检测时不填充默认值")
+
+	c = validConfig()
+	c.Summary.Anomaly.Enable = true
+	require.NoError(t, c.Validate())
+	assert.Equal(t, Anomaly{Enable: true, BaselineDays: 7, MinSamples: 3, Ratio: 3}, c.Summary.Anomaly)
+
+	c = validConfig()
+	c.MetadataRefresh.Cron = "0 4 * * *"
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 24, c.MetadataRefresh.MaxAgeHours)
+
+	c = validConfig()
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 800, *c.Summary.CompressMaxRunes)
+
+	c = validConfig()
+	noCompress := 0
+	c.Summary.CompressMaxRunes = &noCompress
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 0, *c.Summary.CompressMaxRunes, "显式配置的 0 不被默认值覆盖")
+
+	c = validConfig()
+	noAlert := 0
+	c.Alert.DisconnectThreshold = &noAlert
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 0, *c.Alert.DisconnectThreshold, "显式配置的 0 表示不告警")
+
+	c = validConfig()
+	c.UserRefresh = MetadataRefresh{Cron: "0 4 * * *", MaxAgeHours: 12}
+	require.NoError(t, c.Validate())
+	assert.Equal(t, MetadataRefresh{Cron: "0 4 * * *", MaxAgeHours: 12}, c.MetadataRefresh, "兼容旧的 UserRefresh 配置")
+
+	c = validConfig()
+	c.UserRefresh.Cron = "0 3 * * *"
+	c.MetadataRefresh.Cron = "0 4 * * *"
+	require.NoError(t, c.Validate())
+	assert.Equal(t, "0 4 * * *", c.MetadataRefresh.Cron, "同时配置时以 MetadataRefresh 为准")
+
+	c = validConfig()
+	c.TLDR.Enable = true
+	require.NoError(t, c.Validate())
+	assert.Equal(t, TLDR{Enable: true, MaxMessages: 200, CooldownSeconds: 60}, c.TLDR)
+
+	c = validConfig()
+	c.OnDemand.Enable = true
+	require.NoError(t, c.Validate())
+	assert.Equal(t, OnDemand{Enable: true, MaxDays: 7, CooldownSeconds: 300}, c.OnDemand)
+
+	c = validConfig()
+	c.Onboarding.Enable = true
+	require.NoError(t, c.Validate())
+	assert.Contains(t, c.Onboarding.Text, "/optout")
+
+	c = validConfig()
+	c.LLM.Shadow.Model = "deepseek-chat"
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 0.1, c.LLM.Shadow.SampleRate)
+
+	c = validConfig()
+	c.LLM.Capture.Dir = "data/llm_capture"
+	require.NoError(t, c.Validate())
+	assert.Equal(t, LLMCapture{Dir: "data/llm_capture", MaxBytes: 65536, MaxFiles: 200}, c.LLM.Capture)
+
+	c = validConfig()
+	c.Summary.RetryTimes = 5
+	c.Summary.Retry.DB.Times = 10
+	c.Summary.FallbackEngines = []string{}
+	c.Alert.ChatFailureThreshold = 7
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 5, c.Summary.Retry.LLM.Times, "未配置的重试策略沿用 RetryTimes")
+	assert.Equal(t, 10, c.Summary.Retry.DB.Times, "已配置的值不被覆盖")
+	assert.Empty(t, c.Summary.FallbackEngines, "显式配置空列表表示不降级")
+	assert.Equal(t, 7, c.Alert.ChatFailureThreshold)
+}
+
+func TestSummary_AllowsChat(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []int64
+		exclude []int64
+		chatID  int64
+		want    bool
+	}{
+		{"未配置时不限制", nil, nil, -100, true},
+		{"在允许列表中", []int64{-100}, nil, -100, true},
+		{"不在允许列表中", []int64{-100}, nil, -200, false},
+		{"在排除列表中", nil, []int64{-100}, -100, false},
+		{"排除列表优先", []int64{-100}, []int64{-100}, -100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summary{IncludeChatIds: tt.include, ExcludeChatIds: tt.exclude}
+			assert.Equal(t, tt.want, s.AllowsChat(tt.chatID))
+		})
+	}
+}
+
+func TestValidate_Cron(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{"标准表达式", "0 23 * * *", ""},
+		{"描述符", "@daily", ""},
+		{"段数错误", "0 0 * *", "不是有效的 cron 表达式"},
+		{"超出范围", "0 25 * * *", "不是有效的 cron 表达式"},
+		{"永远不会触发", "0 0 30 2 *", "永远不会触发"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validConfig()
+			c.Summary.Cron = tt.spec
+			err := c.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Contains(t, err.Error(), "分 时 日 月 周", "错误信息应包含格式提示")
+			}
+		})
+	}
+}
+
+func TestCronNextRuns(t *testing.T) {
+	from := time.Date(2025, 2, 10, 22, 0, 0, 0, time.UTC)
+	runs, err := CronNextRuns("0 23 * * *", from, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 2, 10, 23, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 11, 23, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 12, 23, 0, 0, 0, time.UTC),
+	}, runs)
+}
+
+func TestShadowLLM(t *testing.T) {
+	l := &LLM{
+		BaseURL: "https://api.openai.com/v1", APIKey: "key", Model: "gpt-4o", MaxTokens: 128000,
+		Capture: LLMCapture{Dir: "data/llm_capture"},
+		Shadow:  LLMShadow{Model: "deepseek-chat", BaseURL: "https://api.deepseek.com/v1", SampleRate: 0.5},
+	}
+	shadow := l.ShadowLLM()
+	assert.Equal(t, "deepseek-chat", shadow.Model)
+	assert.Equal(t, "https://api.deepseek.com/v1", shadow.BaseURL)
+	assert.Equal(t, "key", shadow.APIKey, "未配置的密钥与主模型相同")
+	assert.Equal(t, 128000, shadow.MaxTokens)
+	assert.Empty(t, shadow.Capture.Dir, "对比模型不保存请求记录")
+	assert.Equal(t, "gpt-4o", l.Model, "不修改主模型配置")
+}
+
+func TestValidate_TelegramAccounts(t *testing.T) {
+	c := validConfig()
+	c.TelegramApp.Accounts = []TelegramAccount{{DataDir: "data/account2"}, {DataDir: "data/account3", ApiId: 2, ApiHash: "hash2"}}
+	require.NoError(t, c.Validate())
+
+	apiId, apiHash := c.TelegramApp.Credentials(c.TelegramApp.Accounts[0])
+	assert.Equal(t, int32(1), apiId, "未配置时与主账号相同")
+	assert.Equal(t, "hash", apiHash)
+	apiId, apiHash = c.TelegramApp.Credentials(c.TelegramApp.Accounts[1])
+	assert.Equal(t, int32(2), apiId)
+	assert.Equal(t, "hash2", apiHash)
+
+	for name, accounts := range map[string][]TelegramAccount{
+		"数据目录为空":    {{DataDir: ""}},
+		"与主账号目录相同":  {{DataDir: "./data/"}},
+		"与其他账号目录相同": {{DataDir: "data/account2"}, {DataDir: "data/account2/"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := validConfig()
+			c.TelegramApp.Accounts = accounts
+			assert.Error(t, c.Validate())
+		})
+	}
+}
+
+func TestValidate_TelegramLogin(t *testing.T) {
+	c := validConfig()
+	c.TelegramApp.Login.PhoneNumber = "+8613800000000"
+	c.TelegramApp.Accounts = []TelegramAccount{
+		{DataDir: "data/account2", Login: TelegramLogin{PhoneNumber: "+8613900000000"}},
+		{DataDir: "data/account3"},
+		{DataDir: "data/account4", Login: TelegramLogin{QRCode: true}},
+	}
+	require.NoError(t, c.Validate())
+	assert.Equal(t, 300, c.TelegramApp.Login.CodeTimeout)
+	assert.Equal(t, 300, c.TelegramApp.Accounts[0].Login.CodeTimeout)
+	assert.Zero(t, c.TelegramApp.Accounts[1].Login.CodeTimeout, "未配置手机号时不使用非交互式登录")
+	assert.Equal(t, 300, c.TelegramApp.Accounts[2].Login.CodeTimeout, "二维码登录")
+
+	c = validConfig()
+	c.TelegramApp.Login.CodeTimeout = -1
+	assert.Error(t, c.Validate())
+	c = validConfig()
+	c.TelegramApp.Accounts = []TelegramAccount{{DataDir: "data/account2", Login: TelegramLogin{CodeTimeout: -1}}}
+	assert.Error(t, c.Validate())
+	c = validConfig()
+	c.TelegramApp.Login = TelegramLogin{PhoneNumber: "+8613800000000", QRCode: true}
+	assert.Error(t, c.Validate(), "手机号与二维码登录只能二选一")
+}
+
+func TestValidate_IngestRateLimit(t *testing.T) {
+	c := validConfig()
+	c.Ingest.RateLimit.MaxPerHour = 500
+	require.NoError(t, c.Validate())
+	assert.Equal(t, "sample", c.Ingest.RateLimit.Mode)
+
+	c = validConfig()
+	c.Ingest.RateLimit = IngestRateLimit{MaxPerHour: 500, Mode: "last"}
+	assert.Error(t, c.Validate())
+	c = validConfig()
+	c.Ingest.RateLimit.MaxPerHour = -1
+	assert.Error(t, c.Validate())
+}
+
+func TestLoadFromFile_Profile(t *testing.T) {
+	const base = `
+TelegramApp: {ApiId: 1, ApiHash: hash}
+LLM: {BaseURL: "https://api.openai.com/v1", APIKey: key, Model: gpt-4o, MaxTokens: 128000}
+`
+	load := func(t *testing.T, content string) (*Config, error) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(base+content), 0o600))
+		return LoadFromFile(path)
+	}
+
+	c, err := load(t, `
+Profile: large-community
+Summary:
+  Cron: "0 0 * * *"
+  RetentionDays: 5
+  MinMessageRunes: 0
+  Retry:
+    LLM: {Times: 2}
+`)
+	require.NoError(t, err)
+	assert.Equal(t, "group", c.Summary.NotifyMode, "未配置的字段使用预设值")
+	assert.Equal(t, 3600, c.Summary.TaskTimeout)
+	assert.Equal(t, 0.6, c.LLM.TopicMergeThreshold)
+	assert.Equal(t, 5, c.Summary.RetentionDays, "配置的字段覆盖预设值")
+	assert.Equal(t, 0, c.Summary.MinMessageRunes, "显式配置为 0 同样覆盖预设值")
+	assert.Equal(t, RetryPolicy{Times: 2, Interval: 120}, c.Summary.Retry.LLM, "按字段覆盖")
+	assert.Equal(t, 1, c.Summary.RangeDays, "预设未包含的字段使用默认值")
+
+	_, err = load(t, `
+Profile: huge
+Summary: {Cron: "0 0 * * *", NotifyMode: group}
+`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Profile")
+}
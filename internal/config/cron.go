@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/robfig/cron/v3"
+)
+
+// cronHint cron 表达式格式提示，与调度器使用的标准解析器一致（5 段，按 UTC 执行）
+const cronHint = `格式为 "分 时 日 月 周"（UTC），如 "0 0 * * *" 表示每天 0:00，"30 12 * * 1-5" 表示工作日 12:30；也支持 "@daily"、"@every 1h" 等描述符`
+
+// CronNextRuns 解析 cron 表达式并返回 from 之后的 n 次执行时间（UTC）
+func CronNextRuns(spec string, from time.Time, n int) ([]time.Time, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]time.Time, 0, n)
+	next := from.UTC()
+	for range n {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next)
+	}
+	return runs, nil
+}
+
+// validateCron 校验 cron 表达式，解析失败时返回带格式提示的错误；成功时记录接下来三次执行时间，便于确认是否符合预期
+func validateCron(name, spec string) error {
+	runs, err := CronNextRuns(spec, time.Now(), 3)
+	if err != nil {
+		return fmt.Errorf("%s 不是有效的 cron 表达式 %q: %v。%s", name, spec, err, cronHint)
+	}
+	if len(runs) == 0 {
+		return fmt.Errorf("%s 的 cron 表达式 %q 永远不会触发（如 2 月 30 日）。%s", name, spec, cronHint)
+	}
+
+	labels := make([]string, len(runs))
+	for i, t := range runs {
+		labels[i] = t.Format("2006-01-02 15:04 MST")
+	}
+	logger.Infof("[Config] %s = %q，接下来执行时间: %s", name, spec, strings.Join(labels, ", "))
+	return nil
+}
+
+// cronFiresBefore 返回 cron 表达式早于当日 offset（相对当日 0 点，UTC）的一次执行时间，不存在时返回 false。
+// 检查 from 所在日起一周内的执行时间，覆盖只在部分星期执行的表达式；一周内不执行时检查第一次执行
+func cronFiresBefore(spec string, from time.Time, offset time.Duration) (time.Time, bool) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	from = from.UTC()
+	dayStart := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	limit := dayStart.AddDate(0, 0, 7)
+	for next := schedule.Next(dayStart.Add(-time.Second)); !next.IsZero(); next = schedule.Next(next) {
+		day := time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, time.UTC)
+		if next.Sub(day) < offset {
+			return next, true
+		}
+		if next.After(limit) {
+			break
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Profiles 可选的配置预设
+var Profiles = []string{"small-group", "large-community", "compliance"}
+
+// applyProfile 按预设预填保留天数、分块、重试和通知等配置，需在解析配置文件之前调用：
+// 配置文件中出现的字段（包括显式配置为 0 或空的字段）覆盖预设值，其余未配置项再由 applyDefaults 填充
+func applyProfile(c *Config, name string) error {
+	switch name {
+	case "small-group":
+		// 消息量小：无需分块调优，保留一周消息便于补跑
+		c.Summary.RetentionDays = 7
+		c.Summary.NotifyMode = "group"
+		c.Summary.TaskTimeout = 600
+		c.Summary.Retry = Retry{
+			LLM:      RetryPolicy{Times: 3, Interval: 60},
+			Telegram: RetryPolicy{Times: 2, Interval: 30},
+			DB:       RetryPolicy{Times: 3, Interval: 60},
+		}
+	case "large-community":
+		// 消息量大：过滤短消息减少分块，合并各分块的相似话题，放宽超时和重试
+		c.Summary.RetentionDays = 3
+		c.Summary.NotifyMode = "group"
+		c.Summary.MinMessageRunes = 3
+		c.Summary.OrderByMessages = true
+		c.Summary.TaskTimeout = 3600
+		c.Summary.Retry = Retry{
+			LLM:      RetryPolicy{Times: 5, Interval: 120},
+			Telegram: RetryPolicy{Times: 3, Interval: 60},
+			DB:       RetryPolicy{Times: 3, Interval: 60},
+		}
+		c.LLM.TopicMergeThreshold = 0.6
+		c.LLM.SummarizeTimeout = 2400
+	case "compliance":
+		// 尽量少地保存和外发消息：只保留总结所需的一天，降低提交给 LLM 的时间精度，不引用原文，运行结果报告给管理员
+		c.Summary.RetentionDays = 1
+		c.Summary.QuoteMaxRunes = 0
+		c.Summary.AdminReport = true
+		c.Summary.TaskTimeout = 1200
+		c.Summary.Retry = Retry{
+			LLM:      RetryPolicy{Times: 2, Interval: 60},
+			Telegram: RetryPolicy{Times: 2, Interval: 30},
+			DB:       RetryPolicy{Times: 3, Interval: 60},
+		}
+		c.LLM.PromptTimestamps = "hour"
+	default:
+		return fmt.Errorf("未知的配置预设 Profile %q，可选值: %s", name, strings.Join(Profiles, "、"))
+	}
+	c.Profile = name
+	return nil
+}
@@ -0,0 +1,198 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open 打开 path 处的 SQLite 数据库（WAL 模式）。获取锁时最多等待 5 秒再返回 database is locked，
+// 减少消息写入与总结读取并发时的失败
+func Open(path string) (*sql.DB, error) {
+	return sql.Open("sqlite3", "file:"+path+"?mode=rwc&_journal_mode=WAL&_fk=1&_busy_timeout=5000")
+}
+
+// Run 执行 migrate 子命令，path 为数据库文件路径，结果输出到 out：
+//   - status（默认）：查看当前版本和待执行的迁移
+//   - up [n]：备份数据库后执行待执行的迁移，n 为 0 或省略时全部执行
+//   - restore <备份文件>：先备份当前数据库，再用备份文件替换数据库，需先停止服务
+//
+// 迁移文件只有正向迁移，回滚即用 up 执行前生成的备份 restore
+func Run(ctx context.Context, path string, args []string, out io.Writer) error {
+	cmd := "status"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+	switch cmd {
+	case "status":
+		db, err := Open(path)
+		if err != nil {
+			return fmt.Errorf("打开数据库失败: %w", err)
+		}
+		defer db.Close()
+		return printStatus(ctx, db, out)
+	case "up":
+		n := 0
+		if len(args) > 1 {
+			var err error
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 0 {
+				return fmt.Errorf("迁移数量无效: %s", args[1])
+			}
+		}
+		return runUp(ctx, path, n, out)
+	case "restore":
+		if len(args) < 2 {
+			return errors.New("用法: migrate restore <备份文件>")
+		}
+		return restore(ctx, path, args[1], out)
+	default:
+		return fmt.Errorf("未知的子命令 %q，可用: status、up [n]、restore <备份文件>", cmd)
+	}
+}
+
+// printStatus 输出当前版本和待执行的迁移
+func printStatus(ctx context.Context, db *sql.DB, out io.Writer) error {
+	status, err := GetStatus(ctx, db)
+	if err != nil {
+		return fmt.Errorf("查询迁移状态失败: %w", err)
+	}
+	switch {
+	case status.Legacy:
+		fmt.Fprintln(out, "数据库尚未纳入版本化迁移，执行 migrate up 将其迁移到当前版本并标记基线")
+	case len(status.Pending) == 0:
+		fmt.Fprintf(out, "当前版本: %s，无待执行的迁移\n", status.Current)
+	default:
+		fmt.Fprintf(out, "当前版本: %s，待执行的迁移 %d 个:\n", status.Current, len(status.Pending))
+		for _, name := range status.Pending {
+			fmt.Fprintln(out, "  "+name)
+		}
+	}
+	return nil
+}
+
+// runUp 备份数据库后执行 n 个待执行的迁移，没有待执行的迁移时不备份
+func runUp(ctx context.Context, path string, n int, out io.Writer) error {
+	db, err := Open(path)
+	if err != nil {
+		return fmt.Errorf("打开数据库失败: %w", err)
+	}
+	defer db.Close()
+
+	status, err := GetStatus(ctx, db)
+	if err != nil {
+		return fmt.Errorf("查询迁移状态失败: %w", err)
+	}
+	if !status.Legacy && len(status.Pending) == 0 {
+		fmt.Fprintf(out, "当前版本: %s，无待执行的迁移\n", status.Current)
+		return nil
+	}
+
+	backup, err := backupBeside(ctx, db, path)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "数据库已备份到 %s\n", backup)
+
+	applied, err := Up(ctx, db, n)
+	if err != nil {
+		return fmt.Errorf("执行迁移失败: %w；可停止服务后执行 migrate restore %s 回滚", err, backup)
+	}
+	fmt.Fprintf(out, "已执行 %d 个迁移，如需回滚可停止服务后执行 migrate restore %s\n", applied, backup)
+	return nil
+}
+
+// restore 校验备份文件后，先备份当前数据库，再用备份文件替换数据库文件并删除 WAL 文件
+func restore(ctx context.Context, path, backup string, out io.Writer) error {
+	version, err := backupVersion(ctx, backup)
+	if err != nil {
+		return fmt.Errorf("备份文件 %s 无效: %w", backup, err)
+	}
+
+	db, err := Open(path)
+	if err != nil {
+		return fmt.Errorf("打开数据库失败: %w", err)
+	}
+	current, err := backupBeside(ctx, db, path)
+	db.Close()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "当前数据库已备份到 %s\n", current)
+
+	data, err := os.ReadFile(backup)
+	if err != nil {
+		return fmt.Errorf("读取备份文件失败: %w", err)
+	}
+	tmp := path + ".restore"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("写入数据库文件失败: %w", err)
+	}
+	// 备份由 VACUUM INTO 生成，不含 WAL；旧的 WAL 文件留在原处会被应用到恢复后的数据库
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("删除 %s 失败: %w", path+suffix, err)
+		}
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("替换数据库文件失败: %w", err)
+	}
+	if version == "" {
+		version = "无迁移记录"
+	}
+	fmt.Fprintf(out, "已用 %s 恢复数据库，版本: %s；请运行与该版本对应的程序\n", backup, version)
+	return nil
+}
+
+// backupBeside 将数据库备份到 <path>.<时间>.bak（同一秒内已有备份时追加序号），返回备份文件路径
+func backupBeside(ctx context.Context, db *sql.DB, path string) (string, error) {
+	prefix := fmt.Sprintf("%s.%s", path, time.Now().Format("20060102150405"))
+	backup := prefix + ".bak"
+	for i := 1; ; i++ {
+		if _, err := os.Stat(backup); os.IsNotExist(err) {
+			break
+		}
+		backup = fmt.Sprintf("%s-%d.bak", prefix, i)
+	}
+	if err := Backup(ctx, db, backup); err != nil {
+		return "", fmt.Errorf("备份数据库失败: %w", err)
+	}
+	return backup, nil
+}
+
+// backupVersion 以只读方式检查备份文件的完整性，返回其最近执行的迁移版本
+func backupVersion(ctx context.Context, backup string) (string, error) {
+	if _, err := os.Stat(backup); err != nil {
+		return "", err
+	}
+	db, err := sql.Open("sqlite3", "file:"+backup+"?mode=ro")
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&result); err != nil {
+		return "", err
+	}
+	if result != "ok" {
+		return "", fmt.Errorf("完整性检查失败: %s", result)
+	}
+	var tables int
+	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", revisionTable).Scan(&tables)
+	if err != nil || tables == 0 {
+		return "", err
+	}
+	var version string
+	err = db.QueryRowContext(ctx, "SELECT `version` FROM `"+revisionTable+"` ORDER BY `version` DESC LIMIT 1").Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	return version, nil
+}
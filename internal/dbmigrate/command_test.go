@@ -0,0 +1,76 @@
+package dbmigrate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sqlite.db")
+	run := func(args ...string) (string, error) {
+		var out bytes.Buffer
+		err := Run(ctx, path, args, &out)
+		return out.String(), err
+	}
+
+	out, err := run()
+	require.NoError(t, err)
+	assert.Contains(t, out, "待执行的迁移", "默认查看状态")
+
+	out, err = run("up", "1")
+	require.NoError(t, err)
+	backup := regexp.MustCompile(`数据库已备份到 (\S+)`).FindStringSubmatch(out)
+	require.Len(t, backup, 2)
+	assert.FileExists(t, backup[1])
+	assert.Contains(t, out, "已执行 1 个迁移")
+
+	out, err = run("up")
+	require.NoError(t, err)
+	assert.Contains(t, out, "已执行")
+	out, err = run("status")
+	require.NoError(t, err)
+	assert.Equal(t, "当前版本: "+latestVersion(t)+"，无待执行的迁移\n", out)
+
+	t.Run("用备份回滚", func(t *testing.T) {
+		out, err := run("restore", backup[1])
+		require.NoError(t, err)
+		assert.Contains(t, out, "当前数据库已备份到")
+		assert.Contains(t, out, "版本: 无迁移记录")
+		_, err = os.Stat(path + "-wal")
+		assert.True(t, os.IsNotExist(err), "删除旧的 WAL 文件")
+
+		db, err := Open(path)
+		require.NoError(t, err)
+		defer db.Close()
+		status, err := GetStatus(ctx, db)
+		require.NoError(t, err)
+		assert.Empty(t, status.Current, "恢复到执行迁移前的状态")
+		assert.NotEmpty(t, status.Pending)
+	})
+
+	t.Run("参数错误", func(t *testing.T) {
+		_, err := run("up", "-1")
+		assert.ErrorContains(t, err, "迁移数量无效")
+		_, err = run("restore")
+		assert.ErrorContains(t, err, "用法")
+		_, err = run("restore", filepath.Join(t.TempDir(), "missing.bak"))
+		assert.ErrorContains(t, err, "无效")
+		_, err = run("down")
+		assert.ErrorContains(t, err, "未知的子命令")
+	})
+
+	t.Run("无效的备份文件", func(t *testing.T) {
+		bad := filepath.Join(t.TempDir(), "bad.bak")
+		require.NoError(t, os.WriteFile(bad, []byte("not a database"), 0o644))
+		_, err := run("restore", bad)
+		assert.ErrorContains(t, err, "无效")
+	})
+}
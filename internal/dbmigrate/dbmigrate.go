@@ -0,0 +1,182 @@
+// Package dbmigrate 管理数据库的版本化迁移：迁移文件由 gen.go 根据 ent schema 生成并嵌入程序，
+// 通过 migrate 子命令显式执行，执行记录保存在 atlas_schema_revisions 表
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqlite"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+// legacyTable 判断旧数据库的表：引入版本化迁移前由 Schema.Create 创建的数据库包含该表
+const legacyTable = "messages"
+
+// Status 数据库的迁移状态
+type Status struct {
+	Current string   // 最近执行的迁移版本，为空表示尚未执行
+	Pending []string // 待执行的迁移文件
+	Legacy  bool     // 引入版本化迁移前创建的数据库，执行 Up 时先自动标记基线
+}
+
+// Dir 返回嵌入的迁移目录
+func Dir() (migrate.Dir, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	dir := &migrate.MemDir{}
+	for _, entry := range entries {
+		data, err := migrationsFS.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+	// 迁移文件被修改时校验和不一致，拒绝执行
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("迁移目录校验失败: %w", err)
+	}
+	return dir, nil
+}
+
+// GetStatus 查询数据库的迁移状态
+func GetStatus(ctx context.Context, db *sql.DB) (*Status, error) {
+	executor, rrw, err := newExecutor(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{}
+	if len(revisions) == 0 {
+		status.Legacy, err = isLegacy(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		status.Current = revisions[len(revisions)-1].Version
+	}
+	if status.Legacy {
+		return status, nil
+	}
+
+	pending, err := executor.Pending(ctx)
+	if err != nil && !errors.Is(err, migrate.ErrNoPendingFiles) {
+		return nil, err
+	}
+	for _, f := range pending {
+		status.Pending = append(status.Pending, f.Name())
+	}
+	return status, nil
+}
+
+// Up 执行待执行的迁移，n 为 0 时执行全部，返回执行的文件数。
+// 旧数据库先按当前 schema 自动迁移，再将最新的迁移文件标记为基线
+func Up(ctx context.Context, db *sql.DB, n int) (int, error) {
+	status, err := GetStatus(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	if status.Legacy {
+		return 0, baseline(ctx, db)
+	}
+	if len(status.Pending) == 0 {
+		return 0, nil
+	}
+
+	executor, _, err := newExecutor(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 || n > len(status.Pending) {
+		n = len(status.Pending)
+	}
+	if err := executor.ExecuteN(ctx, n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Backup 将数据库备份到 path（VACUUM INTO，WAL 模式下也能得到一致的副本），用于迁移失败时回滚
+func Backup(ctx context.Context, db *sql.DB, path string) error {
+	_, err := db.ExecContext(ctx, "VACUUM INTO ?", path)
+	return err
+}
+
+// OpenClient 基于已打开的数据库连接创建 ent 客户端
+func OpenClient(db *sql.DB) *ent.Client {
+	return ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+}
+
+func newExecutor(ctx context.Context, db *sql.DB, opts ...migrate.ExecutorOption) (*migrate.Executor, *revisionStore, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, nil, err
+	}
+	drv, err := sqlite.Open(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	rrw, err := newRevisionStore(ctx, db)
+	if err != nil {
+		return nil, nil, err
+	}
+	executor, err := migrate.NewExecutor(drv, dir, rrw, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return executor, rrw, nil
+}
+
+// isLegacy 判断是否为引入版本化迁移前创建的数据库（有业务表但没有迁移记录）
+func isLegacy(ctx context.Context, db *sql.DB) (bool, error) {
+	var n int
+	err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", legacyTable).Scan(&n)
+	return n > 0, err
+}
+
+// baseline 旧数据库由 Schema.Create 管理，可能落后于当前 schema：先自动迁移到当前 schema，再将最新的迁移文件标记为已执行
+func baseline(ctx context.Context, db *sql.DB) error {
+	if err := OpenClient(db).Schema.Create(ctx); err != nil {
+		return fmt.Errorf("迁移旧数据库失败: %w", err)
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	latest := files[len(files)-1].Version()
+
+	executor, _, err := newExecutor(ctx, db, migrate.WithBaselineVersion(latest))
+	if err != nil {
+		return err
+	}
+	if _, err := executor.Pending(ctx); err != nil && !errors.Is(err, migrate.ErrNoPendingFiles) {
+		return err
+	}
+	logger.Infof("[Migrate] 旧数据库已标记基线版本 %s", latest)
+	return nil
+}
@@ -0,0 +1,110 @@
+package dbmigrate
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", "file:"+filepath.Join(t.TempDir(), "sqlite.db")+"?mode=rwc&_fk=1")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func latestVersion(t *testing.T) string {
+	dir, err := Dir()
+	require.NoError(t, err)
+	files, err := dir.Files()
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	return files[len(files)-1].Version()
+}
+
+func TestUp(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	status, err := GetStatus(ctx, db)
+	require.NoError(t, err)
+	assert.False(t, status.Legacy)
+	assert.Empty(t, status.Current)
+	assert.NotEmpty(t, status.Pending)
+
+	n, err := Up(ctx, db, 0)
+	require.NoError(t, err)
+	assert.Equal(t, len(status.Pending), n)
+
+	status, err = GetStatus(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, latestVersion(t), status.Current)
+	assert.Empty(t, status.Pending)
+
+	n, err = Up(ctx, db, 0)
+	require.NoError(t, err)
+	assert.Zero(t, n, "没有待执行的迁移")
+
+	client := OpenClient(db)
+	_, err = client.Chat.Create().SetChatID(-100).SetTitle("技术交流群").SetCreateTime(time.Now()).Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestMigrationsMatchSchema(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	_, err := Up(ctx, db, 0)
+	require.NoError(t, err)
+
+	// 执行全部迁移后，数据库应与当前 ent schema 一致；不一致说明修改 schema 后未生成迁移文件
+	var buf bytes.Buffer
+	require.NoError(t, OpenClient(db).Schema.WriteTo(ctx, &buf))
+	assert.NotContains(t, buf.String(), "CREATE", "迁移文件与 ent schema 不一致，请运行 go run -mod=mod ./internal/dbmigrate/gen.go <迁移名称>")
+	assert.NotContains(t, buf.String(), "ALTER", "迁移文件与 ent schema 不一致，请运行 go run -mod=mod ./internal/dbmigrate/gen.go <迁移名称>")
+}
+
+func TestUp_Legacy(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+
+	// 引入版本化迁移前由 Schema.Create 创建的数据库
+	require.NoError(t, OpenClient(db).Schema.Create(ctx))
+
+	status, err := GetStatus(ctx, db)
+	require.NoError(t, err)
+	assert.True(t, status.Legacy)
+
+	n, err := Up(ctx, db, 0)
+	require.NoError(t, err)
+	assert.Zero(t, n, "旧数据库只标记基线，不执行迁移文件")
+
+	status, err = GetStatus(ctx, db)
+	require.NoError(t, err)
+	assert.False(t, status.Legacy)
+	assert.Equal(t, latestVersion(t), status.Current)
+	assert.Empty(t, status.Pending)
+}
+
+func TestBackup(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDB(t)
+	_, err := Up(ctx, db, 0)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, Backup(ctx, db, path))
+
+	backup, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	require.NoError(t, err)
+	defer backup.Close()
+	status, err := GetStatus(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, latestVersion(t), status.Current)
+}
@@ -0,0 +1,40 @@
+//go:build ignore
+
+// 根据 ent schema 与已有迁移文件的差异生成新的迁移文件，修改 internal/ent/schema 并执行 go generate 后运行：
+//
+//	go run -mod=mod ./internal/dbmigrate/gen.go <迁移名称>
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/migrate"
+
+	atlas "ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalln("用法: go run -mod=mod ./internal/dbmigrate/gen.go <迁移名称>")
+	}
+
+	dir, err := atlas.NewLocalDir("internal/dbmigrate/migrations")
+	if err != nil {
+		log.Fatalf("打开迁移目录失败: %v", err)
+	}
+	opts := []schema.MigrateOption{
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.SQLite),
+		schema.WithFormatter(atlas.DefaultFormatter),
+	}
+	// 使用内存数据库回放已有迁移，与当前 schema 比较生成差异
+	if err := migrate.NamedDiff(context.Background(), "sqlite://file?mode=memory&_fk=1", os.Args[1], opts...); err != nil {
+		log.Fatalf("生成迁移文件失败: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+-- Create "chats" table
+CREATE TABLE `chats` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `title` text NOT NULL, `username` text NULL, `member_count` integer NULL);
+-- Create index "chats_chat_id_key" to table: "chats"
+CREATE UNIQUE INDEX `chats_chat_id_key` ON `chats` (`chat_id`);
+-- Create "daily_runs" table
+CREATE TABLE `daily_runs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `window` text NOT NULL DEFAULT ('daily'), `start_time` datetime NOT NULL, `end_time` datetime NOT NULL, `status` text NOT NULL DEFAULT ('in_progress'), `error_message` text NULL, `duration_ms` integer NOT NULL DEFAULT (0), `chats_processed` integer NOT NULL DEFAULT (0), `chats_failed` integer NOT NULL DEFAULT (0), `messages_summarized` integer NOT NULL DEFAULT (0), `prompt_tokens` integer NOT NULL DEFAULT (0), `completion_tokens` integer NOT NULL DEFAULT (0), `total_tokens` integer NOT NULL DEFAULT (0), `cost` real NOT NULL DEFAULT (0), `messages_cleaned` integer NOT NULL DEFAULT (0), `lock_owner` text NULL, `lock_expires_at` datetime NULL);
+-- Create index "dailyrun_start_time_end_time" to table: "daily_runs"
+CREATE UNIQUE INDEX `dailyrun_start_time_end_time` ON `daily_runs` (`start_time`, `end_time`);
+-- Create index "dailyrun_status" to table: "daily_runs"
+CREATE INDEX `dailyrun_status` ON `daily_runs` (`status`);
+-- Create "follows" table
+CREATE TABLE `follows` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `user_id` integer NOT NULL, `keyword` text NOT NULL);
+-- Create index "follow_user_id_keyword" to table: "follows"
+CREATE UNIQUE INDEX `follow_user_id_keyword` ON `follows` (`user_id`, `keyword`);
+-- Create "messages" table
+CREATE TABLE `messages` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `message_id` integer NOT NULL, `server_message_id` integer NULL, `chat_id` integer NOT NULL, `sender_id` integer NOT NULL, `sender_name` text NOT NULL, `sender_username` text NULL, `text` text NOT NULL, `sent_at` datetime NOT NULL, `lang` text NULL, `deleted_at` datetime NULL);
+-- Create index "message_deleted_at" to table: "messages"
+CREATE INDEX `message_deleted_at` ON `messages` (`deleted_at`);
+-- Create index "message_chat_id_message_id" to table: "messages"
+CREATE INDEX `message_chat_id_message_id` ON `messages` (`chat_id`, `message_id`);
+-- Create "sent_parts" table
+CREATE TABLE `sent_parts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `key` text NOT NULL, `message_id` integer NULL);
+-- Create index "sent_parts_key_key" to table: "sent_parts"
+CREATE UNIQUE INDEX `sent_parts_key_key` ON `sent_parts` (`key`);
+-- Create "summaries" table
+CREATE TABLE `summaries` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `sender_id` integer NOT NULL, `sender_name` text NOT NULL, `sender_username` text NULL, `sender_nickname` text NULL, `summary_date` datetime NOT NULL, `content` text NOT NULL);
+-- Create "summary_views" table
+CREATE TABLE `summary_views` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `task_id` integer NOT NULL, `chat_id` integer NOT NULL, `user_id` integer NOT NULL, `topic` integer NOT NULL DEFAULT (-1));
+-- Create index "summaryview_create_time" to table: "summary_views"
+CREATE INDEX `summaryview_create_time` ON `summary_views` (`create_time`);
+-- Create "tasks" table
+CREATE TABLE `tasks` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `start_time` datetime NOT NULL, `end_time` datetime NOT NULL, `status` text NOT NULL DEFAULT ('pending'), `completed_at` datetime NULL, `error_message` text NULL, `summary_content` text NULL, `message_count` integer NOT NULL DEFAULT (0), `participant_count` integer NOT NULL DEFAULT (0), `summary_json` text NULL);
+-- Create index "task_chat_id_start_time_end_time" to table: "tasks"
+CREATE UNIQUE INDEX `task_chat_id_start_time_end_time` ON `tasks` (`chat_id`, `start_time`, `end_time`);
+-- Create index "task_status" to table: "tasks"
+CREATE INDEX `task_status` ON `tasks` (`status`);
+-- Create "users" table
+CREATE TABLE `users` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `user_id` integer NOT NULL, `name` text NOT NULL, `username` text NULL);
+-- Create index "users_user_id_key" to table: "users"
+CREATE UNIQUE INDEX `users_user_id_key` ON `users` (`user_id`);
@@ -0,0 +1,4 @@
+-- Create "shadow_runs" table
+CREATE TABLE `shadow_runs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `start_time` datetime NOT NULL, `end_time` datetime NOT NULL, `primary_model` text NOT NULL, `shadow_model` text NOT NULL, `primary_content` text NOT NULL, `shadow_content` text NULL, `shadow_error` text NULL, `shadow_tokens` integer NOT NULL DEFAULT (0), `primary_topics` integer NOT NULL DEFAULT (0), `shadow_topics` integer NOT NULL DEFAULT (0), `matched_topics` integer NOT NULL DEFAULT (0), `message_overlap` real NOT NULL DEFAULT (0));
+-- Create index "shadowrun_create_time" to table: "shadow_runs"
+CREATE INDEX `shadowrun_create_time` ON `shadow_runs` (`create_time`);
@@ -0,0 +1,4 @@
+-- Create "summary_revisions" table
+CREATE TABLE `summary_revisions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `task_id` integer NOT NULL, `chat_id` integer NOT NULL, `content` text NOT NULL, `source` text NOT NULL DEFAULT ('generated'), `sent_at` datetime NULL);
+-- Create index "summaryrevision_task_id" to table: "summary_revisions"
+CREATE INDEX `summaryrevision_task_id` ON `summary_revisions` (`task_id`);
@@ -0,0 +1,14 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_chats" table
+CREATE TABLE `new_chats` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `title` text NOT NULL, `username` text NULL, `member_count` integer NULL, `consent` text NOT NULL DEFAULT ('unknown'), `consent_updated_at` datetime NULL);
+-- Copy rows from old table "chats" to new temporary table "new_chats"
+INSERT INTO `new_chats` (`id`, `create_time`, `update_time`, `chat_id`, `title`, `username`, `member_count`) SELECT `id`, `create_time`, `update_time`, `chat_id`, `title`, `username`, `member_count` FROM `chats`;
+-- Drop "chats" table after copying rows
+DROP TABLE `chats`;
+-- Rename temporary table "new_chats" to "chats"
+ALTER TABLE `new_chats` RENAME TO `chats`;
+-- Create index "chats_chat_id_key" to table: "chats"
+CREATE UNIQUE INDEX `chats_chat_id_key` ON `chats` (`chat_id`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
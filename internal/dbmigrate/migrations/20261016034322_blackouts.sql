@@ -0,0 +1,4 @@
+-- Create "blackouts" table
+CREATE TABLE `blackouts` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `start_time` datetime NOT NULL, `end_time` datetime NOT NULL, `operator_id` integer NOT NULL);
+-- Create index "blackout_end_time" to table: "blackouts"
+CREATE INDEX `blackout_end_time` ON `blackouts` (`end_time`);
@@ -0,0 +1,2 @@
+-- Add column "prompt_budget" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `prompt_budget` text NULL;
@@ -0,0 +1,2 @@
+-- Add column "text_zstd" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `text_zstd` blob NULL;
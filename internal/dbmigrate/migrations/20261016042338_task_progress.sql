@@ -0,0 +1,16 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_tasks" table
+CREATE TABLE `new_tasks` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `start_time` datetime NOT NULL, `end_time` datetime NOT NULL, `status` text NOT NULL DEFAULT ('pending'), `completed_at` datetime NULL, `error_message` text NULL, `summary_content` text NULL, `message_count` integer NOT NULL DEFAULT (0), `participant_count` integer NOT NULL DEFAULT (0), `summary_json` text NULL, `prompt_budget` text NULL, `progress_chunk` integer NOT NULL DEFAULT (0), `progress_chunks` integer NOT NULL DEFAULT (0), `progress_tokens` integer NOT NULL DEFAULT (0));
+-- Copy rows from old table "tasks" to new temporary table "new_tasks"
+INSERT INTO `new_tasks` (`id`, `create_time`, `update_time`, `chat_id`, `start_time`, `end_time`, `status`, `completed_at`, `error_message`, `summary_content`, `message_count`, `participant_count`, `summary_json`, `prompt_budget`) SELECT `id`, `create_time`, `update_time`, `chat_id`, `start_time`, `end_time`, `status`, `completed_at`, `error_message`, `summary_content`, `message_count`, `participant_count`, `summary_json`, `prompt_budget` FROM `tasks`;
+-- Drop "tasks" table after copying rows
+DROP TABLE `tasks`;
+-- Rename temporary table "new_tasks" to "tasks"
+ALTER TABLE `new_tasks` RENAME TO `tasks`;
+-- Create index "task_chat_id_start_time_end_time" to table: "tasks"
+CREATE UNIQUE INDEX `task_chat_id_start_time_end_time` ON `tasks` (`chat_id`, `start_time`, `end_time`);
+-- Create index "task_status" to table: "tasks"
+CREATE INDEX `task_status` ON `tasks` (`status`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- Create "run_logs" table
+CREATE TABLE `run_logs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `run_id` integer NOT NULL DEFAULT (0), `task_id` integer NOT NULL DEFAULT (0), `chat_id` integer NOT NULL DEFAULT (0), `event` text NOT NULL, `detail` text NULL);
+-- Create index "runlog_run_id" to table: "run_logs"
+CREATE INDEX `runlog_run_id` ON `run_logs` (`run_id`);
+-- Create index "runlog_task_id" to table: "run_logs"
+CREATE INDEX `runlog_task_id` ON `run_logs` (`task_id`);
@@ -0,0 +1,2 @@
+-- Add column "chat_type" to table: "chats"
+ALTER TABLE `chats` ADD COLUMN `chat_type` text NULL;
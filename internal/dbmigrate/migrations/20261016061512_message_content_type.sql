@@ -0,0 +1,2 @@
+-- Add column "content_type" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `content_type` text NULL;
@@ -0,0 +1,2 @@
+-- Add column "edited_at" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `edited_at` datetime NULL;
@@ -0,0 +1,2 @@
+-- Add column "message_thread_id" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `message_thread_id` integer NULL;
@@ -0,0 +1,2 @@
+-- Add column "reply_to_message_id" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `reply_to_message_id` integer NULL;
@@ -0,0 +1,6 @@
+-- Add column "kind" to table: "tasks"
+ALTER TABLE `tasks` ADD COLUMN `kind` text NOT NULL DEFAULT ('scheduled');
+-- Drop index "task_chat_id_start_time_end_time" from table: "tasks"
+DROP INDEX `task_chat_id_start_time_end_time`;
+-- Create index "task_chat_id_start_time_end_time_kind" to table: "tasks"
+CREATE UNIQUE INDEX `task_chat_id_start_time_end_time_kind` ON `tasks` (`chat_id`, `start_time`, `end_time`, `kind`);
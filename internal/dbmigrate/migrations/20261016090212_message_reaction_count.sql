@@ -0,0 +1,2 @@
+-- Add column "reaction_count" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `reaction_count` integer NULL;
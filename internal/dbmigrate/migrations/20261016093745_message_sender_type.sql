@@ -0,0 +1,2 @@
+-- Add column "sender_type" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `sender_type` text NULL;
@@ -0,0 +1,2 @@
+-- Add column "poll" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `poll` text NULL;
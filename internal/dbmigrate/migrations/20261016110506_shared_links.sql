@@ -0,0 +1,6 @@
+-- Create "shared_links" table
+CREATE TABLE `shared_links` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `create_time` datetime NOT NULL, `update_time` datetime NOT NULL, `chat_id` integer NOT NULL, `message_id` integer NOT NULL, `sender_id` integer NOT NULL, `sender_name` text NOT NULL, `url` text NOT NULL, `sent_at` datetime NOT NULL);
+-- Create index "sharedlink_chat_id_sent_at" to table: "shared_links"
+CREATE INDEX `sharedlink_chat_id_sent_at` ON `shared_links` (`chat_id`, `sent_at`);
+-- Create index "sharedlink_sent_at" to table: "shared_links"
+CREATE INDEX `sharedlink_sent_at` ON `shared_links` (`sent_at`);
@@ -0,0 +1,2 @@
+-- Add column "account_id" to table: "messages"
+ALTER TABLE `messages` ADD COLUMN `account_id` integer NULL;
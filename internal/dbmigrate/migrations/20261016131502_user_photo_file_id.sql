@@ -0,0 +1,2 @@
+-- Add column "photo_file_id" to table: "users"
+ALTER TABLE `users` ADD COLUMN `photo_file_id` text NULL;
@@ -0,0 +1,131 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// revisionTable 记录已执行迁移的表
+const revisionTable = "atlas_schema_revisions"
+
+// revisionStore 将迁移执行记录保存在数据库中（实现 migrate.RevisionReadWriter）
+type revisionStore struct {
+	db *sql.DB
+}
+
+// storedRevision 以 JSON 保存的执行记录：migrate.Revision 的 Type 序列化为文本后无法反序列化，
+// Hash 和 PartialHashes 不参与序列化，因此逐字段保存
+type storedRevision struct {
+	Version         string        `json:"version"`
+	Description     string        `json:"description"`
+	Type            uint          `json:"type"`
+	Applied         int           `json:"applied"`
+	Total           int           `json:"total"`
+	ExecutedAt      time.Time     `json:"executed_at"`
+	ExecutionTime   time.Duration `json:"execution_time"`
+	Error           string        `json:"error,omitempty"`
+	ErrorStmt       string        `json:"error_stmt,omitempty"`
+	Hash            string        `json:"hash"`
+	PartialHashes   []string      `json:"partial_hashes,omitempty"`
+	OperatorVersion string        `json:"operator_version"`
+}
+
+func newRevisionStore(ctx context.Context, db *sql.DB) (*revisionStore, error) {
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS `"+revisionTable+"` (`version` text NOT NULL PRIMARY KEY, `revision` text NOT NULL)")
+	if err != nil {
+		return nil, err
+	}
+	return &revisionStore{db: db}, nil
+}
+
+func (s *revisionStore) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionTable}
+}
+
+func (s *revisionStore) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT `revision` FROM `"+revisionTable+"` ORDER BY `version`")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*migrate.Revision
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		r, err := decodeRevision(data)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	return revisions, rows.Err()
+}
+
+func (s *revisionStore) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	var data string
+	err := s.db.QueryRowContext(ctx, "SELECT `revision` FROM `"+revisionTable+"` WHERE `version` = ?", version).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeRevision(data)
+}
+
+func (s *revisionStore) WriteRevision(ctx context.Context, r *migrate.Revision) error {
+	data, err := json.Marshal(storedRevision{
+		Version:         r.Version,
+		Description:     r.Description,
+		Type:            uint(r.Type),
+		Applied:         r.Applied,
+		Total:           r.Total,
+		ExecutedAt:      r.ExecutedAt,
+		ExecutionTime:   r.ExecutionTime,
+		Error:           r.Error,
+		ErrorStmt:       r.ErrorStmt,
+		Hash:            r.Hash,
+		PartialHashes:   r.PartialHashes,
+		OperatorVersion: r.OperatorVersion,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, "INSERT INTO `"+revisionTable+"` (`version`, `revision`) VALUES (?, ?) "+
+		"ON CONFLICT (`version`) DO UPDATE SET `revision` = excluded.`revision`", r.Version, string(data))
+	return err
+}
+
+func (s *revisionStore) DeleteRevision(ctx context.Context, version string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM `"+revisionTable+"` WHERE `version` = ?", version)
+	return err
+}
+
+func decodeRevision(data string) (*migrate.Revision, error) {
+	var stored storedRevision
+	if err := json.Unmarshal([]byte(data), &stored); err != nil {
+		return nil, err
+	}
+	return &migrate.Revision{
+		Version:         stored.Version,
+		Description:     stored.Description,
+		Type:            migrate.RevisionType(stored.Type),
+		Applied:         stored.Applied,
+		Total:           stored.Total,
+		ExecutedAt:      stored.ExecutedAt,
+		ExecutionTime:   stored.ExecutionTime,
+		Error:           stored.Error,
+		ErrorStmt:       stored.ErrorStmt,
+		Hash:            stored.Hash,
+		PartialHashes:   stored.PartialHashes,
+		OperatorVersion: stored.OperatorVersion,
+	}, nil
+}
@@ -0,0 +1,441 @@
+package display
+
+// TextKey 本地化文本键
+type TextKey string
+
+// 总结内容
+const (
+	TextSummaryTitle       TextKey = "summary_title"       // 总结标题
+	TextSummaryTitleChat   TextKey = "summary_title_chat"  // 带群聊名称的总结标题，%s 为群聊名称
+	TextExtractiveNotice   TextKey = "extractive_notice"   // 降级为抽取式总结时的提示
+	TextRangeSeparator     TextKey = "range_separator"     // 区间开始与结束之间的连接词
+	TextWeek               TextKey = "week"                // 周次，%d 为第几周
+	TextDigestHint         TextKey = "digest_hint"         // 精简总结底部的按钮提示
+	TextAllTopics          TextKey = "all_topics"          // 查看全部话题详情的按钮
+	TextDetailSent         TextKey = "detail_sent"         // 详情已私聊发送的提示
+	TextStartBotFirst      TextKey = "start_bot_first"     // 机器人无法私聊用户时的提示
+	TextNotMember          TextKey = "not_member"          // 非群组成员查看话题详情
+	TextFollowMatched      TextKey = "follow_matched"      // 关注推送的标题，%s 为匹配的关键词
+	TextMetricMessages     TextKey = "metric_messages"     // 活跃度指标：消息量
+	TextMetricParticipants TextKey = "metric_participants" // 活跃度指标：发言人数
+	TextAnomalyHigh        TextKey = "anomaly_high"        // 活跃度高于平日，%s 为指标，%.1f 为倍数
+	TextAnomalyLow         TextKey = "anomaly_low"         // 活跃度低于平日，%s 为指标，%.0f 为百分比
+	TextMostReacted        TextKey = "most_reacted"        // 回应最多的消息的小标题
+	TextPolls              TextKey = "polls"               // 投票的小标题
+	TextPollWinner         TextKey = "poll_winner"         // 投票结果：%s 为问题，%s 为领先选项，%d 为其得票数，%d 为参与人数
+	TextPollNoResult       TextKey = "poll_no_result"      // 得票数未公开的投票：%s 为问题，%d 为参与人数
+	TextActivity           TextKey = "activity"            // 成员活跃度的小标题
+	TextLinks              TextKey = "links"               // 分享链接的小标题
+	TextLinksOmitted       TextKey = "links_omitted"       // 未列出的链接数：%d 为数量
+	TextActivityEntry      TextKey = "activity_entry"      // 成员活跃度：%s 为成员名，%d 为消息数
+	TextActivityWithEvents TextKey = "activity_events"     // 含非文字消息的成员活跃度：%s 为成员名，%d 为消息总数，%d 为其中的非文字消息数
+)
+
+// 群聊命令（/optout、/optin、/redact、/tldr、/summary）的回复
+const (
+	TextAdminOnly         TextKey = "admin_only"          // 非群管理员使用管理命令
+	TextOptedIn           TextKey = "opted_in"            // 已恢复收集
+	TextOptedOut          TextKey = "opted_out"           // 已停止收集，%d 为删除的消息数
+	TextRedacted          TextKey = "redacted"            // 已排除区间，%s 为区间，%d 为删除的消息数
+	TextTLDROptedOut      TextKey = "tldr_opted_out"      // 已退出收集的群聊使用 /tldr
+	TextTLDRUsage         TextKey = "tldr_usage"          // /tldr 用法
+	TextTLDRCooldown      TextKey = "tldr_cooldown"       // /tldr 冷却中，%d 为需等待的秒数
+	TextTLDREmpty         TextKey = "tldr_empty"          // 讨论中没有可总结的消息
+	TextTLDRTitle         TextKey = "tldr_title"          // 讨论摘要标题，%d 为消息数
+	TextSummaryOptedOut   TextKey = "summary_opted_out"   // 已退出收集的群聊使用 /summary
+	TextSummaryUsage      TextKey = "summary_usage"       // /summary 用法
+	TextSummaryTooLong    TextKey = "summary_too_long"    // 区间超过天数上限，%d 为最多天数
+	TextSummaryCooldown   TextKey = "summary_cooldown"    // /summary 冷却中，%d 为需等待的秒数
+	TextSummaryEmpty      TextKey = "summary_empty"       // 区间内没有可总结的消息
+	TextListSeparator     TextKey = "list_separator"      // 同一行列出多项时的分隔符
+	TextRedactUsage       TextKey = "redact_usage"        // /redact 用法
+	TextRedactInvalid     TextKey = "redact_invalid"      // /redact 结束时间不晚于开始时间
+	TextRedactPurgeFailed TextKey = "redact_purge_failed" // 已记录排除区间，但删除已保存的消息失败
+	TextOptOutPurgeFailed TextKey = "optout_purge_failed" // 已停止收集，但删除已保存的消息失败
+	TextCommandFailed     TextKey = "command_failed"      // 群聊命令及管理员命令执行失败
+)
+
+// 机器人私聊命令（/start、/follow、/unfollow、/following）的回复
+const (
+	TextBotHelp         TextKey = "bot_help"         // /start 帮助
+	TextBotFailed       TextKey = "bot_failed"       // 命令执行失败
+	TextKeywordEmpty    TextKey = "keyword_empty"    // 关键词为空
+	TextKeywordTooLong  TextKey = "keyword_too_long" // 关键词过长，%d 为最大字数
+	TextFollowUsage     TextKey = "follow_usage"     // /follow 用法
+	TextFollowLimit     TextKey = "follow_limit"     // 关注数达到上限，%d 为上限
+	TextFollowExists    TextKey = "follow_exists"    // 已关注过，%s 为关键词
+	TextFollowAdded     TextKey = "follow_added"     // 关注成功，%s 为关键词
+	TextUnfollowUsage   TextKey = "unfollow_usage"   // /unfollow 用法
+	TextUnfollowMissing TextKey = "unfollow_missing" // 未关注该关键词，%s 为关键词
+	TextUnfollowed      TextKey = "unfollowed"       // 取消关注成功，%s 为关键词
+	TextFollowingEmpty  TextKey = "following_empty"  // 未关注任何关键词
+	TextFollowingList   TextKey = "following_list"   // 已关注关键词列表的标题
+)
+
+// 告警（发送给管理员及 Webhook）
+const (
+	TextAlertRunFailed         TextKey = "alert_run_failed"          // 运行失败的告警标题
+	TextAlertRunFailedDetail   TextKey = "alert_run_failed_detail"   // %s 为窗口，%s 为区间，%v 为错误
+	TextAlertChatFailing       TextKey = "alert_chat_failing"        // 群组连续失败的告警标题
+	TextAlertChatFailingDetail TextKey = "alert_chat_failing_detail" // %s 为群组名称及ID，%d 为连续失败次数，%v 为最近错误
+	TextAlertAnomaly           TextKey = "alert_anomaly"             // 活跃度异常的告警标题
+	TextAlertAnomalyDetail     TextKey = "alert_anomaly_detail"      // %s 为群组，%s 为区间，%s 为异常说明
+	TextAlertOffline           TextKey = "alert_offline"             // 连接中断的告警标题
+	TextAlertOfflineDetail     TextKey = "alert_offline_detail"      // %d 为账号的用户ID，%s 为中断时长，%s 为连接状态
+	TextAlertOnline            TextKey = "alert_online"              // 连接恢复的告警标题
+	TextAlertOnlineDetail      TextKey = "alert_online_detail"       // %d 为账号的用户ID，%s 为中断时长
+	TextAlertLoggedOut         TextKey = "alert_logged_out"          // 登录失效的告警标题
+	TextAlertLoggedOutDetail   TextKey = "alert_logged_out_detail"   // %d 为账号的用户ID，%s 为登录状态
+	TextAnomalyDetail          TextKey = "anomaly_detail"            // 单项异常说明：%s 为指标，%d 为本期值，%.1f 为近期均值，%.1f 为倍数
+)
+
+// 私聊发送的运行报告、启动自检结果、每周回顾、管理员命令用法，以及推送到 Webhook 的二维码登录链接
+const (
+	TextReportTitle       TextKey = "report_title"        // 运行报告标题
+	TextReportWindow      TextKey = "report_window"       // %s 为窗口名称
+	TextReportFailed      TextKey = "report_failed"       // 运行失败，%s 为错误
+	TextReportDone        TextKey = "report_done"         // 运行完成
+	TextReportChats       TextKey = "report_chats"        // %d 为成功的群组数，%d 为失败的群组数
+	TextReportMessages    TextKey = "report_messages"     // %d 为总结的消息数，%d 为清理的消息数
+	TextReportLanguages   TextKey = "report_languages"    // %s 为语言分布
+	TextReportDuration    TextKey = "report_duration"     // %s 为耗时
+	TextReportTokens      TextKey = "report_tokens"       // %d 为总 tokens，%d 为输入，%d 为输出，%.4f 为费用
+	TextReportTopChats    TextKey = "report_top_chats"    // 输入 tokens 最多的群组的小标题
+	TextReportTopChat     TextKey = "report_top_chat"     // %d 为群组ID，%d 为输入 tokens，%d、%d 为过滤前后的消息数，%d 为请求数，%d 为额外 tokens
+	TextSelfTestPassed    TextKey = "selftest_passed"     // %s 为引擎，%s 为耗时，%d 为话题数，%d 为通知分段数
+	TextSelfTestFailed    TextKey = "selftest_failed"     // %s 为引擎，%s 为耗时，%v 为错误
+	TextReviewTitle       TextKey = "review_title"        // 每周回顾标题：%s、%s 为起止日期，%d 为天数
+	TextReviewSection     TextKey = "review_section"      // 回顾分类：%s 为分类名称，%d 为条数
+	TextReviewTopics      TextKey = "review_topics"       // 回顾分类：参与的话题
+	TextReviewItems       TextKey = "review_items"        // 回顾分类：待跟进事项
+	TextReviewMentions    TextKey = "review_mentions"     // 回顾分类：提及你的消息
+	TextReviewMore        TextKey = "review_more"         // 未列出的条数，%d 为数量
+	TextSummaryAdminUsage TextKey = "summary_admin_usage" // 管理员私聊 /summary 用法
+	TextQRLoginTitle      TextKey = "qr_login_title"      // 二维码登录链接推送的标题
+	TextQRLoginDetail     TextKey = "qr_login_detail"     // 二维码登录链接推送的说明
+)
+
+// 管理员私聊命令（/status、/views、/shadow、/revisions、/edit、/summary）的回复及心跳消息
+const (
+	TextHeartbeat         TextKey = "heartbeat"          // 心跳消息：%s 为更新时间，%s 为运行状态
+	TextStatusTitle       TextKey = "status_title"       // /status 标题
+	TextStatusNoJobs      TextKey = "status_no_jobs"     // 没有已注册的定时任务
+	TextStatusJob         TextKey = "status_job"         // 定时任务：%s 为名称，%s 为 cron 表达式，%s 为下次执行时间
+	TextStatusRunning     TextKey = "status_running"     // 任务运行中，%s 为开始时间
+	TextStatusLastRun     TextKey = "status_last_run"    // 上次执行：%s 为时间，%s 为耗时，%s 为结果
+	TextStatusSucceeded   TextKey = "status_succeeded"   // 上次执行成功
+	TextStatusFailed      TextKey = "status_failed"      // 上次执行失败，%s 为错误
+	TextStatusRuns        TextKey = "status_runs"        // %d 为累计执行次数，%d 为失败次数
+	TextViewsTitle        TextKey = "views_title"        // 总结查看统计标题，%d 为天数
+	TextViewsEmpty        TextKey = "views_empty"        // 没有查看记录
+	TextViewsEntry        TextKey = "views_entry"        // %s 为群聊名称，%d 为被查看的总结数，%d 为点击次数，%d 为人数
+	TextShadowDisabled    TextKey = "shadow_disabled"    // 未启用模型对比
+	TextShadowTitle       TextKey = "shadow_title"       // 模型对比统计标题：%d 为天数，%s 为主模型，%s 为对比模型
+	TextShadowEmpty       TextKey = "shadow_empty"       // 没有对比记录
+	TextShadowEntry       TextKey = "shadow_entry"       // %s 为群聊名称，%d 为对比次数，%d 为失败次数，%.1f、%.1f 为平均话题数，%.0f 为话题覆盖率，%.0f 为消息重合度
+	TextRevisionsUsage    TextKey = "revisions_usage"    // /revisions 用法
+	TextRevisionsEmpty    TextKey = "revisions_empty"    // 任务没有摘要版本，%d 为任务ID
+	TextRevisionsTitle    TextKey = "revisions_title"    // %d 为任务ID，%d 为版本数
+	TextRevisionEntry     TextKey = "revision_entry"     // 摘要版本：%d 为序号，%s 为保存时间，%s 为来源，%s 为发送状态，%d 为字数
+	TextRevisionGenerated TextKey = "revision_generated" // 版本来源：生成
+	TextRevisionEdited    TextKey = "revision_edited"    // 版本来源：人工修改
+	TextRevisionUnsent    TextKey = "revision_unsent"    // 版本未发送
+	TextRevisionSent      TextKey = "revision_sent"      // 版本已发送，%s 为发送时间
+	TextRevisionSame      TextKey = "revision_same"      // 与上一版本相同
+	TextRevisionMore      TextKey = "revision_more"      // 未列出的差异行数，%d 为行数
+	TextInvalidID         TextKey = "invalid_id"         // 任务ID或群组ID无效，%s 为输入的参数
+	TextInvalidTaskID     TextKey = "invalid_task_id"    // 任务ID无效，%s 为输入的参数
+	TextInvalidChatID     TextKey = "invalid_chat_id"    // 群组ID无效，%s 为输入的参数
+	TextChatNoTasks       TextKey = "chat_no_tasks"      // 群组没有任务，%d 为群组ID
+	TextEditUsage         TextKey = "edit_usage"         // /edit 用法
+	TextEditEmpty         TextKey = "edit_empty"         // 修改后的摘要为空
+	TextTaskNotFound      TextKey = "task_not_found"     // 任务不存在，%d 为任务ID
+	TextTaskProcessing    TextKey = "task_processing"    // 任务处理中，%d 为任务ID
+	TextEditNotPending    TextKey = "edit_not_pending"   // 任务没有待发送的摘要，%d 为任务ID
+	TextEditSent          TextKey = "edit_sent"          // 摘要已替换并发送，%d 为任务ID
+	TextEditSendFailed    TextKey = "edit_send_failed"   // 摘要已替换但发送失败，%d 为任务ID
+)
+
+// texts 各语言的文本目录，每种语言须包含全部文本键
+var texts = map[string]map[TextKey]string{
+	"zh": {
+		TextSummaryTitle:       "群组总结",
+		TextSummaryTitleChat:   "群组总结：%s",
+		TextExtractiveNotice:   "⚠️ LLM 暂不可用，以下为自动摘录的消息",
+		TextRangeSeparator:     " 至 ",
+		TextWeek:               "第 %d 周",
+		TextDigestHint:         "👇 点击话题按钮，私聊查看完整内容和消息链接",
+		TextAllTopics:          "📄 全部话题",
+		TextDetailSent:         "详情已私聊发送给你",
+		TextStartBotFirst:      "请先私聊机器人并点击「开始」，详情将自动发送",
+		TextNotMember:          "只有群组成员可以查看该总结",
+		TextFollowMatched:      "🔔 你关注的「%s」有新讨论",
+		TextMetricMessages:     "消息量",
+		TextMetricParticipants: "发言人数",
+		TextAnomalyHigh:        "⚡ %s是平日的 %.1f 倍",
+		TextAnomalyLow:         "📉 %s仅为平日的 %.0f%%",
+		TextMostReacted:        "🔥 最多回应",
+		TextPolls:              "🗳 投票",
+		TextPollWinner:         "%s → %s（%d 票，共 %d 人参与）",
+		TextPollNoResult:       "%s（%d 人参与，结果尚未公开）",
+		TextActivity:           "👥 活跃成员",
+		TextLinks:              "🔗 今日链接",
+		TextLinksOmitted:       "……另有 %d 个链接",
+		TextActivityEntry:      "%s %d 条",
+		TextActivityWithEvents: "%s %d 条（含非文字 %d 条）",
+
+		TextAdminOnly:         "仅群管理员可以使用该命令",
+		TextOptedIn:           "✅ 已恢复收集本群消息",
+		TextOptedOut:          "✅ 已停止收集本群消息，并删除已保存的 %d 条消息。发送 /optin 可恢复收集",
+		TextRedacted:          "✅ 已排除 %s 的消息，删除已保存的 %d 条，区间内的消息不会参与总结",
+		TextTLDROptedOut:      "本群已退出数据收集，无法使用 /tldr",
+		TextTLDRUsage:         "用法: 回复某条消息发送 /tldr，总结该消息所在的讨论",
+		TextTLDRCooldown:      "操作太频繁，请 %d 秒后再试",
+		TextTLDREmpty:         "该讨论没有可总结的文字消息",
+		TextTLDRTitle:         "🧵 讨论摘要（%d 条消息）",
+		TextSummaryOptedOut:   "本群已退出数据收集，无法使用 /summary",
+		TextSummaryUsage:      "用法: /summary today（今天）、/summary yesterday（昨天）、/summary 2025-02-10，或 /summary 2025-02-10 2025-02-12 总结多天",
+		TextSummaryTooLong:    "单次最多总结 %d 天",
+		TextSummaryCooldown:   "操作太频繁，请 %d 秒后再试",
+		TextSummaryEmpty:      "该时间段没有可总结的消息",
+		TextListSeparator:     "；",
+		TextRedactUsage:       "用法: /redact 14:00-15:00、/redact 2025-02-10 14:00-15:00，或回复某条消息发送 /redact 排除该消息至今的内容",
+		TextRedactInvalid:     "结束时间需晚于开始时间",
+		TextRedactPurgeFailed: "⚠️ 已记录排除区间，但删除已保存的消息失败，请稍后重试",
+		TextOptOutPurgeFailed: "⚠️ 已停止收集本群消息，但删除已保存的消息失败，请稍后重试",
+		TextCommandFailed:     "❌ 操作失败，请稍后再试",
+
+		TextBotHelp: `👋 我会在群聊中发送每日总结，点击话题按钮即可私聊查看详情。
+
+/follow <关键词> 关注关键词，每日总结中包含该关键词的话题会私聊推送给你（仅限你所在的群组）
+/unfollow <关键词> 取消关注
+/following 查看已关注的关键词`,
+		TextBotFailed:       "❌ 操作失败，请稍后再试",
+		TextKeywordEmpty:    "关键词不能为空",
+		TextKeywordTooLong:  "关键词不能超过 %d 个字",
+		TextFollowUsage:     "用法：/follow <关键词>，如 /follow kubernetes",
+		TextFollowLimit:     "最多关注 %d 个关键词，请先使用 /unfollow 取消部分关注",
+		TextFollowExists:    "已关注过「%s」",
+		TextFollowAdded:     "✅ 已关注「%s」，每日总结中的相关话题会私聊推送给你",
+		TextUnfollowUsage:   "用法：/unfollow <关键词>",
+		TextUnfollowMissing: "未关注「%s」，使用 /following 查看已关注的关键词",
+		TextUnfollowed:      "已取消关注「%s」",
+		TextFollowingEmpty:  "尚未关注任何关键词，使用 /follow <关键词> 添加",
+		TextFollowingList:   "🔔 已关注的关键词：",
+
+		TextAlertRunFailed:         "每日总结运行失败",
+		TextAlertRunFailedDetail:   "窗口 %s，区间 %s: %v",
+		TextAlertChatFailing:       "群组总结连续失败",
+		TextAlertChatFailingDetail: "群组 %s 已连续 %d 次总结失败，最近错误: %v",
+		TextAlertAnomaly:           "群组活跃度异常",
+		TextAlertAnomalyDetail:     "群组 %s，区间 %s: %s",
+		TextAlertOffline:           "Telegram 连接中断",
+		TextAlertOfflineDetail:     "账号 %d 已 %s 未连接到 Telegram（%s），期间的群聊消息可能缺失，恢复连接后将自动补录",
+		TextAlertOnline:            "Telegram 连接已恢复",
+		TextAlertOnlineDetail:      "账号 %d 中断 %s 后已恢复连接，正在补录中断期间的消息",
+		TextAlertLoggedOut:         "Telegram 账号登录失效",
+		TextAlertLoggedOutDetail:   "账号 %d 的登录状态变为 %s，需要重新登录后才能继续接收消息",
+		TextAnomalyDetail:          "%s %d，近期均值 %.1f（%.1f 倍）",
+
+		TextReportTitle:       "🛠 <b>运行报告</b>",
+		TextReportWindow:      "窗口: %s",
+		TextReportFailed:      "状态: ❌ 失败 (%s)",
+		TextReportDone:        "状态: ✅ 完成",
+		TextReportChats:       "群组: 成功 %d，失败 %d",
+		TextReportMessages:    "消息: 总结 %d 条，清理 %d 条",
+		TextReportLanguages:   "语言: %s",
+		TextReportDuration:    "耗时: %s",
+		TextReportTokens:      "Tokens: %d（输入 %d / 输出 %d），费用 %.4f",
+		TextReportTopChats:    "输入 tokens 最多的群组:",
+		TextReportTopChat:     "- %d: %d（消息 %d → %d 条，请求 %d 次，额外 ~%d）",
+		TextSelfTestPassed:    "✅ 启动自检通过（引擎 %s，耗时 %s）\n示例对话生成 %d 个话题，通知 %d 段",
+		TextSelfTestFailed:    "❌ 启动自检失败（引擎 %s，耗时 %s）\n%v\n\n请在下次总结运行前检查 LLM 配置（APIKey、BaseURL、Model）",
+		TextReviewTitle:       "📅 <b>每周回顾</b> %s ~ %s\n过去 %d 天与你相关的群聊内容：",
+		TextReviewSection:     "%s（%d）",
+		TextReviewTopics:      "💬 <b>参与的话题</b>",
+		TextReviewItems:       "📌 <b>待跟进事项</b>",
+		TextReviewMentions:    "🔔 <b>提及你的消息</b>",
+		TextReviewMore:        "…另有 %d 条",
+		TextSummaryAdminUsage: "用法: /summary <群组ID> today|yesterday|2025-02-10 [2025-02-12]",
+		TextQRLoginTitle:      "Telegram 扫码登录",
+		TextQRLoginDetail:     "请用已登录的 Telegram 设备（设置 → 设备 → 连接桌面设备）扫描该链接生成的二维码，链接很快过期，过期后会推送新的链接",
+
+		TextHeartbeat:         "💓 服务运行中\n更新时间: %s\n若长时间未更新，说明服务已停止\n\n%s",
+		TextStatusTitle:       "📊 运行状态",
+		TextStatusNoJobs:      "暂无已注册的定时任务",
+		TextStatusJob:         "⏰ %s (%s)\n   下次执行: %s",
+		TextStatusRunning:     "   运行中，开始于 %s",
+		TextStatusLastRun:     "   上次执行: %s，耗时 %s，%s",
+		TextStatusSucceeded:   "成功",
+		TextStatusFailed:      "失败: %s",
+		TextStatusRuns:        "   累计执行 %d 次，失败 %d 次",
+		TextViewsTitle:        "👀 近 %d 天总结查看统计",
+		TextViewsEmpty:        "暂无查看记录",
+		TextViewsEntry:        "%s: %d 份总结被查看，点击 %d 次，%d 人",
+		TextShadowDisabled:    "⚖️ 未启用模型对比（LLM.Shadow.Model）",
+		TextShadowTitle:       "⚖️ 近 %d 天模型对比: %s vs %s",
+		TextShadowEmpty:       "暂无对比记录",
+		TextShadowEntry:       "%s: 对比 %d 次（失败 %d），话题数 %.1f → %.1f，话题覆盖 %.0f%%，引用消息重合 %.0f%%",
+		TextRevisionsUsage:    "用法: /revisions <任务ID|群组ID>",
+		TextRevisionsEmpty:    "任务 %d 暂无摘要版本记录",
+		TextRevisionsTitle:    "📝 任务 %d 共 %d 个摘要版本",
+		TextRevisionEntry:     "#%d %s %s，%s，%d 字",
+		TextRevisionGenerated: "生成",
+		TextRevisionEdited:    "人工修改",
+		TextRevisionUnsent:    "未发送",
+		TextRevisionSent:      "已发送于 %s",
+		TextRevisionSame:      "与上一版本相同",
+		TextRevisionMore:      "…（另有 %d 行差异）",
+		TextInvalidID:         "无效的ID: %s",
+		TextInvalidTaskID:     "无效的任务ID: %s",
+		TextInvalidChatID:     "无效的群组ID: %s",
+		TextChatNoTasks:       "群组 %d 暂无任务",
+		TextEditUsage:         "用法: /edit <任务ID>，换行后附上修改后的完整摘要",
+		TextEditEmpty:         "摘要内容不能为空",
+		TextTaskNotFound:      "任务 %d 不存在",
+		TextTaskProcessing:    "任务 %d 正在处理中，请稍后再试",
+		TextEditNotPending:    "任务 %d 没有待发送的摘要（已发送或尚未生成）",
+		TextEditSent:          "✅ 任务 %d 的摘要已替换并发送",
+		TextEditSendFailed:    "⚠️ 任务 %d 的摘要已替换，但发送失败，可稍后重新执行 /edit",
+	},
+	"en": {
+		TextSummaryTitle:       "Group Summary",
+		TextSummaryTitleChat:   "Group Summary: %s",
+		TextExtractiveNotice:   "⚠️ LLM unavailable, showing automatically extracted messages",
+		TextRangeSeparator:     " to ",
+		TextWeek:               "Week %d",
+		TextDigestHint:         "👇 Tap a topic to get the full details and message links privately",
+		TextAllTopics:          "📄 All topics",
+		TextDetailSent:         "Details sent to you privately",
+		TextStartBotFirst:      "Please start a private chat with the bot first; details will be sent automatically",
+		TextNotMember:          "Only members of the group can view this summary",
+		TextFollowMatched:      "🔔 New discussions on your followed keywords: %s",
+		TextMetricMessages:     "Messages",
+		TextMetricParticipants: "Participants",
+		TextAnomalyHigh:        "⚡ %s are %.1fx the usual level",
+		TextAnomalyLow:         "📉 %s are only %.0f%% of the usual level",
+		TextMostReacted:        "🔥 Most reacted",
+		TextPolls:              "🗳 Polls",
+		TextPollWinner:         "%s → %s (%d votes, %d voters)",
+		TextPollNoResult:       "%s (%d voters, results not public yet)",
+		TextActivity:           "👥 Most active",
+		TextLinks:              "🔗 Links",
+		TextLinksOmitted:       "… and %d more",
+		TextActivityEntry:      "%s %d",
+		TextActivityWithEvents: "%s %d (%d non-text)",
+
+		TextAdminOnly:         "Only group admins can use this command",
+		TextOptedIn:           "✅ Resumed collecting messages in this group",
+		TextOptedOut:          "✅ Stopped collecting messages in this group and deleted %d saved messages. Send /optin to resume",
+		TextRedacted:          "✅ Excluded messages from %s and deleted %d saved messages; messages in this range will not be summarized",
+		TextTLDROptedOut:      "This group has opted out of data collection, /tldr is unavailable",
+		TextTLDRUsage:         "Usage: reply to a message with /tldr to summarize its discussion",
+		TextTLDRCooldown:      "Too many requests, please try again in %d seconds",
+		TextTLDREmpty:         "This discussion has no text messages to summarize",
+		TextTLDRTitle:         "🧵 Discussion summary (%d messages)",
+		TextSummaryOptedOut:   "This group has opted out of data collection, /summary is unavailable",
+		TextSummaryUsage:      "Usage: /summary today, /summary yesterday, /summary 2025-02-10, or /summary 2025-02-10 2025-02-12 for several days",
+		TextSummaryTooLong:    "At most %d days can be summarized at once",
+		TextSummaryCooldown:   "Too many requests, please try again in %d seconds",
+		TextSummaryEmpty:      "There are no messages to summarize in this period",
+		TextListSeparator:     "; ",
+		TextRedactUsage:       "Usage: /redact 14:00-15:00, /redact 2025-02-10 14:00-15:00, or reply to a message with /redact to exclude everything from that message until now",
+		TextRedactInvalid:     "The end time must be later than the start time",
+		TextRedactPurgeFailed: "⚠️ Recorded the excluded range, but failed to delete the saved messages, please try again later",
+		TextOptOutPurgeFailed: "⚠️ Stopped collecting messages in this group, but failed to delete the saved messages, please try again later",
+		TextCommandFailed:     "❌ Something went wrong, please try again later",
+
+		TextBotHelp: `👋 I post daily summaries in group chats. Tap a topic button to get the details privately.
+
+/follow <keyword> Follow a keyword; topics in daily summaries that mention it are sent to you privately (only for groups you are in)
+/unfollow <keyword> Stop following a keyword
+/following List your followed keywords`,
+		TextBotFailed:       "❌ Something went wrong, please try again later",
+		TextKeywordEmpty:    "Keyword must not be empty",
+		TextKeywordTooLong:  "Keyword must not exceed %d characters",
+		TextFollowUsage:     "Usage: /follow <keyword>, e.g. /follow kubernetes",
+		TextFollowLimit:     "You can follow at most %d keywords, use /unfollow to remove some first",
+		TextFollowExists:    "Already following \"%s\"",
+		TextFollowAdded:     "✅ Following \"%s\", related topics in daily summaries will be sent to you privately",
+		TextUnfollowUsage:   "Usage: /unfollow <keyword>",
+		TextUnfollowMissing: "Not following \"%s\", use /following to list your followed keywords",
+		TextUnfollowed:      "Unfollowed \"%s\"",
+		TextFollowingEmpty:  "You are not following any keywords yet, use /follow <keyword> to add one",
+		TextFollowingList:   "🔔 Followed keywords:",
+
+		TextAlertRunFailed:         "Daily summary run failed",
+		TextAlertRunFailedDetail:   "Window %s, range %s: %v",
+		TextAlertChatFailing:       "Group summary failing repeatedly",
+		TextAlertChatFailingDetail: "Group %s failed to summarize %d times in a row, last error: %v",
+		TextAlertAnomaly:           "Unusual group activity",
+		TextAlertAnomalyDetail:     "Group %s, range %s: %s",
+		TextAlertOffline:           "Telegram connection lost",
+		TextAlertOfflineDetail:     "Account %d has been disconnected from Telegram for %s (%s); group messages may be missing and will be backfilled after reconnecting",
+		TextAlertOnline:            "Telegram connection restored",
+		TextAlertOnlineDetail:      "Account %d reconnected after %s; backfilling messages from the outage",
+		TextAlertLoggedOut:         "Telegram account logged out",
+		TextAlertLoggedOutDetail:   "Account %d authorization state changed to %s; log in again to keep receiving messages",
+		TextAnomalyDetail:          "%s %d, recent average %.1f (%.1fx)",
+
+		TextReportTitle:       "🛠 <b>Run report</b>",
+		TextReportWindow:      "Window: %s",
+		TextReportFailed:      "Status: ❌ failed (%s)",
+		TextReportDone:        "Status: ✅ completed",
+		TextReportChats:       "Groups: %d succeeded, %d failed",
+		TextReportMessages:    "Messages: %d summarized, %d cleaned up",
+		TextReportLanguages:   "Languages: %s",
+		TextReportDuration:    "Duration: %s",
+		TextReportTokens:      "Tokens: %d (prompt %d / completion %d), cost %.4f",
+		TextReportTopChats:    "Groups with the most prompt tokens:",
+		TextReportTopChat:     "- %d: %d (messages %d → %d, %d requests, overhead ~%d)",
+		TextSelfTestPassed:    "✅ Startup self-test passed (engine %s, took %s)\nThe sample conversation produced %d topics in %d notification parts",
+		TextSelfTestFailed:    "❌ Startup self-test failed (engine %s, took %s)\n%v\n\nPlease check the LLM settings (APIKey, BaseURL, Model) before the next summary run",
+		TextReviewTitle:       "📅 <b>Weekly review</b> %s ~ %s\nGroup content related to you in the past %d days:",
+		TextReviewSection:     "%s (%d)",
+		TextReviewTopics:      "💬 <b>Topics you joined</b>",
+		TextReviewItems:       "📌 <b>Follow-ups</b>",
+		TextReviewMentions:    "🔔 <b>Messages mentioning you</b>",
+		TextReviewMore:        "… and %d more",
+		TextSummaryAdminUsage: "Usage: /summary <chat ID> today|yesterday|2025-02-10 [2025-02-12]",
+		TextQRLoginTitle:      "Telegram QR code login",
+		TextQRLoginDetail:     "Scan the QR code generated from this link with a logged-in Telegram device (Settings → Devices → Link Desktop Device). The link expires soon; a new link will be pushed when it does",
+
+		TextHeartbeat:         "💓 Service running\nUpdated at: %s\nIf this stops updating, the service has stopped\n\n%s",
+		TextStatusTitle:       "📊 Status",
+		TextStatusNoJobs:      "No scheduled jobs registered",
+		TextStatusJob:         "⏰ %s (%s)\n   Next run: %s",
+		TextStatusRunning:     "   Running since %s",
+		TextStatusLastRun:     "   Last run: %s, took %s, %s",
+		TextStatusSucceeded:   "succeeded",
+		TextStatusFailed:      "failed: %s",
+		TextStatusRuns:        "   %d runs in total, %d failed",
+		TextViewsTitle:        "👀 Summary views in the last %d days",
+		TextViewsEmpty:        "No views yet",
+		TextViewsEntry:        "%s: %d summaries viewed, %d clicks, %d viewers",
+		TextShadowDisabled:    "⚖️ Model comparison is not enabled (LLM.Shadow.Model)",
+		TextShadowTitle:       "⚖️ Model comparison in the last %d days: %s vs %s",
+		TextShadowEmpty:       "No comparisons yet",
+		TextShadowEntry:       "%s: %d comparisons (%d failed), topics %.1f → %.1f, topic coverage %.0f%%, message overlap %.0f%%",
+		TextRevisionsUsage:    "Usage: /revisions <task ID|chat ID>",
+		TextRevisionsEmpty:    "Task %d has no summary revisions",
+		TextRevisionsTitle:    "📝 Task %d summary revisions: %d",
+		TextRevisionEntry:     "#%d %s %s, %s, %d characters",
+		TextRevisionGenerated: "generated",
+		TextRevisionEdited:    "edited",
+		TextRevisionUnsent:    "not sent",
+		TextRevisionSent:      "sent at %s",
+		TextRevisionSame:      "Same as the previous revision",
+		TextRevisionMore:      "… (%d more changed lines)",
+		TextInvalidID:         "Invalid ID: %s",
+		TextInvalidTaskID:     "Invalid task ID: %s",
+		TextInvalidChatID:     "Invalid chat ID: %s",
+		TextChatNoTasks:       "Chat %d has no tasks",
+		TextEditUsage:         "Usage: /edit <task ID>, followed by the full edited summary on the next lines",
+		TextEditEmpty:         "The summary must not be empty",
+		TextTaskNotFound:      "Task %d does not exist",
+		TextTaskProcessing:    "Task %d is being processed, please try again later",
+		TextEditNotPending:    "Task %d has no summary waiting to be sent (already sent or not generated yet)",
+		TextEditSent:          "✅ Replaced and sent the summary of task %d",
+		TextEditSendFailed:    "⚠️ Replaced the summary of task %d, but sending failed; run /edit again later",
+	},
+}
@@ -0,0 +1,200 @@
+// Package display 总结内容的本地化展示：日期区间格式、时区标签和界面文本。
+package display
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+)
+
+var zhWeekdays = [...]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+
+// 默认的头部 emoji
+const (
+	defaultTitleEmoji = "📊"
+	defaultDateEmoji  = "📅"
+)
+
+// Formatter 按配置格式化展示内容，nil 时使用默认配置（中文、UTC、YYYY-MM-DD）
+type Formatter struct {
+	locale      string
+	chatLocales map[int64]string
+	overrides   map[TextKey]string
+	titleEmoji  string
+	dateEmoji   string
+	footer      string
+	loc         *time.Location
+	zoneLabel   string
+	dateFormat  string
+	timeFormat  string
+	showWeekday bool
+	firstDay    time.Weekday
+	exclusive   bool
+}
+
+var defaultFormatter, _ = NewFormatter(&config.Display{})
+
+// NewFormatter 根据展示配置创建 Formatter
+func NewFormatter(cfg *config.Display) (*Formatter, error) {
+	loc, err := cfg.Location()
+	if err != nil {
+		return nil, fmt.Errorf("加载时区失败: %w", err)
+	}
+
+	f := &Formatter{
+		locale:      cfg.Locale,
+		loc:         loc,
+		zoneLabel:   cfg.TimezoneLabel,
+		dateFormat:  cfg.DateFormat,
+		timeFormat:  cfg.TimeFormat,
+		showWeekday: cfg.ShowWeekday,
+		firstDay:    time.Monday,
+		exclusive:   cfg.RangeEnd == "exclusive",
+		titleEmoji:  defaultTitleEmoji,
+		dateEmoji:   defaultDateEmoji,
+		footer:      cfg.Footer,
+	}
+	if _, ok := texts[f.locale]; !ok {
+		f.locale = "zh"
+	}
+	for chatID, locale := range cfg.ChatLocales {
+		if _, ok := texts[locale]; !ok {
+			return nil, fmt.Errorf("群组 %d 的展示语言不受支持: %s", chatID, locale)
+		}
+		if f.chatLocales == nil {
+			f.chatLocales = make(map[int64]string, len(cfg.ChatLocales))
+		}
+		f.chatLocales[chatID] = locale
+	}
+	if f.dateFormat == "" {
+		f.dateFormat = "2006-01-02"
+	}
+	if f.timeFormat == "" {
+		f.timeFormat = "15:04"
+	}
+	if cfg.FirstDayOfWeek == "sunday" {
+		f.firstDay = time.Sunday
+	}
+	if cfg.TitleEmoji != nil {
+		f.titleEmoji = *cfg.TitleEmoji
+	}
+	if cfg.DateEmoji != nil {
+		f.dateEmoji = *cfg.DateEmoji
+	}
+	if len(cfg.Texts) > 0 {
+		f.overrides = make(map[TextKey]string, len(cfg.Texts))
+		for key, text := range cfg.Texts {
+			if _, ok := texts[f.locale][TextKey(key)]; !ok {
+				return nil, fmt.Errorf("未知的文本键: %s", key)
+			}
+			f.overrides[TextKey(key)] = text
+		}
+	}
+	return f, nil
+}
+
+// T 返回本地化文本，配置了自定义文本时优先使用
+func (f *Formatter) T(key TextKey) string {
+	if f == nil {
+		f = defaultFormatter
+	}
+	if text, ok := f.overrides[key]; ok {
+		return text
+	}
+	return texts[f.locale][key]
+}
+
+// Tf 返回按 args 格式化后的本地化文本
+func (f *Formatter) Tf(key TextKey, args ...any) string {
+	return fmt.Sprintf(f.T(key), args...)
+}
+
+// ForChat 返回群组使用的 Formatter：配置了该群组的展示语言时切换语言，其他设置不变
+func (f *Formatter) ForChat(chatID int64) *Formatter {
+	if f == nil {
+		f = defaultFormatter
+	}
+	locale, ok := f.chatLocales[chatID]
+	if !ok || locale == f.locale {
+		return f
+	}
+	chatFormatter := *f
+	chatFormatter.locale = locale
+	return &chatFormatter
+}
+
+// TitleEmoji 标题前的 emoji，为空表示不显示
+func (f *Formatter) TitleEmoji() string {
+	if f == nil {
+		f = defaultFormatter
+	}
+	return f.titleEmoji
+}
+
+// DateEmoji 日期前的 emoji，为空表示不显示
+func (f *Formatter) DateEmoji() string {
+	if f == nil {
+		f = defaultFormatter
+	}
+	return f.dateEmoji
+}
+
+// Footer 总结末尾的页脚，为空表示不显示
+func (f *Formatter) Footer() string {
+	if f == nil {
+		f = defaultFormatter
+	}
+	return f.footer
+}
+
+// DateRange 格式化区间 [startTime, endTime)，如 "2025-02-10 至 2025-02-11 (UTC)"：
+// 整天区间只显示日期（结束日为包含），否则附加时间；整天区间恰为从每周第一天开始的整周时附加周次。
+// RangeEnd 为 exclusive 时始终显示精确的开始和结束时刻，结束时刻本身不包含在区间内
+func (f *Formatter) DateRange(startTime, endTime time.Time) string {
+	if f == nil {
+		f = defaultFormatter
+	}
+	startTime, endTime = startTime.In(f.loc), endTime.In(f.loc)
+
+	var text string
+	if !f.exclusive && isMidnight(startTime) && isMidnight(endTime) {
+		lastDay := endTime.AddDate(0, 0, -1)
+		text = f.date(startTime) + f.T(TextRangeSeparator) + f.date(lastDay)
+		if startTime.Weekday() == f.firstDay && startTime.AddDate(0, 0, 7).Equal(endTime) {
+			text += " · " + fmt.Sprintf(f.T(TextWeek), f.weekNumber(startTime))
+		}
+	} else {
+		text = f.date(startTime) + " " + startTime.Format(f.timeFormat) +
+			f.T(TextRangeSeparator) + f.date(endTime) + " " + endTime.Format(f.timeFormat)
+	}
+
+	zoneLabel := f.zoneLabel
+	if zoneLabel == "" {
+		zoneLabel = startTime.Format("MST")
+	}
+	return text + " (" + zoneLabel + ")"
+}
+
+// date 格式化日期，按配置附加星期
+func (f *Formatter) date(t time.Time) string {
+	text := t.Format(f.dateFormat)
+	if !f.showWeekday {
+		return text
+	}
+	if f.locale == "zh" {
+		return text + " " + zhWeekdays[t.Weekday()]
+	}
+	return text + " " + t.Format("Mon")
+}
+
+// weekNumber 返回 t 在当年的周次，1 月 1 日所在周为第 1 周，每周从 firstDay 开始
+func (f *Formatter) weekNumber(t time.Time) int {
+	jan1 := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	offset := (int(jan1.Weekday()) - int(f.firstDay) + 7) % 7
+	return (t.YearDay()-1+offset)/7 + 1
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
@@ -0,0 +1,168 @@
+package display
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateRange(t *testing.T) {
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC) // 周一
+
+	tests := []struct {
+		name  string
+		cfg   config.Display
+		start time.Time
+		end   time.Time
+		want  string
+	}{
+		{
+			name:  "默认配置",
+			start: day,
+			end:   day.AddDate(0, 0, 1),
+			want:  "2025-02-10 至 2025-02-10 (UTC)",
+		},
+		{
+			name:  "非整天区间显示时间",
+			start: day.Add(12 * time.Hour),
+			end:   day.Add(18 * time.Hour),
+			want:  "2025-02-10 12:00 至 2025-02-10 18:00 (UTC)",
+		},
+		{
+			name:  "展示时区与自定义标签",
+			cfg:   config.Display{Timezone: "Asia/Shanghai", TimezoneLabel: "北京时间"},
+			start: day.Add(-8 * time.Hour),
+			end:   day.Add(16 * time.Hour),
+			want:  "2025-02-10 至 2025-02-10 (北京时间)",
+		},
+		{
+			name:  "展示时区下 UTC 整天不再是整天",
+			cfg:   config.Display{Timezone: "Asia/Shanghai"},
+			start: day,
+			end:   day.AddDate(0, 0, 1),
+			want:  "2025-02-10 08:00 至 2025-02-11 08:00 (CST)",
+		},
+		{
+			name:  "英文与星期",
+			cfg:   config.Display{Locale: "en", DateFormat: "Jan 2, 2006", ShowWeekday: true},
+			start: day,
+			end:   day.AddDate(0, 0, 2),
+			want:  "Feb 10, 2025 Mon to Feb 11, 2025 Tue (UTC)",
+		},
+		{
+			name:  "中文星期",
+			cfg:   config.Display{ShowWeekday: true},
+			start: day,
+			end:   day.AddDate(0, 0, 1),
+			want:  "2025-02-10 周一 至 2025-02-10 周一 (UTC)",
+		},
+		{
+			name:  "整周显示周次",
+			start: day,
+			end:   day.AddDate(0, 0, 7),
+			want:  "2025-02-10 至 2025-02-16 · 第 7 周 (UTC)",
+		},
+		{
+			name:  "每周从周日开始",
+			cfg:   config.Display{FirstDayOfWeek: "sunday"},
+			start: day.AddDate(0, 0, -1),
+			end:   day.AddDate(0, 0, 6),
+			want:  "2025-02-09 至 2025-02-15 · 第 7 周 (UTC)",
+		},
+		{
+			name:  "结束不包含时显示精确时刻",
+			cfg:   config.Display{RangeEnd: "exclusive"},
+			start: day,
+			end:   day.AddDate(0, 0, 7),
+			want:  "2025-02-10 00:00 至 2025-02-17 00:00 (UTC)",
+		},
+		{
+			name:  "展示时区跨夏令时的整周",
+			cfg:   config.Display{Timezone: "America/New_York", TimezoneLabel: "ET"},
+			start: time.Date(2025, 3, 3, 5, 0, 0, 0, time.UTC),  // 03-03 00:00 EST
+			end:   time.Date(2025, 3, 10, 4, 0, 0, 0, time.UTC), // 03-10 00:00 EDT
+			want:  "2025-03-03 至 2025-03-09 · 第 10 周 (ET)",
+		},
+		{
+			name:  "未从每周第一天开始不显示周次",
+			cfg:   config.Display{FirstDayOfWeek: "sunday"},
+			start: day,
+			end:   day.AddDate(0, 0, 7),
+			want:  "2025-02-10 至 2025-02-16 (UTC)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFormatter(&tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.DateRange(tt.start, tt.end))
+		})
+	}
+}
+
+func TestFormatter_Nil(t *testing.T) {
+	var f *Formatter
+	assert.Equal(t, "群组总结", f.T(TextSummaryTitle))
+	assert.Equal(t, "2025-02-10 至 2025-02-10 (UTC)", f.DateRange(time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestNewFormatter_InvalidTimezone(t *testing.T) {
+	_, err := NewFormatter(&config.Display{Timezone: "Mars/Olympus"})
+	assert.Error(t, err)
+}
+
+func TestNewFormatter_Branding(t *testing.T) {
+	empty := ""
+	f, err := NewFormatter(&config.Display{
+		TitleEmoji: &empty,
+		Footer:     "generated by talk-trace-bot",
+		Texts:      map[string]string{"summary_title": "Acme 日报"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Acme 日报", f.T(TextSummaryTitle), "自定义文本覆盖默认文本")
+	assert.Equal(t, "群组总结：%s", f.T(TextSummaryTitleChat), "未覆盖的文本保持默认")
+	assert.Equal(t, "", f.TitleEmoji())
+	assert.Equal(t, "📅", f.DateEmoji())
+	assert.Equal(t, "generated by talk-trace-bot", f.Footer())
+
+	var nilFormatter *Formatter
+	assert.Equal(t, "📊", nilFormatter.TitleEmoji())
+	assert.Equal(t, "", nilFormatter.Footer())
+
+	_, err = NewFormatter(&config.Display{Texts: map[string]string{"no_such_key": "x"}})
+	assert.ErrorContains(t, err, "no_such_key")
+}
+
+func TestCatalog_Complete(t *testing.T) {
+	for key := range texts["zh"] {
+		for locale, catalog := range texts {
+			assert.NotEmpty(t, catalog[key], "%s 缺少文本 %s", locale, key)
+		}
+	}
+	assert.Equal(t, len(texts["zh"]), len(texts["en"]))
+}
+
+func TestFormatter_ForChat(t *testing.T) {
+	f, err := NewFormatter(&config.Display{
+		Texts:       map[string]string{"summary_title": "Acme"},
+		ChatLocales: map[int64]string{-100: "en"},
+	})
+	require.NoError(t, err)
+
+	en := f.ForChat(-100)
+	assert.Equal(t, "Only group admins can use this command", en.T(TextAdminOnly))
+	assert.Equal(t, "Acme", en.T(TextSummaryTitle), "自定义文本对所有语言生效")
+	assert.Equal(t, "2025-02-10 to 2025-02-10 (UTC)", en.DateRange(time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC)))
+	assert.Same(t, f, f.ForChat(-200), "未配置的群组使用全局语言")
+	assert.Equal(t, "操作太频繁，请 3 秒后再试", f.ForChat(-200).Tf(TextTLDRCooldown, 3))
+
+	var nilFormatter *Formatter
+	assert.Equal(t, "群组总结", nilFormatter.ForChat(-100).T(TextSummaryTitle))
+
+	_, err = NewFormatter(&config.Display{ChatLocales: map[int64]string{-100: "fr"}})
+	assert.ErrorContains(t, err, "-100")
+}
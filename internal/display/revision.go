@@ -0,0 +1,86 @@
+package display
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+)
+
+// maxRevisionDiffLines 每个版本最多展示的差异行数
+const maxRevisionDiffLines = 30
+
+// RevisionsText 任务摘要的历史版本（按保存顺序），每个版本附与上一版本的逐行差异
+func (f *Formatter) RevisionsText(taskID int, revisions []*ent.SummaryRevision) string {
+	if len(revisions) == 0 {
+		return f.Tf(TextRevisionsEmpty, taskID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(f.Tf(TextRevisionsTitle, taskID, len(revisions)) + "\n")
+	var prev []string
+	for i, rev := range revisions {
+		source := f.T(TextRevisionGenerated)
+		if rev.Source == summaryrevision.SourceEdited {
+			source = f.T(TextRevisionEdited)
+		}
+		sent := f.T(TextRevisionUnsent)
+		if !rev.SentAt.IsZero() {
+			sent = f.Tf(TextRevisionSent, rev.SentAt.Local().Format("01-02 15:04"))
+		}
+		sb.WriteString("\n" + f.Tf(TextRevisionEntry, i+1, rev.CreateTime.Local().Format("01-02 15:04"), source, sent, utf8.RuneCountInString(rev.Content)) + "\n")
+
+		lines := strings.Split(rev.Content, "\n")
+		if i > 0 {
+			diff := DiffLines(prev, lines)
+			if len(diff) == 0 {
+				sb.WriteString(f.T(TextRevisionSame) + "\n")
+			}
+			for j, line := range diff {
+				if j == maxRevisionDiffLines {
+					sb.WriteString(f.Tf(TextRevisionMore, len(diff)-j) + "\n")
+					break
+				}
+				sb.WriteString(line + "\n")
+			}
+		}
+		prev = lines
+	}
+	return sb.String()
+}
+
+// DiffLines 基于最长公共子序列的逐行差异，删除的行以 "- " 开头，新增的行以 "+ " 开头
+func DiffLines(a, b []string) []string {
+	// lcs[i][j] 为 a[i:] 与 b[j:] 的最长公共子序列长度
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			diff = append(diff, "+ "+b[j])
+			j++
+		default:
+			diff = append(diff, "- "+a[i])
+			i++
+		}
+	}
+	return diff
+}
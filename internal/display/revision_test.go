@@ -0,0 +1,79 @@
+package display
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []string
+	}{
+		{"相同", []string{"a", "b"}, []string{"a", "b"}, nil},
+		{"新增", []string{"a"}, []string{"a", "b"}, []string{"+ b"}},
+		{"删除", []string{"a", "b"}, []string{"b"}, []string{"- a"}},
+		{"修改", []string{"a", "b", "c"}, []string{"a", "x", "c"}, []string{"+ x", "- b"}},
+		{"原为空", nil, []string{"a", "b"}, []string{"+ a", "+ b"}},
+		{"改为空", []string{"a", "b"}, nil, []string{"- a", "- b"}},
+		{"保留公共行", []string{"1", "2", "3", "4"}, []string{"0", "1", "3", "4", "5"}, []string{"+ 0", "- 2", "+ 5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiffLines(tt.a, tt.b))
+		})
+	}
+}
+
+func TestRevisionsText(t *testing.T) {
+	created := time.Date(2025, 3, 1, 8, 0, 0, 0, time.Local)
+	var f *Formatter // 默认配置（中文）
+
+	t.Run("无版本", func(t *testing.T) {
+		assert.Equal(t, "任务 7 暂无摘要版本记录", f.RevisionsText(7, nil))
+	})
+
+	t.Run("按配置的语言输出", func(t *testing.T) {
+		en, err := NewFormatter(&config.Display{Locale: "en"})
+		require.NoError(t, err)
+		revisions := []*ent.SummaryRevision{{Content: "topic", Source: summaryrevision.SourceEdited, CreateTime: created}}
+		assert.Equal(t, "📝 Task 7 summary revisions: 1\n\n#1 03-01 08:00 edited, not sent, 5 characters\n", en.RevisionsText(7, revisions))
+	})
+
+	t.Run("逐版本列出差异", func(t *testing.T) {
+		revisions := []*ent.SummaryRevision{
+			{Content: "话题一\n话题二", Source: summaryrevision.SourceGenerated, CreateTime: created},
+			{Content: "话题一\n话题三", Source: summaryrevision.SourceEdited, CreateTime: created.Add(time.Hour), SentAt: created.Add(2 * time.Hour)},
+			{Content: "话题一\n话题三", Source: summaryrevision.SourceEdited, CreateTime: created.Add(3 * time.Hour)},
+		}
+		want := "📝 任务 7 共 3 个摘要版本\n" +
+			"\n#1 03-01 08:00 生成，未发送，7 字\n" +
+			"\n#2 03-01 09:00 人工修改，已发送于 03-01 10:00，7 字\n+ 话题三\n- 话题二\n" +
+			"\n#3 03-01 11:00 人工修改，未发送，7 字\n与上一版本相同\n"
+		assert.Equal(t, want, f.RevisionsText(7, revisions))
+	})
+
+	t.Run("差异过多时截断", func(t *testing.T) {
+		var lines []string
+		for i := range maxRevisionDiffLines + 5 {
+			lines = append(lines, fmt.Sprintf("第 %d 行", i))
+		}
+		revisions := []*ent.SummaryRevision{
+			{Content: "", Source: summaryrevision.SourceGenerated, CreateTime: created},
+			{Content: strings.Join(lines, "\n"), Source: summaryrevision.SourceEdited, CreateTime: created},
+		}
+		text := f.RevisionsText(7, revisions)
+		assert.Contains(t, text, fmt.Sprintf("+ 第 %d 行\n", maxRevisionDiffLines-1))
+		assert.NotContains(t, text, fmt.Sprintf("+ 第 %d 行\n", maxRevisionDiffLines))
+		assert.True(t, strings.HasSuffix(text, "…（另有 6 行差异）\n"), "空行被删除也计入差异")
+	})
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+)
+
+// BlackoutCreate is the builder for creating a Blackout entity.
+type BlackoutCreate struct {
+	config
+	mutation *BlackoutMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *BlackoutCreate) SetCreateTime(v time.Time) *BlackoutCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *BlackoutCreate) SetNillableCreateTime(v *time.Time) *BlackoutCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *BlackoutCreate) SetUpdateTime(v time.Time) *BlackoutCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *BlackoutCreate) SetNillableUpdateTime(v *time.Time) *BlackoutCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *BlackoutCreate) SetChatID(v int64) *BlackoutCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *BlackoutCreate) SetStartTime(v time.Time) *BlackoutCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *BlackoutCreate) SetEndTime(v time.Time) *BlackoutCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_c *BlackoutCreate) SetOperatorID(v int64) *BlackoutCreate {
+	_c.mutation.SetOperatorID(v)
+	return _c
+}
+
+// Mutation returns the BlackoutMutation object of the builder.
+func (_c *BlackoutCreate) Mutation() *BlackoutMutation {
+	return _c.mutation
+}
+
+// Save creates the Blackout in the database.
+func (_c *BlackoutCreate) Save(ctx context.Context) (*Blackout, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlackoutCreate) SaveX(ctx context.Context) *Blackout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlackoutCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlackoutCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BlackoutCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := blackout.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := blackout.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlackoutCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Blackout.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Blackout.update_time"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "Blackout.chat_id"`)}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "Blackout.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "Blackout.end_time"`)}
+	}
+	if _, ok := _c.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "Blackout.operator_id"`)}
+	}
+	return nil
+}
+
+func (_c *BlackoutCreate) sqlSave(ctx context.Context) (*Blackout, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlackoutCreate) createSpec() (*Blackout, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Blackout{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(blackout.Table, sqlgraph.NewFieldSpec(blackout.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(blackout.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(blackout.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(blackout.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(blackout.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(blackout.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.OperatorID(); ok {
+		_spec.SetField(blackout.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = value
+	}
+	return _node, _spec
+}
+
+// BlackoutCreateBulk is the builder for creating many Blackout entities in bulk.
+type BlackoutCreateBulk struct {
+	config
+	err      error
+	builders []*BlackoutCreate
+}
+
+// Save creates the Blackout entities in the database.
+func (_c *BlackoutCreateBulk) Save(ctx context.Context) ([]*Blackout, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Blackout, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlackoutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlackoutCreateBulk) SaveX(ctx context.Context) []*Blackout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlackoutCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlackoutCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
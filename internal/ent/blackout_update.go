@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+)
+
+// BlackoutUpdate is the builder for updating Blackout entities.
+type BlackoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlackoutMutation
+}
+
+// Where appends a list predicates to the BlackoutUpdate builder.
+func (_u *BlackoutUpdate) Where(ps ...predicate.Blackout) *BlackoutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BlackoutUpdate) SetUpdateTime(v time.Time) *BlackoutUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *BlackoutUpdate) SetChatID(v int64) *BlackoutUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *BlackoutUpdate) SetNillableChatID(v *int64) *BlackoutUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *BlackoutUpdate) AddChatID(v int64) *BlackoutUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *BlackoutUpdate) SetStartTime(v time.Time) *BlackoutUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *BlackoutUpdate) SetNillableStartTime(v *time.Time) *BlackoutUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *BlackoutUpdate) SetEndTime(v time.Time) *BlackoutUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *BlackoutUpdate) SetNillableEndTime(v *time.Time) *BlackoutUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *BlackoutUpdate) SetOperatorID(v int64) *BlackoutUpdate {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *BlackoutUpdate) SetNillableOperatorID(v *int64) *BlackoutUpdate {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *BlackoutUpdate) AddOperatorID(v int64) *BlackoutUpdate {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// Mutation returns the BlackoutMutation object of the builder.
+func (_u *BlackoutUpdate) Mutation() *BlackoutMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlackoutUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlackoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlackoutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlackoutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BlackoutUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := blackout.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *BlackoutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blackout.Table, blackout.Columns, sqlgraph.NewFieldSpec(blackout.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(blackout.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(blackout.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(blackout.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(blackout.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(blackout.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(blackout.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(blackout.FieldOperatorID, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blackout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlackoutUpdateOne is the builder for updating a single Blackout entity.
+type BlackoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlackoutMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BlackoutUpdateOne) SetUpdateTime(v time.Time) *BlackoutUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *BlackoutUpdateOne) SetChatID(v int64) *BlackoutUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *BlackoutUpdateOne) SetNillableChatID(v *int64) *BlackoutUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *BlackoutUpdateOne) AddChatID(v int64) *BlackoutUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *BlackoutUpdateOne) SetStartTime(v time.Time) *BlackoutUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *BlackoutUpdateOne) SetNillableStartTime(v *time.Time) *BlackoutUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *BlackoutUpdateOne) SetEndTime(v time.Time) *BlackoutUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *BlackoutUpdateOne) SetNillableEndTime(v *time.Time) *BlackoutUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *BlackoutUpdateOne) SetOperatorID(v int64) *BlackoutUpdateOne {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *BlackoutUpdateOne) SetNillableOperatorID(v *int64) *BlackoutUpdateOne {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *BlackoutUpdateOne) AddOperatorID(v int64) *BlackoutUpdateOne {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// Mutation returns the BlackoutMutation object of the builder.
+func (_u *BlackoutUpdateOne) Mutation() *BlackoutMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BlackoutUpdate builder.
+func (_u *BlackoutUpdateOne) Where(ps ...predicate.Blackout) *BlackoutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlackoutUpdateOne) Select(field string, fields ...string) *BlackoutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Blackout entity.
+func (_u *BlackoutUpdateOne) Save(ctx context.Context) (*Blackout, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlackoutUpdateOne) SaveX(ctx context.Context) *Blackout {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlackoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlackoutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BlackoutUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := blackout.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *BlackoutUpdateOne) sqlSave(ctx context.Context) (_node *Blackout, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blackout.Table, blackout.Columns, sqlgraph.NewFieldSpec(blackout.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blackout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blackout.FieldID)
+		for _, f := range fields {
+			if !blackout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blackout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(blackout.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(blackout.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(blackout.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(blackout.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(blackout.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(blackout.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(blackout.FieldOperatorID, field.TypeInt64, value)
+	}
+	_node = &Blackout{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blackout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+)
+
+// Chat is the model entity for the Chat schema.
+type Chat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 群聊ID
+	ChatID int64 `json:"chat_id,omitempty"`
+	// 群聊名称
+	Title string `json:"title,omitempty"`
+	// 公开群组的用户名，如 @golang_cn；私有群组为空
+	Username string `json:"username,omitempty"`
+	// 成员数，由定期刷新任务更新；0 表示未知
+	MemberCount int `json:"member_count,omitempty"`
+	// 聊天类型：group=普通群组, supergroup=超级群组, channel=频道；为空表示未知（旧数据，刷新群聊信息后补全）
+	ChatType chat.ChatType `json:"chat_type,omitempty"`
+	// 数据收集告知状态：unknown=未告知, notified=已发送介绍消息, opted_out=群管理员已退出收集
+	Consent chat.Consent `json:"consent,omitempty"`
+	// 告知状态的最近变更时间
+	ConsentUpdatedAt time.Time `json:"consent_updated_at,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Chat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chat.FieldID, chat.FieldChatID, chat.FieldMemberCount:
+			values[i] = new(sql.NullInt64)
+		case chat.FieldTitle, chat.FieldUsername, chat.FieldChatType, chat.FieldConsent:
+			values[i] = new(sql.NullString)
+		case chat.FieldCreateTime, chat.FieldUpdateTime, chat.FieldConsentUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Chat fields.
+func (_m *Chat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chat.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case chat.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case chat.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case chat.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case chat.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case chat.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				_m.Username = value.String
+			}
+		case chat.FieldMemberCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field member_count", values[i])
+			} else if value.Valid {
+				_m.MemberCount = int(value.Int64)
+			}
+		case chat.FieldChatType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_type", values[i])
+			} else if value.Valid {
+				_m.ChatType = chat.ChatType(value.String)
+			}
+		case chat.FieldConsent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field consent", values[i])
+			} else if value.Valid {
+				_m.Consent = chat.Consent(value.String)
+			}
+		case chat.FieldConsentUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field consent_updated_at", values[i])
+			} else if value.Valid {
+				_m.ConsentUpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Chat.
+// This includes values selected through modifiers, order, etc.
+func (_m *Chat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Chat.
+// Note that you need to call Chat.Unwrap() before calling this method if this Chat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Chat) Update() *ChatUpdateOne {
+	return NewChatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Chat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Chat) Unwrap() *Chat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Chat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Chat) String() string {
+	var builder strings.Builder
+	builder.WriteString("Chat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(_m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("member_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MemberCount))
+	builder.WriteString(", ")
+	builder.WriteString("chat_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatType))
+	builder.WriteString(", ")
+	builder.WriteString("consent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Consent))
+	builder.WriteString(", ")
+	builder.WriteString("consent_updated_at=")
+	builder.WriteString(_m.ConsentUpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Chats is a parsable slice of Chat.
+type Chats []*Chat
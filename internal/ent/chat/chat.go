@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chat
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the chat type in the database.
+	Label = "chat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldMemberCount holds the string denoting the member_count field in the database.
+	FieldMemberCount = "member_count"
+	// FieldChatType holds the string denoting the chat_type field in the database.
+	FieldChatType = "chat_type"
+	// FieldConsent holds the string denoting the consent field in the database.
+	FieldConsent = "consent"
+	// FieldConsentUpdatedAt holds the string denoting the consent_updated_at field in the database.
+	FieldConsentUpdatedAt = "consent_updated_at"
+	// Table holds the table name of the chat in the database.
+	Table = "chats"
+)
+
+// Columns holds all SQL columns for chat fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldChatID,
+	FieldTitle,
+	FieldUsername,
+	FieldMemberCount,
+	FieldChatType,
+	FieldConsent,
+	FieldConsentUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// ChatType defines the type for the "chat_type" enum field.
+type ChatType string
+
+// ChatType values.
+const (
+	ChatTypeGroup      ChatType = "group"
+	ChatTypeSupergroup ChatType = "supergroup"
+	ChatTypeChannel    ChatType = "channel"
+)
+
+func (ct ChatType) String() string {
+	return string(ct)
+}
+
+// ChatTypeValidator is a validator for the "chat_type" field enum values. It is called by the builders before save.
+func ChatTypeValidator(ct ChatType) error {
+	switch ct {
+	case ChatTypeGroup, ChatTypeSupergroup, ChatTypeChannel:
+		return nil
+	default:
+		return fmt.Errorf("chat: invalid enum value for chat_type field: %q", ct)
+	}
+}
+
+// Consent defines the type for the "consent" enum field.
+type Consent string
+
+// ConsentUnknown is the default value of the Consent enum.
+const DefaultConsent = ConsentUnknown
+
+// Consent values.
+const (
+	ConsentUnknown  Consent = "unknown"
+	ConsentNotified Consent = "notified"
+	ConsentOptedOut Consent = "opted_out"
+)
+
+func (c Consent) String() string {
+	return string(c)
+}
+
+// ConsentValidator is a validator for the "consent" field enum values. It is called by the builders before save.
+func ConsentValidator(c Consent) error {
+	switch c {
+	case ConsentUnknown, ConsentNotified, ConsentOptedOut:
+		return nil
+	default:
+		return fmt.Errorf("chat: invalid enum value for consent field: %q", c)
+	}
+}
+
+// OrderOption defines the ordering options for the Chat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByMemberCount orders the results by the member_count field.
+func ByMemberCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemberCount, opts...).ToFunc()
+}
+
+// ByChatType orders the results by the chat_type field.
+func ByChatType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatType, opts...).ToFunc()
+}
+
+// ByConsent orders the results by the consent field.
+func ByConsent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsent, opts...).ToFunc()
+}
+
+// ByConsentUpdatedAt orders the results by the consent_updated_at field.
+func ByConsentUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsentUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldChatID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldTitle, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldUsername, v))
+}
+
+// MemberCount applies equality check predicate on the "member_count" field. It's identical to MemberCountEQ.
+func MemberCount(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldMemberCount, v))
+}
+
+// ConsentUpdatedAt applies equality check predicate on the "consent_updated_at" field. It's identical to ConsentUpdatedAtEQ.
+func ConsentUpdatedAt(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldConsentUpdatedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...int64) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...int64) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v int64) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldChatID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameIsNil applies the IsNil predicate on the "username" field.
+func UsernameIsNil() predicate.Chat {
+	return predicate.Chat(sql.FieldIsNull(FieldUsername))
+}
+
+// UsernameNotNil applies the NotNil predicate on the "username" field.
+func UsernameNotNil() predicate.Chat {
+	return predicate.Chat(sql.FieldNotNull(FieldUsername))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.Chat {
+	return predicate.Chat(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// MemberCountEQ applies the EQ predicate on the "member_count" field.
+func MemberCountEQ(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldMemberCount, v))
+}
+
+// MemberCountNEQ applies the NEQ predicate on the "member_count" field.
+func MemberCountNEQ(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldMemberCount, v))
+}
+
+// MemberCountIn applies the In predicate on the "member_count" field.
+func MemberCountIn(vs ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldMemberCount, vs...))
+}
+
+// MemberCountNotIn applies the NotIn predicate on the "member_count" field.
+func MemberCountNotIn(vs ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldMemberCount, vs...))
+}
+
+// MemberCountGT applies the GT predicate on the "member_count" field.
+func MemberCountGT(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldMemberCount, v))
+}
+
+// MemberCountGTE applies the GTE predicate on the "member_count" field.
+func MemberCountGTE(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldMemberCount, v))
+}
+
+// MemberCountLT applies the LT predicate on the "member_count" field.
+func MemberCountLT(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldMemberCount, v))
+}
+
+// MemberCountLTE applies the LTE predicate on the "member_count" field.
+func MemberCountLTE(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldMemberCount, v))
+}
+
+// MemberCountIsNil applies the IsNil predicate on the "member_count" field.
+func MemberCountIsNil() predicate.Chat {
+	return predicate.Chat(sql.FieldIsNull(FieldMemberCount))
+}
+
+// MemberCountNotNil applies the NotNil predicate on the "member_count" field.
+func MemberCountNotNil() predicate.Chat {
+	return predicate.Chat(sql.FieldNotNull(FieldMemberCount))
+}
+
+// ChatTypeEQ applies the EQ predicate on the "chat_type" field.
+func ChatTypeEQ(v ChatType) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldChatType, v))
+}
+
+// ChatTypeNEQ applies the NEQ predicate on the "chat_type" field.
+func ChatTypeNEQ(v ChatType) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldChatType, v))
+}
+
+// ChatTypeIn applies the In predicate on the "chat_type" field.
+func ChatTypeIn(vs ...ChatType) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldChatType, vs...))
+}
+
+// ChatTypeNotIn applies the NotIn predicate on the "chat_type" field.
+func ChatTypeNotIn(vs ...ChatType) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldChatType, vs...))
+}
+
+// ChatTypeIsNil applies the IsNil predicate on the "chat_type" field.
+func ChatTypeIsNil() predicate.Chat {
+	return predicate.Chat(sql.FieldIsNull(FieldChatType))
+}
+
+// ChatTypeNotNil applies the NotNil predicate on the "chat_type" field.
+func ChatTypeNotNil() predicate.Chat {
+	return predicate.Chat(sql.FieldNotNull(FieldChatType))
+}
+
+// ConsentEQ applies the EQ predicate on the "consent" field.
+func ConsentEQ(v Consent) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldConsent, v))
+}
+
+// ConsentNEQ applies the NEQ predicate on the "consent" field.
+func ConsentNEQ(v Consent) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldConsent, v))
+}
+
+// ConsentIn applies the In predicate on the "consent" field.
+func ConsentIn(vs ...Consent) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldConsent, vs...))
+}
+
+// ConsentNotIn applies the NotIn predicate on the "consent" field.
+func ConsentNotIn(vs ...Consent) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldConsent, vs...))
+}
+
+// ConsentUpdatedAtEQ applies the EQ predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtNEQ applies the NEQ predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtNEQ(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtIn applies the In predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldConsentUpdatedAt, vs...))
+}
+
+// ConsentUpdatedAtNotIn applies the NotIn predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtNotIn(vs ...time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldConsentUpdatedAt, vs...))
+}
+
+// ConsentUpdatedAtGT applies the GT predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtGT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtGTE applies the GTE predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtGTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtLT applies the LT predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtLT(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtLTE applies the LTE predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtLTE(v time.Time) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldConsentUpdatedAt, v))
+}
+
+// ConsentUpdatedAtIsNil applies the IsNil predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtIsNil() predicate.Chat {
+	return predicate.Chat(sql.FieldIsNull(FieldConsentUpdatedAt))
+}
+
+// ConsentUpdatedAtNotNil applies the NotNil predicate on the "consent_updated_at" field.
+func ConsentUpdatedAtNotNil() predicate.Chat {
+	return predicate.Chat(sql.FieldNotNull(FieldConsentUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Chat) predicate.Chat {
+	return predicate.Chat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Chat) predicate.Chat {
+	return predicate.Chat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Chat) predicate.Chat {
+	return predicate.Chat(sql.NotPredicates(p))
+}
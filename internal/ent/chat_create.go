@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+)
+
+// ChatCreate is the builder for creating a Chat entity.
+type ChatCreate struct {
+	config
+	mutation *ChatMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ChatCreate) SetCreateTime(v time.Time) *ChatCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableCreateTime(v *time.Time) *ChatCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ChatCreate) SetUpdateTime(v time.Time) *ChatCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableUpdateTime(v *time.Time) *ChatCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatCreate) SetChatID(v int64) *ChatCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ChatCreate) SetTitle(v string) *ChatCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetUsername sets the "username" field.
+func (_c *ChatCreate) SetUsername(v string) *ChatCreate {
+	_c.mutation.SetUsername(v)
+	return _c
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableUsername(v *string) *ChatCreate {
+	if v != nil {
+		_c.SetUsername(*v)
+	}
+	return _c
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_c *ChatCreate) SetMemberCount(v int) *ChatCreate {
+	_c.mutation.SetMemberCount(v)
+	return _c
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableMemberCount(v *int) *ChatCreate {
+	if v != nil {
+		_c.SetMemberCount(*v)
+	}
+	return _c
+}
+
+// SetChatType sets the "chat_type" field.
+func (_c *ChatCreate) SetChatType(v chat.ChatType) *ChatCreate {
+	_c.mutation.SetChatType(v)
+	return _c
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableChatType(v *chat.ChatType) *ChatCreate {
+	if v != nil {
+		_c.SetChatType(*v)
+	}
+	return _c
+}
+
+// SetConsent sets the "consent" field.
+func (_c *ChatCreate) SetConsent(v chat.Consent) *ChatCreate {
+	_c.mutation.SetConsent(v)
+	return _c
+}
+
+// SetNillableConsent sets the "consent" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableConsent(v *chat.Consent) *ChatCreate {
+	if v != nil {
+		_c.SetConsent(*v)
+	}
+	return _c
+}
+
+// SetConsentUpdatedAt sets the "consent_updated_at" field.
+func (_c *ChatCreate) SetConsentUpdatedAt(v time.Time) *ChatCreate {
+	_c.mutation.SetConsentUpdatedAt(v)
+	return _c
+}
+
+// SetNillableConsentUpdatedAt sets the "consent_updated_at" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableConsentUpdatedAt(v *time.Time) *ChatCreate {
+	if v != nil {
+		_c.SetConsentUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChatMutation object of the builder.
+func (_c *ChatCreate) Mutation() *ChatMutation {
+	return _c.mutation
+}
+
+// Save creates the Chat in the database.
+func (_c *ChatCreate) Save(ctx context.Context) (*Chat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatCreate) SaveX(ctx context.Context) *Chat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := chat.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := chat.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Consent(); !ok {
+		v := chat.DefaultConsent
+		_c.mutation.SetConsent(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Chat.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Chat.update_time"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "Chat.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Chat.title"`)}
+	}
+	if v, ok := _c.mutation.ChatType(); ok {
+		if err := chat.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "Chat.chat_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Consent(); !ok {
+		return &ValidationError{Name: "consent", err: errors.New(`ent: missing required field "Chat.consent"`)}
+	}
+	if v, ok := _c.mutation.Consent(); ok {
+		if err := chat.ConsentValidator(v); err != nil {
+			return &ValidationError{Name: "consent", err: fmt.Errorf(`ent: validator failed for field "Chat.consent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ChatCreate) sqlSave(ctx context.Context) (*Chat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Chat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chat.Table, sqlgraph.NewFieldSpec(chat.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(chat.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(chat.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(chat.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(chat.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Username(); ok {
+		_spec.SetField(chat.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := _c.mutation.MemberCount(); ok {
+		_spec.SetField(chat.FieldMemberCount, field.TypeInt, value)
+		_node.MemberCount = value
+	}
+	if value, ok := _c.mutation.ChatType(); ok {
+		_spec.SetField(chat.FieldChatType, field.TypeEnum, value)
+		_node.ChatType = value
+	}
+	if value, ok := _c.mutation.Consent(); ok {
+		_spec.SetField(chat.FieldConsent, field.TypeEnum, value)
+		_node.Consent = value
+	}
+	if value, ok := _c.mutation.ConsentUpdatedAt(); ok {
+		_spec.SetField(chat.FieldConsentUpdatedAt, field.TypeTime, value)
+		_node.ConsentUpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ChatCreateBulk is the builder for creating many Chat entities in bulk.
+type ChatCreateBulk struct {
+	config
+	err      error
+	builders []*ChatCreate
+}
+
+// Save creates the Chat entities in the database.
+func (_c *ChatCreateBulk) Save(ctx context.Context) ([]*Chat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Chat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatCreateBulk) SaveX(ctx context.Context) []*Chat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
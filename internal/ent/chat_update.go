@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+)
+
+// ChatUpdate is the builder for updating Chat entities.
+type ChatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMutation
+}
+
+// Where appends a list predicates to the ChatUpdate builder.
+func (_u *ChatUpdate) Where(ps ...predicate.Chat) *ChatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ChatUpdate) SetUpdateTime(v time.Time) *ChatUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatUpdate) SetChatID(v int64) *ChatUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableChatID(v *int64) *ChatUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *ChatUpdate) AddChatID(v int64) *ChatUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ChatUpdate) SetTitle(v string) *ChatUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableTitle(v *string) *ChatUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *ChatUpdate) SetUsername(v string) *ChatUpdate {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableUsername(v *string) *ChatUpdate {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *ChatUpdate) ClearUsername() *ChatUpdate {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_u *ChatUpdate) SetMemberCount(v int) *ChatUpdate {
+	_u.mutation.ResetMemberCount()
+	_u.mutation.SetMemberCount(v)
+	return _u
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableMemberCount(v *int) *ChatUpdate {
+	if v != nil {
+		_u.SetMemberCount(*v)
+	}
+	return _u
+}
+
+// AddMemberCount adds value to the "member_count" field.
+func (_u *ChatUpdate) AddMemberCount(v int) *ChatUpdate {
+	_u.mutation.AddMemberCount(v)
+	return _u
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (_u *ChatUpdate) ClearMemberCount() *ChatUpdate {
+	_u.mutation.ClearMemberCount()
+	return _u
+}
+
+// SetChatType sets the "chat_type" field.
+func (_u *ChatUpdate) SetChatType(v chat.ChatType) *ChatUpdate {
+	_u.mutation.SetChatType(v)
+	return _u
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableChatType(v *chat.ChatType) *ChatUpdate {
+	if v != nil {
+		_u.SetChatType(*v)
+	}
+	return _u
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (_u *ChatUpdate) ClearChatType() *ChatUpdate {
+	_u.mutation.ClearChatType()
+	return _u
+}
+
+// SetConsent sets the "consent" field.
+func (_u *ChatUpdate) SetConsent(v chat.Consent) *ChatUpdate {
+	_u.mutation.SetConsent(v)
+	return _u
+}
+
+// SetNillableConsent sets the "consent" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableConsent(v *chat.Consent) *ChatUpdate {
+	if v != nil {
+		_u.SetConsent(*v)
+	}
+	return _u
+}
+
+// SetConsentUpdatedAt sets the "consent_updated_at" field.
+func (_u *ChatUpdate) SetConsentUpdatedAt(v time.Time) *ChatUpdate {
+	_u.mutation.SetConsentUpdatedAt(v)
+	return _u
+}
+
+// SetNillableConsentUpdatedAt sets the "consent_updated_at" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableConsentUpdatedAt(v *time.Time) *ChatUpdate {
+	if v != nil {
+		_u.SetConsentUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearConsentUpdatedAt clears the value of the "consent_updated_at" field.
+func (_u *ChatUpdate) ClearConsentUpdatedAt() *ChatUpdate {
+	_u.mutation.ClearConsentUpdatedAt()
+	return _u
+}
+
+// Mutation returns the ChatMutation object of the builder.
+func (_u *ChatUpdate) Mutation() *ChatMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := chat.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatUpdate) check() error {
+	if v, ok := _u.mutation.ChatType(); ok {
+		if err := chat.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "Chat.chat_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Consent(); ok {
+		if err := chat.ConsentValidator(v); err != nil {
+			return &ValidationError{Name: "consent", err: fmt.Errorf(`ent: validator failed for field "Chat.consent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chat.Table, chat.Columns, sqlgraph.NewFieldSpec(chat.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(chat.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chat.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(chat.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(chat.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(chat.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(chat.FieldUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.MemberCount(); ok {
+		_spec.SetField(chat.FieldMemberCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemberCount(); ok {
+		_spec.AddField(chat.FieldMemberCount, field.TypeInt, value)
+	}
+	if _u.mutation.MemberCountCleared() {
+		_spec.ClearField(chat.FieldMemberCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChatType(); ok {
+		_spec.SetField(chat.FieldChatType, field.TypeEnum, value)
+	}
+	if _u.mutation.ChatTypeCleared() {
+		_spec.ClearField(chat.FieldChatType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Consent(); ok {
+		_spec.SetField(chat.FieldConsent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ConsentUpdatedAt(); ok {
+		_spec.SetField(chat.FieldConsentUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConsentUpdatedAtCleared() {
+		_spec.ClearField(chat.FieldConsentUpdatedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatUpdateOne is the builder for updating a single Chat entity.
+type ChatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ChatUpdateOne) SetUpdateTime(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatUpdateOne) SetChatID(v int64) *ChatUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableChatID(v *int64) *ChatUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *ChatUpdateOne) AddChatID(v int64) *ChatUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ChatUpdateOne) SetTitle(v string) *ChatUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableTitle(v *string) *ChatUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetUsername sets the "username" field.
+func (_u *ChatUpdateOne) SetUsername(v string) *ChatUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableUsername(v *string) *ChatUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
+	}
+	return _u
+}
+
+// ClearUsername clears the value of the "username" field.
+func (_u *ChatUpdateOne) ClearUsername() *ChatUpdateOne {
+	_u.mutation.ClearUsername()
+	return _u
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_u *ChatUpdateOne) SetMemberCount(v int) *ChatUpdateOne {
+	_u.mutation.ResetMemberCount()
+	_u.mutation.SetMemberCount(v)
+	return _u
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableMemberCount(v *int) *ChatUpdateOne {
+	if v != nil {
+		_u.SetMemberCount(*v)
+	}
+	return _u
+}
+
+// AddMemberCount adds value to the "member_count" field.
+func (_u *ChatUpdateOne) AddMemberCount(v int) *ChatUpdateOne {
+	_u.mutation.AddMemberCount(v)
+	return _u
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (_u *ChatUpdateOne) ClearMemberCount() *ChatUpdateOne {
+	_u.mutation.ClearMemberCount()
+	return _u
+}
+
+// SetChatType sets the "chat_type" field.
+func (_u *ChatUpdateOne) SetChatType(v chat.ChatType) *ChatUpdateOne {
+	_u.mutation.SetChatType(v)
+	return _u
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableChatType(v *chat.ChatType) *ChatUpdateOne {
+	if v != nil {
+		_u.SetChatType(*v)
+	}
+	return _u
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (_u *ChatUpdateOne) ClearChatType() *ChatUpdateOne {
+	_u.mutation.ClearChatType()
+	return _u
+}
+
+// SetConsent sets the "consent" field.
+func (_u *ChatUpdateOne) SetConsent(v chat.Consent) *ChatUpdateOne {
+	_u.mutation.SetConsent(v)
+	return _u
+}
+
+// SetNillableConsent sets the "consent" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableConsent(v *chat.Consent) *ChatUpdateOne {
+	if v != nil {
+		_u.SetConsent(*v)
+	}
+	return _u
+}
+
+// SetConsentUpdatedAt sets the "consent_updated_at" field.
+func (_u *ChatUpdateOne) SetConsentUpdatedAt(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetConsentUpdatedAt(v)
+	return _u
+}
+
+// SetNillableConsentUpdatedAt sets the "consent_updated_at" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableConsentUpdatedAt(v *time.Time) *ChatUpdateOne {
+	if v != nil {
+		_u.SetConsentUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearConsentUpdatedAt clears the value of the "consent_updated_at" field.
+func (_u *ChatUpdateOne) ClearConsentUpdatedAt() *ChatUpdateOne {
+	_u.mutation.ClearConsentUpdatedAt()
+	return _u
+}
+
+// Mutation returns the ChatMutation object of the builder.
+func (_u *ChatUpdateOne) Mutation() *ChatMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ChatUpdate builder.
+func (_u *ChatUpdateOne) Where(ps ...predicate.Chat) *ChatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatUpdateOne) Select(field string, fields ...string) *ChatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Chat entity.
+func (_u *ChatUpdateOne) Save(ctx context.Context) (*Chat, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatUpdateOne) SaveX(ctx context.Context) *Chat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := chat.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatUpdateOne) check() error {
+	if v, ok := _u.mutation.ChatType(); ok {
+		if err := chat.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "Chat.chat_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Consent(); ok {
+		if err := chat.ConsentValidator(v); err != nil {
+			return &ValidationError{Name: "consent", err: fmt.Errorf(`ent: validator failed for field "Chat.consent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chat.Table, chat.Columns, sqlgraph.NewFieldSpec(chat.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Chat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chat.FieldID)
+		for _, f := range fields {
+			if !chat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(chat.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(chat.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(chat.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(chat.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(chat.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(chat.FieldUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.MemberCount(); ok {
+		_spec.SetField(chat.FieldMemberCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemberCount(); ok {
+		_spec.AddField(chat.FieldMemberCount, field.TypeInt, value)
+	}
+	if _u.mutation.MemberCountCleared() {
+		_spec.ClearField(chat.FieldMemberCount, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChatType(); ok {
+		_spec.SetField(chat.FieldChatType, field.TypeEnum, value)
+	}
+	if _u.mutation.ChatTypeCleared() {
+		_spec.ClearField(chat.FieldChatType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Consent(); ok {
+		_spec.SetField(chat.FieldConsent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ConsentUpdatedAt(); ok {
+		_spec.SetField(chat.FieldConsentUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConsentUpdatedAtCleared() {
+		_spec.ClearField(chat.FieldConsentUpdatedAt, field.TypeTime)
+	}
+	_node = &Chat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,10 +14,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
 	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
 	"github.com/fachebot/talk-trace-bot/internal/ent/message"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
 	"github.com/fachebot/talk-trace-bot/internal/ent/summary"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
 	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/ent/user"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,14 +35,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Blackout is the client for interacting with the Blackout builders.
+	Blackout *BlackoutClient
+	// Chat is the client for interacting with the Chat builders.
+	Chat *ChatClient
 	// DailyRun is the client for interacting with the DailyRun builders.
 	DailyRun *DailyRunClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// RunLog is the client for interacting with the RunLog builders.
+	RunLog *RunLogClient
+	// SentPart is the client for interacting with the SentPart builders.
+	SentPart *SentPartClient
+	// ShadowRun is the client for interacting with the ShadowRun builders.
+	ShadowRun *ShadowRunClient
+	// SharedLink is the client for interacting with the SharedLink builders.
+	SharedLink *SharedLinkClient
 	// Summary is the client for interacting with the Summary builders.
 	Summary *SummaryClient
+	// SummaryRevision is the client for interacting with the SummaryRevision builders.
+	SummaryRevision *SummaryRevisionClient
+	// SummaryView is the client for interacting with the SummaryView builders.
+	SummaryView *SummaryViewClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -44,10 +74,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Blackout = NewBlackoutClient(c.config)
+	c.Chat = NewChatClient(c.config)
 	c.DailyRun = NewDailyRunClient(c.config)
+	c.Follow = NewFollowClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.RunLog = NewRunLogClient(c.config)
+	c.SentPart = NewSentPartClient(c.config)
+	c.ShadowRun = NewShadowRunClient(c.config)
+	c.SharedLink = NewSharedLinkClient(c.config)
 	c.Summary = NewSummaryClient(c.config)
+	c.SummaryRevision = NewSummaryRevisionClient(c.config)
+	c.SummaryView = NewSummaryViewClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.User = NewUserClient(c.config)
 }
 
 type (
@@ -138,12 +178,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		DailyRun: NewDailyRunClient(cfg),
-		Message:  NewMessageClient(cfg),
-		Summary:  NewSummaryClient(cfg),
-		Task:     NewTaskClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Blackout:        NewBlackoutClient(cfg),
+		Chat:            NewChatClient(cfg),
+		DailyRun:        NewDailyRunClient(cfg),
+		Follow:          NewFollowClient(cfg),
+		Message:         NewMessageClient(cfg),
+		RunLog:          NewRunLogClient(cfg),
+		SentPart:        NewSentPartClient(cfg),
+		ShadowRun:       NewShadowRunClient(cfg),
+		SharedLink:      NewSharedLinkClient(cfg),
+		Summary:         NewSummaryClient(cfg),
+		SummaryRevision: NewSummaryRevisionClient(cfg),
+		SummaryView:     NewSummaryViewClient(cfg),
+		Task:            NewTaskClient(cfg),
+		User:            NewUserClient(cfg),
 	}, nil
 }
 
@@ -161,19 +211,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		DailyRun: NewDailyRunClient(cfg),
-		Message:  NewMessageClient(cfg),
-		Summary:  NewSummaryClient(cfg),
-		Task:     NewTaskClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Blackout:        NewBlackoutClient(cfg),
+		Chat:            NewChatClient(cfg),
+		DailyRun:        NewDailyRunClient(cfg),
+		Follow:          NewFollowClient(cfg),
+		Message:         NewMessageClient(cfg),
+		RunLog:          NewRunLogClient(cfg),
+		SentPart:        NewSentPartClient(cfg),
+		ShadowRun:       NewShadowRunClient(cfg),
+		SharedLink:      NewSharedLinkClient(cfg),
+		Summary:         NewSummaryClient(cfg),
+		SummaryRevision: NewSummaryRevisionClient(cfg),
+		SummaryView:     NewSummaryViewClient(cfg),
+		Task:            NewTaskClient(cfg),
+		User:            NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		DailyRun.
+//		Blackout.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -195,37 +255,329 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.DailyRun.Use(hooks...)
-	c.Message.Use(hooks...)
-	c.Summary.Use(hooks...)
-	c.Task.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Blackout, c.Chat, c.DailyRun, c.Follow, c.Message, c.RunLog, c.SentPart,
+		c.ShadowRun, c.SharedLink, c.Summary, c.SummaryRevision, c.SummaryView, c.Task,
+		c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.DailyRun.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
-	c.Summary.Intercept(interceptors...)
-	c.Task.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Blackout, c.Chat, c.DailyRun, c.Follow, c.Message, c.RunLog, c.SentPart,
+		c.ShadowRun, c.SharedLink, c.Summary, c.SummaryRevision, c.SummaryView, c.Task,
+		c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BlackoutMutation:
+		return c.Blackout.mutate(ctx, m)
+	case *ChatMutation:
+		return c.Chat.mutate(ctx, m)
 	case *DailyRunMutation:
 		return c.DailyRun.mutate(ctx, m)
+	case *FollowMutation:
+		return c.Follow.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *RunLogMutation:
+		return c.RunLog.mutate(ctx, m)
+	case *SentPartMutation:
+		return c.SentPart.mutate(ctx, m)
+	case *ShadowRunMutation:
+		return c.ShadowRun.mutate(ctx, m)
+	case *SharedLinkMutation:
+		return c.SharedLink.mutate(ctx, m)
 	case *SummaryMutation:
 		return c.Summary.mutate(ctx, m)
+	case *SummaryRevisionMutation:
+		return c.SummaryRevision.mutate(ctx, m)
+	case *SummaryViewMutation:
+		return c.SummaryView.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// BlackoutClient is a client for the Blackout schema.
+type BlackoutClient struct {
+	config
+}
+
+// NewBlackoutClient returns a client for the Blackout from the given config.
+func NewBlackoutClient(c config) *BlackoutClient {
+	return &BlackoutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blackout.Hooks(f(g(h())))`.
+func (c *BlackoutClient) Use(hooks ...Hook) {
+	c.hooks.Blackout = append(c.hooks.Blackout, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blackout.Intercept(f(g(h())))`.
+func (c *BlackoutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blackout = append(c.inters.Blackout, interceptors...)
+}
+
+// Create returns a builder for creating a Blackout entity.
+func (c *BlackoutClient) Create() *BlackoutCreate {
+	mutation := newBlackoutMutation(c.config, OpCreate)
+	return &BlackoutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Blackout entities.
+func (c *BlackoutClient) CreateBulk(builders ...*BlackoutCreate) *BlackoutCreateBulk {
+	return &BlackoutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlackoutClient) MapCreateBulk(slice any, setFunc func(*BlackoutCreate, int)) *BlackoutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlackoutCreateBulk{err: fmt.Errorf("calling to BlackoutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlackoutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlackoutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Blackout.
+func (c *BlackoutClient) Update() *BlackoutUpdate {
+	mutation := newBlackoutMutation(c.config, OpUpdate)
+	return &BlackoutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlackoutClient) UpdateOne(_m *Blackout) *BlackoutUpdateOne {
+	mutation := newBlackoutMutation(c.config, OpUpdateOne, withBlackout(_m))
+	return &BlackoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlackoutClient) UpdateOneID(id int) *BlackoutUpdateOne {
+	mutation := newBlackoutMutation(c.config, OpUpdateOne, withBlackoutID(id))
+	return &BlackoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Blackout.
+func (c *BlackoutClient) Delete() *BlackoutDelete {
+	mutation := newBlackoutMutation(c.config, OpDelete)
+	return &BlackoutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlackoutClient) DeleteOne(_m *Blackout) *BlackoutDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlackoutClient) DeleteOneID(id int) *BlackoutDeleteOne {
+	builder := c.Delete().Where(blackout.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlackoutDeleteOne{builder}
+}
+
+// Query returns a query builder for Blackout.
+func (c *BlackoutClient) Query() *BlackoutQuery {
+	return &BlackoutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlackout},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Blackout entity by its id.
+func (c *BlackoutClient) Get(ctx context.Context, id int) (*Blackout, error) {
+	return c.Query().Where(blackout.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlackoutClient) GetX(ctx context.Context, id int) *Blackout {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlackoutClient) Hooks() []Hook {
+	return c.hooks.Blackout
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlackoutClient) Interceptors() []Interceptor {
+	return c.inters.Blackout
+}
+
+func (c *BlackoutClient) mutate(ctx context.Context, m *BlackoutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlackoutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlackoutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlackoutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlackoutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Blackout mutation op: %q", m.Op())
+	}
+}
+
+// ChatClient is a client for the Chat schema.
+type ChatClient struct {
+	config
+}
+
+// NewChatClient returns a client for the Chat from the given config.
+func NewChatClient(c config) *ChatClient {
+	return &ChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chat.Hooks(f(g(h())))`.
+func (c *ChatClient) Use(hooks ...Hook) {
+	c.hooks.Chat = append(c.hooks.Chat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chat.Intercept(f(g(h())))`.
+func (c *ChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Chat = append(c.inters.Chat, interceptors...)
+}
+
+// Create returns a builder for creating a Chat entity.
+func (c *ChatClient) Create() *ChatCreate {
+	mutation := newChatMutation(c.config, OpCreate)
+	return &ChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Chat entities.
+func (c *ChatClient) CreateBulk(builders ...*ChatCreate) *ChatCreateBulk {
+	return &ChatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatClient) MapCreateBulk(slice any, setFunc func(*ChatCreate, int)) *ChatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatCreateBulk{err: fmt.Errorf("calling to ChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Chat.
+func (c *ChatClient) Update() *ChatUpdate {
+	mutation := newChatMutation(c.config, OpUpdate)
+	return &ChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatClient) UpdateOne(_m *Chat) *ChatUpdateOne {
+	mutation := newChatMutation(c.config, OpUpdateOne, withChat(_m))
+	return &ChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatClient) UpdateOneID(id int) *ChatUpdateOne {
+	mutation := newChatMutation(c.config, OpUpdateOne, withChatID(id))
+	return &ChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Chat.
+func (c *ChatClient) Delete() *ChatDelete {
+	mutation := newChatMutation(c.config, OpDelete)
+	return &ChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatClient) DeleteOne(_m *Chat) *ChatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatClient) DeleteOneID(id int) *ChatDeleteOne {
+	builder := c.Delete().Where(chat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatDeleteOne{builder}
+}
+
+// Query returns a query builder for Chat.
+func (c *ChatClient) Query() *ChatQuery {
+	return &ChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Chat entity by its id.
+func (c *ChatClient) Get(ctx context.Context, id int) (*Chat, error) {
+	return c.Query().Where(chat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatClient) GetX(ctx context.Context, id int) *Chat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ChatClient) Hooks() []Hook {
+	return c.hooks.Chat
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatClient) Interceptors() []Interceptor {
+	return c.inters.Chat
+}
+
+func (c *ChatClient) mutate(ctx context.Context, m *ChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Chat mutation op: %q", m.Op())
+	}
+}
+
 // DailyRunClient is a client for the DailyRun schema.
 type DailyRunClient struct {
 	config
@@ -281,52 +633,983 @@ func (c *DailyRunClient) Update() *DailyRunUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DailyRunClient) UpdateOne(_m *DailyRun) *DailyRunUpdateOne {
-	mutation := newDailyRunMutation(c.config, OpUpdateOne, withDailyRun(_m))
-	return &DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DailyRunClient) UpdateOne(_m *DailyRun) *DailyRunUpdateOne {
+	mutation := newDailyRunMutation(c.config, OpUpdateOne, withDailyRun(_m))
+	return &DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DailyRunClient) UpdateOneID(id int) *DailyRunUpdateOne {
+	mutation := newDailyRunMutation(c.config, OpUpdateOne, withDailyRunID(id))
+	return &DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DailyRun.
+func (c *DailyRunClient) Delete() *DailyRunDelete {
+	mutation := newDailyRunMutation(c.config, OpDelete)
+	return &DailyRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DailyRunClient) DeleteOne(_m *DailyRun) *DailyRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DailyRunClient) DeleteOneID(id int) *DailyRunDeleteOne {
+	builder := c.Delete().Where(dailyrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DailyRunDeleteOne{builder}
+}
+
+// Query returns a query builder for DailyRun.
+func (c *DailyRunClient) Query() *DailyRunQuery {
+	return &DailyRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDailyRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DailyRun entity by its id.
+func (c *DailyRunClient) Get(ctx context.Context, id int) (*DailyRun, error) {
+	return c.Query().Where(dailyrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DailyRunClient) GetX(ctx context.Context, id int) *DailyRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DailyRunClient) Hooks() []Hook {
+	return c.hooks.DailyRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *DailyRunClient) Interceptors() []Interceptor {
+	return c.inters.DailyRun
+}
+
+func (c *DailyRunClient) mutate(ctx context.Context, m *DailyRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DailyRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DailyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DailyRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DailyRun mutation op: %q", m.Op())
+	}
+}
+
+// FollowClient is a client for the Follow schema.
+type FollowClient struct {
+	config
+}
+
+// NewFollowClient returns a client for the Follow from the given config.
+func NewFollowClient(c config) *FollowClient {
+	return &FollowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `follow.Hooks(f(g(h())))`.
+func (c *FollowClient) Use(hooks ...Hook) {
+	c.hooks.Follow = append(c.hooks.Follow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `follow.Intercept(f(g(h())))`.
+func (c *FollowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Follow = append(c.inters.Follow, interceptors...)
+}
+
+// Create returns a builder for creating a Follow entity.
+func (c *FollowClient) Create() *FollowCreate {
+	mutation := newFollowMutation(c.config, OpCreate)
+	return &FollowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Follow entities.
+func (c *FollowClient) CreateBulk(builders ...*FollowCreate) *FollowCreateBulk {
+	return &FollowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FollowClient) MapCreateBulk(slice any, setFunc func(*FollowCreate, int)) *FollowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FollowCreateBulk{err: fmt.Errorf("calling to FollowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FollowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FollowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Follow.
+func (c *FollowClient) Update() *FollowUpdate {
+	mutation := newFollowMutation(c.config, OpUpdate)
+	return &FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FollowClient) UpdateOne(_m *Follow) *FollowUpdateOne {
+	mutation := newFollowMutation(c.config, OpUpdateOne, withFollow(_m))
+	return &FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FollowClient) UpdateOneID(id int) *FollowUpdateOne {
+	mutation := newFollowMutation(c.config, OpUpdateOne, withFollowID(id))
+	return &FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Follow.
+func (c *FollowClient) Delete() *FollowDelete {
+	mutation := newFollowMutation(c.config, OpDelete)
+	return &FollowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FollowClient) DeleteOne(_m *Follow) *FollowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FollowClient) DeleteOneID(id int) *FollowDeleteOne {
+	builder := c.Delete().Where(follow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FollowDeleteOne{builder}
+}
+
+// Query returns a query builder for Follow.
+func (c *FollowClient) Query() *FollowQuery {
+	return &FollowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFollow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Follow entity by its id.
+func (c *FollowClient) Get(ctx context.Context, id int) (*Follow, error) {
+	return c.Query().Where(follow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FollowClient) GetX(ctx context.Context, id int) *Follow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FollowClient) Hooks() []Hook {
+	return c.hooks.Follow
+}
+
+// Interceptors returns the client interceptors.
+func (c *FollowClient) Interceptors() []Interceptor {
+	return c.inters.Follow
+}
+
+func (c *FollowClient) mutate(ctx context.Context, m *FollowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FollowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FollowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FollowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FollowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Follow mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// RunLogClient is a client for the RunLog schema.
+type RunLogClient struct {
+	config
+}
+
+// NewRunLogClient returns a client for the RunLog from the given config.
+func NewRunLogClient(c config) *RunLogClient {
+	return &RunLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `runlog.Hooks(f(g(h())))`.
+func (c *RunLogClient) Use(hooks ...Hook) {
+	c.hooks.RunLog = append(c.hooks.RunLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `runlog.Intercept(f(g(h())))`.
+func (c *RunLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RunLog = append(c.inters.RunLog, interceptors...)
+}
+
+// Create returns a builder for creating a RunLog entity.
+func (c *RunLogClient) Create() *RunLogCreate {
+	mutation := newRunLogMutation(c.config, OpCreate)
+	return &RunLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RunLog entities.
+func (c *RunLogClient) CreateBulk(builders ...*RunLogCreate) *RunLogCreateBulk {
+	return &RunLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RunLogClient) MapCreateBulk(slice any, setFunc func(*RunLogCreate, int)) *RunLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RunLogCreateBulk{err: fmt.Errorf("calling to RunLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RunLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RunLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RunLog.
+func (c *RunLogClient) Update() *RunLogUpdate {
+	mutation := newRunLogMutation(c.config, OpUpdate)
+	return &RunLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RunLogClient) UpdateOne(_m *RunLog) *RunLogUpdateOne {
+	mutation := newRunLogMutation(c.config, OpUpdateOne, withRunLog(_m))
+	return &RunLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RunLogClient) UpdateOneID(id int) *RunLogUpdateOne {
+	mutation := newRunLogMutation(c.config, OpUpdateOne, withRunLogID(id))
+	return &RunLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RunLog.
+func (c *RunLogClient) Delete() *RunLogDelete {
+	mutation := newRunLogMutation(c.config, OpDelete)
+	return &RunLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RunLogClient) DeleteOne(_m *RunLog) *RunLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RunLogClient) DeleteOneID(id int) *RunLogDeleteOne {
+	builder := c.Delete().Where(runlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RunLogDeleteOne{builder}
+}
+
+// Query returns a query builder for RunLog.
+func (c *RunLogClient) Query() *RunLogQuery {
+	return &RunLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRunLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RunLog entity by its id.
+func (c *RunLogClient) Get(ctx context.Context, id int) (*RunLog, error) {
+	return c.Query().Where(runlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RunLogClient) GetX(ctx context.Context, id int) *RunLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RunLogClient) Hooks() []Hook {
+	return c.hooks.RunLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *RunLogClient) Interceptors() []Interceptor {
+	return c.inters.RunLog
+}
+
+func (c *RunLogClient) mutate(ctx context.Context, m *RunLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RunLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RunLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RunLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RunLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RunLog mutation op: %q", m.Op())
+	}
+}
+
+// SentPartClient is a client for the SentPart schema.
+type SentPartClient struct {
+	config
+}
+
+// NewSentPartClient returns a client for the SentPart from the given config.
+func NewSentPartClient(c config) *SentPartClient {
+	return &SentPartClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sentpart.Hooks(f(g(h())))`.
+func (c *SentPartClient) Use(hooks ...Hook) {
+	c.hooks.SentPart = append(c.hooks.SentPart, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sentpart.Intercept(f(g(h())))`.
+func (c *SentPartClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SentPart = append(c.inters.SentPart, interceptors...)
+}
+
+// Create returns a builder for creating a SentPart entity.
+func (c *SentPartClient) Create() *SentPartCreate {
+	mutation := newSentPartMutation(c.config, OpCreate)
+	return &SentPartCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SentPart entities.
+func (c *SentPartClient) CreateBulk(builders ...*SentPartCreate) *SentPartCreateBulk {
+	return &SentPartCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SentPartClient) MapCreateBulk(slice any, setFunc func(*SentPartCreate, int)) *SentPartCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SentPartCreateBulk{err: fmt.Errorf("calling to SentPartClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SentPartCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SentPartCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SentPart.
+func (c *SentPartClient) Update() *SentPartUpdate {
+	mutation := newSentPartMutation(c.config, OpUpdate)
+	return &SentPartUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SentPartClient) UpdateOne(_m *SentPart) *SentPartUpdateOne {
+	mutation := newSentPartMutation(c.config, OpUpdateOne, withSentPart(_m))
+	return &SentPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SentPartClient) UpdateOneID(id int) *SentPartUpdateOne {
+	mutation := newSentPartMutation(c.config, OpUpdateOne, withSentPartID(id))
+	return &SentPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SentPart.
+func (c *SentPartClient) Delete() *SentPartDelete {
+	mutation := newSentPartMutation(c.config, OpDelete)
+	return &SentPartDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SentPartClient) DeleteOne(_m *SentPart) *SentPartDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SentPartClient) DeleteOneID(id int) *SentPartDeleteOne {
+	builder := c.Delete().Where(sentpart.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SentPartDeleteOne{builder}
+}
+
+// Query returns a query builder for SentPart.
+func (c *SentPartClient) Query() *SentPartQuery {
+	return &SentPartQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSentPart},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SentPart entity by its id.
+func (c *SentPartClient) Get(ctx context.Context, id int) (*SentPart, error) {
+	return c.Query().Where(sentpart.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SentPartClient) GetX(ctx context.Context, id int) *SentPart {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SentPartClient) Hooks() []Hook {
+	return c.hooks.SentPart
+}
+
+// Interceptors returns the client interceptors.
+func (c *SentPartClient) Interceptors() []Interceptor {
+	return c.inters.SentPart
+}
+
+func (c *SentPartClient) mutate(ctx context.Context, m *SentPartMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SentPartCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SentPartUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SentPartUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SentPartDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SentPart mutation op: %q", m.Op())
+	}
+}
+
+// ShadowRunClient is a client for the ShadowRun schema.
+type ShadowRunClient struct {
+	config
+}
+
+// NewShadowRunClient returns a client for the ShadowRun from the given config.
+func NewShadowRunClient(c config) *ShadowRunClient {
+	return &ShadowRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shadowrun.Hooks(f(g(h())))`.
+func (c *ShadowRunClient) Use(hooks ...Hook) {
+	c.hooks.ShadowRun = append(c.hooks.ShadowRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shadowrun.Intercept(f(g(h())))`.
+func (c *ShadowRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShadowRun = append(c.inters.ShadowRun, interceptors...)
+}
+
+// Create returns a builder for creating a ShadowRun entity.
+func (c *ShadowRunClient) Create() *ShadowRunCreate {
+	mutation := newShadowRunMutation(c.config, OpCreate)
+	return &ShadowRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShadowRun entities.
+func (c *ShadowRunClient) CreateBulk(builders ...*ShadowRunCreate) *ShadowRunCreateBulk {
+	return &ShadowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShadowRunClient) MapCreateBulk(slice any, setFunc func(*ShadowRunCreate, int)) *ShadowRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShadowRunCreateBulk{err: fmt.Errorf("calling to ShadowRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShadowRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShadowRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShadowRun.
+func (c *ShadowRunClient) Update() *ShadowRunUpdate {
+	mutation := newShadowRunMutation(c.config, OpUpdate)
+	return &ShadowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShadowRunClient) UpdateOne(_m *ShadowRun) *ShadowRunUpdateOne {
+	mutation := newShadowRunMutation(c.config, OpUpdateOne, withShadowRun(_m))
+	return &ShadowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShadowRunClient) UpdateOneID(id int) *ShadowRunUpdateOne {
+	mutation := newShadowRunMutation(c.config, OpUpdateOne, withShadowRunID(id))
+	return &ShadowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShadowRun.
+func (c *ShadowRunClient) Delete() *ShadowRunDelete {
+	mutation := newShadowRunMutation(c.config, OpDelete)
+	return &ShadowRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShadowRunClient) DeleteOne(_m *ShadowRun) *ShadowRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShadowRunClient) DeleteOneID(id int) *ShadowRunDeleteOne {
+	builder := c.Delete().Where(shadowrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShadowRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ShadowRun.
+func (c *ShadowRunClient) Query() *ShadowRunQuery {
+	return &ShadowRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShadowRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShadowRun entity by its id.
+func (c *ShadowRunClient) Get(ctx context.Context, id int) (*ShadowRun, error) {
+	return c.Query().Where(shadowrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShadowRunClient) GetX(ctx context.Context, id int) *ShadowRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ShadowRunClient) Hooks() []Hook {
+	return c.hooks.ShadowRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShadowRunClient) Interceptors() []Interceptor {
+	return c.inters.ShadowRun
+}
+
+func (c *ShadowRunClient) mutate(ctx context.Context, m *ShadowRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShadowRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShadowRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShadowRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShadowRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShadowRun mutation op: %q", m.Op())
+	}
+}
+
+// SharedLinkClient is a client for the SharedLink schema.
+type SharedLinkClient struct {
+	config
+}
+
+// NewSharedLinkClient returns a client for the SharedLink from the given config.
+func NewSharedLinkClient(c config) *SharedLinkClient {
+	return &SharedLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sharedlink.Hooks(f(g(h())))`.
+func (c *SharedLinkClient) Use(hooks ...Hook) {
+	c.hooks.SharedLink = append(c.hooks.SharedLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sharedlink.Intercept(f(g(h())))`.
+func (c *SharedLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SharedLink = append(c.inters.SharedLink, interceptors...)
+}
+
+// Create returns a builder for creating a SharedLink entity.
+func (c *SharedLinkClient) Create() *SharedLinkCreate {
+	mutation := newSharedLinkMutation(c.config, OpCreate)
+	return &SharedLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SharedLink entities.
+func (c *SharedLinkClient) CreateBulk(builders ...*SharedLinkCreate) *SharedLinkCreateBulk {
+	return &SharedLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SharedLinkClient) MapCreateBulk(slice any, setFunc func(*SharedLinkCreate, int)) *SharedLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SharedLinkCreateBulk{err: fmt.Errorf("calling to SharedLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SharedLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SharedLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SharedLink.
+func (c *SharedLinkClient) Update() *SharedLinkUpdate {
+	mutation := newSharedLinkMutation(c.config, OpUpdate)
+	return &SharedLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SharedLinkClient) UpdateOne(_m *SharedLink) *SharedLinkUpdateOne {
+	mutation := newSharedLinkMutation(c.config, OpUpdateOne, withSharedLink(_m))
+	return &SharedLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SharedLinkClient) UpdateOneID(id int) *SharedLinkUpdateOne {
+	mutation := newSharedLinkMutation(c.config, OpUpdateOne, withSharedLinkID(id))
+	return &SharedLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SharedLink.
+func (c *SharedLinkClient) Delete() *SharedLinkDelete {
+	mutation := newSharedLinkMutation(c.config, OpDelete)
+	return &SharedLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SharedLinkClient) DeleteOne(_m *SharedLink) *SharedLinkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SharedLinkClient) DeleteOneID(id int) *SharedLinkDeleteOne {
+	builder := c.Delete().Where(sharedlink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SharedLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for SharedLink.
+func (c *SharedLinkClient) Query() *SharedLinkQuery {
+	return &SharedLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSharedLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SharedLink entity by its id.
+func (c *SharedLinkClient) Get(ctx context.Context, id int) (*SharedLink, error) {
+	return c.Query().Where(sharedlink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SharedLinkClient) GetX(ctx context.Context, id int) *SharedLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SharedLinkClient) Hooks() []Hook {
+	return c.hooks.SharedLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *SharedLinkClient) Interceptors() []Interceptor {
+	return c.inters.SharedLink
+}
+
+func (c *SharedLinkClient) mutate(ctx context.Context, m *SharedLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SharedLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SharedLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SharedLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SharedLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SharedLink mutation op: %q", m.Op())
+	}
+}
+
+// SummaryClient is a client for the Summary schema.
+type SummaryClient struct {
+	config
+}
+
+// NewSummaryClient returns a client for the Summary from the given config.
+func NewSummaryClient(c config) *SummaryClient {
+	return &SummaryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `summary.Hooks(f(g(h())))`.
+func (c *SummaryClient) Use(hooks ...Hook) {
+	c.hooks.Summary = append(c.hooks.Summary, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `summary.Intercept(f(g(h())))`.
+func (c *SummaryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Summary = append(c.inters.Summary, interceptors...)
+}
+
+// Create returns a builder for creating a Summary entity.
+func (c *SummaryClient) Create() *SummaryCreate {
+	mutation := newSummaryMutation(c.config, OpCreate)
+	return &SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Summary entities.
+func (c *SummaryClient) CreateBulk(builders ...*SummaryCreate) *SummaryCreateBulk {
+	return &SummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SummaryClient) MapCreateBulk(slice any, setFunc func(*SummaryCreate, int)) *SummaryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SummaryCreateBulk{err: fmt.Errorf("calling to SummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SummaryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SummaryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Summary.
+func (c *SummaryClient) Update() *SummaryUpdate {
+	mutation := newSummaryMutation(c.config, OpUpdate)
+	return &SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SummaryClient) UpdateOne(_m *Summary) *SummaryUpdateOne {
+	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummary(_m))
+	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DailyRunClient) UpdateOneID(id int) *DailyRunUpdateOne {
-	mutation := newDailyRunMutation(c.config, OpUpdateOne, withDailyRunID(id))
-	return &DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryClient) UpdateOneID(id int) *SummaryUpdateOne {
+	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummaryID(id))
+	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DailyRun.
-func (c *DailyRunClient) Delete() *DailyRunDelete {
-	mutation := newDailyRunMutation(c.config, OpDelete)
-	return &DailyRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Summary.
+func (c *SummaryClient) Delete() *SummaryDelete {
+	mutation := newSummaryMutation(c.config, OpDelete)
+	return &SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DailyRunClient) DeleteOne(_m *DailyRun) *DailyRunDeleteOne {
+func (c *SummaryClient) DeleteOne(_m *Summary) *SummaryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DailyRunClient) DeleteOneID(id int) *DailyRunDeleteOne {
-	builder := c.Delete().Where(dailyrun.ID(id))
+func (c *SummaryClient) DeleteOneID(id int) *SummaryDeleteOne {
+	builder := c.Delete().Where(summary.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DailyRunDeleteOne{builder}
+	return &SummaryDeleteOne{builder}
 }
 
-// Query returns a query builder for DailyRun.
-func (c *DailyRunClient) Query() *DailyRunQuery {
-	return &DailyRunQuery{
+// Query returns a query builder for Summary.
+func (c *SummaryClient) Query() *SummaryQuery {
+	return &SummaryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDailyRun},
+		ctx:    &QueryContext{Type: TypeSummary},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DailyRun entity by its id.
-func (c *DailyRunClient) Get(ctx context.Context, id int) (*DailyRun, error) {
-	return c.Query().Where(dailyrun.ID(id)).Only(ctx)
+// Get returns a Summary entity by its id.
+func (c *SummaryClient) Get(ctx context.Context, id int) (*Summary, error) {
+	return c.Query().Where(summary.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DailyRunClient) GetX(ctx context.Context, id int) *DailyRun {
+func (c *SummaryClient) GetX(ctx context.Context, id int) *Summary {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -335,131 +1618,131 @@ func (c *DailyRunClient) GetX(ctx context.Context, id int) *DailyRun {
 }
 
 // Hooks returns the client hooks.
-func (c *DailyRunClient) Hooks() []Hook {
-	return c.hooks.DailyRun
+func (c *SummaryClient) Hooks() []Hook {
+	return c.hooks.Summary
 }
 
 // Interceptors returns the client interceptors.
-func (c *DailyRunClient) Interceptors() []Interceptor {
-	return c.inters.DailyRun
+func (c *SummaryClient) Interceptors() []Interceptor {
+	return c.inters.Summary
 }
 
-func (c *DailyRunClient) mutate(ctx context.Context, m *DailyRunMutation) (Value, error) {
+func (c *SummaryClient) mutate(ctx context.Context, m *SummaryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DailyRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DailyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DailyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DailyRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DailyRun mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Summary mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// SummaryRevisionClient is a client for the SummaryRevision schema.
+type SummaryRevisionClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewSummaryRevisionClient returns a client for the SummaryRevision from the given config.
+func NewSummaryRevisionClient(c config) *SummaryRevisionClient {
+	return &SummaryRevisionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `summaryrevision.Hooks(f(g(h())))`.
+func (c *SummaryRevisionClient) Use(hooks ...Hook) {
+	c.hooks.SummaryRevision = append(c.hooks.SummaryRevision, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `summaryrevision.Intercept(f(g(h())))`.
+func (c *SummaryRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SummaryRevision = append(c.inters.SummaryRevision, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SummaryRevision entity.
+func (c *SummaryRevisionClient) Create() *SummaryRevisionCreate {
+	mutation := newSummaryRevisionMutation(c.config, OpCreate)
+	return &SummaryRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SummaryRevision entities.
+func (c *SummaryRevisionClient) CreateBulk(builders ...*SummaryRevisionCreate) *SummaryRevisionCreateBulk {
+	return &SummaryRevisionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *SummaryRevisionClient) MapCreateBulk(slice any, setFunc func(*SummaryRevisionCreate, int)) *SummaryRevisionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SummaryRevisionCreateBulk{err: fmt.Errorf("calling to SummaryRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*SummaryRevisionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &SummaryRevisionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SummaryRevision.
+func (c *SummaryRevisionClient) Update() *SummaryRevisionUpdate {
+	mutation := newSummaryRevisionMutation(c.config, OpUpdate)
+	return &SummaryRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryRevisionClient) UpdateOne(_m *SummaryRevision) *SummaryRevisionUpdateOne {
+	mutation := newSummaryRevisionMutation(c.config, OpUpdateOne, withSummaryRevision(_m))
+	return &SummaryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id int) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryRevisionClient) UpdateOneID(id int) *SummaryRevisionUpdateOne {
+	mutation := newSummaryRevisionMutation(c.config, OpUpdateOne, withSummaryRevisionID(id))
+	return &SummaryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SummaryRevision.
+func (c *SummaryRevisionClient) Delete() *SummaryRevisionDelete {
+	mutation := newSummaryRevisionMutation(c.config, OpDelete)
+	return &SummaryRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+func (c *SummaryRevisionClient) DeleteOne(_m *SummaryRevision) *SummaryRevisionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id int) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *SummaryRevisionClient) DeleteOneID(id int) *SummaryRevisionDeleteOne {
+	builder := c.Delete().Where(summaryrevision.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &SummaryRevisionDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for SummaryRevision.
+func (c *SummaryRevisionClient) Query() *SummaryRevisionQuery {
+	return &SummaryRevisionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeSummaryRevision},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id int) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a SummaryRevision entity by its id.
+func (c *SummaryRevisionClient) Get(ctx context.Context, id int) (*SummaryRevision, error) {
+	return c.Query().Where(summaryrevision.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
+func (c *SummaryRevisionClient) GetX(ctx context.Context, id int) *SummaryRevision {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -468,131 +1751,131 @@ func (c *MessageClient) GetX(ctx context.Context, id int) *Message {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *SummaryRevisionClient) Hooks() []Hook {
+	return c.hooks.SummaryRevision
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *SummaryRevisionClient) Interceptors() []Interceptor {
+	return c.inters.SummaryRevision
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *SummaryRevisionClient) mutate(ctx context.Context, m *SummaryRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SummaryRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SummaryRevision mutation op: %q", m.Op())
 	}
 }
 
-// SummaryClient is a client for the Summary schema.
-type SummaryClient struct {
+// SummaryViewClient is a client for the SummaryView schema.
+type SummaryViewClient struct {
 	config
 }
 
-// NewSummaryClient returns a client for the Summary from the given config.
-func NewSummaryClient(c config) *SummaryClient {
-	return &SummaryClient{config: c}
+// NewSummaryViewClient returns a client for the SummaryView from the given config.
+func NewSummaryViewClient(c config) *SummaryViewClient {
+	return &SummaryViewClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `summary.Hooks(f(g(h())))`.
-func (c *SummaryClient) Use(hooks ...Hook) {
-	c.hooks.Summary = append(c.hooks.Summary, hooks...)
+// A call to `Use(f, g, h)` equals to `summaryview.Hooks(f(g(h())))`.
+func (c *SummaryViewClient) Use(hooks ...Hook) {
+	c.hooks.SummaryView = append(c.hooks.SummaryView, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `summary.Intercept(f(g(h())))`.
-func (c *SummaryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Summary = append(c.inters.Summary, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `summaryview.Intercept(f(g(h())))`.
+func (c *SummaryViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SummaryView = append(c.inters.SummaryView, interceptors...)
 }
 
-// Create returns a builder for creating a Summary entity.
-func (c *SummaryClient) Create() *SummaryCreate {
-	mutation := newSummaryMutation(c.config, OpCreate)
-	return &SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SummaryView entity.
+func (c *SummaryViewClient) Create() *SummaryViewCreate {
+	mutation := newSummaryViewMutation(c.config, OpCreate)
+	return &SummaryViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Summary entities.
-func (c *SummaryClient) CreateBulk(builders ...*SummaryCreate) *SummaryCreateBulk {
-	return &SummaryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SummaryView entities.
+func (c *SummaryViewClient) CreateBulk(builders ...*SummaryViewCreate) *SummaryViewCreateBulk {
+	return &SummaryViewCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SummaryClient) MapCreateBulk(slice any, setFunc func(*SummaryCreate, int)) *SummaryCreateBulk {
+func (c *SummaryViewClient) MapCreateBulk(slice any, setFunc func(*SummaryViewCreate, int)) *SummaryViewCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SummaryCreateBulk{err: fmt.Errorf("calling to SummaryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SummaryViewCreateBulk{err: fmt.Errorf("calling to SummaryViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SummaryCreate, rv.Len())
+	builders := make([]*SummaryViewCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SummaryCreateBulk{config: c.config, builders: builders}
+	return &SummaryViewCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Summary.
-func (c *SummaryClient) Update() *SummaryUpdate {
-	mutation := newSummaryMutation(c.config, OpUpdate)
-	return &SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SummaryView.
+func (c *SummaryViewClient) Update() *SummaryViewUpdate {
+	mutation := newSummaryViewMutation(c.config, OpUpdate)
+	return &SummaryViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SummaryClient) UpdateOne(_m *Summary) *SummaryUpdateOne {
-	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummary(_m))
-	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryViewClient) UpdateOne(_m *SummaryView) *SummaryViewUpdateOne {
+	mutation := newSummaryViewMutation(c.config, OpUpdateOne, withSummaryView(_m))
+	return &SummaryViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SummaryClient) UpdateOneID(id int) *SummaryUpdateOne {
-	mutation := newSummaryMutation(c.config, OpUpdateOne, withSummaryID(id))
-	return &SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SummaryViewClient) UpdateOneID(id int) *SummaryViewUpdateOne {
+	mutation := newSummaryViewMutation(c.config, OpUpdateOne, withSummaryViewID(id))
+	return &SummaryViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Summary.
-func (c *SummaryClient) Delete() *SummaryDelete {
-	mutation := newSummaryMutation(c.config, OpDelete)
-	return &SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SummaryView.
+func (c *SummaryViewClient) Delete() *SummaryViewDelete {
+	mutation := newSummaryViewMutation(c.config, OpDelete)
+	return &SummaryViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SummaryClient) DeleteOne(_m *Summary) *SummaryDeleteOne {
+func (c *SummaryViewClient) DeleteOne(_m *SummaryView) *SummaryViewDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SummaryClient) DeleteOneID(id int) *SummaryDeleteOne {
-	builder := c.Delete().Where(summary.ID(id))
+func (c *SummaryViewClient) DeleteOneID(id int) *SummaryViewDeleteOne {
+	builder := c.Delete().Where(summaryview.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SummaryDeleteOne{builder}
+	return &SummaryViewDeleteOne{builder}
 }
 
-// Query returns a query builder for Summary.
-func (c *SummaryClient) Query() *SummaryQuery {
-	return &SummaryQuery{
+// Query returns a query builder for SummaryView.
+func (c *SummaryViewClient) Query() *SummaryViewQuery {
+	return &SummaryViewQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSummary},
+		ctx:    &QueryContext{Type: TypeSummaryView},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Summary entity by its id.
-func (c *SummaryClient) Get(ctx context.Context, id int) (*Summary, error) {
-	return c.Query().Where(summary.ID(id)).Only(ctx)
+// Get returns a SummaryView entity by its id.
+func (c *SummaryViewClient) Get(ctx context.Context, id int) (*SummaryView, error) {
+	return c.Query().Where(summaryview.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SummaryClient) GetX(ctx context.Context, id int) *Summary {
+func (c *SummaryViewClient) GetX(ctx context.Context, id int) *SummaryView {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -601,27 +1884,27 @@ func (c *SummaryClient) GetX(ctx context.Context, id int) *Summary {
 }
 
 // Hooks returns the client hooks.
-func (c *SummaryClient) Hooks() []Hook {
-	return c.hooks.Summary
+func (c *SummaryViewClient) Hooks() []Hook {
+	return c.hooks.SummaryView
 }
 
 // Interceptors returns the client interceptors.
-func (c *SummaryClient) Interceptors() []Interceptor {
-	return c.inters.Summary
+func (c *SummaryViewClient) Interceptors() []Interceptor {
+	return c.inters.SummaryView
 }
 
-func (c *SummaryClient) mutate(ctx context.Context, m *SummaryMutation) (Value, error) {
+func (c *SummaryViewClient) mutate(ctx context.Context, m *SummaryViewMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SummaryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SummaryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SummaryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SummaryViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SummaryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SummaryViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Summary mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SummaryView mutation op: %q", m.Op())
 	}
 }
 
@@ -758,12 +2041,147 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		DailyRun, Message, Summary, Task []ent.Hook
+		Blackout, Chat, DailyRun, Follow, Message, RunLog, SentPart, ShadowRun,
+		SharedLink, Summary, SummaryRevision, SummaryView, Task, User []ent.Hook
 	}
 	inters struct {
-		DailyRun, Message, Summary, Task []ent.Interceptor
+		Blackout, Chat, DailyRun, Follow, Message, RunLog, SentPart, ShadowRun,
+		SharedLink, Summary, SummaryRevision, SummaryView, Task, User []ent.Interceptor
 	}
 )
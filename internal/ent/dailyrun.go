@@ -21,6 +21,8 @@ type DailyRun struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 总结窗口名称，同一天可有多个窗口
+	Window string `json:"window,omitempty"`
 	// 日期范围开始时间
 	StartTime time.Time `json:"start_time,omitempty"`
 	// 日期范围结束时间
@@ -29,7 +31,29 @@ type DailyRun struct {
 	Status dailyrun.Status `json:"status,omitempty"`
 	// 错误信息
 	ErrorMessage string `json:"error_message,omitempty"`
-	selectValues sql.SelectValues
+	// 运行耗时（毫秒）
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// 成功处理的群组数
+	ChatsProcessed int `json:"chats_processed,omitempty"`
+	// 处理失败的群组数
+	ChatsFailed int `json:"chats_failed,omitempty"`
+	// 参与总结的消息数
+	MessagesSummarized int `json:"messages_summarized,omitempty"`
+	// LLM 输入 token 数
+	PromptTokens int `json:"prompt_tokens,omitempty"`
+	// LLM 输出 token 数
+	CompletionTokens int `json:"completion_tokens,omitempty"`
+	// LLM 总 token 数
+	TotalTokens int `json:"total_tokens,omitempty"`
+	// LLM 费用估算
+	Cost float64 `json:"cost,omitempty"`
+	// 运行结束时清理的过期消息数
+	MessagesCleaned int `json:"messages_cleaned,omitempty"`
+	// 当前持有执行租约的流程标识
+	LockOwner string `json:"lock_owner,omitempty"`
+	// 执行租约过期时间，过期后其他流程可接管
+	LockExpiresAt *time.Time `json:"lock_expires_at,omitempty"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -37,11 +61,13 @@ func (*DailyRun) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dailyrun.FieldID:
+		case dailyrun.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case dailyrun.FieldID, dailyrun.FieldDurationMs, dailyrun.FieldChatsProcessed, dailyrun.FieldChatsFailed, dailyrun.FieldMessagesSummarized, dailyrun.FieldPromptTokens, dailyrun.FieldCompletionTokens, dailyrun.FieldTotalTokens, dailyrun.FieldMessagesCleaned:
 			values[i] = new(sql.NullInt64)
-		case dailyrun.FieldStatus, dailyrun.FieldErrorMessage:
+		case dailyrun.FieldWindow, dailyrun.FieldStatus, dailyrun.FieldErrorMessage, dailyrun.FieldLockOwner:
 			values[i] = new(sql.NullString)
-		case dailyrun.FieldCreateTime, dailyrun.FieldUpdateTime, dailyrun.FieldStartTime, dailyrun.FieldEndTime:
+		case dailyrun.FieldCreateTime, dailyrun.FieldUpdateTime, dailyrun.FieldStartTime, dailyrun.FieldEndTime, dailyrun.FieldLockExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -76,6 +102,12 @@ func (_m *DailyRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case dailyrun.FieldWindow:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field window", values[i])
+			} else if value.Valid {
+				_m.Window = value.String
+			}
 		case dailyrun.FieldStartTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field start_time", values[i])
@@ -100,6 +132,73 @@ func (_m *DailyRun) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ErrorMessage = value.String
 			}
+		case dailyrun.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = value.Int64
+			}
+		case dailyrun.FieldChatsProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chats_processed", values[i])
+			} else if value.Valid {
+				_m.ChatsProcessed = int(value.Int64)
+			}
+		case dailyrun.FieldChatsFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chats_failed", values[i])
+			} else if value.Valid {
+				_m.ChatsFailed = int(value.Int64)
+			}
+		case dailyrun.FieldMessagesSummarized:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field messages_summarized", values[i])
+			} else if value.Valid {
+				_m.MessagesSummarized = int(value.Int64)
+			}
+		case dailyrun.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = int(value.Int64)
+			}
+		case dailyrun.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = int(value.Int64)
+			}
+		case dailyrun.FieldTotalTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tokens", values[i])
+			} else if value.Valid {
+				_m.TotalTokens = int(value.Int64)
+			}
+		case dailyrun.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				_m.Cost = value.Float64
+			}
+		case dailyrun.FieldMessagesCleaned:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field messages_cleaned", values[i])
+			} else if value.Valid {
+				_m.MessagesCleaned = int(value.Int64)
+			}
+		case dailyrun.FieldLockOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_owner", values[i])
+			} else if value.Valid {
+				_m.LockOwner = value.String
+			}
+		case dailyrun.FieldLockExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_expires_at", values[i])
+			} else if value.Valid {
+				_m.LockExpiresAt = new(time.Time)
+				*_m.LockExpiresAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -142,6 +241,9 @@ func (_m *DailyRun) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("window=")
+	builder.WriteString(_m.Window)
+	builder.WriteString(", ")
 	builder.WriteString("start_time=")
 	builder.WriteString(_m.StartTime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -153,6 +255,41 @@ func (_m *DailyRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("error_message=")
 	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("chats_processed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatsProcessed))
+	builder.WriteString(", ")
+	builder.WriteString("chats_failed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatsFailed))
+	builder.WriteString(", ")
+	builder.WriteString("messages_summarized=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessagesSummarized))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("total_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("messages_cleaned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessagesCleaned))
+	builder.WriteString(", ")
+	builder.WriteString("lock_owner=")
+	builder.WriteString(_m.LockOwner)
+	builder.WriteString(", ")
+	if v := _m.LockExpiresAt; v != nil {
+		builder.WriteString("lock_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -18,6 +18,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldWindow holds the string denoting the window field in the database.
+	FieldWindow = "window"
 	// FieldStartTime holds the string denoting the start_time field in the database.
 	FieldStartTime = "start_time"
 	// FieldEndTime holds the string denoting the end_time field in the database.
@@ -26,6 +28,28 @@ const (
 	FieldStatus = "status"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldChatsProcessed holds the string denoting the chats_processed field in the database.
+	FieldChatsProcessed = "chats_processed"
+	// FieldChatsFailed holds the string denoting the chats_failed field in the database.
+	FieldChatsFailed = "chats_failed"
+	// FieldMessagesSummarized holds the string denoting the messages_summarized field in the database.
+	FieldMessagesSummarized = "messages_summarized"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldTotalTokens holds the string denoting the total_tokens field in the database.
+	FieldTotalTokens = "total_tokens"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldMessagesCleaned holds the string denoting the messages_cleaned field in the database.
+	FieldMessagesCleaned = "messages_cleaned"
+	// FieldLockOwner holds the string denoting the lock_owner field in the database.
+	FieldLockOwner = "lock_owner"
+	// FieldLockExpiresAt holds the string denoting the lock_expires_at field in the database.
+	FieldLockExpiresAt = "lock_expires_at"
 	// Table holds the table name of the dailyrun in the database.
 	Table = "daily_runs"
 )
@@ -35,10 +59,22 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldWindow,
 	FieldStartTime,
 	FieldEndTime,
 	FieldStatus,
 	FieldErrorMessage,
+	FieldDurationMs,
+	FieldChatsProcessed,
+	FieldChatsFailed,
+	FieldMessagesSummarized,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldTotalTokens,
+	FieldCost,
+	FieldMessagesCleaned,
+	FieldLockOwner,
+	FieldLockExpiresAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -58,6 +94,26 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultWindow holds the default value on creation for the "window" field.
+	DefaultWindow string
+	// DefaultDurationMs holds the default value on creation for the "duration_ms" field.
+	DefaultDurationMs int64
+	// DefaultChatsProcessed holds the default value on creation for the "chats_processed" field.
+	DefaultChatsProcessed int
+	// DefaultChatsFailed holds the default value on creation for the "chats_failed" field.
+	DefaultChatsFailed int
+	// DefaultMessagesSummarized holds the default value on creation for the "messages_summarized" field.
+	DefaultMessagesSummarized int
+	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
+	DefaultPromptTokens int
+	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
+	DefaultCompletionTokens int
+	// DefaultTotalTokens holds the default value on creation for the "total_tokens" field.
+	DefaultTotalTokens int
+	// DefaultCost holds the default value on creation for the "cost" field.
+	DefaultCost float64
+	// DefaultMessagesCleaned holds the default value on creation for the "messages_cleaned" field.
+	DefaultMessagesCleaned int
 )
 
 // Status defines the type for the "status" enum field.
@@ -106,6 +162,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByWindow orders the results by the window field.
+func ByWindow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindow, opts...).ToFunc()
+}
+
 // ByStartTime orders the results by the start_time field.
 func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
@@ -125,3 +186,58 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByChatsProcessed orders the results by the chats_processed field.
+func ByChatsProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatsProcessed, opts...).ToFunc()
+}
+
+// ByChatsFailed orders the results by the chats_failed field.
+func ByChatsFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatsFailed, opts...).ToFunc()
+}
+
+// ByMessagesSummarized orders the results by the messages_summarized field.
+func ByMessagesSummarized(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagesSummarized, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByTotalTokens orders the results by the total_tokens field.
+func ByTotalTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTokens, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByMessagesCleaned orders the results by the messages_cleaned field.
+func ByMessagesCleaned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagesCleaned, opts...).ToFunc()
+}
+
+// ByLockOwner orders the results by the lock_owner field.
+func ByLockOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockOwner, opts...).ToFunc()
+}
+
+// ByLockExpiresAt orders the results by the lock_expires_at field.
+func ByLockExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockExpiresAt, opts...).ToFunc()
+}
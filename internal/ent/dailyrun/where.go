@@ -64,6 +64,11 @@ func UpdateTime(v time.Time) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// Window applies equality check predicate on the "window" field. It's identical to WindowEQ.
+func Window(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldWindow, v))
+}
+
 // StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
 func StartTime(v time.Time) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldEQ(FieldStartTime, v))
@@ -79,6 +84,61 @@ func ErrorMessage(v string) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// ChatsProcessed applies equality check predicate on the "chats_processed" field. It's identical to ChatsProcessedEQ.
+func ChatsProcessed(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldChatsProcessed, v))
+}
+
+// ChatsFailed applies equality check predicate on the "chats_failed" field. It's identical to ChatsFailedEQ.
+func ChatsFailed(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldChatsFailed, v))
+}
+
+// MessagesSummarized applies equality check predicate on the "messages_summarized" field. It's identical to MessagesSummarizedEQ.
+func MessagesSummarized(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldMessagesSummarized, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// TotalTokens applies equality check predicate on the "total_tokens" field. It's identical to TotalTokensEQ.
+func TotalTokens(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldCost, v))
+}
+
+// MessagesCleaned applies equality check predicate on the "messages_cleaned" field. It's identical to MessagesCleanedEQ.
+func MessagesCleaned(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldMessagesCleaned, v))
+}
+
+// LockOwner applies equality check predicate on the "lock_owner" field. It's identical to LockOwnerEQ.
+func LockOwner(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldLockOwner, v))
+}
+
+// LockExpiresAt applies equality check predicate on the "lock_expires_at" field. It's identical to LockExpiresAtEQ.
+func LockExpiresAt(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldLockExpiresAt, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldEQ(FieldCreateTime, v))
@@ -159,6 +219,71 @@ func UpdateTimeLTE(v time.Time) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// WindowEQ applies the EQ predicate on the "window" field.
+func WindowEQ(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldWindow, v))
+}
+
+// WindowNEQ applies the NEQ predicate on the "window" field.
+func WindowNEQ(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldWindow, v))
+}
+
+// WindowIn applies the In predicate on the "window" field.
+func WindowIn(vs ...string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldWindow, vs...))
+}
+
+// WindowNotIn applies the NotIn predicate on the "window" field.
+func WindowNotIn(vs ...string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldWindow, vs...))
+}
+
+// WindowGT applies the GT predicate on the "window" field.
+func WindowGT(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldWindow, v))
+}
+
+// WindowGTE applies the GTE predicate on the "window" field.
+func WindowGTE(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldWindow, v))
+}
+
+// WindowLT applies the LT predicate on the "window" field.
+func WindowLT(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldWindow, v))
+}
+
+// WindowLTE applies the LTE predicate on the "window" field.
+func WindowLTE(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldWindow, v))
+}
+
+// WindowContains applies the Contains predicate on the "window" field.
+func WindowContains(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldContains(FieldWindow, v))
+}
+
+// WindowHasPrefix applies the HasPrefix predicate on the "window" field.
+func WindowHasPrefix(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldHasPrefix(FieldWindow, v))
+}
+
+// WindowHasSuffix applies the HasSuffix predicate on the "window" field.
+func WindowHasSuffix(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldHasSuffix(FieldWindow, v))
+}
+
+// WindowEqualFold applies the EqualFold predicate on the "window" field.
+func WindowEqualFold(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEqualFold(FieldWindow, v))
+}
+
+// WindowContainsFold applies the ContainsFold predicate on the "window" field.
+func WindowContainsFold(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldContainsFold(FieldWindow, v))
+}
+
 // StartTimeEQ applies the EQ predicate on the "start_time" field.
 func StartTimeEQ(v time.Time) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldEQ(FieldStartTime, v))
@@ -334,6 +459,491 @@ func ErrorMessageContainsFold(v string) predicate.DailyRun {
 	return predicate.DailyRun(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// ChatsProcessedEQ applies the EQ predicate on the "chats_processed" field.
+func ChatsProcessedEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldChatsProcessed, v))
+}
+
+// ChatsProcessedNEQ applies the NEQ predicate on the "chats_processed" field.
+func ChatsProcessedNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldChatsProcessed, v))
+}
+
+// ChatsProcessedIn applies the In predicate on the "chats_processed" field.
+func ChatsProcessedIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldChatsProcessed, vs...))
+}
+
+// ChatsProcessedNotIn applies the NotIn predicate on the "chats_processed" field.
+func ChatsProcessedNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldChatsProcessed, vs...))
+}
+
+// ChatsProcessedGT applies the GT predicate on the "chats_processed" field.
+func ChatsProcessedGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldChatsProcessed, v))
+}
+
+// ChatsProcessedGTE applies the GTE predicate on the "chats_processed" field.
+func ChatsProcessedGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldChatsProcessed, v))
+}
+
+// ChatsProcessedLT applies the LT predicate on the "chats_processed" field.
+func ChatsProcessedLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldChatsProcessed, v))
+}
+
+// ChatsProcessedLTE applies the LTE predicate on the "chats_processed" field.
+func ChatsProcessedLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldChatsProcessed, v))
+}
+
+// ChatsFailedEQ applies the EQ predicate on the "chats_failed" field.
+func ChatsFailedEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldChatsFailed, v))
+}
+
+// ChatsFailedNEQ applies the NEQ predicate on the "chats_failed" field.
+func ChatsFailedNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldChatsFailed, v))
+}
+
+// ChatsFailedIn applies the In predicate on the "chats_failed" field.
+func ChatsFailedIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldChatsFailed, vs...))
+}
+
+// ChatsFailedNotIn applies the NotIn predicate on the "chats_failed" field.
+func ChatsFailedNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldChatsFailed, vs...))
+}
+
+// ChatsFailedGT applies the GT predicate on the "chats_failed" field.
+func ChatsFailedGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldChatsFailed, v))
+}
+
+// ChatsFailedGTE applies the GTE predicate on the "chats_failed" field.
+func ChatsFailedGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldChatsFailed, v))
+}
+
+// ChatsFailedLT applies the LT predicate on the "chats_failed" field.
+func ChatsFailedLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldChatsFailed, v))
+}
+
+// ChatsFailedLTE applies the LTE predicate on the "chats_failed" field.
+func ChatsFailedLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldChatsFailed, v))
+}
+
+// MessagesSummarizedEQ applies the EQ predicate on the "messages_summarized" field.
+func MessagesSummarizedEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldMessagesSummarized, v))
+}
+
+// MessagesSummarizedNEQ applies the NEQ predicate on the "messages_summarized" field.
+func MessagesSummarizedNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldMessagesSummarized, v))
+}
+
+// MessagesSummarizedIn applies the In predicate on the "messages_summarized" field.
+func MessagesSummarizedIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldMessagesSummarized, vs...))
+}
+
+// MessagesSummarizedNotIn applies the NotIn predicate on the "messages_summarized" field.
+func MessagesSummarizedNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldMessagesSummarized, vs...))
+}
+
+// MessagesSummarizedGT applies the GT predicate on the "messages_summarized" field.
+func MessagesSummarizedGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldMessagesSummarized, v))
+}
+
+// MessagesSummarizedGTE applies the GTE predicate on the "messages_summarized" field.
+func MessagesSummarizedGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldMessagesSummarized, v))
+}
+
+// MessagesSummarizedLT applies the LT predicate on the "messages_summarized" field.
+func MessagesSummarizedLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldMessagesSummarized, v))
+}
+
+// MessagesSummarizedLTE applies the LTE predicate on the "messages_summarized" field.
+func MessagesSummarizedLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldMessagesSummarized, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// TotalTokensEQ applies the EQ predicate on the "total_tokens" field.
+func TotalTokensEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensNEQ applies the NEQ predicate on the "total_tokens" field.
+func TotalTokensNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensIn applies the In predicate on the "total_tokens" field.
+func TotalTokensIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensNotIn applies the NotIn predicate on the "total_tokens" field.
+func TotalTokensNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensGT applies the GT predicate on the "total_tokens" field.
+func TotalTokensGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldTotalTokens, v))
+}
+
+// TotalTokensGTE applies the GTE predicate on the "total_tokens" field.
+func TotalTokensGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldTotalTokens, v))
+}
+
+// TotalTokensLT applies the LT predicate on the "total_tokens" field.
+func TotalTokensLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldTotalTokens, v))
+}
+
+// TotalTokensLTE applies the LTE predicate on the "total_tokens" field.
+func TotalTokensLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldTotalTokens, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldCost, v))
+}
+
+// MessagesCleanedEQ applies the EQ predicate on the "messages_cleaned" field.
+func MessagesCleanedEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldMessagesCleaned, v))
+}
+
+// MessagesCleanedNEQ applies the NEQ predicate on the "messages_cleaned" field.
+func MessagesCleanedNEQ(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldMessagesCleaned, v))
+}
+
+// MessagesCleanedIn applies the In predicate on the "messages_cleaned" field.
+func MessagesCleanedIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldMessagesCleaned, vs...))
+}
+
+// MessagesCleanedNotIn applies the NotIn predicate on the "messages_cleaned" field.
+func MessagesCleanedNotIn(vs ...int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldMessagesCleaned, vs...))
+}
+
+// MessagesCleanedGT applies the GT predicate on the "messages_cleaned" field.
+func MessagesCleanedGT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldMessagesCleaned, v))
+}
+
+// MessagesCleanedGTE applies the GTE predicate on the "messages_cleaned" field.
+func MessagesCleanedGTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldMessagesCleaned, v))
+}
+
+// MessagesCleanedLT applies the LT predicate on the "messages_cleaned" field.
+func MessagesCleanedLT(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldMessagesCleaned, v))
+}
+
+// MessagesCleanedLTE applies the LTE predicate on the "messages_cleaned" field.
+func MessagesCleanedLTE(v int) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldMessagesCleaned, v))
+}
+
+// LockOwnerEQ applies the EQ predicate on the "lock_owner" field.
+func LockOwnerEQ(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldLockOwner, v))
+}
+
+// LockOwnerNEQ applies the NEQ predicate on the "lock_owner" field.
+func LockOwnerNEQ(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldLockOwner, v))
+}
+
+// LockOwnerIn applies the In predicate on the "lock_owner" field.
+func LockOwnerIn(vs ...string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldLockOwner, vs...))
+}
+
+// LockOwnerNotIn applies the NotIn predicate on the "lock_owner" field.
+func LockOwnerNotIn(vs ...string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldLockOwner, vs...))
+}
+
+// LockOwnerGT applies the GT predicate on the "lock_owner" field.
+func LockOwnerGT(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldLockOwner, v))
+}
+
+// LockOwnerGTE applies the GTE predicate on the "lock_owner" field.
+func LockOwnerGTE(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldLockOwner, v))
+}
+
+// LockOwnerLT applies the LT predicate on the "lock_owner" field.
+func LockOwnerLT(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldLockOwner, v))
+}
+
+// LockOwnerLTE applies the LTE predicate on the "lock_owner" field.
+func LockOwnerLTE(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldLockOwner, v))
+}
+
+// LockOwnerContains applies the Contains predicate on the "lock_owner" field.
+func LockOwnerContains(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldContains(FieldLockOwner, v))
+}
+
+// LockOwnerHasPrefix applies the HasPrefix predicate on the "lock_owner" field.
+func LockOwnerHasPrefix(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldHasPrefix(FieldLockOwner, v))
+}
+
+// LockOwnerHasSuffix applies the HasSuffix predicate on the "lock_owner" field.
+func LockOwnerHasSuffix(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldHasSuffix(FieldLockOwner, v))
+}
+
+// LockOwnerIsNil applies the IsNil predicate on the "lock_owner" field.
+func LockOwnerIsNil() predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIsNull(FieldLockOwner))
+}
+
+// LockOwnerNotNil applies the NotNil predicate on the "lock_owner" field.
+func LockOwnerNotNil() predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotNull(FieldLockOwner))
+}
+
+// LockOwnerEqualFold applies the EqualFold predicate on the "lock_owner" field.
+func LockOwnerEqualFold(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEqualFold(FieldLockOwner, v))
+}
+
+// LockOwnerContainsFold applies the ContainsFold predicate on the "lock_owner" field.
+func LockOwnerContainsFold(v string) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldContainsFold(FieldLockOwner, v))
+}
+
+// LockExpiresAtEQ applies the EQ predicate on the "lock_expires_at" field.
+func LockExpiresAtEQ(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldEQ(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtNEQ applies the NEQ predicate on the "lock_expires_at" field.
+func LockExpiresAtNEQ(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNEQ(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtIn applies the In predicate on the "lock_expires_at" field.
+func LockExpiresAtIn(vs ...time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIn(FieldLockExpiresAt, vs...))
+}
+
+// LockExpiresAtNotIn applies the NotIn predicate on the "lock_expires_at" field.
+func LockExpiresAtNotIn(vs ...time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotIn(FieldLockExpiresAt, vs...))
+}
+
+// LockExpiresAtGT applies the GT predicate on the "lock_expires_at" field.
+func LockExpiresAtGT(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGT(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtGTE applies the GTE predicate on the "lock_expires_at" field.
+func LockExpiresAtGTE(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldGTE(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtLT applies the LT predicate on the "lock_expires_at" field.
+func LockExpiresAtLT(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLT(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtLTE applies the LTE predicate on the "lock_expires_at" field.
+func LockExpiresAtLTE(v time.Time) predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldLTE(FieldLockExpiresAt, v))
+}
+
+// LockExpiresAtIsNil applies the IsNil predicate on the "lock_expires_at" field.
+func LockExpiresAtIsNil() predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldIsNull(FieldLockExpiresAt))
+}
+
+// LockExpiresAtNotNil applies the NotNil predicate on the "lock_expires_at" field.
+func LockExpiresAtNotNil() predicate.DailyRun {
+	return predicate.DailyRun(sql.FieldNotNull(FieldLockExpiresAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DailyRun) predicate.DailyRun {
 	return predicate.DailyRun(sql.AndPredicates(predicates...))
@@ -48,6 +48,20 @@ func (_c *DailyRunCreate) SetNillableUpdateTime(v *time.Time) *DailyRunCreate {
 	return _c
 }
 
+// SetWindow sets the "window" field.
+func (_c *DailyRunCreate) SetWindow(v string) *DailyRunCreate {
+	_c.mutation.SetWindow(v)
+	return _c
+}
+
+// SetNillableWindow sets the "window" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableWindow(v *string) *DailyRunCreate {
+	if v != nil {
+		_c.SetWindow(*v)
+	}
+	return _c
+}
+
 // SetStartTime sets the "start_time" field.
 func (_c *DailyRunCreate) SetStartTime(v time.Time) *DailyRunCreate {
 	_c.mutation.SetStartTime(v)
@@ -88,6 +102,160 @@ func (_c *DailyRunCreate) SetNillableErrorMessage(v *string) *DailyRunCreate {
 	return _c
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_c *DailyRunCreate) SetDurationMs(v int64) *DailyRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableDurationMs(v *int64) *DailyRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetChatsProcessed sets the "chats_processed" field.
+func (_c *DailyRunCreate) SetChatsProcessed(v int) *DailyRunCreate {
+	_c.mutation.SetChatsProcessed(v)
+	return _c
+}
+
+// SetNillableChatsProcessed sets the "chats_processed" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableChatsProcessed(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetChatsProcessed(*v)
+	}
+	return _c
+}
+
+// SetChatsFailed sets the "chats_failed" field.
+func (_c *DailyRunCreate) SetChatsFailed(v int) *DailyRunCreate {
+	_c.mutation.SetChatsFailed(v)
+	return _c
+}
+
+// SetNillableChatsFailed sets the "chats_failed" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableChatsFailed(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetChatsFailed(*v)
+	}
+	return _c
+}
+
+// SetMessagesSummarized sets the "messages_summarized" field.
+func (_c *DailyRunCreate) SetMessagesSummarized(v int) *DailyRunCreate {
+	_c.mutation.SetMessagesSummarized(v)
+	return _c
+}
+
+// SetNillableMessagesSummarized sets the "messages_summarized" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableMessagesSummarized(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetMessagesSummarized(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *DailyRunCreate) SetPromptTokens(v int) *DailyRunCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillablePromptTokens(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *DailyRunCreate) SetCompletionTokens(v int) *DailyRunCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableCompletionTokens(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *DailyRunCreate) SetTotalTokens(v int) *DailyRunCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableTotalTokens(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetCost sets the "cost" field.
+func (_c *DailyRunCreate) SetCost(v float64) *DailyRunCreate {
+	_c.mutation.SetCost(v)
+	return _c
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableCost(v *float64) *DailyRunCreate {
+	if v != nil {
+		_c.SetCost(*v)
+	}
+	return _c
+}
+
+// SetMessagesCleaned sets the "messages_cleaned" field.
+func (_c *DailyRunCreate) SetMessagesCleaned(v int) *DailyRunCreate {
+	_c.mutation.SetMessagesCleaned(v)
+	return _c
+}
+
+// SetNillableMessagesCleaned sets the "messages_cleaned" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableMessagesCleaned(v *int) *DailyRunCreate {
+	if v != nil {
+		_c.SetMessagesCleaned(*v)
+	}
+	return _c
+}
+
+// SetLockOwner sets the "lock_owner" field.
+func (_c *DailyRunCreate) SetLockOwner(v string) *DailyRunCreate {
+	_c.mutation.SetLockOwner(v)
+	return _c
+}
+
+// SetNillableLockOwner sets the "lock_owner" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableLockOwner(v *string) *DailyRunCreate {
+	if v != nil {
+		_c.SetLockOwner(*v)
+	}
+	return _c
+}
+
+// SetLockExpiresAt sets the "lock_expires_at" field.
+func (_c *DailyRunCreate) SetLockExpiresAt(v time.Time) *DailyRunCreate {
+	_c.mutation.SetLockExpiresAt(v)
+	return _c
+}
+
+// SetNillableLockExpiresAt sets the "lock_expires_at" field if the given value is not nil.
+func (_c *DailyRunCreate) SetNillableLockExpiresAt(v *time.Time) *DailyRunCreate {
+	if v != nil {
+		_c.SetLockExpiresAt(*v)
+	}
+	return _c
+}
+
 // Mutation returns the DailyRunMutation object of the builder.
 func (_c *DailyRunCreate) Mutation() *DailyRunMutation {
 	return _c.mutation
@@ -131,10 +299,50 @@ func (_c *DailyRunCreate) defaults() {
 		v := dailyrun.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.Window(); !ok {
+		v := dailyrun.DefaultWindow
+		_c.mutation.SetWindow(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := dailyrun.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := dailyrun.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.ChatsProcessed(); !ok {
+		v := dailyrun.DefaultChatsProcessed
+		_c.mutation.SetChatsProcessed(v)
+	}
+	if _, ok := _c.mutation.ChatsFailed(); !ok {
+		v := dailyrun.DefaultChatsFailed
+		_c.mutation.SetChatsFailed(v)
+	}
+	if _, ok := _c.mutation.MessagesSummarized(); !ok {
+		v := dailyrun.DefaultMessagesSummarized
+		_c.mutation.SetMessagesSummarized(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := dailyrun.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := dailyrun.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := dailyrun.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		v := dailyrun.DefaultCost
+		_c.mutation.SetCost(v)
+	}
+	if _, ok := _c.mutation.MessagesCleaned(); !ok {
+		v := dailyrun.DefaultMessagesCleaned
+		_c.mutation.SetMessagesCleaned(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -145,6 +353,9 @@ func (_c *DailyRunCreate) check() error {
 	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DailyRun.update_time"`)}
 	}
+	if _, ok := _c.mutation.Window(); !ok {
+		return &ValidationError{Name: "window", err: errors.New(`ent: missing required field "DailyRun.window"`)}
+	}
 	if _, ok := _c.mutation.StartTime(); !ok {
 		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "DailyRun.start_time"`)}
 	}
@@ -159,6 +370,33 @@ func (_c *DailyRunCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DailyRun.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "DailyRun.duration_ms"`)}
+	}
+	if _, ok := _c.mutation.ChatsProcessed(); !ok {
+		return &ValidationError{Name: "chats_processed", err: errors.New(`ent: missing required field "DailyRun.chats_processed"`)}
+	}
+	if _, ok := _c.mutation.ChatsFailed(); !ok {
+		return &ValidationError{Name: "chats_failed", err: errors.New(`ent: missing required field "DailyRun.chats_failed"`)}
+	}
+	if _, ok := _c.mutation.MessagesSummarized(); !ok {
+		return &ValidationError{Name: "messages_summarized", err: errors.New(`ent: missing required field "DailyRun.messages_summarized"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "DailyRun.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "DailyRun.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "DailyRun.total_tokens"`)}
+	}
+	if _, ok := _c.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`ent: missing required field "DailyRun.cost"`)}
+	}
+	if _, ok := _c.mutation.MessagesCleaned(); !ok {
+		return &ValidationError{Name: "messages_cleaned", err: errors.New(`ent: missing required field "DailyRun.messages_cleaned"`)}
+	}
 	return nil
 }
 
@@ -193,6 +431,10 @@ func (_c *DailyRunCreate) createSpec() (*DailyRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(dailyrun.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := _c.mutation.Window(); ok {
+		_spec.SetField(dailyrun.FieldWindow, field.TypeString, value)
+		_node.Window = value
+	}
 	if value, ok := _c.mutation.StartTime(); ok {
 		_spec.SetField(dailyrun.FieldStartTime, field.TypeTime, value)
 		_node.StartTime = value
@@ -209,6 +451,50 @@ func (_c *DailyRunCreate) createSpec() (*DailyRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(dailyrun.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = value
 	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(dailyrun.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.ChatsProcessed(); ok {
+		_spec.SetField(dailyrun.FieldChatsProcessed, field.TypeInt, value)
+		_node.ChatsProcessed = value
+	}
+	if value, ok := _c.mutation.ChatsFailed(); ok {
+		_spec.SetField(dailyrun.FieldChatsFailed, field.TypeInt, value)
+		_node.ChatsFailed = value
+	}
+	if value, ok := _c.mutation.MessagesSummarized(); ok {
+		_spec.SetField(dailyrun.FieldMessagesSummarized, field.TypeInt, value)
+		_node.MessagesSummarized = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(dailyrun.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(dailyrun.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(dailyrun.FieldTotalTokens, field.TypeInt, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.Cost(); ok {
+		_spec.SetField(dailyrun.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if value, ok := _c.mutation.MessagesCleaned(); ok {
+		_spec.SetField(dailyrun.FieldMessagesCleaned, field.TypeInt, value)
+		_node.MessagesCleaned = value
+	}
+	if value, ok := _c.mutation.LockOwner(); ok {
+		_spec.SetField(dailyrun.FieldLockOwner, field.TypeString, value)
+		_node.LockOwner = value
+	}
+	if value, ok := _c.mutation.LockExpiresAt(); ok {
+		_spec.SetField(dailyrun.FieldLockExpiresAt, field.TypeTime, value)
+		_node.LockExpiresAt = &value
+	}
 	return _node, _spec
 }
 
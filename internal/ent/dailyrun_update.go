@@ -34,6 +34,20 @@ func (_u *DailyRunUpdate) SetUpdateTime(v time.Time) *DailyRunUpdate {
 	return _u
 }
 
+// SetWindow sets the "window" field.
+func (_u *DailyRunUpdate) SetWindow(v string) *DailyRunUpdate {
+	_u.mutation.SetWindow(v)
+	return _u
+}
+
+// SetNillableWindow sets the "window" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableWindow(v *string) *DailyRunUpdate {
+	if v != nil {
+		_u.SetWindow(*v)
+	}
+	return _u
+}
+
 // SetStartTime sets the "start_time" field.
 func (_u *DailyRunUpdate) SetStartTime(v time.Time) *DailyRunUpdate {
 	_u.mutation.SetStartTime(v)
@@ -96,6 +110,235 @@ func (_u *DailyRunUpdate) ClearErrorMessage() *DailyRunUpdate {
 	return _u
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_u *DailyRunUpdate) SetDurationMs(v int64) *DailyRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableDurationMs(v *int64) *DailyRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *DailyRunUpdate) AddDurationMs(v int64) *DailyRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetChatsProcessed sets the "chats_processed" field.
+func (_u *DailyRunUpdate) SetChatsProcessed(v int) *DailyRunUpdate {
+	_u.mutation.ResetChatsProcessed()
+	_u.mutation.SetChatsProcessed(v)
+	return _u
+}
+
+// SetNillableChatsProcessed sets the "chats_processed" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableChatsProcessed(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetChatsProcessed(*v)
+	}
+	return _u
+}
+
+// AddChatsProcessed adds value to the "chats_processed" field.
+func (_u *DailyRunUpdate) AddChatsProcessed(v int) *DailyRunUpdate {
+	_u.mutation.AddChatsProcessed(v)
+	return _u
+}
+
+// SetChatsFailed sets the "chats_failed" field.
+func (_u *DailyRunUpdate) SetChatsFailed(v int) *DailyRunUpdate {
+	_u.mutation.ResetChatsFailed()
+	_u.mutation.SetChatsFailed(v)
+	return _u
+}
+
+// SetNillableChatsFailed sets the "chats_failed" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableChatsFailed(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetChatsFailed(*v)
+	}
+	return _u
+}
+
+// AddChatsFailed adds value to the "chats_failed" field.
+func (_u *DailyRunUpdate) AddChatsFailed(v int) *DailyRunUpdate {
+	_u.mutation.AddChatsFailed(v)
+	return _u
+}
+
+// SetMessagesSummarized sets the "messages_summarized" field.
+func (_u *DailyRunUpdate) SetMessagesSummarized(v int) *DailyRunUpdate {
+	_u.mutation.ResetMessagesSummarized()
+	_u.mutation.SetMessagesSummarized(v)
+	return _u
+}
+
+// SetNillableMessagesSummarized sets the "messages_summarized" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableMessagesSummarized(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetMessagesSummarized(*v)
+	}
+	return _u
+}
+
+// AddMessagesSummarized adds value to the "messages_summarized" field.
+func (_u *DailyRunUpdate) AddMessagesSummarized(v int) *DailyRunUpdate {
+	_u.mutation.AddMessagesSummarized(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *DailyRunUpdate) SetPromptTokens(v int) *DailyRunUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillablePromptTokens(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *DailyRunUpdate) AddPromptTokens(v int) *DailyRunUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *DailyRunUpdate) SetCompletionTokens(v int) *DailyRunUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableCompletionTokens(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *DailyRunUpdate) AddCompletionTokens(v int) *DailyRunUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *DailyRunUpdate) SetTotalTokens(v int) *DailyRunUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableTotalTokens(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *DailyRunUpdate) AddTotalTokens(v int) *DailyRunUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *DailyRunUpdate) SetCost(v float64) *DailyRunUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableCost(v *float64) *DailyRunUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *DailyRunUpdate) AddCost(v float64) *DailyRunUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetMessagesCleaned sets the "messages_cleaned" field.
+func (_u *DailyRunUpdate) SetMessagesCleaned(v int) *DailyRunUpdate {
+	_u.mutation.ResetMessagesCleaned()
+	_u.mutation.SetMessagesCleaned(v)
+	return _u
+}
+
+// SetNillableMessagesCleaned sets the "messages_cleaned" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableMessagesCleaned(v *int) *DailyRunUpdate {
+	if v != nil {
+		_u.SetMessagesCleaned(*v)
+	}
+	return _u
+}
+
+// AddMessagesCleaned adds value to the "messages_cleaned" field.
+func (_u *DailyRunUpdate) AddMessagesCleaned(v int) *DailyRunUpdate {
+	_u.mutation.AddMessagesCleaned(v)
+	return _u
+}
+
+// SetLockOwner sets the "lock_owner" field.
+func (_u *DailyRunUpdate) SetLockOwner(v string) *DailyRunUpdate {
+	_u.mutation.SetLockOwner(v)
+	return _u
+}
+
+// SetNillableLockOwner sets the "lock_owner" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableLockOwner(v *string) *DailyRunUpdate {
+	if v != nil {
+		_u.SetLockOwner(*v)
+	}
+	return _u
+}
+
+// ClearLockOwner clears the value of the "lock_owner" field.
+func (_u *DailyRunUpdate) ClearLockOwner() *DailyRunUpdate {
+	_u.mutation.ClearLockOwner()
+	return _u
+}
+
+// SetLockExpiresAt sets the "lock_expires_at" field.
+func (_u *DailyRunUpdate) SetLockExpiresAt(v time.Time) *DailyRunUpdate {
+	_u.mutation.SetLockExpiresAt(v)
+	return _u
+}
+
+// SetNillableLockExpiresAt sets the "lock_expires_at" field if the given value is not nil.
+func (_u *DailyRunUpdate) SetNillableLockExpiresAt(v *time.Time) *DailyRunUpdate {
+	if v != nil {
+		_u.SetLockExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLockExpiresAt clears the value of the "lock_expires_at" field.
+func (_u *DailyRunUpdate) ClearLockExpiresAt() *DailyRunUpdate {
+	_u.mutation.ClearLockExpiresAt()
+	return _u
+}
+
 // Mutation returns the DailyRunMutation object of the builder.
 func (_u *DailyRunUpdate) Mutation() *DailyRunMutation {
 	return _u.mutation
@@ -162,6 +405,9 @@ func (_u *DailyRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(dailyrun.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Window(); ok {
+		_spec.SetField(dailyrun.FieldWindow, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.StartTime(); ok {
 		_spec.SetField(dailyrun.FieldStartTime, field.TypeTime, value)
 	}
@@ -177,6 +423,72 @@ func (_u *DailyRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(dailyrun.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(dailyrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(dailyrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChatsProcessed(); ok {
+		_spec.SetField(dailyrun.FieldChatsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChatsProcessed(); ok {
+		_spec.AddField(dailyrun.FieldChatsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatsFailed(); ok {
+		_spec.SetField(dailyrun.FieldChatsFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChatsFailed(); ok {
+		_spec.AddField(dailyrun.FieldChatsFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MessagesSummarized(); ok {
+		_spec.SetField(dailyrun.FieldMessagesSummarized, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesSummarized(); ok {
+		_spec.AddField(dailyrun.FieldMessagesSummarized, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(dailyrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(dailyrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(dailyrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(dailyrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(dailyrun.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(dailyrun.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(dailyrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(dailyrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MessagesCleaned(); ok {
+		_spec.SetField(dailyrun.FieldMessagesCleaned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesCleaned(); ok {
+		_spec.AddField(dailyrun.FieldMessagesCleaned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockOwner(); ok {
+		_spec.SetField(dailyrun.FieldLockOwner, field.TypeString, value)
+	}
+	if _u.mutation.LockOwnerCleared() {
+		_spec.ClearField(dailyrun.FieldLockOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.LockExpiresAt(); ok {
+		_spec.SetField(dailyrun.FieldLockExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockExpiresAtCleared() {
+		_spec.ClearField(dailyrun.FieldLockExpiresAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{dailyrun.Label}
@@ -203,6 +515,20 @@ func (_u *DailyRunUpdateOne) SetUpdateTime(v time.Time) *DailyRunUpdateOne {
 	return _u
 }
 
+// SetWindow sets the "window" field.
+func (_u *DailyRunUpdateOne) SetWindow(v string) *DailyRunUpdateOne {
+	_u.mutation.SetWindow(v)
+	return _u
+}
+
+// SetNillableWindow sets the "window" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableWindow(v *string) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetWindow(*v)
+	}
+	return _u
+}
+
 // SetStartTime sets the "start_time" field.
 func (_u *DailyRunUpdateOne) SetStartTime(v time.Time) *DailyRunUpdateOne {
 	_u.mutation.SetStartTime(v)
@@ -265,6 +591,235 @@ func (_u *DailyRunUpdateOne) ClearErrorMessage() *DailyRunUpdateOne {
 	return _u
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_u *DailyRunUpdateOne) SetDurationMs(v int64) *DailyRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableDurationMs(v *int64) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *DailyRunUpdateOne) AddDurationMs(v int64) *DailyRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetChatsProcessed sets the "chats_processed" field.
+func (_u *DailyRunUpdateOne) SetChatsProcessed(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetChatsProcessed()
+	_u.mutation.SetChatsProcessed(v)
+	return _u
+}
+
+// SetNillableChatsProcessed sets the "chats_processed" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableChatsProcessed(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetChatsProcessed(*v)
+	}
+	return _u
+}
+
+// AddChatsProcessed adds value to the "chats_processed" field.
+func (_u *DailyRunUpdateOne) AddChatsProcessed(v int) *DailyRunUpdateOne {
+	_u.mutation.AddChatsProcessed(v)
+	return _u
+}
+
+// SetChatsFailed sets the "chats_failed" field.
+func (_u *DailyRunUpdateOne) SetChatsFailed(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetChatsFailed()
+	_u.mutation.SetChatsFailed(v)
+	return _u
+}
+
+// SetNillableChatsFailed sets the "chats_failed" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableChatsFailed(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetChatsFailed(*v)
+	}
+	return _u
+}
+
+// AddChatsFailed adds value to the "chats_failed" field.
+func (_u *DailyRunUpdateOne) AddChatsFailed(v int) *DailyRunUpdateOne {
+	_u.mutation.AddChatsFailed(v)
+	return _u
+}
+
+// SetMessagesSummarized sets the "messages_summarized" field.
+func (_u *DailyRunUpdateOne) SetMessagesSummarized(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetMessagesSummarized()
+	_u.mutation.SetMessagesSummarized(v)
+	return _u
+}
+
+// SetNillableMessagesSummarized sets the "messages_summarized" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableMessagesSummarized(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetMessagesSummarized(*v)
+	}
+	return _u
+}
+
+// AddMessagesSummarized adds value to the "messages_summarized" field.
+func (_u *DailyRunUpdateOne) AddMessagesSummarized(v int) *DailyRunUpdateOne {
+	_u.mutation.AddMessagesSummarized(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *DailyRunUpdateOne) SetPromptTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillablePromptTokens(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *DailyRunUpdateOne) AddPromptTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *DailyRunUpdateOne) SetCompletionTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableCompletionTokens(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *DailyRunUpdateOne) AddCompletionTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *DailyRunUpdateOne) SetTotalTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableTotalTokens(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *DailyRunUpdateOne) AddTotalTokens(v int) *DailyRunUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *DailyRunUpdateOne) SetCost(v float64) *DailyRunUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableCost(v *float64) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *DailyRunUpdateOne) AddCost(v float64) *DailyRunUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// SetMessagesCleaned sets the "messages_cleaned" field.
+func (_u *DailyRunUpdateOne) SetMessagesCleaned(v int) *DailyRunUpdateOne {
+	_u.mutation.ResetMessagesCleaned()
+	_u.mutation.SetMessagesCleaned(v)
+	return _u
+}
+
+// SetNillableMessagesCleaned sets the "messages_cleaned" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableMessagesCleaned(v *int) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetMessagesCleaned(*v)
+	}
+	return _u
+}
+
+// AddMessagesCleaned adds value to the "messages_cleaned" field.
+func (_u *DailyRunUpdateOne) AddMessagesCleaned(v int) *DailyRunUpdateOne {
+	_u.mutation.AddMessagesCleaned(v)
+	return _u
+}
+
+// SetLockOwner sets the "lock_owner" field.
+func (_u *DailyRunUpdateOne) SetLockOwner(v string) *DailyRunUpdateOne {
+	_u.mutation.SetLockOwner(v)
+	return _u
+}
+
+// SetNillableLockOwner sets the "lock_owner" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableLockOwner(v *string) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetLockOwner(*v)
+	}
+	return _u
+}
+
+// ClearLockOwner clears the value of the "lock_owner" field.
+func (_u *DailyRunUpdateOne) ClearLockOwner() *DailyRunUpdateOne {
+	_u.mutation.ClearLockOwner()
+	return _u
+}
+
+// SetLockExpiresAt sets the "lock_expires_at" field.
+func (_u *DailyRunUpdateOne) SetLockExpiresAt(v time.Time) *DailyRunUpdateOne {
+	_u.mutation.SetLockExpiresAt(v)
+	return _u
+}
+
+// SetNillableLockExpiresAt sets the "lock_expires_at" field if the given value is not nil.
+func (_u *DailyRunUpdateOne) SetNillableLockExpiresAt(v *time.Time) *DailyRunUpdateOne {
+	if v != nil {
+		_u.SetLockExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLockExpiresAt clears the value of the "lock_expires_at" field.
+func (_u *DailyRunUpdateOne) ClearLockExpiresAt() *DailyRunUpdateOne {
+	_u.mutation.ClearLockExpiresAt()
+	return _u
+}
+
 // Mutation returns the DailyRunMutation object of the builder.
 func (_u *DailyRunUpdateOne) Mutation() *DailyRunMutation {
 	return _u.mutation
@@ -361,6 +916,9 @@ func (_u *DailyRunUpdateOne) sqlSave(ctx context.Context) (_node *DailyRun, err
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(dailyrun.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Window(); ok {
+		_spec.SetField(dailyrun.FieldWindow, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.StartTime(); ok {
 		_spec.SetField(dailyrun.FieldStartTime, field.TypeTime, value)
 	}
@@ -376,6 +934,72 @@ func (_u *DailyRunUpdateOne) sqlSave(ctx context.Context) (_node *DailyRun, err
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(dailyrun.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(dailyrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(dailyrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChatsProcessed(); ok {
+		_spec.SetField(dailyrun.FieldChatsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChatsProcessed(); ok {
+		_spec.AddField(dailyrun.FieldChatsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatsFailed(); ok {
+		_spec.SetField(dailyrun.FieldChatsFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChatsFailed(); ok {
+		_spec.AddField(dailyrun.FieldChatsFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MessagesSummarized(); ok {
+		_spec.SetField(dailyrun.FieldMessagesSummarized, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesSummarized(); ok {
+		_spec.AddField(dailyrun.FieldMessagesSummarized, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(dailyrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(dailyrun.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(dailyrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(dailyrun.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(dailyrun.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(dailyrun.FieldTotalTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(dailyrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(dailyrun.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MessagesCleaned(); ok {
+		_spec.SetField(dailyrun.FieldMessagesCleaned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesCleaned(); ok {
+		_spec.AddField(dailyrun.FieldMessagesCleaned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockOwner(); ok {
+		_spec.SetField(dailyrun.FieldLockOwner, field.TypeString, value)
+	}
+	if _u.mutation.LockOwnerCleared() {
+		_spec.ClearField(dailyrun.FieldLockOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.LockExpiresAt(); ok {
+		_spec.SetField(dailyrun.FieldLockExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockExpiresAtCleared() {
+		_spec.ClearField(dailyrun.FieldLockExpiresAt, field.TypeTime)
+	}
 	_node = &DailyRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
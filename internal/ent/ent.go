@@ -12,10 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
 	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
 	"github.com/fachebot/talk-trace-bot/internal/ent/message"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
 	"github.com/fachebot/talk-trace-bot/internal/ent/summary"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
 	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/ent/user"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			dailyrun.Table: dailyrun.ValidColumn,
-			message.Table:  message.ValidColumn,
-			summary.Table:  summary.ValidColumn,
-			task.Table:     task.ValidColumn,
+			blackout.Table:        blackout.ValidColumn,
+			chat.Table:            chat.ValidColumn,
+			dailyrun.Table:        dailyrun.ValidColumn,
+			follow.Table:          follow.ValidColumn,
+			message.Table:         message.ValidColumn,
+			runlog.Table:          runlog.ValidColumn,
+			sentpart.Table:        sentpart.ValidColumn,
+			shadowrun.Table:       shadowrun.ValidColumn,
+			sharedlink.Table:      sharedlink.ValidColumn,
+			summary.Table:         summary.ValidColumn,
+			summaryrevision.Table: summaryrevision.ValidColumn,
+			summaryview.Table:     summaryview.ValidColumn,
+			task.Table:            task.ValidColumn,
+			user.Table:            user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+)
+
+// FollowUpdate is the builder for updating Follow entities.
+type FollowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FollowMutation
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (_u *FollowUpdate) Where(ps ...predicate.Follow) *FollowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FollowUpdate) SetUpdateTime(v time.Time) *FollowUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *FollowUpdate) SetUserID(v int64) *FollowUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *FollowUpdate) SetNillableUserID(v *int64) *FollowUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *FollowUpdate) AddUserID(v int64) *FollowUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetKeyword sets the "keyword" field.
+func (_u *FollowUpdate) SetKeyword(v string) *FollowUpdate {
+	_u.mutation.SetKeyword(v)
+	return _u
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (_u *FollowUpdate) SetNillableKeyword(v *string) *FollowUpdate {
+	if v != nil {
+		_u.SetKeyword(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (_u *FollowUpdate) Mutation() *FollowMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FollowUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FollowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FollowUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := follow.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *FollowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(follow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(follow.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(follow.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Keyword(); ok {
+		_spec.SetField(follow.FieldKeyword, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FollowUpdateOne is the builder for updating a single Follow entity.
+type FollowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FollowMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FollowUpdateOne) SetUpdateTime(v time.Time) *FollowUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *FollowUpdateOne) SetUserID(v int64) *FollowUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *FollowUpdateOne) SetNillableUserID(v *int64) *FollowUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *FollowUpdateOne) AddUserID(v int64) *FollowUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetKeyword sets the "keyword" field.
+func (_u *FollowUpdateOne) SetKeyword(v string) *FollowUpdateOne {
+	_u.mutation.SetKeyword(v)
+	return _u
+}
+
+// SetNillableKeyword sets the "keyword" field if the given value is not nil.
+func (_u *FollowUpdateOne) SetNillableKeyword(v *string) *FollowUpdateOne {
+	if v != nil {
+		_u.SetKeyword(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (_u *FollowUpdateOne) Mutation() *FollowMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (_u *FollowUpdateOne) Where(ps ...predicate.Follow) *FollowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FollowUpdateOne) Select(field string, fields ...string) *FollowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Follow entity.
+func (_u *FollowUpdateOne) Save(ctx context.Context) (*Follow, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowUpdateOne) SaveX(ctx context.Context) *Follow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FollowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FollowUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := follow.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err error) {
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Follow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, follow.FieldID)
+		for _, f := range fields {
+			if !follow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != follow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(follow.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(follow.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(follow.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Keyword(); ok {
+		_spec.SetField(follow.FieldKeyword, field.TypeString, value)
+	}
+	_node = &Follow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
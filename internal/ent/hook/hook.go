@@ -9,6 +9,30 @@ import (
 	"github.com/fachebot/talk-trace-bot/internal/ent"
 )
 
+// The BlackoutFunc type is an adapter to allow the use of ordinary
+// function as Blackout mutator.
+type BlackoutFunc func(context.Context, *ent.BlackoutMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlackoutFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlackoutMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlackoutMutation", m)
+}
+
+// The ChatFunc type is an adapter to allow the use of ordinary
+// function as Chat mutator.
+type ChatFunc func(context.Context, *ent.ChatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMutation", m)
+}
+
 // The DailyRunFunc type is an adapter to allow the use of ordinary
 // function as DailyRun mutator.
 type DailyRunFunc func(context.Context, *ent.DailyRunMutation) (ent.Value, error)
@@ -21,6 +45,18 @@ func (f DailyRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DailyRunMutation", m)
 }
 
+// The FollowFunc type is an adapter to allow the use of ordinary
+// function as Follow mutator.
+type FollowFunc func(context.Context, *ent.FollowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FollowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FollowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FollowMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *ent.MessageMutation) (ent.Value, error)
@@ -33,6 +69,54 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The RunLogFunc type is an adapter to allow the use of ordinary
+// function as RunLog mutator.
+type RunLogFunc func(context.Context, *ent.RunLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RunLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RunLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RunLogMutation", m)
+}
+
+// The SentPartFunc type is an adapter to allow the use of ordinary
+// function as SentPart mutator.
+type SentPartFunc func(context.Context, *ent.SentPartMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SentPartFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SentPartMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SentPartMutation", m)
+}
+
+// The ShadowRunFunc type is an adapter to allow the use of ordinary
+// function as ShadowRun mutator.
+type ShadowRunFunc func(context.Context, *ent.ShadowRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShadowRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShadowRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShadowRunMutation", m)
+}
+
+// The SharedLinkFunc type is an adapter to allow the use of ordinary
+// function as SharedLink mutator.
+type SharedLinkFunc func(context.Context, *ent.SharedLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SharedLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SharedLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SharedLinkMutation", m)
+}
+
 // The SummaryFunc type is an adapter to allow the use of ordinary
 // function as Summary mutator.
 type SummaryFunc func(context.Context, *ent.SummaryMutation) (ent.Value, error)
@@ -45,6 +129,30 @@ func (f SummaryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SummaryMutation", m)
 }
 
+// The SummaryRevisionFunc type is an adapter to allow the use of ordinary
+// function as SummaryRevision mutator.
+type SummaryRevisionFunc func(context.Context, *ent.SummaryRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SummaryRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SummaryRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SummaryRevisionMutation", m)
+}
+
+// The SummaryViewFunc type is an adapter to allow the use of ordinary
+// function as SummaryView mutator.
+type SummaryViewFunc func(context.Context, *ent.SummaryViewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SummaryViewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SummaryViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SummaryViewMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -57,6 +165,18 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The UserFunc type is an adapter to allow the use of ordinary
+// function as User mutator.
+type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
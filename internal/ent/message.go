@@ -21,8 +21,10 @@ type Message struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
-	// Telegram消息ID
+	// TDLib消息ID
 	MessageID int64 `json:"message_id,omitempty"`
+	// 服务器消息ID，用于生成 t.me 链接；为空表示尚未发送成功的本地消息或旧数据
+	ServerMessageID int64 `json:"server_message_id,omitempty"`
 	// 群聊ID
 	ChatID int64 `json:"chat_id,omitempty"`
 	// 发送者用户ID
@@ -33,8 +35,30 @@ type Message struct {
 	SenderUsername string `json:"sender_username,omitempty"`
 	// 消息文本内容
 	Text string `json:"text,omitempty"`
+	// zstd 压缩后的消息文本，非空时 text 为空
+	TextZstd []byte `json:"text_zstd,omitempty"`
 	// 消息发送时间
-	SentAt       time.Time `json:"sent_at,omitempty"`
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// 识别的语言代码，如 zh、en；无法识别时为空
+	Lang string `json:"lang,omitempty"`
+	// 软删除时间，非空表示已过期、等待清除任务物理删除
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 消息内容类型（对应 Ingest.ContentTypes），如 text、caption；为空表示旧数据
+	ContentType string `json:"content_type,omitempty"`
+	// 最后编辑时间，为空表示未编辑
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// 论坛话题（Topic）的消息线程ID，为空表示非话题消息或旧数据
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// 回复的同一群聊内消息的 TDLib 消息ID，为空表示未回复或旧数据
+	ReplyToMessageID int64 `json:"reply_to_message_id,omitempty"`
+	// 消息收到的回应（Reaction）总数，为空表示没有回应或旧数据
+	ReactionCount int64 `json:"reaction_count,omitempty"`
+	// 发送者类型：user 为用户，chat 为以群组或频道身份发送（匿名管理员、关联频道）；为空表示旧数据
+	SenderType string `json:"sender_type,omitempty"`
+	// 投票的问题、选项及得票数（JSON），非投票消息为空
+	Poll string `json:"poll,omitempty"`
+	// 保存该消息的 Telegram 账号的用户ID（多账号时区分来源），为空表示旧数据
+	AccountID    int64 `json:"account_id,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -43,11 +67,13 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldID, message.FieldMessageID, message.FieldChatID, message.FieldSenderID:
+		case message.FieldTextZstd:
+			values[i] = new([]byte)
+		case message.FieldID, message.FieldMessageID, message.FieldServerMessageID, message.FieldChatID, message.FieldSenderID, message.FieldMessageThreadID, message.FieldReplyToMessageID, message.FieldReactionCount, message.FieldAccountID:
 			values[i] = new(sql.NullInt64)
-		case message.FieldSenderName, message.FieldSenderUsername, message.FieldText:
+		case message.FieldSenderName, message.FieldSenderUsername, message.FieldText, message.FieldLang, message.FieldContentType, message.FieldSenderType, message.FieldPoll:
 			values[i] = new(sql.NullString)
-		case message.FieldCreateTime, message.FieldUpdateTime, message.FieldSentAt:
+		case message.FieldCreateTime, message.FieldUpdateTime, message.FieldSentAt, message.FieldDeletedAt, message.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -88,6 +114,12 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MessageID = value.Int64
 			}
+		case message.FieldServerMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field server_message_id", values[i])
+			} else if value.Valid {
+				_m.ServerMessageID = value.Int64
+			}
 		case message.FieldChatID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
@@ -118,12 +150,80 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Text = value.String
 			}
+		case message.FieldTextZstd:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field text_zstd", values[i])
+			} else if value != nil {
+				_m.TextZstd = *value
+			}
 		case message.FieldSentAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
 			} else if value.Valid {
 				_m.SentAt = value.Time
 			}
+		case message.FieldLang:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lang", values[i])
+			} else if value.Valid {
+				_m.Lang = value.String
+			}
+		case message.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case message.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = value.String
+			}
+		case message.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				_m.EditedAt = new(time.Time)
+				*_m.EditedAt = value.Time
+			}
+		case message.FieldMessageThreadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_thread_id", values[i])
+			} else if value.Valid {
+				_m.MessageThreadID = value.Int64
+			}
+		case message.FieldReplyToMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_message_id", values[i])
+			} else if value.Valid {
+				_m.ReplyToMessageID = value.Int64
+			}
+		case message.FieldReactionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reaction_count", values[i])
+			} else if value.Valid {
+				_m.ReactionCount = value.Int64
+			}
+		case message.FieldSenderType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_type", values[i])
+			} else if value.Valid {
+				_m.SenderType = value.String
+			}
+		case message.FieldPoll:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field poll", values[i])
+			} else if value.Valid {
+				_m.Poll = value.String
+			}
+		case message.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				_m.AccountID = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -169,6 +269,9 @@ func (_m *Message) String() string {
 	builder.WriteString("message_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.MessageID))
 	builder.WriteString(", ")
+	builder.WriteString("server_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ServerMessageID))
+	builder.WriteString(", ")
 	builder.WriteString("chat_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
 	builder.WriteString(", ")
@@ -184,8 +287,45 @@ func (_m *Message) String() string {
 	builder.WriteString("text=")
 	builder.WriteString(_m.Text)
 	builder.WriteString(", ")
+	builder.WriteString("text_zstd=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TextZstd))
+	builder.WriteString(", ")
 	builder.WriteString("sent_at=")
 	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("lang=")
+	builder.WriteString(_m.Lang)
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(_m.ContentType)
+	builder.WriteString(", ")
+	if v := _m.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("message_thread_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageThreadID))
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReplyToMessageID))
+	builder.WriteString(", ")
+	builder.WriteString("reaction_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReactionCount))
+	builder.WriteString(", ")
+	builder.WriteString("sender_type=")
+	builder.WriteString(_m.SenderType)
+	builder.WriteString(", ")
+	builder.WriteString("poll=")
+	builder.WriteString(_m.Poll)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
 	builder.WriteByte(')')
 	return builder.String()
 }
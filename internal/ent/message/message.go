@@ -19,6 +19,8 @@ const (
 	FieldUpdateTime = "update_time"
 	// FieldMessageID holds the string denoting the message_id field in the database.
 	FieldMessageID = "message_id"
+	// FieldServerMessageID holds the string denoting the server_message_id field in the database.
+	FieldServerMessageID = "server_message_id"
 	// FieldChatID holds the string denoting the chat_id field in the database.
 	FieldChatID = "chat_id"
 	// FieldSenderID holds the string denoting the sender_id field in the database.
@@ -29,8 +31,30 @@ const (
 	FieldSenderUsername = "sender_username"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldTextZstd holds the string denoting the text_zstd field in the database.
+	FieldTextZstd = "text_zstd"
 	// FieldSentAt holds the string denoting the sent_at field in the database.
 	FieldSentAt = "sent_at"
+	// FieldLang holds the string denoting the lang field in the database.
+	FieldLang = "lang"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
+	// FieldMessageThreadID holds the string denoting the message_thread_id field in the database.
+	FieldMessageThreadID = "message_thread_id"
+	// FieldReplyToMessageID holds the string denoting the reply_to_message_id field in the database.
+	FieldReplyToMessageID = "reply_to_message_id"
+	// FieldReactionCount holds the string denoting the reaction_count field in the database.
+	FieldReactionCount = "reaction_count"
+	// FieldSenderType holds the string denoting the sender_type field in the database.
+	FieldSenderType = "sender_type"
+	// FieldPoll holds the string denoting the poll field in the database.
+	FieldPoll = "poll"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
 	// Table holds the table name of the message in the database.
 	Table = "messages"
 )
@@ -41,12 +65,24 @@ var Columns = []string{
 	FieldCreateTime,
 	FieldUpdateTime,
 	FieldMessageID,
+	FieldServerMessageID,
 	FieldChatID,
 	FieldSenderID,
 	FieldSenderName,
 	FieldSenderUsername,
 	FieldText,
+	FieldTextZstd,
 	FieldSentAt,
+	FieldLang,
+	FieldDeletedAt,
+	FieldContentType,
+	FieldEditedAt,
+	FieldMessageThreadID,
+	FieldReplyToMessageID,
+	FieldReactionCount,
+	FieldSenderType,
+	FieldPoll,
+	FieldAccountID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -91,6 +127,11 @@ func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
 }
 
+// ByServerMessageID orders the results by the server_message_id field.
+func ByServerMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerMessageID, opts...).ToFunc()
+}
+
 // ByChatID orders the results by the chat_id field.
 func ByChatID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChatID, opts...).ToFunc()
@@ -120,3 +161,53 @@ func ByText(opts ...sql.OrderTermOption) OrderOption {
 func BySentAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
 }
+
+// ByLang orders the results by the lang field.
+func ByLang(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLang, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
+// ByMessageThreadID orders the results by the message_thread_id field.
+func ByMessageThreadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageThreadID, opts...).ToFunc()
+}
+
+// ByReplyToMessageID orders the results by the reply_to_message_id field.
+func ByReplyToMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToMessageID, opts...).ToFunc()
+}
+
+// ByReactionCount orders the results by the reaction_count field.
+func ByReactionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReactionCount, opts...).ToFunc()
+}
+
+// BySenderType orders the results by the sender_type field.
+func BySenderType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderType, opts...).ToFunc()
+}
+
+// ByPoll orders the results by the poll field.
+func ByPoll(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoll, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
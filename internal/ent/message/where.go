@@ -69,6 +69,11 @@ func MessageID(v int64) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldMessageID, v))
 }
 
+// ServerMessageID applies equality check predicate on the "server_message_id" field. It's identical to ServerMessageIDEQ.
+func ServerMessageID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldServerMessageID, v))
+}
+
 // ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
 func ChatID(v int64) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldChatID, v))
@@ -94,11 +99,66 @@ func Text(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldText, v))
 }
 
+// TextZstd applies equality check predicate on the "text_zstd" field. It's identical to TextZstdEQ.
+func TextZstd(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTextZstd, v))
+}
+
 // SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
 func SentAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldSentAt, v))
 }
 
+// Lang applies equality check predicate on the "lang" field. It's identical to LangEQ.
+func Lang(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldLang, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContentType, v))
+}
+
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// MessageThreadID applies equality check predicate on the "message_thread_id" field. It's identical to MessageThreadIDEQ.
+func MessageThreadID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldMessageThreadID, v))
+}
+
+// ReplyToMessageID applies equality check predicate on the "reply_to_message_id" field. It's identical to ReplyToMessageIDEQ.
+func ReplyToMessageID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMessageID, v))
+}
+
+// ReactionCount applies equality check predicate on the "reaction_count" field. It's identical to ReactionCountEQ.
+func ReactionCount(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReactionCount, v))
+}
+
+// SenderType applies equality check predicate on the "sender_type" field. It's identical to SenderTypeEQ.
+func SenderType(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderType, v))
+}
+
+// Poll applies equality check predicate on the "poll" field. It's identical to PollEQ.
+func Poll(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPoll, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAccountID, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreateTime, v))
@@ -219,6 +279,56 @@ func MessageIDLTE(v int64) predicate.Message {
 	return predicate.Message(sql.FieldLTE(FieldMessageID, v))
 }
 
+// ServerMessageIDEQ applies the EQ predicate on the "server_message_id" field.
+func ServerMessageIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldServerMessageID, v))
+}
+
+// ServerMessageIDNEQ applies the NEQ predicate on the "server_message_id" field.
+func ServerMessageIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldServerMessageID, v))
+}
+
+// ServerMessageIDIn applies the In predicate on the "server_message_id" field.
+func ServerMessageIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldServerMessageID, vs...))
+}
+
+// ServerMessageIDNotIn applies the NotIn predicate on the "server_message_id" field.
+func ServerMessageIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldServerMessageID, vs...))
+}
+
+// ServerMessageIDGT applies the GT predicate on the "server_message_id" field.
+func ServerMessageIDGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldServerMessageID, v))
+}
+
+// ServerMessageIDGTE applies the GTE predicate on the "server_message_id" field.
+func ServerMessageIDGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldServerMessageID, v))
+}
+
+// ServerMessageIDLT applies the LT predicate on the "server_message_id" field.
+func ServerMessageIDLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldServerMessageID, v))
+}
+
+// ServerMessageIDLTE applies the LTE predicate on the "server_message_id" field.
+func ServerMessageIDLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldServerMessageID, v))
+}
+
+// ServerMessageIDIsNil applies the IsNil predicate on the "server_message_id" field.
+func ServerMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldServerMessageID))
+}
+
+// ServerMessageIDNotNil applies the NotNil predicate on the "server_message_id" field.
+func ServerMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldServerMessageID))
+}
+
 // ChatIDEQ applies the EQ predicate on the "chat_id" field.
 func ChatIDEQ(v int64) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldChatID, v))
@@ -504,6 +614,56 @@ func TextContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldText, v))
 }
 
+// TextZstdEQ applies the EQ predicate on the "text_zstd" field.
+func TextZstdEQ(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldTextZstd, v))
+}
+
+// TextZstdNEQ applies the NEQ predicate on the "text_zstd" field.
+func TextZstdNEQ(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldTextZstd, v))
+}
+
+// TextZstdIn applies the In predicate on the "text_zstd" field.
+func TextZstdIn(vs ...[]byte) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldTextZstd, vs...))
+}
+
+// TextZstdNotIn applies the NotIn predicate on the "text_zstd" field.
+func TextZstdNotIn(vs ...[]byte) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldTextZstd, vs...))
+}
+
+// TextZstdGT applies the GT predicate on the "text_zstd" field.
+func TextZstdGT(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldTextZstd, v))
+}
+
+// TextZstdGTE applies the GTE predicate on the "text_zstd" field.
+func TextZstdGTE(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldTextZstd, v))
+}
+
+// TextZstdLT applies the LT predicate on the "text_zstd" field.
+func TextZstdLT(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldTextZstd, v))
+}
+
+// TextZstdLTE applies the LTE predicate on the "text_zstd" field.
+func TextZstdLTE(v []byte) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldTextZstd, v))
+}
+
+// TextZstdIsNil applies the IsNil predicate on the "text_zstd" field.
+func TextZstdIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldTextZstd))
+}
+
+// TextZstdNotNil applies the NotNil predicate on the "text_zstd" field.
+func TextZstdNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldTextZstd))
+}
+
 // SentAtEQ applies the EQ predicate on the "sent_at" field.
 func SentAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldSentAt, v))
@@ -544,6 +704,606 @@ func SentAtLTE(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldLTE(FieldSentAt, v))
 }
 
+// LangEQ applies the EQ predicate on the "lang" field.
+func LangEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldLang, v))
+}
+
+// LangNEQ applies the NEQ predicate on the "lang" field.
+func LangNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldLang, v))
+}
+
+// LangIn applies the In predicate on the "lang" field.
+func LangIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldLang, vs...))
+}
+
+// LangNotIn applies the NotIn predicate on the "lang" field.
+func LangNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldLang, vs...))
+}
+
+// LangGT applies the GT predicate on the "lang" field.
+func LangGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldLang, v))
+}
+
+// LangGTE applies the GTE predicate on the "lang" field.
+func LangGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldLang, v))
+}
+
+// LangLT applies the LT predicate on the "lang" field.
+func LangLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldLang, v))
+}
+
+// LangLTE applies the LTE predicate on the "lang" field.
+func LangLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldLang, v))
+}
+
+// LangContains applies the Contains predicate on the "lang" field.
+func LangContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldLang, v))
+}
+
+// LangHasPrefix applies the HasPrefix predicate on the "lang" field.
+func LangHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldLang, v))
+}
+
+// LangHasSuffix applies the HasSuffix predicate on the "lang" field.
+func LangHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldLang, v))
+}
+
+// LangIsNil applies the IsNil predicate on the "lang" field.
+func LangIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldLang))
+}
+
+// LangNotNil applies the NotNil predicate on the "lang" field.
+func LangNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldLang))
+}
+
+// LangEqualFold applies the EqualFold predicate on the "lang" field.
+func LangEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldLang, v))
+}
+
+// LangContainsFold applies the ContainsFold predicate on the "lang" field.
+func LangContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldLang, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeIsNil applies the IsNil predicate on the "content_type" field.
+func ContentTypeIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldContentType))
+}
+
+// ContentTypeNotNil applies the NotNil predicate on the "content_type" field.
+func ContentTypeNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldContentType))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// EditedAtIsNil applies the IsNil predicate on the "edited_at" field.
+func EditedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldEditedAt))
+}
+
+// EditedAtNotNil applies the NotNil predicate on the "edited_at" field.
+func EditedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldEditedAt))
+}
+
+// MessageThreadIDEQ applies the EQ predicate on the "message_thread_id" field.
+func MessageThreadIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDNEQ applies the NEQ predicate on the "message_thread_id" field.
+func MessageThreadIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDIn applies the In predicate on the "message_thread_id" field.
+func MessageThreadIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldMessageThreadID, vs...))
+}
+
+// MessageThreadIDNotIn applies the NotIn predicate on the "message_thread_id" field.
+func MessageThreadIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldMessageThreadID, vs...))
+}
+
+// MessageThreadIDGT applies the GT predicate on the "message_thread_id" field.
+func MessageThreadIDGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDGTE applies the GTE predicate on the "message_thread_id" field.
+func MessageThreadIDGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDLT applies the LT predicate on the "message_thread_id" field.
+func MessageThreadIDLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDLTE applies the LTE predicate on the "message_thread_id" field.
+func MessageThreadIDLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldMessageThreadID, v))
+}
+
+// MessageThreadIDIsNil applies the IsNil predicate on the "message_thread_id" field.
+func MessageThreadIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldMessageThreadID))
+}
+
+// MessageThreadIDNotNil applies the NotNil predicate on the "message_thread_id" field.
+func MessageThreadIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldMessageThreadID))
+}
+
+// ReplyToMessageIDEQ applies the EQ predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDNEQ applies the NEQ predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDIn applies the In predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReplyToMessageID, vs...))
+}
+
+// ReplyToMessageIDNotIn applies the NotIn predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReplyToMessageID, vs...))
+}
+
+// ReplyToMessageIDGT applies the GT predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDGTE applies the GTE predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDLT applies the LT predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDLTE applies the LTE predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReplyToMessageID, v))
+}
+
+// ReplyToMessageIDIsNil applies the IsNil predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReplyToMessageID))
+}
+
+// ReplyToMessageIDNotNil applies the NotNil predicate on the "reply_to_message_id" field.
+func ReplyToMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReplyToMessageID))
+}
+
+// ReactionCountEQ applies the EQ predicate on the "reaction_count" field.
+func ReactionCountEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReactionCount, v))
+}
+
+// ReactionCountNEQ applies the NEQ predicate on the "reaction_count" field.
+func ReactionCountNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReactionCount, v))
+}
+
+// ReactionCountIn applies the In predicate on the "reaction_count" field.
+func ReactionCountIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReactionCount, vs...))
+}
+
+// ReactionCountNotIn applies the NotIn predicate on the "reaction_count" field.
+func ReactionCountNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReactionCount, vs...))
+}
+
+// ReactionCountGT applies the GT predicate on the "reaction_count" field.
+func ReactionCountGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReactionCount, v))
+}
+
+// ReactionCountGTE applies the GTE predicate on the "reaction_count" field.
+func ReactionCountGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReactionCount, v))
+}
+
+// ReactionCountLT applies the LT predicate on the "reaction_count" field.
+func ReactionCountLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReactionCount, v))
+}
+
+// ReactionCountLTE applies the LTE predicate on the "reaction_count" field.
+func ReactionCountLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReactionCount, v))
+}
+
+// ReactionCountIsNil applies the IsNil predicate on the "reaction_count" field.
+func ReactionCountIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReactionCount))
+}
+
+// ReactionCountNotNil applies the NotNil predicate on the "reaction_count" field.
+func ReactionCountNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReactionCount))
+}
+
+// SenderTypeEQ applies the EQ predicate on the "sender_type" field.
+func SenderTypeEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldSenderType, v))
+}
+
+// SenderTypeNEQ applies the NEQ predicate on the "sender_type" field.
+func SenderTypeNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldSenderType, v))
+}
+
+// SenderTypeIn applies the In predicate on the "sender_type" field.
+func SenderTypeIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldSenderType, vs...))
+}
+
+// SenderTypeNotIn applies the NotIn predicate on the "sender_type" field.
+func SenderTypeNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldSenderType, vs...))
+}
+
+// SenderTypeGT applies the GT predicate on the "sender_type" field.
+func SenderTypeGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldSenderType, v))
+}
+
+// SenderTypeGTE applies the GTE predicate on the "sender_type" field.
+func SenderTypeGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldSenderType, v))
+}
+
+// SenderTypeLT applies the LT predicate on the "sender_type" field.
+func SenderTypeLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldSenderType, v))
+}
+
+// SenderTypeLTE applies the LTE predicate on the "sender_type" field.
+func SenderTypeLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldSenderType, v))
+}
+
+// SenderTypeContains applies the Contains predicate on the "sender_type" field.
+func SenderTypeContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldSenderType, v))
+}
+
+// SenderTypeHasPrefix applies the HasPrefix predicate on the "sender_type" field.
+func SenderTypeHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldSenderType, v))
+}
+
+// SenderTypeHasSuffix applies the HasSuffix predicate on the "sender_type" field.
+func SenderTypeHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldSenderType, v))
+}
+
+// SenderTypeIsNil applies the IsNil predicate on the "sender_type" field.
+func SenderTypeIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldSenderType))
+}
+
+// SenderTypeNotNil applies the NotNil predicate on the "sender_type" field.
+func SenderTypeNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldSenderType))
+}
+
+// SenderTypeEqualFold applies the EqualFold predicate on the "sender_type" field.
+func SenderTypeEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldSenderType, v))
+}
+
+// SenderTypeContainsFold applies the ContainsFold predicate on the "sender_type" field.
+func SenderTypeContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldSenderType, v))
+}
+
+// PollEQ applies the EQ predicate on the "poll" field.
+func PollEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldPoll, v))
+}
+
+// PollNEQ applies the NEQ predicate on the "poll" field.
+func PollNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldPoll, v))
+}
+
+// PollIn applies the In predicate on the "poll" field.
+func PollIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldPoll, vs...))
+}
+
+// PollNotIn applies the NotIn predicate on the "poll" field.
+func PollNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldPoll, vs...))
+}
+
+// PollGT applies the GT predicate on the "poll" field.
+func PollGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldPoll, v))
+}
+
+// PollGTE applies the GTE predicate on the "poll" field.
+func PollGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldPoll, v))
+}
+
+// PollLT applies the LT predicate on the "poll" field.
+func PollLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldPoll, v))
+}
+
+// PollLTE applies the LTE predicate on the "poll" field.
+func PollLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldPoll, v))
+}
+
+// PollContains applies the Contains predicate on the "poll" field.
+func PollContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldPoll, v))
+}
+
+// PollHasPrefix applies the HasPrefix predicate on the "poll" field.
+func PollHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldPoll, v))
+}
+
+// PollHasSuffix applies the HasSuffix predicate on the "poll" field.
+func PollHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldPoll, v))
+}
+
+// PollIsNil applies the IsNil predicate on the "poll" field.
+func PollIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldPoll))
+}
+
+// PollNotNil applies the NotNil predicate on the "poll" field.
+func PollNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldPoll))
+}
+
+// PollEqualFold applies the EqualFold predicate on the "poll" field.
+func PollEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldPoll, v))
+}
+
+// PollContainsFold applies the ContainsFold predicate on the "poll" field.
+func PollContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldPoll, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int64) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int64) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// AccountIDGT applies the GT predicate on the "account_id" field.
+func AccountIDGT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldAccountID, v))
+}
+
+// AccountIDGTE applies the GTE predicate on the "account_id" field.
+func AccountIDGTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldAccountID, v))
+}
+
+// AccountIDLT applies the LT predicate on the "account_id" field.
+func AccountIDLT(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldAccountID, v))
+}
+
+// AccountIDLTE applies the LTE predicate on the "account_id" field.
+func AccountIDLTE(v int64) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldAccountID, v))
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldAccountID))
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldAccountID))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Message) predicate.Message {
 	return predicate.Message(sql.AndPredicates(predicates...))
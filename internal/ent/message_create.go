@@ -54,6 +54,20 @@ func (_c *MessageCreate) SetMessageID(v int64) *MessageCreate {
 	return _c
 }
 
+// SetServerMessageID sets the "server_message_id" field.
+func (_c *MessageCreate) SetServerMessageID(v int64) *MessageCreate {
+	_c.mutation.SetServerMessageID(v)
+	return _c
+}
+
+// SetNillableServerMessageID sets the "server_message_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableServerMessageID(v *int64) *MessageCreate {
+	if v != nil {
+		_c.SetServerMessageID(*v)
+	}
+	return _c
+}
+
 // SetChatID sets the "chat_id" field.
 func (_c *MessageCreate) SetChatID(v int64) *MessageCreate {
 	_c.mutation.SetChatID(v)
@@ -92,12 +106,158 @@ func (_c *MessageCreate) SetText(v string) *MessageCreate {
 	return _c
 }
 
+// SetTextZstd sets the "text_zstd" field.
+func (_c *MessageCreate) SetTextZstd(v []byte) *MessageCreate {
+	_c.mutation.SetTextZstd(v)
+	return _c
+}
+
 // SetSentAt sets the "sent_at" field.
 func (_c *MessageCreate) SetSentAt(v time.Time) *MessageCreate {
 	_c.mutation.SetSentAt(v)
 	return _c
 }
 
+// SetLang sets the "lang" field.
+func (_c *MessageCreate) SetLang(v string) *MessageCreate {
+	_c.mutation.SetLang(v)
+	return _c
+}
+
+// SetNillableLang sets the "lang" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableLang(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetLang(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *MessageCreate) SetDeletedAt(v time.Time) *MessageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableDeletedAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *MessageCreate) SetContentType(v string) *MessageCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableContentType(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetContentType(*v)
+	}
+	return _c
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (_c *MessageCreate) SetEditedAt(v time.Time) *MessageCreate {
+	_c.mutation.SetEditedAt(v)
+	return _c
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableEditedAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetEditedAt(*v)
+	}
+	return _c
+}
+
+// SetMessageThreadID sets the "message_thread_id" field.
+func (_c *MessageCreate) SetMessageThreadID(v int64) *MessageCreate {
+	_c.mutation.SetMessageThreadID(v)
+	return _c
+}
+
+// SetNillableMessageThreadID sets the "message_thread_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableMessageThreadID(v *int64) *MessageCreate {
+	if v != nil {
+		_c.SetMessageThreadID(*v)
+	}
+	return _c
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (_c *MessageCreate) SetReplyToMessageID(v int64) *MessageCreate {
+	_c.mutation.SetReplyToMessageID(v)
+	return _c
+}
+
+// SetNillableReplyToMessageID sets the "reply_to_message_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableReplyToMessageID(v *int64) *MessageCreate {
+	if v != nil {
+		_c.SetReplyToMessageID(*v)
+	}
+	return _c
+}
+
+// SetReactionCount sets the "reaction_count" field.
+func (_c *MessageCreate) SetReactionCount(v int64) *MessageCreate {
+	_c.mutation.SetReactionCount(v)
+	return _c
+}
+
+// SetNillableReactionCount sets the "reaction_count" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableReactionCount(v *int64) *MessageCreate {
+	if v != nil {
+		_c.SetReactionCount(*v)
+	}
+	return _c
+}
+
+// SetSenderType sets the "sender_type" field.
+func (_c *MessageCreate) SetSenderType(v string) *MessageCreate {
+	_c.mutation.SetSenderType(v)
+	return _c
+}
+
+// SetNillableSenderType sets the "sender_type" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableSenderType(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetSenderType(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" field.
+func (_c *MessageCreate) SetPoll(v string) *MessageCreate {
+	_c.mutation.SetPoll(v)
+	return _c
+}
+
+// SetNillablePoll sets the "poll" field if the given value is not nil.
+func (_c *MessageCreate) SetNillablePoll(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetPoll(*v)
+	}
+	return _c
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *MessageCreate) SetAccountID(v int64) *MessageCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableAccountID(v *int64) *MessageCreate {
+	if v != nil {
+		_c.SetAccountID(*v)
+	}
+	return _c
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (_c *MessageCreate) Mutation() *MessageMutation {
 	return _c.mutation
@@ -207,6 +367,10 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldMessageID, field.TypeInt64, value)
 		_node.MessageID = value
 	}
+	if value, ok := _c.mutation.ServerMessageID(); ok {
+		_spec.SetField(message.FieldServerMessageID, field.TypeInt64, value)
+		_node.ServerMessageID = value
+	}
 	if value, ok := _c.mutation.ChatID(); ok {
 		_spec.SetField(message.FieldChatID, field.TypeInt64, value)
 		_node.ChatID = value
@@ -227,10 +391,54 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 		_node.Text = value
 	}
+	if value, ok := _c.mutation.TextZstd(); ok {
+		_spec.SetField(message.FieldTextZstd, field.TypeBytes, value)
+		_node.TextZstd = value
+	}
 	if value, ok := _c.mutation.SentAt(); ok {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 		_node.SentAt = value
 	}
+	if value, ok := _c.mutation.Lang(); ok {
+		_spec.SetField(message.FieldLang, field.TypeString, value)
+		_node.Lang = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(message.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := _c.mutation.MessageThreadID(); ok {
+		_spec.SetField(message.FieldMessageThreadID, field.TypeInt64, value)
+		_node.MessageThreadID = value
+	}
+	if value, ok := _c.mutation.ReplyToMessageID(); ok {
+		_spec.SetField(message.FieldReplyToMessageID, field.TypeInt64, value)
+		_node.ReplyToMessageID = value
+	}
+	if value, ok := _c.mutation.ReactionCount(); ok {
+		_spec.SetField(message.FieldReactionCount, field.TypeInt64, value)
+		_node.ReactionCount = value
+	}
+	if value, ok := _c.mutation.SenderType(); ok {
+		_spec.SetField(message.FieldSenderType, field.TypeString, value)
+		_node.SenderType = value
+	}
+	if value, ok := _c.mutation.Poll(); ok {
+		_spec.SetField(message.FieldPoll, field.TypeString, value)
+		_node.Poll = value
+	}
+	if value, ok := _c.mutation.AccountID(); ok {
+		_spec.SetField(message.FieldAccountID, field.TypeInt64, value)
+		_node.AccountID = value
+	}
 	return _node, _spec
 }
 
@@ -55,6 +55,33 @@ func (_u *MessageUpdate) AddMessageID(v int64) *MessageUpdate {
 	return _u
 }
 
+// SetServerMessageID sets the "server_message_id" field.
+func (_u *MessageUpdate) SetServerMessageID(v int64) *MessageUpdate {
+	_u.mutation.ResetServerMessageID()
+	_u.mutation.SetServerMessageID(v)
+	return _u
+}
+
+// SetNillableServerMessageID sets the "server_message_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableServerMessageID(v *int64) *MessageUpdate {
+	if v != nil {
+		_u.SetServerMessageID(*v)
+	}
+	return _u
+}
+
+// AddServerMessageID adds value to the "server_message_id" field.
+func (_u *MessageUpdate) AddServerMessageID(v int64) *MessageUpdate {
+	_u.mutation.AddServerMessageID(v)
+	return _u
+}
+
+// ClearServerMessageID clears the value of the "server_message_id" field.
+func (_u *MessageUpdate) ClearServerMessageID() *MessageUpdate {
+	_u.mutation.ClearServerMessageID()
+	return _u
+}
+
 // SetChatID sets the "chat_id" field.
 func (_u *MessageUpdate) SetChatID(v int64) *MessageUpdate {
 	_u.mutation.ResetChatID()
@@ -145,6 +172,18 @@ func (_u *MessageUpdate) SetNillableText(v *string) *MessageUpdate {
 	return _u
 }
 
+// SetTextZstd sets the "text_zstd" field.
+func (_u *MessageUpdate) SetTextZstd(v []byte) *MessageUpdate {
+	_u.mutation.SetTextZstd(v)
+	return _u
+}
+
+// ClearTextZstd clears the value of the "text_zstd" field.
+func (_u *MessageUpdate) ClearTextZstd() *MessageUpdate {
+	_u.mutation.ClearTextZstd()
+	return _u
+}
+
 // SetSentAt sets the "sent_at" field.
 func (_u *MessageUpdate) SetSentAt(v time.Time) *MessageUpdate {
 	_u.mutation.SetSentAt(v)
@@ -159,6 +198,234 @@ func (_u *MessageUpdate) SetNillableSentAt(v *time.Time) *MessageUpdate {
 	return _u
 }
 
+// SetLang sets the "lang" field.
+func (_u *MessageUpdate) SetLang(v string) *MessageUpdate {
+	_u.mutation.SetLang(v)
+	return _u
+}
+
+// SetNillableLang sets the "lang" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableLang(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetLang(*v)
+	}
+	return _u
+}
+
+// ClearLang clears the value of the "lang" field.
+func (_u *MessageUpdate) ClearLang() *MessageUpdate {
+	_u.mutation.ClearLang()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MessageUpdate) SetDeletedAt(v time.Time) *MessageUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableDeletedAt(v *time.Time) *MessageUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MessageUpdate) ClearDeletedAt() *MessageUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *MessageUpdate) SetContentType(v string) *MessageUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableContentType(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *MessageUpdate) ClearContentType() *MessageUpdate {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (_u *MessageUpdate) SetEditedAt(v time.Time) *MessageUpdate {
+	_u.mutation.SetEditedAt(v)
+	return _u
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableEditedAt(v *time.Time) *MessageUpdate {
+	if v != nil {
+		_u.SetEditedAt(*v)
+	}
+	return _u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (_u *MessageUpdate) ClearEditedAt() *MessageUpdate {
+	_u.mutation.ClearEditedAt()
+	return _u
+}
+
+// SetMessageThreadID sets the "message_thread_id" field.
+func (_u *MessageUpdate) SetMessageThreadID(v int64) *MessageUpdate {
+	_u.mutation.ResetMessageThreadID()
+	_u.mutation.SetMessageThreadID(v)
+	return _u
+}
+
+// SetNillableMessageThreadID sets the "message_thread_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableMessageThreadID(v *int64) *MessageUpdate {
+	if v != nil {
+		_u.SetMessageThreadID(*v)
+	}
+	return _u
+}
+
+// AddMessageThreadID adds value to the "message_thread_id" field.
+func (_u *MessageUpdate) AddMessageThreadID(v int64) *MessageUpdate {
+	_u.mutation.AddMessageThreadID(v)
+	return _u
+}
+
+// ClearMessageThreadID clears the value of the "message_thread_id" field.
+func (_u *MessageUpdate) ClearMessageThreadID() *MessageUpdate {
+	_u.mutation.ClearMessageThreadID()
+	return _u
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (_u *MessageUpdate) SetReplyToMessageID(v int64) *MessageUpdate {
+	_u.mutation.ResetReplyToMessageID()
+	_u.mutation.SetReplyToMessageID(v)
+	return _u
+}
+
+// SetNillableReplyToMessageID sets the "reply_to_message_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableReplyToMessageID(v *int64) *MessageUpdate {
+	if v != nil {
+		_u.SetReplyToMessageID(*v)
+	}
+	return _u
+}
+
+// AddReplyToMessageID adds value to the "reply_to_message_id" field.
+func (_u *MessageUpdate) AddReplyToMessageID(v int64) *MessageUpdate {
+	_u.mutation.AddReplyToMessageID(v)
+	return _u
+}
+
+// ClearReplyToMessageID clears the value of the "reply_to_message_id" field.
+func (_u *MessageUpdate) ClearReplyToMessageID() *MessageUpdate {
+	_u.mutation.ClearReplyToMessageID()
+	return _u
+}
+
+// SetReactionCount sets the "reaction_count" field.
+func (_u *MessageUpdate) SetReactionCount(v int64) *MessageUpdate {
+	_u.mutation.ResetReactionCount()
+	_u.mutation.SetReactionCount(v)
+	return _u
+}
+
+// SetNillableReactionCount sets the "reaction_count" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableReactionCount(v *int64) *MessageUpdate {
+	if v != nil {
+		_u.SetReactionCount(*v)
+	}
+	return _u
+}
+
+// AddReactionCount adds value to the "reaction_count" field.
+func (_u *MessageUpdate) AddReactionCount(v int64) *MessageUpdate {
+	_u.mutation.AddReactionCount(v)
+	return _u
+}
+
+// ClearReactionCount clears the value of the "reaction_count" field.
+func (_u *MessageUpdate) ClearReactionCount() *MessageUpdate {
+	_u.mutation.ClearReactionCount()
+	return _u
+}
+
+// SetSenderType sets the "sender_type" field.
+func (_u *MessageUpdate) SetSenderType(v string) *MessageUpdate {
+	_u.mutation.SetSenderType(v)
+	return _u
+}
+
+// SetNillableSenderType sets the "sender_type" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableSenderType(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetSenderType(*v)
+	}
+	return _u
+}
+
+// ClearSenderType clears the value of the "sender_type" field.
+func (_u *MessageUpdate) ClearSenderType() *MessageUpdate {
+	_u.mutation.ClearSenderType()
+	return _u
+}
+
+// SetPoll sets the "poll" field.
+func (_u *MessageUpdate) SetPoll(v string) *MessageUpdate {
+	_u.mutation.SetPoll(v)
+	return _u
+}
+
+// SetNillablePoll sets the "poll" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillablePoll(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetPoll(*v)
+	}
+	return _u
+}
+
+// ClearPoll clears the value of the "poll" field.
+func (_u *MessageUpdate) ClearPoll() *MessageUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *MessageUpdate) SetAccountID(v int64) *MessageUpdate {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableAccountID(v *int64) *MessageUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *MessageUpdate) AddAccountID(v int64) *MessageUpdate {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *MessageUpdate) ClearAccountID() *MessageUpdate {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (_u *MessageUpdate) Mutation() *MessageMutation {
 	return _u.mutation
@@ -218,6 +485,15 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedMessageID(); ok {
 		_spec.AddField(message.FieldMessageID, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.ServerMessageID(); ok {
+		_spec.SetField(message.FieldServerMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedServerMessageID(); ok {
+		_spec.AddField(message.FieldServerMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.ServerMessageIDCleared() {
+		_spec.ClearField(message.FieldServerMessageID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.ChatID(); ok {
 		_spec.SetField(message.FieldChatID, field.TypeInt64, value)
 	}
@@ -242,9 +518,87 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TextZstd(); ok {
+		_spec.SetField(message.FieldTextZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.TextZstdCleared() {
+		_spec.ClearField(message.FieldTextZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.SentAt(); ok {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Lang(); ok {
+		_spec.SetField(message.FieldLang, field.TypeString, value)
+	}
+	if _u.mutation.LangCleared() {
+		_spec.ClearField(message.FieldLang, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(message.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(message.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MessageThreadID(); ok {
+		_spec.SetField(message.FieldMessageThreadID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageThreadID(); ok {
+		_spec.AddField(message.FieldMessageThreadID, field.TypeInt64, value)
+	}
+	if _u.mutation.MessageThreadIDCleared() {
+		_spec.ClearField(message.FieldMessageThreadID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReplyToMessageID(); ok {
+		_spec.SetField(message.FieldReplyToMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReplyToMessageID(); ok {
+		_spec.AddField(message.FieldReplyToMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReplyToMessageIDCleared() {
+		_spec.ClearField(message.FieldReplyToMessageID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReactionCount(); ok {
+		_spec.SetField(message.FieldReactionCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReactionCount(); ok {
+		_spec.AddField(message.FieldReactionCount, field.TypeInt64, value)
+	}
+	if _u.mutation.ReactionCountCleared() {
+		_spec.ClearField(message.FieldReactionCount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SenderType(); ok {
+		_spec.SetField(message.FieldSenderType, field.TypeString, value)
+	}
+	if _u.mutation.SenderTypeCleared() {
+		_spec.ClearField(message.FieldSenderType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Poll(); ok {
+		_spec.SetField(message.FieldPoll, field.TypeString, value)
+	}
+	if _u.mutation.PollCleared() {
+		_spec.ClearField(message.FieldPoll, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(message.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(message.FieldAccountID, field.TypeInt64, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(message.FieldAccountID, field.TypeInt64)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{message.Label}
@@ -292,6 +646,33 @@ func (_u *MessageUpdateOne) AddMessageID(v int64) *MessageUpdateOne {
 	return _u
 }
 
+// SetServerMessageID sets the "server_message_id" field.
+func (_u *MessageUpdateOne) SetServerMessageID(v int64) *MessageUpdateOne {
+	_u.mutation.ResetServerMessageID()
+	_u.mutation.SetServerMessageID(v)
+	return _u
+}
+
+// SetNillableServerMessageID sets the "server_message_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableServerMessageID(v *int64) *MessageUpdateOne {
+	if v != nil {
+		_u.SetServerMessageID(*v)
+	}
+	return _u
+}
+
+// AddServerMessageID adds value to the "server_message_id" field.
+func (_u *MessageUpdateOne) AddServerMessageID(v int64) *MessageUpdateOne {
+	_u.mutation.AddServerMessageID(v)
+	return _u
+}
+
+// ClearServerMessageID clears the value of the "server_message_id" field.
+func (_u *MessageUpdateOne) ClearServerMessageID() *MessageUpdateOne {
+	_u.mutation.ClearServerMessageID()
+	return _u
+}
+
 // SetChatID sets the "chat_id" field.
 func (_u *MessageUpdateOne) SetChatID(v int64) *MessageUpdateOne {
 	_u.mutation.ResetChatID()
@@ -382,6 +763,18 @@ func (_u *MessageUpdateOne) SetNillableText(v *string) *MessageUpdateOne {
 	return _u
 }
 
+// SetTextZstd sets the "text_zstd" field.
+func (_u *MessageUpdateOne) SetTextZstd(v []byte) *MessageUpdateOne {
+	_u.mutation.SetTextZstd(v)
+	return _u
+}
+
+// ClearTextZstd clears the value of the "text_zstd" field.
+func (_u *MessageUpdateOne) ClearTextZstd() *MessageUpdateOne {
+	_u.mutation.ClearTextZstd()
+	return _u
+}
+
 // SetSentAt sets the "sent_at" field.
 func (_u *MessageUpdateOne) SetSentAt(v time.Time) *MessageUpdateOne {
 	_u.mutation.SetSentAt(v)
@@ -396,6 +789,234 @@ func (_u *MessageUpdateOne) SetNillableSentAt(v *time.Time) *MessageUpdateOne {
 	return _u
 }
 
+// SetLang sets the "lang" field.
+func (_u *MessageUpdateOne) SetLang(v string) *MessageUpdateOne {
+	_u.mutation.SetLang(v)
+	return _u
+}
+
+// SetNillableLang sets the "lang" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableLang(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetLang(*v)
+	}
+	return _u
+}
+
+// ClearLang clears the value of the "lang" field.
+func (_u *MessageUpdateOne) ClearLang() *MessageUpdateOne {
+	_u.mutation.ClearLang()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MessageUpdateOne) SetDeletedAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableDeletedAt(v *time.Time) *MessageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MessageUpdateOne) ClearDeletedAt() *MessageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *MessageUpdateOne) SetContentType(v string) *MessageUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableContentType(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *MessageUpdateOne) ClearContentType() *MessageUpdateOne {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (_u *MessageUpdateOne) SetEditedAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetEditedAt(v)
+	return _u
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableEditedAt(v *time.Time) *MessageUpdateOne {
+	if v != nil {
+		_u.SetEditedAt(*v)
+	}
+	return _u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (_u *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
+	_u.mutation.ClearEditedAt()
+	return _u
+}
+
+// SetMessageThreadID sets the "message_thread_id" field.
+func (_u *MessageUpdateOne) SetMessageThreadID(v int64) *MessageUpdateOne {
+	_u.mutation.ResetMessageThreadID()
+	_u.mutation.SetMessageThreadID(v)
+	return _u
+}
+
+// SetNillableMessageThreadID sets the "message_thread_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableMessageThreadID(v *int64) *MessageUpdateOne {
+	if v != nil {
+		_u.SetMessageThreadID(*v)
+	}
+	return _u
+}
+
+// AddMessageThreadID adds value to the "message_thread_id" field.
+func (_u *MessageUpdateOne) AddMessageThreadID(v int64) *MessageUpdateOne {
+	_u.mutation.AddMessageThreadID(v)
+	return _u
+}
+
+// ClearMessageThreadID clears the value of the "message_thread_id" field.
+func (_u *MessageUpdateOne) ClearMessageThreadID() *MessageUpdateOne {
+	_u.mutation.ClearMessageThreadID()
+	return _u
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (_u *MessageUpdateOne) SetReplyToMessageID(v int64) *MessageUpdateOne {
+	_u.mutation.ResetReplyToMessageID()
+	_u.mutation.SetReplyToMessageID(v)
+	return _u
+}
+
+// SetNillableReplyToMessageID sets the "reply_to_message_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableReplyToMessageID(v *int64) *MessageUpdateOne {
+	if v != nil {
+		_u.SetReplyToMessageID(*v)
+	}
+	return _u
+}
+
+// AddReplyToMessageID adds value to the "reply_to_message_id" field.
+func (_u *MessageUpdateOne) AddReplyToMessageID(v int64) *MessageUpdateOne {
+	_u.mutation.AddReplyToMessageID(v)
+	return _u
+}
+
+// ClearReplyToMessageID clears the value of the "reply_to_message_id" field.
+func (_u *MessageUpdateOne) ClearReplyToMessageID() *MessageUpdateOne {
+	_u.mutation.ClearReplyToMessageID()
+	return _u
+}
+
+// SetReactionCount sets the "reaction_count" field.
+func (_u *MessageUpdateOne) SetReactionCount(v int64) *MessageUpdateOne {
+	_u.mutation.ResetReactionCount()
+	_u.mutation.SetReactionCount(v)
+	return _u
+}
+
+// SetNillableReactionCount sets the "reaction_count" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableReactionCount(v *int64) *MessageUpdateOne {
+	if v != nil {
+		_u.SetReactionCount(*v)
+	}
+	return _u
+}
+
+// AddReactionCount adds value to the "reaction_count" field.
+func (_u *MessageUpdateOne) AddReactionCount(v int64) *MessageUpdateOne {
+	_u.mutation.AddReactionCount(v)
+	return _u
+}
+
+// ClearReactionCount clears the value of the "reaction_count" field.
+func (_u *MessageUpdateOne) ClearReactionCount() *MessageUpdateOne {
+	_u.mutation.ClearReactionCount()
+	return _u
+}
+
+// SetSenderType sets the "sender_type" field.
+func (_u *MessageUpdateOne) SetSenderType(v string) *MessageUpdateOne {
+	_u.mutation.SetSenderType(v)
+	return _u
+}
+
+// SetNillableSenderType sets the "sender_type" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableSenderType(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetSenderType(*v)
+	}
+	return _u
+}
+
+// ClearSenderType clears the value of the "sender_type" field.
+func (_u *MessageUpdateOne) ClearSenderType() *MessageUpdateOne {
+	_u.mutation.ClearSenderType()
+	return _u
+}
+
+// SetPoll sets the "poll" field.
+func (_u *MessageUpdateOne) SetPoll(v string) *MessageUpdateOne {
+	_u.mutation.SetPoll(v)
+	return _u
+}
+
+// SetNillablePoll sets the "poll" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillablePoll(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetPoll(*v)
+	}
+	return _u
+}
+
+// ClearPoll clears the value of the "poll" field.
+func (_u *MessageUpdateOne) ClearPoll() *MessageUpdateOne {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *MessageUpdateOne) SetAccountID(v int64) *MessageUpdateOne {
+	_u.mutation.ResetAccountID()
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableAccountID(v *int64) *MessageUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// AddAccountID adds value to the "account_id" field.
+func (_u *MessageUpdateOne) AddAccountID(v int64) *MessageUpdateOne {
+	_u.mutation.AddAccountID(v)
+	return _u
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (_u *MessageUpdateOne) ClearAccountID() *MessageUpdateOne {
+	_u.mutation.ClearAccountID()
+	return _u
+}
+
 // Mutation returns the MessageMutation object of the builder.
 func (_u *MessageUpdateOne) Mutation() *MessageMutation {
 	return _u.mutation
@@ -485,6 +1106,15 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if value, ok := _u.mutation.AddedMessageID(); ok {
 		_spec.AddField(message.FieldMessageID, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.ServerMessageID(); ok {
+		_spec.SetField(message.FieldServerMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedServerMessageID(); ok {
+		_spec.AddField(message.FieldServerMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.ServerMessageIDCleared() {
+		_spec.ClearField(message.FieldServerMessageID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.ChatID(); ok {
 		_spec.SetField(message.FieldChatID, field.TypeInt64, value)
 	}
@@ -509,9 +1139,87 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(message.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TextZstd(); ok {
+		_spec.SetField(message.FieldTextZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.TextZstdCleared() {
+		_spec.ClearField(message.FieldTextZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.SentAt(); ok {
 		_spec.SetField(message.FieldSentAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Lang(); ok {
+		_spec.SetField(message.FieldLang, field.TypeString, value)
+	}
+	if _u.mutation.LangCleared() {
+		_spec.ClearField(message.FieldLang, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(message.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(message.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(message.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(message.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.EditedAt(); ok {
+		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EditedAtCleared() {
+		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MessageThreadID(); ok {
+		_spec.SetField(message.FieldMessageThreadID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageThreadID(); ok {
+		_spec.AddField(message.FieldMessageThreadID, field.TypeInt64, value)
+	}
+	if _u.mutation.MessageThreadIDCleared() {
+		_spec.ClearField(message.FieldMessageThreadID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReplyToMessageID(); ok {
+		_spec.SetField(message.FieldReplyToMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReplyToMessageID(); ok {
+		_spec.AddField(message.FieldReplyToMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReplyToMessageIDCleared() {
+		_spec.ClearField(message.FieldReplyToMessageID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReactionCount(); ok {
+		_spec.SetField(message.FieldReactionCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReactionCount(); ok {
+		_spec.AddField(message.FieldReactionCount, field.TypeInt64, value)
+	}
+	if _u.mutation.ReactionCountCleared() {
+		_spec.ClearField(message.FieldReactionCount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SenderType(); ok {
+		_spec.SetField(message.FieldSenderType, field.TypeString, value)
+	}
+	if _u.mutation.SenderTypeCleared() {
+		_spec.ClearField(message.FieldSenderType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Poll(); ok {
+		_spec.SetField(message.FieldPoll, field.TypeString, value)
+	}
+	if _u.mutation.PollCleared() {
+		_spec.ClearField(message.FieldPoll, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccountID(); ok {
+		_spec.SetField(message.FieldAccountID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAccountID(); ok {
+		_spec.AddField(message.FieldAccountID, field.TypeInt64, value)
+	}
+	if _u.mutation.AccountIDCleared() {
+		_spec.ClearField(message.FieldAccountID, field.TypeInt64)
+	}
 	_node = &Message{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
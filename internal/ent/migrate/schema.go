@@ -8,15 +8,69 @@ import (
 )
 
 var (
+	// BlackoutsColumns holds the columns for the "blackouts" table.
+	BlackoutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "operator_id", Type: field.TypeInt64},
+	}
+	// BlackoutsTable holds the schema information for the "blackouts" table.
+	BlackoutsTable = &schema.Table{
+		Name:       "blackouts",
+		Columns:    BlackoutsColumns,
+		PrimaryKey: []*schema.Column{BlackoutsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blackout_end_time",
+				Unique:  false,
+				Columns: []*schema.Column{BlackoutsColumns[5]},
+			},
+		},
+	}
+	// ChatsColumns holds the columns for the "chats" table.
+	ChatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "chat_id", Type: field.TypeInt64, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString, Nullable: true},
+		{Name: "member_count", Type: field.TypeInt, Nullable: true},
+		{Name: "chat_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"group", "supergroup", "channel"}},
+		{Name: "consent", Type: field.TypeEnum, Enums: []string{"unknown", "notified", "opted_out"}, Default: "unknown"},
+		{Name: "consent_updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ChatsTable holds the schema information for the "chats" table.
+	ChatsTable = &schema.Table{
+		Name:       "chats",
+		Columns:    ChatsColumns,
+		PrimaryKey: []*schema.Column{ChatsColumns[0]},
+	}
 	// DailyRunsColumns holds the columns for the "daily_runs" table.
 	DailyRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "window", Type: field.TypeString, Default: "daily"},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "in_progress", "completed", "failed"}, Default: "in_progress"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "chats_processed", Type: field.TypeInt, Default: 0},
+		{Name: "chats_failed", Type: field.TypeInt, Default: 0},
+		{Name: "messages_summarized", Type: field.TypeInt, Default: 0},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "messages_cleaned", Type: field.TypeInt, Default: 0},
+		{Name: "lock_owner", Type: field.TypeString, Nullable: true},
+		{Name: "lock_expires_at", Type: field.TypeTime, Nullable: true},
 	}
 	// DailyRunsTable holds the schema information for the "daily_runs" table.
 	DailyRunsTable = &schema.Table{
@@ -27,12 +81,33 @@ var (
 			{
 				Name:    "dailyrun_start_time_end_time",
 				Unique:  true,
-				Columns: []*schema.Column{DailyRunsColumns[3], DailyRunsColumns[4]},
+				Columns: []*schema.Column{DailyRunsColumns[4], DailyRunsColumns[5]},
 			},
 			{
 				Name:    "dailyrun_status",
 				Unique:  false,
-				Columns: []*schema.Column{DailyRunsColumns[5]},
+				Columns: []*schema.Column{DailyRunsColumns[6]},
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "keyword", Type: field.TypeString},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_user_id_keyword",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[3], FollowsColumns[4]},
 			},
 		},
 	}
@@ -42,18 +117,147 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "message_id", Type: field.TypeInt64},
+		{Name: "server_message_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "chat_id", Type: field.TypeInt64},
 		{Name: "sender_id", Type: field.TypeInt64},
 		{Name: "sender_name", Type: field.TypeString},
 		{Name: "sender_username", Type: field.TypeString, Nullable: true},
 		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "text_zstd", Type: field.TypeBytes, Nullable: true},
 		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "lang", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_thread_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "reply_to_message_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "reaction_count", Type: field.TypeInt64, Nullable: true},
+		{Name: "sender_type", Type: field.TypeString, Nullable: true},
+		{Name: "poll", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "account_id", Type: field.TypeInt64, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
 	MessagesTable = &schema.Table{
 		Name:       "messages",
 		Columns:    MessagesColumns,
 		PrimaryKey: []*schema.Column{MessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[13]},
+			},
+			{
+				Name:    "message_chat_id_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[5], MessagesColumns[3]},
+			},
+		},
+	}
+	// RunLogsColumns holds the columns for the "run_logs" table.
+	RunLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeInt, Default: 0},
+		{Name: "task_id", Type: field.TypeInt, Default: 0},
+		{Name: "chat_id", Type: field.TypeInt64, Default: 0},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"run_started", "run_completed", "run_failed", "task_started", "chunk_completed", "notify_sent", "task_failed", "task_blocked"}},
+		{Name: "detail", Type: field.TypeString, Nullable: true},
+	}
+	// RunLogsTable holds the schema information for the "run_logs" table.
+	RunLogsTable = &schema.Table{
+		Name:       "run_logs",
+		Columns:    RunLogsColumns,
+		PrimaryKey: []*schema.Column{RunLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "runlog_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{RunLogsColumns[3]},
+			},
+			{
+				Name:    "runlog_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{RunLogsColumns[4]},
+			},
+		},
+	}
+	// SentPartsColumns holds the columns for the "sent_parts" table.
+	SentPartsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "message_id", Type: field.TypeInt64, Nullable: true},
+	}
+	// SentPartsTable holds the schema information for the "sent_parts" table.
+	SentPartsTable = &schema.Table{
+		Name:       "sent_parts",
+		Columns:    SentPartsColumns,
+		PrimaryKey: []*schema.Column{SentPartsColumns[0]},
+	}
+	// ShadowRunsColumns holds the columns for the "shadow_runs" table.
+	ShadowRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "primary_model", Type: field.TypeString},
+		{Name: "shadow_model", Type: field.TypeString},
+		{Name: "primary_content", Type: field.TypeString, Size: 2147483647},
+		{Name: "shadow_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "shadow_error", Type: field.TypeString, Nullable: true},
+		{Name: "shadow_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "primary_topics", Type: field.TypeInt, Default: 0},
+		{Name: "shadow_topics", Type: field.TypeInt, Default: 0},
+		{Name: "matched_topics", Type: field.TypeInt, Default: 0},
+		{Name: "message_overlap", Type: field.TypeFloat64, Default: 0},
+	}
+	// ShadowRunsTable holds the schema information for the "shadow_runs" table.
+	ShadowRunsTable = &schema.Table{
+		Name:       "shadow_runs",
+		Columns:    ShadowRunsColumns,
+		PrimaryKey: []*schema.Column{ShadowRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shadowrun_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ShadowRunsColumns[1]},
+			},
+		},
+	}
+	// SharedLinksColumns holds the columns for the "shared_links" table.
+	SharedLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "message_id", Type: field.TypeInt64},
+		{Name: "sender_id", Type: field.TypeInt64},
+		{Name: "sender_name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "sent_at", Type: field.TypeTime},
+	}
+	// SharedLinksTable holds the schema information for the "shared_links" table.
+	SharedLinksTable = &schema.Table{
+		Name:       "shared_links",
+		Columns:    SharedLinksColumns,
+		PrimaryKey: []*schema.Column{SharedLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharedlink_chat_id_sent_at",
+				Unique:  false,
+				Columns: []*schema.Column{SharedLinksColumns[3], SharedLinksColumns[8]},
+			},
+			{
+				Name:    "sharedlink_sent_at",
+				Unique:  false,
+				Columns: []*schema.Column{SharedLinksColumns[8]},
+			},
+		},
 	}
 	// SummariesColumns holds the columns for the "summaries" table.
 	SummariesColumns = []*schema.Column{
@@ -74,6 +278,53 @@ var (
 		Columns:    SummariesColumns,
 		PrimaryKey: []*schema.Column{SummariesColumns[0]},
 	}
+	// SummaryRevisionsColumns holds the columns for the "summary_revisions" table.
+	SummaryRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"generated", "edited"}, Default: "generated"},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SummaryRevisionsTable holds the schema information for the "summary_revisions" table.
+	SummaryRevisionsTable = &schema.Table{
+		Name:       "summary_revisions",
+		Columns:    SummaryRevisionsColumns,
+		PrimaryKey: []*schema.Column{SummaryRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "summaryrevision_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{SummaryRevisionsColumns[3]},
+			},
+		},
+	}
+	// SummaryViewsColumns holds the columns for the "summary_views" table.
+	SummaryViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "chat_id", Type: field.TypeInt64},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "topic", Type: field.TypeInt, Default: -1},
+	}
+	// SummaryViewsTable holds the schema information for the "summary_views" table.
+	SummaryViewsTable = &schema.Table{
+		Name:       "summary_views",
+		Columns:    SummaryViewsColumns,
+		PrimaryKey: []*schema.Column{SummaryViewsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "summaryview_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{SummaryViewsColumns[1]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -82,10 +333,18 @@ var (
 		{Name: "chat_id", Type: field.TypeInt64},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "blocked"}, Default: "pending"},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "summary_content", Type: field.TypeString, Nullable: true},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "participant_count", Type: field.TypeInt, Default: 0},
+		{Name: "summary_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "prompt_budget", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "progress_chunk", Type: field.TypeInt, Default: 0},
+		{Name: "progress_chunks", Type: field.TypeInt, Default: 0},
+		{Name: "progress_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"scheduled", "adhoc"}, Default: "scheduled"},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -94,9 +353,9 @@ var (
 		PrimaryKey: []*schema.Column{TasksColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "task_chat_id_start_time_end_time",
+				Name:    "task_chat_id_start_time_end_time_kind",
 				Unique:  true,
-				Columns: []*schema.Column{TasksColumns[3], TasksColumns[4], TasksColumns[5]},
+				Columns: []*schema.Column{TasksColumns[3], TasksColumns[4], TasksColumns[5], TasksColumns[17]},
 			},
 			{
 				Name:    "task_status",
@@ -105,12 +364,38 @@ var (
 			},
 		},
 	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "username", Type: field.TypeString, Nullable: true},
+		{Name: "photo_file_id", Type: field.TypeString, Nullable: true},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BlackoutsTable,
+		ChatsTable,
 		DailyRunsTable,
+		FollowsTable,
 		MessagesTable,
+		RunLogsTable,
+		SentPartsTable,
+		ShadowRunsTable,
+		SharedLinksTable,
 		SummariesTable,
+		SummaryRevisionsTable,
+		SummaryViewsTable,
 		TasksTable,
+		UsersTable,
 	}
 )
 
@@ -11,11 +11,21 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
 	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
 	"github.com/fachebot/talk-trace-bot/internal/ent/message"
 	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
 	"github.com/fachebot/talk-trace-bot/internal/ent/summary"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
 	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/ent/user"
 )
 
 const (
@@ -27,41 +37,53 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDailyRun = "DailyRun"
-	TypeMessage  = "Message"
-	TypeSummary  = "Summary"
-	TypeTask     = "Task"
+	TypeBlackout        = "Blackout"
+	TypeChat            = "Chat"
+	TypeDailyRun        = "DailyRun"
+	TypeFollow          = "Follow"
+	TypeMessage         = "Message"
+	TypeRunLog          = "RunLog"
+	TypeSentPart        = "SentPart"
+	TypeShadowRun       = "ShadowRun"
+	TypeSharedLink      = "SharedLink"
+	TypeSummary         = "Summary"
+	TypeSummaryRevision = "SummaryRevision"
+	TypeSummaryView     = "SummaryView"
+	TypeTask            = "Task"
+	TypeUser            = "User"
 )
 
-// DailyRunMutation represents an operation that mutates the DailyRun nodes in the graph.
-type DailyRunMutation struct {
+// BlackoutMutation represents an operation that mutates the Blackout nodes in the graph.
+type BlackoutMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	start_time    *time.Time
-	end_time      *time.Time
-	status        *dailyrun.Status
-	error_message *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*DailyRun, error)
-	predicates    []predicate.DailyRun
-}
-
-var _ ent.Mutation = (*DailyRunMutation)(nil)
-
-// dailyrunOption allows management of the mutation configuration using functional options.
-type dailyrunOption func(*DailyRunMutation)
-
-// newDailyRunMutation creates new mutation for the DailyRun entity.
-func newDailyRunMutation(c config, op Op, opts ...dailyrunOption) *DailyRunMutation {
-	m := &DailyRunMutation{
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	chat_id        *int64
+	addchat_id     *int64
+	start_time     *time.Time
+	end_time       *time.Time
+	operator_id    *int64
+	addoperator_id *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Blackout, error)
+	predicates     []predicate.Blackout
+}
+
+var _ ent.Mutation = (*BlackoutMutation)(nil)
+
+// blackoutOption allows management of the mutation configuration using functional options.
+type blackoutOption func(*BlackoutMutation)
+
+// newBlackoutMutation creates new mutation for the Blackout entity.
+func newBlackoutMutation(c config, op Op, opts ...blackoutOption) *BlackoutMutation {
+	m := &BlackoutMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDailyRun,
+		typ:           TypeBlackout,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +92,20 @@ func newDailyRunMutation(c config, op Op, opts ...dailyrunOption) *DailyRunMutat
 	return m
 }
 
-// withDailyRunID sets the ID field of the mutation.
-func withDailyRunID(id int) dailyrunOption {
-	return func(m *DailyRunMutation) {
+// withBlackoutID sets the ID field of the mutation.
+func withBlackoutID(id int) blackoutOption {
+	return func(m *BlackoutMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DailyRun
+			value *Blackout
 		)
-		m.oldValue = func(ctx context.Context) (*DailyRun, error) {
+		m.oldValue = func(ctx context.Context) (*Blackout, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DailyRun.Get(ctx, id)
+					value, err = m.Client().Blackout.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +114,10 @@ func withDailyRunID(id int) dailyrunOption {
 	}
 }
 
-// withDailyRun sets the old DailyRun of the mutation.
-func withDailyRun(node *DailyRun) dailyrunOption {
-	return func(m *DailyRunMutation) {
-		m.oldValue = func(context.Context) (*DailyRun, error) {
+// withBlackout sets the old Blackout of the mutation.
+func withBlackout(node *Blackout) blackoutOption {
+	return func(m *BlackoutMutation) {
+		m.oldValue = func(context.Context) (*Blackout, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +126,7 @@ func withDailyRun(node *DailyRun) dailyrunOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DailyRunMutation) Client() *Client {
+func (m BlackoutMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +134,7 @@ func (m DailyRunMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DailyRunMutation) Tx() (*Tx, error) {
+func (m BlackoutMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,7 +145,7 @@ func (m DailyRunMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DailyRunMutation) ID() (id int, exists bool) {
+func (m *BlackoutMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -134,7 +156,7 @@ func (m *DailyRunMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DailyRunMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BlackoutMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -143,19 +165,19 @@ func (m *DailyRunMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DailyRun.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Blackout.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *DailyRunMutation) SetCreateTime(t time.Time) {
+func (m *BlackoutMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *DailyRunMutation) CreateTime() (r time.Time, exists bool) {
+func (m *BlackoutMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -163,10 +185,10 @@ func (m *DailyRunMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlackoutMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -181,17 +203,17 @@ func (m *DailyRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *DailyRunMutation) ResetCreateTime() {
+func (m *BlackoutMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *DailyRunMutation) SetUpdateTime(t time.Time) {
+func (m *BlackoutMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *DailyRunMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *BlackoutMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -199,10 +221,10 @@ func (m *DailyRunMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlackoutMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -217,17 +239,73 @@ func (m *DailyRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *DailyRunMutation) ResetUpdateTime() {
+func (m *BlackoutMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
+// SetChatID sets the "chat_id" field.
+func (m *BlackoutMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *BlackoutMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlackoutMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *BlackoutMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *BlackoutMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *BlackoutMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
 // SetStartTime sets the "start_time" field.
-func (m *DailyRunMutation) SetStartTime(t time.Time) {
+func (m *BlackoutMutation) SetStartTime(t time.Time) {
 	m.start_time = &t
 }
 
 // StartTime returns the value of the "start_time" field in the mutation.
-func (m *DailyRunMutation) StartTime() (r time.Time, exists bool) {
+func (m *BlackoutMutation) StartTime() (r time.Time, exists bool) {
 	v := m.start_time
 	if v == nil {
 		return
@@ -235,10 +313,10 @@ func (m *DailyRunMutation) StartTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldStartTime returns the old "start_time" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "start_time" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlackoutMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
@@ -253,17 +331,17 @@ func (m *DailyRunMutation) OldStartTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetStartTime resets all changes to the "start_time" field.
-func (m *DailyRunMutation) ResetStartTime() {
+func (m *BlackoutMutation) ResetStartTime() {
 	m.start_time = nil
 }
 
 // SetEndTime sets the "end_time" field.
-func (m *DailyRunMutation) SetEndTime(t time.Time) {
+func (m *BlackoutMutation) SetEndTime(t time.Time) {
 	m.end_time = &t
 }
 
 // EndTime returns the value of the "end_time" field in the mutation.
-func (m *DailyRunMutation) EndTime() (r time.Time, exists bool) {
+func (m *BlackoutMutation) EndTime() (r time.Time, exists bool) {
 	v := m.end_time
 	if v == nil {
 		return
@@ -271,10 +349,10 @@ func (m *DailyRunMutation) EndTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldEndTime returns the old "end_time" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "end_time" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+func (m *BlackoutMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
@@ -289,104 +367,75 @@ func (m *DailyRunMutation) OldEndTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetEndTime resets all changes to the "end_time" field.
-func (m *DailyRunMutation) ResetEndTime() {
+func (m *BlackoutMutation) ResetEndTime() {
 	m.end_time = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DailyRunMutation) SetStatus(d dailyrun.Status) {
-	m.status = &d
+// SetOperatorID sets the "operator_id" field.
+func (m *BlackoutMutation) SetOperatorID(i int64) {
+	m.operator_id = &i
+	m.addoperator_id = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DailyRunMutation) Status() (r dailyrun.Status, exists bool) {
-	v := m.status
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *BlackoutMutation) OperatorID() (r int64, exists bool) {
+	v := m.operator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorID returns the old "operator_id" field's value of the Blackout entity.
+// If the Blackout object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldStatus(ctx context.Context) (v dailyrun.Status, err error) {
+func (m *BlackoutMutation) OldOperatorID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.OperatorID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DailyRunMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetErrorMessage sets the "error_message" field.
-func (m *DailyRunMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// AddOperatorID adds i to the "operator_id" field.
+func (m *BlackoutMutation) AddOperatorID(i int64) {
+	if m.addoperator_id != nil {
+		*m.addoperator_id += i
+	} else {
+		m.addoperator_id = &i
+	}
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *DailyRunMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// AddedOperatorID returns the value that was added to the "operator_id" field in this mutation.
+func (m *BlackoutMutation) AddedOperatorID() (r int64, exists bool) {
+	v := m.addoperator_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the DailyRun entity.
-// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DailyRunMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
-	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *DailyRunMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[dailyrun.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *DailyRunMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[dailyrun.FieldErrorMessage]
-	return ok
-}
-
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *DailyRunMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, dailyrun.FieldErrorMessage)
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *BlackoutMutation) ResetOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
 }
 
-// Where appends a list predicates to the DailyRunMutation builder.
-func (m *DailyRunMutation) Where(ps ...predicate.DailyRun) {
+// Where appends a list predicates to the BlackoutMutation builder.
+func (m *BlackoutMutation) Where(ps ...predicate.Blackout) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DailyRunMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BlackoutMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DailyRunMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DailyRun, len(ps))
+func (m *BlackoutMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blackout, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -394,42 +443,42 @@ func (m *DailyRunMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DailyRunMutation) Op() Op {
+func (m *BlackoutMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DailyRunMutation) SetOp(op Op) {
+func (m *BlackoutMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DailyRun).
-func (m *DailyRunMutation) Type() string {
+// Type returns the node type of this mutation (Blackout).
+func (m *BlackoutMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DailyRunMutation) Fields() []string {
+func (m *BlackoutMutation) Fields() []string {
 	fields := make([]string, 0, 6)
 	if m.create_time != nil {
-		fields = append(fields, dailyrun.FieldCreateTime)
+		fields = append(fields, blackout.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, dailyrun.FieldUpdateTime)
+		fields = append(fields, blackout.FieldUpdateTime)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, blackout.FieldChatID)
 	}
 	if m.start_time != nil {
-		fields = append(fields, dailyrun.FieldStartTime)
+		fields = append(fields, blackout.FieldStartTime)
 	}
 	if m.end_time != nil {
-		fields = append(fields, dailyrun.FieldEndTime)
-	}
-	if m.status != nil {
-		fields = append(fields, dailyrun.FieldStatus)
+		fields = append(fields, blackout.FieldEndTime)
 	}
-	if m.error_message != nil {
-		fields = append(fields, dailyrun.FieldErrorMessage)
+	if m.operator_id != nil {
+		fields = append(fields, blackout.FieldOperatorID)
 	}
 	return fields
 }
@@ -437,20 +486,20 @@ func (m *DailyRunMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DailyRunMutation) Field(name string) (ent.Value, bool) {
+func (m *BlackoutMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case dailyrun.FieldCreateTime:
+	case blackout.FieldCreateTime:
 		return m.CreateTime()
-	case dailyrun.FieldUpdateTime:
+	case blackout.FieldUpdateTime:
 		return m.UpdateTime()
-	case dailyrun.FieldStartTime:
+	case blackout.FieldChatID:
+		return m.ChatID()
+	case blackout.FieldStartTime:
 		return m.StartTime()
-	case dailyrun.FieldEndTime:
+	case blackout.FieldEndTime:
 		return m.EndTime()
-	case dailyrun.FieldStatus:
-		return m.Status()
-	case dailyrun.FieldErrorMessage:
-		return m.ErrorMessage()
+	case blackout.FieldOperatorID:
+		return m.OperatorID()
 	}
 	return nil, false
 }
@@ -458,234 +507,251 @@ func (m *DailyRunMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DailyRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BlackoutMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case dailyrun.FieldCreateTime:
+	case blackout.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case dailyrun.FieldUpdateTime:
+	case blackout.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case dailyrun.FieldStartTime:
+	case blackout.FieldChatID:
+		return m.OldChatID(ctx)
+	case blackout.FieldStartTime:
 		return m.OldStartTime(ctx)
-	case dailyrun.FieldEndTime:
+	case blackout.FieldEndTime:
 		return m.OldEndTime(ctx)
-	case dailyrun.FieldStatus:
-		return m.OldStatus(ctx)
-	case dailyrun.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
+	case blackout.FieldOperatorID:
+		return m.OldOperatorID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DailyRun field %s", name)
+	return nil, fmt.Errorf("unknown Blackout field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DailyRunMutation) SetField(name string, value ent.Value) error {
+func (m *BlackoutMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case dailyrun.FieldCreateTime:
+	case blackout.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case dailyrun.FieldUpdateTime:
+	case blackout.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case dailyrun.FieldStartTime:
-		v, ok := value.(time.Time)
+	case blackout.FieldChatID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetChatID(v)
 		return nil
-	case dailyrun.FieldEndTime:
+	case blackout.FieldStartTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndTime(v)
+		m.SetStartTime(v)
 		return nil
-	case dailyrun.FieldStatus:
-		v, ok := value.(dailyrun.Status)
+	case blackout.FieldEndTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetEndTime(v)
 		return nil
-	case dailyrun.FieldErrorMessage:
-		v, ok := value.(string)
+	case blackout.FieldOperatorID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetOperatorID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DailyRun field %s", name)
+	return fmt.Errorf("unknown Blackout field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DailyRunMutation) AddedFields() []string {
-	return nil
+func (m *BlackoutMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, blackout.FieldChatID)
+	}
+	if m.addoperator_id != nil {
+		fields = append(fields, blackout.FieldOperatorID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DailyRunMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BlackoutMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blackout.FieldChatID:
+		return m.AddedChatID()
+	case blackout.FieldOperatorID:
+		return m.AddedOperatorID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DailyRunMutation) AddField(name string, value ent.Value) error {
+func (m *BlackoutMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case blackout.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case blackout.FieldOperatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOperatorID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DailyRun numeric field %s", name)
+	return fmt.Errorf("unknown Blackout numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DailyRunMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(dailyrun.FieldErrorMessage) {
-		fields = append(fields, dailyrun.FieldErrorMessage)
-	}
-	return fields
+func (m *BlackoutMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DailyRunMutation) FieldCleared(name string) bool {
+func (m *BlackoutMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DailyRunMutation) ClearField(name string) error {
-	switch name {
-	case dailyrun.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	}
-	return fmt.Errorf("unknown DailyRun nullable field %s", name)
+func (m *BlackoutMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Blackout nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DailyRunMutation) ResetField(name string) error {
+func (m *BlackoutMutation) ResetField(name string) error {
 	switch name {
-	case dailyrun.FieldCreateTime:
+	case blackout.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case dailyrun.FieldUpdateTime:
+	case blackout.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case dailyrun.FieldStartTime:
+	case blackout.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case blackout.FieldStartTime:
 		m.ResetStartTime()
 		return nil
-	case dailyrun.FieldEndTime:
+	case blackout.FieldEndTime:
 		m.ResetEndTime()
 		return nil
-	case dailyrun.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case dailyrun.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case blackout.FieldOperatorID:
+		m.ResetOperatorID()
 		return nil
 	}
-	return fmt.Errorf("unknown DailyRun field %s", name)
+	return fmt.Errorf("unknown Blackout field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DailyRunMutation) AddedEdges() []string {
+func (m *BlackoutMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DailyRunMutation) AddedIDs(name string) []ent.Value {
+func (m *BlackoutMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DailyRunMutation) RemovedEdges() []string {
+func (m *BlackoutMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DailyRunMutation) RemovedIDs(name string) []ent.Value {
+func (m *BlackoutMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DailyRunMutation) ClearedEdges() []string {
+func (m *BlackoutMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DailyRunMutation) EdgeCleared(name string) bool {
+func (m *BlackoutMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DailyRunMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DailyRun unique edge %s", name)
+func (m *BlackoutMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Blackout unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DailyRunMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DailyRun edge %s", name)
+func (m *BlackoutMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Blackout edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// ChatMutation represents an operation that mutates the Chat nodes in the graph.
+type ChatMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	create_time     *time.Time
-	update_time     *time.Time
-	message_id      *int64
-	addmessage_id   *int64
-	chat_id         *int64
-	addchat_id      *int64
-	sender_id       *int64
-	addsender_id    *int64
-	sender_name     *string
-	sender_username *string
-	text            *string
-	sent_at         *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Message, error)
-	predicates      []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	chat_id            *int64
+	addchat_id         *int64
+	title              *string
+	username           *string
+	member_count       *int
+	addmember_count    *int
+	chat_type          *chat.ChatType
+	consent            *chat.Consent
+	consent_updated_at *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Chat, error)
+	predicates         []predicate.Chat
+}
+
+var _ ent.Mutation = (*ChatMutation)(nil)
+
+// chatOption allows management of the mutation configuration using functional options.
+type chatOption func(*ChatMutation)
+
+// newChatMutation creates new mutation for the Chat entity.
+func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
+	m := &ChatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeChat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -694,20 +760,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id int) messageOption {
-	return func(m *MessageMutation) {
+// withChatID sets the ID field of the mutation.
+func withChatID(id int) chatOption {
+	return func(m *ChatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Chat
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Chat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Chat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -716,10 +782,10 @@ func withMessageID(id int) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withChat sets the old Chat of the mutation.
+func withChat(node *Chat) chatOption {
+	return func(m *ChatMutation) {
+		m.oldValue = func(context.Context) (*Chat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -728,7 +794,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m ChatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -736,7 +802,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m ChatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -747,7 +813,7 @@ func (m MessageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id int, exists bool) {
+func (m *ChatMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -758,7 +824,7 @@ func (m *MessageMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChatMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -767,19 +833,19 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *MessageMutation) SetCreateTime(t time.Time) {
+func (m *ChatMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ChatMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -787,10 +853,10 @@ func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -805,17 +871,17 @@ func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MessageMutation) ResetCreateTime() {
+func (m *ChatMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *MessageMutation) SetUpdateTime(t time.Time) {
+func (m *ChatMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ChatMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -823,10 +889,10 @@ func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -841,74 +907,18 @@ func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MessageMutation) ResetUpdateTime() {
+func (m *ChatMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *MessageMutation) SetMessageID(i int64) {
-	m.message_id = &i
-	m.addmessage_id = nil
-}
-
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *MessageMutation) MessageID() (r int64, exists bool) {
-	v := m.message_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMessageID returns the old "message_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldMessageID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
-	}
-	return oldValue.MessageID, nil
-}
-
-// AddMessageID adds i to the "message_id" field.
-func (m *MessageMutation) AddMessageID(i int64) {
-	if m.addmessage_id != nil {
-		*m.addmessage_id += i
-	} else {
-		m.addmessage_id = &i
-	}
-}
-
-// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
-func (m *MessageMutation) AddedMessageID() (r int64, exists bool) {
-	v := m.addmessage_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *MessageMutation) ResetMessageID() {
-	m.message_id = nil
-	m.addmessage_id = nil
-}
-
 // SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(i int64) {
+func (m *ChatMutation) SetChatID(i int64) {
 	m.chat_id = &i
 	m.addchat_id = nil
 }
 
 // ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r int64, exists bool) {
+func (m *ChatMutation) ChatID() (r int64, exists bool) {
 	v := m.chat_id
 	if v == nil {
 		return
@@ -916,10 +926,10 @@ func (m *MessageMutation) ChatID() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v int64, err error) {
+func (m *ChatMutation) OldChatID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
@@ -934,7 +944,7 @@ func (m *MessageMutation) OldChatID(ctx context.Context) (v int64, err error) {
 }
 
 // AddChatID adds i to the "chat_id" field.
-func (m *MessageMutation) AddChatID(i int64) {
+func (m *ChatMutation) AddChatID(i int64) {
 	if m.addchat_id != nil {
 		*m.addchat_id += i
 	} else {
@@ -943,7 +953,7 @@ func (m *MessageMutation) AddChatID(i int64) {
 }
 
 // AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *MessageMutation) AddedChatID() (r int64, exists bool) {
+func (m *ChatMutation) AddedChatID() (r int64, exists bool) {
 	v := m.addchat_id
 	if v == nil {
 		return
@@ -952,233 +962,309 @@ func (m *MessageMutation) AddedChatID() (r int64, exists bool) {
 }
 
 // ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
+func (m *ChatMutation) ResetChatID() {
 	m.chat_id = nil
 	m.addchat_id = nil
 }
 
-// SetSenderID sets the "sender_id" field.
-func (m *MessageMutation) SetSenderID(i int64) {
-	m.sender_id = &i
-	m.addsender_id = nil
+// SetTitle sets the "title" field.
+func (m *ChatMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// SenderID returns the value of the "sender_id" field in the mutation.
-func (m *MessageMutation) SenderID() (r int64, exists bool) {
-	v := m.sender_id
+// Title returns the value of the "title" field in the mutation.
+func (m *ChatMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderID returns the old "sender_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSenderID(ctx context.Context) (v int64, err error) {
+func (m *ChatMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderID requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
-	}
-	return oldValue.SenderID, nil
-}
-
-// AddSenderID adds i to the "sender_id" field.
-func (m *MessageMutation) AddSenderID(i int64) {
-	if m.addsender_id != nil {
-		*m.addsender_id += i
-	} else {
-		m.addsender_id = &i
-	}
-}
-
-// AddedSenderID returns the value that was added to the "sender_id" field in this mutation.
-func (m *MessageMutation) AddedSenderID() (r int64, exists bool) {
-	v := m.addsender_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return *v, true
+	return oldValue.Title, nil
 }
 
-// ResetSenderID resets all changes to the "sender_id" field.
-func (m *MessageMutation) ResetSenderID() {
-	m.sender_id = nil
-	m.addsender_id = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *ChatMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetSenderName sets the "sender_name" field.
-func (m *MessageMutation) SetSenderName(s string) {
-	m.sender_name = &s
+// SetUsername sets the "username" field.
+func (m *ChatMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// SenderName returns the value of the "sender_name" field in the mutation.
-func (m *MessageMutation) SenderName() (r string, exists bool) {
-	v := m.sender_name
+// Username returns the value of the "username" field in the mutation.
+func (m *ChatMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderName returns the old "sender_name" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSenderName(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderName requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.SenderName, nil
+	return oldValue.Username, nil
 }
 
-// ResetSenderName resets all changes to the "sender_name" field.
-func (m *MessageMutation) ResetSenderName() {
-	m.sender_name = nil
+// ClearUsername clears the value of the "username" field.
+func (m *ChatMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[chat.FieldUsername] = struct{}{}
 }
 
-// SetSenderUsername sets the "sender_username" field.
-func (m *MessageMutation) SetSenderUsername(s string) {
-	m.sender_username = &s
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *ChatMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[chat.FieldUsername]
+	return ok
 }
 
-// SenderUsername returns the value of the "sender_username" field in the mutation.
-func (m *MessageMutation) SenderUsername() (r string, exists bool) {
-	v := m.sender_username
+// ResetUsername resets all changes to the "username" field.
+func (m *ChatMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, chat.FieldUsername)
+}
+
+// SetMemberCount sets the "member_count" field.
+func (m *ChatMutation) SetMemberCount(i int) {
+	m.member_count = &i
+	m.addmember_count = nil
+}
+
+// MemberCount returns the value of the "member_count" field in the mutation.
+func (m *ChatMutation) MemberCount() (r int, exists bool) {
+	v := m.member_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderUsername returns the old "sender_username" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldMemberCount returns the old "member_count" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSenderUsername(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldMemberCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemberCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderUsername requires an ID field in the mutation")
+		return v, errors.New("OldMemberCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemberCount: %w", err)
 	}
-	return oldValue.SenderUsername, nil
+	return oldValue.MemberCount, nil
 }
 
-// ClearSenderUsername clears the value of the "sender_username" field.
-func (m *MessageMutation) ClearSenderUsername() {
-	m.sender_username = nil
-	m.clearedFields[message.FieldSenderUsername] = struct{}{}
+// AddMemberCount adds i to the "member_count" field.
+func (m *ChatMutation) AddMemberCount(i int) {
+	if m.addmember_count != nil {
+		*m.addmember_count += i
+	} else {
+		m.addmember_count = &i
+	}
 }
 
-// SenderUsernameCleared returns if the "sender_username" field was cleared in this mutation.
-func (m *MessageMutation) SenderUsernameCleared() bool {
-	_, ok := m.clearedFields[message.FieldSenderUsername]
+// AddedMemberCount returns the value that was added to the "member_count" field in this mutation.
+func (m *ChatMutation) AddedMemberCount() (r int, exists bool) {
+	v := m.addmember_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (m *ChatMutation) ClearMemberCount() {
+	m.member_count = nil
+	m.addmember_count = nil
+	m.clearedFields[chat.FieldMemberCount] = struct{}{}
+}
+
+// MemberCountCleared returns if the "member_count" field was cleared in this mutation.
+func (m *ChatMutation) MemberCountCleared() bool {
+	_, ok := m.clearedFields[chat.FieldMemberCount]
 	return ok
 }
 
-// ResetSenderUsername resets all changes to the "sender_username" field.
-func (m *MessageMutation) ResetSenderUsername() {
-	m.sender_username = nil
-	delete(m.clearedFields, message.FieldSenderUsername)
+// ResetMemberCount resets all changes to the "member_count" field.
+func (m *ChatMutation) ResetMemberCount() {
+	m.member_count = nil
+	m.addmember_count = nil
+	delete(m.clearedFields, chat.FieldMemberCount)
 }
 
-// SetText sets the "text" field.
-func (m *MessageMutation) SetText(s string) {
-	m.text = &s
+// SetChatType sets the "chat_type" field.
+func (m *ChatMutation) SetChatType(ct chat.ChatType) {
+	m.chat_type = &ct
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *MessageMutation) Text() (r string, exists bool) {
-	v := m.text
+// ChatType returns the value of the "chat_type" field in the mutation.
+func (m *ChatMutation) ChatType() (r chat.ChatType, exists bool) {
+	v := m.chat_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldChatType returns the old "chat_type" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldChatType(ctx context.Context) (v chat.ChatType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldChatType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.ChatType, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *MessageMutation) ResetText() {
-	m.text = nil
+// ClearChatType clears the value of the "chat_type" field.
+func (m *ChatMutation) ClearChatType() {
+	m.chat_type = nil
+	m.clearedFields[chat.FieldChatType] = struct{}{}
 }
 
-// SetSentAt sets the "sent_at" field.
-func (m *MessageMutation) SetSentAt(t time.Time) {
-	m.sent_at = &t
+// ChatTypeCleared returns if the "chat_type" field was cleared in this mutation.
+func (m *ChatMutation) ChatTypeCleared() bool {
+	_, ok := m.clearedFields[chat.FieldChatType]
+	return ok
 }
 
-// SentAt returns the value of the "sent_at" field in the mutation.
-func (m *MessageMutation) SentAt() (r time.Time, exists bool) {
-	v := m.sent_at
+// ResetChatType resets all changes to the "chat_type" field.
+func (m *ChatMutation) ResetChatType() {
+	m.chat_type = nil
+	delete(m.clearedFields, chat.FieldChatType)
+}
+
+// SetConsent sets the "consent" field.
+func (m *ChatMutation) SetConsent(c chat.Consent) {
+	m.consent = &c
+}
+
+// Consent returns the value of the "consent" field in the mutation.
+func (m *ChatMutation) Consent() (r chat.Consent, exists bool) {
+	v := m.consent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentAt returns the old "sent_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldConsent returns the old "consent" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMutation) OldConsent(ctx context.Context) (v chat.Consent, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentAt requires an ID field in the mutation")
+		return v, errors.New("OldConsent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsent: %w", err)
 	}
-	return oldValue.SentAt, nil
+	return oldValue.Consent, nil
 }
 
-// ResetSentAt resets all changes to the "sent_at" field.
-func (m *MessageMutation) ResetSentAt() {
-	m.sent_at = nil
+// ResetConsent resets all changes to the "consent" field.
+func (m *ChatMutation) ResetConsent() {
+	m.consent = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// SetConsentUpdatedAt sets the "consent_updated_at" field.
+func (m *ChatMutation) SetConsentUpdatedAt(t time.Time) {
+	m.consent_updated_at = &t
+}
+
+// ConsentUpdatedAt returns the value of the "consent_updated_at" field in the mutation.
+func (m *ChatMutation) ConsentUpdatedAt() (r time.Time, exists bool) {
+	v := m.consent_updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsentUpdatedAt returns the old "consent_updated_at" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMutation) OldConsentUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsentUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsentUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsentUpdatedAt: %w", err)
+	}
+	return oldValue.ConsentUpdatedAt, nil
+}
+
+// ClearConsentUpdatedAt clears the value of the "consent_updated_at" field.
+func (m *ChatMutation) ClearConsentUpdatedAt() {
+	m.consent_updated_at = nil
+	m.clearedFields[chat.FieldConsentUpdatedAt] = struct{}{}
+}
+
+// ConsentUpdatedAtCleared returns if the "consent_updated_at" field was cleared in this mutation.
+func (m *ChatMutation) ConsentUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[chat.FieldConsentUpdatedAt]
+	return ok
+}
+
+// ResetConsentUpdatedAt resets all changes to the "consent_updated_at" field.
+func (m *ChatMutation) ResetConsentUpdatedAt() {
+	m.consent_updated_at = nil
+	delete(m.clearedFields, chat.FieldConsentUpdatedAt)
+}
+
+// Where appends a list predicates to the ChatMutation builder.
+func (m *ChatMutation) Where(ps ...predicate.Chat) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Chat, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1186,51 +1272,51 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ChatMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ChatMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Chat).
+func (m *ChatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
+func (m *ChatMutation) Fields() []string {
 	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, message.FieldCreateTime)
+		fields = append(fields, chat.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, message.FieldUpdateTime)
-	}
-	if m.message_id != nil {
-		fields = append(fields, message.FieldMessageID)
+		fields = append(fields, chat.FieldUpdateTime)
 	}
 	if m.chat_id != nil {
-		fields = append(fields, message.FieldChatID)
+		fields = append(fields, chat.FieldChatID)
 	}
-	if m.sender_id != nil {
-		fields = append(fields, message.FieldSenderID)
+	if m.title != nil {
+		fields = append(fields, chat.FieldTitle)
 	}
-	if m.sender_name != nil {
-		fields = append(fields, message.FieldSenderName)
+	if m.username != nil {
+		fields = append(fields, chat.FieldUsername)
 	}
-	if m.sender_username != nil {
-		fields = append(fields, message.FieldSenderUsername)
+	if m.member_count != nil {
+		fields = append(fields, chat.FieldMemberCount)
 	}
-	if m.text != nil {
-		fields = append(fields, message.FieldText)
+	if m.chat_type != nil {
+		fields = append(fields, chat.FieldChatType)
 	}
-	if m.sent_at != nil {
-		fields = append(fields, message.FieldSentAt)
+	if m.consent != nil {
+		fields = append(fields, chat.FieldConsent)
+	}
+	if m.consent_updated_at != nil {
+		fields = append(fields, chat.FieldConsentUpdatedAt)
 	}
 	return fields
 }
@@ -1238,26 +1324,26 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldCreateTime:
+	case chat.FieldCreateTime:
 		return m.CreateTime()
-	case message.FieldUpdateTime:
+	case chat.FieldUpdateTime:
 		return m.UpdateTime()
-	case message.FieldMessageID:
-		return m.MessageID()
-	case message.FieldChatID:
+	case chat.FieldChatID:
 		return m.ChatID()
-	case message.FieldSenderID:
-		return m.SenderID()
-	case message.FieldSenderName:
-		return m.SenderName()
-	case message.FieldSenderUsername:
-		return m.SenderUsername()
-	case message.FieldText:
-		return m.Text()
-	case message.FieldSentAt:
-		return m.SentAt()
+	case chat.FieldTitle:
+		return m.Title()
+	case chat.FieldUsername:
+		return m.Username()
+	case chat.FieldMemberCount:
+		return m.MemberCount()
+	case chat.FieldChatType:
+		return m.ChatType()
+	case chat.FieldConsent:
+		return m.Consent()
+	case chat.FieldConsentUpdatedAt:
+		return m.ConsentUpdatedAt()
 	}
 	return nil, false
 }
@@ -1265,114 +1351,111 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldCreateTime:
+	case chat.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case message.FieldUpdateTime:
+	case chat.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case message.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case message.FieldChatID:
+	case chat.FieldChatID:
 		return m.OldChatID(ctx)
-	case message.FieldSenderID:
-		return m.OldSenderID(ctx)
-	case message.FieldSenderName:
-		return m.OldSenderName(ctx)
-	case message.FieldSenderUsername:
-		return m.OldSenderUsername(ctx)
-	case message.FieldText:
-		return m.OldText(ctx)
-	case message.FieldSentAt:
-		return m.OldSentAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	case chat.FieldTitle:
+		return m.OldTitle(ctx)
+	case chat.FieldUsername:
+		return m.OldUsername(ctx)
+	case chat.FieldMemberCount:
+		return m.OldMemberCount(ctx)
+	case chat.FieldChatType:
+		return m.OldChatType(ctx)
+	case chat.FieldConsent:
+		return m.OldConsent(ctx)
+	case chat.FieldConsentUpdatedAt:
+		return m.OldConsentUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Chat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ChatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldCreateTime:
+	case chat.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case message.FieldUpdateTime:
+	case chat.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case message.FieldMessageID:
+	case chat.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageID(v)
+		m.SetChatID(v)
 		return nil
-	case message.FieldChatID:
-		v, ok := value.(int64)
+	case chat.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
+		m.SetTitle(v)
 		return nil
-	case message.FieldSenderID:
-		v, ok := value.(int64)
+	case chat.FieldUsername:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderID(v)
+		m.SetUsername(v)
 		return nil
-	case message.FieldSenderName:
-		v, ok := value.(string)
+	case chat.FieldMemberCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderName(v)
+		m.SetMemberCount(v)
 		return nil
-	case message.FieldSenderUsername:
-		v, ok := value.(string)
+	case chat.FieldChatType:
+		v, ok := value.(chat.ChatType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderUsername(v)
+		m.SetChatType(v)
 		return nil
-	case message.FieldText:
-		v, ok := value.(string)
+	case chat.FieldConsent:
+		v, ok := value.(chat.Consent)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetConsent(v)
 		return nil
-	case message.FieldSentAt:
+	case chat.FieldConsentUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentAt(v)
+		m.SetConsentUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *ChatMutation) AddedFields() []string {
 	var fields []string
-	if m.addmessage_id != nil {
-		fields = append(fields, message.FieldMessageID)
-	}
 	if m.addchat_id != nil {
-		fields = append(fields, message.FieldChatID)
+		fields = append(fields, chat.FieldChatID)
 	}
-	if m.addsender_id != nil {
-		fields = append(fields, message.FieldSenderID)
+	if m.addmember_count != nil {
+		fields = append(fields, chat.FieldMemberCount)
 	}
 	return fields
 }
@@ -1380,14 +1463,12 @@ func (m *MessageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldMessageID:
-		return m.AddedMessageID()
-	case message.FieldChatID:
+	case chat.FieldChatID:
 		return m.AddedChatID()
-	case message.FieldSenderID:
-		return m.AddedSenderID()
+	case chat.FieldMemberCount:
+		return m.AddedMemberCount()
 	}
 	return nil, false
 }
@@ -1395,178 +1476,205 @@ func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ChatMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldMessageID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMessageID(v)
-		return nil
-	case message.FieldChatID:
+	case chat.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddChatID(v)
 		return nil
-	case message.FieldSenderID:
-		v, ok := value.(int64)
+	case chat.FieldMemberCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSenderID(v)
+		m.AddMemberCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Chat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ChatMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldSenderUsername) {
-		fields = append(fields, message.FieldSenderUsername)
+	if m.FieldCleared(chat.FieldUsername) {
+		fields = append(fields, chat.FieldUsername)
+	}
+	if m.FieldCleared(chat.FieldMemberCount) {
+		fields = append(fields, chat.FieldMemberCount)
+	}
+	if m.FieldCleared(chat.FieldChatType) {
+		fields = append(fields, chat.FieldChatType)
+	}
+	if m.FieldCleared(chat.FieldConsentUpdatedAt) {
+		fields = append(fields, chat.FieldConsentUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ChatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ChatMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldSenderUsername:
-		m.ClearSenderUsername()
+	case chat.FieldUsername:
+		m.ClearUsername()
+		return nil
+	case chat.FieldMemberCount:
+		m.ClearMemberCount()
+		return nil
+	case chat.FieldChatType:
+		m.ClearChatType()
+		return nil
+	case chat.FieldConsentUpdatedAt:
+		m.ClearConsentUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown Chat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ChatMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCreateTime:
+	case chat.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case message.FieldUpdateTime:
+	case chat.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case message.FieldMessageID:
-		m.ResetMessageID()
-		return nil
-	case message.FieldChatID:
+	case chat.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case message.FieldSenderID:
-		m.ResetSenderID()
+	case chat.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case message.FieldSenderName:
-		m.ResetSenderName()
+	case chat.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case message.FieldSenderUsername:
-		m.ResetSenderUsername()
+	case chat.FieldMemberCount:
+		m.ResetMemberCount()
 		return nil
-	case message.FieldText:
-		m.ResetText()
+	case chat.FieldChatType:
+		m.ResetChatType()
 		return nil
-	case message.FieldSentAt:
-		m.ResetSentAt()
+	case chat.FieldConsent:
+		m.ResetConsent()
+		return nil
+	case chat.FieldConsentUpdatedAt:
+		m.ResetConsentUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ChatMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ChatMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ChatMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ChatMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *ChatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Chat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *ChatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Chat edge %s", name)
 }
 
-// SummaryMutation represents an operation that mutates the Summary nodes in the graph.
-type SummaryMutation struct {
+// DailyRunMutation represents an operation that mutates the DailyRun nodes in the graph.
+type DailyRunMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	create_time     *time.Time
-	update_time     *time.Time
-	chat_id         *int64
-	addchat_id      *int64
-	sender_id       *int64
-	addsender_id    *int64
-	sender_name     *string
-	sender_username *string
-	sender_nickname *string
-	summary_date    *time.Time
-	content         *string
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Summary, error)
-	predicates      []predicate.Summary
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	window                 *string
+	start_time             *time.Time
+	end_time               *time.Time
+	status                 *dailyrun.Status
+	error_message          *string
+	duration_ms            *int64
+	addduration_ms         *int64
+	chats_processed        *int
+	addchats_processed     *int
+	chats_failed           *int
+	addchats_failed        *int
+	messages_summarized    *int
+	addmessages_summarized *int
+	prompt_tokens          *int
+	addprompt_tokens       *int
+	completion_tokens      *int
+	addcompletion_tokens   *int
+	total_tokens           *int
+	addtotal_tokens        *int
+	cost                   *float64
+	addcost                *float64
+	messages_cleaned       *int
+	addmessages_cleaned    *int
+	lock_owner             *string
+	lock_expires_at        *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*DailyRun, error)
+	predicates             []predicate.DailyRun
 }
 
-var _ ent.Mutation = (*SummaryMutation)(nil)
+var _ ent.Mutation = (*DailyRunMutation)(nil)
 
-// summaryOption allows management of the mutation configuration using functional options.
-type summaryOption func(*SummaryMutation)
+// dailyrunOption allows management of the mutation configuration using functional options.
+type dailyrunOption func(*DailyRunMutation)
 
-// newSummaryMutation creates new mutation for the Summary entity.
-func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation {
-	m := &SummaryMutation{
+// newDailyRunMutation creates new mutation for the DailyRun entity.
+func newDailyRunMutation(c config, op Op, opts ...dailyrunOption) *DailyRunMutation {
+	m := &DailyRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSummary,
+		typ:           TypeDailyRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1575,20 +1683,20 @@ func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation
 	return m
 }
 
-// withSummaryID sets the ID field of the mutation.
-func withSummaryID(id int) summaryOption {
-	return func(m *SummaryMutation) {
+// withDailyRunID sets the ID field of the mutation.
+func withDailyRunID(id int) dailyrunOption {
+	return func(m *DailyRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Summary
+			value *DailyRun
 		)
-		m.oldValue = func(ctx context.Context) (*Summary, error) {
+		m.oldValue = func(ctx context.Context) (*DailyRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Summary.Get(ctx, id)
+					value, err = m.Client().DailyRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1597,10 +1705,10 @@ func withSummaryID(id int) summaryOption {
 	}
 }
 
-// withSummary sets the old Summary of the mutation.
-func withSummary(node *Summary) summaryOption {
-	return func(m *SummaryMutation) {
-		m.oldValue = func(context.Context) (*Summary, error) {
+// withDailyRun sets the old DailyRun of the mutation.
+func withDailyRun(node *DailyRun) dailyrunOption {
+	return func(m *DailyRunMutation) {
+		m.oldValue = func(context.Context) (*DailyRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1609,7 +1717,7 @@ func withSummary(node *Summary) summaryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SummaryMutation) Client() *Client {
+func (m DailyRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1617,7 +1725,7 @@ func (m SummaryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SummaryMutation) Tx() (*Tx, error) {
+func (m DailyRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1628,7 +1736,7 @@ func (m SummaryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SummaryMutation) ID() (id int, exists bool) {
+func (m *DailyRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1639,7 +1747,7 @@ func (m *SummaryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SummaryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DailyRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1648,19 +1756,19 @@ func (m *SummaryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Summary.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DailyRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *SummaryMutation) SetCreateTime(t time.Time) {
+func (m *DailyRunMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *SummaryMutation) CreateTime() (r time.Time, exists bool) {
+func (m *DailyRunMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1668,10 +1776,10 @@ func (m *SummaryMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DailyRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1686,17 +1794,17 @@ func (m *SummaryMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *SummaryMutation) ResetCreateTime() {
+func (m *DailyRunMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *SummaryMutation) SetUpdateTime(t time.Time) {
+func (m *DailyRunMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *SummaryMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *DailyRunMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1704,10 +1812,10 @@ func (m *SummaryMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DailyRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1722,248 +1830,7739 @@ func (m *SummaryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *SummaryMutation) ResetUpdateTime() {
+func (m *DailyRunMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *SummaryMutation) SetChatID(i int64) {
-	m.chat_id = &i
-	m.addchat_id = nil
+// SetWindow sets the "window" field.
+func (m *DailyRunMutation) SetWindow(s string) {
+	m.window = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *SummaryMutation) ChatID() (r int64, exists bool) {
-	v := m.chat_id
+// Window returns the value of the "window" field in the mutation.
+func (m *DailyRunMutation) Window() (r string, exists bool) {
+	v := m.window
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldWindow returns the old "window" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldChatID(ctx context.Context) (v int64, err error) {
+func (m *DailyRunMutation) OldWindow(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWindow is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldWindow requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// AddChatID adds i to the "chat_id" field.
-func (m *SummaryMutation) AddChatID(i int64) {
-	if m.addchat_id != nil {
-		*m.addchat_id += i
-	} else {
-		m.addchat_id = &i
-	}
-}
-
-// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *SummaryMutation) AddedChatID() (r int64, exists bool) {
-	v := m.addchat_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldWindow: %w", err)
 	}
-	return *v, true
+	return oldValue.Window, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *SummaryMutation) ResetChatID() {
-	m.chat_id = nil
-	m.addchat_id = nil
+// ResetWindow resets all changes to the "window" field.
+func (m *DailyRunMutation) ResetWindow() {
+	m.window = nil
 }
 
-// SetSenderID sets the "sender_id" field.
-func (m *SummaryMutation) SetSenderID(i int64) {
-	m.sender_id = &i
-	m.addsender_id = nil
+// SetStartTime sets the "start_time" field.
+func (m *DailyRunMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
 }
 
-// SenderID returns the value of the "sender_id" field in the mutation.
-func (m *SummaryMutation) SenderID() (r int64, exists bool) {
-	v := m.sender_id
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *DailyRunMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderID returns the old "sender_id" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "start_time" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSenderID(ctx context.Context) (v int64, err error) {
+func (m *DailyRunMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderID requires an ID field in the mutation")
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.SenderID, nil
+	return oldValue.StartTime, nil
 }
 
-// AddSenderID adds i to the "sender_id" field.
-func (m *SummaryMutation) AddSenderID(i int64) {
-	if m.addsender_id != nil {
-		*m.addsender_id += i
-	} else {
-		m.addsender_id = &i
-	}
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *DailyRunMutation) ResetStartTime() {
+	m.start_time = nil
 }
 
-// AddedSenderID returns the value that was added to the "sender_id" field in this mutation.
-func (m *SummaryMutation) AddedSenderID() (r int64, exists bool) {
-	v := m.addsender_id
+// SetEndTime sets the "end_time" field.
+func (m *DailyRunMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *DailyRunMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSenderID resets all changes to the "sender_id" field.
-func (m *SummaryMutation) ResetSenderID() {
-	m.sender_id = nil
-	m.addsender_id = nil
+// OldEndTime returns the old "end_time" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
 }
 
-// SetSenderName sets the "sender_name" field.
-func (m *SummaryMutation) SetSenderName(s string) {
-	m.sender_name = &s
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *DailyRunMutation) ResetEndTime() {
+	m.end_time = nil
 }
 
-// SenderName returns the value of the "sender_name" field in the mutation.
-func (m *SummaryMutation) SenderName() (r string, exists bool) {
-	v := m.sender_name
+// SetStatus sets the "status" field.
+func (m *DailyRunMutation) SetStatus(d dailyrun.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DailyRunMutation) Status() (r dailyrun.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderName returns the old "sender_name" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSenderName(ctx context.Context) (v string, err error) {
+func (m *DailyRunMutation) OldStatus(ctx context.Context) (v dailyrun.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.SenderName, nil
+	return oldValue.Status, nil
 }
 
-// ResetSenderName resets all changes to the "sender_name" field.
-func (m *SummaryMutation) ResetSenderName() {
-	m.sender_name = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *DailyRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetSenderUsername sets the "sender_username" field.
-func (m *SummaryMutation) SetSenderUsername(s string) {
-	m.sender_username = &s
+// SetErrorMessage sets the "error_message" field.
+func (m *DailyRunMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// SenderUsername returns the value of the "sender_username" field in the mutation.
-func (m *SummaryMutation) SenderUsername() (r string, exists bool) {
-	v := m.sender_username
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *DailyRunMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderUsername returns the old "sender_username" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSenderUsername(ctx context.Context) (v string, err error) {
+func (m *DailyRunMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderUsername requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.SenderUsername, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearSenderUsername clears the value of the "sender_username" field.
-func (m *SummaryMutation) ClearSenderUsername() {
-	m.sender_username = nil
-	m.clearedFields[summary.FieldSenderUsername] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *DailyRunMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[dailyrun.FieldErrorMessage] = struct{}{}
 }
 
-// SenderUsernameCleared returns if the "sender_username" field was cleared in this mutation.
-func (m *SummaryMutation) SenderUsernameCleared() bool {
-	_, ok := m.clearedFields[summary.FieldSenderUsername]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *DailyRunMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[dailyrun.FieldErrorMessage]
 	return ok
 }
 
-// ResetSenderUsername resets all changes to the "sender_username" field.
-func (m *SummaryMutation) ResetSenderUsername() {
-	m.sender_username = nil
-	delete(m.clearedFields, summary.FieldSenderUsername)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *DailyRunMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, dailyrun.FieldErrorMessage)
 }
 
-// SetSenderNickname sets the "sender_nickname" field.
-func (m *SummaryMutation) SetSenderNickname(s string) {
-	m.sender_nickname = &s
+// SetDurationMs sets the "duration_ms" field.
+func (m *DailyRunMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// SenderNickname returns the value of the "sender_nickname" field in the mutation.
-func (m *SummaryMutation) SenderNickname() (r string, exists bool) {
-	v := m.sender_nickname
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *DailyRunMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderNickname returns the old "sender_nickname" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSenderNickname(ctx context.Context) (v string, err error) {
+func (m *DailyRunMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderNickname is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderNickname requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderNickname: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.SenderNickname, nil
+	return oldValue.DurationMs, nil
 }
 
-// ClearSenderNickname clears the value of the "sender_nickname" field.
-func (m *SummaryMutation) ClearSenderNickname() {
-	m.sender_nickname = nil
-	m.clearedFields[summary.FieldSenderNickname] = struct{}{}
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *DailyRunMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// SenderNicknameCleared returns if the "sender_nickname" field was cleared in this mutation.
-func (m *SummaryMutation) SenderNicknameCleared() bool {
-	_, ok := m.clearedFields[summary.FieldSenderNickname]
-	return ok
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *DailyRunMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *DailyRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetChatsProcessed sets the "chats_processed" field.
+func (m *DailyRunMutation) SetChatsProcessed(i int) {
+	m.chats_processed = &i
+	m.addchats_processed = nil
+}
+
+// ChatsProcessed returns the value of the "chats_processed" field in the mutation.
+func (m *DailyRunMutation) ChatsProcessed() (r int, exists bool) {
+	v := m.chats_processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatsProcessed returns the old "chats_processed" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldChatsProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatsProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatsProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatsProcessed: %w", err)
+	}
+	return oldValue.ChatsProcessed, nil
+}
+
+// AddChatsProcessed adds i to the "chats_processed" field.
+func (m *DailyRunMutation) AddChatsProcessed(i int) {
+	if m.addchats_processed != nil {
+		*m.addchats_processed += i
+	} else {
+		m.addchats_processed = &i
+	}
+}
+
+// AddedChatsProcessed returns the value that was added to the "chats_processed" field in this mutation.
+func (m *DailyRunMutation) AddedChatsProcessed() (r int, exists bool) {
+	v := m.addchats_processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatsProcessed resets all changes to the "chats_processed" field.
+func (m *DailyRunMutation) ResetChatsProcessed() {
+	m.chats_processed = nil
+	m.addchats_processed = nil
+}
+
+// SetChatsFailed sets the "chats_failed" field.
+func (m *DailyRunMutation) SetChatsFailed(i int) {
+	m.chats_failed = &i
+	m.addchats_failed = nil
+}
+
+// ChatsFailed returns the value of the "chats_failed" field in the mutation.
+func (m *DailyRunMutation) ChatsFailed() (r int, exists bool) {
+	v := m.chats_failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatsFailed returns the old "chats_failed" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldChatsFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatsFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatsFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatsFailed: %w", err)
+	}
+	return oldValue.ChatsFailed, nil
+}
+
+// AddChatsFailed adds i to the "chats_failed" field.
+func (m *DailyRunMutation) AddChatsFailed(i int) {
+	if m.addchats_failed != nil {
+		*m.addchats_failed += i
+	} else {
+		m.addchats_failed = &i
+	}
+}
+
+// AddedChatsFailed returns the value that was added to the "chats_failed" field in this mutation.
+func (m *DailyRunMutation) AddedChatsFailed() (r int, exists bool) {
+	v := m.addchats_failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatsFailed resets all changes to the "chats_failed" field.
+func (m *DailyRunMutation) ResetChatsFailed() {
+	m.chats_failed = nil
+	m.addchats_failed = nil
+}
+
+// SetMessagesSummarized sets the "messages_summarized" field.
+func (m *DailyRunMutation) SetMessagesSummarized(i int) {
+	m.messages_summarized = &i
+	m.addmessages_summarized = nil
+}
+
+// MessagesSummarized returns the value of the "messages_summarized" field in the mutation.
+func (m *DailyRunMutation) MessagesSummarized() (r int, exists bool) {
+	v := m.messages_summarized
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessagesSummarized returns the old "messages_summarized" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldMessagesSummarized(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessagesSummarized is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessagesSummarized requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessagesSummarized: %w", err)
+	}
+	return oldValue.MessagesSummarized, nil
+}
+
+// AddMessagesSummarized adds i to the "messages_summarized" field.
+func (m *DailyRunMutation) AddMessagesSummarized(i int) {
+	if m.addmessages_summarized != nil {
+		*m.addmessages_summarized += i
+	} else {
+		m.addmessages_summarized = &i
+	}
+}
+
+// AddedMessagesSummarized returns the value that was added to the "messages_summarized" field in this mutation.
+func (m *DailyRunMutation) AddedMessagesSummarized() (r int, exists bool) {
+	v := m.addmessages_summarized
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessagesSummarized resets all changes to the "messages_summarized" field.
+func (m *DailyRunMutation) ResetMessagesSummarized() {
+	m.messages_summarized = nil
+	m.addmessages_summarized = nil
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *DailyRunMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *DailyRunMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *DailyRunMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *DailyRunMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *DailyRunMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *DailyRunMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *DailyRunMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *DailyRunMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *DailyRunMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *DailyRunMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (m *DailyRunMutation) SetTotalTokens(i int) {
+	m.total_tokens = &i
+	m.addtotal_tokens = nil
+}
+
+// TotalTokens returns the value of the "total_tokens" field in the mutation.
+func (m *DailyRunMutation) TotalTokens() (r int, exists bool) {
+	v := m.total_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTokens returns the old "total_tokens" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldTotalTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTokens: %w", err)
+	}
+	return oldValue.TotalTokens, nil
+}
+
+// AddTotalTokens adds i to the "total_tokens" field.
+func (m *DailyRunMutation) AddTotalTokens(i int) {
+	if m.addtotal_tokens != nil {
+		*m.addtotal_tokens += i
+	} else {
+		m.addtotal_tokens = &i
+	}
+}
+
+// AddedTotalTokens returns the value that was added to the "total_tokens" field in this mutation.
+func (m *DailyRunMutation) AddedTotalTokens() (r int, exists bool) {
+	v := m.addtotal_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTokens resets all changes to the "total_tokens" field.
+func (m *DailyRunMutation) ResetTotalTokens() {
+	m.total_tokens = nil
+	m.addtotal_tokens = nil
+}
+
+// SetCost sets the "cost" field.
+func (m *DailyRunMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *DailyRunMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *DailyRunMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *DailyRunMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *DailyRunMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+}
+
+// SetMessagesCleaned sets the "messages_cleaned" field.
+func (m *DailyRunMutation) SetMessagesCleaned(i int) {
+	m.messages_cleaned = &i
+	m.addmessages_cleaned = nil
+}
+
+// MessagesCleaned returns the value of the "messages_cleaned" field in the mutation.
+func (m *DailyRunMutation) MessagesCleaned() (r int, exists bool) {
+	v := m.messages_cleaned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessagesCleaned returns the old "messages_cleaned" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldMessagesCleaned(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessagesCleaned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessagesCleaned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessagesCleaned: %w", err)
+	}
+	return oldValue.MessagesCleaned, nil
+}
+
+// AddMessagesCleaned adds i to the "messages_cleaned" field.
+func (m *DailyRunMutation) AddMessagesCleaned(i int) {
+	if m.addmessages_cleaned != nil {
+		*m.addmessages_cleaned += i
+	} else {
+		m.addmessages_cleaned = &i
+	}
+}
+
+// AddedMessagesCleaned returns the value that was added to the "messages_cleaned" field in this mutation.
+func (m *DailyRunMutation) AddedMessagesCleaned() (r int, exists bool) {
+	v := m.addmessages_cleaned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessagesCleaned resets all changes to the "messages_cleaned" field.
+func (m *DailyRunMutation) ResetMessagesCleaned() {
+	m.messages_cleaned = nil
+	m.addmessages_cleaned = nil
+}
+
+// SetLockOwner sets the "lock_owner" field.
+func (m *DailyRunMutation) SetLockOwner(s string) {
+	m.lock_owner = &s
+}
+
+// LockOwner returns the value of the "lock_owner" field in the mutation.
+func (m *DailyRunMutation) LockOwner() (r string, exists bool) {
+	v := m.lock_owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockOwner returns the old "lock_owner" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldLockOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockOwner: %w", err)
+	}
+	return oldValue.LockOwner, nil
+}
+
+// ClearLockOwner clears the value of the "lock_owner" field.
+func (m *DailyRunMutation) ClearLockOwner() {
+	m.lock_owner = nil
+	m.clearedFields[dailyrun.FieldLockOwner] = struct{}{}
+}
+
+// LockOwnerCleared returns if the "lock_owner" field was cleared in this mutation.
+func (m *DailyRunMutation) LockOwnerCleared() bool {
+	_, ok := m.clearedFields[dailyrun.FieldLockOwner]
+	return ok
+}
+
+// ResetLockOwner resets all changes to the "lock_owner" field.
+func (m *DailyRunMutation) ResetLockOwner() {
+	m.lock_owner = nil
+	delete(m.clearedFields, dailyrun.FieldLockOwner)
+}
+
+// SetLockExpiresAt sets the "lock_expires_at" field.
+func (m *DailyRunMutation) SetLockExpiresAt(t time.Time) {
+	m.lock_expires_at = &t
+}
+
+// LockExpiresAt returns the value of the "lock_expires_at" field in the mutation.
+func (m *DailyRunMutation) LockExpiresAt() (r time.Time, exists bool) {
+	v := m.lock_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockExpiresAt returns the old "lock_expires_at" field's value of the DailyRun entity.
+// If the DailyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyRunMutation) OldLockExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockExpiresAt: %w", err)
+	}
+	return oldValue.LockExpiresAt, nil
+}
+
+// ClearLockExpiresAt clears the value of the "lock_expires_at" field.
+func (m *DailyRunMutation) ClearLockExpiresAt() {
+	m.lock_expires_at = nil
+	m.clearedFields[dailyrun.FieldLockExpiresAt] = struct{}{}
+}
+
+// LockExpiresAtCleared returns if the "lock_expires_at" field was cleared in this mutation.
+func (m *DailyRunMutation) LockExpiresAtCleared() bool {
+	_, ok := m.clearedFields[dailyrun.FieldLockExpiresAt]
+	return ok
+}
+
+// ResetLockExpiresAt resets all changes to the "lock_expires_at" field.
+func (m *DailyRunMutation) ResetLockExpiresAt() {
+	m.lock_expires_at = nil
+	delete(m.clearedFields, dailyrun.FieldLockExpiresAt)
+}
+
+// Where appends a list predicates to the DailyRunMutation builder.
+func (m *DailyRunMutation) Where(ps ...predicate.DailyRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DailyRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DailyRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DailyRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DailyRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DailyRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DailyRun).
+func (m *DailyRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DailyRunMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.create_time != nil {
+		fields = append(fields, dailyrun.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, dailyrun.FieldUpdateTime)
+	}
+	if m.window != nil {
+		fields = append(fields, dailyrun.FieldWindow)
+	}
+	if m.start_time != nil {
+		fields = append(fields, dailyrun.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, dailyrun.FieldEndTime)
+	}
+	if m.status != nil {
+		fields = append(fields, dailyrun.FieldStatus)
+	}
+	if m.error_message != nil {
+		fields = append(fields, dailyrun.FieldErrorMessage)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, dailyrun.FieldDurationMs)
+	}
+	if m.chats_processed != nil {
+		fields = append(fields, dailyrun.FieldChatsProcessed)
+	}
+	if m.chats_failed != nil {
+		fields = append(fields, dailyrun.FieldChatsFailed)
+	}
+	if m.messages_summarized != nil {
+		fields = append(fields, dailyrun.FieldMessagesSummarized)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, dailyrun.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, dailyrun.FieldCompletionTokens)
+	}
+	if m.total_tokens != nil {
+		fields = append(fields, dailyrun.FieldTotalTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, dailyrun.FieldCost)
+	}
+	if m.messages_cleaned != nil {
+		fields = append(fields, dailyrun.FieldMessagesCleaned)
+	}
+	if m.lock_owner != nil {
+		fields = append(fields, dailyrun.FieldLockOwner)
+	}
+	if m.lock_expires_at != nil {
+		fields = append(fields, dailyrun.FieldLockExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DailyRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dailyrun.FieldCreateTime:
+		return m.CreateTime()
+	case dailyrun.FieldUpdateTime:
+		return m.UpdateTime()
+	case dailyrun.FieldWindow:
+		return m.Window()
+	case dailyrun.FieldStartTime:
+		return m.StartTime()
+	case dailyrun.FieldEndTime:
+		return m.EndTime()
+	case dailyrun.FieldStatus:
+		return m.Status()
+	case dailyrun.FieldErrorMessage:
+		return m.ErrorMessage()
+	case dailyrun.FieldDurationMs:
+		return m.DurationMs()
+	case dailyrun.FieldChatsProcessed:
+		return m.ChatsProcessed()
+	case dailyrun.FieldChatsFailed:
+		return m.ChatsFailed()
+	case dailyrun.FieldMessagesSummarized:
+		return m.MessagesSummarized()
+	case dailyrun.FieldPromptTokens:
+		return m.PromptTokens()
+	case dailyrun.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case dailyrun.FieldTotalTokens:
+		return m.TotalTokens()
+	case dailyrun.FieldCost:
+		return m.Cost()
+	case dailyrun.FieldMessagesCleaned:
+		return m.MessagesCleaned()
+	case dailyrun.FieldLockOwner:
+		return m.LockOwner()
+	case dailyrun.FieldLockExpiresAt:
+		return m.LockExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DailyRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dailyrun.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case dailyrun.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case dailyrun.FieldWindow:
+		return m.OldWindow(ctx)
+	case dailyrun.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case dailyrun.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case dailyrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case dailyrun.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case dailyrun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case dailyrun.FieldChatsProcessed:
+		return m.OldChatsProcessed(ctx)
+	case dailyrun.FieldChatsFailed:
+		return m.OldChatsFailed(ctx)
+	case dailyrun.FieldMessagesSummarized:
+		return m.OldMessagesSummarized(ctx)
+	case dailyrun.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case dailyrun.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case dailyrun.FieldTotalTokens:
+		return m.OldTotalTokens(ctx)
+	case dailyrun.FieldCost:
+		return m.OldCost(ctx)
+	case dailyrun.FieldMessagesCleaned:
+		return m.OldMessagesCleaned(ctx)
+	case dailyrun.FieldLockOwner:
+		return m.OldLockOwner(ctx)
+	case dailyrun.FieldLockExpiresAt:
+		return m.OldLockExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DailyRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DailyRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dailyrun.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case dailyrun.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case dailyrun.FieldWindow:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindow(v)
+		return nil
+	case dailyrun.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case dailyrun.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case dailyrun.FieldStatus:
+		v, ok := value.(dailyrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case dailyrun.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case dailyrun.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case dailyrun.FieldChatsProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatsProcessed(v)
+		return nil
+	case dailyrun.FieldChatsFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatsFailed(v)
+		return nil
+	case dailyrun.FieldMessagesSummarized:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessagesSummarized(v)
+		return nil
+	case dailyrun.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case dailyrun.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case dailyrun.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTokens(v)
+		return nil
+	case dailyrun.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case dailyrun.FieldMessagesCleaned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessagesCleaned(v)
+		return nil
+	case dailyrun.FieldLockOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockOwner(v)
+		return nil
+	case dailyrun.FieldLockExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DailyRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DailyRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, dailyrun.FieldDurationMs)
+	}
+	if m.addchats_processed != nil {
+		fields = append(fields, dailyrun.FieldChatsProcessed)
+	}
+	if m.addchats_failed != nil {
+		fields = append(fields, dailyrun.FieldChatsFailed)
+	}
+	if m.addmessages_summarized != nil {
+		fields = append(fields, dailyrun.FieldMessagesSummarized)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, dailyrun.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, dailyrun.FieldCompletionTokens)
+	}
+	if m.addtotal_tokens != nil {
+		fields = append(fields, dailyrun.FieldTotalTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, dailyrun.FieldCost)
+	}
+	if m.addmessages_cleaned != nil {
+		fields = append(fields, dailyrun.FieldMessagesCleaned)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DailyRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dailyrun.FieldDurationMs:
+		return m.AddedDurationMs()
+	case dailyrun.FieldChatsProcessed:
+		return m.AddedChatsProcessed()
+	case dailyrun.FieldChatsFailed:
+		return m.AddedChatsFailed()
+	case dailyrun.FieldMessagesSummarized:
+		return m.AddedMessagesSummarized()
+	case dailyrun.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case dailyrun.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case dailyrun.FieldTotalTokens:
+		return m.AddedTotalTokens()
+	case dailyrun.FieldCost:
+		return m.AddedCost()
+	case dailyrun.FieldMessagesCleaned:
+		return m.AddedMessagesCleaned()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DailyRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case dailyrun.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	case dailyrun.FieldChatsProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatsProcessed(v)
+		return nil
+	case dailyrun.FieldChatsFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatsFailed(v)
+		return nil
+	case dailyrun.FieldMessagesSummarized:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessagesSummarized(v)
+		return nil
+	case dailyrun.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case dailyrun.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case dailyrun.FieldTotalTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTokens(v)
+		return nil
+	case dailyrun.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case dailyrun.FieldMessagesCleaned:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessagesCleaned(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DailyRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DailyRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dailyrun.FieldErrorMessage) {
+		fields = append(fields, dailyrun.FieldErrorMessage)
+	}
+	if m.FieldCleared(dailyrun.FieldLockOwner) {
+		fields = append(fields, dailyrun.FieldLockOwner)
+	}
+	if m.FieldCleared(dailyrun.FieldLockExpiresAt) {
+		fields = append(fields, dailyrun.FieldLockExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DailyRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DailyRunMutation) ClearField(name string) error {
+	switch name {
+	case dailyrun.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case dailyrun.FieldLockOwner:
+		m.ClearLockOwner()
+		return nil
+	case dailyrun.FieldLockExpiresAt:
+		m.ClearLockExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DailyRunMutation) ResetField(name string) error {
+	switch name {
+	case dailyrun.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case dailyrun.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case dailyrun.FieldWindow:
+		m.ResetWindow()
+		return nil
+	case dailyrun.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case dailyrun.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case dailyrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case dailyrun.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case dailyrun.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case dailyrun.FieldChatsProcessed:
+		m.ResetChatsProcessed()
+		return nil
+	case dailyrun.FieldChatsFailed:
+		m.ResetChatsFailed()
+		return nil
+	case dailyrun.FieldMessagesSummarized:
+		m.ResetMessagesSummarized()
+		return nil
+	case dailyrun.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case dailyrun.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case dailyrun.FieldTotalTokens:
+		m.ResetTotalTokens()
+		return nil
+	case dailyrun.FieldCost:
+		m.ResetCost()
+		return nil
+	case dailyrun.FieldMessagesCleaned:
+		m.ResetMessagesCleaned()
+		return nil
+	case dailyrun.FieldLockOwner:
+		m.ResetLockOwner()
+		return nil
+	case dailyrun.FieldLockExpiresAt:
+		m.ResetLockExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DailyRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DailyRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DailyRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DailyRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DailyRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DailyRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DailyRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DailyRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DailyRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DailyRun edge %s", name)
+}
+
+// FollowMutation represents an operation that mutates the Follow nodes in the graph.
+type FollowMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user_id       *int64
+	adduser_id    *int64
+	keyword       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Follow, error)
+	predicates    []predicate.Follow
+}
+
+var _ ent.Mutation = (*FollowMutation)(nil)
+
+// followOption allows management of the mutation configuration using functional options.
+type followOption func(*FollowMutation)
+
+// newFollowMutation creates new mutation for the Follow entity.
+func newFollowMutation(c config, op Op, opts ...followOption) *FollowMutation {
+	m := &FollowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFollow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFollowID sets the ID field of the mutation.
+func withFollowID(id int) followOption {
+	return func(m *FollowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Follow
+		)
+		m.oldValue = func(ctx context.Context) (*Follow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Follow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFollow sets the old Follow of the mutation.
+func withFollow(node *Follow) followOption {
+	return func(m *FollowMutation) {
+		m.oldValue = func(context.Context) (*Follow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FollowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FollowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FollowMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FollowMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Follow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FollowMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FollowMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FollowMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FollowMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FollowMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FollowMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FollowMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FollowMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *FollowMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *FollowMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FollowMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetKeyword sets the "keyword" field.
+func (m *FollowMutation) SetKeyword(s string) {
+	m.keyword = &s
+}
+
+// Keyword returns the value of the "keyword" field in the mutation.
+func (m *FollowMutation) Keyword() (r string, exists bool) {
+	v := m.keyword
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyword returns the old "keyword" field's value of the Follow entity.
+// If the Follow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FollowMutation) OldKeyword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyword: %w", err)
+	}
+	return oldValue.Keyword, nil
+}
+
+// ResetKeyword resets all changes to the "keyword" field.
+func (m *FollowMutation) ResetKeyword() {
+	m.keyword = nil
+}
+
+// Where appends a list predicates to the FollowMutation builder.
+func (m *FollowMutation) Where(ps ...predicate.Follow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FollowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FollowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Follow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FollowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FollowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Follow).
+func (m *FollowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FollowMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, follow.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, follow.FieldUpdateTime)
+	}
+	if m.user_id != nil {
+		fields = append(fields, follow.FieldUserID)
+	}
+	if m.keyword != nil {
+		fields = append(fields, follow.FieldKeyword)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FollowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case follow.FieldCreateTime:
+		return m.CreateTime()
+	case follow.FieldUpdateTime:
+		return m.UpdateTime()
+	case follow.FieldUserID:
+		return m.UserID()
+	case follow.FieldKeyword:
+		return m.Keyword()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FollowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case follow.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case follow.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case follow.FieldUserID:
+		return m.OldUserID(ctx)
+	case follow.FieldKeyword:
+		return m.OldKeyword(ctx)
+	}
+	return nil, fmt.Errorf("unknown Follow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case follow.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case follow.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case follow.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case follow.FieldKeyword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyword(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Follow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FollowMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, follow.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FollowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case follow.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FollowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case follow.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Follow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FollowMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FollowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FollowMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Follow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FollowMutation) ResetField(name string) error {
+	switch name {
+	case follow.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case follow.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case follow.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case follow.FieldKeyword:
+		m.ResetKeyword()
+		return nil
+	}
+	return fmt.Errorf("unknown Follow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FollowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FollowMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FollowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FollowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FollowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FollowMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FollowMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Follow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FollowMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Follow edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	message_id             *int64
+	addmessage_id          *int64
+	server_message_id      *int64
+	addserver_message_id   *int64
+	chat_id                *int64
+	addchat_id             *int64
+	sender_id              *int64
+	addsender_id           *int64
+	sender_name            *string
+	sender_username        *string
+	text                   *string
+	text_zstd              *[]byte
+	sent_at                *time.Time
+	lang                   *string
+	deleted_at             *time.Time
+	content_type           *string
+	edited_at              *time.Time
+	message_thread_id      *int64
+	addmessage_thread_id   *int64
+	reply_to_message_id    *int64
+	addreply_to_message_id *int64
+	reaction_count         *int64
+	addreaction_count      *int64
+	sender_type            *string
+	poll                   *string
+	account_id             *int64
+	addaccount_id          *int64
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Message, error)
+	predicates             []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id int) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MessageMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MessageMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MessageMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MessageMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageMutation) SetMessageID(i int64) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageMutation) MessageID() (r int64, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMessageID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (m *MessageMutation) AddMessageID(i int64) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
+	}
+}
+
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *MessageMutation) AddedMessageID() (r int64, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+}
+
+// SetServerMessageID sets the "server_message_id" field.
+func (m *MessageMutation) SetServerMessageID(i int64) {
+	m.server_message_id = &i
+	m.addserver_message_id = nil
+}
+
+// ServerMessageID returns the value of the "server_message_id" field in the mutation.
+func (m *MessageMutation) ServerMessageID() (r int64, exists bool) {
+	v := m.server_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServerMessageID returns the old "server_message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldServerMessageID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServerMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServerMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServerMessageID: %w", err)
+	}
+	return oldValue.ServerMessageID, nil
+}
+
+// AddServerMessageID adds i to the "server_message_id" field.
+func (m *MessageMutation) AddServerMessageID(i int64) {
+	if m.addserver_message_id != nil {
+		*m.addserver_message_id += i
+	} else {
+		m.addserver_message_id = &i
+	}
+}
+
+// AddedServerMessageID returns the value that was added to the "server_message_id" field in this mutation.
+func (m *MessageMutation) AddedServerMessageID() (r int64, exists bool) {
+	v := m.addserver_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearServerMessageID clears the value of the "server_message_id" field.
+func (m *MessageMutation) ClearServerMessageID() {
+	m.server_message_id = nil
+	m.addserver_message_id = nil
+	m.clearedFields[message.FieldServerMessageID] = struct{}{}
+}
+
+// ServerMessageIDCleared returns if the "server_message_id" field was cleared in this mutation.
+func (m *MessageMutation) ServerMessageIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldServerMessageID]
+	return ok
+}
+
+// ResetServerMessageID resets all changes to the "server_message_id" field.
+func (m *MessageMutation) ResetServerMessageID() {
+	m.server_message_id = nil
+	m.addserver_message_id = nil
+	delete(m.clearedFields, message.FieldServerMessageID)
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *MessageMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *MessageMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *MessageMutation) SetSenderID(i int64) {
+	m.sender_id = &i
+	m.addsender_id = nil
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *MessageMutation) SenderID() (r int64, exists bool) {
+	v := m.sender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (m *MessageMutation) AddSenderID(i int64) {
+	if m.addsender_id != nil {
+		*m.addsender_id += i
+	} else {
+		m.addsender_id = &i
+	}
+}
+
+// AddedSenderID returns the value that was added to the "sender_id" field in this mutation.
+func (m *MessageMutation) AddedSenderID() (r int64, exists bool) {
+	v := m.addsender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *MessageMutation) ResetSenderID() {
+	m.sender_id = nil
+	m.addsender_id = nil
+}
+
+// SetSenderName sets the "sender_name" field.
+func (m *MessageMutation) SetSenderName(s string) {
+	m.sender_name = &s
+}
+
+// SenderName returns the value of the "sender_name" field in the mutation.
+func (m *MessageMutation) SenderName() (r string, exists bool) {
+	v := m.sender_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderName returns the old "sender_name" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+	}
+	return oldValue.SenderName, nil
+}
+
+// ResetSenderName resets all changes to the "sender_name" field.
+func (m *MessageMutation) ResetSenderName() {
+	m.sender_name = nil
+}
+
+// SetSenderUsername sets the "sender_username" field.
+func (m *MessageMutation) SetSenderUsername(s string) {
+	m.sender_username = &s
+}
+
+// SenderUsername returns the value of the "sender_username" field in the mutation.
+func (m *MessageMutation) SenderUsername() (r string, exists bool) {
+	v := m.sender_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderUsername returns the old "sender_username" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderUsername: %w", err)
+	}
+	return oldValue.SenderUsername, nil
+}
+
+// ClearSenderUsername clears the value of the "sender_username" field.
+func (m *MessageMutation) ClearSenderUsername() {
+	m.sender_username = nil
+	m.clearedFields[message.FieldSenderUsername] = struct{}{}
+}
+
+// SenderUsernameCleared returns if the "sender_username" field was cleared in this mutation.
+func (m *MessageMutation) SenderUsernameCleared() bool {
+	_, ok := m.clearedFields[message.FieldSenderUsername]
+	return ok
+}
+
+// ResetSenderUsername resets all changes to the "sender_username" field.
+func (m *MessageMutation) ResetSenderUsername() {
+	m.sender_username = nil
+	delete(m.clearedFields, message.FieldSenderUsername)
+}
+
+// SetText sets the "text" field.
+func (m *MessageMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *MessageMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *MessageMutation) ResetText() {
+	m.text = nil
+}
+
+// SetTextZstd sets the "text_zstd" field.
+func (m *MessageMutation) SetTextZstd(b []byte) {
+	m.text_zstd = &b
+}
+
+// TextZstd returns the value of the "text_zstd" field in the mutation.
+func (m *MessageMutation) TextZstd() (r []byte, exists bool) {
+	v := m.text_zstd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextZstd returns the old "text_zstd" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTextZstd(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextZstd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextZstd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextZstd: %w", err)
+	}
+	return oldValue.TextZstd, nil
+}
+
+// ClearTextZstd clears the value of the "text_zstd" field.
+func (m *MessageMutation) ClearTextZstd() {
+	m.text_zstd = nil
+	m.clearedFields[message.FieldTextZstd] = struct{}{}
+}
+
+// TextZstdCleared returns if the "text_zstd" field was cleared in this mutation.
+func (m *MessageMutation) TextZstdCleared() bool {
+	_, ok := m.clearedFields[message.FieldTextZstd]
+	return ok
+}
+
+// ResetTextZstd resets all changes to the "text_zstd" field.
+func (m *MessageMutation) ResetTextZstd() {
+	m.text_zstd = nil
+	delete(m.clearedFields, message.FieldTextZstd)
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *MessageMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *MessageMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *MessageMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetLang sets the "lang" field.
+func (m *MessageMutation) SetLang(s string) {
+	m.lang = &s
+}
+
+// Lang returns the value of the "lang" field in the mutation.
+func (m *MessageMutation) Lang() (r string, exists bool) {
+	v := m.lang
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLang returns the old "lang" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldLang(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLang is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLang requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLang: %w", err)
+	}
+	return oldValue.Lang, nil
+}
+
+// ClearLang clears the value of the "lang" field.
+func (m *MessageMutation) ClearLang() {
+	m.lang = nil
+	m.clearedFields[message.FieldLang] = struct{}{}
+}
+
+// LangCleared returns if the "lang" field was cleared in this mutation.
+func (m *MessageMutation) LangCleared() bool {
+	_, ok := m.clearedFields[message.FieldLang]
+	return ok
+}
+
+// ResetLang resets all changes to the "lang" field.
+func (m *MessageMutation) ResetLang() {
+	m.lang = nil
+	delete(m.clearedFields, message.FieldLang)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MessageMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MessageMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (m *MessageMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[message.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the "content_type" field was cleared in this mutation.
+func (m *MessageMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[message.FieldContentType]
+	return ok
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MessageMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, message.FieldContentType)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetMessageThreadID sets the "message_thread_id" field.
+func (m *MessageMutation) SetMessageThreadID(i int64) {
+	m.message_thread_id = &i
+	m.addmessage_thread_id = nil
+}
+
+// MessageThreadID returns the value of the "message_thread_id" field in the mutation.
+func (m *MessageMutation) MessageThreadID() (r int64, exists bool) {
+	v := m.message_thread_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageThreadID returns the old "message_thread_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMessageThreadID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageThreadID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageThreadID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageThreadID: %w", err)
+	}
+	return oldValue.MessageThreadID, nil
+}
+
+// AddMessageThreadID adds i to the "message_thread_id" field.
+func (m *MessageMutation) AddMessageThreadID(i int64) {
+	if m.addmessage_thread_id != nil {
+		*m.addmessage_thread_id += i
+	} else {
+		m.addmessage_thread_id = &i
+	}
+}
+
+// AddedMessageThreadID returns the value that was added to the "message_thread_id" field in this mutation.
+func (m *MessageMutation) AddedMessageThreadID() (r int64, exists bool) {
+	v := m.addmessage_thread_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMessageThreadID clears the value of the "message_thread_id" field.
+func (m *MessageMutation) ClearMessageThreadID() {
+	m.message_thread_id = nil
+	m.addmessage_thread_id = nil
+	m.clearedFields[message.FieldMessageThreadID] = struct{}{}
+}
+
+// MessageThreadIDCleared returns if the "message_thread_id" field was cleared in this mutation.
+func (m *MessageMutation) MessageThreadIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldMessageThreadID]
+	return ok
+}
+
+// ResetMessageThreadID resets all changes to the "message_thread_id" field.
+func (m *MessageMutation) ResetMessageThreadID() {
+	m.message_thread_id = nil
+	m.addmessage_thread_id = nil
+	delete(m.clearedFields, message.FieldMessageThreadID)
+}
+
+// SetReplyToMessageID sets the "reply_to_message_id" field.
+func (m *MessageMutation) SetReplyToMessageID(i int64) {
+	m.reply_to_message_id = &i
+	m.addreply_to_message_id = nil
+}
+
+// ReplyToMessageID returns the value of the "reply_to_message_id" field in the mutation.
+func (m *MessageMutation) ReplyToMessageID() (r int64, exists bool) {
+	v := m.reply_to_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToMessageID returns the old "reply_to_message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToMessageID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToMessageID: %w", err)
+	}
+	return oldValue.ReplyToMessageID, nil
+}
+
+// AddReplyToMessageID adds i to the "reply_to_message_id" field.
+func (m *MessageMutation) AddReplyToMessageID(i int64) {
+	if m.addreply_to_message_id != nil {
+		*m.addreply_to_message_id += i
+	} else {
+		m.addreply_to_message_id = &i
+	}
+}
+
+// AddedReplyToMessageID returns the value that was added to the "reply_to_message_id" field in this mutation.
+func (m *MessageMutation) AddedReplyToMessageID() (r int64, exists bool) {
+	v := m.addreply_to_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReplyToMessageID clears the value of the "reply_to_message_id" field.
+func (m *MessageMutation) ClearReplyToMessageID() {
+	m.reply_to_message_id = nil
+	m.addreply_to_message_id = nil
+	m.clearedFields[message.FieldReplyToMessageID] = struct{}{}
+}
+
+// ReplyToMessageIDCleared returns if the "reply_to_message_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToMessageIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToMessageID]
+	return ok
+}
+
+// ResetReplyToMessageID resets all changes to the "reply_to_message_id" field.
+func (m *MessageMutation) ResetReplyToMessageID() {
+	m.reply_to_message_id = nil
+	m.addreply_to_message_id = nil
+	delete(m.clearedFields, message.FieldReplyToMessageID)
+}
+
+// SetReactionCount sets the "reaction_count" field.
+func (m *MessageMutation) SetReactionCount(i int64) {
+	m.reaction_count = &i
+	m.addreaction_count = nil
+}
+
+// ReactionCount returns the value of the "reaction_count" field in the mutation.
+func (m *MessageMutation) ReactionCount() (r int64, exists bool) {
+	v := m.reaction_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReactionCount returns the old "reaction_count" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReactionCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReactionCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReactionCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReactionCount: %w", err)
+	}
+	return oldValue.ReactionCount, nil
+}
+
+// AddReactionCount adds i to the "reaction_count" field.
+func (m *MessageMutation) AddReactionCount(i int64) {
+	if m.addreaction_count != nil {
+		*m.addreaction_count += i
+	} else {
+		m.addreaction_count = &i
+	}
+}
+
+// AddedReactionCount returns the value that was added to the "reaction_count" field in this mutation.
+func (m *MessageMutation) AddedReactionCount() (r int64, exists bool) {
+	v := m.addreaction_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReactionCount clears the value of the "reaction_count" field.
+func (m *MessageMutation) ClearReactionCount() {
+	m.reaction_count = nil
+	m.addreaction_count = nil
+	m.clearedFields[message.FieldReactionCount] = struct{}{}
+}
+
+// ReactionCountCleared returns if the "reaction_count" field was cleared in this mutation.
+func (m *MessageMutation) ReactionCountCleared() bool {
+	_, ok := m.clearedFields[message.FieldReactionCount]
+	return ok
+}
+
+// ResetReactionCount resets all changes to the "reaction_count" field.
+func (m *MessageMutation) ResetReactionCount() {
+	m.reaction_count = nil
+	m.addreaction_count = nil
+	delete(m.clearedFields, message.FieldReactionCount)
+}
+
+// SetSenderType sets the "sender_type" field.
+func (m *MessageMutation) SetSenderType(s string) {
+	m.sender_type = &s
+}
+
+// SenderType returns the value of the "sender_type" field in the mutation.
+func (m *MessageMutation) SenderType() (r string, exists bool) {
+	v := m.sender_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderType returns the old "sender_type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderType: %w", err)
+	}
+	return oldValue.SenderType, nil
+}
+
+// ClearSenderType clears the value of the "sender_type" field.
+func (m *MessageMutation) ClearSenderType() {
+	m.sender_type = nil
+	m.clearedFields[message.FieldSenderType] = struct{}{}
+}
+
+// SenderTypeCleared returns if the "sender_type" field was cleared in this mutation.
+func (m *MessageMutation) SenderTypeCleared() bool {
+	_, ok := m.clearedFields[message.FieldSenderType]
+	return ok
+}
+
+// ResetSenderType resets all changes to the "sender_type" field.
+func (m *MessageMutation) ResetSenderType() {
+	m.sender_type = nil
+	delete(m.clearedFields, message.FieldSenderType)
+}
+
+// SetPoll sets the "poll" field.
+func (m *MessageMutation) SetPoll(s string) {
+	m.poll = &s
+}
+
+// Poll returns the value of the "poll" field in the mutation.
+func (m *MessageMutation) Poll() (r string, exists bool) {
+	v := m.poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoll returns the old "poll" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldPoll(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoll is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoll requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoll: %w", err)
+	}
+	return oldValue.Poll, nil
+}
+
+// ClearPoll clears the value of the "poll" field.
+func (m *MessageMutation) ClearPoll() {
+	m.poll = nil
+	m.clearedFields[message.FieldPoll] = struct{}{}
+}
+
+// PollCleared returns if the "poll" field was cleared in this mutation.
+func (m *MessageMutation) PollCleared() bool {
+	_, ok := m.clearedFields[message.FieldPoll]
+	return ok
+}
+
+// ResetPoll resets all changes to the "poll" field.
+func (m *MessageMutation) ResetPoll() {
+	m.poll = nil
+	delete(m.clearedFields, message.FieldPoll)
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *MessageMutation) SetAccountID(i int64) {
+	m.account_id = &i
+	m.addaccount_id = nil
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *MessageMutation) AccountID() (r int64, exists bool) {
+	v := m.account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAccountID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// AddAccountID adds i to the "account_id" field.
+func (m *MessageMutation) AddAccountID(i int64) {
+	if m.addaccount_id != nil {
+		*m.addaccount_id += i
+	} else {
+		m.addaccount_id = &i
+	}
+}
+
+// AddedAccountID returns the value that was added to the "account_id" field in this mutation.
+func (m *MessageMutation) AddedAccountID() (r int64, exists bool) {
+	v := m.addaccount_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (m *MessageMutation) ClearAccountID() {
+	m.account_id = nil
+	m.addaccount_id = nil
+	m.clearedFields[message.FieldAccountID] = struct{}{}
+}
+
+// AccountIDCleared returns if the "account_id" field was cleared in this mutation.
+func (m *MessageMutation) AccountIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAccountID]
+	return ok
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *MessageMutation) ResetAccountID() {
+	m.account_id = nil
+	m.addaccount_id = nil
+	delete(m.clearedFields, message.FieldAccountID)
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.create_time != nil {
+		fields = append(fields, message.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, message.FieldUpdateTime)
+	}
+	if m.message_id != nil {
+		fields = append(fields, message.FieldMessageID)
+	}
+	if m.server_message_id != nil {
+		fields = append(fields, message.FieldServerMessageID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.sender_id != nil {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.sender_name != nil {
+		fields = append(fields, message.FieldSenderName)
+	}
+	if m.sender_username != nil {
+		fields = append(fields, message.FieldSenderUsername)
+	}
+	if m.text != nil {
+		fields = append(fields, message.FieldText)
+	}
+	if m.text_zstd != nil {
+		fields = append(fields, message.FieldTextZstd)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, message.FieldSentAt)
+	}
+	if m.lang != nil {
+		fields = append(fields, message.FieldLang)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.content_type != nil {
+		fields = append(fields, message.FieldContentType)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.message_thread_id != nil {
+		fields = append(fields, message.FieldMessageThreadID)
+	}
+	if m.reply_to_message_id != nil {
+		fields = append(fields, message.FieldReplyToMessageID)
+	}
+	if m.reaction_count != nil {
+		fields = append(fields, message.FieldReactionCount)
+	}
+	if m.sender_type != nil {
+		fields = append(fields, message.FieldSenderType)
+	}
+	if m.poll != nil {
+		fields = append(fields, message.FieldPoll)
+	}
+	if m.account_id != nil {
+		fields = append(fields, message.FieldAccountID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.CreateTime()
+	case message.FieldUpdateTime:
+		return m.UpdateTime()
+	case message.FieldMessageID:
+		return m.MessageID()
+	case message.FieldServerMessageID:
+		return m.ServerMessageID()
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldSenderID:
+		return m.SenderID()
+	case message.FieldSenderName:
+		return m.SenderName()
+	case message.FieldSenderUsername:
+		return m.SenderUsername()
+	case message.FieldText:
+		return m.Text()
+	case message.FieldTextZstd:
+		return m.TextZstd()
+	case message.FieldSentAt:
+		return m.SentAt()
+	case message.FieldLang:
+		return m.Lang()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	case message.FieldContentType:
+		return m.ContentType()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldMessageThreadID:
+		return m.MessageThreadID()
+	case message.FieldReplyToMessageID:
+		return m.ReplyToMessageID()
+	case message.FieldReactionCount:
+		return m.ReactionCount()
+	case message.FieldSenderType:
+		return m.SenderType()
+	case message.FieldPoll:
+		return m.Poll()
+	case message.FieldAccountID:
+		return m.AccountID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case message.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case message.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case message.FieldServerMessageID:
+		return m.OldServerMessageID(ctx)
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case message.FieldSenderName:
+		return m.OldSenderName(ctx)
+	case message.FieldSenderUsername:
+		return m.OldSenderUsername(ctx)
+	case message.FieldText:
+		return m.OldText(ctx)
+	case message.FieldTextZstd:
+		return m.OldTextZstd(ctx)
+	case message.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case message.FieldLang:
+		return m.OldLang(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case message.FieldContentType:
+		return m.OldContentType(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldMessageThreadID:
+		return m.OldMessageThreadID(ctx)
+	case message.FieldReplyToMessageID:
+		return m.OldReplyToMessageID(ctx)
+	case message.FieldReactionCount:
+		return m.OldReactionCount(ctx)
+	case message.FieldSenderType:
+		return m.OldSenderType(ctx)
+	case message.FieldPoll:
+		return m.OldPoll(ctx)
+	case message.FieldAccountID:
+		return m.OldAccountID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case message.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case message.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case message.FieldServerMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServerMessageID(v)
+		return nil
+	case message.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case message.FieldSenderName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderName(v)
+		return nil
+	case message.FieldSenderUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderUsername(v)
+		return nil
+	case message.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case message.FieldTextZstd:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextZstd(v)
+		return nil
+	case message.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case message.FieldLang:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLang(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case message.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldMessageThreadID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageThreadID(v)
+		return nil
+	case message.FieldReplyToMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToMessageID(v)
+		return nil
+	case message.FieldReactionCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReactionCount(v)
+		return nil
+	case message.FieldSenderType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderType(v)
+		return nil
+	case message.FieldPoll:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoll(v)
+		return nil
+	case message.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_id != nil {
+		fields = append(fields, message.FieldMessageID)
+	}
+	if m.addserver_message_id != nil {
+		fields = append(fields, message.FieldServerMessageID)
+	}
+	if m.addchat_id != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.addsender_id != nil {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.addmessage_thread_id != nil {
+		fields = append(fields, message.FieldMessageThreadID)
+	}
+	if m.addreply_to_message_id != nil {
+		fields = append(fields, message.FieldReplyToMessageID)
+	}
+	if m.addreaction_count != nil {
+		fields = append(fields, message.FieldReactionCount)
+	}
+	if m.addaccount_id != nil {
+		fields = append(fields, message.FieldAccountID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldMessageID:
+		return m.AddedMessageID()
+	case message.FieldServerMessageID:
+		return m.AddedServerMessageID()
+	case message.FieldChatID:
+		return m.AddedChatID()
+	case message.FieldSenderID:
+		return m.AddedSenderID()
+	case message.FieldMessageThreadID:
+		return m.AddedMessageThreadID()
+	case message.FieldReplyToMessageID:
+		return m.AddedReplyToMessageID()
+	case message.FieldReactionCount:
+		return m.AddedReactionCount()
+	case message.FieldAccountID:
+		return m.AddedAccountID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	case message.FieldServerMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddServerMessageID(v)
+		return nil
+	case message.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case message.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderID(v)
+		return nil
+	case message.FieldMessageThreadID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageThreadID(v)
+		return nil
+	case message.FieldReplyToMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplyToMessageID(v)
+		return nil
+	case message.FieldReactionCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReactionCount(v)
+		return nil
+	case message.FieldAccountID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldServerMessageID) {
+		fields = append(fields, message.FieldServerMessageID)
+	}
+	if m.FieldCleared(message.FieldSenderUsername) {
+		fields = append(fields, message.FieldSenderUsername)
+	}
+	if m.FieldCleared(message.FieldTextZstd) {
+		fields = append(fields, message.FieldTextZstd)
+	}
+	if m.FieldCleared(message.FieldLang) {
+		fields = append(fields, message.FieldLang)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.FieldCleared(message.FieldContentType) {
+		fields = append(fields, message.FieldContentType)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldMessageThreadID) {
+		fields = append(fields, message.FieldMessageThreadID)
+	}
+	if m.FieldCleared(message.FieldReplyToMessageID) {
+		fields = append(fields, message.FieldReplyToMessageID)
+	}
+	if m.FieldCleared(message.FieldReactionCount) {
+		fields = append(fields, message.FieldReactionCount)
+	}
+	if m.FieldCleared(message.FieldSenderType) {
+		fields = append(fields, message.FieldSenderType)
+	}
+	if m.FieldCleared(message.FieldPoll) {
+		fields = append(fields, message.FieldPoll)
+	}
+	if m.FieldCleared(message.FieldAccountID) {
+		fields = append(fields, message.FieldAccountID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldServerMessageID:
+		m.ClearServerMessageID()
+		return nil
+	case message.FieldSenderUsername:
+		m.ClearSenderUsername()
+		return nil
+	case message.FieldTextZstd:
+		m.ClearTextZstd()
+		return nil
+	case message.FieldLang:
+		m.ClearLang()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case message.FieldContentType:
+		m.ClearContentType()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldMessageThreadID:
+		m.ClearMessageThreadID()
+		return nil
+	case message.FieldReplyToMessageID:
+		m.ClearReplyToMessageID()
+		return nil
+	case message.FieldReactionCount:
+		m.ClearReactionCount()
+		return nil
+	case message.FieldSenderType:
+		m.ClearSenderType()
+		return nil
+	case message.FieldPoll:
+		m.ClearPoll()
+		return nil
+	case message.FieldAccountID:
+		m.ClearAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case message.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case message.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case message.FieldServerMessageID:
+		m.ResetServerMessageID()
+		return nil
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case message.FieldSenderName:
+		m.ResetSenderName()
+		return nil
+	case message.FieldSenderUsername:
+		m.ResetSenderUsername()
+		return nil
+	case message.FieldText:
+		m.ResetText()
+		return nil
+	case message.FieldTextZstd:
+		m.ResetTextZstd()
+		return nil
+	case message.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case message.FieldLang:
+		m.ResetLang()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case message.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldMessageThreadID:
+		m.ResetMessageThreadID()
+		return nil
+	case message.FieldReplyToMessageID:
+		m.ResetReplyToMessageID()
+		return nil
+	case message.FieldReactionCount:
+		m.ResetReactionCount()
+		return nil
+	case message.FieldSenderType:
+		m.ResetSenderType()
+		return nil
+	case message.FieldPoll:
+		m.ResetPoll()
+		return nil
+	case message.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// RunLogMutation represents an operation that mutates the RunLog nodes in the graph.
+type RunLogMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	run_id        *int
+	addrun_id     *int
+	task_id       *int
+	addtask_id    *int
+	chat_id       *int64
+	addchat_id    *int64
+	event         *runlog.Event
+	detail        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RunLog, error)
+	predicates    []predicate.RunLog
+}
+
+var _ ent.Mutation = (*RunLogMutation)(nil)
+
+// runlogOption allows management of the mutation configuration using functional options.
+type runlogOption func(*RunLogMutation)
+
+// newRunLogMutation creates new mutation for the RunLog entity.
+func newRunLogMutation(c config, op Op, opts ...runlogOption) *RunLogMutation {
+	m := &RunLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRunLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRunLogID sets the ID field of the mutation.
+func withRunLogID(id int) runlogOption {
+	return func(m *RunLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RunLog
+		)
+		m.oldValue = func(ctx context.Context) (*RunLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RunLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRunLog sets the old RunLog of the mutation.
+func withRunLog(node *RunLog) runlogOption {
+	return func(m *RunLogMutation) {
+		m.oldValue = func(context.Context) (*RunLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RunLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RunLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RunLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RunLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RunLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RunLogMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RunLogMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RunLogMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RunLogMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RunLogMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RunLogMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *RunLogMutation) SetRunID(i int) {
+	m.run_id = &i
+	m.addrun_id = nil
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *RunLogMutation) RunID() (r int, exists bool) {
+	v := m.run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// AddRunID adds i to the "run_id" field.
+func (m *RunLogMutation) AddRunID(i int) {
+	if m.addrun_id != nil {
+		*m.addrun_id += i
+	} else {
+		m.addrun_id = &i
+	}
+}
+
+// AddedRunID returns the value that was added to the "run_id" field in this mutation.
+func (m *RunLogMutation) AddedRunID() (r int, exists bool) {
+	v := m.addrun_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *RunLogMutation) ResetRunID() {
+	m.run_id = nil
+	m.addrun_id = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *RunLogMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *RunLogMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldTaskID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *RunLogMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *RunLogMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *RunLogMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *RunLogMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *RunLogMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *RunLogMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *RunLogMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *RunLogMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *RunLogMutation) SetEvent(r runlog.Event) {
+	m.event = &r
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *RunLogMutation) Event() (r runlog.Event, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldEvent(ctx context.Context) (v runlog.Event, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *RunLogMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetDetail sets the "detail" field.
+func (m *RunLogMutation) SetDetail(s string) {
+	m.detail = &s
+}
+
+// Detail returns the value of the "detail" field in the mutation.
+func (m *RunLogMutation) Detail() (r string, exists bool) {
+	v := m.detail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetail returns the old "detail" field's value of the RunLog entity.
+// If the RunLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunLogMutation) OldDetail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetail: %w", err)
+	}
+	return oldValue.Detail, nil
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (m *RunLogMutation) ClearDetail() {
+	m.detail = nil
+	m.clearedFields[runlog.FieldDetail] = struct{}{}
+}
+
+// DetailCleared returns if the "detail" field was cleared in this mutation.
+func (m *RunLogMutation) DetailCleared() bool {
+	_, ok := m.clearedFields[runlog.FieldDetail]
+	return ok
+}
+
+// ResetDetail resets all changes to the "detail" field.
+func (m *RunLogMutation) ResetDetail() {
+	m.detail = nil
+	delete(m.clearedFields, runlog.FieldDetail)
+}
+
+// Where appends a list predicates to the RunLogMutation builder.
+func (m *RunLogMutation) Where(ps ...predicate.RunLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RunLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RunLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RunLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RunLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RunLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RunLog).
+func (m *RunLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RunLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, runlog.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, runlog.FieldUpdateTime)
+	}
+	if m.run_id != nil {
+		fields = append(fields, runlog.FieldRunID)
+	}
+	if m.task_id != nil {
+		fields = append(fields, runlog.FieldTaskID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, runlog.FieldChatID)
+	}
+	if m.event != nil {
+		fields = append(fields, runlog.FieldEvent)
+	}
+	if m.detail != nil {
+		fields = append(fields, runlog.FieldDetail)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RunLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case runlog.FieldCreateTime:
+		return m.CreateTime()
+	case runlog.FieldUpdateTime:
+		return m.UpdateTime()
+	case runlog.FieldRunID:
+		return m.RunID()
+	case runlog.FieldTaskID:
+		return m.TaskID()
+	case runlog.FieldChatID:
+		return m.ChatID()
+	case runlog.FieldEvent:
+		return m.Event()
+	case runlog.FieldDetail:
+		return m.Detail()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RunLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case runlog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case runlog.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case runlog.FieldRunID:
+		return m.OldRunID(ctx)
+	case runlog.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case runlog.FieldChatID:
+		return m.OldChatID(ctx)
+	case runlog.FieldEvent:
+		return m.OldEvent(ctx)
+	case runlog.FieldDetail:
+		return m.OldDetail(ctx)
+	}
+	return nil, fmt.Errorf("unknown RunLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case runlog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case runlog.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case runlog.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case runlog.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case runlog.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case runlog.FieldEvent:
+		v, ok := value.(runlog.Event)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case runlog.FieldDetail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetail(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RunLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RunLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addrun_id != nil {
+		fields = append(fields, runlog.FieldRunID)
+	}
+	if m.addtask_id != nil {
+		fields = append(fields, runlog.FieldTaskID)
+	}
+	if m.addchat_id != nil {
+		fields = append(fields, runlog.FieldChatID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RunLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case runlog.FieldRunID:
+		return m.AddedRunID()
+	case runlog.FieldTaskID:
+		return m.AddedTaskID()
+	case runlog.FieldChatID:
+		return m.AddedChatID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case runlog.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunID(v)
+		return nil
+	case runlog.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case runlog.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RunLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RunLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(runlog.FieldDetail) {
+		fields = append(fields, runlog.FieldDetail)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RunLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RunLogMutation) ClearField(name string) error {
+	switch name {
+	case runlog.FieldDetail:
+		m.ClearDetail()
+		return nil
+	}
+	return fmt.Errorf("unknown RunLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RunLogMutation) ResetField(name string) error {
+	switch name {
+	case runlog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case runlog.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case runlog.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case runlog.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case runlog.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case runlog.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case runlog.FieldDetail:
+		m.ResetDetail()
+		return nil
+	}
+	return fmt.Errorf("unknown RunLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RunLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RunLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RunLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RunLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RunLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RunLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RunLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RunLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RunLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RunLog edge %s", name)
+}
+
+// SentPartMutation represents an operation that mutates the SentPart nodes in the graph.
+type SentPartMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	key           *string
+	message_id    *int64
+	addmessage_id *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SentPart, error)
+	predicates    []predicate.SentPart
+}
+
+var _ ent.Mutation = (*SentPartMutation)(nil)
+
+// sentpartOption allows management of the mutation configuration using functional options.
+type sentpartOption func(*SentPartMutation)
+
+// newSentPartMutation creates new mutation for the SentPart entity.
+func newSentPartMutation(c config, op Op, opts ...sentpartOption) *SentPartMutation {
+	m := &SentPartMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSentPart,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSentPartID sets the ID field of the mutation.
+func withSentPartID(id int) sentpartOption {
+	return func(m *SentPartMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SentPart
+		)
+		m.oldValue = func(ctx context.Context) (*SentPart, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SentPart.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSentPart sets the old SentPart of the mutation.
+func withSentPart(node *SentPart) sentpartOption {
+	return func(m *SentPartMutation) {
+		m.oldValue = func(context.Context) (*SentPart, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SentPartMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SentPartMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SentPartMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SentPartMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SentPart.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SentPartMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SentPartMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SentPart entity.
+// If the SentPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentPartMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SentPartMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SentPartMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SentPartMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SentPart entity.
+// If the SentPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentPartMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SentPartMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKey sets the "key" field.
+func (m *SentPartMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SentPartMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the SentPart entity.
+// If the SentPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentPartMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SentPartMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *SentPartMutation) SetMessageID(i int64) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *SentPartMutation) MessageID() (r int64, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the SentPart entity.
+// If the SentPart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SentPartMutation) OldMessageID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (m *SentPartMutation) AddMessageID(i int64) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
+	}
+}
+
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *SentPartMutation) AddedMessageID() (r int64, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (m *SentPartMutation) ClearMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+	m.clearedFields[sentpart.FieldMessageID] = struct{}{}
+}
+
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *SentPartMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[sentpart.FieldMessageID]
+	return ok
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *SentPartMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+	delete(m.clearedFields, sentpart.FieldMessageID)
+}
+
+// Where appends a list predicates to the SentPartMutation builder.
+func (m *SentPartMutation) Where(ps ...predicate.SentPart) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SentPartMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SentPartMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SentPart, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SentPartMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SentPartMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SentPart).
+func (m *SentPartMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SentPartMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, sentpart.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sentpart.FieldUpdateTime)
+	}
+	if m.key != nil {
+		fields = append(fields, sentpart.FieldKey)
+	}
+	if m.message_id != nil {
+		fields = append(fields, sentpart.FieldMessageID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SentPartMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sentpart.FieldCreateTime:
+		return m.CreateTime()
+	case sentpart.FieldUpdateTime:
+		return m.UpdateTime()
+	case sentpart.FieldKey:
+		return m.Key()
+	case sentpart.FieldMessageID:
+		return m.MessageID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SentPartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sentpart.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sentpart.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sentpart.FieldKey:
+		return m.OldKey(ctx)
+	case sentpart.FieldMessageID:
+		return m.OldMessageID(ctx)
+	}
+	return nil, fmt.Errorf("unknown SentPart field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SentPartMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sentpart.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sentpart.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sentpart.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case sentpart.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SentPart field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SentPartMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_id != nil {
+		fields = append(fields, sentpart.FieldMessageID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SentPartMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sentpart.FieldMessageID:
+		return m.AddedMessageID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SentPartMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sentpart.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SentPart numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SentPartMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sentpart.FieldMessageID) {
+		fields = append(fields, sentpart.FieldMessageID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SentPartMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SentPartMutation) ClearField(name string) error {
+	switch name {
+	case sentpart.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown SentPart nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SentPartMutation) ResetField(name string) error {
+	switch name {
+	case sentpart.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sentpart.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sentpart.FieldKey:
+		m.ResetKey()
+		return nil
+	case sentpart.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown SentPart field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SentPartMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SentPartMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SentPartMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SentPartMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SentPartMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SentPartMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SentPartMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SentPart unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SentPartMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SentPart edge %s", name)
+}
+
+// ShadowRunMutation represents an operation that mutates the ShadowRun nodes in the graph.
+type ShadowRunMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	chat_id            *int64
+	addchat_id         *int64
+	start_time         *time.Time
+	end_time           *time.Time
+	primary_model      *string
+	shadow_model       *string
+	primary_content    *string
+	shadow_content     *string
+	shadow_error       *string
+	shadow_tokens      *int
+	addshadow_tokens   *int
+	primary_topics     *int
+	addprimary_topics  *int
+	shadow_topics      *int
+	addshadow_topics   *int
+	matched_topics     *int
+	addmatched_topics  *int
+	message_overlap    *float64
+	addmessage_overlap *float64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ShadowRun, error)
+	predicates         []predicate.ShadowRun
+}
+
+var _ ent.Mutation = (*ShadowRunMutation)(nil)
+
+// shadowrunOption allows management of the mutation configuration using functional options.
+type shadowrunOption func(*ShadowRunMutation)
+
+// newShadowRunMutation creates new mutation for the ShadowRun entity.
+func newShadowRunMutation(c config, op Op, opts ...shadowrunOption) *ShadowRunMutation {
+	m := &ShadowRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShadowRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShadowRunID sets the ID field of the mutation.
+func withShadowRunID(id int) shadowrunOption {
+	return func(m *ShadowRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShadowRun
+		)
+		m.oldValue = func(ctx context.Context) (*ShadowRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShadowRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShadowRun sets the old ShadowRun of the mutation.
+func withShadowRun(node *ShadowRun) shadowrunOption {
+	return func(m *ShadowRunMutation) {
+		m.oldValue = func(context.Context) (*ShadowRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShadowRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShadowRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShadowRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShadowRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShadowRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ShadowRunMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ShadowRunMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ShadowRunMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ShadowRunMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ShadowRunMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ShadowRunMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *ShadowRunMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ShadowRunMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *ShadowRunMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *ShadowRunMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ShadowRunMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *ShadowRunMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *ShadowRunMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *ShadowRunMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *ShadowRunMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *ShadowRunMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *ShadowRunMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetPrimaryModel sets the "primary_model" field.
+func (m *ShadowRunMutation) SetPrimaryModel(s string) {
+	m.primary_model = &s
+}
+
+// PrimaryModel returns the value of the "primary_model" field in the mutation.
+func (m *ShadowRunMutation) PrimaryModel() (r string, exists bool) {
+	v := m.primary_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryModel returns the old "primary_model" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldPrimaryModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryModel: %w", err)
+	}
+	return oldValue.PrimaryModel, nil
+}
+
+// ResetPrimaryModel resets all changes to the "primary_model" field.
+func (m *ShadowRunMutation) ResetPrimaryModel() {
+	m.primary_model = nil
+}
+
+// SetShadowModel sets the "shadow_model" field.
+func (m *ShadowRunMutation) SetShadowModel(s string) {
+	m.shadow_model = &s
+}
+
+// ShadowModel returns the value of the "shadow_model" field in the mutation.
+func (m *ShadowRunMutation) ShadowModel() (r string, exists bool) {
+	v := m.shadow_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShadowModel returns the old "shadow_model" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldShadowModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShadowModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShadowModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShadowModel: %w", err)
+	}
+	return oldValue.ShadowModel, nil
+}
+
+// ResetShadowModel resets all changes to the "shadow_model" field.
+func (m *ShadowRunMutation) ResetShadowModel() {
+	m.shadow_model = nil
+}
+
+// SetPrimaryContent sets the "primary_content" field.
+func (m *ShadowRunMutation) SetPrimaryContent(s string) {
+	m.primary_content = &s
+}
+
+// PrimaryContent returns the value of the "primary_content" field in the mutation.
+func (m *ShadowRunMutation) PrimaryContent() (r string, exists bool) {
+	v := m.primary_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryContent returns the old "primary_content" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldPrimaryContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryContent: %w", err)
+	}
+	return oldValue.PrimaryContent, nil
+}
+
+// ResetPrimaryContent resets all changes to the "primary_content" field.
+func (m *ShadowRunMutation) ResetPrimaryContent() {
+	m.primary_content = nil
+}
+
+// SetShadowContent sets the "shadow_content" field.
+func (m *ShadowRunMutation) SetShadowContent(s string) {
+	m.shadow_content = &s
+}
+
+// ShadowContent returns the value of the "shadow_content" field in the mutation.
+func (m *ShadowRunMutation) ShadowContent() (r string, exists bool) {
+	v := m.shadow_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShadowContent returns the old "shadow_content" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldShadowContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShadowContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShadowContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShadowContent: %w", err)
+	}
+	return oldValue.ShadowContent, nil
+}
+
+// ClearShadowContent clears the value of the "shadow_content" field.
+func (m *ShadowRunMutation) ClearShadowContent() {
+	m.shadow_content = nil
+	m.clearedFields[shadowrun.FieldShadowContent] = struct{}{}
+}
+
+// ShadowContentCleared returns if the "shadow_content" field was cleared in this mutation.
+func (m *ShadowRunMutation) ShadowContentCleared() bool {
+	_, ok := m.clearedFields[shadowrun.FieldShadowContent]
+	return ok
+}
+
+// ResetShadowContent resets all changes to the "shadow_content" field.
+func (m *ShadowRunMutation) ResetShadowContent() {
+	m.shadow_content = nil
+	delete(m.clearedFields, shadowrun.FieldShadowContent)
+}
+
+// SetShadowError sets the "shadow_error" field.
+func (m *ShadowRunMutation) SetShadowError(s string) {
+	m.shadow_error = &s
+}
+
+// ShadowError returns the value of the "shadow_error" field in the mutation.
+func (m *ShadowRunMutation) ShadowError() (r string, exists bool) {
+	v := m.shadow_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShadowError returns the old "shadow_error" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldShadowError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShadowError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShadowError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShadowError: %w", err)
+	}
+	return oldValue.ShadowError, nil
+}
+
+// ClearShadowError clears the value of the "shadow_error" field.
+func (m *ShadowRunMutation) ClearShadowError() {
+	m.shadow_error = nil
+	m.clearedFields[shadowrun.FieldShadowError] = struct{}{}
+}
+
+// ShadowErrorCleared returns if the "shadow_error" field was cleared in this mutation.
+func (m *ShadowRunMutation) ShadowErrorCleared() bool {
+	_, ok := m.clearedFields[shadowrun.FieldShadowError]
+	return ok
+}
+
+// ResetShadowError resets all changes to the "shadow_error" field.
+func (m *ShadowRunMutation) ResetShadowError() {
+	m.shadow_error = nil
+	delete(m.clearedFields, shadowrun.FieldShadowError)
+}
+
+// SetShadowTokens sets the "shadow_tokens" field.
+func (m *ShadowRunMutation) SetShadowTokens(i int) {
+	m.shadow_tokens = &i
+	m.addshadow_tokens = nil
+}
+
+// ShadowTokens returns the value of the "shadow_tokens" field in the mutation.
+func (m *ShadowRunMutation) ShadowTokens() (r int, exists bool) {
+	v := m.shadow_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShadowTokens returns the old "shadow_tokens" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldShadowTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShadowTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShadowTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShadowTokens: %w", err)
+	}
+	return oldValue.ShadowTokens, nil
+}
+
+// AddShadowTokens adds i to the "shadow_tokens" field.
+func (m *ShadowRunMutation) AddShadowTokens(i int) {
+	if m.addshadow_tokens != nil {
+		*m.addshadow_tokens += i
+	} else {
+		m.addshadow_tokens = &i
+	}
+}
+
+// AddedShadowTokens returns the value that was added to the "shadow_tokens" field in this mutation.
+func (m *ShadowRunMutation) AddedShadowTokens() (r int, exists bool) {
+	v := m.addshadow_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShadowTokens resets all changes to the "shadow_tokens" field.
+func (m *ShadowRunMutation) ResetShadowTokens() {
+	m.shadow_tokens = nil
+	m.addshadow_tokens = nil
+}
+
+// SetPrimaryTopics sets the "primary_topics" field.
+func (m *ShadowRunMutation) SetPrimaryTopics(i int) {
+	m.primary_topics = &i
+	m.addprimary_topics = nil
+}
+
+// PrimaryTopics returns the value of the "primary_topics" field in the mutation.
+func (m *ShadowRunMutation) PrimaryTopics() (r int, exists bool) {
+	v := m.primary_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrimaryTopics returns the old "primary_topics" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldPrimaryTopics(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrimaryTopics is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrimaryTopics requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrimaryTopics: %w", err)
+	}
+	return oldValue.PrimaryTopics, nil
+}
+
+// AddPrimaryTopics adds i to the "primary_topics" field.
+func (m *ShadowRunMutation) AddPrimaryTopics(i int) {
+	if m.addprimary_topics != nil {
+		*m.addprimary_topics += i
+	} else {
+		m.addprimary_topics = &i
+	}
+}
+
+// AddedPrimaryTopics returns the value that was added to the "primary_topics" field in this mutation.
+func (m *ShadowRunMutation) AddedPrimaryTopics() (r int, exists bool) {
+	v := m.addprimary_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrimaryTopics resets all changes to the "primary_topics" field.
+func (m *ShadowRunMutation) ResetPrimaryTopics() {
+	m.primary_topics = nil
+	m.addprimary_topics = nil
+}
+
+// SetShadowTopics sets the "shadow_topics" field.
+func (m *ShadowRunMutation) SetShadowTopics(i int) {
+	m.shadow_topics = &i
+	m.addshadow_topics = nil
+}
+
+// ShadowTopics returns the value of the "shadow_topics" field in the mutation.
+func (m *ShadowRunMutation) ShadowTopics() (r int, exists bool) {
+	v := m.shadow_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShadowTopics returns the old "shadow_topics" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldShadowTopics(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShadowTopics is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShadowTopics requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShadowTopics: %w", err)
+	}
+	return oldValue.ShadowTopics, nil
+}
+
+// AddShadowTopics adds i to the "shadow_topics" field.
+func (m *ShadowRunMutation) AddShadowTopics(i int) {
+	if m.addshadow_topics != nil {
+		*m.addshadow_topics += i
+	} else {
+		m.addshadow_topics = &i
+	}
+}
+
+// AddedShadowTopics returns the value that was added to the "shadow_topics" field in this mutation.
+func (m *ShadowRunMutation) AddedShadowTopics() (r int, exists bool) {
+	v := m.addshadow_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShadowTopics resets all changes to the "shadow_topics" field.
+func (m *ShadowRunMutation) ResetShadowTopics() {
+	m.shadow_topics = nil
+	m.addshadow_topics = nil
+}
+
+// SetMatchedTopics sets the "matched_topics" field.
+func (m *ShadowRunMutation) SetMatchedTopics(i int) {
+	m.matched_topics = &i
+	m.addmatched_topics = nil
+}
+
+// MatchedTopics returns the value of the "matched_topics" field in the mutation.
+func (m *ShadowRunMutation) MatchedTopics() (r int, exists bool) {
+	v := m.matched_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatchedTopics returns the old "matched_topics" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldMatchedTopics(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchedTopics is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchedTopics requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchedTopics: %w", err)
+	}
+	return oldValue.MatchedTopics, nil
+}
+
+// AddMatchedTopics adds i to the "matched_topics" field.
+func (m *ShadowRunMutation) AddMatchedTopics(i int) {
+	if m.addmatched_topics != nil {
+		*m.addmatched_topics += i
+	} else {
+		m.addmatched_topics = &i
+	}
+}
+
+// AddedMatchedTopics returns the value that was added to the "matched_topics" field in this mutation.
+func (m *ShadowRunMutation) AddedMatchedTopics() (r int, exists bool) {
+	v := m.addmatched_topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMatchedTopics resets all changes to the "matched_topics" field.
+func (m *ShadowRunMutation) ResetMatchedTopics() {
+	m.matched_topics = nil
+	m.addmatched_topics = nil
+}
+
+// SetMessageOverlap sets the "message_overlap" field.
+func (m *ShadowRunMutation) SetMessageOverlap(f float64) {
+	m.message_overlap = &f
+	m.addmessage_overlap = nil
+}
+
+// MessageOverlap returns the value of the "message_overlap" field in the mutation.
+func (m *ShadowRunMutation) MessageOverlap() (r float64, exists bool) {
+	v := m.message_overlap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageOverlap returns the old "message_overlap" field's value of the ShadowRun entity.
+// If the ShadowRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShadowRunMutation) OldMessageOverlap(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageOverlap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageOverlap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageOverlap: %w", err)
+	}
+	return oldValue.MessageOverlap, nil
+}
+
+// AddMessageOverlap adds f to the "message_overlap" field.
+func (m *ShadowRunMutation) AddMessageOverlap(f float64) {
+	if m.addmessage_overlap != nil {
+		*m.addmessage_overlap += f
+	} else {
+		m.addmessage_overlap = &f
+	}
+}
+
+// AddedMessageOverlap returns the value that was added to the "message_overlap" field in this mutation.
+func (m *ShadowRunMutation) AddedMessageOverlap() (r float64, exists bool) {
+	v := m.addmessage_overlap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageOverlap resets all changes to the "message_overlap" field.
+func (m *ShadowRunMutation) ResetMessageOverlap() {
+	m.message_overlap = nil
+	m.addmessage_overlap = nil
+}
+
+// Where appends a list predicates to the ShadowRunMutation builder.
+func (m *ShadowRunMutation) Where(ps ...predicate.ShadowRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShadowRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShadowRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShadowRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShadowRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShadowRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShadowRun).
+func (m *ShadowRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShadowRunMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, shadowrun.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, shadowrun.FieldUpdateTime)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, shadowrun.FieldChatID)
+	}
+	if m.start_time != nil {
+		fields = append(fields, shadowrun.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, shadowrun.FieldEndTime)
+	}
+	if m.primary_model != nil {
+		fields = append(fields, shadowrun.FieldPrimaryModel)
+	}
+	if m.shadow_model != nil {
+		fields = append(fields, shadowrun.FieldShadowModel)
+	}
+	if m.primary_content != nil {
+		fields = append(fields, shadowrun.FieldPrimaryContent)
+	}
+	if m.shadow_content != nil {
+		fields = append(fields, shadowrun.FieldShadowContent)
+	}
+	if m.shadow_error != nil {
+		fields = append(fields, shadowrun.FieldShadowError)
+	}
+	if m.shadow_tokens != nil {
+		fields = append(fields, shadowrun.FieldShadowTokens)
+	}
+	if m.primary_topics != nil {
+		fields = append(fields, shadowrun.FieldPrimaryTopics)
+	}
+	if m.shadow_topics != nil {
+		fields = append(fields, shadowrun.FieldShadowTopics)
+	}
+	if m.matched_topics != nil {
+		fields = append(fields, shadowrun.FieldMatchedTopics)
+	}
+	if m.message_overlap != nil {
+		fields = append(fields, shadowrun.FieldMessageOverlap)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShadowRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shadowrun.FieldCreateTime:
+		return m.CreateTime()
+	case shadowrun.FieldUpdateTime:
+		return m.UpdateTime()
+	case shadowrun.FieldChatID:
+		return m.ChatID()
+	case shadowrun.FieldStartTime:
+		return m.StartTime()
+	case shadowrun.FieldEndTime:
+		return m.EndTime()
+	case shadowrun.FieldPrimaryModel:
+		return m.PrimaryModel()
+	case shadowrun.FieldShadowModel:
+		return m.ShadowModel()
+	case shadowrun.FieldPrimaryContent:
+		return m.PrimaryContent()
+	case shadowrun.FieldShadowContent:
+		return m.ShadowContent()
+	case shadowrun.FieldShadowError:
+		return m.ShadowError()
+	case shadowrun.FieldShadowTokens:
+		return m.ShadowTokens()
+	case shadowrun.FieldPrimaryTopics:
+		return m.PrimaryTopics()
+	case shadowrun.FieldShadowTopics:
+		return m.ShadowTopics()
+	case shadowrun.FieldMatchedTopics:
+		return m.MatchedTopics()
+	case shadowrun.FieldMessageOverlap:
+		return m.MessageOverlap()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShadowRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shadowrun.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case shadowrun.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case shadowrun.FieldChatID:
+		return m.OldChatID(ctx)
+	case shadowrun.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case shadowrun.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case shadowrun.FieldPrimaryModel:
+		return m.OldPrimaryModel(ctx)
+	case shadowrun.FieldShadowModel:
+		return m.OldShadowModel(ctx)
+	case shadowrun.FieldPrimaryContent:
+		return m.OldPrimaryContent(ctx)
+	case shadowrun.FieldShadowContent:
+		return m.OldShadowContent(ctx)
+	case shadowrun.FieldShadowError:
+		return m.OldShadowError(ctx)
+	case shadowrun.FieldShadowTokens:
+		return m.OldShadowTokens(ctx)
+	case shadowrun.FieldPrimaryTopics:
+		return m.OldPrimaryTopics(ctx)
+	case shadowrun.FieldShadowTopics:
+		return m.OldShadowTopics(ctx)
+	case shadowrun.FieldMatchedTopics:
+		return m.OldMatchedTopics(ctx)
+	case shadowrun.FieldMessageOverlap:
+		return m.OldMessageOverlap(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShadowRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShadowRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shadowrun.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case shadowrun.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case shadowrun.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case shadowrun.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case shadowrun.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case shadowrun.FieldPrimaryModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryModel(v)
+		return nil
+	case shadowrun.FieldShadowModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShadowModel(v)
+		return nil
+	case shadowrun.FieldPrimaryContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryContent(v)
+		return nil
+	case shadowrun.FieldShadowContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShadowContent(v)
+		return nil
+	case shadowrun.FieldShadowError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShadowError(v)
+		return nil
+	case shadowrun.FieldShadowTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShadowTokens(v)
+		return nil
+	case shadowrun.FieldPrimaryTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryTopics(v)
+		return nil
+	case shadowrun.FieldShadowTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShadowTopics(v)
+		return nil
+	case shadowrun.FieldMatchedTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchedTopics(v)
+		return nil
+	case shadowrun.FieldMessageOverlap:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageOverlap(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShadowRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShadowRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, shadowrun.FieldChatID)
+	}
+	if m.addshadow_tokens != nil {
+		fields = append(fields, shadowrun.FieldShadowTokens)
+	}
+	if m.addprimary_topics != nil {
+		fields = append(fields, shadowrun.FieldPrimaryTopics)
+	}
+	if m.addshadow_topics != nil {
+		fields = append(fields, shadowrun.FieldShadowTopics)
+	}
+	if m.addmatched_topics != nil {
+		fields = append(fields, shadowrun.FieldMatchedTopics)
+	}
+	if m.addmessage_overlap != nil {
+		fields = append(fields, shadowrun.FieldMessageOverlap)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShadowRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case shadowrun.FieldChatID:
+		return m.AddedChatID()
+	case shadowrun.FieldShadowTokens:
+		return m.AddedShadowTokens()
+	case shadowrun.FieldPrimaryTopics:
+		return m.AddedPrimaryTopics()
+	case shadowrun.FieldShadowTopics:
+		return m.AddedShadowTopics()
+	case shadowrun.FieldMatchedTopics:
+		return m.AddedMatchedTopics()
+	case shadowrun.FieldMessageOverlap:
+		return m.AddedMessageOverlap()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShadowRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case shadowrun.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case shadowrun.FieldShadowTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShadowTokens(v)
+		return nil
+	case shadowrun.FieldPrimaryTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrimaryTopics(v)
+		return nil
+	case shadowrun.FieldShadowTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShadowTopics(v)
+		return nil
+	case shadowrun.FieldMatchedTopics:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMatchedTopics(v)
+		return nil
+	case shadowrun.FieldMessageOverlap:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageOverlap(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShadowRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShadowRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shadowrun.FieldShadowContent) {
+		fields = append(fields, shadowrun.FieldShadowContent)
+	}
+	if m.FieldCleared(shadowrun.FieldShadowError) {
+		fields = append(fields, shadowrun.FieldShadowError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShadowRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShadowRunMutation) ClearField(name string) error {
+	switch name {
+	case shadowrun.FieldShadowContent:
+		m.ClearShadowContent()
+		return nil
+	case shadowrun.FieldShadowError:
+		m.ClearShadowError()
+		return nil
+	}
+	return fmt.Errorf("unknown ShadowRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShadowRunMutation) ResetField(name string) error {
+	switch name {
+	case shadowrun.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case shadowrun.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case shadowrun.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case shadowrun.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case shadowrun.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case shadowrun.FieldPrimaryModel:
+		m.ResetPrimaryModel()
+		return nil
+	case shadowrun.FieldShadowModel:
+		m.ResetShadowModel()
+		return nil
+	case shadowrun.FieldPrimaryContent:
+		m.ResetPrimaryContent()
+		return nil
+	case shadowrun.FieldShadowContent:
+		m.ResetShadowContent()
+		return nil
+	case shadowrun.FieldShadowError:
+		m.ResetShadowError()
+		return nil
+	case shadowrun.FieldShadowTokens:
+		m.ResetShadowTokens()
+		return nil
+	case shadowrun.FieldPrimaryTopics:
+		m.ResetPrimaryTopics()
+		return nil
+	case shadowrun.FieldShadowTopics:
+		m.ResetShadowTopics()
+		return nil
+	case shadowrun.FieldMatchedTopics:
+		m.ResetMatchedTopics()
+		return nil
+	case shadowrun.FieldMessageOverlap:
+		m.ResetMessageOverlap()
+		return nil
+	}
+	return fmt.Errorf("unknown ShadowRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShadowRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShadowRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShadowRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShadowRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShadowRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShadowRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShadowRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ShadowRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShadowRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ShadowRun edge %s", name)
+}
+
+// SharedLinkMutation represents an operation that mutates the SharedLink nodes in the graph.
+type SharedLinkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	chat_id       *int64
+	addchat_id    *int64
+	message_id    *int64
+	addmessage_id *int64
+	sender_id     *int64
+	addsender_id  *int64
+	sender_name   *string
+	url           *string
+	sent_at       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SharedLink, error)
+	predicates    []predicate.SharedLink
+}
+
+var _ ent.Mutation = (*SharedLinkMutation)(nil)
+
+// sharedlinkOption allows management of the mutation configuration using functional options.
+type sharedlinkOption func(*SharedLinkMutation)
+
+// newSharedLinkMutation creates new mutation for the SharedLink entity.
+func newSharedLinkMutation(c config, op Op, opts ...sharedlinkOption) *SharedLinkMutation {
+	m := &SharedLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSharedLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSharedLinkID sets the ID field of the mutation.
+func withSharedLinkID(id int) sharedlinkOption {
+	return func(m *SharedLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SharedLink
+		)
+		m.oldValue = func(ctx context.Context) (*SharedLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SharedLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSharedLink sets the old SharedLink of the mutation.
+func withSharedLink(node *SharedLink) sharedlinkOption {
+	return func(m *SharedLinkMutation) {
+		m.oldValue = func(context.Context) (*SharedLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SharedLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SharedLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SharedLinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SharedLinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SharedLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SharedLinkMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SharedLinkMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SharedLinkMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SharedLinkMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SharedLinkMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SharedLinkMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *SharedLinkMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *SharedLinkMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *SharedLinkMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *SharedLinkMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *SharedLinkMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *SharedLinkMutation) SetMessageID(i int64) {
+	m.message_id = &i
+	m.addmessage_id = nil
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *SharedLinkMutation) MessageID() (r int64, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldMessageID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// AddMessageID adds i to the "message_id" field.
+func (m *SharedLinkMutation) AddMessageID(i int64) {
+	if m.addmessage_id != nil {
+		*m.addmessage_id += i
+	} else {
+		m.addmessage_id = &i
+	}
+}
+
+// AddedMessageID returns the value that was added to the "message_id" field in this mutation.
+func (m *SharedLinkMutation) AddedMessageID() (r int64, exists bool) {
+	v := m.addmessage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *SharedLinkMutation) ResetMessageID() {
+	m.message_id = nil
+	m.addmessage_id = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *SharedLinkMutation) SetSenderID(i int64) {
+	m.sender_id = &i
+	m.addsender_id = nil
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *SharedLinkMutation) SenderID() (r int64, exists bool) {
+	v := m.sender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldSenderID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (m *SharedLinkMutation) AddSenderID(i int64) {
+	if m.addsender_id != nil {
+		*m.addsender_id += i
+	} else {
+		m.addsender_id = &i
+	}
+}
+
+// AddedSenderID returns the value that was added to the "sender_id" field in this mutation.
+func (m *SharedLinkMutation) AddedSenderID() (r int64, exists bool) {
+	v := m.addsender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *SharedLinkMutation) ResetSenderID() {
+	m.sender_id = nil
+	m.addsender_id = nil
+}
+
+// SetSenderName sets the "sender_name" field.
+func (m *SharedLinkMutation) SetSenderName(s string) {
+	m.sender_name = &s
+}
+
+// SenderName returns the value of the "sender_name" field in the mutation.
+func (m *SharedLinkMutation) SenderName() (r string, exists bool) {
+	v := m.sender_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderName returns the old "sender_name" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldSenderName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+	}
+	return oldValue.SenderName, nil
+}
+
+// ResetSenderName resets all changes to the "sender_name" field.
+func (m *SharedLinkMutation) ResetSenderName() {
+	m.sender_name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *SharedLinkMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *SharedLinkMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SharedLinkMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *SharedLinkMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *SharedLinkMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the SharedLink entity.
+// If the SharedLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SharedLinkMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *SharedLinkMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// Where appends a list predicates to the SharedLinkMutation builder.
+func (m *SharedLinkMutation) Where(ps ...predicate.SharedLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SharedLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SharedLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SharedLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SharedLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SharedLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SharedLink).
+func (m *SharedLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SharedLinkMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, sharedlink.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, sharedlink.FieldUpdateTime)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, sharedlink.FieldChatID)
+	}
+	if m.message_id != nil {
+		fields = append(fields, sharedlink.FieldMessageID)
+	}
+	if m.sender_id != nil {
+		fields = append(fields, sharedlink.FieldSenderID)
+	}
+	if m.sender_name != nil {
+		fields = append(fields, sharedlink.FieldSenderName)
+	}
+	if m.url != nil {
+		fields = append(fields, sharedlink.FieldURL)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, sharedlink.FieldSentAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SharedLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlink.FieldCreateTime:
+		return m.CreateTime()
+	case sharedlink.FieldUpdateTime:
+		return m.UpdateTime()
+	case sharedlink.FieldChatID:
+		return m.ChatID()
+	case sharedlink.FieldMessageID:
+		return m.MessageID()
+	case sharedlink.FieldSenderID:
+		return m.SenderID()
+	case sharedlink.FieldSenderName:
+		return m.SenderName()
+	case sharedlink.FieldURL:
+		return m.URL()
+	case sharedlink.FieldSentAt:
+		return m.SentAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SharedLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sharedlink.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case sharedlink.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case sharedlink.FieldChatID:
+		return m.OldChatID(ctx)
+	case sharedlink.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case sharedlink.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case sharedlink.FieldSenderName:
+		return m.OldSenderName(ctx)
+	case sharedlink.FieldURL:
+		return m.OldURL(ctx)
+	case sharedlink.FieldSentAt:
+		return m.OldSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sharedlink.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case sharedlink.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case sharedlink.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case sharedlink.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case sharedlink.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case sharedlink.FieldSenderName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderName(v)
+		return nil
+	case sharedlink.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case sharedlink.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SharedLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, sharedlink.FieldChatID)
+	}
+	if m.addmessage_id != nil {
+		fields = append(fields, sharedlink.FieldMessageID)
+	}
+	if m.addsender_id != nil {
+		fields = append(fields, sharedlink.FieldSenderID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SharedLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sharedlink.FieldChatID:
+		return m.AddedChatID()
+	case sharedlink.FieldMessageID:
+		return m.AddedMessageID()
+	case sharedlink.FieldSenderID:
+		return m.AddedSenderID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SharedLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sharedlink.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case sharedlink.FieldMessageID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageID(v)
+		return nil
+	case sharedlink.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SharedLinkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SharedLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SharedLinkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SharedLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SharedLinkMutation) ResetField(name string) error {
+	switch name {
+	case sharedlink.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case sharedlink.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case sharedlink.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case sharedlink.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case sharedlink.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case sharedlink.FieldSenderName:
+		m.ResetSenderName()
+		return nil
+	case sharedlink.FieldURL:
+		m.ResetURL()
+		return nil
+	case sharedlink.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SharedLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SharedLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SharedLinkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SharedLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SharedLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SharedLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SharedLinkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SharedLinkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SharedLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SharedLinkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SharedLink edge %s", name)
+}
+
+// SummaryMutation represents an operation that mutates the Summary nodes in the graph.
+type SummaryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	create_time     *time.Time
+	update_time     *time.Time
+	chat_id         *int64
+	addchat_id      *int64
+	sender_id       *int64
+	addsender_id    *int64
+	sender_name     *string
+	sender_username *string
+	sender_nickname *string
+	summary_date    *time.Time
+	content         *string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Summary, error)
+	predicates      []predicate.Summary
+}
+
+var _ ent.Mutation = (*SummaryMutation)(nil)
+
+// summaryOption allows management of the mutation configuration using functional options.
+type summaryOption func(*SummaryMutation)
+
+// newSummaryMutation creates new mutation for the Summary entity.
+func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation {
+	m := &SummaryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSummary,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSummaryID sets the ID field of the mutation.
+func withSummaryID(id int) summaryOption {
+	return func(m *SummaryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Summary
+		)
+		m.oldValue = func(ctx context.Context) (*Summary, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Summary.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSummary sets the old Summary of the mutation.
+func withSummary(node *Summary) summaryOption {
+	return func(m *SummaryMutation) {
+		m.oldValue = func(context.Context) (*Summary, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SummaryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SummaryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SummaryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SummaryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Summary.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SummaryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SummaryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SummaryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SummaryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SummaryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SummaryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *SummaryMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *SummaryMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *SummaryMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *SummaryMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *SummaryMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *SummaryMutation) SetSenderID(i int64) {
+	m.sender_id = &i
+	m.addsender_id = nil
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *SummaryMutation) SenderID() (r int64, exists bool) {
+	v := m.sender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSenderID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// AddSenderID adds i to the "sender_id" field.
+func (m *SummaryMutation) AddSenderID(i int64) {
+	if m.addsender_id != nil {
+		*m.addsender_id += i
+	} else {
+		m.addsender_id = &i
+	}
+}
+
+// AddedSenderID returns the value that was added to the "sender_id" field in this mutation.
+func (m *SummaryMutation) AddedSenderID() (r int64, exists bool) {
+	v := m.addsender_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *SummaryMutation) ResetSenderID() {
+	m.sender_id = nil
+	m.addsender_id = nil
+}
+
+// SetSenderName sets the "sender_name" field.
+func (m *SummaryMutation) SetSenderName(s string) {
+	m.sender_name = &s
+}
+
+// SenderName returns the value of the "sender_name" field in the mutation.
+func (m *SummaryMutation) SenderName() (r string, exists bool) {
+	v := m.sender_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderName returns the old "sender_name" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSenderName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderName: %w", err)
+	}
+	return oldValue.SenderName, nil
+}
+
+// ResetSenderName resets all changes to the "sender_name" field.
+func (m *SummaryMutation) ResetSenderName() {
+	m.sender_name = nil
+}
+
+// SetSenderUsername sets the "sender_username" field.
+func (m *SummaryMutation) SetSenderUsername(s string) {
+	m.sender_username = &s
+}
+
+// SenderUsername returns the value of the "sender_username" field in the mutation.
+func (m *SummaryMutation) SenderUsername() (r string, exists bool) {
+	v := m.sender_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderUsername returns the old "sender_username" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSenderUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderUsername: %w", err)
+	}
+	return oldValue.SenderUsername, nil
+}
+
+// ClearSenderUsername clears the value of the "sender_username" field.
+func (m *SummaryMutation) ClearSenderUsername() {
+	m.sender_username = nil
+	m.clearedFields[summary.FieldSenderUsername] = struct{}{}
+}
+
+// SenderUsernameCleared returns if the "sender_username" field was cleared in this mutation.
+func (m *SummaryMutation) SenderUsernameCleared() bool {
+	_, ok := m.clearedFields[summary.FieldSenderUsername]
+	return ok
+}
+
+// ResetSenderUsername resets all changes to the "sender_username" field.
+func (m *SummaryMutation) ResetSenderUsername() {
+	m.sender_username = nil
+	delete(m.clearedFields, summary.FieldSenderUsername)
+}
+
+// SetSenderNickname sets the "sender_nickname" field.
+func (m *SummaryMutation) SetSenderNickname(s string) {
+	m.sender_nickname = &s
+}
+
+// SenderNickname returns the value of the "sender_nickname" field in the mutation.
+func (m *SummaryMutation) SenderNickname() (r string, exists bool) {
+	v := m.sender_nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderNickname returns the old "sender_nickname" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSenderNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderNickname: %w", err)
+	}
+	return oldValue.SenderNickname, nil
+}
+
+// ClearSenderNickname clears the value of the "sender_nickname" field.
+func (m *SummaryMutation) ClearSenderNickname() {
+	m.sender_nickname = nil
+	m.clearedFields[summary.FieldSenderNickname] = struct{}{}
+}
+
+// SenderNicknameCleared returns if the "sender_nickname" field was cleared in this mutation.
+func (m *SummaryMutation) SenderNicknameCleared() bool {
+	_, ok := m.clearedFields[summary.FieldSenderNickname]
+	return ok
 }
 
 // ResetSenderNickname resets all changes to the "sender_nickname" field.
@@ -1972,87 +9571,2846 @@ func (m *SummaryMutation) ResetSenderNickname() {
 	delete(m.clearedFields, summary.FieldSenderNickname)
 }
 
-// SetSummaryDate sets the "summary_date" field.
-func (m *SummaryMutation) SetSummaryDate(t time.Time) {
-	m.summary_date = &t
+// SetSummaryDate sets the "summary_date" field.
+func (m *SummaryMutation) SetSummaryDate(t time.Time) {
+	m.summary_date = &t
+}
+
+// SummaryDate returns the value of the "summary_date" field in the mutation.
+func (m *SummaryMutation) SummaryDate() (r time.Time, exists bool) {
+	v := m.summary_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryDate returns the old "summary_date" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSummaryDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryDate: %w", err)
+	}
+	return oldValue.SummaryDate, nil
+}
+
+// ResetSummaryDate resets all changes to the "summary_date" field.
+func (m *SummaryMutation) ResetSummaryDate() {
+	m.summary_date = nil
+}
+
+// SetContent sets the "content" field.
+func (m *SummaryMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *SummaryMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *SummaryMutation) ResetContent() {
+	m.content = nil
+}
+
+// Where appends a list predicates to the SummaryMutation builder.
+func (m *SummaryMutation) Where(ps ...predicate.Summary) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SummaryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Summary, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SummaryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SummaryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Summary).
+func (m *SummaryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SummaryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, summary.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, summary.FieldUpdateTime)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, summary.FieldChatID)
+	}
+	if m.sender_id != nil {
+		fields = append(fields, summary.FieldSenderID)
+	}
+	if m.sender_name != nil {
+		fields = append(fields, summary.FieldSenderName)
+	}
+	if m.sender_username != nil {
+		fields = append(fields, summary.FieldSenderUsername)
+	}
+	if m.sender_nickname != nil {
+		fields = append(fields, summary.FieldSenderNickname)
+	}
+	if m.summary_date != nil {
+		fields = append(fields, summary.FieldSummaryDate)
+	}
+	if m.content != nil {
+		fields = append(fields, summary.FieldContent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case summary.FieldCreateTime:
+		return m.CreateTime()
+	case summary.FieldUpdateTime:
+		return m.UpdateTime()
+	case summary.FieldChatID:
+		return m.ChatID()
+	case summary.FieldSenderID:
+		return m.SenderID()
+	case summary.FieldSenderName:
+		return m.SenderName()
+	case summary.FieldSenderUsername:
+		return m.SenderUsername()
+	case summary.FieldSenderNickname:
+		return m.SenderNickname()
+	case summary.FieldSummaryDate:
+		return m.SummaryDate()
+	case summary.FieldContent:
+		return m.Content()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case summary.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case summary.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case summary.FieldChatID:
+		return m.OldChatID(ctx)
+	case summary.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case summary.FieldSenderName:
+		return m.OldSenderName(ctx)
+	case summary.FieldSenderUsername:
+		return m.OldSenderUsername(ctx)
+	case summary.FieldSenderNickname:
+		return m.OldSenderNickname(ctx)
+	case summary.FieldSummaryDate:
+		return m.OldSummaryDate(ctx)
+	case summary.FieldContent:
+		return m.OldContent(ctx)
+	}
+	return nil, fmt.Errorf("unknown Summary field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case summary.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case summary.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case summary.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case summary.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case summary.FieldSenderName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderName(v)
+		return nil
+	case summary.FieldSenderUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderUsername(v)
+		return nil
+	case summary.FieldSenderNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderNickname(v)
+		return nil
+	case summary.FieldSummaryDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummaryDate(v)
+		return nil
+	case summary.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Summary field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SummaryMutation) AddedFields() []string {
+	var fields []string
+	if m.addchat_id != nil {
+		fields = append(fields, summary.FieldChatID)
+	}
+	if m.addsender_id != nil {
+		fields = append(fields, summary.FieldSenderID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case summary.FieldChatID:
+		return m.AddedChatID()
+	case summary.FieldSenderID:
+		return m.AddedSenderID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case summary.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case summary.FieldSenderID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSenderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Summary numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SummaryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(summary.FieldSenderUsername) {
+		fields = append(fields, summary.FieldSenderUsername)
+	}
+	if m.FieldCleared(summary.FieldSenderNickname) {
+		fields = append(fields, summary.FieldSenderNickname)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SummaryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SummaryMutation) ClearField(name string) error {
+	switch name {
+	case summary.FieldSenderUsername:
+		m.ClearSenderUsername()
+		return nil
+	case summary.FieldSenderNickname:
+		m.ClearSenderNickname()
+		return nil
+	}
+	return fmt.Errorf("unknown Summary nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SummaryMutation) ResetField(name string) error {
+	switch name {
+	case summary.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case summary.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case summary.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case summary.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case summary.FieldSenderName:
+		m.ResetSenderName()
+		return nil
+	case summary.FieldSenderUsername:
+		m.ResetSenderUsername()
+		return nil
+	case summary.FieldSenderNickname:
+		m.ResetSenderNickname()
+		return nil
+	case summary.FieldSummaryDate:
+		m.ResetSummaryDate()
+		return nil
+	case summary.FieldContent:
+		m.ResetContent()
+		return nil
+	}
+	return fmt.Errorf("unknown Summary field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SummaryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SummaryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SummaryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SummaryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SummaryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SummaryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SummaryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Summary unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SummaryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Summary edge %s", name)
+}
+
+// SummaryRevisionMutation represents an operation that mutates the SummaryRevision nodes in the graph.
+type SummaryRevisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	task_id       *int
+	addtask_id    *int
+	chat_id       *int64
+	addchat_id    *int64
+	content       *string
+	source        *summaryrevision.Source
+	sent_at       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SummaryRevision, error)
+	predicates    []predicate.SummaryRevision
+}
+
+var _ ent.Mutation = (*SummaryRevisionMutation)(nil)
+
+// summaryrevisionOption allows management of the mutation configuration using functional options.
+type summaryrevisionOption func(*SummaryRevisionMutation)
+
+// newSummaryRevisionMutation creates new mutation for the SummaryRevision entity.
+func newSummaryRevisionMutation(c config, op Op, opts ...summaryrevisionOption) *SummaryRevisionMutation {
+	m := &SummaryRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSummaryRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSummaryRevisionID sets the ID field of the mutation.
+func withSummaryRevisionID(id int) summaryrevisionOption {
+	return func(m *SummaryRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SummaryRevision
+		)
+		m.oldValue = func(ctx context.Context) (*SummaryRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SummaryRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSummaryRevision sets the old SummaryRevision of the mutation.
+func withSummaryRevision(node *SummaryRevision) summaryrevisionOption {
+	return func(m *SummaryRevisionMutation) {
+		m.oldValue = func(context.Context) (*SummaryRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SummaryRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SummaryRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SummaryRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SummaryRevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SummaryRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SummaryRevisionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SummaryRevisionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SummaryRevisionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SummaryRevisionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SummaryRevisionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SummaryRevisionMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *SummaryRevisionMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *SummaryRevisionMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldTaskID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *SummaryRevisionMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *SummaryRevisionMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *SummaryRevisionMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *SummaryRevisionMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *SummaryRevisionMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *SummaryRevisionMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *SummaryRevisionMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *SummaryRevisionMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetContent sets the "content" field.
+func (m *SummaryRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *SummaryRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *SummaryRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetSource sets the "source" field.
+func (m *SummaryRevisionMutation) SetSource(s summaryrevision.Source) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *SummaryRevisionMutation) Source() (r summaryrevision.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldSource(ctx context.Context) (v summaryrevision.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *SummaryRevisionMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *SummaryRevisionMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *SummaryRevisionMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the SummaryRevision entity.
+// If the SummaryRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryRevisionMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *SummaryRevisionMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[summaryrevision.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *SummaryRevisionMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[summaryrevision.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *SummaryRevisionMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, summaryrevision.FieldSentAt)
+}
+
+// Where appends a list predicates to the SummaryRevisionMutation builder.
+func (m *SummaryRevisionMutation) Where(ps ...predicate.SummaryRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SummaryRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SummaryRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SummaryRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SummaryRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SummaryRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SummaryRevision).
+func (m *SummaryRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SummaryRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, summaryrevision.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, summaryrevision.FieldUpdateTime)
+	}
+	if m.task_id != nil {
+		fields = append(fields, summaryrevision.FieldTaskID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, summaryrevision.FieldChatID)
+	}
+	if m.content != nil {
+		fields = append(fields, summaryrevision.FieldContent)
+	}
+	if m.source != nil {
+		fields = append(fields, summaryrevision.FieldSource)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, summaryrevision.FieldSentAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SummaryRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case summaryrevision.FieldCreateTime:
+		return m.CreateTime()
+	case summaryrevision.FieldUpdateTime:
+		return m.UpdateTime()
+	case summaryrevision.FieldTaskID:
+		return m.TaskID()
+	case summaryrevision.FieldChatID:
+		return m.ChatID()
+	case summaryrevision.FieldContent:
+		return m.Content()
+	case summaryrevision.FieldSource:
+		return m.Source()
+	case summaryrevision.FieldSentAt:
+		return m.SentAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SummaryRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case summaryrevision.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case summaryrevision.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case summaryrevision.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case summaryrevision.FieldChatID:
+		return m.OldChatID(ctx)
+	case summaryrevision.FieldContent:
+		return m.OldContent(ctx)
+	case summaryrevision.FieldSource:
+		return m.OldSource(ctx)
+	case summaryrevision.FieldSentAt:
+		return m.OldSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SummaryRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case summaryrevision.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case summaryrevision.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case summaryrevision.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case summaryrevision.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case summaryrevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case summaryrevision.FieldSource:
+		v, ok := value.(summaryrevision.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case summaryrevision.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SummaryRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, summaryrevision.FieldTaskID)
+	}
+	if m.addchat_id != nil {
+		fields = append(fields, summaryrevision.FieldChatID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SummaryRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case summaryrevision.FieldTaskID:
+		return m.AddedTaskID()
+	case summaryrevision.FieldChatID:
+		return m.AddedChatID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case summaryrevision.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case summaryrevision.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SummaryRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(summaryrevision.FieldSentAt) {
+		fields = append(fields, summaryrevision.FieldSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SummaryRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SummaryRevisionMutation) ClearField(name string) error {
+	switch name {
+	case summaryrevision.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SummaryRevisionMutation) ResetField(name string) error {
+	switch name {
+	case summaryrevision.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case summaryrevision.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case summaryrevision.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case summaryrevision.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case summaryrevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case summaryrevision.FieldSource:
+		m.ResetSource()
+		return nil
+	case summaryrevision.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SummaryRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SummaryRevisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SummaryRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SummaryRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SummaryRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SummaryRevisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SummaryRevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SummaryRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SummaryRevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SummaryRevision edge %s", name)
+}
+
+// SummaryViewMutation represents an operation that mutates the SummaryView nodes in the graph.
+type SummaryViewMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	task_id       *int
+	addtask_id    *int
+	chat_id       *int64
+	addchat_id    *int64
+	user_id       *int64
+	adduser_id    *int64
+	topic         *int
+	addtopic      *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SummaryView, error)
+	predicates    []predicate.SummaryView
+}
+
+var _ ent.Mutation = (*SummaryViewMutation)(nil)
+
+// summaryviewOption allows management of the mutation configuration using functional options.
+type summaryviewOption func(*SummaryViewMutation)
+
+// newSummaryViewMutation creates new mutation for the SummaryView entity.
+func newSummaryViewMutation(c config, op Op, opts ...summaryviewOption) *SummaryViewMutation {
+	m := &SummaryViewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSummaryView,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSummaryViewID sets the ID field of the mutation.
+func withSummaryViewID(id int) summaryviewOption {
+	return func(m *SummaryViewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SummaryView
+		)
+		m.oldValue = func(ctx context.Context) (*SummaryView, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SummaryView.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSummaryView sets the old SummaryView of the mutation.
+func withSummaryView(node *SummaryView) summaryviewOption {
+	return func(m *SummaryViewMutation) {
+		m.oldValue = func(context.Context) (*SummaryView, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SummaryViewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SummaryViewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SummaryViewMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SummaryViewMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SummaryView.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SummaryViewMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SummaryViewMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SummaryViewMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SummaryViewMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SummaryViewMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SummaryViewMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *SummaryViewMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *SummaryViewMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldTaskID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *SummaryViewMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *SummaryViewMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *SummaryViewMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *SummaryViewMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *SummaryViewMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *SummaryViewMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *SummaryViewMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *SummaryViewMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SummaryViewMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SummaryViewMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *SummaryViewMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *SummaryViewMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SummaryViewMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetTopic sets the "topic" field.
+func (m *SummaryViewMutation) SetTopic(i int) {
+	m.topic = &i
+	m.addtopic = nil
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *SummaryViewMutation) Topic() (r int, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the SummaryView entity.
+// If the SummaryView object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryViewMutation) OldTopic(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// AddTopic adds i to the "topic" field.
+func (m *SummaryViewMutation) AddTopic(i int) {
+	if m.addtopic != nil {
+		*m.addtopic += i
+	} else {
+		m.addtopic = &i
+	}
+}
+
+// AddedTopic returns the value that was added to the "topic" field in this mutation.
+func (m *SummaryViewMutation) AddedTopic() (r int, exists bool) {
+	v := m.addtopic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *SummaryViewMutation) ResetTopic() {
+	m.topic = nil
+	m.addtopic = nil
+}
+
+// Where appends a list predicates to the SummaryViewMutation builder.
+func (m *SummaryViewMutation) Where(ps ...predicate.SummaryView) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SummaryViewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SummaryViewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SummaryView, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SummaryViewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SummaryViewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SummaryView).
+func (m *SummaryViewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SummaryViewMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, summaryview.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, summaryview.FieldUpdateTime)
+	}
+	if m.task_id != nil {
+		fields = append(fields, summaryview.FieldTaskID)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, summaryview.FieldChatID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, summaryview.FieldUserID)
+	}
+	if m.topic != nil {
+		fields = append(fields, summaryview.FieldTopic)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SummaryViewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case summaryview.FieldCreateTime:
+		return m.CreateTime()
+	case summaryview.FieldUpdateTime:
+		return m.UpdateTime()
+	case summaryview.FieldTaskID:
+		return m.TaskID()
+	case summaryview.FieldChatID:
+		return m.ChatID()
+	case summaryview.FieldUserID:
+		return m.UserID()
+	case summaryview.FieldTopic:
+		return m.Topic()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SummaryViewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case summaryview.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case summaryview.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case summaryview.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case summaryview.FieldChatID:
+		return m.OldChatID(ctx)
+	case summaryview.FieldUserID:
+		return m.OldUserID(ctx)
+	case summaryview.FieldTopic:
+		return m.OldTopic(ctx)
+	}
+	return nil, fmt.Errorf("unknown SummaryView field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryViewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case summaryview.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case summaryview.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case summaryview.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case summaryview.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case summaryview.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case summaryview.FieldTopic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopic(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryView field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SummaryViewMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, summaryview.FieldTaskID)
+	}
+	if m.addchat_id != nil {
+		fields = append(fields, summaryview.FieldChatID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, summaryview.FieldUserID)
+	}
+	if m.addtopic != nil {
+		fields = append(fields, summaryview.FieldTopic)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SummaryViewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case summaryview.FieldTaskID:
+		return m.AddedTaskID()
+	case summaryview.FieldChatID:
+		return m.AddedChatID()
+	case summaryview.FieldUserID:
+		return m.AddedUserID()
+	case summaryview.FieldTopic:
+		return m.AddedTopic()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryViewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case summaryview.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case summaryview.FieldChatID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChatID(v)
+		return nil
+	case summaryview.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case summaryview.FieldTopic:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopic(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryView numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SummaryViewMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SummaryViewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SummaryViewMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SummaryView nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SummaryViewMutation) ResetField(name string) error {
+	switch name {
+	case summaryview.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case summaryview.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case summaryview.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case summaryview.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case summaryview.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case summaryview.FieldTopic:
+		m.ResetTopic()
+		return nil
+	}
+	return fmt.Errorf("unknown SummaryView field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SummaryViewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SummaryViewMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SummaryViewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SummaryViewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SummaryViewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SummaryViewMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SummaryViewMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SummaryView unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SummaryViewMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SummaryView edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	create_time          *time.Time
+	update_time          *time.Time
+	chat_id              *int64
+	addchat_id           *int64
+	start_time           *time.Time
+	end_time             *time.Time
+	status               *task.Status
+	completed_at         *time.Time
+	error_message        *string
+	summary_content      *string
+	message_count        *int
+	addmessage_count     *int
+	participant_count    *int
+	addparticipant_count *int
+	summary_json         *string
+	prompt_budget        *string
+	progress_chunk       *int
+	addprogress_chunk    *int
+	progress_chunks      *int
+	addprogress_chunks   *int
+	progress_tokens      *int
+	addprogress_tokens   *int
+	kind                 *task.Kind
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Task, error)
+	predicates           []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TaskMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TaskMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TaskMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *TaskMutation) SetChatID(i int64) {
+	m.chat_id = &i
+	m.addchat_id = nil
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *TaskMutation) ChatID() (r int64, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldChatID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// AddChatID adds i to the "chat_id" field.
+func (m *TaskMutation) AddChatID(i int64) {
+	if m.addchat_id != nil {
+		*m.addchat_id += i
+	} else {
+		m.addchat_id = &i
+	}
+}
+
+// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
+func (m *TaskMutation) AddedChatID() (r int64, exists bool) {
+	v := m.addchat_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *TaskMutation) ResetChatID() {
+	m.chat_id = nil
+	m.addchat_id = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *TaskMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *TaskMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *TaskMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *TaskMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *TaskMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *TaskMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TaskMutation) SetStatus(t task.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskMutation) Status() (r task.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *TaskMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *TaskMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *TaskMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[task.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *TaskMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *TaskMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, task.FieldCompletedAt)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *TaskMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *TaskMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *TaskMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[task.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *TaskMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[task.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *TaskMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, task.FieldErrorMessage)
+}
+
+// SetSummaryContent sets the "summary_content" field.
+func (m *TaskMutation) SetSummaryContent(s string) {
+	m.summary_content = &s
+}
+
+// SummaryContent returns the value of the "summary_content" field in the mutation.
+func (m *TaskMutation) SummaryContent() (r string, exists bool) {
+	v := m.summary_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryContent returns the old "summary_content" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSummaryContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryContent: %w", err)
+	}
+	return oldValue.SummaryContent, nil
+}
+
+// ClearSummaryContent clears the value of the "summary_content" field.
+func (m *TaskMutation) ClearSummaryContent() {
+	m.summary_content = nil
+	m.clearedFields[task.FieldSummaryContent] = struct{}{}
 }
 
-// SummaryDate returns the value of the "summary_date" field in the mutation.
-func (m *SummaryMutation) SummaryDate() (r time.Time, exists bool) {
-	v := m.summary_date
+// SummaryContentCleared returns if the "summary_content" field was cleared in this mutation.
+func (m *TaskMutation) SummaryContentCleared() bool {
+	_, ok := m.clearedFields[task.FieldSummaryContent]
+	return ok
+}
+
+// ResetSummaryContent resets all changes to the "summary_content" field.
+func (m *TaskMutation) ResetSummaryContent() {
+	m.summary_content = nil
+	delete(m.clearedFields, task.FieldSummaryContent)
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *TaskMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *TaskMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummaryDate returns the old "summary_date" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageCount returns the old "message_count" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSummaryDate(ctx context.Context) (v time.Time, err error) {
+func (m *TaskMutation) OldMessageCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummaryDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummaryDate requires an ID field in the mutation")
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummaryDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
 	}
-	return oldValue.SummaryDate, nil
+	return oldValue.MessageCount, nil
 }
 
-// ResetSummaryDate resets all changes to the "summary_date" field.
-func (m *SummaryMutation) ResetSummaryDate() {
-	m.summary_date = nil
+// AddMessageCount adds i to the "message_count" field.
+func (m *TaskMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
 }
 
-// SetContent sets the "content" field.
-func (m *SummaryMutation) SetContent(s string) {
-	m.content = &s
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *TaskMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *SummaryMutation) Content() (r string, exists bool) {
-	v := m.content
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *TaskMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
+}
+
+// SetParticipantCount sets the "participant_count" field.
+func (m *TaskMutation) SetParticipantCount(i int) {
+	m.participant_count = &i
+	m.addparticipant_count = nil
+}
+
+// ParticipantCount returns the value of the "participant_count" field in the mutation.
+func (m *TaskMutation) ParticipantCount() (r int, exists bool) {
+	v := m.participant_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldParticipantCount returns the old "participant_count" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *TaskMutation) OldParticipantCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldParticipantCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldParticipantCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldParticipantCount: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.ParticipantCount, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *SummaryMutation) ResetContent() {
-	m.content = nil
+// AddParticipantCount adds i to the "participant_count" field.
+func (m *TaskMutation) AddParticipantCount(i int) {
+	if m.addparticipant_count != nil {
+		*m.addparticipant_count += i
+	} else {
+		m.addparticipant_count = &i
+	}
 }
 
-// Where appends a list predicates to the SummaryMutation builder.
-func (m *SummaryMutation) Where(ps ...predicate.Summary) {
+// AddedParticipantCount returns the value that was added to the "participant_count" field in this mutation.
+func (m *TaskMutation) AddedParticipantCount() (r int, exists bool) {
+	v := m.addparticipant_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParticipantCount resets all changes to the "participant_count" field.
+func (m *TaskMutation) ResetParticipantCount() {
+	m.participant_count = nil
+	m.addparticipant_count = nil
+}
+
+// SetSummaryJSON sets the "summary_json" field.
+func (m *TaskMutation) SetSummaryJSON(s string) {
+	m.summary_json = &s
+}
+
+// SummaryJSON returns the value of the "summary_json" field in the mutation.
+func (m *TaskMutation) SummaryJSON() (r string, exists bool) {
+	v := m.summary_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummaryJSON returns the old "summary_json" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSummaryJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummaryJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummaryJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummaryJSON: %w", err)
+	}
+	return oldValue.SummaryJSON, nil
+}
+
+// ClearSummaryJSON clears the value of the "summary_json" field.
+func (m *TaskMutation) ClearSummaryJSON() {
+	m.summary_json = nil
+	m.clearedFields[task.FieldSummaryJSON] = struct{}{}
+}
+
+// SummaryJSONCleared returns if the "summary_json" field was cleared in this mutation.
+func (m *TaskMutation) SummaryJSONCleared() bool {
+	_, ok := m.clearedFields[task.FieldSummaryJSON]
+	return ok
+}
+
+// ResetSummaryJSON resets all changes to the "summary_json" field.
+func (m *TaskMutation) ResetSummaryJSON() {
+	m.summary_json = nil
+	delete(m.clearedFields, task.FieldSummaryJSON)
+}
+
+// SetPromptBudget sets the "prompt_budget" field.
+func (m *TaskMutation) SetPromptBudget(s string) {
+	m.prompt_budget = &s
+}
+
+// PromptBudget returns the value of the "prompt_budget" field in the mutation.
+func (m *TaskMutation) PromptBudget() (r string, exists bool) {
+	v := m.prompt_budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptBudget returns the old "prompt_budget" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPromptBudget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptBudget: %w", err)
+	}
+	return oldValue.PromptBudget, nil
+}
+
+// ClearPromptBudget clears the value of the "prompt_budget" field.
+func (m *TaskMutation) ClearPromptBudget() {
+	m.prompt_budget = nil
+	m.clearedFields[task.FieldPromptBudget] = struct{}{}
+}
+
+// PromptBudgetCleared returns if the "prompt_budget" field was cleared in this mutation.
+func (m *TaskMutation) PromptBudgetCleared() bool {
+	_, ok := m.clearedFields[task.FieldPromptBudget]
+	return ok
+}
+
+// ResetPromptBudget resets all changes to the "prompt_budget" field.
+func (m *TaskMutation) ResetPromptBudget() {
+	m.prompt_budget = nil
+	delete(m.clearedFields, task.FieldPromptBudget)
+}
+
+// SetProgressChunk sets the "progress_chunk" field.
+func (m *TaskMutation) SetProgressChunk(i int) {
+	m.progress_chunk = &i
+	m.addprogress_chunk = nil
+}
+
+// ProgressChunk returns the value of the "progress_chunk" field in the mutation.
+func (m *TaskMutation) ProgressChunk() (r int, exists bool) {
+	v := m.progress_chunk
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgressChunk returns the old "progress_chunk" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProgressChunk(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgressChunk is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgressChunk requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgressChunk: %w", err)
+	}
+	return oldValue.ProgressChunk, nil
+}
+
+// AddProgressChunk adds i to the "progress_chunk" field.
+func (m *TaskMutation) AddProgressChunk(i int) {
+	if m.addprogress_chunk != nil {
+		*m.addprogress_chunk += i
+	} else {
+		m.addprogress_chunk = &i
+	}
+}
+
+// AddedProgressChunk returns the value that was added to the "progress_chunk" field in this mutation.
+func (m *TaskMutation) AddedProgressChunk() (r int, exists bool) {
+	v := m.addprogress_chunk
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgressChunk resets all changes to the "progress_chunk" field.
+func (m *TaskMutation) ResetProgressChunk() {
+	m.progress_chunk = nil
+	m.addprogress_chunk = nil
+}
+
+// SetProgressChunks sets the "progress_chunks" field.
+func (m *TaskMutation) SetProgressChunks(i int) {
+	m.progress_chunks = &i
+	m.addprogress_chunks = nil
+}
+
+// ProgressChunks returns the value of the "progress_chunks" field in the mutation.
+func (m *TaskMutation) ProgressChunks() (r int, exists bool) {
+	v := m.progress_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgressChunks returns the old "progress_chunks" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProgressChunks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgressChunks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgressChunks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgressChunks: %w", err)
+	}
+	return oldValue.ProgressChunks, nil
+}
+
+// AddProgressChunks adds i to the "progress_chunks" field.
+func (m *TaskMutation) AddProgressChunks(i int) {
+	if m.addprogress_chunks != nil {
+		*m.addprogress_chunks += i
+	} else {
+		m.addprogress_chunks = &i
+	}
+}
+
+// AddedProgressChunks returns the value that was added to the "progress_chunks" field in this mutation.
+func (m *TaskMutation) AddedProgressChunks() (r int, exists bool) {
+	v := m.addprogress_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgressChunks resets all changes to the "progress_chunks" field.
+func (m *TaskMutation) ResetProgressChunks() {
+	m.progress_chunks = nil
+	m.addprogress_chunks = nil
+}
+
+// SetProgressTokens sets the "progress_tokens" field.
+func (m *TaskMutation) SetProgressTokens(i int) {
+	m.progress_tokens = &i
+	m.addprogress_tokens = nil
+}
+
+// ProgressTokens returns the value of the "progress_tokens" field in the mutation.
+func (m *TaskMutation) ProgressTokens() (r int, exists bool) {
+	v := m.progress_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgressTokens returns the old "progress_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProgressTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgressTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgressTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgressTokens: %w", err)
+	}
+	return oldValue.ProgressTokens, nil
+}
+
+// AddProgressTokens adds i to the "progress_tokens" field.
+func (m *TaskMutation) AddProgressTokens(i int) {
+	if m.addprogress_tokens != nil {
+		*m.addprogress_tokens += i
+	} else {
+		m.addprogress_tokens = &i
+	}
+}
+
+// AddedProgressTokens returns the value that was added to the "progress_tokens" field in this mutation.
+func (m *TaskMutation) AddedProgressTokens() (r int, exists bool) {
+	v := m.addprogress_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgressTokens resets all changes to the "progress_tokens" field.
+func (m *TaskMutation) ResetProgressTokens() {
+	m.progress_tokens = nil
+	m.addprogress_tokens = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TaskMutation) SetKind(t task.Kind) {
+	m.kind = &t
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TaskMutation) Kind() (r task.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldKind(ctx context.Context) (v task.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TaskMutation) ResetKind() {
+	m.kind = nil
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SummaryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Summary, len(ps))
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2060,51 +12418,75 @@ func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SummaryMutation) Op() Op {
+func (m *TaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SummaryMutation) SetOp(op Op) {
+func (m *TaskMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Summary).
-func (m *SummaryMutation) Type() string {
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SummaryMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 17)
 	if m.create_time != nil {
-		fields = append(fields, summary.FieldCreateTime)
+		fields = append(fields, task.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, summary.FieldUpdateTime)
+		fields = append(fields, task.FieldUpdateTime)
 	}
 	if m.chat_id != nil {
-		fields = append(fields, summary.FieldChatID)
+		fields = append(fields, task.FieldChatID)
 	}
-	if m.sender_id != nil {
-		fields = append(fields, summary.FieldSenderID)
+	if m.start_time != nil {
+		fields = append(fields, task.FieldStartTime)
 	}
-	if m.sender_name != nil {
-		fields = append(fields, summary.FieldSenderName)
+	if m.end_time != nil {
+		fields = append(fields, task.FieldEndTime)
 	}
-	if m.sender_username != nil {
-		fields = append(fields, summary.FieldSenderUsername)
+	if m.status != nil {
+		fields = append(fields, task.FieldStatus)
 	}
-	if m.sender_nickname != nil {
-		fields = append(fields, summary.FieldSenderNickname)
+	if m.completed_at != nil {
+		fields = append(fields, task.FieldCompletedAt)
 	}
-	if m.summary_date != nil {
-		fields = append(fields, summary.FieldSummaryDate)
+	if m.error_message != nil {
+		fields = append(fields, task.FieldErrorMessage)
 	}
-	if m.content != nil {
-		fields = append(fields, summary.FieldContent)
+	if m.summary_content != nil {
+		fields = append(fields, task.FieldSummaryContent)
+	}
+	if m.message_count != nil {
+		fields = append(fields, task.FieldMessageCount)
+	}
+	if m.participant_count != nil {
+		fields = append(fields, task.FieldParticipantCount)
+	}
+	if m.summary_json != nil {
+		fields = append(fields, task.FieldSummaryJSON)
+	}
+	if m.prompt_budget != nil {
+		fields = append(fields, task.FieldPromptBudget)
+	}
+	if m.progress_chunk != nil {
+		fields = append(fields, task.FieldProgressChunk)
+	}
+	if m.progress_chunks != nil {
+		fields = append(fields, task.FieldProgressChunks)
+	}
+	if m.progress_tokens != nil {
+		fields = append(fields, task.FieldProgressTokens)
+	}
+	if m.kind != nil {
+		fields = append(fields, task.FieldKind)
 	}
 	return fields
 }
@@ -2112,26 +12494,42 @@ func (m *SummaryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case summary.FieldCreateTime:
+	case task.FieldCreateTime:
 		return m.CreateTime()
-	case summary.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		return m.UpdateTime()
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		return m.ChatID()
-	case summary.FieldSenderID:
-		return m.SenderID()
-	case summary.FieldSenderName:
-		return m.SenderName()
-	case summary.FieldSenderUsername:
-		return m.SenderUsername()
-	case summary.FieldSenderNickname:
-		return m.SenderNickname()
-	case summary.FieldSummaryDate:
-		return m.SummaryDate()
-	case summary.FieldContent:
-		return m.Content()
+	case task.FieldStartTime:
+		return m.StartTime()
+	case task.FieldEndTime:
+		return m.EndTime()
+	case task.FieldStatus:
+		return m.Status()
+	case task.FieldCompletedAt:
+		return m.CompletedAt()
+	case task.FieldErrorMessage:
+		return m.ErrorMessage()
+	case task.FieldSummaryContent:
+		return m.SummaryContent()
+	case task.FieldMessageCount:
+		return m.MessageCount()
+	case task.FieldParticipantCount:
+		return m.ParticipantCount()
+	case task.FieldSummaryJSON:
+		return m.SummaryJSON()
+	case task.FieldPromptBudget:
+		return m.PromptBudget()
+	case task.FieldProgressChunk:
+		return m.ProgressChunk()
+	case task.FieldProgressChunks:
+		return m.ProgressChunks()
+	case task.FieldProgressTokens:
+		return m.ProgressTokens()
+	case task.FieldKind:
+		return m.Kind()
 	}
 	return nil, false
 }
@@ -2139,111 +12537,195 @@ func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case summary.FieldCreateTime:
+	case task.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case summary.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		return m.OldChatID(ctx)
-	case summary.FieldSenderID:
-		return m.OldSenderID(ctx)
-	case summary.FieldSenderName:
-		return m.OldSenderName(ctx)
-	case summary.FieldSenderUsername:
-		return m.OldSenderUsername(ctx)
-	case summary.FieldSenderNickname:
-		return m.OldSenderNickname(ctx)
-	case summary.FieldSummaryDate:
-		return m.OldSummaryDate(ctx)
-	case summary.FieldContent:
-		return m.OldContent(ctx)
+	case task.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case task.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case task.FieldStatus:
+		return m.OldStatus(ctx)
+	case task.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case task.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case task.FieldSummaryContent:
+		return m.OldSummaryContent(ctx)
+	case task.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case task.FieldParticipantCount:
+		return m.OldParticipantCount(ctx)
+	case task.FieldSummaryJSON:
+		return m.OldSummaryJSON(ctx)
+	case task.FieldPromptBudget:
+		return m.OldPromptBudget(ctx)
+	case task.FieldProgressChunk:
+		return m.OldProgressChunk(ctx)
+	case task.FieldProgressChunks:
+		return m.OldProgressChunks(ctx)
+	case task.FieldProgressTokens:
+		return m.OldProgressTokens(ctx)
+	case task.FieldKind:
+		return m.OldKind(ctx)
 	}
-	return nil, fmt.Errorf("unknown Summary field %s", name)
+	return nil, fmt.Errorf("unknown Task field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SummaryMutation) SetField(name string, value ent.Value) error {
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case summary.FieldCreateTime:
+	case task.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case summary.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case summary.FieldSenderID:
-		v, ok := value.(int64)
+	case task.FieldStartTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderID(v)
+		m.SetStartTime(v)
 		return nil
-	case summary.FieldSenderName:
-		v, ok := value.(string)
+	case task.FieldEndTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderName(v)
+		m.SetEndTime(v)
 		return nil
-	case summary.FieldSenderUsername:
+	case task.FieldStatus:
+		v, ok := value.(task.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case task.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case task.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderUsername(v)
+		m.SetErrorMessage(v)
 		return nil
-	case summary.FieldSenderNickname:
+	case task.FieldSummaryContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderNickname(v)
+		m.SetSummaryContent(v)
 		return nil
-	case summary.FieldSummaryDate:
-		v, ok := value.(time.Time)
+	case task.FieldMessageCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummaryDate(v)
+		m.SetMessageCount(v)
 		return nil
-	case summary.FieldContent:
+	case task.FieldParticipantCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParticipantCount(v)
+		return nil
+	case task.FieldSummaryJSON:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetSummaryJSON(v)
+		return nil
+	case task.FieldPromptBudget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptBudget(v)
+		return nil
+	case task.FieldProgressChunk:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgressChunk(v)
+		return nil
+	case task.FieldProgressChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgressChunks(v)
+		return nil
+	case task.FieldProgressTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgressTokens(v)
+		return nil
+	case task.FieldKind:
+		v, ok := value.(task.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Summary field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SummaryMutation) AddedFields() []string {
+func (m *TaskMutation) AddedFields() []string {
 	var fields []string
 	if m.addchat_id != nil {
-		fields = append(fields, summary.FieldChatID)
+		fields = append(fields, task.FieldChatID)
 	}
-	if m.addsender_id != nil {
-		fields = append(fields, summary.FieldSenderID)
+	if m.addmessage_count != nil {
+		fields = append(fields, task.FieldMessageCount)
+	}
+	if m.addparticipant_count != nil {
+		fields = append(fields, task.FieldParticipantCount)
+	}
+	if m.addprogress_chunk != nil {
+		fields = append(fields, task.FieldProgressChunk)
+	}
+	if m.addprogress_chunks != nil {
+		fields = append(fields, task.FieldProgressChunks)
+	}
+	if m.addprogress_tokens != nil {
+		fields = append(fields, task.FieldProgressTokens)
 	}
 	return fields
 }
@@ -2251,12 +12733,20 @@ func (m *SummaryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		return m.AddedChatID()
-	case summary.FieldSenderID:
-		return m.AddedSenderID()
+	case task.FieldMessageCount:
+		return m.AddedMessageCount()
+	case task.FieldParticipantCount:
+		return m.AddedParticipantCount()
+	case task.FieldProgressChunk:
+		return m.AddedProgressChunk()
+	case task.FieldProgressChunks:
+		return m.AddedProgressChunks()
+	case task.FieldProgressTokens:
+		return m.AddedProgressTokens()
 	}
 	return nil, false
 }
@@ -2264,176 +12754,243 @@ func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SummaryMutation) AddField(name string, value ent.Value) error {
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddChatID(v)
 		return nil
-	case summary.FieldSenderID:
-		v, ok := value.(int64)
+	case task.FieldMessageCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSenderID(v)
+		m.AddMessageCount(v)
+		return nil
+	case task.FieldParticipantCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParticipantCount(v)
+		return nil
+	case task.FieldProgressChunk:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgressChunk(v)
+		return nil
+	case task.FieldProgressChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgressChunks(v)
+		return nil
+	case task.FieldProgressTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgressTokens(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Summary numeric field %s", name)
+	return fmt.Errorf("unknown Task numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SummaryMutation) ClearedFields() []string {
+func (m *TaskMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(summary.FieldSenderUsername) {
-		fields = append(fields, summary.FieldSenderUsername)
+	if m.FieldCleared(task.FieldCompletedAt) {
+		fields = append(fields, task.FieldCompletedAt)
 	}
-	if m.FieldCleared(summary.FieldSenderNickname) {
-		fields = append(fields, summary.FieldSenderNickname)
+	if m.FieldCleared(task.FieldErrorMessage) {
+		fields = append(fields, task.FieldErrorMessage)
+	}
+	if m.FieldCleared(task.FieldSummaryContent) {
+		fields = append(fields, task.FieldSummaryContent)
+	}
+	if m.FieldCleared(task.FieldSummaryJSON) {
+		fields = append(fields, task.FieldSummaryJSON)
+	}
+	if m.FieldCleared(task.FieldPromptBudget) {
+		fields = append(fields, task.FieldPromptBudget)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SummaryMutation) FieldCleared(name string) bool {
+func (m *TaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SummaryMutation) ClearField(name string) error {
+func (m *TaskMutation) ClearField(name string) error {
 	switch name {
-	case summary.FieldSenderUsername:
-		m.ClearSenderUsername()
+	case task.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case task.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case task.FieldSummaryContent:
+		m.ClearSummaryContent()
 		return nil
-	case summary.FieldSenderNickname:
-		m.ClearSenderNickname()
+	case task.FieldSummaryJSON:
+		m.ClearSummaryJSON()
+		return nil
+	case task.FieldPromptBudget:
+		m.ClearPromptBudget()
 		return nil
 	}
-	return fmt.Errorf("unknown Summary nullable field %s", name)
+	return fmt.Errorf("unknown Task nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SummaryMutation) ResetField(name string) error {
+func (m *TaskMutation) ResetField(name string) error {
 	switch name {
-	case summary.FieldCreateTime:
+	case task.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case summary.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case summary.FieldChatID:
+	case task.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case summary.FieldSenderID:
-		m.ResetSenderID()
+	case task.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case summary.FieldSenderName:
-		m.ResetSenderName()
+	case task.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case summary.FieldSenderUsername:
-		m.ResetSenderUsername()
+	case task.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case summary.FieldSenderNickname:
-		m.ResetSenderNickname()
+	case task.FieldCompletedAt:
+		m.ResetCompletedAt()
 		return nil
-	case summary.FieldSummaryDate:
-		m.ResetSummaryDate()
+	case task.FieldErrorMessage:
+		m.ResetErrorMessage()
 		return nil
-	case summary.FieldContent:
-		m.ResetContent()
+	case task.FieldSummaryContent:
+		m.ResetSummaryContent()
+		return nil
+	case task.FieldMessageCount:
+		m.ResetMessageCount()
+		return nil
+	case task.FieldParticipantCount:
+		m.ResetParticipantCount()
+		return nil
+	case task.FieldSummaryJSON:
+		m.ResetSummaryJSON()
+		return nil
+	case task.FieldPromptBudget:
+		m.ResetPromptBudget()
+		return nil
+	case task.FieldProgressChunk:
+		m.ResetProgressChunk()
+		return nil
+	case task.FieldProgressChunks:
+		m.ResetProgressChunks()
+		return nil
+	case task.FieldProgressTokens:
+		m.ResetProgressTokens()
+		return nil
+	case task.FieldKind:
+		m.ResetKind()
 		return nil
 	}
-	return fmt.Errorf("unknown Summary field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SummaryMutation) AddedEdges() []string {
+func (m *TaskMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SummaryMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SummaryMutation) RemovedEdges() []string {
+func (m *TaskMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SummaryMutation) RemovedIDs(name string) []ent.Value {
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SummaryMutation) ClearedEdges() []string {
+func (m *TaskMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SummaryMutation) EdgeCleared(name string) bool {
+func (m *TaskMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SummaryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Summary unique edge %s", name)
+func (m *TaskMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Task unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SummaryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Summary edge %s", name)
+func (m *TaskMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Task edge %s", name)
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	create_time     *time.Time
-	update_time     *time.Time
-	chat_id         *int64
-	addchat_id      *int64
-	start_time      *time.Time
-	end_time        *time.Time
-	status          *task.Status
-	completed_at    *time.Time
-	error_message   *string
-	summary_content *string
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Task, error)
-	predicates      []predicate.Task
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	user_id       *int64
+	adduser_id    *int64
+	name          *string
+	username      *string
+	photo_file_id *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*User, error)
+	predicates    []predicate.User
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2442,20 +12999,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2464,10 +13021,10 @@ func withTaskID(id int) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2476,7 +13033,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2484,7 +13041,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2495,7 +13052,7 @@ func (m TaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2506,7 +13063,7 @@ func (m *TaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2515,19 +13072,19 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TaskMutation) SetCreateTime(t time.Time) {
+func (m *UserMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+func (m *UserMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2535,10 +13092,10 @@ func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2553,17 +13110,17 @@ func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TaskMutation) ResetCreateTime() {
+func (m *UserMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TaskMutation) SetUpdateTime(t time.Time) {
+func (m *UserMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *UserMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2571,10 +13128,10 @@ func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2589,330 +13146,209 @@ func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TaskMutation) ResetUpdateTime() {
+func (m *UserMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *TaskMutation) SetChatID(i int64) {
-	m.chat_id = &i
-	m.addchat_id = nil
-}
-
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *TaskMutation) ChatID() (r int64, exists bool) {
-	v := m.chat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatID returns the old "chat_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldChatID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// AddChatID adds i to the "chat_id" field.
-func (m *TaskMutation) AddChatID(i int64) {
-	if m.addchat_id != nil {
-		*m.addchat_id += i
-	} else {
-		m.addchat_id = &i
-	}
-}
-
-// AddedChatID returns the value that was added to the "chat_id" field in this mutation.
-func (m *TaskMutation) AddedChatID() (r int64, exists bool) {
-	v := m.addchat_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *TaskMutation) ResetChatID() {
-	m.chat_id = nil
-	m.addchat_id = nil
-}
-
-// SetStartTime sets the "start_time" field.
-func (m *TaskMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
-}
-
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *TaskMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStartTime returns the old "start_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
-	}
-	return oldValue.StartTime, nil
-}
-
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *TaskMutation) ResetStartTime() {
-	m.start_time = nil
-}
-
-// SetEndTime sets the "end_time" field.
-func (m *TaskMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
-}
-
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *TaskMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEndTime returns the old "end_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
-	}
-	return oldValue.EndTime, nil
-}
-
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *TaskMutation) ResetEndTime() {
-	m.end_time = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *TaskMutation) SetStatus(t task.Status) {
-	m.status = &t
+// SetUserID sets the "user_id" field.
+func (m *UserMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TaskMutation) Status() (r task.Status, exists bool) {
-	v := m.status
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldStatus returns the old "status" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+
+// OldUserID returns the old "user_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldStatus(ctx context.Context) (v task.Status, err error) {
+func (m *UserMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UserID, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TaskMutation) ResetStatus() {
-	m.status = nil
+// AddUserID adds i to the "user_id" field.
+func (m *UserMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *TaskMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *UserMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *TaskMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.Name, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *TaskMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[task.FieldCompletedAt] = struct{}{}
-}
-
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *TaskMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[task.FieldCompletedAt]
-	return ok
-}
-
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *TaskMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, task.FieldCompletedAt)
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *TaskMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *TaskMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.Username, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *TaskMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[task.FieldErrorMessage] = struct{}{}
+// ClearUsername clears the value of the "username" field.
+func (m *UserMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[user.FieldUsername] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *TaskMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[task.FieldErrorMessage]
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *UserMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[user.FieldUsername]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *TaskMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, task.FieldErrorMessage)
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, user.FieldUsername)
 }
 
-// SetSummaryContent sets the "summary_content" field.
-func (m *TaskMutation) SetSummaryContent(s string) {
-	m.summary_content = &s
+// SetPhotoFileID sets the "photo_file_id" field.
+func (m *UserMutation) SetPhotoFileID(s string) {
+	m.photo_file_id = &s
 }
 
-// SummaryContent returns the value of the "summary_content" field in the mutation.
-func (m *TaskMutation) SummaryContent() (r string, exists bool) {
-	v := m.summary_content
+// PhotoFileID returns the value of the "photo_file_id" field in the mutation.
+func (m *UserMutation) PhotoFileID() (r string, exists bool) {
+	v := m.photo_file_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummaryContent returns the old "summary_content" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldPhotoFileID returns the old "photo_file_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldSummaryContent(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPhotoFileID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummaryContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldPhotoFileID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummaryContent requires an ID field in the mutation")
+		return v, errors.New("OldPhotoFileID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummaryContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldPhotoFileID: %w", err)
 	}
-	return oldValue.SummaryContent, nil
+	return oldValue.PhotoFileID, nil
 }
 
-// ClearSummaryContent clears the value of the "summary_content" field.
-func (m *TaskMutation) ClearSummaryContent() {
-	m.summary_content = nil
-	m.clearedFields[task.FieldSummaryContent] = struct{}{}
+// ClearPhotoFileID clears the value of the "photo_file_id" field.
+func (m *UserMutation) ClearPhotoFileID() {
+	m.photo_file_id = nil
+	m.clearedFields[user.FieldPhotoFileID] = struct{}{}
 }
 
-// SummaryContentCleared returns if the "summary_content" field was cleared in this mutation.
-func (m *TaskMutation) SummaryContentCleared() bool {
-	_, ok := m.clearedFields[task.FieldSummaryContent]
+// PhotoFileIDCleared returns if the "photo_file_id" field was cleared in this mutation.
+func (m *UserMutation) PhotoFileIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldPhotoFileID]
 	return ok
 }
 
-// ResetSummaryContent resets all changes to the "summary_content" field.
-func (m *TaskMutation) ResetSummaryContent() {
-	m.summary_content = nil
-	delete(m.clearedFields, task.FieldSummaryContent)
+// ResetPhotoFileID resets all changes to the "photo_file_id" field.
+func (m *UserMutation) ResetPhotoFileID() {
+	m.photo_file_id = nil
+	delete(m.clearedFields, user.FieldPhotoFileID)
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2920,51 +13356,42 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.create_time != nil {
-		fields = append(fields, task.FieldCreateTime)
+		fields = append(fields, user.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, task.FieldUpdateTime)
-	}
-	if m.chat_id != nil {
-		fields = append(fields, task.FieldChatID)
-	}
-	if m.start_time != nil {
-		fields = append(fields, task.FieldStartTime)
+		fields = append(fields, user.FieldUpdateTime)
 	}
-	if m.end_time != nil {
-		fields = append(fields, task.FieldEndTime)
-	}
-	if m.status != nil {
-		fields = append(fields, task.FieldStatus)
+	if m.user_id != nil {
+		fields = append(fields, user.FieldUserID)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, task.FieldCompletedAt)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.error_message != nil {
-		fields = append(fields, task.FieldErrorMessage)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.summary_content != nil {
-		fields = append(fields, task.FieldSummaryContent)
+	if m.photo_file_id != nil {
+		fields = append(fields, user.FieldPhotoFileID)
 	}
 	return fields
 }
@@ -2972,26 +13399,20 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldCreateTime:
+	case user.FieldCreateTime:
 		return m.CreateTime()
-	case task.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		return m.UpdateTime()
-	case task.FieldChatID:
-		return m.ChatID()
-	case task.FieldStartTime:
-		return m.StartTime()
-	case task.FieldEndTime:
-		return m.EndTime()
-	case task.FieldStatus:
-		return m.Status()
-	case task.FieldCompletedAt:
-		return m.CompletedAt()
-	case task.FieldErrorMessage:
-		return m.ErrorMessage()
-	case task.FieldSummaryContent:
-		return m.SummaryContent()
+	case user.FieldUserID:
+		return m.UserID()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPhotoFileID:
+		return m.PhotoFileID()
 	}
 	return nil, false
 }
@@ -2999,108 +13420,81 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldCreateTime:
+	case user.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case task.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case task.FieldChatID:
-		return m.OldChatID(ctx)
-	case task.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case task.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case task.FieldStatus:
-		return m.OldStatus(ctx)
-	case task.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case task.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case task.FieldSummaryContent:
-		return m.OldSummaryContent(ctx)
+	case user.FieldUserID:
+		return m.OldUserID(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPhotoFileID:
+		return m.OldPhotoFileID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldCreateTime:
+	case user.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case task.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case task.FieldChatID:
+	case user.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
-		return nil
-	case task.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case task.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
-		return nil
-	case task.FieldStatus:
-		v, ok := value.(task.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case task.FieldCompletedAt:
-		v, ok := value.(time.Time)
+	case user.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetName(v)
 		return nil
-	case task.FieldErrorMessage:
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetUsername(v)
 		return nil
-	case task.FieldSummaryContent:
+	case user.FieldPhotoFileID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummaryContent(v)
+		m.SetPhotoFileID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addchat_id != nil {
-		fields = append(fields, task.FieldChatID)
+	if m.adduser_id != nil {
+		fields = append(fields, user.FieldUserID)
 	}
 	return fields
 }
@@ -3108,10 +13502,10 @@ func (m *TaskMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldChatID:
-		return m.AddedChatID()
+	case user.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -3119,138 +13513,123 @@ func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldChatID:
+	case user.FieldUserID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChatID(v)
+		m.AddUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldCompletedAt) {
-		fields = append(fields, task.FieldCompletedAt)
-	}
-	if m.FieldCleared(task.FieldErrorMessage) {
-		fields = append(fields, task.FieldErrorMessage)
+	if m.FieldCleared(user.FieldUsername) {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.FieldCleared(task.FieldSummaryContent) {
-		fields = append(fields, task.FieldSummaryContent)
+	if m.FieldCleared(user.FieldPhotoFileID) {
+		fields = append(fields, user.FieldPhotoFileID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case task.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case user.FieldUsername:
+		m.ClearUsername()
 		return nil
-	case task.FieldSummaryContent:
-		m.ClearSummaryContent()
+	case user.FieldPhotoFileID:
+		m.ClearPhotoFileID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldCreateTime:
+	case user.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case task.FieldUpdateTime:
+	case user.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case task.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case task.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case task.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case task.FieldStatus:
-		m.ResetStatus()
+	case user.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case task.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case task.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case task.FieldSummaryContent:
-		m.ResetSummaryContent()
+	case user.FieldPhotoFileID:
+		m.ResetPhotoFileID()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
+func (m *UserMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
+func (m *UserMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
+func (m *UserMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Task unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Task edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
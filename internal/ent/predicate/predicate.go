@@ -6,14 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Blackout is the predicate function for blackout builders.
+type Blackout func(*sql.Selector)
+
+// Chat is the predicate function for chat builders.
+type Chat func(*sql.Selector)
+
 // DailyRun is the predicate function for dailyrun builders.
 type DailyRun func(*sql.Selector)
 
+// Follow is the predicate function for follow builders.
+type Follow func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// RunLog is the predicate function for runlog builders.
+type RunLog func(*sql.Selector)
+
+// SentPart is the predicate function for sentpart builders.
+type SentPart func(*sql.Selector)
+
+// ShadowRun is the predicate function for shadowrun builders.
+type ShadowRun func(*sql.Selector)
+
+// SharedLink is the predicate function for sharedlink builders.
+type SharedLink func(*sql.Selector)
+
 // Summary is the predicate function for summary builders.
 type Summary func(*sql.Selector)
 
+// SummaryRevision is the predicate function for summaryrevision builders.
+type SummaryRevision func(*sql.Selector)
+
+// SummaryView is the predicate function for summaryview builders.
+type SummaryView func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+)
+
+// RunLogCreate is the builder for creating a RunLog entity.
+type RunLogCreate struct {
+	config
+	mutation *RunLogMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *RunLogCreate) SetCreateTime(v time.Time) *RunLogCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableCreateTime(v *time.Time) *RunLogCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *RunLogCreate) SetUpdateTime(v time.Time) *RunLogCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableUpdateTime(v *time.Time) *RunLogCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *RunLogCreate) SetRunID(v int) *RunLogCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableRunID(v *int) *RunLogCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *RunLogCreate) SetTaskID(v int) *RunLogCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableTaskID(v *int) *RunLogCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *RunLogCreate) SetChatID(v int64) *RunLogCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableChatID(v *int64) *RunLogCreate {
+	if v != nil {
+		_c.SetChatID(*v)
+	}
+	return _c
+}
+
+// SetEvent sets the "event" field.
+func (_c *RunLogCreate) SetEvent(v runlog.Event) *RunLogCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *RunLogCreate) SetDetail(v string) *RunLogCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_c *RunLogCreate) SetNillableDetail(v *string) *RunLogCreate {
+	if v != nil {
+		_c.SetDetail(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RunLogMutation object of the builder.
+func (_c *RunLogCreate) Mutation() *RunLogMutation {
+	return _c.mutation
+}
+
+// Save creates the RunLog in the database.
+func (_c *RunLogCreate) Save(ctx context.Context) (*RunLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RunLogCreate) SaveX(ctx context.Context) *RunLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RunLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RunLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RunLogCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := runlog.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := runlog.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.RunID(); !ok {
+		v := runlog.DefaultRunID
+		_c.mutation.SetRunID(v)
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		v := runlog.DefaultTaskID
+		_c.mutation.SetTaskID(v)
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		v := runlog.DefaultChatID
+		_c.mutation.SetChatID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RunLogCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "RunLog.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "RunLog.update_time"`)}
+	}
+	if _, ok := _c.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "RunLog.run_id"`)}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "RunLog.task_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "RunLog.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "RunLog.event"`)}
+	}
+	if v, ok := _c.mutation.Event(); ok {
+		if err := runlog.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "RunLog.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RunLogCreate) sqlSave(ctx context.Context) (*RunLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RunLogCreate) createSpec() (*RunLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RunLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(runlog.Table, sqlgraph.NewFieldSpec(runlog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(runlog.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(runlog.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(runlog.FieldRunID, field.TypeInt, value)
+		_node.RunID = value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(runlog.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(runlog.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(runlog.FieldEvent, field.TypeEnum, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(runlog.FieldDetail, field.TypeString, value)
+		_node.Detail = value
+	}
+	return _node, _spec
+}
+
+// RunLogCreateBulk is the builder for creating many RunLog entities in bulk.
+type RunLogCreateBulk struct {
+	config
+	err      error
+	builders []*RunLogCreate
+}
+
+// Save creates the RunLog entities in the database.
+func (_c *RunLogCreateBulk) Save(ctx context.Context) ([]*RunLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RunLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RunLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RunLogCreateBulk) SaveX(ctx context.Context) []*RunLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RunLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RunLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+)
+
+// RunLogUpdate is the builder for updating RunLog entities.
+type RunLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RunLogMutation
+}
+
+// Where appends a list predicates to the RunLogUpdate builder.
+func (_u *RunLogUpdate) Where(ps ...predicate.RunLog) *RunLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RunLogUpdate) SetUpdateTime(v time.Time) *RunLogUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *RunLogUpdate) SetRunID(v int) *RunLogUpdate {
+	_u.mutation.ResetRunID()
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *RunLogUpdate) SetNillableRunID(v *int) *RunLogUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// AddRunID adds value to the "run_id" field.
+func (_u *RunLogUpdate) AddRunID(v int) *RunLogUpdate {
+	_u.mutation.AddRunID(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *RunLogUpdate) SetTaskID(v int) *RunLogUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *RunLogUpdate) SetNillableTaskID(v *int) *RunLogUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *RunLogUpdate) AddTaskID(v int) *RunLogUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *RunLogUpdate) SetChatID(v int64) *RunLogUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *RunLogUpdate) SetNillableChatID(v *int64) *RunLogUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *RunLogUpdate) AddChatID(v int64) *RunLogUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *RunLogUpdate) SetEvent(v runlog.Event) *RunLogUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *RunLogUpdate) SetNillableEvent(v *runlog.Event) *RunLogUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *RunLogUpdate) SetDetail(v string) *RunLogUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *RunLogUpdate) SetNillableDetail(v *string) *RunLogUpdate {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *RunLogUpdate) ClearDetail() *RunLogUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// Mutation returns the RunLogMutation object of the builder.
+func (_u *RunLogUpdate) Mutation() *RunLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RunLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RunLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RunLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RunLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RunLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := runlog.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RunLogUpdate) check() error {
+	if v, ok := _u.mutation.Event(); ok {
+		if err := runlog.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "RunLog.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RunLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(runlog.Table, runlog.Columns, sqlgraph.NewFieldSpec(runlog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(runlog.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(runlog.FieldRunID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunID(); ok {
+		_spec.AddField(runlog.FieldRunID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(runlog.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(runlog.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(runlog.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(runlog.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(runlog.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(runlog.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(runlog.FieldDetail, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{runlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RunLogUpdateOne is the builder for updating a single RunLog entity.
+type RunLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RunLogMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RunLogUpdateOne) SetUpdateTime(v time.Time) *RunLogUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *RunLogUpdateOne) SetRunID(v int) *RunLogUpdateOne {
+	_u.mutation.ResetRunID()
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *RunLogUpdateOne) SetNillableRunID(v *int) *RunLogUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// AddRunID adds value to the "run_id" field.
+func (_u *RunLogUpdateOne) AddRunID(v int) *RunLogUpdateOne {
+	_u.mutation.AddRunID(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *RunLogUpdateOne) SetTaskID(v int) *RunLogUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *RunLogUpdateOne) SetNillableTaskID(v *int) *RunLogUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *RunLogUpdateOne) AddTaskID(v int) *RunLogUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *RunLogUpdateOne) SetChatID(v int64) *RunLogUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *RunLogUpdateOne) SetNillableChatID(v *int64) *RunLogUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *RunLogUpdateOne) AddChatID(v int64) *RunLogUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *RunLogUpdateOne) SetEvent(v runlog.Event) *RunLogUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *RunLogUpdateOne) SetNillableEvent(v *runlog.Event) *RunLogUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *RunLogUpdateOne) SetDetail(v string) *RunLogUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *RunLogUpdateOne) SetNillableDetail(v *string) *RunLogUpdateOne {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *RunLogUpdateOne) ClearDetail() *RunLogUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// Mutation returns the RunLogMutation object of the builder.
+func (_u *RunLogUpdateOne) Mutation() *RunLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RunLogUpdate builder.
+func (_u *RunLogUpdateOne) Where(ps ...predicate.RunLog) *RunLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RunLogUpdateOne) Select(field string, fields ...string) *RunLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RunLog entity.
+func (_u *RunLogUpdateOne) Save(ctx context.Context) (*RunLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RunLogUpdateOne) SaveX(ctx context.Context) *RunLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RunLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RunLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RunLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := runlog.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RunLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Event(); ok {
+		if err := runlog.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "RunLog.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RunLogUpdateOne) sqlSave(ctx context.Context) (_node *RunLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(runlog.Table, runlog.Columns, sqlgraph.NewFieldSpec(runlog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RunLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, runlog.FieldID)
+		for _, f := range fields {
+			if !runlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != runlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(runlog.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(runlog.FieldRunID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunID(); ok {
+		_spec.AddField(runlog.FieldRunID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(runlog.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(runlog.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(runlog.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(runlog.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(runlog.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(runlog.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(runlog.FieldDetail, field.TypeString)
+	}
+	_node = &RunLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{runlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
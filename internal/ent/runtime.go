@@ -5,17 +5,57 @@ package ent
 import (
 	"time"
 
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
 	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
 	"github.com/fachebot/talk-trace-bot/internal/ent/message"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
 	"github.com/fachebot/talk-trace-bot/internal/ent/schema"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
 	"github.com/fachebot/talk-trace-bot/internal/ent/summary"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
 	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/ent/user"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	blackoutMixin := schema.Blackout{}.Mixin()
+	blackoutMixinFields0 := blackoutMixin[0].Fields()
+	_ = blackoutMixinFields0
+	blackoutFields := schema.Blackout{}.Fields()
+	_ = blackoutFields
+	// blackoutDescCreateTime is the schema descriptor for create_time field.
+	blackoutDescCreateTime := blackoutMixinFields0[0].Descriptor()
+	// blackout.DefaultCreateTime holds the default value on creation for the create_time field.
+	blackout.DefaultCreateTime = blackoutDescCreateTime.Default.(func() time.Time)
+	// blackoutDescUpdateTime is the schema descriptor for update_time field.
+	blackoutDescUpdateTime := blackoutMixinFields0[1].Descriptor()
+	// blackout.DefaultUpdateTime holds the default value on creation for the update_time field.
+	blackout.DefaultUpdateTime = blackoutDescUpdateTime.Default.(func() time.Time)
+	// blackout.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	blackout.UpdateDefaultUpdateTime = blackoutDescUpdateTime.UpdateDefault.(func() time.Time)
+	chatMixin := schema.Chat{}.Mixin()
+	chatMixinFields0 := chatMixin[0].Fields()
+	_ = chatMixinFields0
+	chatFields := schema.Chat{}.Fields()
+	_ = chatFields
+	// chatDescCreateTime is the schema descriptor for create_time field.
+	chatDescCreateTime := chatMixinFields0[0].Descriptor()
+	// chat.DefaultCreateTime holds the default value on creation for the create_time field.
+	chat.DefaultCreateTime = chatDescCreateTime.Default.(func() time.Time)
+	// chatDescUpdateTime is the schema descriptor for update_time field.
+	chatDescUpdateTime := chatMixinFields0[1].Descriptor()
+	// chat.DefaultUpdateTime holds the default value on creation for the update_time field.
+	chat.DefaultUpdateTime = chatDescUpdateTime.Default.(func() time.Time)
+	// chat.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	chat.UpdateDefaultUpdateTime = chatDescUpdateTime.UpdateDefault.(func() time.Time)
 	dailyrunMixin := schema.DailyRun{}.Mixin()
 	dailyrunMixinFields0 := dailyrunMixin[0].Fields()
 	_ = dailyrunMixinFields0
@@ -31,6 +71,61 @@ func init() {
 	dailyrun.DefaultUpdateTime = dailyrunDescUpdateTime.Default.(func() time.Time)
 	// dailyrun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	dailyrun.UpdateDefaultUpdateTime = dailyrunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// dailyrunDescWindow is the schema descriptor for window field.
+	dailyrunDescWindow := dailyrunFields[0].Descriptor()
+	// dailyrun.DefaultWindow holds the default value on creation for the window field.
+	dailyrun.DefaultWindow = dailyrunDescWindow.Default.(string)
+	// dailyrunDescDurationMs is the schema descriptor for duration_ms field.
+	dailyrunDescDurationMs := dailyrunFields[5].Descriptor()
+	// dailyrun.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	dailyrun.DefaultDurationMs = dailyrunDescDurationMs.Default.(int64)
+	// dailyrunDescChatsProcessed is the schema descriptor for chats_processed field.
+	dailyrunDescChatsProcessed := dailyrunFields[6].Descriptor()
+	// dailyrun.DefaultChatsProcessed holds the default value on creation for the chats_processed field.
+	dailyrun.DefaultChatsProcessed = dailyrunDescChatsProcessed.Default.(int)
+	// dailyrunDescChatsFailed is the schema descriptor for chats_failed field.
+	dailyrunDescChatsFailed := dailyrunFields[7].Descriptor()
+	// dailyrun.DefaultChatsFailed holds the default value on creation for the chats_failed field.
+	dailyrun.DefaultChatsFailed = dailyrunDescChatsFailed.Default.(int)
+	// dailyrunDescMessagesSummarized is the schema descriptor for messages_summarized field.
+	dailyrunDescMessagesSummarized := dailyrunFields[8].Descriptor()
+	// dailyrun.DefaultMessagesSummarized holds the default value on creation for the messages_summarized field.
+	dailyrun.DefaultMessagesSummarized = dailyrunDescMessagesSummarized.Default.(int)
+	// dailyrunDescPromptTokens is the schema descriptor for prompt_tokens field.
+	dailyrunDescPromptTokens := dailyrunFields[9].Descriptor()
+	// dailyrun.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	dailyrun.DefaultPromptTokens = dailyrunDescPromptTokens.Default.(int)
+	// dailyrunDescCompletionTokens is the schema descriptor for completion_tokens field.
+	dailyrunDescCompletionTokens := dailyrunFields[10].Descriptor()
+	// dailyrun.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	dailyrun.DefaultCompletionTokens = dailyrunDescCompletionTokens.Default.(int)
+	// dailyrunDescTotalTokens is the schema descriptor for total_tokens field.
+	dailyrunDescTotalTokens := dailyrunFields[11].Descriptor()
+	// dailyrun.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	dailyrun.DefaultTotalTokens = dailyrunDescTotalTokens.Default.(int)
+	// dailyrunDescCost is the schema descriptor for cost field.
+	dailyrunDescCost := dailyrunFields[12].Descriptor()
+	// dailyrun.DefaultCost holds the default value on creation for the cost field.
+	dailyrun.DefaultCost = dailyrunDescCost.Default.(float64)
+	// dailyrunDescMessagesCleaned is the schema descriptor for messages_cleaned field.
+	dailyrunDescMessagesCleaned := dailyrunFields[13].Descriptor()
+	// dailyrun.DefaultMessagesCleaned holds the default value on creation for the messages_cleaned field.
+	dailyrun.DefaultMessagesCleaned = dailyrunDescMessagesCleaned.Default.(int)
+	followMixin := schema.Follow{}.Mixin()
+	followMixinFields0 := followMixin[0].Fields()
+	_ = followMixinFields0
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreateTime is the schema descriptor for create_time field.
+	followDescCreateTime := followMixinFields0[0].Descriptor()
+	// follow.DefaultCreateTime holds the default value on creation for the create_time field.
+	follow.DefaultCreateTime = followDescCreateTime.Default.(func() time.Time)
+	// followDescUpdateTime is the schema descriptor for update_time field.
+	followDescUpdateTime := followMixinFields0[1].Descriptor()
+	// follow.DefaultUpdateTime holds the default value on creation for the update_time field.
+	follow.DefaultUpdateTime = followDescUpdateTime.Default.(func() time.Time)
+	// follow.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	follow.UpdateDefaultUpdateTime = followDescUpdateTime.UpdateDefault.(func() time.Time)
 	messageMixin := schema.Message{}.Mixin()
 	messageMixinFields0 := messageMixin[0].Fields()
 	_ = messageMixinFields0
@@ -46,6 +141,98 @@ func init() {
 	message.DefaultUpdateTime = messageDescUpdateTime.Default.(func() time.Time)
 	// message.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	message.UpdateDefaultUpdateTime = messageDescUpdateTime.UpdateDefault.(func() time.Time)
+	runlogMixin := schema.RunLog{}.Mixin()
+	runlogMixinFields0 := runlogMixin[0].Fields()
+	_ = runlogMixinFields0
+	runlogFields := schema.RunLog{}.Fields()
+	_ = runlogFields
+	// runlogDescCreateTime is the schema descriptor for create_time field.
+	runlogDescCreateTime := runlogMixinFields0[0].Descriptor()
+	// runlog.DefaultCreateTime holds the default value on creation for the create_time field.
+	runlog.DefaultCreateTime = runlogDescCreateTime.Default.(func() time.Time)
+	// runlogDescUpdateTime is the schema descriptor for update_time field.
+	runlogDescUpdateTime := runlogMixinFields0[1].Descriptor()
+	// runlog.DefaultUpdateTime holds the default value on creation for the update_time field.
+	runlog.DefaultUpdateTime = runlogDescUpdateTime.Default.(func() time.Time)
+	// runlog.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	runlog.UpdateDefaultUpdateTime = runlogDescUpdateTime.UpdateDefault.(func() time.Time)
+	// runlogDescRunID is the schema descriptor for run_id field.
+	runlogDescRunID := runlogFields[0].Descriptor()
+	// runlog.DefaultRunID holds the default value on creation for the run_id field.
+	runlog.DefaultRunID = runlogDescRunID.Default.(int)
+	// runlogDescTaskID is the schema descriptor for task_id field.
+	runlogDescTaskID := runlogFields[1].Descriptor()
+	// runlog.DefaultTaskID holds the default value on creation for the task_id field.
+	runlog.DefaultTaskID = runlogDescTaskID.Default.(int)
+	// runlogDescChatID is the schema descriptor for chat_id field.
+	runlogDescChatID := runlogFields[2].Descriptor()
+	// runlog.DefaultChatID holds the default value on creation for the chat_id field.
+	runlog.DefaultChatID = runlogDescChatID.Default.(int64)
+	sentpartMixin := schema.SentPart{}.Mixin()
+	sentpartMixinFields0 := sentpartMixin[0].Fields()
+	_ = sentpartMixinFields0
+	sentpartFields := schema.SentPart{}.Fields()
+	_ = sentpartFields
+	// sentpartDescCreateTime is the schema descriptor for create_time field.
+	sentpartDescCreateTime := sentpartMixinFields0[0].Descriptor()
+	// sentpart.DefaultCreateTime holds the default value on creation for the create_time field.
+	sentpart.DefaultCreateTime = sentpartDescCreateTime.Default.(func() time.Time)
+	// sentpartDescUpdateTime is the schema descriptor for update_time field.
+	sentpartDescUpdateTime := sentpartMixinFields0[1].Descriptor()
+	// sentpart.DefaultUpdateTime holds the default value on creation for the update_time field.
+	sentpart.DefaultUpdateTime = sentpartDescUpdateTime.Default.(func() time.Time)
+	// sentpart.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	sentpart.UpdateDefaultUpdateTime = sentpartDescUpdateTime.UpdateDefault.(func() time.Time)
+	shadowrunMixin := schema.ShadowRun{}.Mixin()
+	shadowrunMixinFields0 := shadowrunMixin[0].Fields()
+	_ = shadowrunMixinFields0
+	shadowrunFields := schema.ShadowRun{}.Fields()
+	_ = shadowrunFields
+	// shadowrunDescCreateTime is the schema descriptor for create_time field.
+	shadowrunDescCreateTime := shadowrunMixinFields0[0].Descriptor()
+	// shadowrun.DefaultCreateTime holds the default value on creation for the create_time field.
+	shadowrun.DefaultCreateTime = shadowrunDescCreateTime.Default.(func() time.Time)
+	// shadowrunDescUpdateTime is the schema descriptor for update_time field.
+	shadowrunDescUpdateTime := shadowrunMixinFields0[1].Descriptor()
+	// shadowrun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	shadowrun.DefaultUpdateTime = shadowrunDescUpdateTime.Default.(func() time.Time)
+	// shadowrun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	shadowrun.UpdateDefaultUpdateTime = shadowrunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// shadowrunDescShadowTokens is the schema descriptor for shadow_tokens field.
+	shadowrunDescShadowTokens := shadowrunFields[8].Descriptor()
+	// shadowrun.DefaultShadowTokens holds the default value on creation for the shadow_tokens field.
+	shadowrun.DefaultShadowTokens = shadowrunDescShadowTokens.Default.(int)
+	// shadowrunDescPrimaryTopics is the schema descriptor for primary_topics field.
+	shadowrunDescPrimaryTopics := shadowrunFields[9].Descriptor()
+	// shadowrun.DefaultPrimaryTopics holds the default value on creation for the primary_topics field.
+	shadowrun.DefaultPrimaryTopics = shadowrunDescPrimaryTopics.Default.(int)
+	// shadowrunDescShadowTopics is the schema descriptor for shadow_topics field.
+	shadowrunDescShadowTopics := shadowrunFields[10].Descriptor()
+	// shadowrun.DefaultShadowTopics holds the default value on creation for the shadow_topics field.
+	shadowrun.DefaultShadowTopics = shadowrunDescShadowTopics.Default.(int)
+	// shadowrunDescMatchedTopics is the schema descriptor for matched_topics field.
+	shadowrunDescMatchedTopics := shadowrunFields[11].Descriptor()
+	// shadowrun.DefaultMatchedTopics holds the default value on creation for the matched_topics field.
+	shadowrun.DefaultMatchedTopics = shadowrunDescMatchedTopics.Default.(int)
+	// shadowrunDescMessageOverlap is the schema descriptor for message_overlap field.
+	shadowrunDescMessageOverlap := shadowrunFields[12].Descriptor()
+	// shadowrun.DefaultMessageOverlap holds the default value on creation for the message_overlap field.
+	shadowrun.DefaultMessageOverlap = shadowrunDescMessageOverlap.Default.(float64)
+	sharedlinkMixin := schema.SharedLink{}.Mixin()
+	sharedlinkMixinFields0 := sharedlinkMixin[0].Fields()
+	_ = sharedlinkMixinFields0
+	sharedlinkFields := schema.SharedLink{}.Fields()
+	_ = sharedlinkFields
+	// sharedlinkDescCreateTime is the schema descriptor for create_time field.
+	sharedlinkDescCreateTime := sharedlinkMixinFields0[0].Descriptor()
+	// sharedlink.DefaultCreateTime holds the default value on creation for the create_time field.
+	sharedlink.DefaultCreateTime = sharedlinkDescCreateTime.Default.(func() time.Time)
+	// sharedlinkDescUpdateTime is the schema descriptor for update_time field.
+	sharedlinkDescUpdateTime := sharedlinkMixinFields0[1].Descriptor()
+	// sharedlink.DefaultUpdateTime holds the default value on creation for the update_time field.
+	sharedlink.DefaultUpdateTime = sharedlinkDescUpdateTime.Default.(func() time.Time)
+	// sharedlink.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	sharedlink.UpdateDefaultUpdateTime = sharedlinkDescUpdateTime.UpdateDefault.(func() time.Time)
 	summaryMixin := schema.Summary{}.Mixin()
 	summaryMixinFields0 := summaryMixin[0].Fields()
 	_ = summaryMixinFields0
@@ -61,6 +248,40 @@ func init() {
 	summary.DefaultUpdateTime = summaryDescUpdateTime.Default.(func() time.Time)
 	// summary.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	summary.UpdateDefaultUpdateTime = summaryDescUpdateTime.UpdateDefault.(func() time.Time)
+	summaryrevisionMixin := schema.SummaryRevision{}.Mixin()
+	summaryrevisionMixinFields0 := summaryrevisionMixin[0].Fields()
+	_ = summaryrevisionMixinFields0
+	summaryrevisionFields := schema.SummaryRevision{}.Fields()
+	_ = summaryrevisionFields
+	// summaryrevisionDescCreateTime is the schema descriptor for create_time field.
+	summaryrevisionDescCreateTime := summaryrevisionMixinFields0[0].Descriptor()
+	// summaryrevision.DefaultCreateTime holds the default value on creation for the create_time field.
+	summaryrevision.DefaultCreateTime = summaryrevisionDescCreateTime.Default.(func() time.Time)
+	// summaryrevisionDescUpdateTime is the schema descriptor for update_time field.
+	summaryrevisionDescUpdateTime := summaryrevisionMixinFields0[1].Descriptor()
+	// summaryrevision.DefaultUpdateTime holds the default value on creation for the update_time field.
+	summaryrevision.DefaultUpdateTime = summaryrevisionDescUpdateTime.Default.(func() time.Time)
+	// summaryrevision.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	summaryrevision.UpdateDefaultUpdateTime = summaryrevisionDescUpdateTime.UpdateDefault.(func() time.Time)
+	summaryviewMixin := schema.SummaryView{}.Mixin()
+	summaryviewMixinFields0 := summaryviewMixin[0].Fields()
+	_ = summaryviewMixinFields0
+	summaryviewFields := schema.SummaryView{}.Fields()
+	_ = summaryviewFields
+	// summaryviewDescCreateTime is the schema descriptor for create_time field.
+	summaryviewDescCreateTime := summaryviewMixinFields0[0].Descriptor()
+	// summaryview.DefaultCreateTime holds the default value on creation for the create_time field.
+	summaryview.DefaultCreateTime = summaryviewDescCreateTime.Default.(func() time.Time)
+	// summaryviewDescUpdateTime is the schema descriptor for update_time field.
+	summaryviewDescUpdateTime := summaryviewMixinFields0[1].Descriptor()
+	// summaryview.DefaultUpdateTime holds the default value on creation for the update_time field.
+	summaryview.DefaultUpdateTime = summaryviewDescUpdateTime.Default.(func() time.Time)
+	// summaryview.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	summaryview.UpdateDefaultUpdateTime = summaryviewDescUpdateTime.UpdateDefault.(func() time.Time)
+	// summaryviewDescTopic is the schema descriptor for topic field.
+	summaryviewDescTopic := summaryviewFields[3].Descriptor()
+	// summaryview.DefaultTopic holds the default value on creation for the topic field.
+	summaryview.DefaultTopic = summaryviewDescTopic.Default.(int)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinFields0 := taskMixin[0].Fields()
 	_ = taskMixinFields0
@@ -76,4 +297,39 @@ func init() {
 	task.DefaultUpdateTime = taskDescUpdateTime.Default.(func() time.Time)
 	// task.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	task.UpdateDefaultUpdateTime = taskDescUpdateTime.UpdateDefault.(func() time.Time)
+	// taskDescMessageCount is the schema descriptor for message_count field.
+	taskDescMessageCount := taskFields[7].Descriptor()
+	// task.DefaultMessageCount holds the default value on creation for the message_count field.
+	task.DefaultMessageCount = taskDescMessageCount.Default.(int)
+	// taskDescParticipantCount is the schema descriptor for participant_count field.
+	taskDescParticipantCount := taskFields[8].Descriptor()
+	// task.DefaultParticipantCount holds the default value on creation for the participant_count field.
+	task.DefaultParticipantCount = taskDescParticipantCount.Default.(int)
+	// taskDescProgressChunk is the schema descriptor for progress_chunk field.
+	taskDescProgressChunk := taskFields[11].Descriptor()
+	// task.DefaultProgressChunk holds the default value on creation for the progress_chunk field.
+	task.DefaultProgressChunk = taskDescProgressChunk.Default.(int)
+	// taskDescProgressChunks is the schema descriptor for progress_chunks field.
+	taskDescProgressChunks := taskFields[12].Descriptor()
+	// task.DefaultProgressChunks holds the default value on creation for the progress_chunks field.
+	task.DefaultProgressChunks = taskDescProgressChunks.Default.(int)
+	// taskDescProgressTokens is the schema descriptor for progress_tokens field.
+	taskDescProgressTokens := taskFields[13].Descriptor()
+	// task.DefaultProgressTokens holds the default value on creation for the progress_tokens field.
+	task.DefaultProgressTokens = taskDescProgressTokens.Default.(int)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
 }
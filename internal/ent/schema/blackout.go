@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Blackout holds the schema definition for the Blackout entity.
+type Blackout struct {
+	ent.Schema
+}
+
+func (Blackout) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Blackout.
+func (Blackout) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chat_id").Comment("群聊ID"),
+		field.Time("start_time").Comment("排除区间的开始时间（包含）"),
+		field.Time("end_time").Comment("排除区间的结束时间（不包含）"),
+		field.Int64("operator_id").Comment("执行 /redact 的用户ID"),
+	}
+}
+
+// Indexes of the Blackout.
+func (Blackout) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：启动时加载未结束的排除区间
+		index.Fields("end_time"),
+	}
+}
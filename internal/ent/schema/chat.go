@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Chat holds the schema definition for the Chat entity.
+type Chat struct {
+	ent.Schema
+}
+
+func (Chat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Chat.
+func (Chat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chat_id").Unique().Comment("群聊ID"),
+		field.String("title").Comment("群聊名称"),
+		field.String("username").Optional().Comment("公开群组的用户名，如 @golang_cn；私有群组为空"),
+		field.Int("member_count").Optional().Comment("成员数，由定期刷新任务更新；0 表示未知"),
+		field.Enum("chat_type").
+			Values("group", "supergroup", "channel").
+			Optional().
+			Comment("聊天类型：group=普通群组, supergroup=超级群组, channel=频道；为空表示未知（旧数据，刷新群聊信息后补全）"),
+		field.Enum("consent").
+			Values("unknown", "notified", "opted_out").
+			Default("unknown").
+			Comment("数据收集告知状态：unknown=未告知, notified=已发送介绍消息, opted_out=群管理员已退出收集"),
+		field.Time("consent_updated_at").Optional().Comment("告知状态的最近变更时间"),
+	}
+}
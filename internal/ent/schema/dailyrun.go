@@ -21,6 +21,7 @@ func (DailyRun) Mixin() []ent.Mixin {
 // Fields of the DailyRun.
 func (DailyRun) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("window").Default("daily").Comment("总结窗口名称，同一天可有多个窗口"),
 		field.Time("start_time").Comment("日期范围开始时间"),
 		field.Time("end_time").Comment("日期范围结束时间"),
 		field.Enum("status").
@@ -28,6 +29,17 @@ func (DailyRun) Fields() []ent.Field {
 			Default("in_progress").
 			Comment("运行状态：pending=待执行, in_progress=执行中, completed=已完成, failed=失败"),
 		field.String("error_message").Optional().Comment("错误信息"),
+		field.Int64("duration_ms").Default(0).Comment("运行耗时（毫秒）"),
+		field.Int("chats_processed").Default(0).Comment("成功处理的群组数"),
+		field.Int("chats_failed").Default(0).Comment("处理失败的群组数"),
+		field.Int("messages_summarized").Default(0).Comment("参与总结的消息数"),
+		field.Int("prompt_tokens").Default(0).Comment("LLM 输入 token 数"),
+		field.Int("completion_tokens").Default(0).Comment("LLM 输出 token 数"),
+		field.Int("total_tokens").Default(0).Comment("LLM 总 token 数"),
+		field.Float("cost").Default(0).Comment("LLM 费用估算"),
+		field.Int("messages_cleaned").Default(0).Comment("运行结束时清理的过期消息数"),
+		field.String("lock_owner").Optional().Comment("当前持有执行租约的流程标识"),
+		field.Time("lock_expires_at").Optional().Nillable().Comment("执行租约过期时间，过期后其他流程可接管"),
 	}
 }
 
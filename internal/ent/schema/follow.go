@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Follow holds the schema definition for the Follow entity.
+type Follow struct {
+	ent.Schema
+}
+
+func (Follow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").Comment("关注者用户ID"),
+		field.String("keyword").Comment("关注的关键词（小写）"),
+	}
+}
+
+// Indexes of the Follow.
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		// 唯一索引：同一用户不重复关注同一关键词
+		index.Fields("user_id", "keyword").Unique(),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 )
 
@@ -20,12 +21,34 @@ func (Message) Mixin() []ent.Mixin {
 // Fields of the Message.
 func (Message) Fields() []ent.Field {
 	return []ent.Field{
-		field.Int64("message_id").Comment("Telegram消息ID"),
+		field.Int64("message_id").Comment("TDLib消息ID"),
+		field.Int64("server_message_id").Optional().Comment("服务器消息ID，用于生成 t.me 链接；为空表示尚未发送成功的本地消息或旧数据"),
 		field.Int64("chat_id").Comment("群聊ID"),
 		field.Int64("sender_id").Comment("发送者用户ID"),
 		field.String("sender_name").Comment("发送者名称"),
 		field.String("sender_username").Optional().Comment("发送者用户名，如 @zhangsan"),
 		field.Text("text").Comment("消息文本内容"),
+		field.Bytes("text_zstd").Optional().Comment("zstd 压缩后的消息文本，非空时 text 为空"),
 		field.Time("sent_at").Comment("消息发送时间"),
+		field.String("lang").Optional().Comment("识别的语言代码，如 zh、en；无法识别时为空"),
+		field.Time("deleted_at").Optional().Nillable().Comment("软删除时间，非空表示已过期、等待清除任务物理删除"),
+		field.String("content_type").Optional().Comment("消息内容类型（对应 Ingest.ContentTypes），如 text、caption；为空表示旧数据"),
+		field.Time("edited_at").Optional().Nillable().Comment("最后编辑时间，为空表示未编辑"),
+		field.Int64("message_thread_id").Optional().Comment("论坛话题（Topic）的消息线程ID，为空表示非话题消息或旧数据"),
+		field.Int64("reply_to_message_id").Optional().Comment("回复的同一群聊内消息的 TDLib 消息ID，为空表示未回复或旧数据"),
+		field.Int64("reaction_count").Optional().Comment("消息收到的回应（Reaction）总数，为空表示没有回应或旧数据"),
+		field.String("sender_type").Optional().Comment("发送者类型：user 为用户，chat 为以群组或频道身份发送（匿名管理员、关联频道）；为空表示旧数据"),
+		field.Text("poll").Optional().Comment("投票的问题、选项及得票数（JSON），非投票消息为空"),
+		field.Int64("account_id").Optional().Comment("保存该消息的 Telegram 账号的用户ID（多账号时区分来源），为空表示旧数据"),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：用于清除任务查询已软删除的消息
+		index.Fields("deleted_at"),
+		// 索引：用于入库去重及发送成功后更新消息ID
+		index.Fields("chat_id", "message_id"),
 	}
 }
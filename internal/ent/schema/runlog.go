@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// RunLog holds the schema definition for the RunLog entity.
+type RunLog struct {
+	ent.Schema
+}
+
+func (RunLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the RunLog.
+func (RunLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("run_id").Default(0).Comment("所属 DailyRun ID，0 表示不属于某次运行（如启动时单独恢复的任务）"),
+		field.Int("task_id").Default(0).Comment("关联的任务ID，0 表示运行级事件"),
+		field.Int64("chat_id").Default(0).Comment("群组ID，0 表示运行级事件"),
+		field.Enum("event").
+			Values("run_started", "run_completed", "run_failed", "task_started", "chunk_completed", "notify_sent", "task_failed", "task_blocked").
+			Comment("事件类型：run_started/run_completed/run_failed=运行开始/完成/失败, task_started=开始处理任务, chunk_completed=完成一个分块, notify_sent=通知发送成功, task_failed=任务失败, task_blocked=无权在群组发送消息"),
+		field.String("detail").Optional().Comment("事件详情，如分块进度或错误信息"),
+	}
+}
+
+// Indexes of the RunLog.
+func (RunLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：按运行或任务查询时间线
+		index.Fields("run_id"),
+		index.Fields("task_id"),
+	}
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SentPart holds the schema definition for the SentPart entity.
+type SentPart struct {
+	ent.Schema
+}
+
+func (SentPart) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the SentPart.
+func (SentPart) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").Unique().Comment("幂等键：task:<任务ID>:<接收方ID>:<分段序号>"),
+		field.Int64("message_id").Optional().Comment("已发送消息的ID，后续分段回复该消息；0 表示未记录"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ShadowRun holds the schema definition for the ShadowRun entity.
+type ShadowRun struct {
+	ent.Schema
+}
+
+func (ShadowRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ShadowRun.
+func (ShadowRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chat_id").Comment("群聊ID"),
+		field.Time("start_time").Comment("总结区间开始时间"),
+		field.Time("end_time").Comment("总结区间结束时间"),
+		field.String("primary_model").Comment("主模型名称"),
+		field.String("shadow_model").Comment("对比模型名称"),
+		field.Text("primary_content").Comment("主模型输出的话题 JSON"),
+		field.Text("shadow_content").Optional().Comment("对比模型输出的话题 JSON，失败时为空"),
+		field.String("shadow_error").Optional().Comment("对比模型失败原因"),
+		field.Int("shadow_tokens").Default(0).Comment("对比模型消耗的 token 数"),
+		field.Int("primary_topics").Default(0).Comment("主模型话题数"),
+		field.Int("shadow_topics").Default(0).Comment("对比模型话题数"),
+		field.Int("matched_topics").Default(0).Comment("主模型话题中与对比模型引用了相同消息的话题数"),
+		field.Float("message_overlap").Default(0).Comment("两者引用消息ID的 Jaccard 相似度（0~1）"),
+	}
+}
+
+// Indexes of the ShadowRun.
+func (ShadowRun) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：按时间范围统计
+		index.Fields("create_time"),
+	}
+}
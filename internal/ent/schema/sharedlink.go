@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SharedLink holds the schema definition for the SharedLink entity.
+type SharedLink struct {
+	ent.Schema
+}
+
+func (SharedLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the SharedLink.
+func (SharedLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("chat_id").Comment("群聊ID"),
+		field.Int64("message_id").Comment("分享链接的消息的 TDLib 消息ID"),
+		field.Int64("sender_id").Comment("发送者ID"),
+		field.String("sender_name").Comment("发送者名称"),
+		field.Text("url").Comment("链接地址"),
+		field.Time("sent_at").Comment("消息发送时间"),
+	}
+}
+
+// Indexes of the SharedLink.
+func (SharedLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：按群聊和时间区间查询分享的链接
+		index.Fields("chat_id", "sent_at"),
+		// 索引：用于清理过期的链接
+		index.Fields("sent_at"),
+	}
+}
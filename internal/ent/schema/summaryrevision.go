@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SummaryRevision holds the schema definition for the SummaryRevision entity.
+type SummaryRevision struct {
+	ent.Schema
+}
+
+func (SummaryRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the SummaryRevision.
+func (SummaryRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").Comment("所属任务ID"),
+		field.Int64("chat_id").Comment("群组ID"),
+		field.Text("content").Comment("该版本的摘要内容"),
+		field.Enum("source").
+			Values("generated", "edited").
+			Default("generated").
+			Comment("版本来源：generated=模型生成（含重新生成）, edited=人工修改"),
+		field.Time("sent_at").Optional().Comment("该版本发送完成的时间；为空表示未发送（被替换或仍待发送）"),
+	}
+}
+
+// Indexes of the SummaryRevision.
+func (SummaryRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：按任务查询历史版本
+		index.Fields("task_id"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// SummaryView holds the schema definition for the SummaryView entity.
+type SummaryView struct {
+	ent.Schema
+}
+
+func (SummaryView) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the SummaryView.
+func (SummaryView) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id").Comment("总结对应的任务ID"),
+		field.Int64("chat_id").Comment("群聊ID"),
+		field.Int64("user_id").Comment("点击的用户ID"),
+		field.Int("topic").Default(-1).Comment("点击的话题序号（从 0 开始），-1 表示整份总结"),
+	}
+}
+
+// Indexes of the SummaryView.
+func (SummaryView) Indexes() []ent.Index {
+	return []ent.Index{
+		// 索引：按时间范围统计
+		index.Fields("create_time"),
+	}
+}
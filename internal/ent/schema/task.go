@@ -25,20 +25,31 @@ func (Task) Fields() []ent.Field {
 		field.Time("start_time").Comment("任务日期范围的开始时间"),
 		field.Time("end_time").Comment("任务日期范围的结束时间"),
 		field.Enum("status").
-			Values("pending", "processing", "completed", "failed").
+			Values("pending", "processing", "completed", "failed", "blocked").
 			Default("pending").
-			Comment("任务状态：pending=待处理, processing=处理中, completed=已完成, failed=失败"),
+			Comment("任务状态：pending=待处理, processing=处理中, completed=已完成, failed=失败, blocked=无权在群组发送消息（已被移出或禁言），不再重试"),
 		field.Time("completed_at").Optional().Comment("完成时间"),
 		field.String("error_message").Optional().Comment("错误信息"),
 		field.String("summary_content").Optional().Comment("已生成待发送的摘要内容；非空表示只需重试发送通知"),
+		field.Int("message_count").Default(0).Comment("参与总结的消息数，用于活跃度基线"),
+		field.Int("participant_count").Default(0).Comment("区间内的发言人数，用于活跃度基线"),
+		field.Text("summary_json").Optional().Comment("结构化总结结果（SummaryResult JSON），发送后保留，供话题展开按钮使用"),
+		field.Text("prompt_budget").Optional().Comment("最近一次总结的 prompt token 构成（PromptBudget JSON），用于调整过滤规则及定位 token 消耗大的群组"),
+		field.Int("progress_chunk").Default(0).Comment("总结进度：已完成的 chunk 数"),
+		field.Int("progress_chunks").Default(0).Comment("总结进度：chunk 总数，0 表示尚未开始总结"),
+		field.Int("progress_tokens").Default(0).Comment("总结进度：已完成 chunk 中消息内容的估算 tokens"),
+		field.Enum("kind").
+			Values("scheduled", "adhoc").
+			Default("scheduled").
+			Comment("任务类型：scheduled=定时总结窗口, adhoc=按需总结（/summary 命令）"),
 	}
 }
 
 // Indexes of the Task.
 func (Task) Indexes() []ent.Index {
 	return []ent.Index{
-		// 唯一索引：防止同一日期范围重复创建任务
-		index.Fields("chat_id", "start_time", "end_time").Unique(),
+		// 唯一索引：防止同一日期范围重复创建任务；按需总结与定时窗口区间相同时互不影响
+		index.Fields("chat_id", "start_time", "end_time", "kind").Unique(),
 		// 索引：用于查询未完成任务
 		index.Fields("status"),
 	}
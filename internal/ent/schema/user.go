@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").Unique().Comment("用户ID"),
+		field.String("name").Comment("用户名称"),
+		field.String("username").Optional().Comment("当前用户名（含 @），未设置时为空"),
+		field.String("photo_file_id").Optional().Comment("当前头像（160x160）的远程文件ID，可通过 TDLib getRemoteFile 下载，未设置头像时为空"),
+	}
+}
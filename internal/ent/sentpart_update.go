@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+)
+
+// SentPartUpdate is the builder for updating SentPart entities.
+type SentPartUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SentPartMutation
+}
+
+// Where appends a list predicates to the SentPartUpdate builder.
+func (_u *SentPartUpdate) Where(ps ...predicate.SentPart) *SentPartUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SentPartUpdate) SetUpdateTime(v time.Time) *SentPartUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *SentPartUpdate) SetKey(v string) *SentPartUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *SentPartUpdate) SetNillableKey(v *string) *SentPartUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *SentPartUpdate) SetMessageID(v int64) *SentPartUpdate {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *SentPartUpdate) SetNillableMessageID(v *int64) *SentPartUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *SentPartUpdate) AddMessageID(v int64) *SentPartUpdate {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *SentPartUpdate) ClearMessageID() *SentPartUpdate {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// Mutation returns the SentPartMutation object of the builder.
+func (_u *SentPartUpdate) Mutation() *SentPartMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SentPartUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SentPartUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SentPartUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SentPartUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SentPartUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sentpart.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SentPartUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sentpart.Table, sentpart.Columns, sqlgraph.NewFieldSpec(sentpart.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sentpart.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(sentpart.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(sentpart.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(sentpart.FieldMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(sentpart.FieldMessageID, field.TypeInt64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sentpart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SentPartUpdateOne is the builder for updating a single SentPart entity.
+type SentPartUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SentPartMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SentPartUpdateOne) SetUpdateTime(v time.Time) *SentPartUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *SentPartUpdateOne) SetKey(v string) *SentPartUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *SentPartUpdateOne) SetNillableKey(v *string) *SentPartUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *SentPartUpdateOne) SetMessageID(v int64) *SentPartUpdateOne {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *SentPartUpdateOne) SetNillableMessageID(v *int64) *SentPartUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *SentPartUpdateOne) AddMessageID(v int64) *SentPartUpdateOne {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *SentPartUpdateOne) ClearMessageID() *SentPartUpdateOne {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// Mutation returns the SentPartMutation object of the builder.
+func (_u *SentPartUpdateOne) Mutation() *SentPartMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SentPartUpdate builder.
+func (_u *SentPartUpdateOne) Where(ps ...predicate.SentPart) *SentPartUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SentPartUpdateOne) Select(field string, fields ...string) *SentPartUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SentPart entity.
+func (_u *SentPartUpdateOne) Save(ctx context.Context) (*SentPart, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SentPartUpdateOne) SaveX(ctx context.Context) *SentPart {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SentPartUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SentPartUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SentPartUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sentpart.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SentPartUpdateOne) sqlSave(ctx context.Context) (_node *SentPart, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sentpart.Table, sentpart.Columns, sqlgraph.NewFieldSpec(sentpart.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SentPart.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sentpart.FieldID)
+		for _, f := range fields {
+			if !sentpart.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sentpart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sentpart.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(sentpart.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(sentpart.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(sentpart.FieldMessageID, field.TypeInt64, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(sentpart.FieldMessageID, field.TypeInt64)
+	}
+	_node = &SentPart{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sentpart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+)
+
+// ShadowRun is the model entity for the ShadowRun schema.
+type ShadowRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 群聊ID
+	ChatID int64 `json:"chat_id,omitempty"`
+	// 总结区间开始时间
+	StartTime time.Time `json:"start_time,omitempty"`
+	// 总结区间结束时间
+	EndTime time.Time `json:"end_time,omitempty"`
+	// 主模型名称
+	PrimaryModel string `json:"primary_model,omitempty"`
+	// 对比模型名称
+	ShadowModel string `json:"shadow_model,omitempty"`
+	// 主模型输出的话题 JSON
+	PrimaryContent string `json:"primary_content,omitempty"`
+	// 对比模型输出的话题 JSON，失败时为空
+	ShadowContent string `json:"shadow_content,omitempty"`
+	// 对比模型失败原因
+	ShadowError string `json:"shadow_error,omitempty"`
+	// 对比模型消耗的 token 数
+	ShadowTokens int `json:"shadow_tokens,omitempty"`
+	// 主模型话题数
+	PrimaryTopics int `json:"primary_topics,omitempty"`
+	// 对比模型话题数
+	ShadowTopics int `json:"shadow_topics,omitempty"`
+	// 主模型话题中与对比模型引用了相同消息的话题数
+	MatchedTopics int `json:"matched_topics,omitempty"`
+	// 两者引用消息ID的 Jaccard 相似度（0~1）
+	MessageOverlap float64 `json:"message_overlap,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShadowRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shadowrun.FieldMessageOverlap:
+			values[i] = new(sql.NullFloat64)
+		case shadowrun.FieldID, shadowrun.FieldChatID, shadowrun.FieldShadowTokens, shadowrun.FieldPrimaryTopics, shadowrun.FieldShadowTopics, shadowrun.FieldMatchedTopics:
+			values[i] = new(sql.NullInt64)
+		case shadowrun.FieldPrimaryModel, shadowrun.FieldShadowModel, shadowrun.FieldPrimaryContent, shadowrun.FieldShadowContent, shadowrun.FieldShadowError:
+			values[i] = new(sql.NullString)
+		case shadowrun.FieldCreateTime, shadowrun.FieldUpdateTime, shadowrun.FieldStartTime, shadowrun.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShadowRun fields.
+func (_m *ShadowRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shadowrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case shadowrun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case shadowrun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case shadowrun.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case shadowrun.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case shadowrun.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case shadowrun.FieldPrimaryModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_model", values[i])
+			} else if value.Valid {
+				_m.PrimaryModel = value.String
+			}
+		case shadowrun.FieldShadowModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shadow_model", values[i])
+			} else if value.Valid {
+				_m.ShadowModel = value.String
+			}
+		case shadowrun.FieldPrimaryContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_content", values[i])
+			} else if value.Valid {
+				_m.PrimaryContent = value.String
+			}
+		case shadowrun.FieldShadowContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shadow_content", values[i])
+			} else if value.Valid {
+				_m.ShadowContent = value.String
+			}
+		case shadowrun.FieldShadowError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shadow_error", values[i])
+			} else if value.Valid {
+				_m.ShadowError = value.String
+			}
+		case shadowrun.FieldShadowTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shadow_tokens", values[i])
+			} else if value.Valid {
+				_m.ShadowTokens = int(value.Int64)
+			}
+		case shadowrun.FieldPrimaryTopics:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_topics", values[i])
+			} else if value.Valid {
+				_m.PrimaryTopics = int(value.Int64)
+			}
+		case shadowrun.FieldShadowTopics:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shadow_topics", values[i])
+			} else if value.Valid {
+				_m.ShadowTopics = int(value.Int64)
+			}
+		case shadowrun.FieldMatchedTopics:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field matched_topics", values[i])
+			} else if value.Valid {
+				_m.MatchedTopics = int(value.Int64)
+			}
+		case shadowrun.FieldMessageOverlap:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_overlap", values[i])
+			} else if value.Valid {
+				_m.MessageOverlap = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShadowRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShadowRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ShadowRun.
+// Note that you need to call ShadowRun.Unwrap() before calling this method if this ShadowRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShadowRun) Update() *ShadowRunUpdateOne {
+	return NewShadowRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShadowRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShadowRun) Unwrap() *ShadowRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShadowRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShadowRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShadowRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("primary_model=")
+	builder.WriteString(_m.PrimaryModel)
+	builder.WriteString(", ")
+	builder.WriteString("shadow_model=")
+	builder.WriteString(_m.ShadowModel)
+	builder.WriteString(", ")
+	builder.WriteString("primary_content=")
+	builder.WriteString(_m.PrimaryContent)
+	builder.WriteString(", ")
+	builder.WriteString("shadow_content=")
+	builder.WriteString(_m.ShadowContent)
+	builder.WriteString(", ")
+	builder.WriteString("shadow_error=")
+	builder.WriteString(_m.ShadowError)
+	builder.WriteString(", ")
+	builder.WriteString("shadow_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShadowTokens))
+	builder.WriteString(", ")
+	builder.WriteString("primary_topics=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PrimaryTopics))
+	builder.WriteString(", ")
+	builder.WriteString("shadow_topics=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShadowTopics))
+	builder.WriteString(", ")
+	builder.WriteString("matched_topics=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchedTopics))
+	builder.WriteString(", ")
+	builder.WriteString("message_overlap=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageOverlap))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShadowRuns is a parsable slice of ShadowRun.
+type ShadowRuns []*ShadowRun
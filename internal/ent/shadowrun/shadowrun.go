@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shadowrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the shadowrun type in the database.
+	Label = "shadow_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldPrimaryModel holds the string denoting the primary_model field in the database.
+	FieldPrimaryModel = "primary_model"
+	// FieldShadowModel holds the string denoting the shadow_model field in the database.
+	FieldShadowModel = "shadow_model"
+	// FieldPrimaryContent holds the string denoting the primary_content field in the database.
+	FieldPrimaryContent = "primary_content"
+	// FieldShadowContent holds the string denoting the shadow_content field in the database.
+	FieldShadowContent = "shadow_content"
+	// FieldShadowError holds the string denoting the shadow_error field in the database.
+	FieldShadowError = "shadow_error"
+	// FieldShadowTokens holds the string denoting the shadow_tokens field in the database.
+	FieldShadowTokens = "shadow_tokens"
+	// FieldPrimaryTopics holds the string denoting the primary_topics field in the database.
+	FieldPrimaryTopics = "primary_topics"
+	// FieldShadowTopics holds the string denoting the shadow_topics field in the database.
+	FieldShadowTopics = "shadow_topics"
+	// FieldMatchedTopics holds the string denoting the matched_topics field in the database.
+	FieldMatchedTopics = "matched_topics"
+	// FieldMessageOverlap holds the string denoting the message_overlap field in the database.
+	FieldMessageOverlap = "message_overlap"
+	// Table holds the table name of the shadowrun in the database.
+	Table = "shadow_runs"
+)
+
+// Columns holds all SQL columns for shadowrun fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldChatID,
+	FieldStartTime,
+	FieldEndTime,
+	FieldPrimaryModel,
+	FieldShadowModel,
+	FieldPrimaryContent,
+	FieldShadowContent,
+	FieldShadowError,
+	FieldShadowTokens,
+	FieldPrimaryTopics,
+	FieldShadowTopics,
+	FieldMatchedTopics,
+	FieldMessageOverlap,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultShadowTokens holds the default value on creation for the "shadow_tokens" field.
+	DefaultShadowTokens int
+	// DefaultPrimaryTopics holds the default value on creation for the "primary_topics" field.
+	DefaultPrimaryTopics int
+	// DefaultShadowTopics holds the default value on creation for the "shadow_topics" field.
+	DefaultShadowTopics int
+	// DefaultMatchedTopics holds the default value on creation for the "matched_topics" field.
+	DefaultMatchedTopics int
+	// DefaultMessageOverlap holds the default value on creation for the "message_overlap" field.
+	DefaultMessageOverlap float64
+)
+
+// OrderOption defines the ordering options for the ShadowRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByPrimaryModel orders the results by the primary_model field.
+func ByPrimaryModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryModel, opts...).ToFunc()
+}
+
+// ByShadowModel orders the results by the shadow_model field.
+func ByShadowModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShadowModel, opts...).ToFunc()
+}
+
+// ByPrimaryContent orders the results by the primary_content field.
+func ByPrimaryContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryContent, opts...).ToFunc()
+}
+
+// ByShadowContent orders the results by the shadow_content field.
+func ByShadowContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShadowContent, opts...).ToFunc()
+}
+
+// ByShadowError orders the results by the shadow_error field.
+func ByShadowError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShadowError, opts...).ToFunc()
+}
+
+// ByShadowTokens orders the results by the shadow_tokens field.
+func ByShadowTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShadowTokens, opts...).ToFunc()
+}
+
+// ByPrimaryTopics orders the results by the primary_topics field.
+func ByPrimaryTopics(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryTopics, opts...).ToFunc()
+}
+
+// ByShadowTopics orders the results by the shadow_topics field.
+func ByShadowTopics(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShadowTopics, opts...).ToFunc()
+}
+
+// ByMatchedTopics orders the results by the matched_topics field.
+func ByMatchedTopics(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchedTopics, opts...).ToFunc()
+}
+
+// ByMessageOverlap orders the results by the message_overlap field.
+func ByMessageOverlap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageOverlap, opts...).ToFunc()
+}
@@ -0,0 +1,890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shadowrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldChatID, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// PrimaryModel applies equality check predicate on the "primary_model" field. It's identical to PrimaryModelEQ.
+func PrimaryModel(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryModel, v))
+}
+
+// ShadowModel applies equality check predicate on the "shadow_model" field. It's identical to ShadowModelEQ.
+func ShadowModel(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowModel, v))
+}
+
+// PrimaryContent applies equality check predicate on the "primary_content" field. It's identical to PrimaryContentEQ.
+func PrimaryContent(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryContent, v))
+}
+
+// ShadowContent applies equality check predicate on the "shadow_content" field. It's identical to ShadowContentEQ.
+func ShadowContent(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowContent, v))
+}
+
+// ShadowError applies equality check predicate on the "shadow_error" field. It's identical to ShadowErrorEQ.
+func ShadowError(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowError, v))
+}
+
+// ShadowTokens applies equality check predicate on the "shadow_tokens" field. It's identical to ShadowTokensEQ.
+func ShadowTokens(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowTokens, v))
+}
+
+// PrimaryTopics applies equality check predicate on the "primary_topics" field. It's identical to PrimaryTopicsEQ.
+func PrimaryTopics(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryTopics, v))
+}
+
+// ShadowTopics applies equality check predicate on the "shadow_topics" field. It's identical to ShadowTopicsEQ.
+func ShadowTopics(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowTopics, v))
+}
+
+// MatchedTopics applies equality check predicate on the "matched_topics" field. It's identical to MatchedTopicsEQ.
+func MatchedTopics(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldMatchedTopics, v))
+}
+
+// MessageOverlap applies equality check predicate on the "message_overlap" field. It's identical to MessageOverlapEQ.
+func MessageOverlap(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldMessageOverlap, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v int64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldChatID, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldEndTime, v))
+}
+
+// PrimaryModelEQ applies the EQ predicate on the "primary_model" field.
+func PrimaryModelEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryModel, v))
+}
+
+// PrimaryModelNEQ applies the NEQ predicate on the "primary_model" field.
+func PrimaryModelNEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldPrimaryModel, v))
+}
+
+// PrimaryModelIn applies the In predicate on the "primary_model" field.
+func PrimaryModelIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldPrimaryModel, vs...))
+}
+
+// PrimaryModelNotIn applies the NotIn predicate on the "primary_model" field.
+func PrimaryModelNotIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldPrimaryModel, vs...))
+}
+
+// PrimaryModelGT applies the GT predicate on the "primary_model" field.
+func PrimaryModelGT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldPrimaryModel, v))
+}
+
+// PrimaryModelGTE applies the GTE predicate on the "primary_model" field.
+func PrimaryModelGTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldPrimaryModel, v))
+}
+
+// PrimaryModelLT applies the LT predicate on the "primary_model" field.
+func PrimaryModelLT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldPrimaryModel, v))
+}
+
+// PrimaryModelLTE applies the LTE predicate on the "primary_model" field.
+func PrimaryModelLTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldPrimaryModel, v))
+}
+
+// PrimaryModelContains applies the Contains predicate on the "primary_model" field.
+func PrimaryModelContains(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContains(FieldPrimaryModel, v))
+}
+
+// PrimaryModelHasPrefix applies the HasPrefix predicate on the "primary_model" field.
+func PrimaryModelHasPrefix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasPrefix(FieldPrimaryModel, v))
+}
+
+// PrimaryModelHasSuffix applies the HasSuffix predicate on the "primary_model" field.
+func PrimaryModelHasSuffix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasSuffix(FieldPrimaryModel, v))
+}
+
+// PrimaryModelEqualFold applies the EqualFold predicate on the "primary_model" field.
+func PrimaryModelEqualFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEqualFold(FieldPrimaryModel, v))
+}
+
+// PrimaryModelContainsFold applies the ContainsFold predicate on the "primary_model" field.
+func PrimaryModelContainsFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContainsFold(FieldPrimaryModel, v))
+}
+
+// ShadowModelEQ applies the EQ predicate on the "shadow_model" field.
+func ShadowModelEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowModel, v))
+}
+
+// ShadowModelNEQ applies the NEQ predicate on the "shadow_model" field.
+func ShadowModelNEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldShadowModel, v))
+}
+
+// ShadowModelIn applies the In predicate on the "shadow_model" field.
+func ShadowModelIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldShadowModel, vs...))
+}
+
+// ShadowModelNotIn applies the NotIn predicate on the "shadow_model" field.
+func ShadowModelNotIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldShadowModel, vs...))
+}
+
+// ShadowModelGT applies the GT predicate on the "shadow_model" field.
+func ShadowModelGT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldShadowModel, v))
+}
+
+// ShadowModelGTE applies the GTE predicate on the "shadow_model" field.
+func ShadowModelGTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldShadowModel, v))
+}
+
+// ShadowModelLT applies the LT predicate on the "shadow_model" field.
+func ShadowModelLT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldShadowModel, v))
+}
+
+// ShadowModelLTE applies the LTE predicate on the "shadow_model" field.
+func ShadowModelLTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldShadowModel, v))
+}
+
+// ShadowModelContains applies the Contains predicate on the "shadow_model" field.
+func ShadowModelContains(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContains(FieldShadowModel, v))
+}
+
+// ShadowModelHasPrefix applies the HasPrefix predicate on the "shadow_model" field.
+func ShadowModelHasPrefix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasPrefix(FieldShadowModel, v))
+}
+
+// ShadowModelHasSuffix applies the HasSuffix predicate on the "shadow_model" field.
+func ShadowModelHasSuffix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasSuffix(FieldShadowModel, v))
+}
+
+// ShadowModelEqualFold applies the EqualFold predicate on the "shadow_model" field.
+func ShadowModelEqualFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEqualFold(FieldShadowModel, v))
+}
+
+// ShadowModelContainsFold applies the ContainsFold predicate on the "shadow_model" field.
+func ShadowModelContainsFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContainsFold(FieldShadowModel, v))
+}
+
+// PrimaryContentEQ applies the EQ predicate on the "primary_content" field.
+func PrimaryContentEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryContent, v))
+}
+
+// PrimaryContentNEQ applies the NEQ predicate on the "primary_content" field.
+func PrimaryContentNEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldPrimaryContent, v))
+}
+
+// PrimaryContentIn applies the In predicate on the "primary_content" field.
+func PrimaryContentIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldPrimaryContent, vs...))
+}
+
+// PrimaryContentNotIn applies the NotIn predicate on the "primary_content" field.
+func PrimaryContentNotIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldPrimaryContent, vs...))
+}
+
+// PrimaryContentGT applies the GT predicate on the "primary_content" field.
+func PrimaryContentGT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldPrimaryContent, v))
+}
+
+// PrimaryContentGTE applies the GTE predicate on the "primary_content" field.
+func PrimaryContentGTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldPrimaryContent, v))
+}
+
+// PrimaryContentLT applies the LT predicate on the "primary_content" field.
+func PrimaryContentLT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldPrimaryContent, v))
+}
+
+// PrimaryContentLTE applies the LTE predicate on the "primary_content" field.
+func PrimaryContentLTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldPrimaryContent, v))
+}
+
+// PrimaryContentContains applies the Contains predicate on the "primary_content" field.
+func PrimaryContentContains(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContains(FieldPrimaryContent, v))
+}
+
+// PrimaryContentHasPrefix applies the HasPrefix predicate on the "primary_content" field.
+func PrimaryContentHasPrefix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasPrefix(FieldPrimaryContent, v))
+}
+
+// PrimaryContentHasSuffix applies the HasSuffix predicate on the "primary_content" field.
+func PrimaryContentHasSuffix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasSuffix(FieldPrimaryContent, v))
+}
+
+// PrimaryContentEqualFold applies the EqualFold predicate on the "primary_content" field.
+func PrimaryContentEqualFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEqualFold(FieldPrimaryContent, v))
+}
+
+// PrimaryContentContainsFold applies the ContainsFold predicate on the "primary_content" field.
+func PrimaryContentContainsFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContainsFold(FieldPrimaryContent, v))
+}
+
+// ShadowContentEQ applies the EQ predicate on the "shadow_content" field.
+func ShadowContentEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowContent, v))
+}
+
+// ShadowContentNEQ applies the NEQ predicate on the "shadow_content" field.
+func ShadowContentNEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldShadowContent, v))
+}
+
+// ShadowContentIn applies the In predicate on the "shadow_content" field.
+func ShadowContentIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldShadowContent, vs...))
+}
+
+// ShadowContentNotIn applies the NotIn predicate on the "shadow_content" field.
+func ShadowContentNotIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldShadowContent, vs...))
+}
+
+// ShadowContentGT applies the GT predicate on the "shadow_content" field.
+func ShadowContentGT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldShadowContent, v))
+}
+
+// ShadowContentGTE applies the GTE predicate on the "shadow_content" field.
+func ShadowContentGTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldShadowContent, v))
+}
+
+// ShadowContentLT applies the LT predicate on the "shadow_content" field.
+func ShadowContentLT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldShadowContent, v))
+}
+
+// ShadowContentLTE applies the LTE predicate on the "shadow_content" field.
+func ShadowContentLTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldShadowContent, v))
+}
+
+// ShadowContentContains applies the Contains predicate on the "shadow_content" field.
+func ShadowContentContains(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContains(FieldShadowContent, v))
+}
+
+// ShadowContentHasPrefix applies the HasPrefix predicate on the "shadow_content" field.
+func ShadowContentHasPrefix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasPrefix(FieldShadowContent, v))
+}
+
+// ShadowContentHasSuffix applies the HasSuffix predicate on the "shadow_content" field.
+func ShadowContentHasSuffix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasSuffix(FieldShadowContent, v))
+}
+
+// ShadowContentIsNil applies the IsNil predicate on the "shadow_content" field.
+func ShadowContentIsNil() predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIsNull(FieldShadowContent))
+}
+
+// ShadowContentNotNil applies the NotNil predicate on the "shadow_content" field.
+func ShadowContentNotNil() predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotNull(FieldShadowContent))
+}
+
+// ShadowContentEqualFold applies the EqualFold predicate on the "shadow_content" field.
+func ShadowContentEqualFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEqualFold(FieldShadowContent, v))
+}
+
+// ShadowContentContainsFold applies the ContainsFold predicate on the "shadow_content" field.
+func ShadowContentContainsFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContainsFold(FieldShadowContent, v))
+}
+
+// ShadowErrorEQ applies the EQ predicate on the "shadow_error" field.
+func ShadowErrorEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowError, v))
+}
+
+// ShadowErrorNEQ applies the NEQ predicate on the "shadow_error" field.
+func ShadowErrorNEQ(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldShadowError, v))
+}
+
+// ShadowErrorIn applies the In predicate on the "shadow_error" field.
+func ShadowErrorIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldShadowError, vs...))
+}
+
+// ShadowErrorNotIn applies the NotIn predicate on the "shadow_error" field.
+func ShadowErrorNotIn(vs ...string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldShadowError, vs...))
+}
+
+// ShadowErrorGT applies the GT predicate on the "shadow_error" field.
+func ShadowErrorGT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldShadowError, v))
+}
+
+// ShadowErrorGTE applies the GTE predicate on the "shadow_error" field.
+func ShadowErrorGTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldShadowError, v))
+}
+
+// ShadowErrorLT applies the LT predicate on the "shadow_error" field.
+func ShadowErrorLT(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldShadowError, v))
+}
+
+// ShadowErrorLTE applies the LTE predicate on the "shadow_error" field.
+func ShadowErrorLTE(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldShadowError, v))
+}
+
+// ShadowErrorContains applies the Contains predicate on the "shadow_error" field.
+func ShadowErrorContains(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContains(FieldShadowError, v))
+}
+
+// ShadowErrorHasPrefix applies the HasPrefix predicate on the "shadow_error" field.
+func ShadowErrorHasPrefix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasPrefix(FieldShadowError, v))
+}
+
+// ShadowErrorHasSuffix applies the HasSuffix predicate on the "shadow_error" field.
+func ShadowErrorHasSuffix(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldHasSuffix(FieldShadowError, v))
+}
+
+// ShadowErrorIsNil applies the IsNil predicate on the "shadow_error" field.
+func ShadowErrorIsNil() predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIsNull(FieldShadowError))
+}
+
+// ShadowErrorNotNil applies the NotNil predicate on the "shadow_error" field.
+func ShadowErrorNotNil() predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotNull(FieldShadowError))
+}
+
+// ShadowErrorEqualFold applies the EqualFold predicate on the "shadow_error" field.
+func ShadowErrorEqualFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEqualFold(FieldShadowError, v))
+}
+
+// ShadowErrorContainsFold applies the ContainsFold predicate on the "shadow_error" field.
+func ShadowErrorContainsFold(v string) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldContainsFold(FieldShadowError, v))
+}
+
+// ShadowTokensEQ applies the EQ predicate on the "shadow_tokens" field.
+func ShadowTokensEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowTokens, v))
+}
+
+// ShadowTokensNEQ applies the NEQ predicate on the "shadow_tokens" field.
+func ShadowTokensNEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldShadowTokens, v))
+}
+
+// ShadowTokensIn applies the In predicate on the "shadow_tokens" field.
+func ShadowTokensIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldShadowTokens, vs...))
+}
+
+// ShadowTokensNotIn applies the NotIn predicate on the "shadow_tokens" field.
+func ShadowTokensNotIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldShadowTokens, vs...))
+}
+
+// ShadowTokensGT applies the GT predicate on the "shadow_tokens" field.
+func ShadowTokensGT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldShadowTokens, v))
+}
+
+// ShadowTokensGTE applies the GTE predicate on the "shadow_tokens" field.
+func ShadowTokensGTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldShadowTokens, v))
+}
+
+// ShadowTokensLT applies the LT predicate on the "shadow_tokens" field.
+func ShadowTokensLT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldShadowTokens, v))
+}
+
+// ShadowTokensLTE applies the LTE predicate on the "shadow_tokens" field.
+func ShadowTokensLTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldShadowTokens, v))
+}
+
+// PrimaryTopicsEQ applies the EQ predicate on the "primary_topics" field.
+func PrimaryTopicsEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldPrimaryTopics, v))
+}
+
+// PrimaryTopicsNEQ applies the NEQ predicate on the "primary_topics" field.
+func PrimaryTopicsNEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldPrimaryTopics, v))
+}
+
+// PrimaryTopicsIn applies the In predicate on the "primary_topics" field.
+func PrimaryTopicsIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldPrimaryTopics, vs...))
+}
+
+// PrimaryTopicsNotIn applies the NotIn predicate on the "primary_topics" field.
+func PrimaryTopicsNotIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldPrimaryTopics, vs...))
+}
+
+// PrimaryTopicsGT applies the GT predicate on the "primary_topics" field.
+func PrimaryTopicsGT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldPrimaryTopics, v))
+}
+
+// PrimaryTopicsGTE applies the GTE predicate on the "primary_topics" field.
+func PrimaryTopicsGTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldPrimaryTopics, v))
+}
+
+// PrimaryTopicsLT applies the LT predicate on the "primary_topics" field.
+func PrimaryTopicsLT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldPrimaryTopics, v))
+}
+
+// PrimaryTopicsLTE applies the LTE predicate on the "primary_topics" field.
+func PrimaryTopicsLTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldPrimaryTopics, v))
+}
+
+// ShadowTopicsEQ applies the EQ predicate on the "shadow_topics" field.
+func ShadowTopicsEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldShadowTopics, v))
+}
+
+// ShadowTopicsNEQ applies the NEQ predicate on the "shadow_topics" field.
+func ShadowTopicsNEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldShadowTopics, v))
+}
+
+// ShadowTopicsIn applies the In predicate on the "shadow_topics" field.
+func ShadowTopicsIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldShadowTopics, vs...))
+}
+
+// ShadowTopicsNotIn applies the NotIn predicate on the "shadow_topics" field.
+func ShadowTopicsNotIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldShadowTopics, vs...))
+}
+
+// ShadowTopicsGT applies the GT predicate on the "shadow_topics" field.
+func ShadowTopicsGT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldShadowTopics, v))
+}
+
+// ShadowTopicsGTE applies the GTE predicate on the "shadow_topics" field.
+func ShadowTopicsGTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldShadowTopics, v))
+}
+
+// ShadowTopicsLT applies the LT predicate on the "shadow_topics" field.
+func ShadowTopicsLT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldShadowTopics, v))
+}
+
+// ShadowTopicsLTE applies the LTE predicate on the "shadow_topics" field.
+func ShadowTopicsLTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldShadowTopics, v))
+}
+
+// MatchedTopicsEQ applies the EQ predicate on the "matched_topics" field.
+func MatchedTopicsEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldMatchedTopics, v))
+}
+
+// MatchedTopicsNEQ applies the NEQ predicate on the "matched_topics" field.
+func MatchedTopicsNEQ(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldMatchedTopics, v))
+}
+
+// MatchedTopicsIn applies the In predicate on the "matched_topics" field.
+func MatchedTopicsIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldMatchedTopics, vs...))
+}
+
+// MatchedTopicsNotIn applies the NotIn predicate on the "matched_topics" field.
+func MatchedTopicsNotIn(vs ...int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldMatchedTopics, vs...))
+}
+
+// MatchedTopicsGT applies the GT predicate on the "matched_topics" field.
+func MatchedTopicsGT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldMatchedTopics, v))
+}
+
+// MatchedTopicsGTE applies the GTE predicate on the "matched_topics" field.
+func MatchedTopicsGTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldMatchedTopics, v))
+}
+
+// MatchedTopicsLT applies the LT predicate on the "matched_topics" field.
+func MatchedTopicsLT(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldMatchedTopics, v))
+}
+
+// MatchedTopicsLTE applies the LTE predicate on the "matched_topics" field.
+func MatchedTopicsLTE(v int) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldMatchedTopics, v))
+}
+
+// MessageOverlapEQ applies the EQ predicate on the "message_overlap" field.
+func MessageOverlapEQ(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldEQ(FieldMessageOverlap, v))
+}
+
+// MessageOverlapNEQ applies the NEQ predicate on the "message_overlap" field.
+func MessageOverlapNEQ(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNEQ(FieldMessageOverlap, v))
+}
+
+// MessageOverlapIn applies the In predicate on the "message_overlap" field.
+func MessageOverlapIn(vs ...float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldIn(FieldMessageOverlap, vs...))
+}
+
+// MessageOverlapNotIn applies the NotIn predicate on the "message_overlap" field.
+func MessageOverlapNotIn(vs ...float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldNotIn(FieldMessageOverlap, vs...))
+}
+
+// MessageOverlapGT applies the GT predicate on the "message_overlap" field.
+func MessageOverlapGT(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGT(FieldMessageOverlap, v))
+}
+
+// MessageOverlapGTE applies the GTE predicate on the "message_overlap" field.
+func MessageOverlapGTE(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldGTE(FieldMessageOverlap, v))
+}
+
+// MessageOverlapLT applies the LT predicate on the "message_overlap" field.
+func MessageOverlapLT(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLT(FieldMessageOverlap, v))
+}
+
+// MessageOverlapLTE applies the LTE predicate on the "message_overlap" field.
+func MessageOverlapLTE(v float64) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.FieldLTE(FieldMessageOverlap, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShadowRun) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShadowRun) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShadowRun) predicate.ShadowRun {
+	return predicate.ShadowRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+)
+
+// ShadowRunCreate is the builder for creating a ShadowRun entity.
+type ShadowRunCreate struct {
+	config
+	mutation *ShadowRunMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ShadowRunCreate) SetCreateTime(v time.Time) *ShadowRunCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableCreateTime(v *time.Time) *ShadowRunCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ShadowRunCreate) SetUpdateTime(v time.Time) *ShadowRunCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableUpdateTime(v *time.Time) *ShadowRunCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ShadowRunCreate) SetChatID(v int64) *ShadowRunCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *ShadowRunCreate) SetStartTime(v time.Time) *ShadowRunCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *ShadowRunCreate) SetEndTime(v time.Time) *ShadowRunCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetPrimaryModel sets the "primary_model" field.
+func (_c *ShadowRunCreate) SetPrimaryModel(v string) *ShadowRunCreate {
+	_c.mutation.SetPrimaryModel(v)
+	return _c
+}
+
+// SetShadowModel sets the "shadow_model" field.
+func (_c *ShadowRunCreate) SetShadowModel(v string) *ShadowRunCreate {
+	_c.mutation.SetShadowModel(v)
+	return _c
+}
+
+// SetPrimaryContent sets the "primary_content" field.
+func (_c *ShadowRunCreate) SetPrimaryContent(v string) *ShadowRunCreate {
+	_c.mutation.SetPrimaryContent(v)
+	return _c
+}
+
+// SetShadowContent sets the "shadow_content" field.
+func (_c *ShadowRunCreate) SetShadowContent(v string) *ShadowRunCreate {
+	_c.mutation.SetShadowContent(v)
+	return _c
+}
+
+// SetNillableShadowContent sets the "shadow_content" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableShadowContent(v *string) *ShadowRunCreate {
+	if v != nil {
+		_c.SetShadowContent(*v)
+	}
+	return _c
+}
+
+// SetShadowError sets the "shadow_error" field.
+func (_c *ShadowRunCreate) SetShadowError(v string) *ShadowRunCreate {
+	_c.mutation.SetShadowError(v)
+	return _c
+}
+
+// SetNillableShadowError sets the "shadow_error" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableShadowError(v *string) *ShadowRunCreate {
+	if v != nil {
+		_c.SetShadowError(*v)
+	}
+	return _c
+}
+
+// SetShadowTokens sets the "shadow_tokens" field.
+func (_c *ShadowRunCreate) SetShadowTokens(v int) *ShadowRunCreate {
+	_c.mutation.SetShadowTokens(v)
+	return _c
+}
+
+// SetNillableShadowTokens sets the "shadow_tokens" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableShadowTokens(v *int) *ShadowRunCreate {
+	if v != nil {
+		_c.SetShadowTokens(*v)
+	}
+	return _c
+}
+
+// SetPrimaryTopics sets the "primary_topics" field.
+func (_c *ShadowRunCreate) SetPrimaryTopics(v int) *ShadowRunCreate {
+	_c.mutation.SetPrimaryTopics(v)
+	return _c
+}
+
+// SetNillablePrimaryTopics sets the "primary_topics" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillablePrimaryTopics(v *int) *ShadowRunCreate {
+	if v != nil {
+		_c.SetPrimaryTopics(*v)
+	}
+	return _c
+}
+
+// SetShadowTopics sets the "shadow_topics" field.
+func (_c *ShadowRunCreate) SetShadowTopics(v int) *ShadowRunCreate {
+	_c.mutation.SetShadowTopics(v)
+	return _c
+}
+
+// SetNillableShadowTopics sets the "shadow_topics" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableShadowTopics(v *int) *ShadowRunCreate {
+	if v != nil {
+		_c.SetShadowTopics(*v)
+	}
+	return _c
+}
+
+// SetMatchedTopics sets the "matched_topics" field.
+func (_c *ShadowRunCreate) SetMatchedTopics(v int) *ShadowRunCreate {
+	_c.mutation.SetMatchedTopics(v)
+	return _c
+}
+
+// SetNillableMatchedTopics sets the "matched_topics" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableMatchedTopics(v *int) *ShadowRunCreate {
+	if v != nil {
+		_c.SetMatchedTopics(*v)
+	}
+	return _c
+}
+
+// SetMessageOverlap sets the "message_overlap" field.
+func (_c *ShadowRunCreate) SetMessageOverlap(v float64) *ShadowRunCreate {
+	_c.mutation.SetMessageOverlap(v)
+	return _c
+}
+
+// SetNillableMessageOverlap sets the "message_overlap" field if the given value is not nil.
+func (_c *ShadowRunCreate) SetNillableMessageOverlap(v *float64) *ShadowRunCreate {
+	if v != nil {
+		_c.SetMessageOverlap(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ShadowRunMutation object of the builder.
+func (_c *ShadowRunCreate) Mutation() *ShadowRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ShadowRun in the database.
+func (_c *ShadowRunCreate) Save(ctx context.Context) (*ShadowRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShadowRunCreate) SaveX(ctx context.Context) *ShadowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShadowRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShadowRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShadowRunCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := shadowrun.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := shadowrun.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ShadowTokens(); !ok {
+		v := shadowrun.DefaultShadowTokens
+		_c.mutation.SetShadowTokens(v)
+	}
+	if _, ok := _c.mutation.PrimaryTopics(); !ok {
+		v := shadowrun.DefaultPrimaryTopics
+		_c.mutation.SetPrimaryTopics(v)
+	}
+	if _, ok := _c.mutation.ShadowTopics(); !ok {
+		v := shadowrun.DefaultShadowTopics
+		_c.mutation.SetShadowTopics(v)
+	}
+	if _, ok := _c.mutation.MatchedTopics(); !ok {
+		v := shadowrun.DefaultMatchedTopics
+		_c.mutation.SetMatchedTopics(v)
+	}
+	if _, ok := _c.mutation.MessageOverlap(); !ok {
+		v := shadowrun.DefaultMessageOverlap
+		_c.mutation.SetMessageOverlap(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShadowRunCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ShadowRun.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ShadowRun.update_time"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ShadowRun.chat_id"`)}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "ShadowRun.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "ShadowRun.end_time"`)}
+	}
+	if _, ok := _c.mutation.PrimaryModel(); !ok {
+		return &ValidationError{Name: "primary_model", err: errors.New(`ent: missing required field "ShadowRun.primary_model"`)}
+	}
+	if _, ok := _c.mutation.ShadowModel(); !ok {
+		return &ValidationError{Name: "shadow_model", err: errors.New(`ent: missing required field "ShadowRun.shadow_model"`)}
+	}
+	if _, ok := _c.mutation.PrimaryContent(); !ok {
+		return &ValidationError{Name: "primary_content", err: errors.New(`ent: missing required field "ShadowRun.primary_content"`)}
+	}
+	if _, ok := _c.mutation.ShadowTokens(); !ok {
+		return &ValidationError{Name: "shadow_tokens", err: errors.New(`ent: missing required field "ShadowRun.shadow_tokens"`)}
+	}
+	if _, ok := _c.mutation.PrimaryTopics(); !ok {
+		return &ValidationError{Name: "primary_topics", err: errors.New(`ent: missing required field "ShadowRun.primary_topics"`)}
+	}
+	if _, ok := _c.mutation.ShadowTopics(); !ok {
+		return &ValidationError{Name: "shadow_topics", err: errors.New(`ent: missing required field "ShadowRun.shadow_topics"`)}
+	}
+	if _, ok := _c.mutation.MatchedTopics(); !ok {
+		return &ValidationError{Name: "matched_topics", err: errors.New(`ent: missing required field "ShadowRun.matched_topics"`)}
+	}
+	if _, ok := _c.mutation.MessageOverlap(); !ok {
+		return &ValidationError{Name: "message_overlap", err: errors.New(`ent: missing required field "ShadowRun.message_overlap"`)}
+	}
+	return nil
+}
+
+func (_c *ShadowRunCreate) sqlSave(ctx context.Context) (*ShadowRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShadowRunCreate) createSpec() (*ShadowRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShadowRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(shadowrun.Table, sqlgraph.NewFieldSpec(shadowrun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(shadowrun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(shadowrun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(shadowrun.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(shadowrun.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(shadowrun.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.PrimaryModel(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryModel, field.TypeString, value)
+		_node.PrimaryModel = value
+	}
+	if value, ok := _c.mutation.ShadowModel(); ok {
+		_spec.SetField(shadowrun.FieldShadowModel, field.TypeString, value)
+		_node.ShadowModel = value
+	}
+	if value, ok := _c.mutation.PrimaryContent(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryContent, field.TypeString, value)
+		_node.PrimaryContent = value
+	}
+	if value, ok := _c.mutation.ShadowContent(); ok {
+		_spec.SetField(shadowrun.FieldShadowContent, field.TypeString, value)
+		_node.ShadowContent = value
+	}
+	if value, ok := _c.mutation.ShadowError(); ok {
+		_spec.SetField(shadowrun.FieldShadowError, field.TypeString, value)
+		_node.ShadowError = value
+	}
+	if value, ok := _c.mutation.ShadowTokens(); ok {
+		_spec.SetField(shadowrun.FieldShadowTokens, field.TypeInt, value)
+		_node.ShadowTokens = value
+	}
+	if value, ok := _c.mutation.PrimaryTopics(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryTopics, field.TypeInt, value)
+		_node.PrimaryTopics = value
+	}
+	if value, ok := _c.mutation.ShadowTopics(); ok {
+		_spec.SetField(shadowrun.FieldShadowTopics, field.TypeInt, value)
+		_node.ShadowTopics = value
+	}
+	if value, ok := _c.mutation.MatchedTopics(); ok {
+		_spec.SetField(shadowrun.FieldMatchedTopics, field.TypeInt, value)
+		_node.MatchedTopics = value
+	}
+	if value, ok := _c.mutation.MessageOverlap(); ok {
+		_spec.SetField(shadowrun.FieldMessageOverlap, field.TypeFloat64, value)
+		_node.MessageOverlap = value
+	}
+	return _node, _spec
+}
+
+// ShadowRunCreateBulk is the builder for creating many ShadowRun entities in bulk.
+type ShadowRunCreateBulk struct {
+	config
+	err      error
+	builders []*ShadowRunCreate
+}
+
+// Save creates the ShadowRun entities in the database.
+func (_c *ShadowRunCreateBulk) Save(ctx context.Context) ([]*ShadowRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShadowRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShadowRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShadowRunCreateBulk) SaveX(ctx context.Context) []*ShadowRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShadowRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShadowRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+)
+
+// ShadowRunUpdate is the builder for updating ShadowRun entities.
+type ShadowRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShadowRunMutation
+}
+
+// Where appends a list predicates to the ShadowRunUpdate builder.
+func (_u *ShadowRunUpdate) Where(ps ...predicate.ShadowRun) *ShadowRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ShadowRunUpdate) SetUpdateTime(v time.Time) *ShadowRunUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ShadowRunUpdate) SetChatID(v int64) *ShadowRunUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableChatID(v *int64) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *ShadowRunUpdate) AddChatID(v int64) *ShadowRunUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *ShadowRunUpdate) SetStartTime(v time.Time) *ShadowRunUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableStartTime(v *time.Time) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ShadowRunUpdate) SetEndTime(v time.Time) *ShadowRunUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableEndTime(v *time.Time) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetPrimaryModel sets the "primary_model" field.
+func (_u *ShadowRunUpdate) SetPrimaryModel(v string) *ShadowRunUpdate {
+	_u.mutation.SetPrimaryModel(v)
+	return _u
+}
+
+// SetNillablePrimaryModel sets the "primary_model" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillablePrimaryModel(v *string) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetPrimaryModel(*v)
+	}
+	return _u
+}
+
+// SetShadowModel sets the "shadow_model" field.
+func (_u *ShadowRunUpdate) SetShadowModel(v string) *ShadowRunUpdate {
+	_u.mutation.SetShadowModel(v)
+	return _u
+}
+
+// SetNillableShadowModel sets the "shadow_model" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableShadowModel(v *string) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetShadowModel(*v)
+	}
+	return _u
+}
+
+// SetPrimaryContent sets the "primary_content" field.
+func (_u *ShadowRunUpdate) SetPrimaryContent(v string) *ShadowRunUpdate {
+	_u.mutation.SetPrimaryContent(v)
+	return _u
+}
+
+// SetNillablePrimaryContent sets the "primary_content" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillablePrimaryContent(v *string) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetPrimaryContent(*v)
+	}
+	return _u
+}
+
+// SetShadowContent sets the "shadow_content" field.
+func (_u *ShadowRunUpdate) SetShadowContent(v string) *ShadowRunUpdate {
+	_u.mutation.SetShadowContent(v)
+	return _u
+}
+
+// SetNillableShadowContent sets the "shadow_content" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableShadowContent(v *string) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetShadowContent(*v)
+	}
+	return _u
+}
+
+// ClearShadowContent clears the value of the "shadow_content" field.
+func (_u *ShadowRunUpdate) ClearShadowContent() *ShadowRunUpdate {
+	_u.mutation.ClearShadowContent()
+	return _u
+}
+
+// SetShadowError sets the "shadow_error" field.
+func (_u *ShadowRunUpdate) SetShadowError(v string) *ShadowRunUpdate {
+	_u.mutation.SetShadowError(v)
+	return _u
+}
+
+// SetNillableShadowError sets the "shadow_error" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableShadowError(v *string) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetShadowError(*v)
+	}
+	return _u
+}
+
+// ClearShadowError clears the value of the "shadow_error" field.
+func (_u *ShadowRunUpdate) ClearShadowError() *ShadowRunUpdate {
+	_u.mutation.ClearShadowError()
+	return _u
+}
+
+// SetShadowTokens sets the "shadow_tokens" field.
+func (_u *ShadowRunUpdate) SetShadowTokens(v int) *ShadowRunUpdate {
+	_u.mutation.ResetShadowTokens()
+	_u.mutation.SetShadowTokens(v)
+	return _u
+}
+
+// SetNillableShadowTokens sets the "shadow_tokens" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableShadowTokens(v *int) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetShadowTokens(*v)
+	}
+	return _u
+}
+
+// AddShadowTokens adds value to the "shadow_tokens" field.
+func (_u *ShadowRunUpdate) AddShadowTokens(v int) *ShadowRunUpdate {
+	_u.mutation.AddShadowTokens(v)
+	return _u
+}
+
+// SetPrimaryTopics sets the "primary_topics" field.
+func (_u *ShadowRunUpdate) SetPrimaryTopics(v int) *ShadowRunUpdate {
+	_u.mutation.ResetPrimaryTopics()
+	_u.mutation.SetPrimaryTopics(v)
+	return _u
+}
+
+// SetNillablePrimaryTopics sets the "primary_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillablePrimaryTopics(v *int) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetPrimaryTopics(*v)
+	}
+	return _u
+}
+
+// AddPrimaryTopics adds value to the "primary_topics" field.
+func (_u *ShadowRunUpdate) AddPrimaryTopics(v int) *ShadowRunUpdate {
+	_u.mutation.AddPrimaryTopics(v)
+	return _u
+}
+
+// SetShadowTopics sets the "shadow_topics" field.
+func (_u *ShadowRunUpdate) SetShadowTopics(v int) *ShadowRunUpdate {
+	_u.mutation.ResetShadowTopics()
+	_u.mutation.SetShadowTopics(v)
+	return _u
+}
+
+// SetNillableShadowTopics sets the "shadow_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableShadowTopics(v *int) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetShadowTopics(*v)
+	}
+	return _u
+}
+
+// AddShadowTopics adds value to the "shadow_topics" field.
+func (_u *ShadowRunUpdate) AddShadowTopics(v int) *ShadowRunUpdate {
+	_u.mutation.AddShadowTopics(v)
+	return _u
+}
+
+// SetMatchedTopics sets the "matched_topics" field.
+func (_u *ShadowRunUpdate) SetMatchedTopics(v int) *ShadowRunUpdate {
+	_u.mutation.ResetMatchedTopics()
+	_u.mutation.SetMatchedTopics(v)
+	return _u
+}
+
+// SetNillableMatchedTopics sets the "matched_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableMatchedTopics(v *int) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetMatchedTopics(*v)
+	}
+	return _u
+}
+
+// AddMatchedTopics adds value to the "matched_topics" field.
+func (_u *ShadowRunUpdate) AddMatchedTopics(v int) *ShadowRunUpdate {
+	_u.mutation.AddMatchedTopics(v)
+	return _u
+}
+
+// SetMessageOverlap sets the "message_overlap" field.
+func (_u *ShadowRunUpdate) SetMessageOverlap(v float64) *ShadowRunUpdate {
+	_u.mutation.ResetMessageOverlap()
+	_u.mutation.SetMessageOverlap(v)
+	return _u
+}
+
+// SetNillableMessageOverlap sets the "message_overlap" field if the given value is not nil.
+func (_u *ShadowRunUpdate) SetNillableMessageOverlap(v *float64) *ShadowRunUpdate {
+	if v != nil {
+		_u.SetMessageOverlap(*v)
+	}
+	return _u
+}
+
+// AddMessageOverlap adds value to the "message_overlap" field.
+func (_u *ShadowRunUpdate) AddMessageOverlap(v float64) *ShadowRunUpdate {
+	_u.mutation.AddMessageOverlap(v)
+	return _u
+}
+
+// Mutation returns the ShadowRunMutation object of the builder.
+func (_u *ShadowRunUpdate) Mutation() *ShadowRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShadowRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShadowRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShadowRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShadowRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ShadowRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := shadowrun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *ShadowRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(shadowrun.Table, shadowrun.Columns, sqlgraph.NewFieldSpec(shadowrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(shadowrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(shadowrun.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(shadowrun.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(shadowrun.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(shadowrun.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PrimaryModel(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShadowModel(); ok {
+		_spec.SetField(shadowrun.FieldShadowModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrimaryContent(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShadowContent(); ok {
+		_spec.SetField(shadowrun.FieldShadowContent, field.TypeString, value)
+	}
+	if _u.mutation.ShadowContentCleared() {
+		_spec.ClearField(shadowrun.FieldShadowContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShadowError(); ok {
+		_spec.SetField(shadowrun.FieldShadowError, field.TypeString, value)
+	}
+	if _u.mutation.ShadowErrorCleared() {
+		_spec.ClearField(shadowrun.FieldShadowError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShadowTokens(); ok {
+		_spec.SetField(shadowrun.FieldShadowTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShadowTokens(); ok {
+		_spec.AddField(shadowrun.FieldShadowTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PrimaryTopics(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrimaryTopics(); ok {
+		_spec.AddField(shadowrun.FieldPrimaryTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShadowTopics(); ok {
+		_spec.SetField(shadowrun.FieldShadowTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShadowTopics(); ok {
+		_spec.AddField(shadowrun.FieldShadowTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MatchedTopics(); ok {
+		_spec.SetField(shadowrun.FieldMatchedTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchedTopics(); ok {
+		_spec.AddField(shadowrun.FieldMatchedTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MessageOverlap(); ok {
+		_spec.SetField(shadowrun.FieldMessageOverlap, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageOverlap(); ok {
+		_spec.AddField(shadowrun.FieldMessageOverlap, field.TypeFloat64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shadowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShadowRunUpdateOne is the builder for updating a single ShadowRun entity.
+type ShadowRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShadowRunMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ShadowRunUpdateOne) SetUpdateTime(v time.Time) *ShadowRunUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ShadowRunUpdateOne) SetChatID(v int64) *ShadowRunUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableChatID(v *int64) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *ShadowRunUpdateOne) AddChatID(v int64) *ShadowRunUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *ShadowRunUpdateOne) SetStartTime(v time.Time) *ShadowRunUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableStartTime(v *time.Time) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *ShadowRunUpdateOne) SetEndTime(v time.Time) *ShadowRunUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableEndTime(v *time.Time) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetPrimaryModel sets the "primary_model" field.
+func (_u *ShadowRunUpdateOne) SetPrimaryModel(v string) *ShadowRunUpdateOne {
+	_u.mutation.SetPrimaryModel(v)
+	return _u
+}
+
+// SetNillablePrimaryModel sets the "primary_model" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillablePrimaryModel(v *string) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetPrimaryModel(*v)
+	}
+	return _u
+}
+
+// SetShadowModel sets the "shadow_model" field.
+func (_u *ShadowRunUpdateOne) SetShadowModel(v string) *ShadowRunUpdateOne {
+	_u.mutation.SetShadowModel(v)
+	return _u
+}
+
+// SetNillableShadowModel sets the "shadow_model" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableShadowModel(v *string) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetShadowModel(*v)
+	}
+	return _u
+}
+
+// SetPrimaryContent sets the "primary_content" field.
+func (_u *ShadowRunUpdateOne) SetPrimaryContent(v string) *ShadowRunUpdateOne {
+	_u.mutation.SetPrimaryContent(v)
+	return _u
+}
+
+// SetNillablePrimaryContent sets the "primary_content" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillablePrimaryContent(v *string) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetPrimaryContent(*v)
+	}
+	return _u
+}
+
+// SetShadowContent sets the "shadow_content" field.
+func (_u *ShadowRunUpdateOne) SetShadowContent(v string) *ShadowRunUpdateOne {
+	_u.mutation.SetShadowContent(v)
+	return _u
+}
+
+// SetNillableShadowContent sets the "shadow_content" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableShadowContent(v *string) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetShadowContent(*v)
+	}
+	return _u
+}
+
+// ClearShadowContent clears the value of the "shadow_content" field.
+func (_u *ShadowRunUpdateOne) ClearShadowContent() *ShadowRunUpdateOne {
+	_u.mutation.ClearShadowContent()
+	return _u
+}
+
+// SetShadowError sets the "shadow_error" field.
+func (_u *ShadowRunUpdateOne) SetShadowError(v string) *ShadowRunUpdateOne {
+	_u.mutation.SetShadowError(v)
+	return _u
+}
+
+// SetNillableShadowError sets the "shadow_error" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableShadowError(v *string) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetShadowError(*v)
+	}
+	return _u
+}
+
+// ClearShadowError clears the value of the "shadow_error" field.
+func (_u *ShadowRunUpdateOne) ClearShadowError() *ShadowRunUpdateOne {
+	_u.mutation.ClearShadowError()
+	return _u
+}
+
+// SetShadowTokens sets the "shadow_tokens" field.
+func (_u *ShadowRunUpdateOne) SetShadowTokens(v int) *ShadowRunUpdateOne {
+	_u.mutation.ResetShadowTokens()
+	_u.mutation.SetShadowTokens(v)
+	return _u
+}
+
+// SetNillableShadowTokens sets the "shadow_tokens" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableShadowTokens(v *int) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetShadowTokens(*v)
+	}
+	return _u
+}
+
+// AddShadowTokens adds value to the "shadow_tokens" field.
+func (_u *ShadowRunUpdateOne) AddShadowTokens(v int) *ShadowRunUpdateOne {
+	_u.mutation.AddShadowTokens(v)
+	return _u
+}
+
+// SetPrimaryTopics sets the "primary_topics" field.
+func (_u *ShadowRunUpdateOne) SetPrimaryTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.ResetPrimaryTopics()
+	_u.mutation.SetPrimaryTopics(v)
+	return _u
+}
+
+// SetNillablePrimaryTopics sets the "primary_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillablePrimaryTopics(v *int) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetPrimaryTopics(*v)
+	}
+	return _u
+}
+
+// AddPrimaryTopics adds value to the "primary_topics" field.
+func (_u *ShadowRunUpdateOne) AddPrimaryTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.AddPrimaryTopics(v)
+	return _u
+}
+
+// SetShadowTopics sets the "shadow_topics" field.
+func (_u *ShadowRunUpdateOne) SetShadowTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.ResetShadowTopics()
+	_u.mutation.SetShadowTopics(v)
+	return _u
+}
+
+// SetNillableShadowTopics sets the "shadow_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableShadowTopics(v *int) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetShadowTopics(*v)
+	}
+	return _u
+}
+
+// AddShadowTopics adds value to the "shadow_topics" field.
+func (_u *ShadowRunUpdateOne) AddShadowTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.AddShadowTopics(v)
+	return _u
+}
+
+// SetMatchedTopics sets the "matched_topics" field.
+func (_u *ShadowRunUpdateOne) SetMatchedTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.ResetMatchedTopics()
+	_u.mutation.SetMatchedTopics(v)
+	return _u
+}
+
+// SetNillableMatchedTopics sets the "matched_topics" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableMatchedTopics(v *int) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetMatchedTopics(*v)
+	}
+	return _u
+}
+
+// AddMatchedTopics adds value to the "matched_topics" field.
+func (_u *ShadowRunUpdateOne) AddMatchedTopics(v int) *ShadowRunUpdateOne {
+	_u.mutation.AddMatchedTopics(v)
+	return _u
+}
+
+// SetMessageOverlap sets the "message_overlap" field.
+func (_u *ShadowRunUpdateOne) SetMessageOverlap(v float64) *ShadowRunUpdateOne {
+	_u.mutation.ResetMessageOverlap()
+	_u.mutation.SetMessageOverlap(v)
+	return _u
+}
+
+// SetNillableMessageOverlap sets the "message_overlap" field if the given value is not nil.
+func (_u *ShadowRunUpdateOne) SetNillableMessageOverlap(v *float64) *ShadowRunUpdateOne {
+	if v != nil {
+		_u.SetMessageOverlap(*v)
+	}
+	return _u
+}
+
+// AddMessageOverlap adds value to the "message_overlap" field.
+func (_u *ShadowRunUpdateOne) AddMessageOverlap(v float64) *ShadowRunUpdateOne {
+	_u.mutation.AddMessageOverlap(v)
+	return _u
+}
+
+// Mutation returns the ShadowRunMutation object of the builder.
+func (_u *ShadowRunUpdateOne) Mutation() *ShadowRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ShadowRunUpdate builder.
+func (_u *ShadowRunUpdateOne) Where(ps ...predicate.ShadowRun) *ShadowRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShadowRunUpdateOne) Select(field string, fields ...string) *ShadowRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShadowRun entity.
+func (_u *ShadowRunUpdateOne) Save(ctx context.Context) (*ShadowRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShadowRunUpdateOne) SaveX(ctx context.Context) *ShadowRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShadowRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShadowRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ShadowRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := shadowrun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *ShadowRunUpdateOne) sqlSave(ctx context.Context) (_node *ShadowRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(shadowrun.Table, shadowrun.Columns, sqlgraph.NewFieldSpec(shadowrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShadowRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shadowrun.FieldID)
+		for _, f := range fields {
+			if !shadowrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shadowrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(shadowrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(shadowrun.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(shadowrun.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(shadowrun.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(shadowrun.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PrimaryModel(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShadowModel(); ok {
+		_spec.SetField(shadowrun.FieldShadowModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrimaryContent(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShadowContent(); ok {
+		_spec.SetField(shadowrun.FieldShadowContent, field.TypeString, value)
+	}
+	if _u.mutation.ShadowContentCleared() {
+		_spec.ClearField(shadowrun.FieldShadowContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShadowError(); ok {
+		_spec.SetField(shadowrun.FieldShadowError, field.TypeString, value)
+	}
+	if _u.mutation.ShadowErrorCleared() {
+		_spec.ClearField(shadowrun.FieldShadowError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ShadowTokens(); ok {
+		_spec.SetField(shadowrun.FieldShadowTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShadowTokens(); ok {
+		_spec.AddField(shadowrun.FieldShadowTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PrimaryTopics(); ok {
+		_spec.SetField(shadowrun.FieldPrimaryTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrimaryTopics(); ok {
+		_spec.AddField(shadowrun.FieldPrimaryTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ShadowTopics(); ok {
+		_spec.SetField(shadowrun.FieldShadowTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShadowTopics(); ok {
+		_spec.AddField(shadowrun.FieldShadowTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MatchedTopics(); ok {
+		_spec.SetField(shadowrun.FieldMatchedTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchedTopics(); ok {
+		_spec.AddField(shadowrun.FieldMatchedTopics, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MessageOverlap(); ok {
+		_spec.SetField(shadowrun.FieldMessageOverlap, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageOverlap(); ok {
+		_spec.AddField(shadowrun.FieldMessageOverlap, field.TypeFloat64, value)
+	}
+	_node = &ShadowRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shadowrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
+)
+
+// SharedLinkCreate is the builder for creating a SharedLink entity.
+type SharedLinkCreate struct {
+	config
+	mutation *SharedLinkMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SharedLinkCreate) SetCreateTime(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableCreateTime(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SharedLinkCreate) SetUpdateTime(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SharedLinkCreate) SetNillableUpdateTime(v *time.Time) *SharedLinkCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *SharedLinkCreate) SetChatID(v int64) *SharedLinkCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *SharedLinkCreate) SetMessageID(v int64) *SharedLinkCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_c *SharedLinkCreate) SetSenderID(v int64) *SharedLinkCreate {
+	_c.mutation.SetSenderID(v)
+	return _c
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_c *SharedLinkCreate) SetSenderName(v string) *SharedLinkCreate {
+	_c.mutation.SetSenderName(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *SharedLinkCreate) SetURL(v string) *SharedLinkCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *SharedLinkCreate) SetSentAt(v time.Time) *SharedLinkCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// Mutation returns the SharedLinkMutation object of the builder.
+func (_c *SharedLinkCreate) Mutation() *SharedLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the SharedLink in the database.
+func (_c *SharedLinkCreate) Save(ctx context.Context) (*SharedLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SharedLinkCreate) SaveX(ctx context.Context) *SharedLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SharedLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SharedLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SharedLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := sharedlink.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := sharedlink.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SharedLinkCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SharedLink.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SharedLink.update_time"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "SharedLink.chat_id"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "SharedLink.message_id"`)}
+	}
+	if _, ok := _c.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "SharedLink.sender_id"`)}
+	}
+	if _, ok := _c.mutation.SenderName(); !ok {
+		return &ValidationError{Name: "sender_name", err: errors.New(`ent: missing required field "SharedLink.sender_name"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "SharedLink.url"`)}
+	}
+	if _, ok := _c.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "SharedLink.sent_at"`)}
+	}
+	return nil
+}
+
+func (_c *SharedLinkCreate) sqlSave(ctx context.Context) (*SharedLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SharedLinkCreate) createSpec() (*SharedLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SharedLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharedlink.Table, sqlgraph.NewFieldSpec(sharedlink.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(sharedlink.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlink.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(sharedlink.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(sharedlink.FieldMessageID, field.TypeInt64, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.SenderID(); ok {
+		_spec.SetField(sharedlink.FieldSenderID, field.TypeInt64, value)
+		_node.SenderID = value
+	}
+	if value, ok := _c.mutation.SenderName(); ok {
+		_spec.SetField(sharedlink.FieldSenderName, field.TypeString, value)
+		_node.SenderName = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(sharedlink.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(sharedlink.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	return _node, _spec
+}
+
+// SharedLinkCreateBulk is the builder for creating many SharedLink entities in bulk.
+type SharedLinkCreateBulk struct {
+	config
+	err      error
+	builders []*SharedLinkCreate
+}
+
+// Save creates the SharedLink entities in the database.
+func (_c *SharedLinkCreateBulk) Save(ctx context.Context) ([]*SharedLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SharedLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SharedLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SharedLinkCreateBulk) SaveX(ctx context.Context) []*SharedLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SharedLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SharedLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
+)
+
+// SharedLinkUpdate is the builder for updating SharedLink entities.
+type SharedLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SharedLinkMutation
+}
+
+// Where appends a list predicates to the SharedLinkUpdate builder.
+func (_u *SharedLinkUpdate) Where(ps ...predicate.SharedLink) *SharedLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SharedLinkUpdate) SetUpdateTime(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SharedLinkUpdate) SetChatID(v int64) *SharedLinkUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableChatID(v *int64) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SharedLinkUpdate) AddChatID(v int64) *SharedLinkUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *SharedLinkUpdate) SetMessageID(v int64) *SharedLinkUpdate {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableMessageID(v *int64) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *SharedLinkUpdate) AddMessageID(v int64) *SharedLinkUpdate {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *SharedLinkUpdate) SetSenderID(v int64) *SharedLinkUpdate {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableSenderID(v *int64) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *SharedLinkUpdate) AddSenderID(v int64) *SharedLinkUpdate {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *SharedLinkUpdate) SetSenderName(v string) *SharedLinkUpdate {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableSenderName(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *SharedLinkUpdate) SetURL(v string) *SharedLinkUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableURL(v *string) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *SharedLinkUpdate) SetSentAt(v time.Time) *SharedLinkUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *SharedLinkUpdate) SetNillableSentAt(v *time.Time) *SharedLinkUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SharedLinkMutation object of the builder.
+func (_u *SharedLinkUpdate) Mutation() *SharedLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SharedLinkUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SharedLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SharedLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SharedLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SharedLinkUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sharedlink.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SharedLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sharedlink.Table, sharedlink.Columns, sqlgraph.NewFieldSpec(sharedlink.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlink.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(sharedlink.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(sharedlink.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(sharedlink.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(sharedlink.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(sharedlink.FieldSenderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(sharedlink.FieldSenderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(sharedlink.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(sharedlink.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(sharedlink.FieldSentAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SharedLinkUpdateOne is the builder for updating a single SharedLink entity.
+type SharedLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SharedLinkMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SharedLinkUpdateOne) SetUpdateTime(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SharedLinkUpdateOne) SetChatID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableChatID(v *int64) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SharedLinkUpdateOne) AddChatID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *SharedLinkUpdateOne) SetMessageID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableMessageID(v *int64) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *SharedLinkUpdateOne) AddMessageID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *SharedLinkUpdateOne) SetSenderID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableSenderID(v *int64) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *SharedLinkUpdateOne) AddSenderID(v int64) *SharedLinkUpdateOne {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// SetSenderName sets the "sender_name" field.
+func (_u *SharedLinkUpdateOne) SetSenderName(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetSenderName(v)
+	return _u
+}
+
+// SetNillableSenderName sets the "sender_name" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableSenderName(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetSenderName(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *SharedLinkUpdateOne) SetURL(v string) *SharedLinkUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableURL(v *string) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *SharedLinkUpdateOne) SetSentAt(v time.Time) *SharedLinkUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *SharedLinkUpdateOne) SetNillableSentAt(v *time.Time) *SharedLinkUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SharedLinkMutation object of the builder.
+func (_u *SharedLinkUpdateOne) Mutation() *SharedLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SharedLinkUpdate builder.
+func (_u *SharedLinkUpdateOne) Where(ps ...predicate.SharedLink) *SharedLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SharedLinkUpdateOne) Select(field string, fields ...string) *SharedLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SharedLink entity.
+func (_u *SharedLinkUpdateOne) Save(ctx context.Context) (*SharedLink, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SharedLinkUpdateOne) SaveX(ctx context.Context) *SharedLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SharedLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SharedLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SharedLinkUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := sharedlink.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SharedLinkUpdateOne) sqlSave(ctx context.Context) (_node *SharedLink, err error) {
+	_spec := sqlgraph.NewUpdateSpec(sharedlink.Table, sharedlink.Columns, sqlgraph.NewFieldSpec(sharedlink.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SharedLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharedlink.FieldID)
+		for _, f := range fields {
+			if !sharedlink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharedlink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(sharedlink.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(sharedlink.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(sharedlink.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(sharedlink.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(sharedlink.FieldMessageID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SenderID(); ok {
+		_spec.SetField(sharedlink.FieldSenderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSenderID(); ok {
+		_spec.AddField(sharedlink.FieldSenderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SenderName(); ok {
+		_spec.SetField(sharedlink.FieldSenderName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(sharedlink.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(sharedlink.FieldSentAt, field.TypeTime, value)
+	}
+	_node = &SharedLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharedlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
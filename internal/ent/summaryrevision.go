@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+)
+
+// SummaryRevision is the model entity for the SummaryRevision schema.
+type SummaryRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 所属任务ID
+	TaskID int `json:"task_id,omitempty"`
+	// 群组ID
+	ChatID int64 `json:"chat_id,omitempty"`
+	// 该版本的摘要内容
+	Content string `json:"content,omitempty"`
+	// 版本来源：generated=模型生成（含重新生成）, edited=人工修改
+	Source summaryrevision.Source `json:"source,omitempty"`
+	// 该版本发送完成的时间；为空表示未发送（被替换或仍待发送）
+	SentAt       time.Time `json:"sent_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SummaryRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case summaryrevision.FieldID, summaryrevision.FieldTaskID, summaryrevision.FieldChatID:
+			values[i] = new(sql.NullInt64)
+		case summaryrevision.FieldContent, summaryrevision.FieldSource:
+			values[i] = new(sql.NullString)
+		case summaryrevision.FieldCreateTime, summaryrevision.FieldUpdateTime, summaryrevision.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SummaryRevision fields.
+func (_m *SummaryRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case summaryrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case summaryrevision.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case summaryrevision.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case summaryrevision.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = int(value.Int64)
+			}
+		case summaryrevision.FieldChatID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.Int64
+			}
+		case summaryrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case summaryrevision.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = summaryrevision.Source(value.String)
+			}
+		case summaryrevision.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SummaryRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *SummaryRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SummaryRevision.
+// Note that you need to call SummaryRevision.Unwrap() before calling this method if this SummaryRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SummaryRevision) Update() *SummaryRevisionUpdateOne {
+	return NewSummaryRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SummaryRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SummaryRevision) Unwrap() *SummaryRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SummaryRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SummaryRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("SummaryRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(_m.SentAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SummaryRevisions is a parsable slice of SummaryRevision.
+type SummaryRevisions []*SummaryRevision
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+)
+
+// SummaryRevisionCreate is the builder for creating a SummaryRevision entity.
+type SummaryRevisionCreate struct {
+	config
+	mutation *SummaryRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SummaryRevisionCreate) SetCreateTime(v time.Time) *SummaryRevisionCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SummaryRevisionCreate) SetNillableCreateTime(v *time.Time) *SummaryRevisionCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SummaryRevisionCreate) SetUpdateTime(v time.Time) *SummaryRevisionCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SummaryRevisionCreate) SetNillableUpdateTime(v *time.Time) *SummaryRevisionCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *SummaryRevisionCreate) SetTaskID(v int) *SummaryRevisionCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *SummaryRevisionCreate) SetChatID(v int64) *SummaryRevisionCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *SummaryRevisionCreate) SetContent(v string) *SummaryRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *SummaryRevisionCreate) SetSource(v summaryrevision.Source) *SummaryRevisionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *SummaryRevisionCreate) SetNillableSource(v *summaryrevision.Source) *SummaryRevisionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *SummaryRevisionCreate) SetSentAt(v time.Time) *SummaryRevisionCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *SummaryRevisionCreate) SetNillableSentAt(v *time.Time) *SummaryRevisionCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SummaryRevisionMutation object of the builder.
+func (_c *SummaryRevisionCreate) Mutation() *SummaryRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the SummaryRevision in the database.
+func (_c *SummaryRevisionCreate) Save(ctx context.Context) (*SummaryRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SummaryRevisionCreate) SaveX(ctx context.Context) *SummaryRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SummaryRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := summaryrevision.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := summaryrevision.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := summaryrevision.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SummaryRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SummaryRevision.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SummaryRevision.update_time"`)}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "SummaryRevision.task_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "SummaryRevision.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "SummaryRevision.content"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SummaryRevision.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := summaryrevision.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SummaryRevision.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SummaryRevisionCreate) sqlSave(ctx context.Context) (*SummaryRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SummaryRevisionCreate) createSpec() (*SummaryRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SummaryRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(summaryrevision.Table, sqlgraph.NewFieldSpec(summaryrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(summaryrevision.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryrevision.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(summaryrevision.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(summaryrevision.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(summaryrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(summaryrevision.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(summaryrevision.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	return _node, _spec
+}
+
+// SummaryRevisionCreateBulk is the builder for creating many SummaryRevision entities in bulk.
+type SummaryRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*SummaryRevisionCreate
+}
+
+// Save creates the SummaryRevision entities in the database.
+func (_c *SummaryRevisionCreateBulk) Save(ctx context.Context) ([]*SummaryRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SummaryRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SummaryRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SummaryRevisionCreateBulk) SaveX(ctx context.Context) []*SummaryRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+)
+
+// SummaryRevisionUpdate is the builder for updating SummaryRevision entities.
+type SummaryRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SummaryRevisionMutation
+}
+
+// Where appends a list predicates to the SummaryRevisionUpdate builder.
+func (_u *SummaryRevisionUpdate) Where(ps ...predicate.SummaryRevision) *SummaryRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SummaryRevisionUpdate) SetUpdateTime(v time.Time) *SummaryRevisionUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *SummaryRevisionUpdate) SetTaskID(v int) *SummaryRevisionUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *SummaryRevisionUpdate) SetNillableTaskID(v *int) *SummaryRevisionUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *SummaryRevisionUpdate) AddTaskID(v int) *SummaryRevisionUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SummaryRevisionUpdate) SetChatID(v int64) *SummaryRevisionUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SummaryRevisionUpdate) SetNillableChatID(v *int64) *SummaryRevisionUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SummaryRevisionUpdate) AddChatID(v int64) *SummaryRevisionUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *SummaryRevisionUpdate) SetContent(v string) *SummaryRevisionUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *SummaryRevisionUpdate) SetNillableContent(v *string) *SummaryRevisionUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *SummaryRevisionUpdate) SetSource(v summaryrevision.Source) *SummaryRevisionUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *SummaryRevisionUpdate) SetNillableSource(v *summaryrevision.Source) *SummaryRevisionUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *SummaryRevisionUpdate) SetSentAt(v time.Time) *SummaryRevisionUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *SummaryRevisionUpdate) SetNillableSentAt(v *time.Time) *SummaryRevisionUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *SummaryRevisionUpdate) ClearSentAt() *SummaryRevisionUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the SummaryRevisionMutation object of the builder.
+func (_u *SummaryRevisionUpdate) Mutation() *SummaryRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SummaryRevisionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SummaryRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryRevisionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := summaryrevision.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SummaryRevisionUpdate) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := summaryrevision.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SummaryRevision.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SummaryRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(summaryrevision.Table, summaryrevision.Columns, sqlgraph.NewFieldSpec(summaryrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryrevision.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(summaryrevision.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(summaryrevision.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(summaryrevision.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(summaryrevision.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(summaryrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(summaryrevision.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(summaryrevision.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(summaryrevision.FieldSentAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summaryrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SummaryRevisionUpdateOne is the builder for updating a single SummaryRevision entity.
+type SummaryRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SummaryRevisionMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SummaryRevisionUpdateOne) SetUpdateTime(v time.Time) *SummaryRevisionUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *SummaryRevisionUpdateOne) SetTaskID(v int) *SummaryRevisionUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *SummaryRevisionUpdateOne) SetNillableTaskID(v *int) *SummaryRevisionUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *SummaryRevisionUpdateOne) AddTaskID(v int) *SummaryRevisionUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SummaryRevisionUpdateOne) SetChatID(v int64) *SummaryRevisionUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SummaryRevisionUpdateOne) SetNillableChatID(v *int64) *SummaryRevisionUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SummaryRevisionUpdateOne) AddChatID(v int64) *SummaryRevisionUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *SummaryRevisionUpdateOne) SetContent(v string) *SummaryRevisionUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *SummaryRevisionUpdateOne) SetNillableContent(v *string) *SummaryRevisionUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *SummaryRevisionUpdateOne) SetSource(v summaryrevision.Source) *SummaryRevisionUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *SummaryRevisionUpdateOne) SetNillableSource(v *summaryrevision.Source) *SummaryRevisionUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *SummaryRevisionUpdateOne) SetSentAt(v time.Time) *SummaryRevisionUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *SummaryRevisionUpdateOne) SetNillableSentAt(v *time.Time) *SummaryRevisionUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *SummaryRevisionUpdateOne) ClearSentAt() *SummaryRevisionUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the SummaryRevisionMutation object of the builder.
+func (_u *SummaryRevisionUpdateOne) Mutation() *SummaryRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SummaryRevisionUpdate builder.
+func (_u *SummaryRevisionUpdateOne) Where(ps ...predicate.SummaryRevision) *SummaryRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SummaryRevisionUpdateOne) Select(field string, fields ...string) *SummaryRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SummaryRevision entity.
+func (_u *SummaryRevisionUpdateOne) Save(ctx context.Context) (*SummaryRevision, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryRevisionUpdateOne) SaveX(ctx context.Context) *SummaryRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SummaryRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryRevisionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := summaryrevision.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SummaryRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := summaryrevision.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SummaryRevision.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SummaryRevisionUpdateOne) sqlSave(ctx context.Context) (_node *SummaryRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(summaryrevision.Table, summaryrevision.Columns, sqlgraph.NewFieldSpec(summaryrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SummaryRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, summaryrevision.FieldID)
+		for _, f := range fields {
+			if !summaryrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != summaryrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryrevision.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(summaryrevision.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(summaryrevision.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(summaryrevision.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(summaryrevision.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(summaryrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(summaryrevision.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(summaryrevision.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(summaryrevision.FieldSentAt, field.TypeTime)
+	}
+	_node = &SummaryRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summaryrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
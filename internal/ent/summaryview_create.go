@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
+)
+
+// SummaryViewCreate is the builder for creating a SummaryView entity.
+type SummaryViewCreate struct {
+	config
+	mutation *SummaryViewMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *SummaryViewCreate) SetCreateTime(v time.Time) *SummaryViewCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *SummaryViewCreate) SetNillableCreateTime(v *time.Time) *SummaryViewCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *SummaryViewCreate) SetUpdateTime(v time.Time) *SummaryViewCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *SummaryViewCreate) SetNillableUpdateTime(v *time.Time) *SummaryViewCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *SummaryViewCreate) SetTaskID(v int) *SummaryViewCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *SummaryViewCreate) SetChatID(v int64) *SummaryViewCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SummaryViewCreate) SetUserID(v int64) *SummaryViewCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTopic sets the "topic" field.
+func (_c *SummaryViewCreate) SetTopic(v int) *SummaryViewCreate {
+	_c.mutation.SetTopic(v)
+	return _c
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_c *SummaryViewCreate) SetNillableTopic(v *int) *SummaryViewCreate {
+	if v != nil {
+		_c.SetTopic(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SummaryViewMutation object of the builder.
+func (_c *SummaryViewCreate) Mutation() *SummaryViewMutation {
+	return _c.mutation
+}
+
+// Save creates the SummaryView in the database.
+func (_c *SummaryViewCreate) Save(ctx context.Context) (*SummaryView, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SummaryViewCreate) SaveX(ctx context.Context) *SummaryView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryViewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryViewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SummaryViewCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := summaryview.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := summaryview.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Topic(); !ok {
+		v := summaryview.DefaultTopic
+		_c.mutation.SetTopic(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SummaryViewCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "SummaryView.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "SummaryView.update_time"`)}
+	}
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "SummaryView.task_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "SummaryView.chat_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SummaryView.user_id"`)}
+	}
+	if _, ok := _c.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "SummaryView.topic"`)}
+	}
+	return nil
+}
+
+func (_c *SummaryViewCreate) sqlSave(ctx context.Context) (*SummaryView, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SummaryViewCreate) createSpec() (*SummaryView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SummaryView{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(summaryview.Table, sqlgraph.NewFieldSpec(summaryview.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(summaryview.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryview.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(summaryview.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(summaryview.FieldChatID, field.TypeInt64, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(summaryview.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Topic(); ok {
+		_spec.SetField(summaryview.FieldTopic, field.TypeInt, value)
+		_node.Topic = value
+	}
+	return _node, _spec
+}
+
+// SummaryViewCreateBulk is the builder for creating many SummaryView entities in bulk.
+type SummaryViewCreateBulk struct {
+	config
+	err      error
+	builders []*SummaryViewCreate
+}
+
+// Save creates the SummaryView entities in the database.
+func (_c *SummaryViewCreateBulk) Save(ctx context.Context) ([]*SummaryView, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SummaryView, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SummaryViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SummaryViewCreateBulk) SaveX(ctx context.Context) []*SummaryView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SummaryViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SummaryViewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
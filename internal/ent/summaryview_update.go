@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
+)
+
+// SummaryViewUpdate is the builder for updating SummaryView entities.
+type SummaryViewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SummaryViewMutation
+}
+
+// Where appends a list predicates to the SummaryViewUpdate builder.
+func (_u *SummaryViewUpdate) Where(ps ...predicate.SummaryView) *SummaryViewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SummaryViewUpdate) SetUpdateTime(v time.Time) *SummaryViewUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *SummaryViewUpdate) SetTaskID(v int) *SummaryViewUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *SummaryViewUpdate) SetNillableTaskID(v *int) *SummaryViewUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *SummaryViewUpdate) AddTaskID(v int) *SummaryViewUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SummaryViewUpdate) SetChatID(v int64) *SummaryViewUpdate {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SummaryViewUpdate) SetNillableChatID(v *int64) *SummaryViewUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SummaryViewUpdate) AddChatID(v int64) *SummaryViewUpdate {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SummaryViewUpdate) SetUserID(v int64) *SummaryViewUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SummaryViewUpdate) SetNillableUserID(v *int64) *SummaryViewUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *SummaryViewUpdate) AddUserID(v int64) *SummaryViewUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *SummaryViewUpdate) SetTopic(v int) *SummaryViewUpdate {
+	_u.mutation.ResetTopic()
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *SummaryViewUpdate) SetNillableTopic(v *int) *SummaryViewUpdate {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// AddTopic adds value to the "topic" field.
+func (_u *SummaryViewUpdate) AddTopic(v int) *SummaryViewUpdate {
+	_u.mutation.AddTopic(v)
+	return _u
+}
+
+// Mutation returns the SummaryViewMutation object of the builder.
+func (_u *SummaryViewUpdate) Mutation() *SummaryViewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SummaryViewUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SummaryViewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryViewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryViewUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := summaryview.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SummaryViewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(summaryview.Table, summaryview.Columns, sqlgraph.NewFieldSpec(summaryview.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryview.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(summaryview.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(summaryview.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(summaryview.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(summaryview.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(summaryview.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(summaryview.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(summaryview.FieldTopic, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTopic(); ok {
+		_spec.AddField(summaryview.FieldTopic, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summaryview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SummaryViewUpdateOne is the builder for updating a single SummaryView entity.
+type SummaryViewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SummaryViewMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *SummaryViewUpdateOne) SetUpdateTime(v time.Time) *SummaryViewUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *SummaryViewUpdateOne) SetTaskID(v int) *SummaryViewUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *SummaryViewUpdateOne) SetNillableTaskID(v *int) *SummaryViewUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *SummaryViewUpdateOne) AddTaskID(v int) *SummaryViewUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *SummaryViewUpdateOne) SetChatID(v int64) *SummaryViewUpdateOne {
+	_u.mutation.ResetChatID()
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *SummaryViewUpdateOne) SetNillableChatID(v *int64) *SummaryViewUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// AddChatID adds value to the "chat_id" field.
+func (_u *SummaryViewUpdateOne) AddChatID(v int64) *SummaryViewUpdateOne {
+	_u.mutation.AddChatID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SummaryViewUpdateOne) SetUserID(v int64) *SummaryViewUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SummaryViewUpdateOne) SetNillableUserID(v *int64) *SummaryViewUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *SummaryViewUpdateOne) AddUserID(v int64) *SummaryViewUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *SummaryViewUpdateOne) SetTopic(v int) *SummaryViewUpdateOne {
+	_u.mutation.ResetTopic()
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *SummaryViewUpdateOne) SetNillableTopic(v *int) *SummaryViewUpdateOne {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// AddTopic adds value to the "topic" field.
+func (_u *SummaryViewUpdateOne) AddTopic(v int) *SummaryViewUpdateOne {
+	_u.mutation.AddTopic(v)
+	return _u
+}
+
+// Mutation returns the SummaryViewMutation object of the builder.
+func (_u *SummaryViewUpdateOne) Mutation() *SummaryViewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SummaryViewUpdate builder.
+func (_u *SummaryViewUpdateOne) Where(ps ...predicate.SummaryView) *SummaryViewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SummaryViewUpdateOne) Select(field string, fields ...string) *SummaryViewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SummaryView entity.
+func (_u *SummaryViewUpdateOne) Save(ctx context.Context) (*SummaryView, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SummaryViewUpdateOne) SaveX(ctx context.Context) *SummaryView {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SummaryViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SummaryViewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SummaryViewUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := summaryview.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+func (_u *SummaryViewUpdateOne) sqlSave(ctx context.Context) (_node *SummaryView, err error) {
+	_spec := sqlgraph.NewUpdateSpec(summaryview.Table, summaryview.Columns, sqlgraph.NewFieldSpec(summaryview.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SummaryView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, summaryview.FieldID)
+		for _, f := range fields {
+			if !summaryview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != summaryview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(summaryview.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(summaryview.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(summaryview.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(summaryview.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChatID(); ok {
+		_spec.AddField(summaryview.FieldChatID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(summaryview.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(summaryview.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(summaryview.FieldTopic, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTopic(); ok {
+		_spec.AddField(summaryview.FieldTopic, field.TypeInt, value)
+	}
+	_node = &SummaryView{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{summaryview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
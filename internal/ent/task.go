@@ -27,7 +27,7 @@ type Task struct {
 	StartTime time.Time `json:"start_time,omitempty"`
 	// 任务日期范围的结束时间
 	EndTime time.Time `json:"end_time,omitempty"`
-	// 任务状态：pending=待处理, processing=处理中, completed=已完成, failed=失败
+	// 任务状态：pending=待处理, processing=处理中, completed=已完成, failed=失败, blocked=无权在群组发送消息（已被移出或禁言），不再重试
 	Status task.Status `json:"status,omitempty"`
 	// 完成时间
 	CompletedAt time.Time `json:"completed_at,omitempty"`
@@ -35,7 +35,23 @@ type Task struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// 已生成待发送的摘要内容；非空表示只需重试发送通知
 	SummaryContent string `json:"summary_content,omitempty"`
-	selectValues   sql.SelectValues
+	// 参与总结的消息数，用于活跃度基线
+	MessageCount int `json:"message_count,omitempty"`
+	// 区间内的发言人数，用于活跃度基线
+	ParticipantCount int `json:"participant_count,omitempty"`
+	// 结构化总结结果（SummaryResult JSON），发送后保留，供话题展开按钮使用
+	SummaryJSON string `json:"summary_json,omitempty"`
+	// 最近一次总结的 prompt token 构成（PromptBudget JSON），用于调整过滤规则及定位 token 消耗大的群组
+	PromptBudget string `json:"prompt_budget,omitempty"`
+	// 总结进度：已完成的 chunk 数
+	ProgressChunk int `json:"progress_chunk,omitempty"`
+	// 总结进度：chunk 总数，0 表示尚未开始总结
+	ProgressChunks int `json:"progress_chunks,omitempty"`
+	// 总结进度：已完成 chunk 中消息内容的估算 tokens
+	ProgressTokens int `json:"progress_tokens,omitempty"`
+	// 任务类型：scheduled=定时总结窗口, adhoc=按需总结（/summary 命令）
+	Kind         task.Kind `json:"kind,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -43,9 +59,9 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldID, task.FieldChatID:
+		case task.FieldID, task.FieldChatID, task.FieldMessageCount, task.FieldParticipantCount, task.FieldProgressChunk, task.FieldProgressChunks, task.FieldProgressTokens:
 			values[i] = new(sql.NullInt64)
-		case task.FieldStatus, task.FieldErrorMessage, task.FieldSummaryContent:
+		case task.FieldStatus, task.FieldErrorMessage, task.FieldSummaryContent, task.FieldSummaryJSON, task.FieldPromptBudget, task.FieldKind:
 			values[i] = new(sql.NullString)
 		case task.FieldCreateTime, task.FieldUpdateTime, task.FieldStartTime, task.FieldEndTime, task.FieldCompletedAt:
 			values[i] = new(sql.NullTime)
@@ -124,6 +140,54 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SummaryContent = value.String
 			}
+		case task.FieldMessageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_count", values[i])
+			} else if value.Valid {
+				_m.MessageCount = int(value.Int64)
+			}
+		case task.FieldParticipantCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field participant_count", values[i])
+			} else if value.Valid {
+				_m.ParticipantCount = int(value.Int64)
+			}
+		case task.FieldSummaryJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_json", values[i])
+			} else if value.Valid {
+				_m.SummaryJSON = value.String
+			}
+		case task.FieldPromptBudget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_budget", values[i])
+			} else if value.Valid {
+				_m.PromptBudget = value.String
+			}
+		case task.FieldProgressChunk:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress_chunk", values[i])
+			} else if value.Valid {
+				_m.ProgressChunk = int(value.Int64)
+			}
+		case task.FieldProgressChunks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress_chunks", values[i])
+			} else if value.Valid {
+				_m.ProgressChunks = int(value.Int64)
+			}
+		case task.FieldProgressTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress_tokens", values[i])
+			} else if value.Valid {
+				_m.ProgressTokens = int(value.Int64)
+			}
+		case task.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = task.Kind(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -186,6 +250,30 @@ func (_m *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("summary_content=")
 	builder.WriteString(_m.SummaryContent)
+	builder.WriteString(", ")
+	builder.WriteString("message_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageCount))
+	builder.WriteString(", ")
+	builder.WriteString("participant_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParticipantCount))
+	builder.WriteString(", ")
+	builder.WriteString("summary_json=")
+	builder.WriteString(_m.SummaryJSON)
+	builder.WriteString(", ")
+	builder.WriteString("prompt_budget=")
+	builder.WriteString(_m.PromptBudget)
+	builder.WriteString(", ")
+	builder.WriteString("progress_chunk=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProgressChunk))
+	builder.WriteString(", ")
+	builder.WriteString("progress_chunks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProgressChunks))
+	builder.WriteString(", ")
+	builder.WriteString("progress_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProgressTokens))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
 	builder.WriteByte(')')
 	return builder.String()
 }
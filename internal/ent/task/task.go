@@ -32,6 +32,22 @@ const (
 	FieldErrorMessage = "error_message"
 	// FieldSummaryContent holds the string denoting the summary_content field in the database.
 	FieldSummaryContent = "summary_content"
+	// FieldMessageCount holds the string denoting the message_count field in the database.
+	FieldMessageCount = "message_count"
+	// FieldParticipantCount holds the string denoting the participant_count field in the database.
+	FieldParticipantCount = "participant_count"
+	// FieldSummaryJSON holds the string denoting the summary_json field in the database.
+	FieldSummaryJSON = "summary_json"
+	// FieldPromptBudget holds the string denoting the prompt_budget field in the database.
+	FieldPromptBudget = "prompt_budget"
+	// FieldProgressChunk holds the string denoting the progress_chunk field in the database.
+	FieldProgressChunk = "progress_chunk"
+	// FieldProgressChunks holds the string denoting the progress_chunks field in the database.
+	FieldProgressChunks = "progress_chunks"
+	// FieldProgressTokens holds the string denoting the progress_tokens field in the database.
+	FieldProgressTokens = "progress_tokens"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 )
@@ -48,6 +64,14 @@ var Columns = []string{
 	FieldCompletedAt,
 	FieldErrorMessage,
 	FieldSummaryContent,
+	FieldMessageCount,
+	FieldParticipantCount,
+	FieldSummaryJSON,
+	FieldPromptBudget,
+	FieldProgressChunk,
+	FieldProgressChunks,
+	FieldProgressTokens,
+	FieldKind,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +91,16 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultMessageCount holds the default value on creation for the "message_count" field.
+	DefaultMessageCount int
+	// DefaultParticipantCount holds the default value on creation for the "participant_count" field.
+	DefaultParticipantCount int
+	// DefaultProgressChunk holds the default value on creation for the "progress_chunk" field.
+	DefaultProgressChunk int
+	// DefaultProgressChunks holds the default value on creation for the "progress_chunks" field.
+	DefaultProgressChunks int
+	// DefaultProgressTokens holds the default value on creation for the "progress_tokens" field.
+	DefaultProgressTokens int
 )
 
 // Status defines the type for the "status" enum field.
@@ -81,6 +115,7 @@ const (
 	StatusProcessing Status = "processing"
 	StatusCompleted  Status = "completed"
 	StatusFailed     Status = "failed"
+	StatusBlocked    Status = "blocked"
 )
 
 func (s Status) String() string {
@@ -90,13 +125,39 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusProcessing, StatusCompleted, StatusFailed:
+	case StatusPending, StatusProcessing, StatusCompleted, StatusFailed, StatusBlocked:
 		return nil
 	default:
 		return fmt.Errorf("task: invalid enum value for status field: %q", s)
 	}
 }
 
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindScheduled is the default value of the Kind enum.
+const DefaultKind = KindScheduled
+
+// Kind values.
+const (
+	KindScheduled Kind = "scheduled"
+	KindAdhoc     Kind = "adhoc"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindScheduled, KindAdhoc:
+		return nil
+	default:
+		return fmt.Errorf("task: invalid enum value for kind field: %q", k)
+	}
+}
+
 // OrderOption defines the ordering options for the Task queries.
 type OrderOption func(*sql.Selector)
 
@@ -149,3 +210,43 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 func BySummaryContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSummaryContent, opts...).ToFunc()
 }
+
+// ByMessageCount orders the results by the message_count field.
+func ByMessageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageCount, opts...).ToFunc()
+}
+
+// ByParticipantCount orders the results by the participant_count field.
+func ByParticipantCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParticipantCount, opts...).ToFunc()
+}
+
+// BySummaryJSON orders the results by the summary_json field.
+func BySummaryJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryJSON, opts...).ToFunc()
+}
+
+// ByPromptBudget orders the results by the prompt_budget field.
+func ByPromptBudget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptBudget, opts...).ToFunc()
+}
+
+// ByProgressChunk orders the results by the progress_chunk field.
+func ByProgressChunk(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgressChunk, opts...).ToFunc()
+}
+
+// ByProgressChunks orders the results by the progress_chunks field.
+func ByProgressChunks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgressChunks, opts...).ToFunc()
+}
+
+// ByProgressTokens orders the results by the progress_tokens field.
+func ByProgressTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgressTokens, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
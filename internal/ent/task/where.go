@@ -94,6 +94,41 @@ func SummaryContent(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldSummaryContent, v))
 }
 
+// MessageCount applies equality check predicate on the "message_count" field. It's identical to MessageCountEQ.
+func MessageCount(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// ParticipantCount applies equality check predicate on the "participant_count" field. It's identical to ParticipantCountEQ.
+func ParticipantCount(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParticipantCount, v))
+}
+
+// SummaryJSON applies equality check predicate on the "summary_json" field. It's identical to SummaryJSONEQ.
+func SummaryJSON(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSummaryJSON, v))
+}
+
+// PromptBudget applies equality check predicate on the "prompt_budget" field. It's identical to PromptBudgetEQ.
+func PromptBudget(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPromptBudget, v))
+}
+
+// ProgressChunk applies equality check predicate on the "progress_chunk" field. It's identical to ProgressChunkEQ.
+func ProgressChunk(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressChunk, v))
+}
+
+// ProgressChunks applies equality check predicate on the "progress_chunks" field. It's identical to ProgressChunksEQ.
+func ProgressChunks(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressChunks, v))
+}
+
+// ProgressTokens applies equality check predicate on the "progress_tokens" field. It's identical to ProgressTokensEQ.
+func ProgressTokens(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressTokens, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreateTime, v))
@@ -514,6 +549,376 @@ func SummaryContentContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldSummaryContent, v))
 }
 
+// MessageCountEQ applies the EQ predicate on the "message_count" field.
+func MessageCountEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMessageCount, v))
+}
+
+// MessageCountNEQ applies the NEQ predicate on the "message_count" field.
+func MessageCountNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldMessageCount, v))
+}
+
+// MessageCountIn applies the In predicate on the "message_count" field.
+func MessageCountIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldMessageCount, vs...))
+}
+
+// MessageCountNotIn applies the NotIn predicate on the "message_count" field.
+func MessageCountNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldMessageCount, vs...))
+}
+
+// MessageCountGT applies the GT predicate on the "message_count" field.
+func MessageCountGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldMessageCount, v))
+}
+
+// MessageCountGTE applies the GTE predicate on the "message_count" field.
+func MessageCountGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldMessageCount, v))
+}
+
+// MessageCountLT applies the LT predicate on the "message_count" field.
+func MessageCountLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldMessageCount, v))
+}
+
+// MessageCountLTE applies the LTE predicate on the "message_count" field.
+func MessageCountLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldMessageCount, v))
+}
+
+// ParticipantCountEQ applies the EQ predicate on the "participant_count" field.
+func ParticipantCountEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldParticipantCount, v))
+}
+
+// ParticipantCountNEQ applies the NEQ predicate on the "participant_count" field.
+func ParticipantCountNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldParticipantCount, v))
+}
+
+// ParticipantCountIn applies the In predicate on the "participant_count" field.
+func ParticipantCountIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldParticipantCount, vs...))
+}
+
+// ParticipantCountNotIn applies the NotIn predicate on the "participant_count" field.
+func ParticipantCountNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldParticipantCount, vs...))
+}
+
+// ParticipantCountGT applies the GT predicate on the "participant_count" field.
+func ParticipantCountGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldParticipantCount, v))
+}
+
+// ParticipantCountGTE applies the GTE predicate on the "participant_count" field.
+func ParticipantCountGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldParticipantCount, v))
+}
+
+// ParticipantCountLT applies the LT predicate on the "participant_count" field.
+func ParticipantCountLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldParticipantCount, v))
+}
+
+// ParticipantCountLTE applies the LTE predicate on the "participant_count" field.
+func ParticipantCountLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldParticipantCount, v))
+}
+
+// SummaryJSONEQ applies the EQ predicate on the "summary_json" field.
+func SummaryJSONEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSummaryJSON, v))
+}
+
+// SummaryJSONNEQ applies the NEQ predicate on the "summary_json" field.
+func SummaryJSONNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSummaryJSON, v))
+}
+
+// SummaryJSONIn applies the In predicate on the "summary_json" field.
+func SummaryJSONIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSummaryJSON, vs...))
+}
+
+// SummaryJSONNotIn applies the NotIn predicate on the "summary_json" field.
+func SummaryJSONNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSummaryJSON, vs...))
+}
+
+// SummaryJSONGT applies the GT predicate on the "summary_json" field.
+func SummaryJSONGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldSummaryJSON, v))
+}
+
+// SummaryJSONGTE applies the GTE predicate on the "summary_json" field.
+func SummaryJSONGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldSummaryJSON, v))
+}
+
+// SummaryJSONLT applies the LT predicate on the "summary_json" field.
+func SummaryJSONLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldSummaryJSON, v))
+}
+
+// SummaryJSONLTE applies the LTE predicate on the "summary_json" field.
+func SummaryJSONLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldSummaryJSON, v))
+}
+
+// SummaryJSONContains applies the Contains predicate on the "summary_json" field.
+func SummaryJSONContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldSummaryJSON, v))
+}
+
+// SummaryJSONHasPrefix applies the HasPrefix predicate on the "summary_json" field.
+func SummaryJSONHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldSummaryJSON, v))
+}
+
+// SummaryJSONHasSuffix applies the HasSuffix predicate on the "summary_json" field.
+func SummaryJSONHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldSummaryJSON, v))
+}
+
+// SummaryJSONIsNil applies the IsNil predicate on the "summary_json" field.
+func SummaryJSONIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSummaryJSON))
+}
+
+// SummaryJSONNotNil applies the NotNil predicate on the "summary_json" field.
+func SummaryJSONNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSummaryJSON))
+}
+
+// SummaryJSONEqualFold applies the EqualFold predicate on the "summary_json" field.
+func SummaryJSONEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldSummaryJSON, v))
+}
+
+// SummaryJSONContainsFold applies the ContainsFold predicate on the "summary_json" field.
+func SummaryJSONContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldSummaryJSON, v))
+}
+
+// PromptBudgetEQ applies the EQ predicate on the "prompt_budget" field.
+func PromptBudgetEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPromptBudget, v))
+}
+
+// PromptBudgetNEQ applies the NEQ predicate on the "prompt_budget" field.
+func PromptBudgetNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPromptBudget, v))
+}
+
+// PromptBudgetIn applies the In predicate on the "prompt_budget" field.
+func PromptBudgetIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPromptBudget, vs...))
+}
+
+// PromptBudgetNotIn applies the NotIn predicate on the "prompt_budget" field.
+func PromptBudgetNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPromptBudget, vs...))
+}
+
+// PromptBudgetGT applies the GT predicate on the "prompt_budget" field.
+func PromptBudgetGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldPromptBudget, v))
+}
+
+// PromptBudgetGTE applies the GTE predicate on the "prompt_budget" field.
+func PromptBudgetGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldPromptBudget, v))
+}
+
+// PromptBudgetLT applies the LT predicate on the "prompt_budget" field.
+func PromptBudgetLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldPromptBudget, v))
+}
+
+// PromptBudgetLTE applies the LTE predicate on the "prompt_budget" field.
+func PromptBudgetLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldPromptBudget, v))
+}
+
+// PromptBudgetContains applies the Contains predicate on the "prompt_budget" field.
+func PromptBudgetContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldPromptBudget, v))
+}
+
+// PromptBudgetHasPrefix applies the HasPrefix predicate on the "prompt_budget" field.
+func PromptBudgetHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldPromptBudget, v))
+}
+
+// PromptBudgetHasSuffix applies the HasSuffix predicate on the "prompt_budget" field.
+func PromptBudgetHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldPromptBudget, v))
+}
+
+// PromptBudgetIsNil applies the IsNil predicate on the "prompt_budget" field.
+func PromptBudgetIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPromptBudget))
+}
+
+// PromptBudgetNotNil applies the NotNil predicate on the "prompt_budget" field.
+func PromptBudgetNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPromptBudget))
+}
+
+// PromptBudgetEqualFold applies the EqualFold predicate on the "prompt_budget" field.
+func PromptBudgetEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldPromptBudget, v))
+}
+
+// PromptBudgetContainsFold applies the ContainsFold predicate on the "prompt_budget" field.
+func PromptBudgetContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldPromptBudget, v))
+}
+
+// ProgressChunkEQ applies the EQ predicate on the "progress_chunk" field.
+func ProgressChunkEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressChunk, v))
+}
+
+// ProgressChunkNEQ applies the NEQ predicate on the "progress_chunk" field.
+func ProgressChunkNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldProgressChunk, v))
+}
+
+// ProgressChunkIn applies the In predicate on the "progress_chunk" field.
+func ProgressChunkIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldProgressChunk, vs...))
+}
+
+// ProgressChunkNotIn applies the NotIn predicate on the "progress_chunk" field.
+func ProgressChunkNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldProgressChunk, vs...))
+}
+
+// ProgressChunkGT applies the GT predicate on the "progress_chunk" field.
+func ProgressChunkGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldProgressChunk, v))
+}
+
+// ProgressChunkGTE applies the GTE predicate on the "progress_chunk" field.
+func ProgressChunkGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldProgressChunk, v))
+}
+
+// ProgressChunkLT applies the LT predicate on the "progress_chunk" field.
+func ProgressChunkLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldProgressChunk, v))
+}
+
+// ProgressChunkLTE applies the LTE predicate on the "progress_chunk" field.
+func ProgressChunkLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldProgressChunk, v))
+}
+
+// ProgressChunksEQ applies the EQ predicate on the "progress_chunks" field.
+func ProgressChunksEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressChunks, v))
+}
+
+// ProgressChunksNEQ applies the NEQ predicate on the "progress_chunks" field.
+func ProgressChunksNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldProgressChunks, v))
+}
+
+// ProgressChunksIn applies the In predicate on the "progress_chunks" field.
+func ProgressChunksIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldProgressChunks, vs...))
+}
+
+// ProgressChunksNotIn applies the NotIn predicate on the "progress_chunks" field.
+func ProgressChunksNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldProgressChunks, vs...))
+}
+
+// ProgressChunksGT applies the GT predicate on the "progress_chunks" field.
+func ProgressChunksGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldProgressChunks, v))
+}
+
+// ProgressChunksGTE applies the GTE predicate on the "progress_chunks" field.
+func ProgressChunksGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldProgressChunks, v))
+}
+
+// ProgressChunksLT applies the LT predicate on the "progress_chunks" field.
+func ProgressChunksLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldProgressChunks, v))
+}
+
+// ProgressChunksLTE applies the LTE predicate on the "progress_chunks" field.
+func ProgressChunksLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldProgressChunks, v))
+}
+
+// ProgressTokensEQ applies the EQ predicate on the "progress_tokens" field.
+func ProgressTokensEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldProgressTokens, v))
+}
+
+// ProgressTokensNEQ applies the NEQ predicate on the "progress_tokens" field.
+func ProgressTokensNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldProgressTokens, v))
+}
+
+// ProgressTokensIn applies the In predicate on the "progress_tokens" field.
+func ProgressTokensIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldProgressTokens, vs...))
+}
+
+// ProgressTokensNotIn applies the NotIn predicate on the "progress_tokens" field.
+func ProgressTokensNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldProgressTokens, vs...))
+}
+
+// ProgressTokensGT applies the GT predicate on the "progress_tokens" field.
+func ProgressTokensGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldProgressTokens, v))
+}
+
+// ProgressTokensGTE applies the GTE predicate on the "progress_tokens" field.
+func ProgressTokensGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldProgressTokens, v))
+}
+
+// ProgressTokensLT applies the LT predicate on the "progress_tokens" field.
+func ProgressTokensLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldProgressTokens, v))
+}
+
+// ProgressTokensLTE applies the LTE predicate on the "progress_tokens" field.
+func ProgressTokensLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldProgressTokens, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldKind, vs...))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
@@ -122,6 +122,118 @@ func (_c *TaskCreate) SetNillableSummaryContent(v *string) *TaskCreate {
 	return _c
 }
 
+// SetMessageCount sets the "message_count" field.
+func (_c *TaskCreate) SetMessageCount(v int) *TaskCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableMessageCount(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetMessageCount(*v)
+	}
+	return _c
+}
+
+// SetParticipantCount sets the "participant_count" field.
+func (_c *TaskCreate) SetParticipantCount(v int) *TaskCreate {
+	_c.mutation.SetParticipantCount(v)
+	return _c
+}
+
+// SetNillableParticipantCount sets the "participant_count" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableParticipantCount(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetParticipantCount(*v)
+	}
+	return _c
+}
+
+// SetSummaryJSON sets the "summary_json" field.
+func (_c *TaskCreate) SetSummaryJSON(v string) *TaskCreate {
+	_c.mutation.SetSummaryJSON(v)
+	return _c
+}
+
+// SetNillableSummaryJSON sets the "summary_json" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableSummaryJSON(v *string) *TaskCreate {
+	if v != nil {
+		_c.SetSummaryJSON(*v)
+	}
+	return _c
+}
+
+// SetPromptBudget sets the "prompt_budget" field.
+func (_c *TaskCreate) SetPromptBudget(v string) *TaskCreate {
+	_c.mutation.SetPromptBudget(v)
+	return _c
+}
+
+// SetNillablePromptBudget sets the "prompt_budget" field if the given value is not nil.
+func (_c *TaskCreate) SetNillablePromptBudget(v *string) *TaskCreate {
+	if v != nil {
+		_c.SetPromptBudget(*v)
+	}
+	return _c
+}
+
+// SetProgressChunk sets the "progress_chunk" field.
+func (_c *TaskCreate) SetProgressChunk(v int) *TaskCreate {
+	_c.mutation.SetProgressChunk(v)
+	return _c
+}
+
+// SetNillableProgressChunk sets the "progress_chunk" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableProgressChunk(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetProgressChunk(*v)
+	}
+	return _c
+}
+
+// SetProgressChunks sets the "progress_chunks" field.
+func (_c *TaskCreate) SetProgressChunks(v int) *TaskCreate {
+	_c.mutation.SetProgressChunks(v)
+	return _c
+}
+
+// SetNillableProgressChunks sets the "progress_chunks" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableProgressChunks(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetProgressChunks(*v)
+	}
+	return _c
+}
+
+// SetProgressTokens sets the "progress_tokens" field.
+func (_c *TaskCreate) SetProgressTokens(v int) *TaskCreate {
+	_c.mutation.SetProgressTokens(v)
+	return _c
+}
+
+// SetNillableProgressTokens sets the "progress_tokens" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableProgressTokens(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetProgressTokens(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *TaskCreate) SetKind(v task.Kind) *TaskCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableKind(v *task.Kind) *TaskCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_c *TaskCreate) Mutation() *TaskMutation {
 	return _c.mutation
@@ -169,6 +281,30 @@ func (_c *TaskCreate) defaults() {
 		v := task.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		v := task.DefaultMessageCount
+		_c.mutation.SetMessageCount(v)
+	}
+	if _, ok := _c.mutation.ParticipantCount(); !ok {
+		v := task.DefaultParticipantCount
+		_c.mutation.SetParticipantCount(v)
+	}
+	if _, ok := _c.mutation.ProgressChunk(); !ok {
+		v := task.DefaultProgressChunk
+		_c.mutation.SetProgressChunk(v)
+	}
+	if _, ok := _c.mutation.ProgressChunks(); !ok {
+		v := task.DefaultProgressChunks
+		_c.mutation.SetProgressChunks(v)
+	}
+	if _, ok := _c.mutation.ProgressTokens(); !ok {
+		v := task.DefaultProgressTokens
+		_c.mutation.SetProgressTokens(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := task.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -196,6 +332,29 @@ func (_c *TaskCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "Task.message_count"`)}
+	}
+	if _, ok := _c.mutation.ParticipantCount(); !ok {
+		return &ValidationError{Name: "participant_count", err: errors.New(`ent: missing required field "Task.participant_count"`)}
+	}
+	if _, ok := _c.mutation.ProgressChunk(); !ok {
+		return &ValidationError{Name: "progress_chunk", err: errors.New(`ent: missing required field "Task.progress_chunk"`)}
+	}
+	if _, ok := _c.mutation.ProgressChunks(); !ok {
+		return &ValidationError{Name: "progress_chunks", err: errors.New(`ent: missing required field "Task.progress_chunks"`)}
+	}
+	if _, ok := _c.mutation.ProgressTokens(); !ok {
+		return &ValidationError{Name: "progress_tokens", err: errors.New(`ent: missing required field "Task.progress_tokens"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Task.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := task.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Task.kind": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -258,6 +417,38 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldSummaryContent, field.TypeString, value)
 		_node.SummaryContent = value
 	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(task.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.ParticipantCount(); ok {
+		_spec.SetField(task.FieldParticipantCount, field.TypeInt, value)
+		_node.ParticipantCount = value
+	}
+	if value, ok := _c.mutation.SummaryJSON(); ok {
+		_spec.SetField(task.FieldSummaryJSON, field.TypeString, value)
+		_node.SummaryJSON = value
+	}
+	if value, ok := _c.mutation.PromptBudget(); ok {
+		_spec.SetField(task.FieldPromptBudget, field.TypeString, value)
+		_node.PromptBudget = value
+	}
+	if value, ok := _c.mutation.ProgressChunk(); ok {
+		_spec.SetField(task.FieldProgressChunk, field.TypeInt, value)
+		_node.ProgressChunk = value
+	}
+	if value, ok := _c.mutation.ProgressChunks(); ok {
+		_spec.SetField(task.FieldProgressChunks, field.TypeInt, value)
+		_node.ProgressChunks = value
+	}
+	if value, ok := _c.mutation.ProgressTokens(); ok {
+		_spec.SetField(task.FieldProgressTokens, field.TypeInt, value)
+		_node.ProgressTokens = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(task.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
 	return _node, _spec
 }
 
@@ -157,6 +157,165 @@ func (_u *TaskUpdate) ClearSummaryContent() *TaskUpdate {
 	return _u
 }
 
+// SetMessageCount sets the "message_count" field.
+func (_u *TaskUpdate) SetMessageCount(v int) *TaskUpdate {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableMessageCount(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *TaskUpdate) AddMessageCount(v int) *TaskUpdate {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetParticipantCount sets the "participant_count" field.
+func (_u *TaskUpdate) SetParticipantCount(v int) *TaskUpdate {
+	_u.mutation.ResetParticipantCount()
+	_u.mutation.SetParticipantCount(v)
+	return _u
+}
+
+// SetNillableParticipantCount sets the "participant_count" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableParticipantCount(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetParticipantCount(*v)
+	}
+	return _u
+}
+
+// AddParticipantCount adds value to the "participant_count" field.
+func (_u *TaskUpdate) AddParticipantCount(v int) *TaskUpdate {
+	_u.mutation.AddParticipantCount(v)
+	return _u
+}
+
+// SetSummaryJSON sets the "summary_json" field.
+func (_u *TaskUpdate) SetSummaryJSON(v string) *TaskUpdate {
+	_u.mutation.SetSummaryJSON(v)
+	return _u
+}
+
+// SetNillableSummaryJSON sets the "summary_json" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableSummaryJSON(v *string) *TaskUpdate {
+	if v != nil {
+		_u.SetSummaryJSON(*v)
+	}
+	return _u
+}
+
+// ClearSummaryJSON clears the value of the "summary_json" field.
+func (_u *TaskUpdate) ClearSummaryJSON() *TaskUpdate {
+	_u.mutation.ClearSummaryJSON()
+	return _u
+}
+
+// SetPromptBudget sets the "prompt_budget" field.
+func (_u *TaskUpdate) SetPromptBudget(v string) *TaskUpdate {
+	_u.mutation.SetPromptBudget(v)
+	return _u
+}
+
+// SetNillablePromptBudget sets the "prompt_budget" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillablePromptBudget(v *string) *TaskUpdate {
+	if v != nil {
+		_u.SetPromptBudget(*v)
+	}
+	return _u
+}
+
+// ClearPromptBudget clears the value of the "prompt_budget" field.
+func (_u *TaskUpdate) ClearPromptBudget() *TaskUpdate {
+	_u.mutation.ClearPromptBudget()
+	return _u
+}
+
+// SetProgressChunk sets the "progress_chunk" field.
+func (_u *TaskUpdate) SetProgressChunk(v int) *TaskUpdate {
+	_u.mutation.ResetProgressChunk()
+	_u.mutation.SetProgressChunk(v)
+	return _u
+}
+
+// SetNillableProgressChunk sets the "progress_chunk" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableProgressChunk(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetProgressChunk(*v)
+	}
+	return _u
+}
+
+// AddProgressChunk adds value to the "progress_chunk" field.
+func (_u *TaskUpdate) AddProgressChunk(v int) *TaskUpdate {
+	_u.mutation.AddProgressChunk(v)
+	return _u
+}
+
+// SetProgressChunks sets the "progress_chunks" field.
+func (_u *TaskUpdate) SetProgressChunks(v int) *TaskUpdate {
+	_u.mutation.ResetProgressChunks()
+	_u.mutation.SetProgressChunks(v)
+	return _u
+}
+
+// SetNillableProgressChunks sets the "progress_chunks" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableProgressChunks(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetProgressChunks(*v)
+	}
+	return _u
+}
+
+// AddProgressChunks adds value to the "progress_chunks" field.
+func (_u *TaskUpdate) AddProgressChunks(v int) *TaskUpdate {
+	_u.mutation.AddProgressChunks(v)
+	return _u
+}
+
+// SetProgressTokens sets the "progress_tokens" field.
+func (_u *TaskUpdate) SetProgressTokens(v int) *TaskUpdate {
+	_u.mutation.ResetProgressTokens()
+	_u.mutation.SetProgressTokens(v)
+	return _u
+}
+
+// SetNillableProgressTokens sets the "progress_tokens" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableProgressTokens(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetProgressTokens(*v)
+	}
+	return _u
+}
+
+// AddProgressTokens adds value to the "progress_tokens" field.
+func (_u *TaskUpdate) AddProgressTokens(v int) *TaskUpdate {
+	_u.mutation.AddProgressTokens(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TaskUpdate) SetKind(v task.Kind) *TaskUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableKind(v *task.Kind) *TaskUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -205,6 +364,11 @@ func (_u *TaskUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := task.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Task.kind": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -256,6 +420,51 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SummaryContentCleared() {
 		_spec.ClearField(task.FieldSummaryContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(task.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(task.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParticipantCount(); ok {
+		_spec.SetField(task.FieldParticipantCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParticipantCount(); ok {
+		_spec.AddField(task.FieldParticipantCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SummaryJSON(); ok {
+		_spec.SetField(task.FieldSummaryJSON, field.TypeString, value)
+	}
+	if _u.mutation.SummaryJSONCleared() {
+		_spec.ClearField(task.FieldSummaryJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptBudget(); ok {
+		_spec.SetField(task.FieldPromptBudget, field.TypeString, value)
+	}
+	if _u.mutation.PromptBudgetCleared() {
+		_spec.ClearField(task.FieldPromptBudget, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProgressChunk(); ok {
+		_spec.SetField(task.FieldProgressChunk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressChunk(); ok {
+		_spec.AddField(task.FieldProgressChunk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressChunks(); ok {
+		_spec.SetField(task.FieldProgressChunks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressChunks(); ok {
+		_spec.AddField(task.FieldProgressChunks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressTokens(); ok {
+		_spec.SetField(task.FieldProgressTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressTokens(); ok {
+		_spec.AddField(task.FieldProgressTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(task.FieldKind, field.TypeEnum, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
@@ -405,6 +614,165 @@ func (_u *TaskUpdateOne) ClearSummaryContent() *TaskUpdateOne {
 	return _u
 }
 
+// SetMessageCount sets the "message_count" field.
+func (_u *TaskUpdateOne) SetMessageCount(v int) *TaskUpdateOne {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableMessageCount(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *TaskUpdateOne) AddMessageCount(v int) *TaskUpdateOne {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetParticipantCount sets the "participant_count" field.
+func (_u *TaskUpdateOne) SetParticipantCount(v int) *TaskUpdateOne {
+	_u.mutation.ResetParticipantCount()
+	_u.mutation.SetParticipantCount(v)
+	return _u
+}
+
+// SetNillableParticipantCount sets the "participant_count" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableParticipantCount(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetParticipantCount(*v)
+	}
+	return _u
+}
+
+// AddParticipantCount adds value to the "participant_count" field.
+func (_u *TaskUpdateOne) AddParticipantCount(v int) *TaskUpdateOne {
+	_u.mutation.AddParticipantCount(v)
+	return _u
+}
+
+// SetSummaryJSON sets the "summary_json" field.
+func (_u *TaskUpdateOne) SetSummaryJSON(v string) *TaskUpdateOne {
+	_u.mutation.SetSummaryJSON(v)
+	return _u
+}
+
+// SetNillableSummaryJSON sets the "summary_json" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableSummaryJSON(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetSummaryJSON(*v)
+	}
+	return _u
+}
+
+// ClearSummaryJSON clears the value of the "summary_json" field.
+func (_u *TaskUpdateOne) ClearSummaryJSON() *TaskUpdateOne {
+	_u.mutation.ClearSummaryJSON()
+	return _u
+}
+
+// SetPromptBudget sets the "prompt_budget" field.
+func (_u *TaskUpdateOne) SetPromptBudget(v string) *TaskUpdateOne {
+	_u.mutation.SetPromptBudget(v)
+	return _u
+}
+
+// SetNillablePromptBudget sets the "prompt_budget" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillablePromptBudget(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetPromptBudget(*v)
+	}
+	return _u
+}
+
+// ClearPromptBudget clears the value of the "prompt_budget" field.
+func (_u *TaskUpdateOne) ClearPromptBudget() *TaskUpdateOne {
+	_u.mutation.ClearPromptBudget()
+	return _u
+}
+
+// SetProgressChunk sets the "progress_chunk" field.
+func (_u *TaskUpdateOne) SetProgressChunk(v int) *TaskUpdateOne {
+	_u.mutation.ResetProgressChunk()
+	_u.mutation.SetProgressChunk(v)
+	return _u
+}
+
+// SetNillableProgressChunk sets the "progress_chunk" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableProgressChunk(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetProgressChunk(*v)
+	}
+	return _u
+}
+
+// AddProgressChunk adds value to the "progress_chunk" field.
+func (_u *TaskUpdateOne) AddProgressChunk(v int) *TaskUpdateOne {
+	_u.mutation.AddProgressChunk(v)
+	return _u
+}
+
+// SetProgressChunks sets the "progress_chunks" field.
+func (_u *TaskUpdateOne) SetProgressChunks(v int) *TaskUpdateOne {
+	_u.mutation.ResetProgressChunks()
+	_u.mutation.SetProgressChunks(v)
+	return _u
+}
+
+// SetNillableProgressChunks sets the "progress_chunks" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableProgressChunks(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetProgressChunks(*v)
+	}
+	return _u
+}
+
+// AddProgressChunks adds value to the "progress_chunks" field.
+func (_u *TaskUpdateOne) AddProgressChunks(v int) *TaskUpdateOne {
+	_u.mutation.AddProgressChunks(v)
+	return _u
+}
+
+// SetProgressTokens sets the "progress_tokens" field.
+func (_u *TaskUpdateOne) SetProgressTokens(v int) *TaskUpdateOne {
+	_u.mutation.ResetProgressTokens()
+	_u.mutation.SetProgressTokens(v)
+	return _u
+}
+
+// SetNillableProgressTokens sets the "progress_tokens" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableProgressTokens(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetProgressTokens(*v)
+	}
+	return _u
+}
+
+// AddProgressTokens adds value to the "progress_tokens" field.
+func (_u *TaskUpdateOne) AddProgressTokens(v int) *TaskUpdateOne {
+	_u.mutation.AddProgressTokens(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TaskUpdateOne) SetKind(v task.Kind) *TaskUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableKind(v *task.Kind) *TaskUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -466,6 +834,11 @@ func (_u *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := task.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Task.kind": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -534,6 +907,51 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if _u.mutation.SummaryContentCleared() {
 		_spec.ClearField(task.FieldSummaryContent, field.TypeString)
 	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(task.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(task.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParticipantCount(); ok {
+		_spec.SetField(task.FieldParticipantCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParticipantCount(); ok {
+		_spec.AddField(task.FieldParticipantCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SummaryJSON(); ok {
+		_spec.SetField(task.FieldSummaryJSON, field.TypeString, value)
+	}
+	if _u.mutation.SummaryJSONCleared() {
+		_spec.ClearField(task.FieldSummaryJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.PromptBudget(); ok {
+		_spec.SetField(task.FieldPromptBudget, field.TypeString, value)
+	}
+	if _u.mutation.PromptBudgetCleared() {
+		_spec.ClearField(task.FieldPromptBudget, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProgressChunk(); ok {
+		_spec.SetField(task.FieldProgressChunk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressChunk(); ok {
+		_spec.AddField(task.FieldProgressChunk, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressChunks(); ok {
+		_spec.SetField(task.FieldProgressChunks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressChunks(); ok {
+		_spec.AddField(task.FieldProgressChunks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressTokens(); ok {
+		_spec.SetField(task.FieldProgressTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressTokens(); ok {
+		_spec.AddField(task.FieldProgressTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(task.FieldKind, field.TypeEnum, value)
+	}
 	_node = &Task{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
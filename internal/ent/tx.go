@@ -12,14 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Blackout is the client for interacting with the Blackout builders.
+	Blackout *BlackoutClient
+	// Chat is the client for interacting with the Chat builders.
+	Chat *ChatClient
 	// DailyRun is the client for interacting with the DailyRun builders.
 	DailyRun *DailyRunClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// RunLog is the client for interacting with the RunLog builders.
+	RunLog *RunLogClient
+	// SentPart is the client for interacting with the SentPart builders.
+	SentPart *SentPartClient
+	// ShadowRun is the client for interacting with the ShadowRun builders.
+	ShadowRun *ShadowRunClient
+	// SharedLink is the client for interacting with the SharedLink builders.
+	SharedLink *SharedLinkClient
 	// Summary is the client for interacting with the Summary builders.
 	Summary *SummaryClient
+	// SummaryRevision is the client for interacting with the SummaryRevision builders.
+	SummaryRevision *SummaryRevisionClient
+	// SummaryView is the client for interacting with the SummaryView builders.
+	SummaryView *SummaryViewClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +171,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Blackout = NewBlackoutClient(tx.config)
+	tx.Chat = NewChatClient(tx.config)
 	tx.DailyRun = NewDailyRunClient(tx.config)
+	tx.Follow = NewFollowClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.RunLog = NewRunLogClient(tx.config)
+	tx.SentPart = NewSentPartClient(tx.config)
+	tx.ShadowRun = NewShadowRunClient(tx.config)
+	tx.SharedLink = NewSharedLinkClient(tx.config)
 	tx.Summary = NewSummaryClient(tx.config)
+	tx.SummaryRevision = NewSummaryRevisionClient(tx.config)
+	tx.SummaryView = NewSummaryViewClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DailyRun.QueryXXX(), the query will be executed
+// applies a query, for example: Blackout.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
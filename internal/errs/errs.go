@@ -0,0 +1,70 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 可按类别判断的错误，各模块返回的错误通过 %w 包装它们，调用方使用 errors.Is 或 Classify 判断
+var (
+	ErrLLMRateLimited = errors.New("LLM 请求频率超限")
+	ErrTelegramFlood  = errors.New("Telegram 发送频率超限")
+	ErrParse          = errors.New("解析 LLM 返回的 JSON 失败")
+	ErrCancelled      = errors.New("任务已取消")
+	ErrChatForbidden  = errors.New("无权在群组发送消息")
+)
+
+// Action 调度器对错误的处理方式
+type Action int
+
+const (
+	ActionRetry    Action = iota // 临时错误，按重试间隔重试
+	ActionDefer                  // 频率超限，等待服务端要求的时间（未知时使用更长的间隔）后重试
+	ActionFailFast               // 任务已取消，立即放弃，不再重试或降级
+	ActionSkip                   // 注定无法完成（如已被移出群组或禁言），不再重试，任务单独标记
+)
+
+// Classify 返回错误的处理方式
+func Classify(err error) Action {
+	switch {
+	case errors.Is(err, ErrCancelled), errors.Is(err, context.Canceled):
+		return ActionFailFast
+	case errors.Is(err, ErrChatForbidden):
+		return ActionSkip
+	case errors.Is(err, ErrLLMRateLimited), errors.Is(err, ErrTelegramFlood):
+		return ActionDefer
+	default:
+		return ActionRetry
+	}
+}
+
+// retryAfterError 附带服务端要求的等待时间的错误
+type retryAfterError struct {
+	kind  error
+	cause error
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return fmt.Sprintf("%v: %v", e.kind, e.cause)
+}
+
+func (e *retryAfterError) Unwrap() []error {
+	return []error{e.kind, e.cause}
+}
+
+// WithRetryAfter 将 cause 包装为 kind 类别的错误（同时匹配 kind 与 cause），附带服务端要求的等待时间；after 为 0 表示未知
+func WithRetryAfter(kind, cause error, after time.Duration) error {
+	return &retryAfterError{kind: kind, cause: cause, after: after}
+}
+
+// RetryAfter 返回错误链中服务端要求的等待时间，未知时返回 false
+func RetryAfter(err error) (time.Duration, bool) {
+	var e *retryAfterError
+	if errors.As(err, &e) && e.after > 0 {
+		return e.after, true
+	}
+	return 0, false
+}
@@ -0,0 +1,46 @@
+package errs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Action
+	}{
+		{"任务已取消", fmt.Errorf("查询失败: %w", ErrCancelled), ActionFailFast},
+		{"context 已取消", fmt.Errorf("LLM 总结失败: %w", context.Canceled), ActionFailFast},
+		{"LLM 频率超限", fmt.Errorf("调用 LLM API 失败: %w", WithRetryAfter(ErrLLMRateLimited, errors.New("429"), 0)), ActionDefer},
+		{"Telegram 频率超限", WithRetryAfter(ErrTelegramFlood, errors.New("429"), time.Second), ActionDefer},
+		{"解析失败", fmt.Errorf("%w: unexpected end of JSON input", ErrParse), ActionRetry},
+		{"无权在群组发言", fmt.Errorf("群组 -100: %w", ErrChatForbidden), ActionSkip},
+		{"其他错误", errors.New("connection reset"), ActionRetry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.err))
+		})
+	}
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	cause := errors.New("Too Many Requests")
+	err := fmt.Errorf("发送失败: %w", WithRetryAfter(ErrTelegramFlood, cause, 35*time.Second))
+	assert.ErrorIs(t, err, ErrTelegramFlood)
+	assert.ErrorIs(t, err, cause, "保留原始错误")
+
+	after, ok := RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, 35*time.Second, after)
+
+	_, ok = RetryAfter(WithRetryAfter(ErrLLMRateLimited, cause, 0))
+	assert.False(t, ok, "未知等待时间")
+}
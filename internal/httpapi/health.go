@@ -0,0 +1,26 @@
+package httpapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/scheduler"
+)
+
+// healthResponse 健康检查响应
+type healthResponse struct {
+	Status string                  `json:"status"`
+	Time   time.Time               `json:"time"`
+	Jobs   []scheduler.JobSchedule `json:"jobs"`
+}
+
+// HealthHandler 返回健康检查处理器，附带各定时任务的下次执行时间
+func HealthHandler(s *scheduler.Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, healthResponse{
+			Status: "ok",
+			Time:   time.Now().UTC(),
+			Jobs:   s.NextRuns(),
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package httpapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+)
+
+// LLMStatsProvider 提供各模型的请求统计（默认实现为 llm.Client）
+type LLMStatsProvider interface {
+	Stats() []llm.ModelStats
+}
+
+// llmStatsResponse LLM 请求统计响应
+type llmStatsResponse struct {
+	Time   time.Time        `json:"time"`
+	Models []llm.ModelStats `json:"models"`
+}
+
+// LLMStatsHandler 返回 LLM 请求统计处理器：各模型的请求数、失败数、token 用量及延迟分位数
+func LLMStatsHandler(provider LLMStatsProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, llmStatsResponse{
+			Time:   time.Now().UTC(),
+			Models: provider.Stats(),
+		})
+	}
+}
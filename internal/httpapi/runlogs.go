@@ -0,0 +1,113 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// runLogsResponse 运行时间线响应
+type runLogsResponse struct {
+	Events []runLogEvent `json:"events"`
+}
+
+// runLogEvent 一条运行事件，与内部存储格式解耦
+type runLogEvent struct {
+	Time      time.Time `json:"time"`
+	RunID     int       `json:"run_id"`
+	TaskID    int       `json:"task_id"`
+	ChatID    int64     `json:"chat_id"`
+	ChatTitle string    `json:"chat_title"` // 群聊名称，运行级事件或未记录名称时为空
+	Event     string    `json:"event"`
+	Detail    string    `json:"detail"`
+}
+
+// runLogsQuery 时间线查询条件：run_id 与 task_id 二选一
+type runLogsQuery struct {
+	runID  int
+	taskID int
+}
+
+// parseRunLogsQuery 解析查询参数：run_id 查询一次运行的全部事件，task_id 查询单个任务的事件
+func parseRunLogsQuery(r *http.Request) (runLogsQuery, error) {
+	var q runLogsQuery
+	values := r.URL.Query()
+	runID, taskID := values.Get("run_id"), values.Get("task_id")
+	if (runID == "") == (taskID == "") {
+		return q, fmt.Errorf("需要且只能指定 run_id 或 task_id 之一")
+	}
+
+	var err error
+	if runID != "" {
+		if q.runID, err = strconv.Atoi(runID); err != nil || q.runID <= 0 {
+			return q, fmt.Errorf("run_id 格式错误: %s", runID)
+		}
+	} else {
+		if q.taskID, err = strconv.Atoi(taskID); err != nil || q.taskID <= 0 {
+			return q, fmt.Errorf("task_id 格式错误: %s", taskID)
+		}
+	}
+	return q, nil
+}
+
+// RunLogsHandler 返回运行时间线处理器：按 DailyRun 或任务列出已记录的生命周期事件，供仪表盘展示
+func RunLogsHandler(runLogModel *model.RunLogModel, chatModel *model.ChatModel, token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			WriteJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "仅支持 GET"})
+			return
+		}
+		if !authorized(r, token) {
+			WriteJSON(w, http.StatusUnauthorized, errorResponse{Error: "未授权"})
+			return
+		}
+		q, err := parseRunLogsQuery(r)
+		if err != nil {
+			WriteJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+
+		var logs []*ent.RunLog
+		if q.runID != 0 {
+			logs, err = runLogModel.ListByRun(r.Context(), q.runID)
+		} else {
+			logs, err = runLogModel.ListByTask(r.Context(), q.taskID)
+		}
+		if err != nil {
+			logger.Errorf("[HTTP] 查询运行事件失败: %v", err)
+			WriteJSON(w, http.StatusInternalServerError, errorResponse{Error: "查询失败"})
+			return
+		}
+
+		chatIDs := make([]int64, 0, len(logs))
+		for _, l := range logs {
+			if l.ChatID != 0 {
+				chatIDs = append(chatIDs, l.ChatID)
+			}
+		}
+		titles, err := chatModel.Titles(r.Context(), chatIDs)
+		if err != nil {
+			// 名称只用于展示，查询失败时仍返回事件
+			logger.Warnf("[HTTP] 查询群聊名称失败: %v", err)
+		}
+
+		events := make([]runLogEvent, 0, len(logs))
+		for _, l := range logs {
+			events = append(events, runLogEvent{
+				Time:      l.CreateTime.UTC(),
+				RunID:     l.RunID,
+				TaskID:    l.TaskID,
+				ChatID:    l.ChatID,
+				ChatTitle: titles[l.ChatID],
+				Event:     string(l.Event),
+				Detail:    l.Detail,
+			})
+		}
+		WriteJSON(w, http.StatusOK, runLogsResponse{Events: events})
+	}
+}
@@ -0,0 +1,37 @@
+package httpapi
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRunLogsQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    runLogsQuery
+		wantErr string
+	}{
+		{"按运行查询", "run_id=3", runLogsQuery{runID: 3}, ""},
+		{"按任务查询", "task_id=12", runLogsQuery{taskID: 12}, ""},
+		{"未指定", "", runLogsQuery{}, "run_id"},
+		{"同时指定", "run_id=3&task_id=12", runLogsQuery{}, "run_id"},
+		{"run_id 格式错误", "run_id=abc", runLogsQuery{}, "run_id"},
+		{"task_id 非正数", "task_id=0", runLogsQuery{}, "task_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := parseRunLogsQuery(httptest.NewRequest("GET", "/v1/runlogs?"+tt.query, nil))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q)
+		})
+	}
+}
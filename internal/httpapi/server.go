@@ -0,0 +1,58 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+type Server struct {
+	server *http.Server
+	mux    *http.ServeMux
+}
+
+func NewServer(cfg *config.HTTPServer) *Server {
+	mux := http.NewServeMux()
+	return &Server{
+		server: &http.Server{
+			Addr:              cfg.Addr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		mux: mux,
+	}
+}
+
+// HandleFunc 注册路由
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, handler)
+}
+
+// Start 在后台启动 HTTP 服务
+func (s *Server) Start() {
+	go func() {
+		logger.Infof("[HTTP] 服务已启动，监听地址: %s", s.server.Addr)
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("[HTTP] 服务异常退出: %v", err)
+		}
+	}()
+}
+
+// Stop 关闭 HTTP 服务
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// WriteJSON 以 JSON 格式写入响应
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Warnf("[HTTP] 写入响应失败: %v", err)
+	}
+}
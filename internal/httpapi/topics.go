@@ -0,0 +1,207 @@
+package httpapi
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/storage"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+const (
+	// TopicsSchemaVersion /v1/topics 响应格式版本：只新增字段时不变，删除或修改已有字段时递增并使用新的路径
+	TopicsSchemaVersion = 1
+
+	maxTopicsRangeDays = 31 // 单次查询的最大天数
+)
+
+// topicsResponse 话题导出响应
+type topicsResponse struct {
+	SchemaVersion int            `json:"schema_version"`
+	GeneratedAt   time.Time      `json:"generated_at"`
+	Summaries     []topicSummary `json:"summaries"`
+}
+
+// topicSummary 一次总结任务的话题，与内部存储格式解耦
+type topicSummary struct {
+	TaskID           int          `json:"task_id"`
+	ChatID           int64        `json:"chat_id"`
+	ChatTitle        string       `json:"chat_title"`
+	ChatUsername     string       `json:"chat_username"`     // 公开群组的用户名（含 "@"），私有群组为空字符串
+	ChatMemberCount  int          `json:"chat_member_count"` // 最近一次刷新群聊信息时的成员数，未刷新时为 0
+	StartTime        time.Time    `json:"start_time"`
+	EndTime          time.Time    `json:"end_time"`
+	MessageCount     int          `json:"message_count"`
+	ParticipantCount int          `json:"participant_count"`
+	Topics           []topicEntry `json:"topics"`
+}
+
+type topicEntry struct {
+	Title string      `json:"title"`
+	Items []topicItem `json:"items"`
+}
+
+type topicItem struct {
+	SenderName  string  `json:"sender_name"`
+	Description string  `json:"description"`
+	MessageIDs  []int64 `json:"message_ids"` // t.me 链接用消息ID
+	Quote       string  `json:"quote"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// topicsQuery 话题导出的查询条件：开始时间在 [start, end) 内的任务，chatID 为 0 表示所有群组
+type topicsQuery struct {
+	chatID int64
+	start  time.Time
+	end    time.Time
+}
+
+// parseTopicsQuery 解析查询参数：from、to 为 UTC 日期（YYYY-MM-DD，含两端），to 默认等于 from；chat_id 可选
+func parseTopicsQuery(r *http.Request) (topicsQuery, error) {
+	var q topicsQuery
+	values := r.URL.Query()
+
+	if s := values.Get("chat_id"); s != "" {
+		chatID, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return q, fmt.Errorf("chat_id 格式错误: %s", s)
+		}
+		q.chatID = chatID
+	}
+
+	from := values.Get("from")
+	if from == "" {
+		return q, fmt.Errorf("缺少 from 参数")
+	}
+	start, err := time.Parse(time.DateOnly, from)
+	if err != nil {
+		return q, fmt.Errorf("from 格式错误，应为 YYYY-MM-DD: %s", from)
+	}
+	end := start
+	if to := values.Get("to"); to != "" {
+		if end, err = time.Parse(time.DateOnly, to); err != nil {
+			return q, fmt.Errorf("to 格式错误，应为 YYYY-MM-DD: %s", to)
+		}
+	}
+	if end.Before(start) {
+		return q, fmt.Errorf("to 不能早于 from")
+	}
+	if days := int(end.Sub(start).Hours()/24) + 1; days > maxTopicsRangeDays {
+		return q, fmt.Errorf("单次最多查询 %d 天", maxTopicsRangeDays)
+	}
+
+	q.start, q.end = start, end.AddDate(0, 0, 1)
+	return q, nil
+}
+
+// toTopicSummary 将任务保存的结构化总结结果转换为导出格式，c 为 nil 表示群聊未记录
+func toTopicSummary(t *ent.Task, c *ent.Chat) (topicSummary, error) {
+	var result summarizer.SummaryResult
+	if err := json.Unmarshal([]byte(t.SummaryJSON), &result); err != nil {
+		return topicSummary{}, err
+	}
+
+	s := topicSummary{
+		TaskID:           t.ID,
+		ChatID:           t.ChatID,
+		StartTime:        t.StartTime.UTC(),
+		EndTime:          t.EndTime.UTC(),
+		MessageCount:     t.MessageCount,
+		ParticipantCount: t.ParticipantCount,
+		Topics:           make([]topicEntry, 0, len(result.Topics)),
+	}
+	if c != nil {
+		s.ChatTitle, s.ChatUsername, s.ChatMemberCount = c.Title, c.Username, c.MemberCount
+	}
+	for _, topic := range result.Topics {
+		entry := topicEntry{Title: topic.Title, Items: make([]topicItem, 0, len(topic.Items))}
+		for _, item := range topic.Items {
+			messageIDs := item.MessageIDs
+			if messageIDs == nil {
+				messageIDs = []int64{}
+			}
+			entry.Items = append(entry.Items, topicItem{
+				SenderName:  item.SenderName,
+				Description: item.Description,
+				MessageIDs:  messageIDs,
+				Quote:       item.Quote,
+			})
+		}
+		s.Topics = append(s.Topics, entry)
+	}
+	return s, nil
+}
+
+// authorized 校验 Bearer Token，未配置 Token 时不校验
+func authorized(r *http.Request, token string) bool {
+	if token == "" {
+		return true
+	}
+	got := r.Header.Get("Authorization")
+	return subtle.ConstantTimeCompare([]byte(got), []byte("Bearer "+token)) == 1
+}
+
+// TopicsHandler 返回话题导出处理器：按群组和日期导出已保存的结构化话题，供 BI 等分析工具使用
+func TopicsHandler(taskModel storage.TaskStore, chatModel *model.ChatModel, token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			WriteJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "仅支持 GET"})
+			return
+		}
+		if !authorized(r, token) {
+			WriteJSON(w, http.StatusUnauthorized, errorResponse{Error: "未授权"})
+			return
+		}
+		q, err := parseTopicsQuery(r)
+		if err != nil {
+			WriteJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+
+		var tasks []*ent.Task
+		if q.chatID != 0 {
+			tasks, err = taskModel.GetSummarizedByChatAndRange(r.Context(), q.chatID, q.start, q.end)
+		} else {
+			tasks, err = taskModel.GetSummarizedByRange(r.Context(), q.start, q.end)
+		}
+		if err != nil {
+			logger.Errorf("[HTTP] 查询话题失败: %v", err)
+			WriteJSON(w, http.StatusInternalServerError, errorResponse{Error: "查询失败"})
+			return
+		}
+
+		chats := make(map[int64]*ent.Chat)
+		summaries := make([]topicSummary, 0, len(tasks))
+		for _, t := range tasks {
+			c, ok := chats[t.ChatID]
+			if !ok {
+				if c, err = chatModel.Get(r.Context(), t.ChatID); err != nil {
+					logger.Warnf("[HTTP] 查询群聊信息失败, id: %d, %v", t.ChatID, err)
+				}
+				chats[t.ChatID] = c
+			}
+			s, err := toTopicSummary(t, c)
+			if err != nil {
+				logger.Warnf("[HTTP] 解析任务 %d 的总结结果失败: %v", t.ID, err)
+				continue
+			}
+			summaries = append(summaries, s)
+		}
+
+		WriteJSON(w, http.StatusOK, topicsResponse{
+			SchemaVersion: TopicsSchemaVersion,
+			GeneratedAt:   time.Now().UTC(),
+			Summaries:     summaries,
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopicsQuery(t *testing.T) {
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		query   string
+		want    topicsQuery
+		wantErr string
+	}{
+		{"单日", "from=2025-02-10", topicsQuery{start: day, end: day.AddDate(0, 0, 1)}, ""},
+		{"日期区间含两端", "from=2025-02-10&to=2025-02-12&chat_id=-100", topicsQuery{chatID: -100, start: day, end: day.AddDate(0, 0, 3)}, ""},
+		{"缺少 from", "chat_id=-100", topicsQuery{}, "from"},
+		{"日期格式错误", "from=2025/02/10", topicsQuery{}, "from"},
+		{"to 早于 from", "from=2025-02-10&to=2025-02-09", topicsQuery{}, "to"},
+		{"超过最大天数", "from=2025-01-01&to=2025-02-10", topicsQuery{}, "31"},
+		{"chat_id 格式错误", "from=2025-02-10&chat_id=abc", topicsQuery{}, "chat_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := parseTopicsQuery(httptest.NewRequest("GET", "/v1/topics?"+tt.query, nil))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q)
+		})
+	}
+}
+
+func TestToTopicSummary(t *testing.T) {
+	task := &ent.Task{
+		ID:           7,
+		ChatID:       -100,
+		StartTime:    time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC),
+		EndTime:      time.Date(2025, 2, 11, 0, 0, 0, 0, time.UTC),
+		MessageCount: 42,
+		SummaryJSON:  `{"topics":[{"title":"发布计划","items":[{"sender_name":"Alice","description":"确定了发布时间"}]}]}`,
+	}
+	s, err := toTopicSummary(task, &ent.Chat{ChatID: -100, Title: "产品群", Username: "@product", MemberCount: 120})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"task_id": 7,
+		"chat_id": -100,
+		"chat_title": "产品群",
+		"chat_username": "@product",
+		"chat_member_count": 120,
+		"start_time": "2025-02-10T00:00:00Z",
+		"end_time": "2025-02-11T00:00:00Z",
+		"message_count": 42,
+		"participant_count": 0,
+		"topics": [{"title": "发布计划", "items": [{"sender_name": "Alice", "description": "确定了发布时间", "message_ids": [], "quote": ""}]}]
+	}`, string(data), "导出格式固定，空字段输出零值")
+
+	s, err = toTopicSummary(&ent.Task{SummaryJSON: "{}"}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, s.ChatTitle, "群聊未记录时名称为空")
+
+	_, err = toTopicSummary(&ent.Task{SummaryJSON: "{"}, nil)
+	assert.Error(t, err)
+}
+
+func TestAuthorized(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/topics", nil)
+	assert.True(t, authorized(r, ""), "未配置 Token 时不校验")
+	assert.False(t, authorized(r, "secret"))
+
+	r.Header.Set("Authorization", "Bearer secret")
+	assert.True(t, authorized(r, "secret"))
+	assert.False(t, authorized(r, "other"))
+}
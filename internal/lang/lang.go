@@ -0,0 +1,169 @@
+// Package lang 基于文字系统（script）的轻量语言识别，用于统计群聊语言分布及选择总结语言。
+// 只区分文字系统不同的语言；拉丁字母的文本按常用虚词及变音符区分英语与其他语言，其他拉丁字母语言不识别。
+package lang
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// names 支持的语言代码 => 语言名称（用于提示 LLM 输出语言）
+var names = map[string]string{
+	"zh": "中文",
+	"en": "English",
+	"ja": "日本語",
+	"ko": "한국어",
+	"ru": "Русский",
+	"ar": "العربية",
+	"th": "ไทย",
+	"hi": "हिन्दी",
+}
+
+// scripts 文字系统 => 语言代码（日语由假名单独判断）
+var scripts = []struct {
+	table *unicode.RangeTable
+	code  string
+}{
+	{unicode.Han, "zh"},
+	{unicode.Hangul, "ko"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Thai, "th"},
+	{unicode.Devanagari, "hi"},
+}
+
+// englishWords 英语常用虚词，拉丁字母文本含这些词时识别为英语
+var englishWords = wordSet("the a an is are was be to of and or in on at for with it this that you i we they not do have will can")
+
+// otherLatinWords 法语、德语、西班牙语等其他拉丁字母语言的常用虚词（不含与英语相同的词），多于英语虚词时不识别为英语
+var otherLatinWords = wordSet("le la les un une des est et du de que qui pas je vous der die das und ist nicht ein eine ich du el los las es y por para con lo una il che di non sono e o")
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// Supported 是否为支持的语言代码
+func Supported(code string) bool {
+	_, ok := names[code]
+	return ok
+}
+
+// Name 返回语言名称，不支持的代码原样返回
+func Name(code string) string {
+	if name, ok := names[code]; ok {
+		return name
+	}
+	return code
+}
+
+// Detect 识别文本的语言，返回语言代码；无法识别（如纯表情、数字、链接）时返回空字符串。
+// 拉丁字母按单词计数，其他文字按字符计数，避免中文消息中夹杂的英文术语主导结果；
+// 拉丁字母的单词只在文本像英语时计为英语（见 isEnglish），否则不计入任何语言
+func Detect(text string) string {
+	counts := make(map[string]int)
+	kana := 0
+	var words []string
+	for _, field := range strings.Fields(text) {
+		if strings.Contains(field, "://") {
+			continue
+		}
+		var word strings.Builder
+		for _, r := range field {
+			if unicode.Is(unicode.Latin, r) {
+				word.WriteRune(unicode.ToLower(r))
+				continue
+			}
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+			if unicode.In(r, unicode.Hiragana, unicode.Katakana) {
+				kana++
+				continue
+			}
+			for _, s := range scripts {
+				if unicode.Is(s.table, r) {
+					counts[s.code]++
+					break
+				}
+			}
+		}
+		if word.Len() > 0 {
+			words = append(words, word.String())
+		}
+	}
+	if isEnglish(words) {
+		counts["en"] = len(words)
+	}
+
+	// 含假名时汉字计入日语
+	if kana > 0 {
+		counts["ja"] = kana + counts["zh"]
+		delete(counts, "zh")
+	}
+
+	best, bestCount := "", 0
+	for _, code := range sortedCodes(counts) {
+		if counts[code] > bestCount {
+			best, bestCount = code, counts[code]
+		}
+	}
+	return best
+}
+
+// isEnglish 判断拉丁字母的单词（已转为小写）是否为英语：英语虚词多于其他语言的虚词时为英语；
+// 都不含时，没有带变音符的字母（如 é、ß）即视为英语，覆盖 "LGTM"、"PR merged" 等简短消息
+func isEnglish(words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	english, other, accented := 0, 0, false
+	for _, w := range words {
+		switch {
+		case englishWords[w]:
+			english++
+		case otherLatinWords[w]:
+			other++
+		}
+		for _, r := range w {
+			if r > unicode.MaxASCII {
+				accented = true
+				break
+			}
+		}
+	}
+	if english > 0 || other > 0 {
+		return english > other
+	}
+	return !accented
+}
+
+// Dominant 返回占比最高的语言及其占比，counts 为空时返回空字符串
+func Dominant(counts map[string]int) (code string, share float64) {
+	total, bestCount := 0, 0
+	for _, c := range sortedCodes(counts) {
+		total += counts[c]
+		if counts[c] > bestCount {
+			code, bestCount = c, counts[c]
+		}
+	}
+	if total == 0 {
+		return "", 0
+	}
+	return code, float64(bestCount) / float64(total)
+}
+
+// sortedCodes 按代码排序，保证计数相同时结果稳定
+func sortedCodes(counts map[string]int) []string {
+	codes := make([]string, 0, len(counts))
+	for code := range counts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,57 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"中文", "今天的会议改到下午三点", "zh"},
+		{"英文", "please review the PR before merging", "en"},
+		{"中文夹杂英文术语", "部署到 kubernetes 集群了", "zh"},
+		{"英文夹杂少量中文", "thanks for the quick fix, 谢谢", "en"},
+		{"日文", "今日はいい天気ですね", "ja"},
+		{"韩文", "오늘 회의는 취소되었습니다", "ko"},
+		{"俄文", "Привет, как дела", "ru"},
+		{"英文缩写", "LGTM", "en"},
+		{"法文", "très bien merci", ""},
+		{"西班牙文", "gracias por la ayuda", ""},
+		{"德文", "das ist gut", ""},
+		{"英文夹杂外语", "this is a déjà vu", "en"},
+		{"中文夹杂外文", "他说 das ist gut 然后走了", "zh"},
+		{"忽略链接", "https://example.com/path 看看这个", "zh"},
+		{"纯数字和表情", "123 👍", ""},
+		{"空文本", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Detect(tt.text))
+		})
+	}
+}
+
+func TestDominant(t *testing.T) {
+	code, share := Dominant(map[string]int{"zh": 30, "en": 70})
+	assert.Equal(t, "en", code)
+	assert.InDelta(t, 0.7, share, 1e-9)
+
+	code, share = Dominant(nil)
+	assert.Empty(t, code)
+	assert.Zero(t, share)
+
+	code, _ = Dominant(map[string]int{"zh": 5, "en": 5})
+	assert.Equal(t, "en", code, "计数相同时按代码排序取第一个")
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "English", Name("en"))
+	assert.Equal(t, "xx", Name("xx"))
+	assert.True(t, Supported("zh"))
+	assert.False(t, Supported("auto"))
+}
@@ -0,0 +1,70 @@
+package llm
+
+import (
+	"context"
+	"sync"
+)
+
+// PromptBudget 累计总结请求的 prompt token 构成，可通过 WithPromptBudget 绑定到 context 上按群组统计
+type PromptBudget struct {
+	mu             sync.Mutex
+	chunks         int
+	messageTokens  int
+	overheadTokens int
+	promptTokens   int
+}
+
+// PromptBudgetSnapshot PromptBudget 的只读快照
+type PromptBudgetSnapshot struct {
+	Chunks         int // 总结请求数，消息过长时每个 chunk 一次
+	MessageTokens  int // 各请求中消息内容的估算 tokens
+	OverheadTokens int // 各请求中 system prompt、上一轮话题总结等非消息内容的估算 tokens
+	PromptTokens   int // API 返回的实际输入 tokens
+}
+
+func (b *PromptBudget) addChunk(messageTokens, overheadTokens int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.chunks++
+	b.messageTokens += messageTokens
+	b.overheadTokens += overheadTokens
+}
+
+func (b *PromptBudget) addPromptTokens(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.promptTokens += n
+}
+
+// Snapshot 返回当前累计的构成
+func (b *PromptBudget) Snapshot() PromptBudgetSnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return PromptBudgetSnapshot{
+		Chunks:         b.chunks,
+		MessageTokens:  b.messageTokens,
+		OverheadTokens: b.overheadTokens,
+		PromptTokens:   b.promptTokens,
+	}
+}
+
+type promptBudgetKey struct{}
+
+// WithPromptBudget 返回绑定了 prompt 构成统计的 context，之后经此 context 发起的总结请求都会计入 b
+func WithPromptBudget(ctx context.Context, b *PromptBudget) context.Context {
+	return context.WithValue(ctx, promptBudgetKey{}, b)
+}
+
+// promptBudgetFromContext 取出 context 上绑定的 prompt 构成统计，未绑定返回 nil
+func promptBudgetFromContext(ctx context.Context) *PromptBudget {
+	b, _ := ctx.Value(promptBudgetKey{}).(*PromptBudget)
+	return b
+}
+
+// EstimateMessageTokens 估算消息转为 prompt 文本（不附带发送时间）后的 token 数
+func EstimateMessageTokens(msgs []ChatMessage) int {
+	if len(msgs) == 0 {
+		return 0
+	}
+	return estimateTokens(messagesToPromptText(msgs, promptFormat{}))
+}
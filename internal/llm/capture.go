@@ -0,0 +1,135 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/sashabaranov/go-openai"
+)
+
+// 脱敏规则：API Key、邮箱、手机号等个人信息替换为占位符。
+// 手机号只匹配带国际区号（+86 138-0013-8000）或按 3-4-4 位分组（138 0013 8000）的写法，
+// 不匹配请求中常见的消息ID、群组ID、Unix 时间戳等连续数字
+var (
+	apiKeyPattern = regexp.MustCompile(`\b(sk|pk|rk)-[A-Za-z0-9_\-]{16,}\b|(?i)\bBearer\s+[A-Za-z0-9._\-]{16,}`)
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	phonePattern  = regexp.MustCompile(`\+\d{1,3}(?:[ \-]?\d{2,4}){2,5}\b|\b\d{3,4}[ \-]\d{3,4}[ \-]\d{4}\b`)
+)
+
+var (
+	// captureSeq 同一毫秒内多次保存时区分文件名
+	captureSeq atomic.Int64
+	// captureMu 串行化写入和清理，避免并发请求重复删除文件
+	captureMu sync.Mutex
+)
+
+// capturedMessage 保存的请求消息
+type capturedMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// capturedExchange 一次 LLM 请求及响应的脱敏记录
+type capturedExchange struct {
+	Time             time.Time         `json:"time"`
+	Model            string            `json:"model"`
+	LatencyMs        int64             `json:"latency_ms"`
+	Error            string            `json:"error,omitempty"`
+	Request          []capturedMessage `json:"request"`
+	Response         string            `json:"response,omitempty"`
+	PromptTokens     int               `json:"prompt_tokens"`
+	CompletionTokens int               `json:"completion_tokens"`
+}
+
+// capture 将请求和响应脱敏、截断后保存为 JSON 文件，未配置 Capture.Dir 时不保存；保存失败只记录日志
+func (c *Client) capture(req openai.ChatCompletionRequest, resp openai.ChatCompletionResponse, latency time.Duration, err error) {
+	cfg := c.config.Capture
+	if cfg.Dir == "" {
+		return
+	}
+
+	exchange := capturedExchange{
+		Time:             time.Now().UTC(),
+		Model:            req.Model,
+		LatencyMs:        latency.Milliseconds(),
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+	}
+	if err != nil {
+		exchange.Error = c.redact(err.Error(), cfg.MaxBytes)
+	}
+	for _, msg := range req.Messages {
+		exchange.Request = append(exchange.Request, capturedMessage{Role: msg.Role, Content: c.redact(msg.Content, cfg.MaxBytes)})
+	}
+	if len(resp.Choices) > 0 {
+		exchange.Response = c.redact(resp.Choices[0].Message.Content, cfg.MaxBytes)
+	}
+
+	data, err := json.MarshalIndent(exchange, "", "  ")
+	if err != nil {
+		logger.Warnf("[LLM] 序列化请求记录失败: %v", err)
+		return
+	}
+	captureMu.Lock()
+	defer captureMu.Unlock()
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		logger.Warnf("[LLM] 创建请求记录目录失败: %v", err)
+		return
+	}
+	name := fmt.Sprintf("%s-%06d.json", exchange.Time.Format("20060102T150405.000"), captureSeq.Add(1))
+	if err := os.WriteFile(filepath.Join(cfg.Dir, name), data, 0600); err != nil {
+		logger.Warnf("[LLM] 保存请求记录失败: %v", err)
+		return
+	}
+	pruneCaptures(cfg.Dir, cfg.MaxFiles)
+}
+
+// redact 脱敏并截断到最多 maxBytes 字节（maxBytes 为 0 时不截断）
+func (c *Client) redact(text string, maxBytes int) string {
+	if c.config.APIKey != "" {
+		text = strings.ReplaceAll(text, c.config.APIKey, "[API_KEY]")
+	}
+	text = apiKeyPattern.ReplaceAllString(text, "[API_KEY]")
+	text = emailPattern.ReplaceAllString(text, "[EMAIL]")
+	text = phonePattern.ReplaceAllString(text, "[PHONE]")
+
+	if maxBytes <= 0 || len(text) <= maxBytes {
+		return text
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8RuneStart(text[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s…[已截断 %d 字节]", text[:cut], len(text)-cut)
+}
+
+// utf8RuneStart 判断字节是否为 UTF-8 字符的首字节，截断时避免切开多字节字符
+func utf8RuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// pruneCaptures 保留最新的 maxFiles 个记录文件（文件名按时间排序），maxFiles 为 0 时不清理
+func pruneCaptures(dir string, maxFiles int) {
+	if maxFiles <= 0 {
+		return
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil || len(files) <= maxFiles {
+		return
+	}
+	sort.Strings(files)
+	for _, file := range files[:len(files)-maxFiles] {
+		if err := os.Remove(file); err != nil {
+			logger.Warnf("[LLM] 删除过期请求记录失败: %v", err)
+		}
+	}
+}
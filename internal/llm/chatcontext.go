@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"context"
+	"strings"
+)
+
+// chatContextMaxRunes 群聊背景各项的最大字数，超出部分截断，避免置顶长文占用过多 token
+const chatContextMaxRunes = 1000
+
+// ChatContext 群聊背景信息：群简介和当前置顶消息，帮助 LLM 理解群聊主题和领域术语
+type ChatContext struct {
+	Description   string
+	PinnedMessage string
+}
+
+type chatContextCtx struct{}
+
+// WithChatContext 附加群聊背景信息，总结时追加到 system prompt
+func WithChatContext(ctx context.Context, chatContext ChatContext) context.Context {
+	return context.WithValue(ctx, chatContextCtx{}, chatContext)
+}
+
+// chatContextSection 追加到 system prompt 的群聊背景，无背景信息时返回空字符串
+func chatContextSection(ctx context.Context) string {
+	chatContext, _ := ctx.Value(chatContextCtx{}).(ChatContext)
+	description := truncateRunes(strings.TrimSpace(chatContext.Description), chatContextMaxRunes)
+	pinned := truncateRunes(strings.TrimSpace(chatContext.PinnedMessage), chatContextMaxRunes)
+	if description == "" && pinned == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\n群聊背景（仅用于理解群聊主题和术语，不要作为话题输出）：")
+	if description != "" {
+		sb.WriteString("\n群简介：")
+		sb.WriteString(description)
+	}
+	if pinned != "" {
+		sb.WriteString("\n置顶消息：")
+		sb.WriteString(pinned)
+	}
+	return sb.String()
+}
+
+// truncateRunes 截断到最多 n 个字符，截断时追加省略号
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
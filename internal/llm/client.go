@@ -3,11 +3,14 @@ package llm
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
 	"github.com/sashabaranov/go-openai"
 )
@@ -21,6 +24,8 @@ type Client struct {
 	config         *config.LLM
 	openaiClient   openAIClientInterface
 	maxInputTokens int
+	stats          statsRecorder
+	location       *time.Location
 }
 
 func NewClient(cfg *config.LLM) *Client {
@@ -36,6 +41,19 @@ func NewClient(cfg *config.LLM) *Client {
 	return client
 }
 
+// SetLocation 设置 prompt 中消息时间使用的时区，未设置时使用 UTC
+func (c *Client) SetLocation(loc *time.Location) {
+	c.location = loc
+}
+
+// requestTimeout 返回单次总结请求的超时时间，未配置时为 5 分钟
+func (c *Client) requestTimeout() time.Duration {
+	if c.config.RequestTimeout > 0 {
+		return time.Duration(c.config.RequestTimeout) * time.Second
+	}
+	return 5 * time.Minute
+}
+
 // estimateTokens 估算文本的 token 数量
 func estimateTokens(text string) int {
 	// 简单估算：中文约 1.5 token/字，英文约 1.3 token/词
@@ -65,12 +83,34 @@ func estimateTokens(text string) int {
 	return tokens
 }
 
+// classifyAPIError 将请求频率超限（HTTP 429）的错误包装为 errs.ErrLLMRateLimited
+func classifyAPIError(err error) error {
+	var apiErr *openai.APIError
+	var reqErr *openai.RequestError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusTooManyRequests ||
+		errors.As(err, &reqErr) && reqErr.HTTPStatusCode == http.StatusTooManyRequests {
+		return errs.WithRetryAfter(errs.ErrLLMRateLimited, err, 0)
+	}
+	return err
+}
+
+// estimateCost 按配置的单价估算一次请求的费用
+func (c *Client) estimateCost(usage openai.Usage) float64 {
+	return (float64(usage.PromptTokens)*c.config.PromptPrice + float64(usage.CompletionTokens)*c.config.CompletionPrice) / 1e6
+}
+
 // ChatMessage 群聊单条消息
 type ChatMessage struct {
 	MessageID  int64
 	SenderID   int64
 	SenderName string
 	Text       string
+	SentAt     time.Time // 发送时间，为零值时 prompt 中不附带时间
+	ThreadID   int64     // 论坛话题的消息线程ID，非 0 时 prompt 中附带 "#线程ID"
+	// 被回复的消息（ID 与 MessageID 的编号方式相同）及其发言者名，ReplyToID 为 0 表示未回复或被回复的消息不在本次输入范围内
+	ReplyToID   int64
+	ReplyToName string
+	Reactions   int64 // 回应（Reaction）总数，不写入每条消息的 prompt，回应最多的消息经 WithHighlights 单独列出
 }
 
 // topicsSummaryJSON 用于解析 LLM 返回的话题分组 JSON
@@ -89,17 +129,75 @@ type topicSubItemJSON struct {
 	MessageIDs  []int64 `json:"message_ids"`
 }
 
-// messagesToPromptText 将消息数组转为 prompt 文本，格式为每行 "[发送者名|msg_id] 消息内容"
-func messagesToPromptText(msgs []ChatMessage) string {
+// promptTimeLayouts 各时间精度对应的格式，总结区间可能跨天，因此均包含日期
+var promptTimeLayouts = map[string]string{
+	"minute": "01-02 15:04",
+	"hour":   "01-02 15:00",
+}
+
+// promptFormat 消息转为 prompt 文本的格式，零值表示不附带时间
+type promptFormat struct {
+	timeLayout string
+	location   *time.Location
+}
+
+// promptFormat 按配置的时间精度返回 prompt 格式
+func (c *Client) promptFormat() promptFormat {
+	loc := c.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return promptFormat{timeLayout: promptTimeLayouts[c.config.PromptTimestamps], location: loc}
+}
+
+// line 将单条消息转为 prompt 行，格式为 "[发送者名|msg_id] 消息内容" 或 "[发送者名|msg_id|发送时间] 消息内容"；
+// 论坛话题中的消息在标记末尾附带 "|#线程ID"，回复消息在内容前附带 "回复 [被回复者名|msg_id] "
+func (f promptFormat) line(m ChatMessage) string {
+	label := fmt.Sprintf("%s|%d", m.SenderName, m.MessageID)
+	if f.timeLayout != "" && !m.SentAt.IsZero() {
+		label += "|" + m.SentAt.In(f.location).Format(f.timeLayout)
+	}
+	if m.ThreadID != 0 {
+		label += fmt.Sprintf("|#%d", m.ThreadID)
+	}
+	if m.ReplyToID != 0 {
+		return fmt.Sprintf("[%s] 回复 [%s|%d] %s", label, m.ReplyToName, m.ReplyToID, m.Text)
+	}
+	return "[" + label + "] " + m.Text
+}
+
+// inputFormatInstruction 返回 system prompt 中对输入格式的说明
+func (f promptFormat) inputFormatInstruction() string {
+	if f.timeLayout == "" {
+		return `输入格式为每行 "[发言者名|消息ID] 消息内容"。` + forumThreadInstruction + replyInstruction + sharedContentInstruction
+	}
+	return fmt.Sprintf(`输入格式为每行 "[发言者名|消息ID|发送时间] 消息内容"，发送时间格式为 %s（%s）。`+
+		"可结合发送时间描述讨论的先后顺序，如「上午讨论了A，下午转向B」。", f.timeLayout, f.location) + forumThreadInstruction + replyInstruction + sharedContentInstruction
+}
+
+// forumThreadInstruction 说明论坛话题标记：开启了 Topics 的群组中，消息按所属论坛话题分组排列
+const forumThreadInstruction = "标记末尾的「#数字」表示消息所属的论坛话题，同一论坛话题的消息排列在一起，" +
+	"不同论坛话题中的讨论通常相互独立，不要仅因时间相近而合并。"
+
+// replyInstruction 说明回复标记：回复链中的消息通常属于同一讨论，据此归类可避免把回答归到错误的话题
+const replyInstruction = "消息内容前的「回复 [发言者名|消息ID]」表示该消息回复了对应的消息，" +
+	"请据此判断回答属于哪个讨论，不要仅因时间相近就将其归入其他话题。"
+
+// sharedContentInstruction 说明分享类消息（由 Telegram 的位置、地点、联系人、投票消息转换而来）的含义
+const sharedContentInstruction = "以「分享了位置」「分享了地点」「分享了联系人」「发起了投票」开头的消息表示发言者分享了对应内容，" +
+	"如与集合地点、活动安排等讨论相关，请在对应话题中体现。"
+
+// messagesToPromptText 将消息数组转为 prompt 文本，每条消息一行
+func messagesToPromptText(msgs []ChatMessage, format promptFormat) string {
 	lines := make([]string, len(msgs))
 	for i, m := range msgs {
-		lines[i] = fmt.Sprintf("[%s|%d] %s", m.SenderName, m.MessageID, m.Text)
+		lines[i] = format.line(m)
 	}
 	return strings.Join(lines, "\n")
 }
 
 // splitMessagesIntoChunks 将消息数组按 token 估算拆分为多个 chunk
-func splitMessagesIntoChunks(msgs []ChatMessage, maxTokensPerChunk int) [][]ChatMessage {
+func splitMessagesIntoChunks(msgs []ChatMessage, maxTokensPerChunk int, format promptFormat) [][]ChatMessage {
 	if len(msgs) == 0 {
 		return nil
 	}
@@ -108,8 +206,7 @@ func splitMessagesIntoChunks(msgs []ChatMessage, maxTokensPerChunk int) [][]Chat
 	currentTokens := 0
 
 	for _, m := range msgs {
-		line := fmt.Sprintf("[%s|%d] %s", m.SenderName, m.MessageID, m.Text)
-		tokens := estimateTokens(line)
+		tokens := estimateTokens(format.line(m))
 		if currentTokens+tokens > maxTokensPerChunk && len(current) > 0 {
 			chunks = append(chunks, current)
 			current = nil
@@ -141,9 +238,9 @@ func formatTopicsForContext(topics []topicItemJSON) string {
 }
 
 // mergeTopics 代码层兜底合并：将 partial 合并到 accumulated 中
-// 按 topic title 匹配，同一话题同一 sender 的 message_ids 取并集
+// 按 topic title 匹配（threshold > 0 时允许相似标题匹配），同一话题同一 sender 的 message_ids 取并集
 // 若旧话题在新结果中完全消失，原样保留
-func mergeTopics(accumulated, partial *topicsSummaryJSON) *topicsSummaryJSON {
+func mergeTopics(accumulated, partial *topicsSummaryJSON, threshold float64) *topicsSummaryJSON {
 	if accumulated == nil {
 		return partial
 	}
@@ -151,12 +248,6 @@ func mergeTopics(accumulated, partial *topicsSummaryJSON) *topicsSummaryJSON {
 		return accumulated
 	}
 
-	// 建立旧话题 title -> index 的映射
-	oldTopicMap := make(map[string]int)
-	for i, t := range accumulated.Topics {
-		oldTopicMap[t.Title] = i
-	}
-
 	// 用 accumulated 作为基础，逐个处理 partial 的话题
 	result := &topicsSummaryJSON{
 		Topics: make([]topicItemJSON, len(accumulated.Topics)),
@@ -164,8 +255,8 @@ func mergeTopics(accumulated, partial *topicsSummaryJSON) *topicsSummaryJSON {
 	copy(result.Topics, accumulated.Topics)
 
 	for _, pt := range partial.Topics {
-		if oldIdx, exists := oldTopicMap[pt.Title]; exists {
-			// 同名话题：按 sender_name 合并 items
+		if oldIdx := findMatchingTopic(result.Topics, pt.Title, threshold); oldIdx >= 0 {
+			// 同名或相似话题：按 sender_name 合并 items
 			result.Topics[oldIdx] = mergeTopicItems(result.Topics[oldIdx], pt)
 		} else {
 			// 新话题：直接追加
@@ -244,21 +335,34 @@ func (c *Client) SummarizeChat(ctx context.Context, messages []ChatMessage) (str
 	if len(messages) == 0 {
 		return "", nil
 	}
-	chatText := messagesToPromptText(messages)
+	if c.config.SummarizeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(c.config.SummarizeTimeout)*time.Second)
+		defer cancel()
+	}
+	format := c.promptFormat()
+	chatText := messagesToPromptText(messages, format)
 	tokens := estimateTokens(chatText)
 
 	if tokens <= c.maxInputTokens {
-		return c.summarizeChatOnce(ctx, chatText, "")
+		content, err := c.summarizeChatOnce(ctx, chatText, "")
+		if err != nil {
+			return "", err
+		}
+		reportProgress(ctx, Progress{Chunk: 1, Chunks: 1, Tokens: tokens, TotalTokens: tokens})
+		return content, nil
 	}
 
 	// Token 超限，采用优化版增量拼接
 	logger.Infof("[LLM] 群聊消息过长 (%d tokens)，将拆分为多个 chunk 进行总结", tokens)
-	chunks := splitMessagesIntoChunks(messages, c.maxInputTokens)
+	chunks := splitMessagesIntoChunks(messages, c.maxInputTokens, format)
 
 	var accumulated *topicsSummaryJSON
+	doneTokens := 0
+	reportProgress(ctx, Progress{Chunks: len(chunks), TotalTokens: tokens})
 	for i, chunkMsgs := range chunks {
 		logger.Debugf("[LLM] 处理 chunk %d/%d", i+1, len(chunks))
-		chunkText := messagesToPromptText(chunkMsgs)
+		chunkText := messagesToPromptText(chunkMsgs, format)
 
 		var prevTopics string
 		if accumulated != nil {
@@ -272,11 +376,17 @@ func (c *Client) SummarizeChat(ctx context.Context, messages []ChatMessage) (str
 
 		var partial topicsSummaryJSON
 		if err := json.Unmarshal([]byte(raw), &partial); err != nil {
-			return "", fmt.Errorf("解析 chunk %d 的 JSON 失败: %w", i+1, err)
+			return "", fmt.Errorf("chunk %d: %w: %w", i+1, errs.ErrParse, err)
 		}
 
 		// 代码层兜底合并
-		accumulated = mergeTopics(accumulated, &partial)
+		accumulated = mergeTopics(accumulated, &partial, c.config.TopicMergeThreshold)
+
+		doneTokens = min(doneTokens+estimateTokens(chunkText), tokens)
+		if i == len(chunks)-1 {
+			doneTokens = tokens // 各 chunk 分别估算，与整体估算略有出入
+		}
+		reportProgress(ctx, Progress{Chunk: i + 1, Chunks: len(chunks), Tokens: doneTokens, TotalTokens: tokens})
 	}
 
 	data, _ := json.Marshal(accumulated)
@@ -285,12 +395,12 @@ func (c *Client) SummarizeChat(ctx context.Context, messages []ChatMessage) (str
 
 // summarizeChatOnce 执行一次群聊总结请求，返回 JSON 字符串
 func (c *Client) summarizeChatOnce(ctx context.Context, chunkContent, prevTopicsSummary string) (string, error) {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	systemPrompt := `你是一个专业的群聊总结助手。根据用户提供的群聊内容，按话题分组总结，输出严格的 JSON 格式。
 
-输入格式为每行 "[发言者名|消息ID] 消息内容"。
+%s
 
 输出要求：
 {
@@ -315,6 +425,8 @@ func (c *Client) summarizeChatOnce(ctx context.Context, chunkContent, prevTopics
 4. description 应具体描述该发言者的观点或贡献
 5. 话题数量控制在 5-15 个，按重要性排序
 6. 只输出 JSON，不要其他内容`
+	systemPrompt = fmt.Sprintf(systemPrompt, c.promptFormat().inputFormatInstruction())
+	systemPrompt += languageInstruction(ctx) + chatContextSection(ctx) + glossarySection(ctx) + highlightsSection(ctx)
 
 	userPrompt := chunkContent
 	if prevTopicsSummary != "" {
@@ -334,20 +446,43 @@ func (c *Client) summarizeChatOnce(ctx context.Context, chunkContent, prevTopics
 		Temperature: 0.3,
 		MaxTokens:   4000,
 	}
+	if b := promptBudgetFromContext(ctx); b != nil {
+		messageTokens := estimateTokens(chunkContent)
+		b.addChunk(messageTokens, max(estimateTokens(systemPrompt+"\n"+userPrompt)-messageTokens, 0))
+	}
+
+	content, err := c.complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+	content = strings.TrimSpace(content)
+	return content, nil
+}
 
+// complete 执行一次对话补全请求，记录统计、抓包和用量，返回去除首尾空白的回复内容
+func (c *Client) complete(ctx context.Context, req openai.ChatCompletionRequest) (string, error) {
+	start := time.Now()
 	resp, err := c.openaiClient.CreateChatCompletion(ctx, req)
+	latency := time.Since(start)
+	c.stats.record(req.Model, latency, resp.Usage, err)
+	c.capture(req, resp, latency, err)
 	if err != nil {
-		return "", fmt.Errorf("调用 LLM API 失败: %w", err)
+		return "", fmt.Errorf("调用 LLM API 失败: %w", classifyAPIError(err))
+	}
+
+	if u := usageFromContext(ctx); u != nil {
+		u.add(resp.Usage.PromptTokens, resp.Usage.CompletionTokens, c.estimateCost(resp.Usage))
+	}
+	if b := promptBudgetFromContext(ctx); b != nil {
+		b.addPromptTokens(resp.Usage.PromptTokens)
 	}
 
 	if len(resp.Choices) == 0 {
 		return "", fmt.Errorf("LLM API 返回空结果")
 	}
 
-	content := strings.TrimSpace(resp.Choices[0].Message.Content)
-	content = strings.TrimPrefix(content, "```json")
-	content = strings.TrimPrefix(content, "```")
-	content = strings.TrimSuffix(content, "```")
-	content = strings.TrimSpace(content)
-	return content, nil
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
 }
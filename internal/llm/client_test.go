@@ -6,11 +6,15 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
 	"github.com/sashabaranov/go-openai"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // mockOpenAIClient 模拟 OpenAI 客户端
@@ -70,13 +74,59 @@ func TestMessagesToPromptText(t *testing.T) {
 		{MessageID: 100, SenderID: 1, SenderName: "张三", Text: "你好"},
 		{MessageID: 101, SenderID: 2, SenderName: "李四", Text: "大家好"},
 	}
-	got := messagesToPromptText(msgs)
+	got := messagesToPromptText(msgs, promptFormat{})
 	assert.Contains(t, got, "[张三|100] 你好")
 	assert.Contains(t, got, "[李四|101] 大家好")
 }
 
+func TestMessagesToPromptText_Timestamps(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	sentAt := time.Date(2024, 3, 5, 6, 30, 0, 0, time.UTC)
+	msgs := []ChatMessage{
+		{MessageID: 100, SenderName: "张三", Text: "你好", SentAt: sentAt},
+		{MessageID: 101, SenderName: "李四", Text: "无时间"},
+	}
+
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{"按分钟", "minute", "[张三|100|03-05 14:30] 你好\n[李四|101] 无时间"},
+		{"按小时", "hour", "[张三|100|03-05 14:00] 你好\n[李四|101] 无时间"},
+		{"不附带时间", "none", "[张三|100] 你好\n[李四|101] 无时间"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{config: &config.LLM{PromptTimestamps: tt.config}}
+			c.SetLocation(shanghai)
+			assert.Equal(t, tt.want, messagesToPromptText(msgs, c.promptFormat()))
+		})
+	}
+}
+
+func TestMessagesToPromptText_ForumThread(t *testing.T) {
+	msgs := []ChatMessage{
+		{MessageID: 100, SenderName: "张三", Text: "发布改到周五", ThreadID: 7340032},
+		{MessageID: 101, SenderName: "李四", Text: "General 中的消息"},
+	}
+	assert.Equal(t, "[张三|100|#7340032] 发布改到周五\n[李四|101] General 中的消息", messagesToPromptText(msgs, promptFormat{}))
+
+	c := &Client{config: &config.LLM{PromptTimestamps: "minute"}}
+	msgs[0].SentAt = time.Date(2024, 3, 5, 6, 30, 0, 0, time.UTC)
+	assert.Equal(t, "[张三|100|03-05 06:30|#7340032] 发布改到周五", c.promptFormat().line(msgs[0]), "论坛话题标记在发送时间之后")
+}
+
+func TestMessagesToPromptText_Reply(t *testing.T) {
+	msgs := []ChatMessage{
+		{MessageID: 100, SenderName: "张三", Text: "周五能发布吗"},
+		{MessageID: 101, SenderName: "李四", Text: "可以", ReplyToID: 100, ReplyToName: "张三"},
+	}
+	assert.Equal(t, "[张三|100] 周五能发布吗\n[李四|101] 回复 [张三|100] 可以", messagesToPromptText(msgs, promptFormat{}))
+}
+
 func TestMessagesToPromptText_Empty(t *testing.T) {
-	got := messagesToPromptText(nil)
+	got := messagesToPromptText(nil, promptFormat{})
 	assert.Empty(t, got)
 }
 
@@ -116,7 +166,7 @@ func TestSplitMessagesIntoChunks(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			chunks := splitMessagesIntoChunks(tt.msgs, tt.maxTokensPerChunk)
+			chunks := splitMessagesIntoChunks(tt.msgs, tt.maxTokensPerChunk, promptFormat{})
 			if tt.wantChunks == 0 {
 				assert.Nil(t, chunks)
 				return
@@ -157,7 +207,7 @@ func TestMergeTopics(t *testing.T) {
 				{Title: "A", Items: []topicSubItemJSON{{SenderName: "X", Description: "d1", MessageIDs: []int64{1}}}},
 			},
 		}
-		result := mergeTopics(nil, partial)
+		result := mergeTopics(nil, partial, 0)
 		assert.Len(t, result.Topics, 1)
 		assert.Equal(t, "A", result.Topics[0].Title)
 	})
@@ -178,7 +228,7 @@ func TestMergeTopics(t *testing.T) {
 				}},
 			},
 		}
-		result := mergeTopics(accumulated, partial)
+		result := mergeTopics(accumulated, partial, 0)
 		assert.Len(t, result.Topics, 1)
 		// X 的 message_ids 应为 {1,2,3}（并集）
 		xItem := result.Topics[0].Items[0]
@@ -201,7 +251,7 @@ func TestMergeTopics(t *testing.T) {
 				{Title: "B", Items: []topicSubItemJSON{{SenderName: "Y", Description: "d2", MessageIDs: []int64{2}}}},
 			},
 		}
-		result := mergeTopics(accumulated, partial)
+		result := mergeTopics(accumulated, partial, 0)
 		assert.Len(t, result.Topics, 2)
 		assert.Equal(t, "A", result.Topics[0].Title)
 		assert.Equal(t, "B", result.Topics[1].Title)
@@ -219,13 +269,58 @@ func TestMergeTopics(t *testing.T) {
 				{Title: "A", Items: []topicSubItemJSON{{SenderName: "X", Description: "updated", MessageIDs: []int64{1, 3}}}},
 			},
 		}
-		result := mergeTopics(accumulated, partial)
+		result := mergeTopics(accumulated, partial, 0)
 		assert.Len(t, result.Topics, 2)
 		// B 应被保留
 		assert.Equal(t, "B", result.Topics[1].Title)
 	})
 }
 
+func TestMergeTopics_Similar(t *testing.T) {
+	accumulated := &topicsSummaryJSON{
+		Topics: []topicItemJSON{
+			{Title: "部署问题", Items: []topicSubItemJSON{{SenderName: "X", Description: "d1", MessageIDs: []int64{1}}}},
+		},
+	}
+	partial := &topicsSummaryJSON{
+		Topics: []topicItemJSON{
+			{Title: "部署相关问题", Items: []topicSubItemJSON{{SenderName: "X", Description: "d2", MessageIDs: []int64{2}}}},
+			{Title: "周末聚餐", Items: []topicSubItemJSON{{SenderName: "Y", Description: "d3", MessageIDs: []int64{3}}}},
+		},
+	}
+
+	t.Run("阈值为 0 仅精确匹配", func(t *testing.T) {
+		result := mergeTopics(accumulated, partial, 0)
+		assert.Len(t, result.Topics, 3)
+	})
+
+	t.Run("相似标题合并", func(t *testing.T) {
+		result := mergeTopics(accumulated, partial, 0.7)
+		assert.Len(t, result.Topics, 2)
+		assert.ElementsMatch(t, []int64{1, 2}, result.Topics[0].Items[0].MessageIDs)
+		assert.Equal(t, "周末聚餐", result.Topics[1].Title)
+	})
+}
+
+func TestTopicSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"完全相同", "部署问题", "部署问题", 1},
+		{"忽略空白标点大小写", "Go 版本升级！", "go版本升级", 1},
+		{"插入词", "部署问题", "部署相关问题", 0.8},
+		{"完全不同", "部署问题", "周末聚餐", 0},
+		{"空标题", "", "部署问题", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, topicSimilarity(tt.a, tt.b), 1e-9)
+		})
+	}
+}
+
 func TestMergeMessageIDs(t *testing.T) {
 	result := mergeMessageIDs([]int64{1, 2, 3}, []int64{2, 3, 4})
 	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, result)
@@ -296,6 +391,49 @@ func TestSummarizeChat_APIError(t *testing.T) {
 	assert.Contains(t, err.Error(), "调用 LLM API 失败")
 }
 
+func TestSummarizeChat_RateLimited(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
+		Return(openai.ChatCompletionResponse{}, &openai.APIError{HTTPStatusCode: 429, Message: "Rate limit reached"})
+
+	client := newTestClient(&config.LLM{Model: "test", MaxTokens: 10000}, mockAPI)
+	_, err := client.SummarizeChat(context.Background(), []ChatMessage{{MessageID: 1, SenderID: 1, SenderName: "A", Text: "test"}})
+	assert.ErrorIs(t, err, errs.ErrLLMRateLimited)
+	var apiErr *openai.APIError
+	assert.ErrorAs(t, err, &apiErr, "保留原始错误")
+}
+
+func TestSummarizeChat_Timeouts(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.LLM
+		want time.Duration
+	}{
+		{"未配置时单次请求 5 分钟", config.LLM{}, 5 * time.Minute},
+		{"按配置的单次请求超时", config.LLM{RequestTimeout: 900}, 15 * time.Minute},
+		{"总结超时更短时以其为准", config.LLM{RequestTimeout: 900, SummarizeTimeout: 60}, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var remaining time.Duration
+			mockAPI := new(mockOpenAIClient)
+			mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					deadline, ok := args.Get(0).(context.Context).Deadline()
+					require.True(t, ok)
+					remaining = time.Until(deadline)
+				}).
+				Return(openai.ChatCompletionResponse{Choices: []openai.ChatCompletionChoice{{Message: openai.ChatCompletionMessage{Content: `{"topics":[]}`}}}}, nil)
+
+			tt.cfg.Model, tt.cfg.MaxTokens = "test", 10000
+			client := newTestClient(&tt.cfg, mockAPI)
+			_, err := client.SummarizeChat(context.Background(), []ChatMessage{{MessageID: 1, SenderID: 1, SenderName: "A", Text: "test"}})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want.Seconds(), remaining.Seconds(), 5)
+		})
+	}
+}
+
 func TestSummarizeChat_EmptyResponse(t *testing.T) {
 	mockAPI := new(mockOpenAIClient)
 	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
@@ -388,3 +526,212 @@ func TestSummarizeChat_TrimsMarkdownCodeBlock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, parsed.Topics, 1)
 }
+
+func TestSummarizeChat_RecordsUsage(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
+		Return(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{
+				{Message: openai.ChatCompletionMessage{Content: `{"topics":[]}`}},
+			},
+			Usage: openai.Usage{PromptTokens: 1000, CompletionTokens: 200, TotalTokens: 1200},
+		}, nil)
+
+	cfg := &config.LLM{Model: "test", MaxTokens: 10000, PromptPrice: 2, CompletionPrice: 10}
+	client := newTestClient(cfg, mockAPI)
+
+	usage := &Usage{}
+	ctx := WithUsage(context.Background(), usage)
+	msgs := []ChatMessage{{MessageID: 1, SenderID: 1, SenderName: "A", Text: "test"}}
+	_, err := client.SummarizeChat(ctx, msgs)
+	assert.NoError(t, err)
+	_, err = client.SummarizeChat(ctx, msgs)
+	assert.NoError(t, err)
+
+	snapshot := usage.Snapshot()
+	assert.Equal(t, 2, snapshot.Requests)
+	assert.Equal(t, 2000, snapshot.PromptTokens)
+	assert.Equal(t, 400, snapshot.CompletionTokens)
+	assert.Equal(t, 2400, snapshot.TotalTokens)
+	assert.InDelta(t, 0.008, snapshot.Cost, 1e-9)
+}
+
+func TestSummarizeChat_RecordsPromptBudget(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
+		Return(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{
+				{Message: openai.ChatCompletionMessage{Content: `{"topics":[]}`}},
+			},
+			Usage: openai.Usage{PromptTokens: 500},
+		}, nil)
+
+	cfg := &config.LLM{Model: "test", MaxTokens: 10000}
+	client := newTestClientWithMaxTokens(cfg, mockAPI, 30) // 很小，强制分块
+
+	budget := &PromptBudget{}
+	ctx := WithPromptBudget(context.Background(), budget)
+	msgs := []ChatMessage{
+		{MessageID: 100, SenderID: 1, SenderName: "A", Text: "第一条较长的中文消息内容"},
+		{MessageID: 200, SenderID: 2, SenderName: "B", Text: "第二条较长的中文消息内容"},
+	}
+	_, err := client.SummarizeChat(ctx, msgs)
+	require.NoError(t, err)
+
+	snapshot := budget.Snapshot()
+	assert.Equal(t, 2, snapshot.Chunks)
+	assert.Equal(t, EstimateMessageTokens(msgs[:1])+EstimateMessageTokens(msgs[1:]), snapshot.MessageTokens)
+	assert.Greater(t, snapshot.OverheadTokens, snapshot.MessageTokens, "system prompt 计入额外部分")
+	assert.Equal(t, 1000, snapshot.PromptTokens)
+}
+
+func TestSummarizeChat_ReportsProgress(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).
+		Return(openai.ChatCompletionResponse{
+			Choices: []openai.ChatCompletionChoice{
+				{Message: openai.ChatCompletionMessage{Content: `{"topics":[]}`}},
+			},
+		}, nil)
+
+	cfg := &config.LLM{Model: "test", MaxTokens: 10000}
+	client := newTestClientWithMaxTokens(cfg, mockAPI, 30) // 很小，强制分块
+
+	var reports []Progress
+	ctx := WithProgress(context.Background(), func(p Progress) { reports = append(reports, p) })
+	msgs := []ChatMessage{
+		{MessageID: 100, SenderID: 1, SenderName: "A", Text: "第一条较长的中文消息内容"},
+		{MessageID: 200, SenderID: 2, SenderName: "B", Text: "第二条较长的中文消息内容"},
+	}
+	_, err := client.SummarizeChat(ctx, msgs)
+	require.NoError(t, err)
+
+	total := EstimateMessageTokens(msgs)
+	require.Len(t, reports, 3, "开始时及每个 chunk 完成后各报告一次")
+	assert.Equal(t, Progress{Chunk: 0, Chunks: 2, Tokens: 0, TotalTokens: total}, reports[0])
+	assert.Equal(t, 1, reports[1].Chunk)
+	assert.Greater(t, reports[1].Tokens, 0)
+	assert.Less(t, reports[1].Tokens, total)
+	assert.Equal(t, Progress{Chunk: 2, Chunks: 2, Tokens: total, TotalTokens: total}, reports[2])
+
+	reports = nil
+	_, err = newTestClient(cfg, mockAPI).SummarizeChat(ctx, msgs)
+	require.NoError(t, err)
+	assert.Equal(t, []Progress{{Chunk: 1, Chunks: 1, Tokens: total, TotalTokens: total}}, reports, "未分块时完成后报告一次")
+}
+
+func TestSummarizeChat_Language(t *testing.T) {
+	jsonResp := `{"topics":[]}`
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.MatchedBy(func(req openai.ChatCompletionRequest) bool {
+		return strings.Contains(req.Messages[0].Content, "使用 English 输出")
+	})).Return(openai.ChatCompletionResponse{
+		Choices: []openai.ChatCompletionChoice{{Message: openai.ChatCompletionMessage{Content: jsonResp}}},
+	}, nil).Once()
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.MatchedBy(func(req openai.ChatCompletionRequest) bool {
+		return !strings.Contains(req.Messages[0].Content, "输出（sender_name 保持原样）")
+	})).Return(openai.ChatCompletionResponse{
+		Choices: []openai.ChatCompletionChoice{{Message: openai.ChatCompletionMessage{Content: jsonResp}}},
+	}, nil).Once()
+
+	cfg := &config.LLM{Model: "test", MaxTokens: 10000}
+	client := newTestClient(cfg, mockAPI)
+	msgs := []ChatMessage{{MessageID: 1, SenderID: 1, SenderName: "A", Text: "hello"}}
+
+	_, err := client.SummarizeChat(WithLanguage(context.Background(), "English"), msgs)
+	assert.NoError(t, err)
+	_, err = client.SummarizeChat(context.Background(), msgs)
+	assert.NoError(t, err)
+	mockAPI.AssertExpectations(t)
+}
+
+func TestChatContextSection(t *testing.T) {
+	tests := []struct {
+		name        string
+		chatContext ChatContext
+		contains    []string
+		excludes    []string
+	}{
+		{"无背景信息", ChatContext{Description: "  "}, nil, []string{"群聊背景"}},
+		{"仅群简介", ChatContext{Description: "Kubernetes 运维交流"}, []string{"群聊背景", "群简介：Kubernetes 运维交流"}, []string{"置顶消息"}},
+		{"群简介和置顶消息", ChatContext{Description: "DeFi 讨论", PinnedMessage: "TVL 指锁仓量"}, []string{"群简介：DeFi 讨论", "置顶消息：TVL 指锁仓量"}, nil},
+		{"置顶消息过长被截断", ChatContext{PinnedMessage: strings.Repeat("长", chatContextMaxRunes+10)}, []string{strings.Repeat("长", chatContextMaxRunes) + "…"}, []string{strings.Repeat("长", chatContextMaxRunes+1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			section := chatContextSection(WithChatContext(context.Background(), tt.chatContext))
+			for _, s := range tt.contains {
+				assert.Contains(t, section, s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, section, s)
+			}
+		})
+	}
+
+	assert.Empty(t, chatContextSection(context.Background()), "未设置背景信息")
+}
+
+func TestGlossarySection(t *testing.T) {
+	assert.Empty(t, glossarySection(context.Background()))
+
+	section := glossarySection(WithGlossary(context.Background(), []config.GlossaryTerm{
+		{Term: "Kubernetes", Aliases: []string{"k8s", "kube"}, Explanation: "容器编排平台"},
+		{Term: "BTC"},
+	}))
+	assert.Contains(t, section, "- Kubernetes（又作 k8s、kube）：容器编排平台")
+	assert.Contains(t, section, "- BTC")
+	assert.NotContains(t, section, "- BTC（")
+}
+
+func TestHighlightsSection(t *testing.T) {
+	assert.Empty(t, highlightsSection(context.Background()))
+
+	section := highlightsSection(WithHighlights(context.Background(), []ChatMessage{
+		{MessageID: 42, SenderName: "张三", Text: "周五\n发布 v2", Reactions: 12},
+	}))
+	assert.Contains(t, section, "高关注消息")
+	assert.Contains(t, section, "- [42] 张三（12 个回应）：周五 发布 v2")
+}
+
+func TestClientStats(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(openai.ChatCompletionResponse{
+		Choices: []openai.ChatCompletionChoice{{Message: openai.ChatCompletionMessage{Content: `{"topics":[]}`}}},
+		Usage:   openai.Usage{PromptTokens: 100, CompletionTokens: 20},
+	}, nil).Once()
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.Anything).Return(openai.ChatCompletionResponse{}, errors.New("rate limited")).Once()
+
+	client := newTestClient(&config.LLM{Model: "stats-model", MaxTokens: 10000}, mockAPI)
+	msgs := []ChatMessage{{MessageID: 1, SenderID: 1, SenderName: "A", Text: "hello"}}
+	_, err := client.SummarizeChat(context.Background(), msgs)
+	assert.NoError(t, err)
+	_, err = client.SummarizeChat(context.Background(), msgs)
+	assert.Error(t, err)
+
+	stats := client.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, "stats-model", stats[0].Model)
+	assert.Equal(t, 2, stats[0].Requests)
+	assert.Equal(t, 1, stats[0].Errors)
+	assert.Equal(t, 100, stats[0].PromptTokens)
+	assert.Equal(t, 20, stats[0].CompletionTokens)
+
+	snapshot := metrics.Snapshot()
+	assert.Equal(t, float64(2), snapshot[`llm_requests_total{model="stats-model"}`])
+	assert.Equal(t, float64(1), snapshot[`llm_request_errors_total{model="stats-model"}`])
+	assert.Equal(t, float64(100), snapshot[`llm_tokens_total{model="stats-model",type="prompt"}`])
+}
+
+func TestQuantile(t *testing.T) {
+	sorted := make([]float64, 100)
+	for i := range sorted {
+		sorted[i] = float64(i + 1)
+	}
+	assert.Equal(t, 50.0, quantile(sorted, 0.5))
+	assert.Equal(t, 90.0, quantile(sorted, 0.9))
+	assert.Equal(t, 99.0, quantile(sorted, 0.99))
+	assert.Equal(t, 3.0, quantile([]float64{3}, 0.99))
+	assert.Zero(t, quantile(nil, 0.5))
+}
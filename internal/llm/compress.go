@@ -0,0 +1,40 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// CompressText 将过长的总结文本压缩到约 maxRunes 字以内，保留关键结论、数字和专有名词，
+// 用于话题子项超过 Telegram 单条消息长度的情况
+func (c *Client) CompressText(ctx context.Context, text string, maxRunes int) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	systemPrompt := `你是一个群聊总结助手。用户会提供群聊总结中的一段内容，它过长，无法在一条消息中发送。请在不改变原意的前提下压缩这段内容。
+
+输出要求：
+1. 总字数不超过 %d 字
+2. 保留关键结论、决定、数字和专有名词，删去重复和次要细节
+3. 使用与原文相同的语言
+4. 只输出压缩后的内容，不要使用 Markdown 或 HTML，不要添加说明`
+	systemPrompt = fmt.Sprintf(systemPrompt, maxRunes) + glossarySection(ctx)
+
+	req := openai.ChatCompletionRequest{
+		Model: c.config.Model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: "需要压缩的内容：\n" + text},
+		},
+		Temperature: 0.3,
+		MaxTokens:   maxRunes*2 + 100,
+	}
+	return c.complete(ctx, req)
+}
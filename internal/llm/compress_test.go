@@ -0,0 +1,34 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressText(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.MatchedBy(func(req openai.ChatCompletionRequest) bool {
+		return strings.Contains(req.Messages[0].Content, "不超过 200 字") && strings.Contains(req.Messages[1].Content, "周五发版")
+	})).Return(openai.ChatCompletionResponse{
+		Choices: []openai.ChatCompletionChoice{
+			{Message: openai.ChatCompletionMessage{Content: " 建议周五发版 \n"}},
+		},
+	}, nil)
+
+	client := newTestClient(&config.LLM{Model: "test", MaxTokens: 10000}, mockAPI)
+	compressed, err := client.CompressText(context.Background(), strings.Repeat("讨论了很久，最终建议周五发版。", 50), 200)
+	require.NoError(t, err)
+	assert.Equal(t, "建议周五发版", compressed)
+	mockAPI.AssertExpectations(t)
+
+	compressed, err = client.CompressText(context.Background(), "  ", 200)
+	require.NoError(t, err)
+	assert.Empty(t, compressed, "空文本不调用 LLM")
+}
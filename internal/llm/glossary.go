@@ -0,0 +1,40 @@
+package llm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+)
+
+type glossaryCtx struct{}
+
+// WithGlossary 附加术语表，总结时追加到 system prompt，要求 LLM 使用标准写法
+func WithGlossary(ctx context.Context, terms []config.GlossaryTerm) context.Context {
+	return context.WithValue(ctx, glossaryCtx{}, terms)
+}
+
+// glossarySection 追加到 system prompt 的术语表，未设置时返回空字符串
+func glossarySection(ctx context.Context) string {
+	terms, _ := ctx.Value(glossaryCtx{}).([]config.GlossaryTerm)
+	if len(terms) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\n术语表（title 和 description 中提到以下术语时使用标准写法）：")
+	for _, term := range terms {
+		sb.WriteString("\n- ")
+		sb.WriteString(term.Term)
+		if len(term.Aliases) > 0 {
+			sb.WriteString("（又作 ")
+			sb.WriteString(strings.Join(term.Aliases, "、"))
+			sb.WriteString("）")
+		}
+		if term.Explanation != "" {
+			sb.WriteString("：")
+			sb.WriteString(term.Explanation)
+		}
+	}
+	return sb.String()
+}
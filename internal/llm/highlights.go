@@ -0,0 +1,33 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// highlightMaxRunes 高关注消息的最大字数
+const highlightMaxRunes = 200
+
+type highlightsCtx struct{}
+
+// WithHighlights 附加回应最多的消息，总结时追加到 system prompt，提示 LLM 优先覆盖群成员关注的讨论
+func WithHighlights(ctx context.Context, messages []ChatMessage) context.Context {
+	return context.WithValue(ctx, highlightsCtx{}, messages)
+}
+
+// highlightsSection 追加到 system prompt 的高关注消息，未设置时返回空字符串
+func highlightsSection(ctx context.Context) string {
+	messages, _ := ctx.Value(highlightsCtx{}).([]ChatMessage)
+	if len(messages) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\n高关注消息（群成员回应最多，应在话题中体现，并优先作为相应子项的 message_ids）：")
+	for _, m := range messages {
+		text := truncateRunes(strings.Join(strings.Fields(m.Text), " "), highlightMaxRunes)
+		sb.WriteString(fmt.Sprintf("\n- [%d] %s（%d 个回应）：%s", m.MessageID, m.SenderName, m.Reactions, text))
+	}
+	return sb.String()
+}
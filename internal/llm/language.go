@@ -0,0 +1,27 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+)
+
+type languageCtx struct{}
+
+// WithLanguage 指定总结的输出语言（语言名称，如 "English"），未指定时按 system prompt 默认使用中文
+func WithLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, languageCtx{}, language)
+}
+
+func languageFromContext(ctx context.Context) string {
+	language, _ := ctx.Value(languageCtx{}).(string)
+	return language
+}
+
+// languageInstruction 追加到 system prompt 的输出语言要求，未指定语言时返回空字符串
+func languageInstruction(ctx context.Context) string {
+	language := languageFromContext(ctx)
+	if language == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n7. title 和 description 使用 %s 输出（sender_name 保持原样）", language)
+}
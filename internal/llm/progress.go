@@ -0,0 +1,28 @@
+package llm
+
+import "context"
+
+// Progress 一次群聊总结的进度，每个 chunk 完成后报告一次
+type Progress struct {
+	Chunk       int // 已完成的 chunk 数
+	Chunks      int // chunk 总数，消息未超长时为 1
+	Tokens      int // 已完成 chunk 中消息内容的估算 tokens
+	TotalTokens int // 全部消息内容的估算 tokens
+}
+
+// ProgressFunc 接收总结进度，在发起总结的 goroutine 中同步调用，应尽快返回
+type ProgressFunc func(Progress)
+
+type progressKey struct{}
+
+// WithProgress 返回绑定了进度回调的 context，之后经此 context 发起的群聊总结都会报告进度；fn 为 nil 表示不报告
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// reportProgress 调用 context 上绑定的进度回调，未绑定时忽略
+func reportProgress(ctx context.Context, p Progress) {
+	if fn, _ := ctx.Value(progressKey{}).(ProgressFunc); fn != nil {
+		fn(p)
+	}
+}
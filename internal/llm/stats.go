@@ -0,0 +1,123 @@
+package llm
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/sashabaranov/go-openai"
+)
+
+// latencyWindow 计算延迟分位数时保留的最近请求数
+const latencyWindow = 1000
+
+// ModelStats 单个模型的累计请求统计
+type ModelStats struct {
+	Model            string  `json:"model"`
+	Requests         int     `json:"requests"`
+	Errors           int     `json:"errors"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	LatencyP50       float64 `json:"latency_p50_seconds"` // 最近请求的延迟分位数（秒）
+	LatencyP90       float64 `json:"latency_p90_seconds"`
+	LatencyP99       float64 `json:"latency_p99_seconds"`
+}
+
+// modelStats 单个模型的统计，latencies 为最近 latencyWindow 次请求延迟（秒）的环形缓冲
+type modelStats struct {
+	ModelStats
+	latencies []float64
+	next      int
+}
+
+// statsRecorder 按模型统计请求，零值可用，可被多个协程并发调用
+type statsRecorder struct {
+	mu     sync.Mutex
+	models map[string]*modelStats
+}
+
+// record 记录一次请求，并同步更新 /metrics 中的指标
+func (r *statsRecorder) record(model string, latency time.Duration, usage openai.Usage, err error) {
+	seconds := latency.Seconds()
+
+	r.mu.Lock()
+	if r.models == nil {
+		r.models = make(map[string]*modelStats)
+	}
+	s, ok := r.models[model]
+	if !ok {
+		s = &modelStats{ModelStats: ModelStats{Model: model}}
+		r.models[model] = s
+	}
+	s.Requests++
+	if err != nil {
+		s.Errors++
+	} else {
+		s.PromptTokens += usage.PromptTokens
+		s.CompletionTokens += usage.CompletionTokens
+	}
+	if len(s.latencies) < latencyWindow {
+		s.latencies = append(s.latencies, seconds)
+	} else {
+		s.latencies[s.next] = seconds
+		s.next = (s.next + 1) % latencyWindow
+	}
+	p50, p90, p99 := s.quantiles()
+	r.mu.Unlock()
+
+	metrics.Inc(metrics.Name("llm_requests_total", "model", model))
+	if err != nil {
+		metrics.Inc(metrics.Name("llm_request_errors_total", "model", model))
+	} else {
+		metrics.Add(metrics.Name("llm_tokens_total", "model", model, "type", "prompt"), float64(usage.PromptTokens))
+		metrics.Add(metrics.Name("llm_tokens_total", "model", model, "type", "completion"), float64(usage.CompletionTokens))
+	}
+	metrics.Add(metrics.Name("llm_request_duration_seconds_sum", "model", model), seconds)
+	metrics.Inc(metrics.Name("llm_request_duration_seconds_count", "model", model))
+	metrics.Set(metrics.Name("llm_request_duration_seconds", "model", model, "quantile", "0.5"), p50)
+	metrics.Set(metrics.Name("llm_request_duration_seconds", "model", model, "quantile", "0.9"), p90)
+	metrics.Set(metrics.Name("llm_request_duration_seconds", "model", model, "quantile", "0.99"), p99)
+}
+
+// quantiles 最近请求延迟的 P50、P90、P99
+func (s *modelStats) quantiles() (p50, p90, p99 float64) {
+	sorted := append([]float64(nil), s.latencies...)
+	sort.Float64s(sorted)
+	return quantile(sorted, 0.5), quantile(sorted, 0.9), quantile(sorted, 0.99)
+}
+
+// quantile 已排序样本的分位数（最近秩法），无样本时返回 0
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(q*float64(len(sorted)) + 0.5)
+	if i > 0 {
+		i--
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+// snapshot 各模型的统计，按模型名称排序
+func (r *statsRecorder) snapshot() []ModelStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := make([]ModelStats, 0, len(r.models))
+	for _, s := range r.models {
+		snapshot := s.ModelStats
+		snapshot.LatencyP50, snapshot.LatencyP90, snapshot.LatencyP99 = s.quantiles()
+		stats = append(stats, snapshot)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Model < stats[j].Model })
+	return stats
+}
+
+// Stats 返回自启动以来各模型的请求数、失败数、token 用量及最近请求的延迟分位数
+func (c *Client) Stats() []ModelStats {
+	return c.stats.snapshot()
+}
@@ -0,0 +1,68 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+)
+
+// SummarizeThread 将一段讨论（回复链）总结为几句话的纯文本，供 /tldr 即时回复使用。
+// 消息过长时保留第一条（讨论起点）和尽可能多的最新消息
+func (c *Client) SummarizeThread(ctx context.Context, messages []ChatMessage) (string, error) {
+	if len(messages) == 0 {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	format := c.promptFormat()
+	messages = trimThread(messages, c.maxInputTokens, format)
+
+	systemPrompt := `你是一个群聊助手。用户会提供群聊中的一段讨论，请用简洁的纯文本总结这段讨论。
+
+%s
+
+输出要求：
+1. 先用一句话概括讨论的主题
+2. 再用 2-4 条以「• 」开头的要点列出主要观点、结论或待办事项，必要时注明发言者
+3. 总字数不超过 300 字
+4. 只输出总结内容，不要使用 Markdown 或 HTML`
+	systemPrompt = fmt.Sprintf(systemPrompt, format.inputFormatInstruction())
+	systemPrompt += languageInstruction(ctx) + glossarySection(ctx)
+
+	req := openai.ChatCompletionRequest{
+		Model: c.config.Model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: systemPrompt},
+			{Role: openai.ChatMessageRoleUser, Content: "讨论内容：\n" + messagesToPromptText(messages, format)},
+		},
+		Temperature: 0.3,
+		MaxTokens:   800,
+	}
+	return c.complete(ctx, req)
+}
+
+// trimThread 讨论超过 maxTokens 时保留第一条消息，并从最新消息开始向前保留，直到达到上限
+func trimThread(messages []ChatMessage, maxTokens int, format promptFormat) []ChatMessage {
+	total := 0
+	for _, m := range messages {
+		total += estimateTokens(format.line(m))
+	}
+	if total <= maxTokens || len(messages) <= 2 {
+		return messages
+	}
+
+	budget := maxTokens - estimateTokens(format.line(messages[0]))
+	start := len(messages)
+	for start > 1 {
+		tokens := estimateTokens(format.line(messages[start-1]))
+		if tokens > budget {
+			break
+		}
+		budget -= tokens
+		start--
+	}
+	return append([]ChatMessage{messages[0]}, messages[start:]...)
+}
@@ -0,0 +1,53 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeThread(t *testing.T) {
+	mockAPI := new(mockOpenAIClient)
+	mockAPI.On("CreateChatCompletion", mock.Anything, mock.MatchedBy(func(req openai.ChatCompletionRequest) bool {
+		return strings.Contains(req.Messages[1].Content, "周五发版")
+	})).Return(openai.ChatCompletionResponse{
+		Choices: []openai.ChatCompletionChoice{
+			{Message: openai.ChatCompletionMessage{Content: "\n讨论了发版时间。\n• 张三建议周五发版\n"}},
+		},
+	}, nil)
+
+	client := newTestClient(&config.LLM{Model: "test", MaxTokens: 10000}, mockAPI)
+	summary, err := client.SummarizeThread(context.Background(), []ChatMessage{
+		{MessageID: 1, SenderID: 1, SenderName: "张三", Text: "周五发版吧"},
+		{MessageID: 2, SenderID: 2, SenderName: "李四", Text: "可以"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "讨论了发版时间。\n• 张三建议周五发版", summary)
+	mockAPI.AssertExpectations(t)
+
+	summary, err = client.SummarizeThread(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, summary, "无消息时不调用 LLM")
+}
+
+func TestTrimThread(t *testing.T) {
+	format := promptFormat{}
+	var msgs []ChatMessage
+	for i := range 10 {
+		msgs = append(msgs, ChatMessage{MessageID: int64(i + 1), SenderName: "张三", Text: strings.Repeat("字", 50)})
+	}
+	perMessage := estimateTokens(format.line(msgs[0]))
+
+	assert.Len(t, trimThread(msgs, perMessage*10, format), 10, "未超限时不裁剪")
+
+	trimmed := trimThread(msgs, perMessage*4, format)
+	require.Len(t, trimmed, 4)
+	assert.Equal(t, int64(1), trimmed[0].MessageID, "保留讨论起点")
+	assert.Equal(t, []int64{8, 9, 10}, []int64{trimmed[1].MessageID, trimmed[2].MessageID, trimmed[3].MessageID}, "保留最新的消息")
+}
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"strings"
+	"unicode"
+)
+
+// normalizeTopicTitle 归一化话题标题：转小写，去除空白和标点
+func normalizeTopicTitle(title string) []rune {
+	runes := make([]rune, 0, len(title))
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		runes = append(runes, r)
+	}
+	return runes
+}
+
+// topicSimilarity 计算两个话题标题的相似度（0~1），基于归一化后的最长公共子序列：2*LCS/(len(a)+len(b))
+// 如 "部署问题" 与 "部署相关问题" 的相似度为 0.8
+func topicSimilarity(a, b string) float64 {
+	ra, rb := normalizeTopicTitle(a), normalizeTopicTitle(b)
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	if string(ra) == string(rb) {
+		return 1
+	}
+
+	// 滚动数组计算 LCS 长度
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			if ra[i-1] == rb[j-1] {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = max(prev[j], curr[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return 2 * float64(prev[len(rb)]) / float64(len(ra)+len(rb))
+}
+
+// findMatchingTopic 在 topics 中查找与 title 匹配的话题，返回下标，未找到返回 -1。
+// 优先完全相同的标题；threshold > 0 时再按相似度取最高且不低于 threshold 的话题
+func findMatchingTopic(topics []topicItemJSON, title string, threshold float64) int {
+	for i, t := range topics {
+		if t.Title == title {
+			return i
+		}
+	}
+	if threshold <= 0 {
+		return -1
+	}
+
+	best, bestScore := -1, threshold
+	for i, t := range topics {
+		if score := topicSimilarity(t.Title, title); score >= bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,59 @@
+package llm
+
+import (
+	"context"
+	"sync"
+)
+
+// Usage 累计 LLM token 用量及费用估算，可通过 WithUsage 绑定到 context 上按运行统计
+type Usage struct {
+	mu               sync.Mutex
+	requests         int
+	promptTokens     int
+	completionTokens int
+	cost             float64
+}
+
+// UsageSnapshot Usage 的只读快照
+type UsageSnapshot struct {
+	Requests         int
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	Cost             float64
+}
+
+func (u *Usage) add(promptTokens, completionTokens int, cost float64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.requests++
+	u.promptTokens += promptTokens
+	u.completionTokens += completionTokens
+	u.cost += cost
+}
+
+// Snapshot 返回当前累计用量
+func (u *Usage) Snapshot() UsageSnapshot {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return UsageSnapshot{
+		Requests:         u.requests,
+		PromptTokens:     u.promptTokens,
+		CompletionTokens: u.completionTokens,
+		TotalTokens:      u.promptTokens + u.completionTokens,
+		Cost:             u.cost,
+	}
+}
+
+type usageKey struct{}
+
+// WithUsage 返回绑定了用量累计器的 context，之后经此 context 发起的 LLM 请求都会计入 u
+func WithUsage(ctx context.Context, u *Usage) context.Context {
+	return context.WithValue(ctx, usageKey{}, u)
+}
+
+// usageFromContext 取出 context 上绑定的用量累计器，未绑定返回 nil
+func usageFromContext(ctx context.Context) *Usage {
+	u, _ := ctx.Value(usageKey{}).(*Usage)
+	return u
+}
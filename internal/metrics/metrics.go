@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 进程内指标注册表：计数器与仪表盘，通过 Handler 以 Prometheus 文本格式导出
+
+var (
+	mu       sync.Mutex
+	counters = make(map[string]float64)
+	gauges   = make(map[string]float64)
+)
+
+// Name 拼接带标签的指标名，如 Name("llm_requests_total", "model", "gpt-4o") => llm_requests_total{model="gpt-4o"}
+func Name(name string, labelPairs ...string) string {
+	if len(labelPairs) < 2 {
+		return name
+	}
+	labels := make([]string, 0, len(labelPairs)/2)
+	for i := 0; i+1 < len(labelPairs); i += 2 {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(labelPairs[i+1])
+		labels = append(labels, fmt.Sprintf(`%s="%s"`, labelPairs[i], value))
+	}
+	return name + "{" + strings.Join(labels, ",") + "}"
+}
+
+// Inc 计数器加一
+func Inc(name string) {
+	Add(name, 1)
+}
+
+// Add 计数器增加 delta
+func Add(name string, delta float64) {
+	mu.Lock()
+	counters[name] += delta
+	mu.Unlock()
+}
+
+// Observe 记录一次观测值（如耗时秒数），累加到 name_sum 和 name_count，二者之比即平均值
+func Observe(name string, value float64, labelPairs ...string) {
+	mu.Lock()
+	counters[Name(name+"_sum", labelPairs...)] += value
+	counters[Name(name+"_count", labelPairs...)]++
+	mu.Unlock()
+}
+
+// Set 设置仪表盘的当前值
+func Set(name string, value float64) {
+	mu.Lock()
+	gauges[name] = value
+	mu.Unlock()
+}
+
+// Snapshot 返回所有指标的当前值
+func Snapshot() map[string]float64 {
+	mu.Lock()
+	defer mu.Unlock()
+	result := make(map[string]float64, len(counters)+len(gauges))
+	for k, v := range counters {
+		result[k] = v
+	}
+	for k, v := range gauges {
+		result[k] = v
+	}
+	return result
+}
+
+// Handler 以 Prometheus 文本格式输出所有指标
+func Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snapshot := Snapshot()
+		names := make([]string, 0, len(snapshot))
+		for name := range snapshot {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		for _, name := range names {
+			fmt.Fprintf(w, "%s %g\n", name, snapshot[name])
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "requests_total", Name("requests_total"))
+	assert.Equal(t, `requests_total{model="gpt-4o"}`, Name("requests_total", "model", "gpt-4o"))
+	assert.Equal(t, `requests_total{a="1",b="x\"y"}`, Name("requests_total", "a", "1", "b", `x"y`))
+}
+
+func TestHandler(t *testing.T) {
+	Inc("test_handler_total")
+	Add("test_handler_total", 2)
+	Set("test_handler_gauge", 1.5)
+
+	rec := httptest.NewRecorder()
+	Handler()(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "test_handler_gauge 1.5\n")
+	assert.Contains(t, body, "test_handler_total 3\n")
+}
+
+func TestObserve(t *testing.T) {
+	Observe("test_observe_seconds", 0.5, "op", "query")
+	Observe("test_observe_seconds", 1, "op", "query")
+
+	snapshot := Snapshot()
+	assert.Equal(t, 1.5, snapshot[`test_observe_seconds_sum{op="query"}`])
+	assert.Equal(t, float64(2), snapshot[`test_observe_seconds_count{op="query"}`])
+}
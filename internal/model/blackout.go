@@ -0,0 +1,34 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/blackout"
+)
+
+type BlackoutModel struct {
+	client *ent.BlackoutClient
+}
+
+func NewBlackoutModel(client *ent.BlackoutClient) *BlackoutModel {
+	return &BlackoutModel{client: client}
+}
+
+// Create 记录群聊的排除区间 [startTime, endTime)
+func (m *BlackoutModel) Create(ctx context.Context, chatID int64, startTime, endTime time.Time, operatorID int64) (*ent.Blackout, error) {
+	return m.client.Create().
+		SetChatID(chatID).
+		SetStartTime(startTime).
+		SetEndTime(endTime).
+		SetOperatorID(operatorID).
+		Save(ctx)
+}
+
+// EndingAfter 查询结束时间晚于 t 的排除区间（尚可能有消息落入的区间）
+func (m *BlackoutModel) EndingAfter(ctx context.Context, t time.Time) ([]*ent.Blackout, error) {
+	return m.client.Query().
+		Where(blackout.EndTimeGT(t)).
+		All(ctx)
+}
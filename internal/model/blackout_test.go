@@ -0,0 +1,26 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlackoutEndingAfter(t *testing.T) {
+	ctx := context.Background()
+	m := NewBlackoutModel(newTestClient(t).Blackout)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	_, err := m.Create(ctx, -100, day.Add(14*time.Hour), day.Add(15*time.Hour), 10)
+	require.NoError(t, err)
+	_, err = m.Create(ctx, -200, day.Add(20*time.Hour), day.Add(22*time.Hour), 10)
+	require.NoError(t, err)
+
+	blackouts, err := m.EndingAfter(ctx, day.Add(15*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, blackouts, 1, "已结束的区间不返回")
+	assert.Equal(t, int64(-200), blackouts[0].ChatID)
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+)
+
+type ChatModel struct {
+	client *ent.ChatClient
+}
+
+func NewChatModel(client *ent.ChatClient) *ChatModel {
+	return &ChatModel{client: client}
+}
+
+// Save 保存群聊名称和类型，已存在时更新
+func (m *ChatModel) Save(ctx context.Context, chatID int64, title string, chatType chat.ChatType) error {
+	n, err := m.client.Update().Where(chat.ChatIDEQ(chatID)).SetTitle(title).SetChatType(chatType).Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	err = m.client.Create().SetChatID(chatID).SetTitle(title).SetChatType(chatType).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发创建，改为更新
+		return m.client.Update().Where(chat.ChatIDEQ(chatID)).SetTitle(title).SetChatType(chatType).Exec(ctx)
+	}
+	return err
+}
+
+// SaveInfo 保存群聊名称、类型、用户名和成员数，已存在时更新
+func (m *ChatModel) SaveInfo(ctx context.Context, chatID int64, title string, chatType chat.ChatType, username string, memberCount int) error {
+	n, err := m.client.Update().Where(chat.ChatIDEQ(chatID)).
+		SetTitle(title).SetChatType(chatType).SetUsername(username).SetMemberCount(memberCount).Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	err = m.client.Create().SetChatID(chatID).
+		SetTitle(title).SetChatType(chatType).SetUsername(username).SetMemberCount(memberCount).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发创建，改为更新
+		return m.client.Update().Where(chat.ChatIDEQ(chatID)).
+			SetTitle(title).SetChatType(chatType).SetUsername(username).SetMemberCount(memberCount).Exec(ctx)
+	}
+	return err
+}
+
+// ChatIDs 返回已记录的所有群聊ID
+func (m *ChatModel) ChatIDs(ctx context.Context) ([]int64, error) {
+	var chatIDs []int64
+	err := m.client.Query().Select(chat.FieldChatID).Scan(ctx, &chatIDs)
+	return chatIDs, err
+}
+
+// SetConsent 保存群聊的数据收集告知状态，群聊未记录时一并创建
+func (m *ChatModel) SetConsent(ctx context.Context, chatID int64, title string, consent chat.Consent) error {
+	now := time.Now()
+	n, err := m.client.Update().Where(chat.ChatIDEQ(chatID)).
+		SetConsent(consent).SetConsentUpdatedAt(now).Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	err = m.client.Create().SetChatID(chatID).SetTitle(title).
+		SetConsent(consent).SetConsentUpdatedAt(now).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发创建，改为更新
+		return m.client.Update().Where(chat.ChatIDEQ(chatID)).
+			SetConsent(consent).SetConsentUpdatedAt(now).Exec(ctx)
+	}
+	return err
+}
+
+// GetConsent 获取群聊的数据收集告知状态，未记录时返回 unknown
+func (m *ChatModel) GetConsent(ctx context.Context, chatID int64) (chat.Consent, error) {
+	c, err := m.client.Query().Where(chat.ChatIDEQ(chatID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return chat.ConsentUnknown, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return c.Consent, nil
+}
+
+// OptedOutChatIDs 返回已退出数据收集的群聊ID
+func (m *ChatModel) OptedOutChatIDs(ctx context.Context) ([]int64, error) {
+	var chatIDs []int64
+	err := m.client.Query().
+		Where(chat.ConsentEQ(chat.ConsentOptedOut)).
+		Select(chat.FieldChatID).
+		Scan(ctx, &chatIDs)
+	return chatIDs, err
+}
+
+// Get 查询群聊信息，未记录时返回 nil
+func (m *ChatModel) Get(ctx context.Context, chatID int64) (*ent.Chat, error) {
+	c, err := m.client.Query().Where(chat.ChatIDEQ(chatID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return c, err
+}
+
+// GetTitle 获取群聊名称，未记录时返回空字符串
+func (m *ChatModel) GetTitle(ctx context.Context, chatID int64) (string, error) {
+	c, err := m.client.Query().Where(chat.ChatIDEQ(chatID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return c.Title, nil
+}
+
+// Titles 批量获取群聊名称，未记录的群聊不在结果中
+func (m *ChatModel) Titles(ctx context.Context, chatIDs []int64) (map[int64]string, error) {
+	titles := make(map[int64]string, len(chatIDs))
+	if len(chatIDs) == 0 {
+		return titles, nil
+	}
+	chats, err := m.client.Query().Where(chat.ChatIDIn(chatIDs...)).Select(chat.FieldChatID, chat.FieldTitle).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range chats {
+		titles[c.ChatID] = c.Title
+	}
+	return titles, nil
+}
+
+// UpdateTitle 更新已记录群聊的名称，返回更新的数量；未记录的群聊不创建
+func (m *ChatModel) UpdateTitle(ctx context.Context, chatID int64, title string) (int, error) {
+	return m.client.Update().Where(chat.ChatIDEQ(chatID)).SetTitle(title).Save(ctx)
+}
+
+// GetType 获取聊天类型，未记录或类型未知时返回空字符串
+func (m *ChatModel) GetType(ctx context.Context, chatID int64) (chat.ChatType, error) {
+	c, err := m.client.Query().Where(chat.ChatIDEQ(chatID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return c.ChatType, nil
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatModel(t *testing.T) {
+	ctx := context.Background()
+	m := NewChatModel(newTestClient(t).Chat)
+
+	title, err := m.GetTitle(ctx, -100)
+	require.NoError(t, err)
+	assert.Empty(t, title, "未记录时返回空")
+
+	require.NoError(t, m.Save(ctx, -100, "技术交流群", chat.ChatTypeGroup))
+	require.NoError(t, m.Save(ctx, -100, "技术交流群（新）", chat.ChatTypeSupergroup))
+
+	title, err = m.GetTitle(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, "技术交流群（新）", title, "重复保存应更新名称")
+	chatType, err := m.GetType(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, chat.ChatTypeSupergroup, chatType, "升级为超级群组后更新类型")
+	chatType, err = m.GetType(ctx, -300)
+	require.NoError(t, err)
+	assert.Empty(t, chatType, "未记录时返回空")
+
+	require.NoError(t, m.SaveInfo(ctx, -100, "技术交流群", chat.ChatTypeSupergroup, "@tech", 120))
+	require.NoError(t, m.SaveInfo(ctx, -200, "闲聊群", chat.ChatTypeGroup, "", 30))
+	c, err := m.client.Query().Where(chat.ChatIDEQ(-100)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "技术交流群", c.Title)
+	assert.Equal(t, "@tech", c.Username)
+	assert.Equal(t, 120, c.MemberCount)
+
+	chatIDs, err := m.ChatIDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{-100, -200}, chatIDs)
+}
+
+func TestChatTitles(t *testing.T) {
+	ctx := context.Background()
+	m := NewChatModel(newTestClient(t).Chat)
+	require.NoError(t, m.Save(ctx, -100, "技术交流群", chat.ChatTypeGroup))
+	require.NoError(t, m.Save(ctx, -200, "闲聊群", chat.ChatTypeGroup))
+
+	n, err := m.UpdateTitle(ctx, -100, "技术交流群（新）")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = m.UpdateTitle(ctx, -300, "未记录")
+	require.NoError(t, err)
+	assert.Zero(t, n, "未记录的群聊不创建")
+
+	titles, err := m.Titles(ctx, []int64{-100, -200, -300})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{-100: "技术交流群（新）", -200: "闲聊群"}, titles)
+	titles, err = m.Titles(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, titles)
+}
+
+func TestChatConsent(t *testing.T) {
+	ctx := context.Background()
+	m := NewChatModel(newTestClient(t).Chat)
+
+	consent, err := m.GetConsent(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, chat.ConsentUnknown, consent, "未记录时为 unknown")
+
+	require.NoError(t, m.SetConsent(ctx, -100, "技术交流群", chat.ConsentNotified), "未记录时创建")
+	require.NoError(t, m.Save(ctx, -200, "闲聊群", chat.ChatTypeGroup))
+	require.NoError(t, m.SetConsent(ctx, -200, "闲聊群", chat.ConsentOptedOut))
+
+	consent, err = m.GetConsent(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, chat.ConsentNotified, consent)
+
+	title, err := m.GetTitle(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, "技术交流群", title)
+
+	chatIDs, err := m.OptedOutChatIDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{-200}, chatIDs)
+}
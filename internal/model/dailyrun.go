@@ -16,18 +16,33 @@ func NewDailyRunModel(client *ent.DailyRunClient) *DailyRunModel {
 	return &DailyRunModel{client: client}
 }
 
+// RunStats DailyRun 运行统计
+type RunStats struct {
+	Duration           time.Duration
+	ChatsProcessed     int
+	ChatsFailed        int
+	MessagesSummarized int
+	PromptTokens       int
+	CompletionTokens   int
+	TotalTokens        int
+	Cost               float64
+	MessagesCleaned    int
+	Languages          map[string]int // 参与总结的消息语言分布，仅用于运行报告，不持久化
+}
+
 // Create 创建 DailyRun 记录
-func (m *DailyRunModel) Create(ctx context.Context, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error) {
+func (m *DailyRunModel) Create(ctx context.Context, window string, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error) {
 	return m.client.Create().
+		SetWindow(window).
 		SetStartTime(startTime).
 		SetEndTime(endTime).
 		SetStatus(status).
 		Save(ctx)
 }
 
-// GetOrCreate 获取或创建 DailyRun（用于 runDailySummary 开始时）
+// GetOrCreate 获取或创建 DailyRun（用于总结窗口开始执行时）
 // 若已存在相同 start_time/end_time 的记录则返回现有记录
-func (m *DailyRunModel) GetOrCreate(ctx context.Context, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error) {
+func (m *DailyRunModel) GetOrCreate(ctx context.Context, window string, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error) {
 	existing, err := m.client.Query().
 		Where(
 			dailyrun.StartTimeEQ(startTime),
@@ -41,7 +56,7 @@ func (m *DailyRunModel) GetOrCreate(ctx context.Context, startTime, endTime time
 	if !ent.IsNotFound(err) {
 		return nil, err
 	}
-	return m.Create(ctx, startTime, endTime, status)
+	return m.Create(ctx, window, startTime, endTime, status)
 }
 
 // GetByDateRange 查询指定日期区间的 DailyRun 记录
@@ -79,3 +94,53 @@ func (m *DailyRunModel) MarkFailed(ctx context.Context, id int, errorMsg string)
 		SetErrorMessage(errorMsg).
 		Exec(ctx)
 }
+
+// SaveStats 保存 DailyRun 运行统计
+func (m *DailyRunModel) SaveStats(ctx context.Context, id int, stats *RunStats) error {
+	return m.client.UpdateOneID(id).
+		SetDurationMs(stats.Duration.Milliseconds()).
+		SetChatsProcessed(stats.ChatsProcessed).
+		SetChatsFailed(stats.ChatsFailed).
+		SetMessagesSummarized(stats.MessagesSummarized).
+		SetPromptTokens(stats.PromptTokens).
+		SetCompletionTokens(stats.CompletionTokens).
+		SetTotalTokens(stats.TotalTokens).
+		SetCost(stats.Cost).
+		SetMessagesCleaned(stats.MessagesCleaned).
+		Exec(ctx)
+}
+
+// Get 查询 DailyRun
+func (m *DailyRunModel) Get(ctx context.Context, id int) (*ent.DailyRun, error) {
+	return m.client.Get(ctx, id)
+}
+
+// AcquireLease 获取 DailyRun 的执行租约：无人持有、租约已过期或已由 owner 持有时成功（同时续期）
+func (m *DailyRunModel) AcquireLease(ctx context.Context, id int, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	n, err := m.client.Update().
+		Where(
+			dailyrun.ID(id),
+			dailyrun.Or(
+				dailyrun.LockExpiresAtIsNil(),
+				dailyrun.LockExpiresAtLT(now),
+				dailyrun.LockOwnerEQ(owner),
+			),
+		).
+		SetLockOwner(owner).
+		SetLockExpiresAt(now.Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ReleaseLease 释放 owner 持有的执行租约
+func (m *DailyRunModel) ReleaseLease(ctx context.Context, id int, owner string) error {
+	return m.client.Update().
+		Where(dailyrun.ID(id), dailyrun.LockOwnerEQ(owner)).
+		ClearLockOwner().
+		ClearLockExpiresAt().
+		Exec(ctx)
+}
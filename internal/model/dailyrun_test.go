@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) *ent.Client {
+	client, err := ent.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	require.NoError(t, client.Schema.Create(context.Background()))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestDailyRunLease(t *testing.T) {
+	ctx := context.Background()
+	m := NewDailyRunModel(newTestClient(t).DailyRun)
+
+	start := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	run, err := m.Create(ctx, "daily", start, start.AddDate(0, 0, 1), dailyrun.StatusInProgress)
+	require.NoError(t, err)
+
+	ok, err := m.AcquireLease(ctx, run.ID, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "无人持有时应获取成功")
+
+	ok, err = m.AcquireLease(ctx, run.ID, "b", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok, "他人持有未过期时应获取失败")
+
+	ok, err = m.AcquireLease(ctx, run.ID, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "持有者可续期")
+
+	require.NoError(t, m.ReleaseLease(ctx, run.ID, "a"))
+	ok, err = m.AcquireLease(ctx, run.ID, "b", -time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "释放后可获取")
+
+	ok, err = m.AcquireLease(ctx, run.ID, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "租约过期后可接管")
+}
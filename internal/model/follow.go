@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/follow"
+)
+
+type FollowModel struct {
+	client *ent.FollowClient
+}
+
+func NewFollowModel(client *ent.FollowClient) *FollowModel {
+	return &FollowModel{client: client}
+}
+
+// Add 关注关键词，返回 false 表示已关注过
+func (m *FollowModel) Add(ctx context.Context, userID int64, keyword string) (bool, error) {
+	err := m.client.Create().SetUserID(userID).SetKeyword(keyword).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Remove 取消关注关键词，返回 false 表示未关注过
+func (m *FollowModel) Remove(ctx context.Context, userID int64, keyword string) (bool, error) {
+	n, err := m.client.Delete().Where(follow.UserIDEQ(userID), follow.KeywordEQ(keyword)).Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// CountByUser 用户已关注的关键词数
+func (m *FollowModel) CountByUser(ctx context.Context, userID int64) (int, error) {
+	return m.client.Query().Where(follow.UserIDEQ(userID)).Count(ctx)
+}
+
+// ListByUser 用户关注的关键词（按关注时间排序）
+func (m *FollowModel) ListByUser(ctx context.Context, userID int64) ([]string, error) {
+	return m.client.Query().
+		Where(follow.UserIDEQ(userID)).
+		Order(ent.Asc(follow.FieldID)).
+		Select(follow.FieldKeyword).
+		Strings(ctx)
+}
+
+// KeywordsByUser 所有用户关注的关键词：用户ID => 关键词列表
+func (m *FollowModel) KeywordsByUser(ctx context.Context) (map[int64][]string, error) {
+	follows, err := m.client.Query().Order(ent.Asc(follow.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]string)
+	for _, f := range follows {
+		result[f.UserID] = append(result[f.UserID], f.Keyword)
+	}
+	return result, nil
+}
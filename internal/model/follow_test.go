@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowModel(t *testing.T) {
+	ctx := context.Background()
+	m := NewFollowModel(newTestClient(t).Follow)
+
+	created, err := m.Add(ctx, 1, "kubernetes")
+	require.NoError(t, err)
+	assert.True(t, created)
+	created, err = m.Add(ctx, 1, "kubernetes")
+	require.NoError(t, err)
+	assert.False(t, created, "重复关注返回 false")
+	_, err = m.Add(ctx, 1, "go")
+	require.NoError(t, err)
+	_, err = m.Add(ctx, 2, "go")
+	require.NoError(t, err)
+
+	keywords, err := m.ListByUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kubernetes", "go"}, keywords)
+
+	count, err := m.CountByUser(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	removed, err := m.Remove(ctx, 1, "kubernetes")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = m.Remove(ctx, 1, "kubernetes")
+	require.NoError(t, err)
+	assert.False(t, removed, "未关注时返回 false")
+
+	all, err := m.KeywordsByUser(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]string{1: {"go"}, 2: {"go"}}, all)
+}
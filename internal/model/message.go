@@ -2,28 +2,79 @@ package model
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/fachebot/talk-trace-bot/internal/ent"
 	"github.com/fachebot/talk-trace-bot/internal/ent/message"
+	"github.com/fachebot/talk-trace-bot/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 type MessageModel struct {
-	client *ent.MessageClient
+	client           *ent.MessageClient
+	compressMinBytes int // 文本不短于该字节数的消息压缩保存，0 表示不压缩
 }
 
 func NewMessageModel(client *ent.MessageClient) *MessageModel {
 	return &MessageModel{client: client}
 }
 
+// EnableCompression 之后写入的消息中，文本不短于 minBytes 字节的使用 zstd 压缩保存；已压缩的消息无论是否启用都会在读取时解压
+func (m *MessageModel) EnableCompression(minBytes int) {
+	m.compressMinBytes = minBytes
+}
+
+// 消息发送者类型（MessageData.SenderType）
+const (
+	SenderTypeUser = "user" // 用户
+	SenderTypeChat = "chat" // 以群组或频道身份发送（匿名管理员、关联频道），SenderID 为发送者的聊天ID
+)
+
+// EventContentTypes 非文字消息记录为事件（Ingest.RecordEvents）时的内容类型。
+// 事件只保存发送者、类型和时间，文本为空，除 GetEventsByDateRangeAndChat 外的查询均不返回事件
+var EventContentTypes = []string{"sticker", "animation", "photo", "video", "video_note", "voice_note", "audio", "document", "dice"}
+
+// IsEvent 判断内容类型是否为事件
+func IsEvent(contentType string) bool {
+	return slices.Contains(EventContentTypes, contentType)
+}
+
+// notEvent 排除事件的查询条件（引入内容类型前保存的消息 content_type 为空）
+func notEvent() predicate.Message {
+	return message.Or(message.ContentTypeIsNil(), message.ContentTypeNotIn(EventContentTypes...))
+}
+
+// eventContentTypeArgs EventContentTypes 转为 SQL 参数
+func eventContentTypeArgs() []any {
+	args := make([]any, len(EventContentTypes))
+	for i, contentType := range EventContentTypes {
+		args[i] = contentType
+	}
+	return args
+}
+
 type MessageData struct {
-	MessageID      int64
-	ChatID         int64
-	SenderID       int64
-	SenderName     string
-	SenderUsername *string
-	Text           string
-	SentAt         time.Time
+	MessageID        int64 // TDLib 消息ID
+	ServerMessageID  int64 // 服务器消息ID，0 表示本地消息（尚未发送成功）
+	ChatID           int64
+	SenderID         int64
+	SenderName       string
+	SenderUsername   *string
+	SenderType       string // SenderTypeUser / SenderTypeChat，为空表示未知
+	Text             string
+	SentAt           time.Time
+	Lang             string     // 识别的语言代码，为空表示无法识别
+	ContentType      string     // 消息内容类型（对应 Ingest.ContentTypes），如 text、caption；事件为 EventContentTypes 之一
+	EditedAt         *time.Time // 编辑时间，非空表示这是对已保存消息的编辑（见 UpdateContent）
+	MessageThreadID  int64      // 论坛话题的消息线程ID，0 表示非话题消息
+	ReplyToMessageID int64      // 回复的同一群聊内消息的 TDLib 消息ID，0 表示未回复
+	ReactionCount    int64      // 回应（Reaction）总数
+	Poll             *Poll      // 投票消息的问题、选项及得票数，非投票消息为 nil
+	Links            []string   // 消息中分享的链接，由入库队列在消息写入后保存到 SharedLinkModel
+	AccountID        int64      // 保存该消息的 Telegram 账号的用户ID
 }
 
 // Create 创建消息
@@ -33,13 +84,131 @@ func (m *MessageModel) Create(ctx context.Context, data *MessageData) (*ent.Mess
 		SetChatID(data.ChatID).
 		SetSenderID(data.SenderID).
 		SetSenderName(data.SenderName).
-		SetText(data.Text).
 		SetSentAt(data.SentAt)
 
+	if compressed := compressText(data.Text, m.compressMinBytes); compressed != nil {
+		create.SetText("").SetTextZstd(compressed)
+	} else {
+		create.SetText(data.Text)
+	}
+
 	if data.SenderUsername != nil {
 		create.SetSenderUsername(*data.SenderUsername)
 	}
-	return create.Save(ctx)
+	if data.Lang != "" {
+		create.SetLang(data.Lang)
+	}
+	if data.ContentType != "" {
+		create.SetContentType(data.ContentType)
+	}
+	if data.ServerMessageID != 0 {
+		create.SetServerMessageID(data.ServerMessageID)
+	}
+	if data.MessageThreadID != 0 {
+		create.SetMessageThreadID(data.MessageThreadID)
+	}
+	if data.ReplyToMessageID != 0 {
+		create.SetReplyToMessageID(data.ReplyToMessageID)
+	}
+	if data.ReactionCount != 0 {
+		create.SetReactionCount(data.ReactionCount)
+	}
+	if data.SenderType != "" {
+		create.SetSenderType(data.SenderType)
+	}
+	if data.AccountID != 0 {
+		create.SetAccountID(data.AccountID)
+	}
+	if data.Poll != nil {
+		poll, err := EncodePoll(data.Poll)
+		if err != nil {
+			return nil, err
+		}
+		create.SetPoll(poll)
+	}
+	msg, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg.Text, msg.TextZstd = data.Text, nil
+	return msg, nil
+}
+
+// Exists 判断群组中是否已保存该 TDLib 消息ID 的消息，用于入库去重
+func (m *MessageModel) Exists(ctx context.Context, chatID, messageID int64) (bool, error) {
+	return m.client.Query().
+		Where(
+			message.ChatID(chatID),
+			message.MessageID(messageID),
+		).
+		Exist(ctx)
+}
+
+// UpdateMessageID 消息发送成功后将临时消息ID更新为正式的 TDLib 消息ID 和服务器消息ID，返回更新的数量
+func (m *MessageModel) UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error) {
+	update := m.client.Update().
+		Where(
+			message.ChatID(chatID),
+			message.MessageID(oldMessageID),
+		).
+		SetMessageID(messageID)
+	if serverMessageID != 0 {
+		update.SetServerMessageID(serverMessageID)
+	}
+	return update.Save(ctx)
+}
+
+// UpdateContent 消息被编辑后更新已保存的文本、语言、内容类型和编辑时间（data.EditedAt），
+// 按 ChatID 和 MessageID 匹配，返回更新的数量；消息未保存时返回 0
+func (m *MessageModel) UpdateContent(ctx context.Context, data *MessageData) (int, error) {
+	update := m.client.Update().
+		Where(
+			message.ChatID(data.ChatID),
+			message.MessageID(data.MessageID),
+		).
+		SetNillableEditedAt(data.EditedAt)
+
+	if compressed := compressText(data.Text, m.compressMinBytes); compressed != nil {
+		update.SetText("").SetTextZstd(compressed)
+	} else {
+		update.SetText(data.Text).ClearTextZstd()
+	}
+
+	if data.Lang != "" {
+		update.SetLang(data.Lang)
+	} else {
+		update.ClearLang()
+	}
+	if data.ContentType != "" {
+		update.SetContentType(data.ContentType)
+	}
+	return update.Save(ctx)
+}
+
+// UpdateReactionCount 消息的回应变化后更新已保存的回应总数，返回更新的数量；消息未保存时返回 0
+func (m *MessageModel) UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error) {
+	return m.client.Update().
+		Where(
+			message.ChatID(chatID),
+			message.MessageID(messageID),
+		).
+		SetReactionCount(count).
+		Save(ctx)
+}
+
+// UpdatePoll 投票的得票情况变化后更新已保存的投票，返回更新的数量；消息未保存时返回 0
+func (m *MessageModel) UpdatePoll(ctx context.Context, chatID, messageID int64, poll *Poll) (int, error) {
+	data, err := EncodePoll(poll)
+	if err != nil {
+		return 0, err
+	}
+	return m.client.Update().
+		Where(
+			message.ChatID(chatID),
+			message.MessageID(messageID),
+		).
+		SetPoll(data).
+		Save(ctx)
 }
 
 // GetByDateAndChat 按日期和群聊查询消息
@@ -47,89 +216,86 @@ func (m *MessageModel) GetByDateAndChat(ctx context.Context, chatID int64, date
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
-	return m.client.Query().
+	return decodeMessages(m.client.Query().
 		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
 			message.ChatIDEQ(chatID),
 			message.SentAtGTE(startOfDay),
 			message.SentAtLT(endOfDay),
 		).
 		Order(message.BySentAt()).
-		All(ctx)
+		All(ctx))
 }
 
 // GetSendersByDateAndChat 获取当日所有发言者（返回每个发送者的一条消息，用于获取发送者信息）
 func (m *MessageModel) GetSendersByDateAndChat(ctx context.Context, chatID int64, date time.Time) ([]*ent.Message, error) {
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	endOfDay := startOfDay.Add(24 * time.Hour)
-
-	// 获取所有消息，然后在应用层去重
-	allMessages, err := m.client.Query().
-		Where(
-			message.ChatIDEQ(chatID),
-			message.SentAtGTE(startOfDay),
-			message.SentAtLT(endOfDay),
-		).
-		Order(message.BySentAt()).
-		All(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// 按 sender_id 去重，保留每个发送者的第一条消息
-	senderMap := make(map[int64]*ent.Message)
-	for _, msg := range allMessages {
-		if _, exists := senderMap[msg.SenderID]; !exists {
-			senderMap[msg.SenderID] = msg
-		}
-	}
-
-	// 转换为切片
-	result := make([]*ent.Message, 0, len(senderMap))
-	for _, msg := range senderMap {
-		result = append(result, msg)
-	}
-
-	return result, nil
+	return m.GetSendersByDateRangeAndChat(ctx, chatID, startOfDay, startOfDay.Add(24*time.Hour))
 }
 
-// GetByDateRangeAndChat 查询时间区间内所有消息
+// GetByDateRangeAndChat 查询时间区间内所有消息（不含事件）
 func (m *MessageModel) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
-	return m.client.Query().
+	return decodeMessages(m.client.Query().
 		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
 			message.ChatIDEQ(chatID),
 			message.SentAtGTE(startTime),
 			message.SentAtLT(endTime),
 		).
 		Order(message.BySentAt()).
-		All(ctx)
+		All(ctx))
 }
 
-// GetSendersByDateRangeAndChat 获取时间区间内所有发言者
+// GetSendersByDateRangeAndChat 获取时间区间内所有发言者（每个发送者返回其第一条消息，按发送时间排序）。
+// 在数据库中按 sender_id 分组取最早的发送时间，无需加载区间内的全部消息；补录的历史消息 ID 大于之后收到的消息，
+// 因此按 sent_at 而不是 ID 判断第一条消息
 func (m *MessageModel) GetSendersByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
-	allMessages, err := m.client.Query().
+	messages, err := decodeMessages(m.client.Query().
 		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
 			message.ChatIDEQ(chatID),
 			message.SentAtGTE(startTime),
 			message.SentAtLT(endTime),
+			func(s *sql.Selector) {
+				t := sql.Table(message.Table)
+				first := sql.Select(t.C(message.FieldSenderID), sql.As(sql.Min(t.C(message.FieldSentAt)), "first_sent_at")).
+					From(t).
+					Where(sql.And(
+						sql.IsNull(t.C(message.FieldDeletedAt)),
+						sql.Or(
+							sql.IsNull(t.C(message.FieldContentType)),
+							sql.NotIn(t.C(message.FieldContentType), eventContentTypeArgs()...),
+						),
+						sql.EQ(t.C(message.FieldChatID), chatID),
+						sql.GTE(t.C(message.FieldSentAt), startTime),
+						sql.LT(t.C(message.FieldSentAt), endTime),
+					)).
+					GroupBy(t.C(message.FieldSenderID)).
+					As("first")
+				s.Join(first).
+					On(s.C(message.FieldSenderID), first.C(message.FieldSenderID)).
+					Where(sql.ColumnsEQ(s.C(message.FieldSentAt), first.C("first_sent_at")))
+			},
 		).
-		Order(message.BySentAt()).
-		All(ctx)
+		Order(message.BySentAt(), message.ByID()).
+		All(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	senderMap := make(map[int64]*ent.Message)
-	for _, msg := range allMessages {
-		if _, exists := senderMap[msg.SenderID]; !exists {
-			senderMap[msg.SenderID] = msg
+	// 同一发送者在同一时刻发送多条消息时只保留一条
+	seen := make(map[int64]bool, len(messages))
+	senders := messages[:0]
+	for _, msg := range messages {
+		if !seen[msg.SenderID] {
+			seen[msg.SenderID] = true
+			senders = append(senders, msg)
 		}
 	}
-
-	result := make([]*ent.Message, 0, len(senderMap))
-	for _, msg := range senderMap {
-		result = append(result, msg)
-	}
-	return result, nil
+	return senders, nil
 }
 
 // GetBySenderDateAndChat 获取指定发送者在指定日期的所有消息
@@ -137,21 +303,110 @@ func (m *MessageModel) GetBySenderDateAndChat(ctx context.Context, chatID int64,
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
-	return m.client.Query().
+	return decodeMessages(m.client.Query().
 		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
 			message.ChatIDEQ(chatID),
 			message.SenderIDEQ(senderID),
 			message.SentAtGTE(startOfDay),
 			message.SentAtLT(endOfDay),
 		).
 		Order(message.BySentAt()).
+		All(ctx))
+}
+
+// GetBySenderAndDateRange 获取指定发送者在时间区间内于所有群组的消息
+func (m *MessageModel) GetBySenderAndDateRange(ctx context.Context, senderID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return decodeMessages(m.client.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
+			message.SenderIDEQ(senderID),
+			message.SentAtGTE(startTime),
+			message.SentAtLT(endTime),
+		).
+		Order(message.BySentAt()).
+		All(ctx))
+}
+
+// mentionScanBatch 查询提及候选时每批读取的消息数
+const mentionScanBatch = 500
+
+// GetMentionCandidates 获取时间区间内其他成员发送的、包含 keyword（不区分大小写）的消息，按发送时间倒序，最多 limit 条。
+// 仅为子串匹配，调用方需自行确认是否为完整的提及。压缩保存的消息无法在数据库中匹配，解压后再过滤，
+// 因此按批读取，凑满 limit 条即停止，不一次加载区间内的全部压缩消息
+func (m *MessageModel) GetMentionCandidates(ctx context.Context, senderID int64, keyword string, startTime, endTime time.Time, limit int) ([]*ent.Message, error) {
+	return m.mentionCandidates(ctx, senderID, keyword, startTime, endTime, limit, mentionScanBatch)
+}
+
+// mentionCandidates 按 (发送时间, ID) 倒序每批读取 batch 条候选消息，从上一批的最后一条之后继续
+func (m *MessageModel) mentionCandidates(ctx context.Context, senderID int64, keyword string, startTime, endTime time.Time, limit, batch int) ([]*ent.Message, error) {
+	lowerKeyword := strings.ToLower(keyword)
+	var matched []*ent.Message
+	var last *ent.Message
+	for {
+		query := m.client.Query().
+			Where(
+				message.DeletedAtIsNil(),
+				notEvent(),
+				message.SenderIDNEQ(senderID),
+				message.Or(
+					message.TextContainsFold(keyword),
+					message.TextZstdNotNil(),
+				),
+				message.SentAtGTE(startTime),
+				message.SentAtLT(endTime),
+			)
+		if last != nil {
+			query = query.Where(message.Or(
+				message.SentAtLT(last.SentAt),
+				message.And(message.SentAtEQ(last.SentAt), message.IDLT(last.ID)),
+			))
+		}
+		messages, err := decodeMessages(query.
+			Order(ent.Desc(message.FieldSentAt), ent.Desc(message.FieldID)).
+			Limit(batch).
+			All(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, msg := range messages {
+			if strings.Contains(strings.ToLower(msg.Text), lowerKeyword) {
+				matched = append(matched, msg)
+				if len(matched) == limit {
+					return matched, nil
+				}
+			}
+		}
+		if len(messages) < batch {
+			return matched, nil
+		}
+		last = messages[len(messages)-1]
+	}
+}
+
+// GetEventsByDateRangeAndChat 查询时间区间内记录的事件（非文字消息），按发送时间排序
+func (m *MessageModel) GetEventsByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return m.client.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			message.ContentTypeIn(EventContentTypes...),
+			message.ChatIDEQ(chatID),
+			message.SentAtGTE(startTime),
+			message.SentAtLT(endTime),
+		).
+		Order(message.BySentAt()).
 		All(ctx)
 }
 
-// GetChatIDsByDateRange 查询指定时间区间内有消息的所有群组ID
+// GetChatIDsByDateRange 查询指定时间区间内有消息（不含事件）的所有群组ID
 func (m *MessageModel) GetChatIDsByDateRange(ctx context.Context, startTime, endTime time.Time) ([]int64, error) {
 	messages, err := m.client.Query().
 		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
 			message.SentAtGTE(startTime),
 			message.SentAtLT(endTime),
 		).
@@ -176,9 +431,110 @@ func (m *MessageModel) GetChatIDsByDateRange(ctx context.Context, startTime, end
 	return chatIDs, nil
 }
 
-// DeleteBefore 删除指定日期之前的消息
-func (m *MessageModel) DeleteBefore(ctx context.Context, cutoffDate time.Time) (int, error) {
-	return m.client.Delete().
-		Where(message.SentAtLT(cutoffDate)).
-		Exec(ctx)
+// CountByChat 查询指定时间区间内各群组未删除的消息数（不含事件）
+func (m *MessageModel) CountByChat(ctx context.Context, startTime, endTime time.Time) (map[int64]int, error) {
+	var rows []struct {
+		ChatID int64 `json:"chat_id"`
+		Count  int   `json:"count"`
+	}
+	err := m.client.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			notEvent(),
+			message.SentAtGTE(startTime),
+			message.SentAtLT(endTime),
+		).
+		GroupBy(message.FieldChatID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.ChatID] = row.Count
+	}
+	return counts, nil
+}
+
+// GetSenderIDs 查询所有未删除消息的发送用户ID（去重，不含以群组或频道身份发送的消息）
+func (m *MessageModel) GetSenderIDs(ctx context.Context) ([]int64, error) {
+	var senderIDs []int64
+	err := m.client.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			message.SenderIDGT(0),
+		).
+		Unique(true).
+		Select(message.FieldSenderID).
+		Scan(ctx, &senderIDs)
+	return senderIDs, err
+}
+
+// SoftDeleteBefore 软删除指定日期之前的消息（标记 deleted_at），单次最多 limit 条，返回本批软删除的数量。
+// 调用方分批调用直到返回数量小于 limit，已软删除的消息由 PurgeDeleted 物理删除
+func (m *MessageModel) SoftDeleteBefore(ctx context.Context, cutoffDate time.Time, limit int) (int, error) {
+	ids, err := m.client.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			message.SentAtLT(cutoffDate),
+		).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return m.client.Update().
+		Where(message.IDIn(ids...)).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+}
+
+// SoftDeleteByChat 软删除指定群聊的全部消息（群聊退出数据收集时调用），返回软删除的数量
+func (m *MessageModel) SoftDeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	return m.client.Update().
+		Where(
+			message.ChatIDEQ(chatID),
+			message.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+}
+
+// SoftDeleteByChatRange 软删除群聊在 [startTime, endTime) 内发送的消息（/redact 排除敏感讨论），返回软删除的数量
+func (m *MessageModel) SoftDeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error) {
+	return m.client.Update().
+		Where(
+			message.ChatIDEQ(chatID),
+			message.SentAtGTE(startTime),
+			message.SentAtLT(endTime),
+			message.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+}
+
+// SoftDeleteByMessageIDs 软删除群聊中已被删除的消息（TDLib 消息ID），不再参与总结，返回软删除的数量
+func (m *MessageModel) SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error) {
+	return m.client.Update().
+		Where(
+			message.ChatIDEQ(chatID),
+			message.MessageIDIn(messageIDs...),
+			message.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+}
+
+// PurgeDeleted 物理删除已软删除的消息，单次最多 limit 条（单独一个事务），返回本批删除的数量
+func (m *MessageModel) PurgeDeleted(ctx context.Context, limit int) (int, error) {
+	ids, err := m.client.Query().
+		Where(message.DeletedAtNotNil()).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return m.client.Delete().Where(message.IDIn(ids...)).Exec(ctx)
 }
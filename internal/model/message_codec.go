@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/klauspost/compress/zstd"
+)
+
+// 编码器和解码器的 EncodeAll / DecodeAll 可并发调用，全局共用
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// compressText 压缩消息文本；短于 minBytes 或压缩后没有变小时返回 nil，此时按原文保存
+func compressText(text string, minBytes int) []byte {
+	if minBytes <= 0 || len(text) < minBytes {
+		return nil
+	}
+	compressed := zstdEncoder.EncodeAll([]byte(text), nil)
+	if len(compressed) >= len(text) {
+		return nil
+	}
+	return compressed
+}
+
+// decodeMessage 将压缩保存的消息文本解压到 Text，调用方无需关心消息是否压缩
+func decodeMessage(m *ent.Message) error {
+	if m.TextZstd == nil {
+		return nil
+	}
+	text, err := zstdDecoder.DecodeAll(m.TextZstd, nil)
+	if err != nil {
+		return fmt.Errorf("解压消息文本失败 (id=%d): %w", m.ID, err)
+	}
+	m.Text = string(text)
+	m.TextZstd = nil
+	return nil
+}
+
+// decodeMessages 解压查询结果中的消息文本，可直接包装 Query().All 的返回值
+func decodeMessages(messages []*ent.Message, err error) ([]*ent.Message, error) {
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range messages {
+		if err := decodeMessage(m); err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
@@ -0,0 +1,457 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSendersByDateRangeAndChat(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "早", SentAt: day.Add(1 * time.Hour)},
+		{MessageID: 2, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "早上好", SentAt: day.Add(2 * time.Hour)},
+		{MessageID: 3, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "开会了", SentAt: day.Add(3 * time.Hour)},
+		{MessageID: 4, ChatID: -200, SenderID: 30, SenderName: "Carol", Text: "其他群", SentAt: day.Add(3 * time.Hour)},
+		{MessageID: 5, ChatID: -100, SenderID: 30, SenderName: "Carol", Text: "区间外", SentAt: day.Add(25 * time.Hour)},
+	} {
+		_, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	senders, err := m.GetSendersByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, senders, 2)
+	assert.Equal(t, int64(1), senders[0].MessageID, "每个发送者返回第一条消息")
+	assert.Equal(t, int64(2), senders[1].MessageID)
+
+	senders, err = m.GetSendersByDateAndChat(ctx, -100, day.Add(12*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, senders, 2)
+
+	t.Run("补录的更早消息", func(t *testing.T) {
+		for _, data := range []MessageData{
+			{MessageID: 6, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "补录", SentAt: day.Add(30 * time.Minute)},
+			{MessageID: 7, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "同一时刻", SentAt: day.Add(30 * time.Minute)},
+		} {
+			_, err := m.Create(ctx, &data)
+			require.NoError(t, err)
+		}
+		senders, err := m.GetSendersByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		require.Len(t, senders, 2)
+		assert.Equal(t, int64(6), senders[0].MessageID, "按发送时间而不是 ID 判断第一条消息")
+		assert.Equal(t, int64(1), senders[1].MessageID)
+	})
+}
+
+func TestGetMentionCandidates(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "@Alice 看一下", SentAt: day.Add(1 * time.Hour)},
+		{MessageID: 2, ChatID: -200, SenderID: 30, SenderName: "Carol", Text: "问问 @alice", SentAt: day.Add(2 * time.Hour)},
+		{MessageID: 3, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "我是 @alice", SentAt: day.Add(3 * time.Hour)},
+		{MessageID: 4, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "@alice 区间外", SentAt: day.Add(25 * time.Hour)},
+	} {
+		_, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	mentions, err := m.GetMentionCandidates(ctx, 10, "@alice", day, day.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	require.Len(t, mentions, 2, "跨群组查询，不含本人发送的消息")
+	assert.Equal(t, int64(2), mentions[0].MessageID, "按发送时间倒序")
+	assert.Equal(t, int64(1), mentions[1].MessageID, "不区分大小写")
+
+	own, err := m.GetBySenderAndDateRange(ctx, 10, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, own, 1)
+	assert.Equal(t, int64(3), own[0].MessageID)
+}
+
+func TestSoftDeleteAndPurge(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		_, err := m.Create(ctx, &MessageData{MessageID: int64(i + 1), ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "消息", SentAt: day.Add(time.Duration(i) * 12 * time.Hour)})
+		require.NoError(t, err)
+	}
+
+	deleted, err := m.SoftDeleteBefore(ctx, day.AddDate(0, 0, 1), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "单批最多 limit 条")
+
+	deleted, err = m.SoftDeleteBefore(ctx, day.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "已软删除的消息不重复标记")
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	assert.Len(t, messages, 3, "软删除的消息不再出现在查询中")
+
+	purged, err := m.PurgeDeleted(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, purged)
+
+	count, err := m.client.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	_, err = m.Create(ctx, &MessageData{MessageID: 6, ChatID: -200, SenderID: 10, SenderName: "Alice", Text: "其他群", SentAt: day})
+	require.NoError(t, err)
+	deleted, err = m.SoftDeleteByChatRange(ctx, -100, day.AddDate(0, 0, 1), day.Add(36*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "区间包含开始、不包含结束")
+
+	deleted, err = m.SoftDeleteByChat(ctx, -100)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted, "只软删除指定群聊的消息")
+}
+
+func TestSoftDeleteByMessageIDs(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "冲动发言", SentAt: day.Add(1 * time.Hour)},
+		{MessageID: 2, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "正常讨论", SentAt: day.Add(2 * time.Hour)},
+		{MessageID: 3, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "再次冲动", SentAt: day.Add(3 * time.Hour)},
+		{MessageID: 1, ChatID: -200, SenderID: 30, SenderName: "Carol", Text: "其他群", SentAt: day.Add(1 * time.Hour)},
+	} {
+		_, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	deleted, err := m.SoftDeleteByMessageIDs(ctx, -100, []int64{1, 3, 99})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted, "未保存的消息ID忽略")
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 1, "已删除的消息不参与总结")
+	assert.Equal(t, int64(2), messages[0].MessageID)
+
+	messages, err = m.GetByDateRangeAndChat(ctx, -200, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Len(t, messages, 1, "其他群组的同一消息ID不受影响")
+}
+
+func TestGetSenderIDs(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	now := time.Now()
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "早", SentAt: now},
+		{MessageID: 2, ChatID: -200, SenderID: 10, SenderName: "Alice", Text: "早上好", SentAt: now},
+		{MessageID: 3, ChatID: -100, SenderID: 20, SenderName: "Bob", Text: "开会了", SentAt: now},
+		{MessageID: 4, ChatID: -100, SenderID: 0, SenderName: "", Text: "匿名", SentAt: now},
+		{MessageID: 5, ChatID: -100, SenderID: -100, SenderName: "Go 夜读", SenderType: SenderTypeChat, Text: "匿名管理员", SentAt: now},
+	} {
+		_, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	senderIDs, err := m.GetSenderIDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{10, 20}, senderIDs, "不含以群组身份发送的消息")
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, now.Add(-time.Minute), now.Add(time.Minute))
+	require.NoError(t, err)
+	types := make(map[int64]string, len(messages))
+	for _, msg := range messages {
+		types[msg.MessageID] = msg.SenderType
+	}
+	assert.Equal(t, SenderTypeChat, types[5])
+	assert.Empty(t, types[1], "未指定发送者类型时为空")
+}
+
+func TestCountByChat(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, Text: "早", SentAt: day.Add(1 * time.Hour)},
+		{MessageID: 2, ChatID: -100, SenderID: 20, Text: "早上好", SentAt: day.Add(2 * time.Hour)},
+		{MessageID: 3, ChatID: -200, SenderID: 30, Text: "其他群", SentAt: day.Add(3 * time.Hour)},
+		{MessageID: 4, ChatID: -300, SenderID: 30, Text: "区间外", SentAt: day.Add(25 * time.Hour)},
+	} {
+		_, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+	}
+
+	counts, err := m.CountByChat(ctx, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{-100: 2, -200: 1}, counts)
+}
+
+func TestMessageCompression(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	m := NewMessageModel(client.Message)
+	m.EnableCompression(64)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	long := strings.Repeat("今天讨论了发布计划，@carol 负责回归测试。", 10)
+	for _, data := range []MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, Text: "早", SentAt: day.Add(1 * time.Hour)},
+		{MessageID: 2, ChatID: -100, SenderID: 20, Text: long, SentAt: day.Add(2 * time.Hour)},
+		{MessageID: 3, ChatID: -100, SenderID: 20, Text: strings.Repeat("无关内容。", 30), SentAt: day.Add(3 * time.Hour)},
+	} {
+		msg, err := m.Create(ctx, &data)
+		require.NoError(t, err)
+		assert.Equal(t, data.Text, msg.Text, "返回原文")
+	}
+
+	stored, err := client.Message.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, stored, 3)
+	assert.Equal(t, "早", stored[0].Text, "短消息不压缩")
+	assert.Nil(t, stored[0].TextZstd)
+	assert.Empty(t, stored[1].Text)
+	assert.Less(t, len(stored[1].TextZstd), len(long))
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	assert.Equal(t, long, messages[1].Text, "读取时解压")
+	assert.Nil(t, messages[1].TextZstd)
+
+	candidates, err := m.GetMentionCandidates(ctx, 30, "@Carol", day, day.AddDate(0, 0, 1), 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1, "解压后匹配关键词")
+	assert.Equal(t, int64(2), candidates[0].MessageID)
+
+	candidates, err = m.mentionCandidates(ctx, 30, "@carol", day, day.AddDate(0, 0, 1), 10, 1)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1, "分批读取，跨批继续查找")
+	assert.Equal(t, int64(2), candidates[0].MessageID)
+
+	// 关闭压缩后已压缩的消息仍可读取
+	m = NewMessageModel(client.Message)
+	messages, err = m.GetBySenderAndDateRange(ctx, 20, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, long, messages[0].Text)
+}
+
+// BenchmarkMessageCodec 比较不同长度消息的压缩、解压耗时及压缩率（ratio 为压缩后与原文的字节数之比）
+func BenchmarkMessageCodec(b *testing.B) {
+	for _, size := range []int{256, 1024, 4096} {
+		text := benchmarkMessageText(size)
+		compressed := compressText(text, 1)
+
+		b.Run(fmt.Sprintf("compress/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				compressText(text, 1)
+			}
+			b.ReportMetric(float64(len(compressed))/float64(len(text)), "ratio")
+		})
+		b.Run(fmt.Sprintf("decompress/%d", size), func(b *testing.B) {
+			b.SetBytes(int64(len(text)))
+			for i := 0; i < b.N; i++ {
+				if _, err := zstdDecoder.DecodeAll(compressed, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkMessageText 生成约 size 字节、中英文混合的聊天文本
+func benchmarkMessageText(size int) string {
+	words := []string{"部署", "回滚", "release", "今天", "服务", "测试", "latency", "数据库", "告警", "已修复", "PR", "review", "明天", "上线"}
+	var sb strings.Builder
+	for i := 0; sb.Len() < size; i++ {
+		sb.WriteString(words[(i*7+i/3)%len(words)])
+		if i%5 == 4 {
+			sb.WriteString("，")
+		} else {
+			sb.WriteString(" ")
+		}
+	}
+	return sb.String()
+}
+
+func TestMessageIDs(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	now := time.Now()
+	_, err := m.Create(ctx, &MessageData{MessageID: 5 << 20, ServerMessageID: 5, ChatID: -100, SenderName: "Alice", Text: "已发送", SentAt: now})
+	require.NoError(t, err)
+	_, err = m.Create(ctx, &MessageData{MessageID: 6<<20 + 1, ChatID: -100, SenderName: "Bob", Text: "发送中", SentAt: now.Add(time.Second)})
+	require.NoError(t, err)
+
+	exists, err := m.Exists(ctx, -100, 5<<20)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = m.Exists(ctx, -200, 5<<20)
+	require.NoError(t, err)
+	assert.False(t, exists, "不同群组的相同消息ID不算重复")
+
+	n, err := m.UpdateMessageID(ctx, -100, 6<<20+1, 6<<20, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, now.Add(-time.Minute), now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, int64(5), messages[0].ServerMessageID)
+	assert.Equal(t, int64(6<<20), messages[1].MessageID)
+	assert.Equal(t, int64(6), messages[1].ServerMessageID)
+}
+
+func TestMessageContentType(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	_, err := m.Create(ctx, &MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "发布截图", SentAt: day.Add(time.Hour), ContentType: "caption"})
+	require.NoError(t, err)
+	_, err = m.Create(ctx, &MessageData{MessageID: 2, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "旧数据", SentAt: day.Add(2 * time.Hour)})
+	require.NoError(t, err)
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "caption", messages[0].ContentType)
+	assert.Empty(t, messages[1].ContentType, "未指定内容类型时为空")
+}
+
+func TestMessageUpdateContent(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	m := NewMessageModel(client.Message)
+	m.EnableCompression(64)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	long := strings.Repeat("今天讨论了发布计划，@carol 负责回归测试。", 10)
+	_, err := m.Create(ctx, &MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: long, SentAt: day.Add(time.Hour), Lang: "zh", ContentType: "text"})
+	require.NoError(t, err)
+
+	editedAt := day.Add(2 * time.Hour)
+	n, err := m.UpdateContent(ctx, &MessageData{MessageID: 1, ChatID: -100, Text: "release moved to Friday", Lang: "en", ContentType: "text", EditedAt: &editedAt})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	stored, err := client.Message.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "release moved to Friday", stored.Text)
+	assert.Nil(t, stored.TextZstd, "编辑后的短文本不压缩，清除原压缩内容")
+	assert.Equal(t, "en", stored.Lang)
+	require.NotNil(t, stored.EditedAt)
+	assert.True(t, stored.EditedAt.Equal(editedAt))
+	assert.Equal(t, "Alice", stored.SenderName, "发送者和发送时间不变")
+	assert.True(t, stored.SentAt.Equal(day.Add(time.Hour)))
+
+	n, err = m.UpdateContent(ctx, &MessageData{MessageID: 2, ChatID: -100, Text: "未保存", EditedAt: &editedAt})
+	require.NoError(t, err)
+	assert.Zero(t, n, "消息未保存时不更新")
+}
+
+func TestMessageUpdateReactionCount(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	_, err := m.Create(ctx, &MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "周五发布", SentAt: day.Add(time.Hour), ReactionCount: 2})
+	require.NoError(t, err)
+
+	n, err := m.UpdateReactionCount(ctx, -100, 1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = m.UpdateReactionCount(ctx, -100, 2, 1)
+	require.NoError(t, err)
+	assert.Zero(t, n, "消息未保存时不更新")
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, int64(5), messages[0].ReactionCount)
+}
+
+func TestMessageUpdatePoll(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	poll := &Poll{Question: "周会时间", Options: []PollOption{{Text: "周三"}, {Text: "周四"}}, TotalVoters: 1}
+	_, err := m.Create(ctx, &MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "周会时间", SentAt: day.Add(time.Hour), Poll: poll})
+	require.NoError(t, err)
+
+	closed := &Poll{Question: "周会时间", Options: []PollOption{{Text: "周三", Voters: 2}, {Text: "周四", Voters: 1}}, TotalVoters: 3, Closed: true}
+	n, err := m.UpdatePoll(ctx, -100, 1, closed)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = m.UpdatePoll(ctx, -100, 2, closed)
+	require.NoError(t, err)
+	assert.Zero(t, n, "消息未保存时不更新")
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	got, err := DecodePoll(messages[0].Poll)
+	require.NoError(t, err)
+	assert.Equal(t, closed, got)
+
+	got, err = DecodePoll("")
+	require.NoError(t, err)
+	assert.Nil(t, got, "非投票消息")
+}
+
+func TestMessageEvents(t *testing.T) {
+	ctx := context.Background()
+	m := NewMessageModel(newTestClient(t).Message)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []*MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", Text: "周五发布", SentAt: day.Add(time.Hour), ContentType: "text"},
+		{MessageID: 2, ChatID: -100, SenderID: 20, SenderName: "Bob", SentAt: day.Add(2 * time.Hour), ContentType: "sticker"},
+		{MessageID: 3, ChatID: -100, SenderID: 10, SenderName: "Alice", SentAt: day.Add(3 * time.Hour), ContentType: "animation"},
+		{MessageID: 4, ChatID: -200, SenderID: 20, SenderName: "Bob", SentAt: day.Add(time.Hour), ContentType: "photo"},
+	} {
+		_, err := m.Create(ctx, data)
+		require.NoError(t, err)
+	}
+
+	messages, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 1, "查询消息时不返回事件")
+	assert.Equal(t, int64(1), messages[0].MessageID)
+
+	senders, err := m.GetSendersByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, senders, 1)
+	assert.Equal(t, int64(10), senders[0].SenderID)
+
+	counts, err := m.CountByChat(ctx, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{-100: 1}, counts)
+
+	chatIDs, err := m.GetChatIDsByDateRange(ctx, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{-100}, chatIDs, "只有事件的群组不参与总结")
+
+	events, err := m.GetEventsByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "sticker", events[0].ContentType)
+	assert.Equal(t, "animation", events[1].ContentType)
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	entgo "entgo.io/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/mattn/go-sqlite3"
+)
+
+// Instrument 为 ent 客户端的查询和写入记录耗时及失败次数，通过 /metrics 导出，
+// 用于定位入库等高频路径上的慢查询
+func Instrument(client *ent.Client) {
+	client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (v ent.Value, err error) {
+			table, op := "", "Query"
+			if qc := entgo.QueryFromContext(ctx); qc != nil {
+				table, op = qc.Type, qc.Op
+			}
+			defer ObserveQuery("sqlite", table, op, time.Now(), &err)
+			return next.Query(ctx, q)
+		})
+	}))
+	client.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (v ent.Value, err error) {
+			defer ObserveQuery("sqlite", m.Type(), strings.TrimPrefix(m.Op().String(), "Op"), time.Now(), &err)
+			return next.Mutate(ctx, m)
+		})
+	})
+}
+
+// ObserveQuery 记录一次数据库操作的耗时（db_query_duration_seconds_sum/_count）、失败次数（db_query_errors_total）
+// 及其中因锁冲突失败的次数（db_lock_contention_total），记录不存在不计为失败。以 defer 调用，err 指向操作返回的错误
+func ObserveQuery(backend, table, op string, start time.Time, err *error) {
+	labels := []string{"backend", backend, "table", table, "op", op}
+	metrics.Observe("db_query_duration_seconds", time.Since(start).Seconds(), labels...)
+	if *err != nil && !ent.IsNotFound(*err) {
+		metrics.Inc(metrics.Name("db_query_errors_total", labels...))
+		if IsLockContention(*err) {
+			metrics.Inc(metrics.Name("db_lock_contention_total", labels...))
+		}
+	}
+}
+
+// IsLockContention 判断错误是否为 SQLite 锁冲突（database is locked / table is locked），
+// 等待超过 busy_timeout 仍未获得锁时返回，稍后重试通常可以成功
+func IsLockContention(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+}
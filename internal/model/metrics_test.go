@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrument(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	Instrument(client)
+	m := NewChatModel(client.Chat)
+
+	require.NoError(t, m.Save(ctx, -100, "测试群", chat.ChatTypeGroup))
+	title, err := m.GetTitle(ctx, -200)
+	require.NoError(t, err)
+	assert.Empty(t, title)
+
+	snapshot := metrics.Snapshot()
+	assert.Equal(t, float64(1), snapshot[`db_query_duration_seconds_count{backend="sqlite",table="Chat",op="Update"}`])
+	assert.Equal(t, float64(1), snapshot[`db_query_duration_seconds_count{backend="sqlite",table="Chat",op="Create"}`])
+	assert.Equal(t, float64(1), snapshot[`db_query_duration_seconds_count{backend="sqlite",table="Chat",op="Only"}`])
+	assert.Zero(t, snapshot[`db_query_errors_total{backend="sqlite",table="Chat",op="Only"}`], "记录不存在不计为失败")
+}
+
+func TestIsLockContention(t *testing.T) {
+	assert.True(t, IsLockContention(fmt.Errorf("ent: %w", sqlite3.Error{Code: sqlite3.ErrBusy})))
+	assert.True(t, IsLockContention(sqlite3.Error{Code: sqlite3.ErrLocked}))
+	assert.False(t, IsLockContention(sqlite3.Error{Code: sqlite3.ErrConstraint}))
+	assert.False(t, IsLockContention(errors.New("database is locked")), "仅识别 SQLite 错误码")
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Poll 投票的问题、选项及得票情况，以 JSON 保存在消息的 poll 字段。
+// Telegram 仅在投票结束或本账号已投票后公开各选项的得票数，此前各选项的 Voters 为 0
+type Poll struct {
+	Question    string       `json:"question"`
+	Options     []PollOption `json:"options"`
+	TotalVoters int32        `json:"total_voters"`     // 参与投票的人数
+	Closed      bool         `json:"closed,omitempty"` // 投票是否已结束
+}
+
+// PollOption 投票选项及其得票数
+type PollOption struct {
+	Text   string `json:"text"`
+	Voters int32  `json:"voters"`
+}
+
+// EncodePoll 将投票编码为 JSON，poll 为 nil 时返回空字符串
+func EncodePoll(poll *Poll) (string, error) {
+	if poll == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(poll)
+	if err != nil {
+		return "", fmt.Errorf("编码投票失败: %w", err)
+	}
+	return string(data), nil
+}
+
+// DecodePoll 解析消息 poll 字段中的投票，非投票消息（空字符串）返回 nil
+func DecodePoll(data string) (*Poll, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var poll Poll
+	if err := json.Unmarshal([]byte(data), &poll); err != nil {
+		return nil, fmt.Errorf("解析投票失败: %w", err)
+	}
+	return &poll, nil
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+)
+
+type RunLogModel struct {
+	client *ent.RunLogClient
+}
+
+func NewRunLogModel(client *ent.RunLogClient) *RunLogModel {
+	return &RunLogModel{client: client}
+}
+
+// Record 记录一条运行事件；runID、taskID、chatID 为 0 表示不关联
+func (m *RunLogModel) Record(ctx context.Context, runID, taskID int, chatID int64, event runlog.Event, detail string) error {
+	return m.client.Create().
+		SetRunID(runID).
+		SetTaskID(taskID).
+		SetChatID(chatID).
+		SetEvent(event).
+		SetDetail(detail).
+		Exec(ctx)
+}
+
+// ListByRun 按时间顺序列出运行的全部事件
+func (m *RunLogModel) ListByRun(ctx context.Context, runID int) ([]*ent.RunLog, error) {
+	return m.client.Query().
+		Where(runlog.RunIDEQ(runID)).
+		Order(ent.Asc(runlog.FieldID)).
+		All(ctx)
+}
+
+// ListByTask 按时间顺序列出任务的全部事件（含不属于某次运行的恢复处理）
+func (m *RunLogModel) ListByTask(ctx context.Context, taskID int) ([]*ent.RunLog, error) {
+	return m.client.Query().
+		Where(runlog.TaskIDEQ(taskID)).
+		Order(ent.Asc(runlog.FieldID)).
+		All(ctx)
+}
+
+// DeleteBefore 删除记录时间早于 cutoffDate 的事件（与消息同样按保留天数清理），返回删除的数量
+func (m *RunLogModel) DeleteBefore(ctx context.Context, cutoffDate time.Time) (int, error) {
+	return m.client.Delete().
+		Where(runlog.CreateTimeLT(cutoffDate)).
+		Exec(ctx)
+}
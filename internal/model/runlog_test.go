@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLog(t *testing.T) {
+	ctx := context.Background()
+	m := NewRunLogModel(newTestClient(t).RunLog)
+
+	require.NoError(t, m.Record(ctx, 1, 0, 0, runlog.EventRunStarted, ""))
+	require.NoError(t, m.Record(ctx, 1, 10, -100, runlog.EventTaskStarted, ""))
+	require.NoError(t, m.Record(ctx, 1, 10, -100, runlog.EventChunkCompleted, "1/2"))
+	require.NoError(t, m.Record(ctx, 0, 10, -100, runlog.EventNotifySent, ""))
+	require.NoError(t, m.Record(ctx, 2, 20, -200, runlog.EventTaskStarted, ""))
+
+	logs, err := m.ListByRun(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, runlog.EventRunStarted, logs[0].Event)
+	assert.Equal(t, runlog.EventChunkCompleted, logs[2].Event)
+	assert.Equal(t, "1/2", logs[2].Detail)
+
+	logs, err = m.ListByTask(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 3, "包含不属于某次运行的恢复处理事件")
+	assert.Equal(t, runlog.EventNotifySent, logs[2].Event)
+
+	deleted, err := m.DeleteBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, deleted, "保留期内的事件不删除")
+
+	deleted, err = m.DeleteBefore(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 5, deleted)
+}
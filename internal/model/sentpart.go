@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sentpart"
+)
+
+type SentPartModel struct {
+	client *ent.SentPartClient
+}
+
+func NewSentPartModel(client *ent.SentPartClient) *SentPartModel {
+	return &SentPartModel{client: client}
+}
+
+// IsSent 检查幂等键对应的消息分段是否已发送
+func (m *SentPartModel) IsSent(ctx context.Context, key string) (bool, error) {
+	return m.client.Query().Where(sentpart.KeyEQ(key)).Exist(ctx)
+}
+
+// MarkSent 记录消息分段已发送及其消息ID（重复记录忽略）
+func (m *SentPartModel) MarkSent(ctx context.Context, key string, messageID int64) error {
+	err := m.client.Create().SetKey(key).SetMessageID(messageID).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
+
+// UpdateSentMessageID 更新已发送分段的消息ID（发送确认超时、之后才收到正式消息ID时调用）
+func (m *SentPartModel) UpdateSentMessageID(ctx context.Context, key string, messageID int64) error {
+	return m.client.Update().Where(sentpart.KeyEQ(key)).SetMessageID(messageID).Exec(ctx)
+}
+
+// SentMessageID 返回已发送分段的消息ID，未发送或未记录时返回 0
+func (m *SentPartModel) SentMessageID(ctx context.Context, key string) (int64, error) {
+	part, err := m.client.Query().Where(sentpart.KeyEQ(key)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return part.MessageID, nil
+}
+
+// ClearSent 删除指定前缀的发送记录，返回删除数量
+func (m *SentPartModel) ClearSent(ctx context.Context, prefix string) (int, error) {
+	return m.client.Delete().Where(sentpart.KeyHasPrefix(prefix)).Exec(ctx)
+}
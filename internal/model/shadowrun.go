@@ -0,0 +1,120 @@
+package model
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/shadowrun"
+)
+
+type ShadowRunModel struct {
+	client *ent.ShadowRunClient
+}
+
+func NewShadowRunModel(client *ent.ShadowRunClient) *ShadowRunModel {
+	return &ShadowRunModel{client: client}
+}
+
+type ShadowRunData struct {
+	ChatID         int64
+	StartTime      time.Time
+	EndTime        time.Time
+	PrimaryModel   string
+	ShadowModel    string
+	PrimaryContent string
+	ShadowContent  string
+	ShadowError    string
+	ShadowTokens   int
+	PrimaryTopics  int
+	ShadowTopics   int
+	MatchedTopics  int
+	MessageOverlap float64
+}
+
+// ShadowStats 单个群组的模型对比统计
+type ShadowStats struct {
+	ChatID         int64
+	Runs           int     // 对比次数
+	Failures       int     // 对比模型失败次数
+	PrimaryTopics  float64 // 主模型平均话题数
+	ShadowTopics   float64 // 对比模型平均话题数（不含失败）
+	MatchedRatio   float64 // 主模型话题被对比模型覆盖的比例（不含失败）
+	MessageOverlap float64 // 引用消息的平均重合度（不含失败）
+}
+
+// Create 保存一次模型对比结果
+func (m *ShadowRunModel) Create(ctx context.Context, data *ShadowRunData) error {
+	create := m.client.Create().
+		SetChatID(data.ChatID).
+		SetStartTime(data.StartTime).
+		SetEndTime(data.EndTime).
+		SetPrimaryModel(data.PrimaryModel).
+		SetShadowModel(data.ShadowModel).
+		SetPrimaryContent(data.PrimaryContent).
+		SetShadowTokens(data.ShadowTokens).
+		SetPrimaryTopics(data.PrimaryTopics).
+		SetShadowTopics(data.ShadowTopics).
+		SetMatchedTopics(data.MatchedTopics).
+		SetMessageOverlap(data.MessageOverlap)
+	if data.ShadowContent != "" {
+		create.SetShadowContent(data.ShadowContent)
+	}
+	if data.ShadowError != "" {
+		create.SetShadowError(data.ShadowError)
+	}
+	return create.Exec(ctx)
+}
+
+// StatsSince 统计 since 之后各群组的模型对比结果，按对比次数降序
+func (m *ShadowRunModel) StatsSince(ctx context.Context, since time.Time) ([]ShadowStats, error) {
+	runs, err := m.client.Query().
+		Where(shadowrun.CreateTimeGTE(since)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byChat := make(map[int64]*ShadowStats)
+	primaryTopics := make(map[int64]int)
+	matchedTopics := make(map[int64]int)
+	for _, run := range runs {
+		st, ok := byChat[run.ChatID]
+		if !ok {
+			st = &ShadowStats{ChatID: run.ChatID}
+			byChat[run.ChatID] = st
+		}
+		st.Runs++
+		st.PrimaryTopics += float64(run.PrimaryTopics)
+		if run.ShadowError != "" {
+			st.Failures++
+			continue
+		}
+		st.ShadowTopics += float64(run.ShadowTopics)
+		st.MessageOverlap += run.MessageOverlap
+		primaryTopics[run.ChatID] += run.PrimaryTopics
+		matchedTopics[run.ChatID] += run.MatchedTopics
+	}
+
+	stats := make([]ShadowStats, 0, len(byChat))
+	for chatID, st := range byChat {
+		st.PrimaryTopics /= float64(st.Runs)
+		if succeeded := st.Runs - st.Failures; succeeded > 0 {
+			st.ShadowTopics /= float64(succeeded)
+			st.MessageOverlap /= float64(succeeded)
+		}
+		if primaryTopics[chatID] > 0 {
+			st.MatchedRatio = float64(matchedTopics[chatID]) / float64(primaryTopics[chatID])
+		}
+		stats = append(stats, *st)
+	}
+	slices.SortFunc(stats, func(a, b ShadowStats) int {
+		if a.Runs != b.Runs {
+			return b.Runs - a.Runs
+		}
+		return cmp.Compare(a.ChatID, b.ChatID)
+	})
+	return stats, nil
+}
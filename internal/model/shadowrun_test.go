@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowRunStats(t *testing.T) {
+	ctx := context.Background()
+	m := NewShadowRunModel(newTestClient(t).ShadowRun)
+
+	start := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []ShadowRunData{
+		{ChatID: -100, PrimaryTopics: 4, ShadowTopics: 5, MatchedTopics: 3, MessageOverlap: 0.5},
+		{ChatID: -100, PrimaryTopics: 6, ShadowTopics: 7, MatchedTopics: 6, MessageOverlap: 0.7},
+		{ChatID: -100, PrimaryTopics: 5, ShadowError: "timeout"},
+		{ChatID: -200, PrimaryTopics: 2, ShadowTopics: 2, MatchedTopics: 1, MessageOverlap: 0.4},
+	} {
+		data.StartTime, data.EndTime = start, start.AddDate(0, 0, 1)
+		data.PrimaryModel, data.ShadowModel, data.PrimaryContent = "gpt-4o", "deepseek-chat", `{"topics":[]}`
+		require.NoError(t, m.Create(ctx, &data))
+	}
+
+	stats, err := m.StatsSince(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, int64(-100), stats[0].ChatID, "按对比次数降序")
+	assert.Equal(t, 3, stats[0].Runs)
+	assert.Equal(t, 1, stats[0].Failures)
+	assert.InDelta(t, 5, stats[0].PrimaryTopics, 1e-9)
+	assert.InDelta(t, 6, stats[0].ShadowTopics, 1e-9, "失败的对比不计入")
+	assert.InDelta(t, 0.9, stats[0].MatchedRatio, 1e-9)
+	assert.InDelta(t, 0.6, stats[0].MessageOverlap, 1e-9)
+	assert.Equal(t, int64(-200), stats[1].ChatID)
+	assert.InDelta(t, 0.5, stats[1].MatchedRatio, 1e-9)
+
+	stats, err = m.StatsSince(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
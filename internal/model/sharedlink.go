@@ -0,0 +1,87 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/sharedlink"
+)
+
+type SharedLinkModel struct {
+	client *ent.SharedLinkClient
+}
+
+func NewSharedLinkModel(client *ent.SharedLinkClient) *SharedLinkModel {
+	return &SharedLinkModel{client: client}
+}
+
+// CreateForMessage 保存消息中分享的链接（MessageData.Links），返回保存的数量
+func (m *SharedLinkModel) CreateForMessage(ctx context.Context, data *MessageData) (int, error) {
+	if len(data.Links) == 0 {
+		return 0, nil
+	}
+	builders := make([]*ent.SharedLinkCreate, len(data.Links))
+	for i, url := range data.Links {
+		builders[i] = m.client.Create().
+			SetChatID(data.ChatID).
+			SetMessageID(data.MessageID).
+			SetSenderID(data.SenderID).
+			SetSenderName(data.SenderName).
+			SetURL(url).
+			SetSentAt(data.SentAt)
+	}
+	links, err := m.client.CreateBulk(builders...).Save(ctx)
+	return len(links), err
+}
+
+// ReplaceForMessage 消息被编辑后以 MessageData.Links 替换该消息已保存的链接，返回保存的数量
+func (m *SharedLinkModel) ReplaceForMessage(ctx context.Context, data *MessageData) (int, error) {
+	_, err := m.client.Delete().
+		Where(
+			sharedlink.ChatIDEQ(data.ChatID),
+			sharedlink.MessageIDEQ(data.MessageID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return m.CreateForMessage(ctx, data)
+}
+
+// GetByDateRangeAndChat 查询群组时间区间 [startTime, endTime) 内分享的链接，按发送时间排序
+func (m *SharedLinkModel) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.SharedLink, error) {
+	return m.client.Query().
+		Where(
+			sharedlink.ChatIDEQ(chatID),
+			sharedlink.SentAtGTE(startTime),
+			sharedlink.SentAtLT(endTime),
+		).
+		Order(sharedlink.BySentAt(), sharedlink.ByID()).
+		All(ctx)
+}
+
+// DeleteBefore 删除发送时间早于 cutoffDate 的链接（与消息同样按保留天数清理），返回删除的数量
+func (m *SharedLinkModel) DeleteBefore(ctx context.Context, cutoffDate time.Time) (int, error) {
+	return m.client.Delete().
+		Where(sharedlink.SentAtLT(cutoffDate)).
+		Exec(ctx)
+}
+
+// DeleteByChat 删除群组分享的全部链接（群组退出数据收集）
+func (m *SharedLinkModel) DeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	return m.client.Delete().
+		Where(sharedlink.ChatIDEQ(chatID)).
+		Exec(ctx)
+}
+
+// DeleteByChatRange 删除群组时间区间 [startTime, endTime) 内分享的链接（/redact 排除区间）
+func (m *SharedLinkModel) DeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error) {
+	return m.client.Delete().
+		Where(
+			sharedlink.ChatIDEQ(chatID),
+			sharedlink.SentAtGTE(startTime),
+			sharedlink.SentAtLT(endTime),
+		).
+		Exec(ctx)
+}
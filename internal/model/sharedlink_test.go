@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedLinkModel(t *testing.T) {
+	ctx := context.Background()
+	m := NewSharedLinkModel(newTestClient(t).SharedLink)
+
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	for _, data := range []*MessageData{
+		{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", SentAt: day.Add(time.Hour), Links: []string{"https://go.dev/doc", "https://example.com"}},
+		{MessageID: 2, ChatID: -100, SenderID: 20, SenderName: "Bob", SentAt: day.Add(-time.Hour), Links: []string{"https://example.com/old"}},
+		{MessageID: 3, ChatID: -200, SenderID: 20, SenderName: "Bob", SentAt: day.Add(2 * time.Hour), Links: []string{"https://example.org"}},
+		{MessageID: 4, ChatID: -100, SenderID: 10, SenderName: "Alice", SentAt: day.Add(3 * time.Hour)},
+	} {
+		_, err := m.CreateForMessage(ctx, data)
+		require.NoError(t, err)
+	}
+
+	links, err := m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, "https://go.dev/doc", links[0].URL)
+	assert.Equal(t, "https://example.com", links[1].URL)
+	assert.Equal(t, int64(1), links[1].MessageID)
+	assert.Equal(t, "Alice", links[1].SenderName)
+
+	edited := &MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", SentAt: day.Add(time.Hour), Links: []string{"https://go.dev/blog"}}
+	saved, err := m.ReplaceForMessage(ctx, edited)
+	require.NoError(t, err)
+	assert.Equal(t, 1, saved)
+	links, err = m.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, links, 1, "编辑后替换该消息的全部链接")
+	assert.Equal(t, "https://go.dev/blog", links[0].URL)
+
+	deleted, err := m.DeleteBefore(ctx, day)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "只删除早于截止时间的链接")
+
+	deleted, err = m.DeleteByChatRange(ctx, -100, day, day.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	deleted, err = m.DeleteByChat(ctx, -200)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+)
+
+type SummaryRevisionModel struct {
+	client *ent.SummaryRevisionClient
+}
+
+func NewSummaryRevisionModel(client *ent.SummaryRevisionClient) *SummaryRevisionModel {
+	return &SummaryRevisionModel{client: client}
+}
+
+// Record 保存任务摘要的一个版本；与最新版本内容相同时不重复保存
+func (m *SummaryRevisionModel) Record(ctx context.Context, taskID int, chatID int64, content string, source summaryrevision.Source) error {
+	latest, err := m.Latest(ctx, taskID)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if latest != nil && latest.Content == content && latest.Source == source {
+		return nil
+	}
+
+	return m.client.Create().
+		SetTaskID(taskID).
+		SetChatID(chatID).
+		SetContent(content).
+		SetSource(source).
+		Exec(ctx)
+}
+
+// Latest 获取任务的最新版本
+func (m *SummaryRevisionModel) Latest(ctx context.Context, taskID int) (*ent.SummaryRevision, error) {
+	return m.client.Query().
+		Where(summaryrevision.TaskIDEQ(taskID)).
+		Order(ent.Desc(summaryrevision.FieldID)).
+		First(ctx)
+}
+
+// MarkLatestSent 将任务的最新版本标记为已发送
+func (m *SummaryRevisionModel) MarkLatestSent(ctx context.Context, taskID int, sentAt time.Time) error {
+	latest, err := m.Latest(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return m.client.UpdateOne(latest).SetSentAt(sentAt).Exec(ctx)
+}
+
+// ListByTask 按时间顺序列出任务的全部版本
+func (m *SummaryRevisionModel) ListByTask(ctx context.Context, taskID int) ([]*ent.SummaryRevision, error) {
+	return m.client.Query().
+		Where(summaryrevision.TaskIDEQ(taskID)).
+		Order(ent.Asc(summaryrevision.FieldID)).
+		All(ctx)
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryRevisionRecord(t *testing.T) {
+	ctx := context.Background()
+	m := NewSummaryRevisionModel(newTestClient(t).SummaryRevision)
+
+	require.NoError(t, m.MarkLatestSent(ctx, 1, time.Now()), "无版本时标记发送不报错")
+
+	require.NoError(t, m.Record(ctx, 1, -100, "初稿", summaryrevision.SourceGenerated))
+	require.NoError(t, m.Record(ctx, 1, -100, "初稿", summaryrevision.SourceGenerated), "内容未变不重复保存")
+	require.NoError(t, m.Record(ctx, 1, -100, "修改稿", summaryrevision.SourceEdited))
+	require.NoError(t, m.Record(ctx, 2, -200, "其他任务", summaryrevision.SourceGenerated))
+	require.NoError(t, m.MarkLatestSent(ctx, 1, time.Now()))
+
+	revisions, err := m.ListByTask(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "初稿", revisions[0].Content)
+	assert.Equal(t, summaryrevision.SourceGenerated, revisions[0].Source)
+	assert.True(t, revisions[0].SentAt.IsZero())
+	assert.Equal(t, "修改稿", revisions[1].Content)
+	assert.Equal(t, summaryrevision.SourceEdited, revisions[1].Source)
+	assert.False(t, revisions[1].SentAt.IsZero())
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryview"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type SummaryViewModel struct {
+	client *ent.SummaryViewClient
+}
+
+func NewSummaryViewModel(client *ent.SummaryViewClient) *SummaryViewModel {
+	return &SummaryViewModel{client: client}
+}
+
+// ViewStats 单个群组的总结查看统计
+type ViewStats struct {
+	ChatID    int64 `json:"chat_id"`
+	Summaries int   `json:"summaries"` // 有人查看过的总结数
+	Clicks    int   `json:"clicks"`    // 点击总次数
+	Viewers   int   `json:"viewers"`   // 查看过的不同用户数
+}
+
+// Record 记录一次总结查看（按钮点击），topic 为 -1 表示整份总结
+func (m *SummaryViewModel) Record(ctx context.Context, taskID int, chatID, userID int64, topic int) error {
+	return m.client.Create().
+		SetTaskID(taskID).
+		SetChatID(chatID).
+		SetUserID(userID).
+		SetTopic(topic).
+		Exec(ctx)
+}
+
+// StatsSince 按群组统计 since 之后的查看情况，按点击次数降序
+func (m *SummaryViewModel) StatsSince(ctx context.Context, since time.Time) ([]ViewStats, error) {
+	countDistinct := func(column, alias string) ent.AggregateFunc {
+		return func(s *sql.Selector) string {
+			return sql.As(fmt.Sprintf("COUNT(DISTINCT %s)", s.C(column)), alias)
+		}
+	}
+
+	var stats []ViewStats
+	err := m.client.Query().
+		Where(summaryview.CreateTimeGTE(since)).
+		GroupBy(summaryview.FieldChatID).
+		Aggregate(
+			countDistinct(summaryview.FieldTaskID, "summaries"),
+			ent.As(ent.Count(), "clicks"),
+			countDistinct(summaryview.FieldUserID, "viewers"),
+		).
+		Scan(ctx, &stats)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(stats, func(a, b ViewStats) int { return b.Clicks - a.Clicks })
+	return stats, nil
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryViewStats(t *testing.T) {
+	ctx := context.Background()
+	m := NewSummaryViewModel(newTestClient(t).SummaryView)
+
+	require.NoError(t, m.Record(ctx, 1, -100, 10, -1))
+	require.NoError(t, m.Record(ctx, 1, -100, 10, 0))
+	require.NoError(t, m.Record(ctx, 2, -100, 20, 1))
+	require.NoError(t, m.Record(ctx, 3, -200, 10, -1))
+
+	stats, err := m.StatsSince(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []ViewStats{
+		{ChatID: -100, Summaries: 2, Clicks: 3, Viewers: 2},
+		{ChatID: -200, Summaries: 1, Clicks: 1, Viewers: 1},
+	}, stats)
+
+	stats, err = m.StatsSince(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
@@ -16,25 +16,30 @@ func NewTaskModel(client *ent.TaskClient) *TaskModel {
 	return &TaskModel{client: client}
 }
 
+// scheduled 仅匹配定时总结任务：按需总结（/summary）不参与恢复、活跃度基线、失败告警及周报等统计
+var scheduled = task.KindEQ(task.KindScheduled)
+
 // CreateTask 创建任务
-func (m *TaskModel) CreateTask(ctx context.Context, chatID int64, startTime, endTime time.Time, status task.Status) (*ent.Task, error) {
+func (m *TaskModel) CreateTask(ctx context.Context, chatID int64, startTime, endTime time.Time, kind task.Kind, status task.Status) (*ent.Task, error) {
 	create := m.client.Create().
 		SetChatID(chatID).
 		SetStartTime(startTime).
 		SetEndTime(endTime).
+		SetKind(kind).
 		SetStatus(status)
 
 	return create.Save(ctx)
 }
 
-// GetOrCreateTask 获取或创建任务（如果已存在则返回现有任务）
-func (m *TaskModel) GetOrCreateTask(ctx context.Context, chatID int64, startTime, endTime time.Time, status task.Status) (*ent.Task, error) {
+// GetOrCreateTask 获取或创建指定类型的任务（如果已存在则返回现有任务）
+func (m *TaskModel) GetOrCreateTask(ctx context.Context, chatID int64, startTime, endTime time.Time, kind task.Kind, status task.Status) (*ent.Task, error) {
 	// 先尝试查询现有任务
 	existing, err := m.client.Query().
 		Where(
 			task.ChatIDEQ(chatID),
 			task.StartTimeEQ(startTime),
 			task.EndTimeEQ(endTime),
+			task.KindEQ(kind),
 		).
 		First(ctx)
 
@@ -49,7 +54,7 @@ func (m *TaskModel) GetOrCreateTask(ctx context.Context, chatID int64, startTime
 	}
 
 	// 任务不存在，创建新任务
-	return m.CreateTask(ctx, chatID, startTime, endTime, status)
+	return m.CreateTask(ctx, chatID, startTime, endTime, kind, status)
 }
 
 // UpdateTaskStatus 更新任务状态
@@ -70,7 +75,7 @@ func (m *TaskModel) UpdateTaskStatus(ctx context.Context, taskID int, status tas
 // GetPendingTasks 查询所有待处理的任务
 func (m *TaskModel) GetPendingTasks(ctx context.Context) ([]*ent.Task, error) {
 	return m.client.Query().
-		Where(task.StatusEQ(task.StatusPending)).
+		Where(scheduled, task.StatusEQ(task.StatusPending)).
 		Order(task.ByCreateTime()).
 		All(ctx)
 }
@@ -78,7 +83,7 @@ func (m *TaskModel) GetPendingTasks(ctx context.Context) ([]*ent.Task, error) {
 // GetProcessingTasks 查询所有处理中的任务
 func (m *TaskModel) GetProcessingTasks(ctx context.Context) ([]*ent.Task, error) {
 	return m.client.Query().
-		Where(task.StatusEQ(task.StatusProcessing)).
+		Where(scheduled, task.StatusEQ(task.StatusProcessing)).
 		Order(task.ByCreateTime()).
 		All(ctx)
 }
@@ -87,6 +92,7 @@ func (m *TaskModel) GetProcessingTasks(ctx context.Context) ([]*ent.Task, error)
 func (m *TaskModel) GetPendingOrProcessingTasks(ctx context.Context) ([]*ent.Task, error) {
 	return m.client.Query().
 		Where(
+			scheduled,
 			task.Or(
 				task.StatusEQ(task.StatusPending),
 				task.StatusEQ(task.StatusProcessing),
@@ -100,6 +106,7 @@ func (m *TaskModel) GetPendingOrProcessingTasks(ctx context.Context) ([]*ent.Tas
 func (m *TaskModel) GetTaskByChatAndDateRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (*ent.Task, error) {
 	return m.client.Query().
 		Where(
+			scheduled,
 			task.ChatIDEQ(chatID),
 			task.StartTimeEQ(startTime),
 			task.EndTimeEQ(endTime),
@@ -117,6 +124,11 @@ func (m *TaskModel) MarkTaskFailed(ctx context.Context, taskID int, errorMsg str
 	return m.UpdateTaskStatus(ctx, taskID, task.StatusFailed, &errorMsg)
 }
 
+// MarkTaskBlocked 标记任务因无权在群组发送消息而放弃，恢复流程不再重试
+func (m *TaskModel) MarkTaskBlocked(ctx context.Context, taskID int, errorMsg string) error {
+	return m.UpdateTaskStatus(ctx, taskID, task.StatusBlocked, &errorMsg)
+}
+
 // ResetTaskToPending 将任务重置为待处理状态（用于恢复）
 func (m *TaskModel) ResetTaskToPending(ctx context.Context, taskID int) error {
 	return m.client.UpdateOneID(taskID).
@@ -131,7 +143,98 @@ func (m *TaskModel) SetSummaryContent(ctx context.Context, taskID int, content s
 	return m.client.UpdateOneID(taskID).SetSummaryContent(content).Exec(ctx)
 }
 
+// ReplacePendingSummaryContent 替换尚未发送完成的摘要内容（人工修改），任务无待发送摘要时返回 false
+func (m *TaskModel) ReplacePendingSummaryContent(ctx context.Context, taskID int, content string) (bool, error) {
+	n, err := m.client.Update().
+		Where(task.ID(taskID), task.SummaryContentNEQ("")).
+		SetSummaryContent(content).
+		Save(ctx)
+	return n > 0, err
+}
+
 // ClearSummaryContent 清除任务的摘要内容（发送成功后调用）
 func (m *TaskModel) ClearSummaryContent(ctx context.Context, taskID int) error {
 	return m.client.UpdateOneID(taskID).ClearSummaryContent().Exec(ctx)
 }
+
+// SetSummaryJSON 保存结构化总结结果（JSON），发送成功后不清除
+func (m *TaskModel) SetSummaryJSON(ctx context.Context, taskID int, data string) error {
+	return m.client.UpdateOneID(taskID).SetSummaryJSON(data).Exec(ctx)
+}
+
+// SetPromptBudget 保存最近一次总结的 prompt token 构成（JSON）
+func (m *TaskModel) SetPromptBudget(ctx context.Context, taskID int, data string) error {
+	return m.client.UpdateOneID(taskID).SetPromptBudget(data).Exec(ctx)
+}
+
+// SetProgress 保存总结进度，用于观察长时间运行的总结是否仍在推进
+func (m *TaskModel) SetProgress(ctx context.Context, taskID int, chunk, chunks, tokens int) error {
+	return m.client.UpdateOneID(taskID).
+		SetProgressChunk(chunk).
+		SetProgressChunks(chunks).
+		SetProgressTokens(tokens).
+		Exec(ctx)
+}
+
+// SetActivity 保存任务区间的消息数和发言人数（活跃度基线）
+func (m *TaskModel) SetActivity(ctx context.Context, taskID int, messages, participants int) error {
+	return m.client.UpdateOneID(taskID).
+		SetMessageCount(messages).
+		SetParticipantCount(participants).
+		Exec(ctx)
+}
+
+// GetActivityHistory 查询群组在 [since, before) 内开始、已记录活跃度的任务（按开始时间倒序）
+func (m *TaskModel) GetActivityHistory(ctx context.Context, chatID int64, since, before time.Time) ([]*ent.Task, error) {
+	return m.client.Query().
+		Where(
+			scheduled,
+			task.ChatIDEQ(chatID),
+			task.StartTimeGTE(since),
+			task.StartTimeLT(before),
+			task.MessageCountGT(0),
+		).
+		Order(ent.Desc(task.FieldStartTime)).
+		All(ctx)
+}
+
+// GetTask 按 ID 查询任务
+func (m *TaskModel) GetTask(ctx context.Context, taskID int) (*ent.Task, error) {
+	return m.client.Get(ctx, taskID)
+}
+
+// GetRecentTasksByChat 查询指定群组最近的任务（按开始时间倒序）
+func (m *TaskModel) GetRecentTasksByChat(ctx context.Context, chatID int64, limit int) ([]*ent.Task, error) {
+	return m.client.Query().
+		Where(scheduled, task.ChatIDEQ(chatID)).
+		Order(ent.Desc(task.FieldStartTime)).
+		Limit(limit).
+		All(ctx)
+}
+
+// GetSummarizedByRange 查询开始时间在 [startTime, endTime) 内、已保存结构化总结结果的任务，按开始时间排序
+func (m *TaskModel) GetSummarizedByRange(ctx context.Context, startTime, endTime time.Time) ([]*ent.Task, error) {
+	return m.client.Query().
+		Where(
+			scheduled,
+			task.StartTimeGTE(startTime),
+			task.StartTimeLT(endTime),
+			task.SummaryJSONNEQ(""),
+		).
+		Order(task.ByStartTime()).
+		All(ctx)
+}
+
+// GetSummarizedByChatAndRange 查询群组开始时间在 [startTime, endTime) 内、已保存结构化总结结果的任务，按开始时间排序
+func (m *TaskModel) GetSummarizedByChatAndRange(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Task, error) {
+	return m.client.Query().
+		Where(
+			scheduled,
+			task.ChatIDEQ(chatID),
+			task.StartTimeGTE(startTime),
+			task.StartTimeLT(endTime),
+			task.SummaryJSONNEQ(""),
+		).
+		Order(task.ByStartTime()).
+		All(ctx)
+}
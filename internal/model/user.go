@@ -0,0 +1,92 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/user"
+)
+
+type UserModel struct {
+	client *ent.UserClient
+}
+
+func NewUserModel(client *ent.UserClient) *UserModel {
+	return &UserModel{client: client}
+}
+
+// Save 保存用户名称、当前用户名及头像文件ID（username、photoFileID 为空表示未设置），已存在时更新并刷新更新时间
+func (m *UserModel) Save(ctx context.Context, userID int64, name, username, photoFileID string) error {
+	n, err := m.client.Update().Where(user.UserIDEQ(userID)).
+		SetName(name).SetUsername(username).SetPhotoFileID(photoFileID).Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	err = m.client.Create().SetUserID(userID).
+		SetName(name).SetUsername(username).SetPhotoFileID(photoFileID).Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发创建，改为更新
+		return m.client.Update().Where(user.UserIDEQ(userID)).
+			SetName(name).SetUsername(username).SetPhotoFileID(photoFileID).Exec(ctx)
+	}
+	return err
+}
+
+// Get 查询用户信息，未记录时返回 nil
+func (m *UserModel) Get(ctx context.Context, userID int64) (*ent.User, error) {
+	u, err := m.client.Query().Where(user.UserIDEQ(userID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Usernames 批量查询用户当前的用户名，未记录或未设置用户名的用户不在结果中
+func (m *UserModel) Usernames(ctx context.Context, userIDs []int64) (map[int64]string, error) {
+	users, err := m.client.Query().
+		Where(user.UserIDIn(userIDs...), user.UsernameNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usernames := make(map[int64]string, len(users))
+	for _, u := range users {
+		usernames[u.UserID] = u.Username
+	}
+	return usernames, nil
+}
+
+// StaleIDs 从 userIDs 中筛选需要刷新的用户，最多 limit 个：未记录的用户优先（补全），其次为更新时间早于 before 的用户（按更新时间升序）
+func (m *UserModel) StaleIDs(ctx context.Context, userIDs []int64, before time.Time, limit int) ([]int64, error) {
+	users, err := m.client.Query().
+		Where(user.UserIDIn(userIDs...)).
+		Order(user.ByUpdateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int64]bool, len(users))
+	for _, u := range users {
+		known[u.UserID] = true
+	}
+
+	stale := make([]int64, 0, limit)
+	for _, id := range userIDs {
+		if len(stale) >= limit {
+			return stale, nil
+		}
+		if !known[id] {
+			stale = append(stale, id)
+		}
+	}
+	for _, u := range users {
+		if len(stale) >= limit || !u.UpdateTime.Before(before) {
+			break
+		}
+		stale = append(stale, u.UserID)
+	}
+	return stale, nil
+}
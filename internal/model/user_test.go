@@ -0,0 +1,45 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserModel(t *testing.T) {
+	ctx := context.Background()
+	m := NewUserModel(newTestClient(t).User)
+
+	require.NoError(t, m.Save(ctx, 1, "Alice", "@alice", ""))
+	require.NoError(t, m.Save(ctx, 2, "Bob", "", "photo-bob"))
+	require.NoError(t, m.Save(ctx, 1, "Alice", "@alice_new", "photo-alice"))
+
+	usernames, err := m.Usernames(ctx, []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{1: "@alice_new"}, usernames, "用户名已更新，未设置用户名的用户不返回")
+
+	u, err := m.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "photo-alice", u.PhotoFileID, "头像已更新")
+
+	t.Run("未记录的用户优先", func(t *testing.T) {
+		stale, err := m.StaleIDs(ctx, []int64{1, 2, 3}, time.Now().Add(-time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, stale)
+	})
+
+	t.Run("过期的用户按更新时间排序", func(t *testing.T) {
+		stale, err := m.StaleIDs(ctx, []int64{1, 2, 3}, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2, 1}, stale)
+	})
+
+	t.Run("数量上限", func(t *testing.T) {
+		stale, err := m.StaleIDs(ctx, []int64{1, 2, 3}, time.Now().Add(time.Hour), 2)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 2}, stale)
+	})
+}
@@ -0,0 +1,139 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+const (
+	sendConfirmTimeout = 60 * time.Second // 等待发送确认的最长时间
+	sendResultTTL      = 5 * time.Minute  // 未被等待的发送结果保留时间
+)
+
+// ErrSendTimeout 等待发送确认超时，消息可能仍在发送中
+var ErrSendTimeout = errors.New("等待发送确认超时")
+
+// SendError 将 TDLib 返回的发送错误转为 error：频率超限（429）时匹配 errs.ErrTelegramFlood，
+// 并附带错误信息中 "retry after N" 要求的等待时间
+func SendError(tdErr *client.Error) error {
+	err := fmt.Errorf("消息发送失败: %s (code %d)", tdErr.Message, tdErr.Code)
+	if tdErr.Code != http.StatusTooManyRequests {
+		return err
+	}
+	var after time.Duration
+	if i := strings.LastIndex(tdErr.Message, "retry after "); i >= 0 {
+		if n, convErr := strconv.Atoi(strings.TrimSpace(tdErr.Message[i+len("retry after "):])); convErr == nil {
+			after = time.Duration(n) * time.Second
+		}
+	}
+	return errs.WithRetryAfter(errs.ErrTelegramFlood, err, after)
+}
+
+// SendWaiter 等待消息发送完成，返回服务端分配的正式消息ID（默认实现为 teleapp.TeleApp）
+type SendWaiter interface {
+	WaitSent(ctx context.Context, chatID, messageID int64) (int64, error)
+}
+
+// SetSendWaiter 启用发送确认：每段消息发送完成后才发送下一段，避免网络抖动时分段乱序到达
+func (n *Notifier) SetSendWaiter(waiter SendWaiter) {
+	n.sendWaiter = waiter
+}
+
+type sendKey struct {
+	chatID    int64
+	messageID int64
+}
+
+type sendResult struct {
+	messageID int64
+	err       error
+	at        time.Time
+}
+
+// SendTracker 跟踪消息的发送结果：TDLib 的 sendMessage 立即返回临时消息，
+// 发送完成后推送 updateMessageSendSucceeded / updateMessageSendFailed，由更新循环调用 Succeeded / Failed
+type SendTracker struct {
+	timeout time.Duration
+	mu      sync.Mutex
+	waiters map[sendKey]chan sendResult
+	results map[sendKey]sendResult // 开始等待前已到达的结果
+}
+
+func NewSendTracker() *SendTracker {
+	return &SendTracker{
+		timeout: sendConfirmTimeout,
+		waiters: make(map[sendKey]chan sendResult),
+		results: make(map[sendKey]sendResult),
+	}
+}
+
+// Succeeded 记录临时消息 oldMessageID 发送成功，正式消息ID为 messageID
+func (t *SendTracker) Succeeded(chatID, oldMessageID, messageID int64) {
+	t.complete(sendKey{chatID, oldMessageID}, sendResult{messageID: messageID})
+}
+
+// Failed 记录临时消息 oldMessageID 发送失败
+func (t *SendTracker) Failed(chatID, oldMessageID int64, err error) {
+	t.complete(sendKey{chatID, oldMessageID}, sendResult{err: err})
+}
+
+func (t *SendTracker) complete(key sendKey, result sendResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ch, ok := t.waiters[key]; ok {
+		delete(t.waiters, key)
+		ch <- result
+		return
+	}
+
+	// 尚无等待者（更新先于 Wait 到达，或该消息无需确认），暂存结果并清理过期记录
+	result.at = time.Now()
+	t.results[key] = result
+	for k, r := range t.results {
+		if time.Since(r.at) > sendResultTTL {
+			delete(t.results, k)
+		}
+	}
+}
+
+// Wait 等待临时消息 messageID 发送完成，返回正式消息ID；超时时返回 ErrSendTimeout
+func (t *SendTracker) Wait(ctx context.Context, chatID, messageID int64) (int64, error) {
+	key := sendKey{chatID, messageID}
+
+	t.mu.Lock()
+	if result, ok := t.results[key]; ok {
+		delete(t.results, key)
+		t.mu.Unlock()
+		return result.messageID, result.err
+	}
+	ch := make(chan sendResult, 1)
+	t.waiters[key] = ch
+	t.mu.Unlock()
+
+	timer := time.NewTimer(t.timeout)
+	defer timer.Stop()
+	select {
+	case result := <-ch:
+		return result.messageID, result.err
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	t.mu.Lock()
+	delete(t.waiters, key)
+	t.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("消息 %d: %w", messageID, ErrSendTimeout)
+}
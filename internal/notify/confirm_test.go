@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestSendTracker(t *testing.T) {
+	t.Run("等待中收到发送成功", func(t *testing.T) {
+		tracker := NewSendTracker()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			tracker.Succeeded(-100, 1, 1001)
+		}()
+		messageID, err := tracker.Wait(context.Background(), -100, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1001), messageID)
+	})
+
+	t.Run("结果先于等待到达", func(t *testing.T) {
+		tracker := NewSendTracker()
+		tracker.Succeeded(-100, 2, 1002)
+		messageID, err := tracker.Wait(context.Background(), -100, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1002), messageID)
+		assert.Empty(t, tracker.results, "结果被取走后删除")
+	})
+
+	t.Run("发送失败", func(t *testing.T) {
+		tracker := NewSendTracker()
+		tracker.Failed(-100, 3, errors.New("消息发送失败: Too Many Requests (code 429)"))
+		_, err := tracker.Wait(context.Background(), -100, 3)
+		assert.ErrorContains(t, err, "Too Many Requests")
+	})
+
+	t.Run("不同聊天的同一临时ID互不影响", func(t *testing.T) {
+		tracker := NewSendTracker()
+		tracker.timeout = 20 * time.Millisecond
+		tracker.Succeeded(-200, 4, 2004)
+		_, err := tracker.Wait(context.Background(), -100, 4)
+		assert.ErrorIs(t, err, ErrSendTimeout)
+		assert.Empty(t, tracker.waiters, "超时后移除等待者")
+	})
+
+	t.Run("取消等待", func(t *testing.T) {
+		tracker := NewSendTracker()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := tracker.Wait(ctx, -100, 5)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSendError(t *testing.T) {
+	err := SendError(&client.Error{Code: 429, Message: "Too Many Requests: retry after 35"})
+	assert.ErrorIs(t, err, errs.ErrTelegramFlood)
+	assert.ErrorContains(t, err, "code 429")
+	after, ok := errs.RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, 35*time.Second, after)
+
+	err = SendError(&client.Error{Code: 400, Message: "Bad Request: message text is empty"})
+	assert.NotErrorIs(t, err, errs.ErrTelegramFlood)
+	assert.Equal(t, errs.ActionRetry, errs.Classify(err))
+}
@@ -0,0 +1,51 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// DigestSender 机器人模式下发送群聊精简总结（话题按钮展开详情），默认实现为 botapp.BotApp
+type DigestSender interface {
+	SendDigest(ctx context.Context, chatID int64, taskID int) error
+}
+
+type taskIDCtx struct{}
+
+// WithTask 标记本次发送所属的任务：设置任务幂等键，机器人模式下群聊改为发送该任务的精简总结
+func WithTask(ctx context.Context, taskID int) context.Context {
+	ctx = context.WithValue(ctx, taskIDCtx{}, taskID)
+	return WithIdempotencyKey(ctx, TaskIdempotencyKey(taskID))
+}
+
+func taskIDFromContext(ctx context.Context) int {
+	taskID, _ := ctx.Value(taskIDCtx{}).(int)
+	return taskID
+}
+
+// SetDigestSender 启用机器人模式的群聊精简总结，需在调度器启动前调用
+func (n *Notifier) SetDigestSender(sender DigestSender) {
+	n.digestSender = sender
+}
+
+// sendDigest 通过机器人发送精简总结；未启用机器人模式或发送内容不属于任务时返回 handled=false
+func (n *Notifier) sendDigest(ctx context.Context, chatID int64) (handled bool, err error) {
+	taskID := taskIDFromContext(ctx)
+	if n.digestSender == nil || taskID <= 0 {
+		return false, nil
+	}
+
+	_, skipped, err := n.sendPart(ctx, chatID, 0, func() (int64, error) {
+		return 0, n.digestSender.SendDigest(ctx, chatID, taskID)
+	})
+	if err != nil {
+		return true, err
+	}
+	if skipped {
+		logger.Infof("[Notify] 群组 %d 的精简总结已发送过，跳过", chatID)
+		return true, nil
+	}
+	logger.Infof("[Notify] 已通过机器人发送精简总结到群组 %d", chatID)
+	return true, nil
+}
@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// FollowSection 某位成员关注的关键词所匹配的总结内容
+type FollowSection struct {
+	UserID  int64
+	Content string
+}
+
+// FollowSender 机器人模式下按成员关注的关键词私聊发送匹配的话题，默认实现为 botapp.BotApp
+type FollowSender interface {
+	FollowSections(ctx context.Context, chatID int64, taskID int) ([]FollowSection, error)
+	SendPrivate(ctx context.Context, userID int64, content string) error
+}
+
+// SetFollowSender 启用关键词关注推送，需在调度器启动前调用
+func (n *Notifier) SetFollowSender(sender FollowSender) {
+	n.followSender = sender
+}
+
+// notifyFollowers 向关注了匹配关键词的成员私聊发送相关话题；失败只记录日志，不影响任务通知结果。
+// 幂等键使用独立前缀，避免与同一用户的私信通知冲突
+func (n *Notifier) notifyFollowers(ctx context.Context, chatID int64) {
+	taskID := taskIDFromContext(ctx)
+	if n.followSender == nil || taskID <= 0 {
+		return
+	}
+
+	sections, err := n.followSender.FollowSections(ctx, chatID, taskID)
+	if err != nil {
+		logger.Errorf("[Notify] 查询群组 %d 的关注推送失败: %v", chatID, err)
+		return
+	}
+
+	followCtx := WithIdempotencyKey(ctx, TaskIdempotencyKey(taskID)+":follow")
+	for _, section := range sections {
+		_, skipped, err := n.sendPart(followCtx, section.UserID, 0, func() (int64, error) {
+			return 0, n.followSender.SendPrivate(ctx, section.UserID, section.Content)
+		})
+		if err != nil {
+			logger.Warnf("[Notify] 发送关注推送给用户 %d 失败: %v", section.UserID, err)
+			continue
+		}
+		if !skipped {
+			logger.Infof("[Notify] 已发送群组 %d 的关注推送给用户 %d", chatID, section.UserID)
+		}
+	}
+}
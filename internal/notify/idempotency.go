@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// pendingWaitRounds 发送确认超时的分段在后台继续等待发送结果的次数（每次最长 sendConfirmTimeout）
+const pendingWaitRounds = 5
+
+// SentStore 记录已发送的消息分段，崩溃后重试发送时跳过已发送部分
+type SentStore interface {
+	IsSent(ctx context.Context, key string) (bool, error)
+	MarkSent(ctx context.Context, key string, messageID int64) error
+	UpdateSentMessageID(ctx context.Context, key string, messageID int64) error
+	SentMessageID(ctx context.Context, key string) (int64, error)
+	ClearSent(ctx context.Context, prefix string) (int, error)
+}
+
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey 为本次发送设置幂等键前缀（如 "task:12"），
+// 每个分段的幂等键为 <前缀>:<接收方ID>:<分段序号>
+func WithIdempotencyKey(ctx context.Context, prefix string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, prefix)
+}
+
+// TaskIdempotencyKey 返回任务通知的幂等键前缀
+func TaskIdempotencyKey(taskID int) string {
+	return fmt.Sprintf("task:%d", taskID)
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	prefix, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	return prefix
+}
+
+// partKey 返回分段的幂等键，未设置前缀时返回空字符串
+func partKey(ctx context.Context, targetID int64, index int) string {
+	prefix := idempotencyKeyFromContext(ctx)
+	if prefix == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d:%d", prefix, targetID, index)
+}
+
+// ClearSent 清除幂等键前缀下的发送记录（重新生成摘要后调用，使新内容完整发送）
+func (n *Notifier) ClearSent(ctx context.Context, prefix string) error {
+	if n.sentStore == nil {
+		return nil
+	}
+	_, err := n.sentStore.ClearSent(ctx, prefix+":")
+	return err
+}
+
+// sendPart 发送单个分段：已发送则跳过，发送成功后记录。send 返回发送的消息ID（无需记录时为 0），
+// 跳过时返回记录的消息ID，供后续分段回复。send 返回 ErrSendTimeout 时消息已提交、正式消息ID未知：
+// 仍记录为已发送（避免重试导致重复发送），消息ID记为 0，在后台等到发送结果后再更新
+func (n *Notifier) sendPart(ctx context.Context, targetID int64, index int, send func() (int64, error)) (messageID int64, skipped bool, err error) {
+	key := partKey(ctx, targetID, index)
+	if key == "" || n.sentStore == nil {
+		messageID, err = send()
+		if errors.Is(err, ErrSendTimeout) {
+			return messageID, false, nil
+		}
+		return messageID, false, err
+	}
+
+	sent, err := n.sentStore.IsSent(ctx, key)
+	if err != nil {
+		return 0, false, fmt.Errorf("查询发送记录失败: %w", err)
+	}
+	if sent {
+		messageID, err = n.sentStore.SentMessageID(ctx, key)
+		if err != nil {
+			return 0, true, fmt.Errorf("查询发送记录失败: %w", err)
+		}
+		return messageID, true, nil
+	}
+	messageID, err = send()
+	pending := errors.Is(err, ErrSendTimeout)
+	if err != nil && !pending {
+		return 0, false, err
+	}
+	recorded := messageID
+	if pending {
+		recorded = 0
+	}
+	if err := n.sentStore.MarkSent(ctx, key, recorded); err != nil {
+		return 0, false, fmt.Errorf("保存发送记录失败: %w", err)
+	}
+	if pending {
+		go n.resolvePending(context.WithoutCancel(ctx), key, targetID, messageID)
+	}
+	return messageID, false, nil
+}
+
+// resolvePending 继续等待发送确认超时的临时消息 tempID 的发送结果，发送成功后以正式消息ID更新分段的发送记录
+func (n *Notifier) resolvePending(ctx context.Context, key string, chatID, tempID int64) {
+	for range pendingWaitRounds {
+		messageID, err := n.sendWaiter.WaitSent(ctx, chatID, tempID)
+		if errors.Is(err, ErrSendTimeout) {
+			continue
+		}
+		if err != nil {
+			logger.Warnf("[Notify] 聊天 %d 的消息 %d 最终发送失败（%s）: %v", chatID, tempID, key, err)
+			return
+		}
+		if err := n.sentStore.UpdateSentMessageID(ctx, key, messageID); err != nil {
+			logger.Warnf("[Notify] 更新发送记录 %s 的消息ID失败: %v", key, err)
+		}
+		return
+	}
+	logger.Warnf("[Notify] 聊天 %d 的消息 %d 仍未确认发送结果，发送记录 %s 不记录消息ID", chatID, tempID, key)
+}
@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// MembershipChecker 查询群组成员关系，默认实现为 teleapp.TeleApp
+type MembershipChecker interface {
+	// IsChatMember 用户是否为群组成员
+	IsChatMember(ctx context.Context, chatID, userID int64) (bool, error)
+	// CanPost 当前账号是否可以在群组发送消息（未被移出、封禁或禁言）
+	CanPost(ctx context.Context, chatID int64) (bool, error)
+}
+
+// IsMemberStatus 成员状态是否表示仍在群组中：已退出、被封禁及不是成员的群主、受限用户除外；
+// 用户账号和机器人查询成员关系时共用
+func IsMemberStatus(status client.ChatMemberStatus) bool {
+	switch s := status.(type) {
+	case *client.ChatMemberStatusCreator:
+		return s.IsMember
+	case *client.ChatMemberStatusAdministrator, *client.ChatMemberStatusMember:
+		return true
+	case *client.ChatMemberStatusRestricted:
+		return s.IsMember
+	}
+	return false
+}
+
+// SetMembershipChecker 启用群聊发送前的发言权限检查及 "both" 模式下的私信去重（Summary.SkipPrivateIfMember），需在调度器启动前调用
+func (n *Notifier) SetMembershipChecker(checker MembershipChecker) {
+	n.membershipChecker = checker
+}
+
+// dedupMembers 去掉配置了 SkipPrivateIfMember 且是该群组成员的用户：群聊总结已发送成功，他们在群内即可看到。
+// 查询失败时仍发送私信，宁可重复也不遗漏
+func (n *Notifier) dedupMembers(ctx context.Context, chatID int64, userIDs []int64) []int64 {
+	if n.membershipChecker == nil || len(n.config.SkipPrivateIfMember) == 0 {
+		return userIDs
+	}
+
+	kept := make([]int64, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if !slices.Contains(n.config.SkipPrivateIfMember, userID) {
+			kept = append(kept, userID)
+			continue
+		}
+		member, err := n.membershipChecker.IsChatMember(ctx, chatID, userID)
+		if err != nil {
+			logger.Warnf("[Notify] 查询用户 %d 是否为群组 %d 成员失败，仍发送私信: %v", userID, chatID, err)
+			kept = append(kept, userID)
+			continue
+		}
+		if member {
+			logger.Infof("[Notify] 用户 %d 是群组 %d 成员，已在群内收到总结，跳过私信", userID, chatID)
+			continue
+		}
+		kept = append(kept, userID)
+	}
+	return kept
+}
+
+// checkCanPost 群聊发送前确认当前账号仍可在群组发言，无权发言时返回 errs.ErrChatForbidden，避免注定失败的重试；
+// 查询失败时不拦截，由发送结果决定
+func (n *Notifier) checkCanPost(ctx context.Context, chatID int64) error {
+	if n.membershipChecker == nil {
+		return nil
+	}
+	ok, err := n.membershipChecker.CanPost(ctx, chatID)
+	if err != nil {
+		logger.Warnf("[Notify] 查询群组 %d 的发言权限失败，继续发送: %v", chatID, err)
+		return nil
+	}
+	if !ok {
+		return fmt.Errorf("群组 %d: %w（已被移出、封禁或禁言）", chatID, errs.ErrChatForbidden)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestIsMemberStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status client.ChatMemberStatus
+		want   bool
+	}{
+		{"普通成员", &client.ChatMemberStatusMember{}, true},
+		{"管理员", &client.ChatMemberStatusAdministrator{}, true},
+		{"群主", &client.ChatMemberStatusCreator{IsMember: true}, true},
+		{"已退出的群主", &client.ChatMemberStatusCreator{}, false},
+		{"受限成员", &client.ChatMemberStatusRestricted{IsMember: true}, true},
+		{"受限的非成员", &client.ChatMemberStatusRestricted{}, false},
+		{"已退出", &client.ChatMemberStatusLeft{}, false},
+		{"被封禁", &client.ChatMemberStatusBanned{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsMemberStatus(tt.status))
+		})
+	}
+}
@@ -2,48 +2,81 @@ package notify
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
 	"github.com/zelenin/go-tdlib/client"
 )
 
 const (
 	MaxMessageLength = 5000 // Telegram 消息最大长度
+	// MaxItemLength 单个话题子项的最大长度：为分段编号和话题标题预留空间，超过时拆分后仍无法放入一条消息
+	MaxItemLength = MaxMessageLength - 500
 )
 
+// MessageSender 发送 TDLib 消息（默认实现为 *client.Client，测试时可替换为 testkit.FakeTelegram）
+type MessageSender interface {
+	SendMessage(req *client.SendMessageRequest) (*client.Message, error)
+}
+
 type Notifier struct {
-	tdClient *client.Client
-	config   *config.Summary
+	tdClient     MessageSender
+	config       *config.Summary
+	adminUserIds []int64
+	sentStore    SentStore
+	digestSender DigestSender
+	followSender FollowSender
+	sendWaiter   SendWaiter
+
+	membershipChecker MembershipChecker
 }
 
-func NewNotifier(tdClient *client.Client, cfg *config.Summary) *Notifier {
+func NewNotifier(tdClient MessageSender, cfg *config.Summary, adminUserIds []int64, sentStore SentStore) *Notifier {
 	return &Notifier{
-		tdClient: tdClient,
-		config:   cfg,
+		tdClient:     tdClient,
+		config:       cfg,
+		adminUserIds: adminUserIds,
+		sentStore:    sentStore,
 	}
 }
 
 // Notify 发送通知
-// chatID 用于群组通知模式，当 NotifyMode 为 "group" 或 "both" 时使用
+// chatID 用于群组通知模式，当 NotifyMode 为 "group" 或 "both" 时使用；通知成功后向关注了相关关键词的成员推送
 func (n *Notifier) Notify(ctx context.Context, content string, chatID int64) error {
 	if content == "" {
 		return nil
 	}
 
+	if err := n.notify(ctx, content, chatID); err != nil {
+		return err
+	}
+	n.notifyFollowers(ctx, chatID)
+	return nil
+}
+
+// notify 按通知模式发送
+func (n *Notifier) notify(ctx context.Context, content string, chatID int64) error {
 	switch n.config.NotifyMode {
 	case "private":
-		return n.notifyPrivate(ctx, content)
+		return n.notifyPrivate(ctx, content, chatID, false)
 	case "group":
 		return n.notifyGroup(ctx, content, chatID)
 	case "both":
-		if err := n.notifyPrivate(ctx, content); err != nil {
-			logger.Errorf("[Notify] 私信通知失败: %v", err)
+		// 先发送群聊，成功后私信才能跳过已在群内看到总结的成员
+		groupErr := n.notifyGroup(ctx, content, chatID)
+		if errs.Classify(groupErr) == errs.ActionSkip {
+			logger.Warnf("[Notify] 跳过群发通知: %v", groupErr)
+		} else if groupErr != nil {
+			logger.Errorf("[Notify] 群发通知失败: %v", groupErr)
 		}
-		if err := n.notifyGroup(ctx, content, chatID); err != nil {
-			logger.Errorf("[Notify] 群发通知失败: %v", err)
+		if err := n.notifyPrivate(ctx, content, chatID, groupErr == nil); err != nil {
+			logger.Errorf("[Notify] 私信通知失败: %v", err)
 		}
 		return nil
 	default:
@@ -52,59 +85,180 @@ func (n *Notifier) Notify(ctx context.Context, content string, chatID int64) err
 	}
 }
 
-// notifyPrivate 发送私信通知
-func (n *Notifier) notifyPrivate(ctx context.Context, content string) error {
+// notifyPrivate 向订阅了该群组的用户发送私信通知；dedup 为 true 时跳过已在群内收到总结的成员
+func (n *Notifier) notifyPrivate(ctx context.Context, content string, chatID int64, dedup bool) error {
 	if len(n.config.NotifyUserIds) == 0 {
 		logger.Warnf("[Notify] 未配置私信通知用户ID")
 		return nil
 	}
 
-	messages := n.splitMessage(content)
+	userIDs := n.subscribers(chatID)
+	if dedup {
+		userIDs = n.dedupMembers(ctx, chatID, userIDs)
+	}
+	if len(userIDs) == 0 {
+		logger.Debugf("[Notify] 群组 %d 无订阅用户，跳过私信通知", chatID)
+		return nil
+	}
+	return n.sendToUsers(ctx, userIDs, content)
+}
 
+// subscribers 返回应接收该群组总结的私信用户：未配置订阅的用户接收所有群组
+func (n *Notifier) subscribers(chatID int64) []int64 {
+	userIDs := make([]int64, 0, len(n.config.NotifyUserIds))
 	for _, userID := range n.config.NotifyUserIds {
-		for _, msg := range messages {
-			formatted := n.parseHTMLText(msg)
-			_, err := n.tdClient.SendMessage(&client.SendMessageRequest{
-				ChatId: userID,
-				InputMessageContent: &client.InputMessageText{
-					Text: formatted,
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("发送私信给用户 %d 失败: %w", userID, err)
-			}
-			logger.Infof("[Notify] 已发送私信给用户 %d", userID)
+		chatIDs, ok := n.config.Subscriptions[userID]
+		if !ok || slices.Contains(chatIDs, chatID) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+// DryRun 演练发送：按实际发送的方式拆分内容并解析 HTML，但不发送，返回分段数；用于自检
+func (n *Notifier) DryRun(content string) (int, error) {
+	messages := SplitNumbered(content)
+	for i, msg := range messages {
+		_, err := client.ParseTextEntities(&client.ParseTextEntitiesRequest{
+			Text:      msg,
+			ParseMode: &client.TextParseModeHTML{},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("解析第 %d 段消息的 HTML 失败: %w", i+1, err)
 		}
 	}
+	return len(messages), nil
+}
+
+// NotifyAdmins 向管理员发送运维消息（运行报告等），未配置管理员时忽略
+func (n *Notifier) NotifyAdmins(ctx context.Context, content string) error {
+	if content == "" || len(n.adminUserIds) == 0 {
+		return nil
+	}
+	return n.sendToUsers(ctx, n.adminUserIds, content)
+}
+
+// NotifyUser 向指定用户私聊发送消息（每周回顾等），超长内容自动拆分
+func (n *Notifier) NotifyUser(ctx context.Context, userID int64, content string) error {
+	if content == "" {
+		return nil
+	}
+	return n.sendToUsers(ctx, []int64{userID}, content)
+}
+
+// sendToUsers 逐个用户私聊发送消息，超长内容自动拆分；设置了幂等键时跳过已发送的分段
+func (n *Notifier) sendToUsers(ctx context.Context, userIDs []int64, content string) error {
+	messages := SplitNumbered(content)
+
+	for _, userID := range userIDs {
+		if err := n.sendParts(ctx, userID, 0, messages); err != nil {
+			return fmt.Errorf("发送私信给用户 %d 失败: %w", userID, err)
+		}
+		logger.Infof("[Notify] 已发送私信给用户 %d", userID)
+	}
 
 	return nil
 }
 
-// notifyGroup 发送群聊通知
+// notifyGroup 发送群聊通知；设置了幂等键时跳过已发送的分段
 func (n *Notifier) notifyGroup(ctx context.Context, content string, chatID int64) error {
-	messages := n.splitMessage(content)
+	// 机器人模式：群聊只发送精简总结，详情由成员点击话题按钮私聊获取
+	if handled, err := n.sendDigest(ctx, chatID); handled {
+		if err != nil {
+			return fmt.Errorf("发送精简总结到群组 %d 失败: %w", chatID, err)
+		}
+		return nil
+	}
 
-	for _, msg := range messages {
-		formatted := n.parseHTMLText(msg)
+	if err := n.checkCanPost(ctx, chatID); err != nil {
+		return err
+	}
+	if parts := threadPartsFromContext(ctx); len(parts) > 0 {
+		if err := n.sendThreadParts(ctx, chatID, parts); err != nil {
+			return fmt.Errorf("发送群聊消息到群组 %d 失败: %w", chatID, err)
+		}
+		logger.Infof("[Notify] 已按论坛话题发送群聊消息到群组 %d（%d 个话题）", chatID, len(parts))
+		return nil
+	}
+	messages := SplitNumbered(content)
+	if err := n.sendParts(ctx, chatID, threadFromContext(ctx), messages); err != nil {
+		return fmt.Errorf("发送群聊消息到群组 %d 失败: %w", chatID, err)
+	}
+	logger.Infof("[Notify] 已发送群聊消息到群组 %d", chatID)
+
+	return nil
+}
 
-		_, err := n.tdClient.SendMessage(&client.SendMessageRequest{
-			ChatId: chatID,
-			InputMessageContent: &client.InputMessageText{
-				Text: formatted,
-			},
+// sendParts 依次发送各分段，第 2 段起回复第 1 段，便于读者按顺序阅读；设置了幂等键时跳过已发送的分段。
+// threadID 不为 0 时发送到该论坛话题
+func (n *Notifier) sendParts(ctx context.Context, chatID, threadID int64, messages []string) error {
+	var firstID int64
+	for i, msg := range messages {
+		messageID, skipped, err := n.sendPart(ctx, chatID, i, func() (int64, error) {
+			return n.sendText(ctx, chatID, threadID, msg, firstID)
 		})
 		if err != nil {
-			return fmt.Errorf("发送群聊消息到群组 %d 失败: %w", chatID, err)
+			return err
+		}
+		if i == 0 {
+			firstID = messageID
+		}
+		if skipped {
+			logger.Infof("[Notify] 聊天 %d 的第 %d 段消息已发送过，跳过", chatID, i+1)
 		}
-		logger.Infof("[Notify] 已发送群聊消息到群组 %d", chatID)
 	}
-
 	return nil
 }
 
-// parseHTMLText 使用 TDLib 的 HTML 解析能力，将 HTML 文本转换为带实体的 FormattedText。
+// sendText 发送 HTML 格式的文本消息，threadID 不为 0 时发送到该论坛话题，replyTo 不为 0 时回复该消息，返回发送的消息ID。
+// 启用发送确认时等待发送完成并返回正式消息ID；确认超时时返回临时ID及 ErrSendTimeout，由 sendPart 继续发送后续分段，
+// 不将临时ID记录为正式消息ID
+func (n *Notifier) sendText(ctx context.Context, chatID, threadID int64, text string, replyTo int64) (int64, error) {
+	req := &client.SendMessageRequest{
+		ChatId:          chatID,
+		MessageThreadId: threadID,
+		InputMessageContent: &client.InputMessageText{
+			Text: ParseHTMLText(text),
+		},
+	}
+	if replyTo != 0 {
+		req.ReplyTo = &client.InputMessageReplyToMessage{MessageId: replyTo}
+	}
+	message, err := n.tdClient.SendMessage(req)
+	if err != nil {
+		var respErr client.ResponseError
+		if errors.As(err, &respErr) && respErr.Err != nil {
+			return 0, SendError(respErr.Err)
+		}
+		return 0, err
+	}
+	if n.sendWaiter == nil || message.SendingState == nil {
+		return message.Id, nil
+	}
+
+	messageID, err := n.sendWaiter.WaitSent(ctx, chatID, message.Id)
+	if errors.Is(err, ErrSendTimeout) {
+		logger.Warnf("[Notify] 聊天 %d: %v，继续发送", chatID, err)
+		return message.Id, err
+	}
+	return messageID, err
+}
+
+// numberParts 拆分为多段时在每段前加上 "(1/3)" 形式的编号，单段时原样返回
+func numberParts(parts []string) []string {
+	if len(parts) <= 1 {
+		return parts
+	}
+	numbered := make([]string, len(parts))
+	for i, part := range parts {
+		numbered[i] = fmt.Sprintf("(%d/%d) %s", i+1, len(parts), part)
+	}
+	return numbered
+}
+
+// ParseHTMLText 使用 TDLib 的 HTML 解析能力，将 HTML 文本转换为带实体的 FormattedText。
 // 支持的 HTML 标签：<b>粗体</b>、<a href="url">链接</a>
-func (n *Notifier) parseHTMLText(text string) *client.FormattedText {
+func ParseHTMLText(text string) *client.FormattedText {
 	if text == "" {
 		return &client.FormattedText{Text: text}
 	}
@@ -120,9 +274,43 @@ func (n *Notifier) parseHTMLText(text string) *client.FormattedText {
 	return formatted
 }
 
-// splitMessage 将消息按长度拆分为多条
-func (n *Notifier) splitMessage(content string) []string {
-	if len(content) <= MaxMessageLength {
+// SplitMessage 将消息按长度拆分为多条，每条不超过 MaxMessageLength
+func SplitMessage(content string) []string {
+	return splitMessage(content, MaxMessageLength)
+}
+
+// SplitNumbered 将消息拆分为多条并加上 "(1/3)" 形式的编号（见 numberParts）。拆分时为编号预留长度，
+// 编号后每条仍不超过 MaxMessageLength；预留后段数增加导致编号变长时重新拆分
+func SplitNumbered(content string) []string {
+	parts := SplitMessage(content)
+	for len(parts) > 1 {
+		reserve := len(fmt.Sprintf("(%d/%d) ", len(parts), len(parts)))
+		resplit := splitMessage(content, MaxMessageLength-reserve)
+		if len(resplit) == len(parts) {
+			return numberParts(resplit)
+		}
+		parts = resplit
+	}
+	return parts
+}
+
+// cutRunes 按字符边界将 s 截断为每段不超过 limit 字节
+func cutRunes(s string, limit int) []string {
+	var pieces []string
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		pieces = append(pieces, s[:cut])
+		s = s[cut:]
+	}
+	return append(pieces, s)
+}
+
+// splitMessage 按段落、换行、句子依次拆分，每条不超过 limit 字节；没有断句的超长句子按字符截断
+func splitMessage(content string, limit int) []string {
+	if len(content) <= limit {
 		return []string{content}
 	}
 
@@ -148,15 +336,16 @@ func (n *Notifier) splitMessage(content string) []string {
 		}
 		testMsg += para
 
-		if len(testMsg) <= MaxMessageLength {
+		if len(testMsg) <= limit {
 			currentMsg = testMsg
 		} else {
 			// 当前消息已满，保存并开始新消息
 			if currentMsg != "" {
 				messages = append(messages, currentMsg)
+				currentMsg = ""
 			}
 			// 如果单个段落就超过长度，需要进一步拆分
-			if len(para) > MaxMessageLength {
+			if len(para) > limit {
 				// 按句子拆分
 				sentences := strings.Split(para, "。")
 				for _, sentence := range sentences {
@@ -164,16 +353,16 @@ func (n *Notifier) splitMessage(content string) []string {
 					if sentence == "" {
 						continue
 					}
-					if len(currentMsg)+len(sentence)+2 > MaxMessageLength {
-						if currentMsg != "" {
+					for _, piece := range cutRunes(sentence, limit) {
+						if currentMsg != "" && len(currentMsg)+len("。")+len(piece) > limit {
 							messages = append(messages, currentMsg)
 							currentMsg = ""
 						}
+						if currentMsg != "" {
+							currentMsg += "。"
+						}
+						currentMsg += piece
 					}
-					if currentMsg != "" {
-						currentMsg += "。"
-					}
-					currentMsg += sentence
 				}
 			} else {
 				currentMsg = para
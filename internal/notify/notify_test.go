@@ -0,0 +1,407 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribers(t *testing.T) {
+	n := NewNotifier(nil, &config.Summary{
+		NotifyUserIds: []int64{1, 2, 3},
+		Subscriptions: map[int64][]int64{
+			2: {-100},
+			3: {},
+		},
+	}, nil, nil)
+
+	tests := []struct {
+		name   string
+		chatID int64
+		want   []int64
+	}{
+		{"订阅的群组", -100, []int64{1, 2}},
+		{"未订阅的群组", -200, []int64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, n.subscribers(tt.chatID))
+		})
+	}
+}
+
+// fakeMembershipChecker 按固定的成员列表回答，members 为 nil 时返回错误；forbidden 表示当前账号无权发言
+type fakeMembershipChecker struct {
+	members   []int64
+	queried   []int64
+	forbidden bool
+}
+
+func (f *fakeMembershipChecker) IsChatMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	f.queried = append(f.queried, userID)
+	if f.members == nil {
+		return false, errors.New("CHAT_ADMIN_REQUIRED")
+	}
+	return slices.Contains(f.members, userID), nil
+}
+
+func (f *fakeMembershipChecker) CanPost(ctx context.Context, chatID int64) (bool, error) {
+	if f.members == nil {
+		return false, errors.New("CHAT_ADMIN_REQUIRED")
+	}
+	return !f.forbidden, nil
+}
+
+func TestDedupMembers(t *testing.T) {
+	cfg := &config.Summary{NotifyMode: "both", NotifyUserIds: []int64{1, 2, 3}, SkipPrivateIfMember: []int64{1, 2}}
+	tests := []struct {
+		name    string
+		members []int64
+		want    []int64
+		queried []int64
+	}{
+		{"跳过配置了去重的群成员", []int64{1, 3}, []int64{2, 3}, []int64{1, 2}},
+		{"查询失败时仍发送", nil, []int64{1, 2, 3}, []int64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewNotifier(nil, cfg, nil, nil)
+			checker := &fakeMembershipChecker{members: tt.members}
+			n.SetMembershipChecker(checker)
+			assert.Equal(t, tt.want, n.dedupMembers(context.Background(), -100, []int64{1, 2, 3}))
+			assert.Equal(t, tt.queried, checker.queried, "只查询配置了去重的用户")
+		})
+	}
+
+	n := NewNotifier(nil, cfg, nil, nil)
+	assert.Equal(t, []int64{1, 2, 3}, n.dedupMembers(context.Background(), -100, []int64{1, 2, 3}), "未设置成员查询时不去重")
+}
+
+// memorySentStore 内存实现的发送记录，可由后台等待发送结果的协程并发更新
+type memorySentStore struct {
+	mu         sync.Mutex
+	keys       map[string]bool
+	messageIDs map[string]int64
+}
+
+func (m *memorySentStore) IsSent(ctx context.Context, key string) (bool, error) {
+	return m.isSent(key), nil
+}
+
+func (m *memorySentStore) MarkSent(ctx context.Context, key string, messageID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.keys[key] = true
+	if messageID != 0 {
+		if m.messageIDs == nil {
+			m.messageIDs = make(map[string]int64)
+		}
+		m.messageIDs[key] = messageID
+	}
+	return nil
+}
+
+func (m *memorySentStore) UpdateSentMessageID(ctx context.Context, key string, messageID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.keys[key] {
+		if m.messageIDs == nil {
+			m.messageIDs = make(map[string]int64)
+		}
+		m.messageIDs[key] = messageID
+	}
+	return nil
+}
+
+func (m *memorySentStore) SentMessageID(ctx context.Context, key string) (int64, error) {
+	return m.sentMessageID(key), nil
+}
+
+func (m *memorySentStore) ClearSent(ctx context.Context, prefix string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for key := range m.keys {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.keys, key)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memorySentStore) isSent(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.keys[key]
+}
+
+func (m *memorySentStore) sentMessageID(key string) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.messageIDs[key]
+}
+
+func TestCheckCanPost(t *testing.T) {
+	tests := []struct {
+		name    string
+		checker *fakeMembershipChecker
+		wantErr bool
+	}{
+		{"可以发言", &fakeMembershipChecker{members: []int64{}}, false},
+		{"无权发言", &fakeMembershipChecker{members: []int64{}, forbidden: true}, true},
+		{"查询失败时继续发送", &fakeMembershipChecker{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewNotifier(nil, &config.Summary{}, nil, nil)
+			n.SetMembershipChecker(tt.checker)
+			err := n.checkCanPost(context.Background(), -100)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errs.ErrChatForbidden)
+				assert.Equal(t, errs.ActionSkip, errs.Classify(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	n := NewNotifier(nil, &config.Summary{}, nil, nil)
+	assert.NoError(t, n.checkCanPost(context.Background(), -100), "未设置成员查询时不检查")
+}
+
+func TestSendPart_ResumesAtUnsentPart(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{}, nil, store)
+	ctx := WithIdempotencyKey(context.Background(), TaskIdempotencyKey(1))
+
+	// 第一次发送：第 0 段成功，第 1 段失败
+	var sent []int
+	send := func(index int, fail bool) error {
+		_, _, err := n.sendPart(ctx, -100, index, func() (int64, error) {
+			if fail {
+				return 0, errors.New("network error")
+			}
+			sent = append(sent, index)
+			return 0, nil
+		})
+		return err
+	}
+	assert.NoError(t, send(0, false))
+	assert.Error(t, send(1, true))
+
+	// 重试：跳过第 0 段，从第 1 段继续
+	assert.NoError(t, send(0, false))
+	assert.NoError(t, send(1, false))
+	assert.Equal(t, []int{0, 1}, sent)
+
+	// 清除后 task:1 的记录被删除，不影响 task:12
+	store.keys["task:12:-100:0"] = true
+	assert.NoError(t, n.ClearSent(context.Background(), TaskIdempotencyKey(1)))
+	assert.Equal(t, map[string]bool{"task:12:-100:0": true}, store.keys)
+}
+
+func TestSendPart_WithoutKey(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{}, nil, store)
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		_, _, err := n.sendPart(context.Background(), 1, 0, func() (int64, error) {
+			calls++
+			return 0, nil
+		})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls, "未设置幂等键时每次都发送")
+	assert.Empty(t, store.keys)
+}
+
+func TestSendPart_RecordsMessageID(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{}, nil, store)
+	ctx := WithIdempotencyKey(context.Background(), TaskIdempotencyKey(1))
+
+	messageID, skipped, err := n.sendPart(ctx, -100, 0, func() (int64, error) { return 42, nil })
+	assert.NoError(t, err)
+	assert.False(t, skipped)
+	assert.Equal(t, int64(42), messageID)
+
+	// 重试时跳过已发送的分段，返回记录的消息ID供后续分段回复
+	messageID, skipped, err = n.sendPart(ctx, -100, 0, func() (int64, error) {
+		t.Fatal("已发送的分段不应重复发送")
+		return 0, nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, skipped)
+	assert.Equal(t, int64(42), messageID)
+}
+
+// fakeSendWaiter 依次返回预设的发送结果，gate 不为 nil 时等待其关闭后再返回
+type fakeSendWaiter struct {
+	gate    chan struct{}
+	results []error
+	calls   int
+}
+
+func (f *fakeSendWaiter) WaitSent(ctx context.Context, chatID, messageID int64) (int64, error) {
+	if f.gate != nil {
+		<-f.gate
+	}
+	err := f.results[f.calls]
+	f.calls++
+	if err != nil {
+		return 0, err
+	}
+	return 1001, nil
+}
+
+func TestSendPart_PendingConfirmation(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{}, nil, store)
+	ctx := WithIdempotencyKey(context.Background(), TaskIdempotencyKey(1))
+	waiter := &fakeSendWaiter{gate: make(chan struct{}), results: []error{ErrSendTimeout, nil}}
+	n.SetSendWaiter(waiter)
+
+	messageID, skipped, err := n.sendPart(ctx, -100, 0, func() (int64, error) { return 7, ErrSendTimeout })
+	require.NoError(t, err, "确认超时时继续发送后续分段")
+	assert.False(t, skipped)
+	assert.Equal(t, int64(7), messageID, "后续分段回复临时消息")
+	assert.True(t, store.isSent("task:1:-100:0"), "记录为已发送，重试时不重复发送")
+	assert.Zero(t, store.sentMessageID("task:1:-100:0"), "临时ID不作为正式消息ID记录")
+
+	close(waiter.gate)
+	assert.Eventually(t, func() bool { return store.sentMessageID("task:1:-100:0") == 1001 }, time.Second, 5*time.Millisecond,
+		"确认超时后继续等待，收到发送成功后记录正式消息ID")
+
+	n.SetSendWaiter(&fakeSendWaiter{results: []error{errors.New("消息发送失败")}})
+	n.resolvePending(context.Background(), "task:1:-100:0", -100, 8)
+	assert.Equal(t, int64(1001), store.sentMessageID("task:1:-100:0"), "发送失败时不更新")
+
+	_, _, err = n.sendPart(context.Background(), -100, 0, func() (int64, error) { return 9, ErrSendTimeout })
+	assert.NoError(t, err, "未设置幂等键时确认超时也继续发送")
+}
+
+func TestNumberParts(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  []string
+	}{
+		{"单段不编号", []string{"全文"}, []string{"全文"}},
+		{"多段编号", []string{"第一段", "第二段", "第三段"}, []string{"(1/3) 第一段", "(2/3) 第二段", "(3/3) 第三段"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, numberParts(tt.parts))
+		})
+	}
+}
+
+func TestSplitNumbered(t *testing.T) {
+	t.Run("单段不编号", func(t *testing.T) {
+		assert.Equal(t, []string{"全文"}, SplitNumbered("全文"))
+	})
+
+	t.Run("拆分时为编号预留长度", func(t *testing.T) {
+		// 前两段合计恰好 MaxMessageLength，未预留时第一条加上编号后超出
+		first := strings.Repeat("a", MaxMessageLength-10)
+		second := strings.Repeat("b", 8)
+		third := strings.Repeat("c", 100)
+		content := first + "\n\n" + second + "\n\n" + third
+		require.Equal(t, MaxMessageLength, len(SplitMessage(content)[0]))
+
+		parts := SplitNumbered(content)
+		assert.Equal(t, []string{"(1/2) " + first, "(2/2) " + second + "\n\n" + third}, parts)
+		for _, part := range parts {
+			assert.LessOrEqual(t, len(part), MaxMessageLength)
+		}
+	})
+
+	t.Run("没有断句的超长文本按字符截断", func(t *testing.T) {
+		content := strings.Repeat("长", MaxMessageLength)
+		parts := SplitNumbered(content)
+		require.Greater(t, len(parts), 1)
+		var joined strings.Builder
+		for i, part := range parts {
+			assert.LessOrEqual(t, len(part), MaxMessageLength)
+			prefix := fmt.Sprintf("(%d/%d) ", i+1, len(parts))
+			require.True(t, strings.HasPrefix(part, prefix))
+			joined.WriteString(strings.TrimPrefix(part, prefix))
+		}
+		assert.Equal(t, content, joined.String(), "不丢失也不截断字符")
+	})
+}
+
+// fakeDigestSender 记录精简总结的发送
+type fakeDigestSender struct {
+	calls []int
+}
+
+func (f *fakeDigestSender) SendDigest(ctx context.Context, chatID int64, taskID int) error {
+	f.calls = append(f.calls, taskID)
+	return nil
+}
+
+func TestNotifyGroup_Digest(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{NotifyMode: "group"}, nil, store)
+	sender := &fakeDigestSender{}
+	n.SetDigestSender(sender)
+
+	// 属于任务的发送改为精简总结，重试时按幂等键跳过
+	ctx := WithTask(context.Background(), 7)
+	assert.NoError(t, n.Notify(ctx, "完整总结", -100))
+	assert.NoError(t, n.Notify(ctx, "完整总结", -100))
+	assert.Equal(t, []int{7}, sender.calls)
+	assert.True(t, store.keys["task:7:-100:0"])
+
+	// 不属于任务的发送不走精简总结
+	handled, err := n.sendDigest(context.Background(), -100)
+	assert.NoError(t, err)
+	assert.False(t, handled)
+}
+
+// fakeFollowSender 固定返回关注推送内容并记录发送
+type fakeFollowSender struct {
+	sections []FollowSection
+	sent     []int64
+}
+
+func (f *fakeFollowSender) FollowSections(ctx context.Context, chatID int64, taskID int) ([]FollowSection, error) {
+	return f.sections, nil
+}
+
+func (f *fakeFollowSender) SendPrivate(ctx context.Context, userID int64, content string) error {
+	f.sent = append(f.sent, userID)
+	return nil
+}
+
+func TestNotifyFollowers(t *testing.T) {
+	store := &memorySentStore{keys: make(map[string]bool)}
+	n := NewNotifier(nil, &config.Summary{NotifyMode: "group"}, nil, store)
+	n.SetDigestSender(&fakeDigestSender{})
+	sender := &fakeFollowSender{sections: []FollowSection{{UserID: 1, Content: "a"}, {UserID: 2, Content: "b"}}}
+	n.SetFollowSender(sender)
+
+	// 通知成功后推送，重试时按幂等键跳过已推送的用户
+	ctx := WithTask(context.Background(), 7)
+	assert.NoError(t, n.Notify(ctx, "完整总结", -100))
+	assert.NoError(t, n.Notify(ctx, "完整总结", -100))
+	assert.Equal(t, []int64{1, 2}, sender.sent)
+	assert.True(t, store.keys["task:7:follow:1:0"])
+
+	// 重新生成摘要后清除记录，会再次推送
+	assert.NoError(t, n.ClearSent(context.Background(), TaskIdempotencyKey(7)))
+	assert.Empty(t, store.keys)
+}
@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+)
+
+type threadIDCtx struct{}
+
+// WithThread 指定群聊通知发送到的论坛话题（消息线程ID），threadID 为 0 时发送到 General；私信不受影响
+func WithThread(ctx context.Context, threadID int64) context.Context {
+	return context.WithValue(ctx, threadIDCtx{}, threadID)
+}
+
+func threadFromContext(ctx context.Context) int64 {
+	threadID, _ := ctx.Value(threadIDCtx{}).(int64)
+	return threadID
+}
+
+// ThreadPart 总结按论坛话题拆分后发送到一个论坛话题的内容
+type ThreadPart struct {
+	ThreadID int64
+	Content  string
+}
+
+type threadPartsCtx struct{}
+
+// WithThreadParts 总结涉及多个论坛话题时，群聊通知改为将各部分分别发送到对应的论坛话题；
+// 私信及机器人模式的精简总结不受影响，仍发送完整内容。parts 为空时不改变发送方式
+func WithThreadParts(ctx context.Context, parts []ThreadPart) context.Context {
+	return context.WithValue(ctx, threadPartsCtx{}, parts)
+}
+
+func threadPartsFromContext(ctx context.Context) []ThreadPart {
+	parts, _ := ctx.Value(threadPartsCtx{}).([]ThreadPart)
+	return parts
+}
+
+// sendThreadParts 依次将各部分发送到对应的论坛话题；设置了幂等键时每个论坛话题使用独立的幂等键
+func (n *Notifier) sendThreadParts(ctx context.Context, chatID int64, parts []ThreadPart) error {
+	prefix := idempotencyKeyFromContext(ctx)
+	for _, part := range parts {
+		partCtx := ctx
+		if prefix != "" {
+			partCtx = WithIdempotencyKey(ctx, fmt.Sprintf("%s:thread:%d", prefix, part.ThreadID))
+		}
+		if err := n.sendParts(partCtx, chatID, part.ThreadID, SplitNumbered(part.Content)); err != nil {
+			return fmt.Errorf("论坛话题 %d: %w", part.ThreadID, err)
+		}
+	}
+	return nil
+}
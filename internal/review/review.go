@@ -0,0 +1,366 @@
+package review
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/storage"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+	"github.com/fachebot/talk-trace-bot/internal/svc"
+)
+
+const (
+	reviewDays       = 7   // 回顾的天数
+	maxEntries       = 15  // 每个分类最多列出的条数
+	maxMentionScan   = 200 // 最多查询的提及候选消息数
+	mentionTextRunes = 80  // 提及消息摘录的最大字数
+)
+
+// actionKeywords 总结要点中表示待办事项的关键词（小写）
+var actionKeywords = []string{"待办", "负责", "跟进", "处理", "安排", "确认", "提醒", "需要", "todo", "follow up", "action", "assign"}
+
+// userNotifier 私聊发送消息（由 notify.Notifier 实现）
+type userNotifier interface {
+	NotifyUser(ctx context.Context, userID int64, content string) error
+}
+
+// Reviewer 汇总已保存的总结和消息，定期私聊发送个人回顾
+type Reviewer struct {
+	config       *config.WeeklyReview
+	messageModel storage.MessageStore
+	taskModel    storage.TaskStore
+	chatModel    *model.ChatModel
+	userModel    *model.UserModel
+	notifier     userNotifier
+	loc          *time.Location
+	formatter    *display.Formatter
+}
+
+func NewReviewer(cfg *config.WeeklyReview, svcCtx *svc.ServiceContext, notifier userNotifier, loc *time.Location) *Reviewer {
+	// 展示配置已在加载配置时校验，出错时使用默认文本
+	formatter, err := display.NewFormatter(&svcCtx.Config.Summary.Display)
+	if err != nil {
+		logger.Warnf("[Review] 加载展示配置失败，使用默认文本: %v", err)
+	}
+	return &Reviewer{
+		config:       cfg,
+		messageModel: svcCtx.MessageModel,
+		taskModel:    svcCtx.TaskModel,
+		chatModel:    svcCtx.ChatModel,
+		userModel:    svcCtx.UserModel,
+		notifier:     notifier,
+		loc:          loc,
+		formatter:    formatter,
+	}
+}
+
+// chatSummary 某次任务的结构化总结结果
+type chatSummary struct {
+	chatID int64
+	title  string
+	result *summarizer.SummaryResult
+}
+
+// profile 接收回顾的用户及其在回顾区间内发送的消息
+type profile struct {
+	name     string
+	username string
+	own      map[int64]map[int64]bool // 群组ID => 该用户消息在总结结果中使用的 ID
+}
+
+// entry 回顾中的一条内容（HTML）
+type entry struct {
+	title string
+	text  string
+}
+
+// Run 向配置的用户逐个发送过去 7 天的个人回顾，单个用户失败不影响其他用户
+func (r *Reviewer) Run(ctx context.Context) error {
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -reviewDays)
+
+	titles := make(map[int64]string)
+	summaries, err := r.loadSummaries(ctx, startTime, endTime, titles)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, userID := range r.config.UserIds {
+		if err := r.sendReview(ctx, userID, summaries, titles, startTime, endTime); err != nil {
+			logger.Warnf("[Review] 发送每周回顾给用户 %d 失败: %v", userID, err)
+			errs = append(errs, fmt.Errorf("用户 %d: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// loadSummaries 加载区间内已保存的结构化总结结果，无法解析的结果跳过
+func (r *Reviewer) loadSummaries(ctx context.Context, startTime, endTime time.Time, titles map[int64]string) ([]chatSummary, error) {
+	tasks, err := r.taskModel.GetSummarizedByRange(ctx, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("查询总结结果失败: %w", err)
+	}
+
+	summaries := make([]chatSummary, 0, len(tasks))
+	for _, t := range tasks {
+		var result summarizer.SummaryResult
+		if err := json.Unmarshal([]byte(t.SummaryJSON), &result); err != nil {
+			logger.Warnf("[Review] 解析任务 %d 的总结结果失败: %v", t.ID, err)
+			continue
+		}
+		summaries = append(summaries, chatSummary{chatID: t.ChatID, title: r.chatTitle(ctx, t.ChatID, titles), result: &result})
+	}
+	return summaries, nil
+}
+
+// chatTitle 查询群聊名称并缓存，未记录时使用群组ID
+func (r *Reviewer) chatTitle(ctx context.Context, chatID int64, titles map[int64]string) string {
+	if title, ok := titles[chatID]; ok {
+		return title
+	}
+	title, err := r.chatModel.GetTitle(ctx, chatID)
+	if err != nil || title == "" {
+		title = fmt.Sprintf("%d", chatID)
+	}
+	titles[chatID] = title
+	return title
+}
+
+// sendReview 汇总并发送单个用户的回顾，没有相关内容时不发送
+func (r *Reviewer) sendReview(ctx context.Context, userID int64, summaries []chatSummary, titles map[int64]string, startTime, endTime time.Time) error {
+	p, err := r.loadProfile(ctx, userID, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	mentions, err := r.mentions(ctx, userID, p, titles, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	topics, items := engagedTopics(summaries, p), actionItems(summaries, p)
+	if len(topics) == 0 && len(items) == 0 && len(mentions) == 0 {
+		logger.Infof("[Review] 用户 %d 过去 %d 天没有相关内容，跳过每周回顾", userID, reviewDays)
+		return nil
+	}
+
+	content := formatReview(startTime, endTime, r.loc, r.formatter, topics, items, mentions)
+	if err := r.notifier.NotifyUser(ctx, userID, content); err != nil {
+		return err
+	}
+	logger.Infof("[Review] 已发送每周回顾给用户 %d: 话题 %d, 待跟进 %d, 提及 %d", userID, len(topics), len(items), len(mentions))
+	return nil
+}
+
+// loadProfile 查询用户名称、用户名及其在区间内发送的消息；未记录用户信息时以最近一条消息的发送者信息为准
+func (r *Reviewer) loadProfile(ctx context.Context, userID int64, startTime, endTime time.Time) (*profile, error) {
+	messages, err := r.messageModel.GetBySenderAndDateRange(ctx, userID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户消息失败: %w", err)
+	}
+
+	p := &profile{own: make(map[int64]map[int64]bool)}
+	for _, m := range messages {
+		if p.own[m.ChatID] == nil {
+			p.own[m.ChatID] = make(map[int64]bool)
+		}
+		p.own[m.ChatID][summarizer.LinkMessageID(m)] = true
+		p.name, p.username = m.SenderName, m.SenderUsername
+	}
+
+	u, err := r.userModel.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户信息失败: %w", err)
+	}
+	if u != nil {
+		p.name, p.username = u.Name, u.Username
+	}
+	return p, nil
+}
+
+// mentions 查询区间内其他成员 @ 该用户的消息，未设置用户名时无法识别提及
+func (r *Reviewer) mentions(ctx context.Context, userID int64, p *profile, titles map[int64]string, startTime, endTime time.Time) ([]entry, error) {
+	if p.username == "" {
+		return nil, nil
+	}
+	candidates, err := r.messageModel.GetMentionCandidates(ctx, userID, p.username, startTime, endTime, maxMentionScan)
+	if err != nil {
+		return nil, fmt.Errorf("查询提及消息失败: %w", err)
+	}
+
+	var matched []*ent.Message
+	senderIDs := make([]int64, 0, len(candidates))
+	for _, m := range candidates {
+		if mentionsUsername(m.Text, p.username) {
+			matched = append(matched, m)
+			senderIDs = append(senderIDs, m.SenderID)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	// 附上发送者当前的用户名；消息中记录的是发送时的用户名，之后可能已修改
+	usernames, err := r.userModel.Usernames(ctx, senderIDs)
+	if err != nil {
+		logger.Warnf("[Review] 查询发送者用户名失败, 不显示用户名: %v", err)
+	}
+
+	entries := make([]entry, 0, len(matched))
+	for _, m := range matched {
+		entries = append(entries, entry{title: r.chatTitle(ctx, m.ChatID, titles), text: formatMention(m, usernames[m.SenderID], r.loc)})
+	}
+	return entries, nil
+}
+
+// engagedTopics 返回用户参与的话题：话题的发言要点引用了该用户的消息。
+// 按发送者ID识别用户的消息，不比较显示名称，避免同名的其他成员被计入
+func engagedTopics(summaries []chatSummary, p *profile) []entry {
+	var entries []entry
+	for _, s := range summaries {
+		for _, topic := range s.result.Topics {
+			for _, item := range topic.Items {
+				if referencesAny(item.MessageIDs, p.own[s.chatID]) {
+					entries = append(entries, entry{title: s.title, text: html.EscapeString(topic.Title)})
+					break
+				}
+			}
+		}
+	}
+	return entries
+}
+
+// actionItems 返回分配给用户的待跟进事项：其他成员的发言要点提及该用户且包含待办类关键词；
+// 引用了该用户消息的要点视为该用户自己的发言
+func actionItems(summaries []chatSummary, p *profile) []entry {
+	var entries []entry
+	for _, s := range summaries {
+		for _, topic := range s.result.Topics {
+			for _, item := range topic.Items {
+				if referencesAny(item.MessageIDs, p.own[s.chatID]) || !mentionsUser(item.Description, p) || !hasActionKeyword(item.Description) {
+					continue
+				}
+				text := fmt.Sprintf("%s：%s：%s", html.EscapeString(topic.Title), html.EscapeString(item.SenderName), html.EscapeString(item.Description))
+				entries = append(entries, entry{title: s.title, text: text})
+			}
+		}
+	}
+	return entries
+}
+
+// referencesAny 判断 ids 中是否有 own 中的消息
+func referencesAny(ids []int64, own map[int64]bool) bool {
+	for _, id := range ids {
+		if own[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// mentionsUser 判断文本是否提及用户（@用户名，或至少 2 个字的名称）
+func mentionsUser(text string, p *profile) bool {
+	if p.username != "" && mentionsUsername(text, p.username) {
+		return true
+	}
+	return len([]rune(p.name)) >= 2 && strings.Contains(strings.ToLower(text), strings.ToLower(p.name))
+}
+
+// mentionsUsername 判断文本是否包含完整的 @用户名（不区分大小写），@alice 不匹配 @alice_bot
+func mentionsUsername(text, username string) bool {
+	text, username = strings.ToLower(text), strings.ToLower(username)
+	for i := 0; ; {
+		idx := strings.Index(text[i:], username)
+		if idx < 0 {
+			return false
+		}
+		end := i + idx + len(username)
+		next, _ := firstRune(text[end:])
+		if !isUsernameRune(next) {
+			return true
+		}
+		i = end
+	}
+}
+
+// firstRune 返回字符串的第一个字符，空字符串返回 false
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return r, true
+	}
+	return 0, false
+}
+
+// isUsernameRune 判断字符能否出现在 Telegram 用户名中
+func isUsernameRune(r rune) bool {
+	return r == '_' || (r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+}
+
+// hasActionKeyword 判断文本是否包含待办类关键词
+func hasActionKeyword(text string) bool {
+	text = strings.ToLower(text)
+	for _, keyword := range actionKeywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatMention 格式化提及消息：发送者（及其当前用户名）、时间及消息摘录，超级群组附消息链接
+func formatMention(m *ent.Message, username string, loc *time.Location) string {
+	text := []rune(strings.Join(strings.Fields(m.Text), " "))
+	if len(text) > mentionTextRunes {
+		text = append(text[:mentionTextRunes], '…')
+	}
+	excerpt := html.EscapeString(string(text))
+	if link := summarizer.MessageLink(m); link != "" {
+		excerpt = fmt.Sprintf(`<a href="%s">%s</a>`, link, excerpt)
+	}
+	sender := html.EscapeString(m.SenderName)
+	if username != "" {
+		sender = fmt.Sprintf("%s (%s)", sender, html.EscapeString(username))
+	}
+	return fmt.Sprintf("%s %s：%s", sender, m.SentAt.In(loc).Format("01-02 15:04"), excerpt)
+}
+
+// formatReview 格式化回顾内容（HTML），每个分类最多列出 maxEntries 条
+func formatReview(startTime, endTime time.Time, loc *time.Location, formatter *display.Formatter, topics, items, mentions []entry) string {
+	var sb strings.Builder
+	sb.WriteString(formatter.Tf(display.TextReviewTitle, startTime.In(loc).Format("01-02"), endTime.In(loc).Format("01-02"), reviewDays))
+	sb.WriteString("\n")
+
+	sections := []struct {
+		title   display.TextKey
+		entries []entry
+	}{
+		{display.TextReviewTopics, topics},
+		{display.TextReviewItems, items},
+		{display.TextReviewMentions, mentions},
+	}
+	for _, section := range sections {
+		if len(section.entries) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n%s\n", formatter.Tf(display.TextReviewSection, formatter.T(section.title), len(section.entries)))
+		for i, e := range section.entries {
+			if i == maxEntries {
+				fmt.Fprintf(&sb, "%s\n", formatter.Tf(display.TextReviewMore, len(section.entries)-maxEntries))
+				break
+			}
+			fmt.Fprintf(&sb, "• [%s] %s\n", html.EscapeString(e.title), e.text)
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
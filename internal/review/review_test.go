@@ -0,0 +1,97 @@
+package review
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMentionsUsername(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"完整提及", "请 @alice 看一下", true},
+		{"不区分大小写", "@Alice 在吗", true},
+		{"句末提及", "交给@alice", true},
+		{"提及后接中文", "@alice你好", true},
+		{"更长的用户名", "@alice_bot 已上线", false},
+		{"更长的用户名后再次提及", "@alice_bot 和 @alice", true},
+		{"未提及", "alice 在吗", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mentionsUsername(tt.text, "@alice"))
+		})
+	}
+}
+
+func TestEngagedTopicsAndActionItems(t *testing.T) {
+	p := &profile{name: "Alice", username: "@alice", own: map[int64]map[int64]bool{-1001: {10: true, 13: true}}}
+	summaries := []chatSummary{{
+		chatID: -1001,
+		title:  "产品群",
+		result: &summarizer.SummaryResult{Topics: []summarizer.TopicItem{
+			{Title: "发布计划", Items: []summarizer.TopicSubItem{
+				{SenderName: "Bob", Description: "请 Alice 负责跟进发布时间", MessageIDs: []int64{11}},
+			}},
+			{Title: "周报", Items: []summarizer.TopicSubItem{
+				{SenderName: "Carol", Description: "整理了周报", MessageIDs: []int64{10, 12}},
+			}},
+			{Title: "午餐", Items: []summarizer.TopicSubItem{
+				{SenderName: "Alice", Description: "推荐了餐厅", MessageIDs: []int64{13}},
+				{SenderName: "Bob", Description: "Alice 推荐的餐厅不错"},
+			}},
+			{Title: "团建", Items: []summarizer.TopicSubItem{
+				{SenderName: "Alice", Description: "另一位同名成员提议周五团建，Alice 负责订场地", MessageIDs: []int64{14}},
+			}},
+		}},
+	}}
+
+	var titles []string
+	for _, e := range engagedTopics(summaries, p) {
+		titles = append(titles, e.text)
+	}
+	assert.Equal(t, []string{"周报", "午餐"}, titles, "引用了用户消息的话题，同名的其他成员不计入")
+
+	items := actionItems(summaries, p)
+	if assert.Len(t, items, 2, "仅提及用户且包含待办关键词的他人要点，含同名的其他成员") {
+		assert.Equal(t, "产品群", items[0].title)
+		assert.Contains(t, items[0].text, "发布计划")
+		assert.Contains(t, items[1].text, "团建")
+	}
+}
+
+func TestFormatReview(t *testing.T) {
+	end := time.Date(2025, 2, 10, 1, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -reviewDays)
+
+	var topics []entry
+	for range maxEntries + 2 {
+		topics = append(topics, entry{title: "<群>", text: "话题"})
+	}
+	content := formatReview(start, end, time.UTC, nil, topics, nil, []entry{{title: "群", text: "提及"}})
+
+	assert.Contains(t, content, "02-03 ~ 02-10")
+	assert.Contains(t, content, "参与的话题</b>（17）")
+	assert.Contains(t, content, "…另有 2 条")
+	assert.Contains(t, content, "[&lt;群&gt;] 话题")
+	assert.NotContains(t, content, "待跟进事项", "空分类不显示")
+	assert.True(t, strings.HasSuffix(content, "[群] 提及"))
+}
+
+func TestFormatMention(t *testing.T) {
+	m := &ent.Message{ChatID: -1001, SenderName: "Bob", Text: "@alice 看一下", SentAt: time.Date(2025, 2, 10, 9, 30, 0, 0, time.UTC)}
+
+	t.Run("附当前用户名", func(t *testing.T) {
+		assert.Equal(t, "Bob (@bob_new) 02-10 09:30：@alice 看一下", formatMention(m, "@bob_new", time.UTC))
+	})
+	t.Run("未记录用户名", func(t *testing.T) {
+		assert.Equal(t, "Bob 02-10 09:30：@alice 看一下", formatMention(m, "", time.UTC))
+	})
+}
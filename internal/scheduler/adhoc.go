@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+type adhocCtx struct{}
+
+// withAdhoc 标记按需总结：区间由用户指定，不与定时窗口的活跃度基线比较，也不触发异常告警
+func withAdhoc(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adhocCtx{}, true)
+}
+
+// isAdhoc 判断是否为按需总结
+func isAdhoc(ctx context.Context) bool {
+	adhoc, _ := ctx.Value(adhocCtx{}).(bool)
+	return adhoc
+}
+
+// SummarizeRange 按需总结群组在 [startTime, endTime) 内的消息（/summary 命令）：创建或复用按需任务，
+// 在 TaskTimeout 内生成总结并保存结构化结果，返回 HTML 格式的总结内容，由调用方回复，不经过通知发送。
+// 区间内没有可总结的消息时返回空字符串
+func (s *Scheduler) SummarizeRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (string, error) {
+	t, err := s.taskModel.GetOrCreateTask(ctx, chatID, startTime, endTime, task.KindAdhoc, task.StatusProcessing)
+	if err != nil {
+		return "", fmt.Errorf("创建按需总结任务失败: %w", err)
+	}
+	if t.Status != task.StatusProcessing {
+		if err := s.taskModel.UpdateTaskStatus(ctx, t.ID, task.StatusProcessing, nil); err != nil {
+			return "", err
+		}
+	}
+	logger.Infof("[Scheduler] 按需总结群组 %d，区间: %s", chatID, formatRange(startTime, endTime))
+	s.recordEvent(ctx, t.ID, chatID, runlog.EventTaskStarted, formatRange(startTime, endTime))
+
+	taskCtx, cancel := context.WithTimeout(withAdhoc(ctx), time.Duration(s.config.TaskTimeout)*time.Second)
+	defer cancel()
+	progressCtx := llm.WithProgress(taskCtx, s.progressReporter(taskCtx, chatID, t.ID))
+	summary, result, err := s.generateSummaryForTask(progressCtx, chatID, startTime, endTime, nil)
+	if err != nil {
+		_ = s.taskModel.MarkTaskFailed(ctx, t.ID, err.Error())
+		s.recordEvent(ctx, t.ID, chatID, runlog.EventTaskFailed, err.Error())
+		return "", err
+	}
+	if result != nil {
+		s.savePromptBudget(ctx, t.ID, result.PromptBudget)
+		if data, err := json.Marshal(result); err != nil {
+			logger.Warnf("[Scheduler] 序列化总结结果失败 (taskID=%d): %v", t.ID, err)
+		} else if err := s.taskModel.SetSummaryJSON(ctx, t.ID, string(data)); err != nil {
+			logger.Warnf("[Scheduler] 保存总结结果失败 (taskID=%d): %v", t.ID, err)
+		}
+	}
+	_ = s.taskModel.MarkTaskCompleted(ctx, t.ID)
+	return summary, nil
+}
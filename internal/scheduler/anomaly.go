@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// checkActivity 将本期消息量、发言人数与近期同一时段的均值比较，异常时告警并返回，用于总结头部提示
+func (s *Scheduler) checkActivity(ctx context.Context, chatID int64, startTime, endTime time.Time, result *summarizer.SummaryResult) []summarizer.ActivityAnomaly {
+	cfg := &s.config.Anomaly
+	if !cfg.Enable {
+		return nil
+	}
+
+	since := startTime.AddDate(0, 0, -cfg.BaselineDays)
+	tasks, err := s.taskModel.GetActivityHistory(ctx, chatID, since, startTime)
+	if err != nil {
+		logger.Warnf("[Scheduler] 群组 %d: 查询活跃度历史失败: %v", chatID, err)
+		return nil
+	}
+	history := sameSlotTasks(tasks, startTime, endTime)
+	if len(history) < cfg.MinSamples {
+		logger.Debugf("[Scheduler] 群组 %d: 活跃度基线样本不足 (%d/%d)，跳过异常检测", chatID, len(history), cfg.MinSamples)
+		return nil
+	}
+
+	anomalies, details := detectAnomalies(result.MessageCount, result.ParticipantCount, history, cfg.Ratio, s.formatter)
+	if len(anomalies) > 0 {
+		name := result.ChatTitle
+		if name == "" {
+			name = fmt.Sprintf("%d", chatID)
+		}
+		s.alerter.Alert(ctx, s.formatter.T(display.TextAlertAnomaly), s.formatter.Tf(display.TextAlertAnomalyDetail,
+			name, formatRange(startTime, endTime), strings.Join(details, s.formatter.T(display.TextListSeparator))))
+	}
+	return anomalies
+}
+
+// sameSlotTasks 筛选与本期区间长度相同、开始时刻相同的历史任务（多窗口时只与同一窗口比较）
+func sameSlotTasks(tasks []*ent.Task, startTime, endTime time.Time) []*ent.Task {
+	duration := endTime.Sub(startTime)
+	var result []*ent.Task
+	for _, t := range tasks {
+		if t.EndTime.Sub(t.StartTime) != duration || startTime.Sub(t.StartTime)%(24*time.Hour) != 0 {
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// detectAnomalies 本期值高于历史均值 ratio 倍或低于均值 1/ratio 时视为异常，同时返回供告警使用的说明（按 formatter 的语言）
+func detectAnomalies(messages, participants int, history []*ent.Task, ratio float64, formatter *display.Formatter) ([]summarizer.ActivityAnomaly, []string) {
+	if len(history) == 0 {
+		return nil, nil
+	}
+	var totalMessages, totalParticipants int
+	for _, t := range history {
+		totalMessages += t.MessageCount
+		totalParticipants += t.ParticipantCount
+	}
+
+	metrics := []struct {
+		metric  string
+		name    display.TextKey
+		current int
+		avg     float64
+	}{
+		{summarizer.MetricMessages, display.TextMetricMessages, messages, float64(totalMessages) / float64(len(history))},
+		{summarizer.MetricParticipants, display.TextMetricParticipants, participants, float64(totalParticipants) / float64(len(history))},
+	}
+
+	var anomalies []summarizer.ActivityAnomaly
+	var details []string
+	for _, m := range metrics {
+		if m.avg <= 0 {
+			continue
+		}
+		r := float64(m.current) / m.avg
+		if r < ratio && r > 1/ratio {
+			continue
+		}
+		anomalies = append(anomalies, summarizer.ActivityAnomaly{Metric: m.metric, Ratio: r})
+		details = append(details, formatter.Tf(display.TextAnomalyDetail, formatter.T(m.name), m.current, m.avg, r))
+	}
+	return anomalies, details
+}
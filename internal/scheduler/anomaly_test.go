@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectAnomalies(t *testing.T) {
+	history := []*ent.Task{
+		{MessageCount: 90, ParticipantCount: 10},
+		{MessageCount: 110, ParticipantCount: 10},
+	}
+
+	tests := []struct {
+		name         string
+		messages     int
+		participants int
+		want         []summarizer.ActivityAnomaly
+	}{
+		{"正常波动", 150, 12, nil},
+		{"消息量激增", 500, 12, []summarizer.ActivityAnomaly{{Metric: summarizer.MetricMessages, Ratio: 5}}},
+		{"恰好达到倍数", 300, 30, []summarizer.ActivityAnomaly{
+			{Metric: summarizer.MetricMessages, Ratio: 3},
+			{Metric: summarizer.MetricParticipants, Ratio: 3},
+		}},
+		{"活跃度骤降", 20, 5, []summarizer.ActivityAnomaly{{Metric: summarizer.MetricMessages, Ratio: 0.2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, details := detectAnomalies(tt.messages, tt.participants, history, 3, nil)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, details, len(tt.want))
+		})
+	}
+
+	got, _ := detectAnomalies(500, 50, nil, 3, nil)
+	assert.Nil(t, got, "无历史时不检测")
+}
+
+func TestSameSlotTasks(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.UTC) }
+	start, end := day(10, 0), day(10, 12)
+	tasks := []*ent.Task{
+		{ID: 1, StartTime: day(9, 0), EndTime: day(9, 12)},
+		{ID: 2, StartTime: day(9, 12), EndTime: day(10, 0)},
+		{ID: 3, StartTime: day(8, 0), EndTime: day(9, 0)},
+		{ID: 4, StartTime: day(7, 0), EndTime: day(7, 12)},
+	}
+
+	var ids []int
+	for _, task := range sameSlotTasks(tasks, start, end) {
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(t, []int{1, 4}, ids, "仅保留同一时段、同一长度的区间")
+}
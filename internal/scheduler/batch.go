@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// cleanupBatchPause 批次之间的间隔，让出 SQLite 写锁并给 WAL checkpoint 留出时间
+const cleanupBatchPause = 100 * time.Millisecond
+
+// batchFunc 处理一批数据，最多 limit 条，返回本批处理的数量
+type batchFunc func(ctx context.Context, limit int) (int, error)
+
+// runBatches 反复执行 batch 直到某批数量小于批大小，批次之间记录进度并暂停，返回处理总数
+func (s *Scheduler) runBatches(ctx context.Context, name string, batch batchFunc) (int, error) {
+	batchSize := s.config.CleanupBatchSize
+	total := 0
+	for {
+		n, err := batch(ctx, batchSize)
+		total += n
+		if err != nil || n < batchSize {
+			return total, err
+		}
+
+		logger.Infof("[Scheduler] %s: 已处理 %d 条，继续下一批", name, total)
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-time.After(cleanupBatchPause):
+		}
+	}
+}
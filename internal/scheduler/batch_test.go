@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunBatches(t *testing.T) {
+	s := &Scheduler{config: &config.Summary{CleanupBatchSize: 10}}
+
+	t.Run("处理到不足一批为止", func(t *testing.T) {
+		remaining := 25
+		var limits []int
+		total, err := s.runBatches(context.Background(), "测试", func(ctx context.Context, limit int) (int, error) {
+			limits = append(limits, limit)
+			n := min(remaining, limit)
+			remaining -= n
+			return n, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 25, total)
+		assert.Equal(t, []int{10, 10, 10}, limits)
+	})
+
+	t.Run("出错时返回已处理数量", func(t *testing.T) {
+		calls := 0
+		total, err := s.runBatches(context.Background(), "测试", func(ctx context.Context, limit int) (int, error) {
+			calls++
+			if calls == 2 {
+				return 0, errors.New("database is locked")
+			}
+			return limit, nil
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 10, total)
+	})
+
+	t.Run("取消后停止", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		total, err := s.runBatches(ctx, "测试", func(ctx context.Context, limit int) (int, error) {
+			cancel()
+			return limit, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 10, total)
+	})
+}
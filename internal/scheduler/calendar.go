@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// maxSkippedDays 区间向前扩展覆盖被跳过日期的上限
+const maxSkippedDays = 31
+
+// calendar 总结跳过规则：周末与节假日
+type calendar struct {
+	weekdaysOnly bool
+	holidays     map[string]bool
+}
+
+// loadCalendar 根据配置创建跳过规则，holidayFile 为空时不加载节假日
+func loadCalendar(weekdaysOnly bool, holidayFile string) (*calendar, error) {
+	c := &calendar{weekdaysOnly: weekdaysOnly, holidays: make(map[string]bool)}
+	if holidayFile == "" {
+		return c, nil
+	}
+
+	file, err := os.Open(holidayFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		if line == "" {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", line, locUTC)
+		if err != nil {
+			return nil, fmt.Errorf("节假日文件第 %d 行日期无效: %s", lineNo, line)
+		}
+		c.holidays[day.Format("2006-01-02")] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// skipped 判断指定日期（UTC）是否跳过总结
+func (c *calendar) skipped(day time.Time) bool {
+	if c == nil {
+		return false
+	}
+	day = day.In(locUTC)
+	if c.weekdaysOnly && (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) {
+		return true
+	}
+	return c.holidays[day.Format("2006-01-02")]
+}
+
+// skippedDaysBefore 返回 day 之前连续被跳过的天数
+func (c *calendar) skippedDaysBefore(day time.Time) int {
+	n := 0
+	for n < maxSkippedDays && c.skipped(day.AddDate(0, 0, -(n+1))) {
+		n++
+	}
+	return n
+}
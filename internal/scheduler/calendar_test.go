@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCalendar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "holidays.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# 春节\n2025-01-29\n\n2025-01-30 # 初二\n"), 0644))
+
+	c, err := loadCalendar(false, path)
+	require.NoError(t, err)
+	assert.True(t, c.skipped(time.Date(2025, 1, 29, 8, 0, 0, 0, time.UTC)))
+	assert.True(t, c.skipped(time.Date(2025, 1, 30, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, c.skipped(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, os.WriteFile(path, []byte("2025/01/29\n"), 0644))
+	_, err = loadCalendar(false, path)
+	assert.Error(t, err)
+}
+
+func TestWindowRange_WeekdaysOnly(t *testing.T) {
+	cal, err := loadCalendar(true, "")
+	require.NoError(t, err)
+	w := summaryWindow{name: defaultWindowName, startOffset: -24 * time.Hour}
+
+	day := func(d int) time.Time { return time.Date(2025, 2, d, 0, 0, 0, 0, time.UTC) }
+	trigger := func(d int) time.Time { return day(d).Add(5 * time.Minute) }
+
+	// 2025-02-07 为周五，02-08、02-09 为周末，02-10 为周一
+	tests := []struct {
+		name      string
+		cover     bool
+		now       time.Time
+		wantSkip  bool
+		wantStart time.Time
+	}{
+		{"周六总结周五", false, trigger(8), false, day(7)},
+		{"周日跳过周六", false, trigger(9), true, time.Time{}},
+		{"周一跳过周日", false, trigger(10), true, time.Time{}},
+		{"周二仅总结周一", false, trigger(11), false, day(10)},
+		{"周二覆盖整个周末", true, trigger(11), false, day(8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{config: &config.Summary{CoverSkippedDays: tt.cover}, calendar: cal}
+			start, end, skip := s.windowRange(w, tt.now)
+			assert.Equal(t, tt.wantSkip, skip)
+			if !tt.wantSkip {
+				assert.Equal(t, tt.wantStart, start)
+				assert.Equal(t, day(tt.now.Day()), end)
+			}
+		})
+	}
+}
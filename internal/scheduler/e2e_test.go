@@ -0,0 +1,238 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChatID = -100
+
+// withoutDates 将总结中的区间日期替换为 {日期1}、{日期2}…，使快照不随运行日期变化
+func withoutDates(text string, dates ...time.Time) string {
+	for i, date := range dates {
+		text = strings.ReplaceAll(text, date.Format(time.DateOnly), fmt.Sprintf("{日期%d}", i+1))
+	}
+	return text
+}
+
+func TestRunOnce_RetriesSummaryAndNotify(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start := testkit.Today().AddDate(0, 0, -1)
+	id := h.AddMessage(t, testChatID, 1, "Alice", "明天发布新版本", start.Add(10*time.Hour))
+	h.AddMessage(t, testChatID, 2, "Bob", "好的，我来准备发布说明", start.Add(11*time.Hour))
+
+	h.LLM.Script(
+		testkit.Fail(errors.New("LLM 服务暂时不可用")),
+		testkit.Topics(testkit.Topic("版本发布", "Alice", "计划明天发布新版本", id)),
+	)
+	h.Telegram.FailNext(errors.New("网络连接中断"))
+
+	require.NoError(t, h.RunOnce(ctx))
+	assert.Equal(t, 2, h.LLM.Calls(), "总结失败后重试一次")
+	sent := h.Telegram.SentTo(testChatID)
+	require.Len(t, sent, 1, "发送失败后重试一次")
+	testkit.AssertGolden(t, "retries_summary", withoutDates(sent[0].Text, start))
+
+	tasks, err := h.Client.Task.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, task.StatusCompleted, tasks[0].Status)
+	assert.Empty(t, tasks[0].SummaryContent, "发送成功后清除待发送摘要")
+
+	require.NoError(t, h.RunOnce(ctx))
+	assert.Equal(t, 2, h.LLM.Calls(), "已完成的窗口不重复执行")
+	assert.Len(t, h.Telegram.Sent(), 1)
+}
+
+func TestRecover_ResendsPersistedSummary(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start, end := testkit.Today().AddDate(0, 0, -1), testkit.Today()
+
+	// 模拟在发送阶段退出：DailyRun 已完成，任务处理中且已保存待发送摘要
+	_, err := h.DailyRuns.Create(ctx, "daily", start, end, dailyrun.StatusCompleted)
+	require.NoError(t, err)
+	taskRecord, err := h.Tasks.GetOrCreateTask(ctx, testChatID, start, end, task.KindScheduled, task.StatusProcessing)
+	require.NoError(t, err)
+	require.NoError(t, h.Tasks.SetSummaryContent(ctx, taskRecord.ID, "已生成的摘要"))
+
+	require.NoError(t, h.Recover(ctx))
+	assert.Zero(t, h.LLM.Calls(), "只重试发送，不重新生成总结")
+	sent := h.Telegram.SentTo(testChatID)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "已生成的摘要", sent[0].Text)
+
+	latest, err := h.Client.Task.Get(ctx, taskRecord.ID)
+	require.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, latest.Status)
+}
+
+func TestEditSummary_CompletesFailedTask(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start, end := testkit.Today().AddDate(0, 0, -1), testkit.Today()
+	h.AddMessage(t, testChatID, 1, "Alice", "明天发布新版本", start.Add(10*time.Hour))
+
+	// 模拟发送失败：DailyRun 失败，任务失败且保留待发送摘要
+	_, err := h.DailyRuns.Create(ctx, "daily", start, end, dailyrun.StatusFailed)
+	require.NoError(t, err)
+	taskRecord, err := h.Tasks.GetOrCreateTask(ctx, testChatID, start, end, task.KindScheduled, task.StatusFailed)
+	require.NoError(t, err)
+	require.NoError(t, h.Tasks.SetSummaryContent(ctx, taskRecord.ID, "已生成的摘要"))
+
+	sent, err := h.Scheduler.EditSummary(ctx, taskRecord.ID, "修改后的摘要")
+	require.NoError(t, err)
+	require.True(t, sent)
+	latest, err := h.Client.Task.Get(ctx, taskRecord.ID)
+	require.NoError(t, err)
+	assert.Equal(t, task.StatusCompleted, latest.Status)
+
+	require.NoError(t, h.RunOnce(ctx))
+	assert.Zero(t, h.LLM.Calls(), "已修改并发送的任务不再重试")
+	messages := h.Telegram.SentTo(testChatID)
+	require.Len(t, messages, 1, "群组只收到一次总结")
+	assert.Equal(t, "修改后的摘要", messages[0].Text)
+}
+
+func TestRecover_RunsMissedWindow(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start := testkit.Today().AddDate(0, 0, -1)
+	id := h.AddMessage(t, testChatID, 1, "Alice", "周会改到周四下午", start.Add(9*time.Hour))
+	h.LLM.Script(testkit.Topics(testkit.Topic("周会时间", "Alice", "周会改到周四下午", id)))
+
+	require.NoError(t, h.Recover(ctx))
+	assert.Equal(t, 1, h.LLM.Calls())
+	assert.Len(t, h.Telegram.SentTo(testChatID), 1)
+
+	runs, err := h.Client.DailyRun.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, runs, 1, "漏跑的窗口补建 DailyRun")
+	assert.Equal(t, dailyrun.StatusCompleted, runs[0].Status)
+}
+
+func TestRunOnce_SkipsChatsNotAllowed(t *testing.T) {
+	ctx := context.Background()
+	cfg := testkit.DefaultConfig()
+	cfg.IncludeChatIds = []int64{testChatID, -200}
+	cfg.ExcludeChatIds = []int64{-300}
+	h := testkit.New(t, cfg)
+	start := testkit.Today().AddDate(0, 0, -1)
+	id := h.AddMessage(t, testChatID, 1, "Alice", "下周一开始迁移数据库", start.Add(10*time.Hour))
+	h.AddMessage(t, -300, 2, "Bob", "排除的群组", start.Add(10*time.Hour))
+	h.AddMessage(t, -400, 3, "Carol", "不在允许列表的群组", start.Add(10*time.Hour))
+	h.LLM.Script(testkit.Topics(testkit.Topic("数据库迁移", "Alice", "下周一开始迁移", id)))
+
+	require.NoError(t, h.RunOnce(ctx))
+	assert.Equal(t, 1, h.LLM.Calls(), "只总结允许的群组")
+	assert.Len(t, h.Telegram.SentTo(testChatID), 1)
+	assert.Len(t, h.Telegram.Sent(), 1)
+
+	tasks, err := h.Client.Task.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1, "未允许的群组不创建任务")
+	assert.Equal(t, int64(testChatID), tasks[0].ChatID)
+}
+
+func TestRunOnce_PostsIntoForumThread(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start := testkit.Today().AddDate(0, 0, -1)
+	const threadID = 7 << 20
+	for i, text := range []string{"发布流程改成灰度发布", "灰度比例先定 10%"} {
+		_, err := h.Messages.Create(ctx, &model.MessageData{
+			MessageID:       int64(i+1) << 20,
+			ServerMessageID: int64(i + 1),
+			ChatID:          testChatID,
+			SenderID:        1,
+			SenderName:      "Alice",
+			Text:            text,
+			SentAt:          start.Add(time.Duration(10+i) * time.Hour),
+			MessageThreadID: threadID,
+		})
+		require.NoError(t, err)
+	}
+	h.LLM.Script(testkit.Topics(testkit.Topic("灰度发布", "Alice", "发布流程改成灰度发布", 1)))
+
+	require.NoError(t, h.RunOnce(ctx))
+	sent := h.Telegram.SentTo(testChatID)
+	require.Len(t, sent, 1)
+	assert.Equal(t, int64(threadID), sent[0].ThreadID, "消息均来自同一个论坛话题时发送到该话题")
+}
+
+func TestRunOnce_PostsPerForumThread(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start := testkit.Today().AddDate(0, 0, -1)
+	threads := []int64{7 << 20, 9 << 20}
+	for i, text := range []string{"发布流程改成灰度发布", "周末团建去爬山"} {
+		_, err := h.Messages.Create(ctx, &model.MessageData{
+			MessageID:       int64(i+1) << 20,
+			ServerMessageID: int64(i + 1),
+			ChatID:          testChatID,
+			SenderID:        1,
+			SenderName:      "Alice",
+			Text:            text,
+			SentAt:          start.Add(time.Duration(10+i) * time.Hour),
+			MessageThreadID: threads[i],
+		})
+		require.NoError(t, err)
+	}
+	h.LLM.Script(testkit.Topics(
+		testkit.Topic("灰度发布", "Alice", "发布流程改成灰度发布", 1),
+		testkit.Topic("团建", "Alice", "周末去爬山", 2),
+	))
+
+	require.NoError(t, h.RunOnce(ctx))
+	sent := h.Telegram.SentTo(testChatID)
+	require.Len(t, sent, 2, "涉及多个论坛话题时分别发送")
+	assert.Equal(t, threads[0], sent[0].ThreadID)
+	assert.Equal(t, threads[1], sent[1].ThreadID)
+	testkit.AssertGolden(t, "forum_thread_1", withoutDates(sent[0].Text, start))
+	testkit.AssertGolden(t, "forum_thread_2", withoutDates(sent[1].Text, start))
+}
+
+func TestSummarizeRange_AdhocTask(t *testing.T) {
+	ctx := context.Background()
+	h := testkit.New(t, nil)
+	start, end := testkit.Today().AddDate(0, 0, -1), testkit.Today()
+	id := h.AddMessage(t, testChatID, 1, "Alice", "周五前完成压测", start.Add(10*time.Hour))
+	h.LLM.Script(
+		testkit.Topics(testkit.Topic("压测计划", "Alice", "周五前完成压测", id)),
+		testkit.Topics(testkit.Topic("压测计划", "Alice", "周五前完成压测", id)),
+	)
+
+	summary, err := h.Scheduler.SummarizeRange(ctx, testChatID, start, end)
+	require.NoError(t, err)
+	testkit.AssertGolden(t, "adhoc_summary", withoutDates(summary, start))
+	assert.Empty(t, h.Telegram.Sent(), "按需总结由命令回复，不发送通知")
+
+	// 与定时窗口区间相同也不影响定时总结
+	require.NoError(t, h.RunOnce(ctx))
+	assert.Len(t, h.Telegram.SentTo(testChatID), 1)
+
+	tasks, err := h.Client.Task.Query().Order(task.ByID()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, task.KindAdhoc, tasks[0].Kind)
+	assert.Equal(t, task.StatusCompleted, tasks[0].Status)
+	assert.NotEmpty(t, tasks[0].SummaryJSON)
+	assert.Equal(t, task.KindScheduled, tasks[1].Kind)
+
+	recent, err := h.Tasks.GetRecentTasksByChat(ctx, testChatID, 10)
+	require.NoError(t, err)
+	require.Len(t, recent, 1, "按需总结不计入定时任务的统计")
+	assert.Equal(t, tasks[1].ID, recent[0].ID)
+}
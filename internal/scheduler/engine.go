@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// engineFor 返回群组使用的总结引擎：ChatEngines > ChatTypeEngines > Engine；chatType 为空表示类型未知
+func (s *Scheduler) engineFor(chatID int64, chatType string) string {
+	if engine, ok := s.config.ChatEngines[chatID]; ok && engine != "" {
+		return engine
+	}
+	if engine, ok := s.config.ChatTypeEngines[chatType]; ok && engine != "" {
+		return engine
+	}
+	return s.config.Engine
+}
+
+// chatTypeFor 查询群组的聊天类型，仅在配置了 ChatTypeEngines 时查询；查询失败时返回空字符串，使用默认引擎
+func (s *Scheduler) chatTypeFor(ctx context.Context, chatID int64) string {
+	if len(s.config.ChatTypeEngines) == 0 {
+		return ""
+	}
+	chatType, err := s.chatModel.GetType(ctx, chatID)
+	if err != nil {
+		logger.Warnf("[Scheduler] 群组 %d: 查询聊天类型失败，使用默认引擎: %v", chatID, err)
+		return ""
+	}
+	return string(chatType)
+}
+
+// configuredEngines 返回配置中引用的所有总结引擎名称
+func (s *Scheduler) configuredEngines() []string {
+	names := []string{s.engineFor(0, "")}
+	for _, engine := range s.config.ChatEngines {
+		if engine != "" {
+			names = append(names, engine)
+		}
+	}
+	for _, engine := range s.config.ChatTypeEngines {
+		if engine != "" {
+			names = append(names, engine)
+		}
+	}
+	return append(names, s.config.FallbackEngines...)
+}
+
+// summarizeWithFallback 依次使用降级引擎生成摘要（每个引擎只尝试一次），跳过已失败的 primary 引擎
+func (s *Scheduler) summarizeWithFallback(ctx context.Context, primary string, chatID int64, startTime, endTime time.Time) (*summarizer.SummaryResult, error) {
+	lastErr := fmt.Errorf("未配置降级引擎")
+	for _, engine := range s.config.FallbackEngines {
+		if engine == primary {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errs.ErrCancelled
+		default:
+		}
+
+		logger.Warnf("[Scheduler] 群组 %d: 引擎 %s 失败，降级使用 %s", chatID, primary, engine)
+		result, err := s.summarizer.SummarizeRangeWith(ctx, engine, chatID, startTime, endTime)
+		if err == nil {
+			return result, nil
+		}
+		logger.Warnf("[Scheduler] 群组 %d: 降级引擎 %s 失败: %v", chatID, engine, err)
+		lastErr = err
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubMessages struct{}
+
+func (stubMessages) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return []*ent.Message{{MessageID: 1, SenderName: "Alice", Text: "hi"}}, nil
+}
+
+type stubEngine struct {
+	resp string
+	err  error
+}
+
+func (e stubEngine) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	return e.resp, e.err
+}
+
+func TestEngineFor(t *testing.T) {
+	s := &Scheduler{config: &config.Summary{
+		Engine:          summarizer.DefaultEngine,
+		ChatEngines:     map[int64]string{-100: "local"},
+		ChatTypeEngines: map[string]string{"channel": summarizer.TopPostsEngine},
+	}}
+	assert.Equal(t, "local", s.engineFor(-100, "channel"), "按群组指定优先于按类型指定")
+	assert.Equal(t, summarizer.TopPostsEngine, s.engineFor(-200, "channel"))
+	assert.Equal(t, summarizer.DefaultEngine, s.engineFor(-200, "supergroup"))
+	assert.Equal(t, summarizer.DefaultEngine, s.engineFor(-200, ""), "类型未知时使用默认引擎")
+
+	s.config.Engine = "other"
+	assert.Equal(t, "other", s.engineFor(-200, ""))
+}
+
+func TestSummarizeWithFallback(t *testing.T) {
+	sum := summarizer.NewSummarizer(stubEngine{err: errors.New("quota exceeded")}, stubMessages{})
+	sum.RegisterEngine("broken", stubEngine{err: errors.New("broken")})
+	sum.RegisterEngine("local", stubEngine{resp: `{"topics":[{"title":"T","items":[]}]}`})
+
+	s := &Scheduler{summarizer: sum, config: &config.Summary{FallbackEngines: []string{"llm", "broken", "local"}}}
+	result, err := s.summarizeWithFallback(context.Background(), "llm", -100, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "local", result.Engine)
+
+	s.config.FallbackEngines = []string{}
+	_, err = s.summarizeWithFallback(context.Background(), "llm", -100, time.Time{}, time.Time{})
+	assert.Error(t, err)
+}
+
+// blockingEngine 一直等待到 context 结束
+type blockingEngine struct{}
+
+func (blockingEngine) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestProcessTask_Timeout(t *testing.T) {
+	s := &Scheduler{
+		summarizer: summarizer.NewSummarizer(blockingEngine{}, stubMessages{}),
+		config: &config.Summary{
+			Engine:          summarizer.DefaultEngine,
+			FallbackEngines: []string{},
+			Retry:           config.Retry{LLM: config.RetryPolicy{Times: 1}},
+			TaskTimeout:     1,
+		},
+	}
+
+	err := s.processTask(context.Background(), -100, time.Time{}, time.Time{}, 0, nil)
+	require.ErrorIs(t, err, errTaskTimeout)
+	assert.Equal(t, errs.ActionRetry, errs.Classify(err), "超时只放弃该群组，不中止整个运行")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = s.processTask(ctx, -100, time.Time{}, time.Time{}, 0, nil)
+	assert.Equal(t, errs.ActionFailFast, errs.Classify(err), "运行取消不视为超时")
+}
@@ -0,0 +1,181 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/robfig/cron/v3"
+)
+
+// JobFunc 定时任务函数，ctx 在调度器停止时取消
+type JobFunc func(ctx context.Context) error
+
+// jobEntry 已注册的定时任务及其运行状态
+type jobEntry struct {
+	name string
+	spec string
+	id   cron.EntryID
+
+	mu           sync.Mutex
+	running      bool
+	lastRun      time.Time
+	lastDuration time.Duration
+	lastError    string
+	runs         int
+	failures     int
+}
+
+// JobSchedule 定时任务的调度信息与运行状态
+type JobSchedule struct {
+	Name         string     `json:"name"`
+	Spec         string     `json:"spec"`
+	NextRun      time.Time  `json:"next_run"`
+	Running      bool       `json:"running"`
+	LastRun      *time.Time `json:"last_run,omitempty"`
+	LastDuration string     `json:"last_duration,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+	Runs         int        `json:"runs"`
+	Failures     int        `json:"failures"`
+}
+
+// AddJob 注册额外的定时任务（如统计快照、备份、元数据刷新），与总结任务一同在 /status 和 /health 中展示运行状态。
+// 同一任务上一次尚未结束时，本次触发会被跳过
+func (s *Scheduler) AddJob(name, spec string, fn JobFunc) error {
+	s.mu.Lock()
+	for _, job := range s.jobs {
+		if job.name == name {
+			s.mu.Unlock()
+			return fmt.Errorf("任务 %s 已注册", name)
+		}
+	}
+	s.mu.Unlock()
+	return s.addJob(name, spec, fn)
+}
+
+// addJob 注册定时任务并记录，便于查询下次执行时间和运行状态
+func (s *Scheduler) addJob(name, spec string, fn JobFunc) error {
+	job := &jobEntry{name: name, spec: spec}
+	id, err := s.cron.AddFunc(spec, func() { s.runJob(job, fn) })
+	if err != nil {
+		return err
+	}
+	job.id = id
+
+	s.mu.Lock()
+	s.jobs = append(s.jobs, job)
+	s.mu.Unlock()
+	return nil
+}
+
+// runJob 执行定时任务并记录运行状态
+func (s *Scheduler) runJob(job *jobEntry, fn JobFunc) {
+	s.mu.Lock()
+	ctx := s.ctx
+	s.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		logger.Infof("[Scheduler] 任务 %s 已取消，退出", job.name)
+		return
+	default:
+	}
+
+	job.mu.Lock()
+	if job.running {
+		job.mu.Unlock()
+		logger.Warnf("[Scheduler] 任务 %s 上一次执行尚未结束，跳过本次触发", job.name)
+		return
+	}
+	job.running = true
+	job.lastRun = time.Now()
+	job.mu.Unlock()
+
+	err := fn(ctx)
+
+	job.mu.Lock()
+	job.running = false
+	job.lastDuration = time.Since(job.lastRun)
+	job.runs++
+	job.lastError = ""
+	if err != nil {
+		job.failures++
+		job.lastError = err.Error()
+	}
+	job.mu.Unlock()
+
+	if err != nil {
+		logger.Errorf("[Scheduler] 任务 %s 执行失败: %v", job.name, err)
+	}
+}
+
+// NextRuns 返回所有已注册定时任务的下次执行时间及运行状态
+func (s *Scheduler) NextRuns() []JobSchedule {
+	s.mu.Lock()
+	jobs := make([]*jobEntry, len(s.jobs))
+	copy(jobs, s.jobs)
+	s.mu.Unlock()
+
+	now := time.Now().In(locUTC)
+	result := make([]JobSchedule, 0, len(jobs))
+	for _, job := range jobs {
+		entry := s.cron.Entry(job.id)
+		if !entry.Valid() {
+			continue
+		}
+		schedule := JobSchedule{
+			Name:    job.name,
+			Spec:    job.spec,
+			NextRun: entry.Schedule.Next(now),
+		}
+
+		job.mu.Lock()
+		schedule.Running = job.running
+		schedule.Runs = job.runs
+		schedule.Failures = job.failures
+		schedule.LastError = job.lastError
+		if !job.lastRun.IsZero() {
+			lastRun := job.lastRun.In(locUTC)
+			schedule.LastRun = &lastRun
+			if !job.running {
+				schedule.LastDuration = job.lastDuration.Round(time.Millisecond).String()
+			}
+		}
+		job.mu.Unlock()
+
+		result = append(result, schedule)
+	}
+	return result
+}
+
+// StatusText 返回调度器状态的文本描述（用于 /status 命令及心跳消息）
+func (s *Scheduler) StatusText() string {
+	f := s.formatter
+	var sb strings.Builder
+	sb.WriteString(f.T(display.TextStatusTitle) + "\n")
+	jobs := s.NextRuns()
+	if len(jobs) == 0 {
+		sb.WriteString(f.T(display.TextStatusNoJobs) + "\n")
+	}
+	for _, job := range jobs {
+		sb.WriteString(f.Tf(display.TextStatusJob, job.Name, job.Spec, job.NextRun.Format("2006-01-02 15:04:05 MST")) + "\n")
+		switch {
+		case job.Running:
+			sb.WriteString(f.Tf(display.TextStatusRunning, job.LastRun.Format("2006-01-02 15:04:05 MST")) + "\n")
+		case job.LastRun != nil:
+			result := f.T(display.TextStatusSucceeded)
+			if job.LastError != "" {
+				result = f.Tf(display.TextStatusFailed, job.LastError)
+			}
+			sb.WriteString(f.Tf(display.TextStatusLastRun, job.LastRun.Format("2006-01-02 15:04:05 MST"), job.LastDuration, result) + "\n")
+		}
+		if job.Runs > 0 {
+			sb.WriteString(f.Tf(display.TextStatusRuns, job.Runs, job.Failures) + "\n")
+		}
+	}
+	return sb.String()
+}
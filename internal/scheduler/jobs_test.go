@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddJob_TracksStatus(t *testing.T) {
+	s := &Scheduler{cron: cron.New(cron.WithLocation(locUTC)), ctx: context.Background()}
+
+	fail := true
+	require.NoError(t, s.AddJob("backup", "0 3 * * *", func(ctx context.Context) error {
+		if fail {
+			return errors.New("disk full")
+		}
+		return nil
+	}))
+	assert.Error(t, s.AddJob("backup", "0 4 * * *", func(ctx context.Context) error { return nil }), "重复名称应报错")
+
+	run := s.cron.Entry(s.jobs[0].id).WrappedJob.Run
+	run()
+	jobs := s.NextRuns()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, 1, jobs[0].Runs)
+	assert.Equal(t, 1, jobs[0].Failures)
+	assert.Equal(t, "disk full", jobs[0].LastError)
+	assert.NotNil(t, jobs[0].LastRun)
+	assert.Contains(t, s.StatusText(), "失败: disk full")
+
+	fail = false
+	run()
+	jobs = s.NextRuns()
+	assert.Equal(t, 2, jobs[0].Runs)
+	assert.Equal(t, 1, jobs[0].Failures)
+	assert.Empty(t, jobs[0].LastError)
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// runLeaseTTL DailyRun 执行租约时长，执行期间每 1/3 租约时长续期一次
+const runLeaseTTL = 5 * time.Minute
+
+// errRunLocked DailyRun 正由其他流程执行
+var errRunLocked = errors.New("DailyRun 正由其他流程执行")
+
+var leaseSeq atomic.Int64
+
+// newLeaseOwner 生成本次执行的租约持有者标识（进程内每次执行唯一）
+func newLeaseOwner() string {
+	return fmt.Sprintf("%d-%d-%d", os.Getpid(), time.Now().UnixNano(), leaseSeq.Add(1))
+}
+
+// holdLease 获取 DailyRun 的执行租约并在后台续期；返回的 release 用于停止续期并释放租约
+func (s *Scheduler) holdLease(ctx context.Context, runID int) (release func(), err error) {
+	owner := newLeaseOwner()
+	ok, err := s.dailyRunModel.AcquireLease(ctx, runID, owner, runLeaseTTL)
+	if err != nil {
+		return nil, fmt.Errorf("获取 DailyRun 执行租约失败: %w", err)
+	}
+	if !ok {
+		return nil, errRunLocked
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(runLeaseTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if ok, err := s.dailyRunModel.AcquireLease(ctx, runID, owner, runLeaseTTL); err != nil || !ok {
+					logger.Warnf("[Scheduler] 续期 DailyRun 执行租约失败 (runID=%d): ok=%v, err=%v", runID, ok, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		if err := s.dailyRunModel.ReleaseLease(context.Background(), runID, owner); err != nil {
+			logger.Warnf("[Scheduler] 释放 DailyRun 执行租约失败 (runID=%d): %v", runID, err)
+		}
+	}, nil
+}
@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// Backfiller 补录 since 之后离线期间的消息，返回时消息已写入存储（默认实现为 teleapp.TeleApp）
+type Backfiller interface {
+	Backfill(ctx context.Context, since time.Time) (int, error)
+}
+
+// dueWindow 单次运行中需要执行的总结窗口及其区间
+type dueWindow struct {
+	window    summaryWindow
+	startTime time.Time
+	endTime   time.Time
+}
+
+// dueWindows 返回 now 时区间已结束的总结窗口；name 非空时只返回该窗口，窗口不存在时返回错误
+func (s *Scheduler) dueWindows(name string, now time.Time) ([]dueWindow, error) {
+	var due []dueWindow
+	found := false
+	for _, w := range s.summaryWindows() {
+		if name != "" && w.name != name {
+			continue
+		}
+		found = true
+		startTime, endTime, skip := s.windowRange(w, now)
+		if skip {
+			logger.Infof("[Scheduler] 今日为跳过日（周末或节假日），跳过总结窗口 %s", w.name)
+			continue
+		}
+		if endTime.After(now) {
+			logger.Infof("[Scheduler] 总结窗口 %s 的区间尚未结束，跳过: %s", w.name, formatRange(startTime, endTime))
+			continue
+		}
+		due = append(due, dueWindow{window: w, startTime: startTime, endTime: endTime})
+	}
+	if !found {
+		return nil, fmt.Errorf("未知的总结窗口: %s", name)
+	}
+	return due, nil
+}
+
+// RunOnce 单次运行模式：不启动 cron，对区间已结束的总结窗口（window 非空时只执行该窗口）补录消息、
+// 生成总结并发送通知后返回，用于由 systemd timer、GitHub Actions 等外部定时触发的短时部署。
+// 已完成的窗口不重复执行，未完成的窗口从中断处继续。backfiller 非空时先补录最早区间开始后的消息。
+// 任一窗口执行失败或有群组总结失败时返回错误，调用方据此以非零状态码退出
+func (s *Scheduler) RunOnce(ctx context.Context, window string, backfiller Backfiller) error {
+	if err := s.prepare(); err != nil {
+		return err
+	}
+	due, err := s.dueWindows(window, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		logger.Infof("[Scheduler] 没有需要执行的总结窗口")
+		return nil
+	}
+
+	if backfiller != nil {
+		since := due[0].startTime
+		for _, d := range due[1:] {
+			if d.startTime.Before(since) {
+				since = d.startTime
+			}
+		}
+		n, err := backfiller.Backfill(ctx, since)
+		if err != nil {
+			return fmt.Errorf("补录消息失败: %w", err)
+		}
+		logger.Infof("[Scheduler] 已补录 %s 之后的消息 %d 条", since.Format("2006-01-02 15:04"), n)
+	}
+
+	var runErrs []error
+	for _, d := range due {
+		if err := s.runDueWindow(ctx, d); err != nil {
+			logger.Errorf("[Scheduler] 总结窗口 %s 执行失败: %v", d.window.name, err)
+			runErrs = append(runErrs, fmt.Errorf("窗口 %s: %w", d.window.name, err))
+		}
+	}
+	return errors.Join(runErrs...)
+}
+
+// runDueWindow 执行单个窗口，有群组总结失败时返回错误
+func (s *Scheduler) runDueWindow(ctx context.Context, d dueWindow) error {
+	logger.Infof("[Scheduler] 开始执行总结窗口 %s，区间: %s", d.window.name, formatRange(d.startTime, d.endTime))
+	run, err := s.dailyRunModel.GetOrCreate(ctx, d.window.name, d.startTime, d.endTime, dailyrun.StatusInProgress)
+	if err != nil {
+		return fmt.Errorf("获取或创建 DailyRun 失败: %w", err)
+	}
+	if run.Status == dailyrun.StatusCompleted {
+		logger.Infof("[Scheduler] 窗口 %s 的 DailyRun 已完成，跳过", d.window.name)
+		return nil
+	}
+
+	stats, err := s.executeDailyRun(ctx, run)
+	if errors.Is(err, errRunLocked) {
+		return fmt.Errorf("DailyRun 正由其他进程执行 (runID=%d)", run.ID)
+	}
+	if err != nil {
+		return err
+	}
+	if stats != nil && stats.ChatsFailed > 0 {
+		return fmt.Errorf("%d 个群组总结失败", stats.ChatsFailed)
+	}
+	return nil
+}
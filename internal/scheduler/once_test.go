@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDueWindows(t *testing.T) {
+	cal, err := loadCalendar(false, "")
+	require.NoError(t, err)
+	s := &Scheduler{calendar: cal, config: &config.Summary{Windows: []config.SummaryWindow{
+		{Name: "morning", Cron: "0 12 * * *", StartOffset: "0h", EndOffset: "12h"},
+		{Name: "afternoon", Cron: "0 18 * * *", StartOffset: "12h", EndOffset: "18h"},
+	}}}
+	now := time.Date(2025, 2, 12, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		window  string
+		want    []string
+		wantErr bool
+	}{
+		{"全部窗口只返回区间已结束的", "", []string{"morning"}, false},
+		{"指定已结束的窗口", "morning", []string{"morning"}, false},
+		{"指定未结束的窗口", "afternoon", nil, false},
+		{"未知窗口", "evening", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due, err := s.dueWindows(tt.window, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, d := range due {
+				names = append(names, d.window.name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
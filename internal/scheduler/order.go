@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// orderChats 按处理顺序排列群组，使重要或消息多的群组先生成总结，运行中断时也能先送达关键总结
+func (s *Scheduler) orderChats(ctx context.Context, chatIDs []int64, startTime, endTime time.Time) []int64 {
+	var counts map[int64]int
+	if s.config.OrderByMessages {
+		var err error
+		counts, err = s.messageModel.CountByChat(ctx, startTime, endTime)
+		if err != nil {
+			logger.Warnf("[Scheduler] 统计群组消息数失败，仅按优先级排序: %v", err)
+			counts = nil
+		}
+	}
+	return sortChats(chatIDs, s.config.ChatPriorities, counts)
+}
+
+// sortChats 按优先级从高到低排序，优先级相同时按消息数从多到少（counts 为 nil 时忽略），再按群组ID升序保证顺序稳定
+func sortChats(chatIDs []int64, priorities map[int64]int, counts map[int64]int) []int64 {
+	sorted := make([]int64, len(chatIDs))
+	copy(sorted, chatIDs)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if priorities[a] != priorities[b] {
+			return priorities[a] > priorities[b]
+		}
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		return a < b
+	})
+	return sorted
+}
@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortChats(t *testing.T) {
+	chatIDs := []int64{-300, -100, -200, -400}
+	tests := []struct {
+		name       string
+		priorities map[int64]int
+		counts     map[int64]int
+		want       []int64
+	}{
+		{"未配置时按群组ID排序", nil, nil, []int64{-400, -300, -200, -100}},
+		{"按优先级从高到低", map[int64]int{-100: 10, -200: 5, -400: -1}, nil, []int64{-100, -200, -300, -400}},
+		{"按消息数从多到少", nil, map[int64]int{-100: 3, -200: 50, -300: 7}, []int64{-200, -300, -100, -400}},
+		{"优先级优先于消息数", map[int64]int{-100: 1}, map[int64]int{-100: 3, -200: 50, -300: 7}, []int64{-100, -200, -300, -400}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sortChats(chatIDs, tt.priorities, tt.counts))
+		})
+	}
+	assert.Equal(t, []int64{-300, -100, -200, -400}, chatIDs, "不修改传入的切片")
+}
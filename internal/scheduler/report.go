@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// reportTopChats 运行报告中列出的输入 tokens 最多的群组数
+const reportTopChats = 5
+
+// formatRunReport 生成发送给管理员的运行报告（HTML）
+func formatRunReport(run *ent.DailyRun, stats *model.RunStats, budgets map[int64]summarizer.PromptBudget, runErr error, formatter *display.Formatter) string {
+	var sb strings.Builder
+	line := func(key display.TextKey, args ...any) {
+		sb.WriteString(formatter.Tf(key, args...))
+		sb.WriteString("\n")
+	}
+	line(display.TextReportTitle)
+	sb.WriteString(fmt.Sprintf("📅 %s\n", formatter.DateRange(run.StartTime, run.EndTime)))
+	if run.Window != defaultWindowName {
+		line(display.TextReportWindow, html.EscapeString(run.Window))
+	}
+	if runErr != nil {
+		line(display.TextReportFailed, html.EscapeString(runErr.Error()))
+	} else {
+		line(display.TextReportDone)
+	}
+	line(display.TextReportChats, stats.ChatsProcessed, stats.ChatsFailed)
+	line(display.TextReportMessages, stats.MessagesSummarized, stats.MessagesCleaned)
+	if len(stats.Languages) > 0 {
+		line(display.TextReportLanguages, formatLanguages(stats.Languages))
+	}
+	line(display.TextReportDuration, stats.Duration.Round(time.Second))
+	line(display.TextReportTokens, stats.TotalTokens, stats.PromptTokens, stats.CompletionTokens, stats.Cost)
+	if top := topBudgets(budgets, reportTopChats); len(top) > 0 {
+		line(display.TextReportTopChats)
+		for _, chatID := range top {
+			b := budgets[chatID]
+			line(display.TextReportTopChat, chatID, b.PromptTokens, b.RawMessages, b.FilteredMessages, b.Chunks, b.OverheadTokens)
+		}
+	}
+	return sb.String()
+}
+
+// topBudgets 按实际输入 tokens 降序返回前 n 个群组，不含未调用 LLM 的群组
+func topBudgets(budgets map[int64]summarizer.PromptBudget, n int) []int64 {
+	chatIDs := make([]int64, 0, len(budgets))
+	for chatID, b := range budgets {
+		if b.PromptTokens > 0 {
+			chatIDs = append(chatIDs, chatID)
+		}
+	}
+	sort.Slice(chatIDs, func(i, j int) bool {
+		if budgets[chatIDs[i]].PromptTokens != budgets[chatIDs[j]].PromptTokens {
+			return budgets[chatIDs[i]].PromptTokens > budgets[chatIDs[j]].PromptTokens
+		}
+		return chatIDs[i] < chatIDs[j]
+	})
+	if len(chatIDs) > n {
+		chatIDs = chatIDs[:n]
+	}
+	return chatIDs
+}
+
+// formatLanguages 语言分布，按消息数降序，如 "zh 70%, en 30%"
+func formatLanguages(languages map[string]int) string {
+	codes := make([]string, 0, len(languages))
+	total := 0
+	for code, n := range languages {
+		codes = append(codes, code)
+		total += n
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		if languages[codes[i]] != languages[codes[j]] {
+			return languages[codes[i]] > languages[codes[j]]
+		}
+		return codes[i] < codes[j]
+	})
+
+	parts := make([]string, len(codes))
+	for i, code := range codes {
+		parts[i] = fmt.Sprintf("%s %.0f%%", code, float64(languages[code])*100/float64(total))
+	}
+	return strings.Join(parts, ", ")
+}
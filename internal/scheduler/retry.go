@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+)
+
+const (
+	deferFactor   = 4                // 频率超限且服务端未给出等待时间时，重试间隔的倍数
+	maxRetryDelay = 10 * time.Minute // 单次重试前的最长等待时间
+)
+
+// retryDelay 返回出错后重试前的等待时间：频率超限时按服务端要求等待（未知时为 deferFactor 倍的重试间隔），
+// 解析失败是模型输出的偶发问题，立即重试；其他错误按重试间隔
+func retryDelay(err error, interval time.Duration) time.Duration {
+	switch {
+	case errs.Classify(err) == errs.ActionDefer:
+		delay := interval * deferFactor
+		if after, ok := errs.RetryAfter(err); ok {
+			delay = max(after, interval)
+		}
+		return min(delay, maxRetryDelay)
+	case errors.Is(err, errs.ErrParse):
+		return 0
+	default:
+		return interval
+	}
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	interval := 10 * time.Second
+	cause := errors.New("429 Too Many Requests")
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{"普通错误按重试间隔", errors.New("connection reset"), interval},
+		{"解析失败立即重试", fmt.Errorf("%w: unexpected end of JSON input", errs.ErrParse), 0},
+		{"频率超限未给出等待时间", fmt.Errorf("调用 LLM API 失败: %w", errs.WithRetryAfter(errs.ErrLLMRateLimited, cause, 0)), 4 * interval},
+		{"按服务端要求等待", errs.WithRetryAfter(errs.ErrTelegramFlood, cause, 35*time.Second), 35 * time.Second},
+		{"等待时间不短于重试间隔", errs.WithRetryAfter(errs.ErrTelegramFlood, cause, time.Second), interval},
+		{"等待时间有上限", errs.WithRetryAfter(errs.ErrTelegramFlood, cause, time.Hour), maxRetryDelay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryDelay(tt.err, interval))
+		})
+	}
+}
+
+func TestFailureStreak(t *testing.T) {
+	day := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	// window 创建第 n 天 [start, end) 小时区间的任务
+	window := func(n, start, end int, status task.Status) *ent.Task {
+		d := day.AddDate(0, 0, n)
+		return &ent.Task{StartTime: d.Add(time.Duration(start) * time.Hour), EndTime: d.Add(time.Duration(end) * time.Hour), Status: status}
+	}
+
+	days, newDay := failureStreak([]*ent.Task{
+		window(2, 12, 24, task.StatusFailed),
+		window(2, 0, 12, task.StatusFailed),
+		window(1, 12, 24, task.StatusFailed),
+		window(1, 0, 12, task.StatusCompleted),
+		window(0, 12, 24, task.StatusFailed),
+	})
+	assert.Equal(t, 2, days, "一天两个窗口按日期计数")
+	assert.False(t, newDay, "当天已有失败的窗口")
+
+	days, newDay = failureStreak([]*ent.Task{
+		window(2, 0, 12, task.StatusFailed),
+		window(1, 12, 24, task.StatusFailed),
+	})
+	assert.Equal(t, 2, days)
+	assert.True(t, newDay)
+
+	days, newDay = failureStreak([]*ent.Task{window(2, 0, 24, task.StatusCompleted)})
+	assert.Zero(t, days)
+	assert.False(t, newDay)
+}
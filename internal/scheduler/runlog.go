@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+type runIDCtx struct{}
+
+// withRunID 将 DailyRun ID 绑定到 ctx，运行期间记录的事件据此关联到该运行
+func withRunID(ctx context.Context, runID int) context.Context {
+	return context.WithValue(ctx, runIDCtx{}, runID)
+}
+
+func runIDFromContext(ctx context.Context) int {
+	runID, _ := ctx.Value(runIDCtx{}).(int)
+	return runID
+}
+
+// recordEvent 将运行事件保存到 RunLog，供仪表盘和 API 按运行或任务展示时间线；
+// 保存失败只记录日志，不影响总结流程
+func (s *Scheduler) recordEvent(ctx context.Context, taskID int, chatID int64, event runlog.Event, detail string) {
+	if s.runLogModel == nil {
+		return
+	}
+	if err := s.runLogModel.Record(ctx, runIDFromContext(ctx), taskID, chatID, event, detail); err != nil {
+		logger.Warnf("[Scheduler] 保存运行事件 %s 失败 (taskID=%d): %v", event, taskID, err)
+	}
+}
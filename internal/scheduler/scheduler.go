@@ -2,17 +2,28 @@ package scheduler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/fachebot/talk-trace-bot/internal/alert"
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
 	"github.com/fachebot/talk-trace-bot/internal/ent"
 	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/runlog"
+	"github.com/fachebot/talk-trace-bot/internal/ent/summaryrevision"
 	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
 	"github.com/fachebot/talk-trace-bot/internal/model"
 	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/storage"
 	"github.com/fachebot/talk-trace-bot/internal/summarizer"
 	"github.com/robfig/cron/v3"
 )
@@ -21,58 +32,134 @@ type Scheduler struct {
 	cron          *cron.Cron
 	summarizer    *summarizer.Summarizer
 	notifier      *notify.Notifier
-	messageModel  *model.MessageModel
-	taskModel     *model.TaskModel
-	dailyRunModel *model.DailyRunModel
+	alerter       *alert.Alerter
+	messageModel  storage.MessageStore
+	chatModel     *model.ChatModel
+	taskModel     storage.TaskStore
+	revisionModel *model.SummaryRevisionModel
+	dailyRunModel storage.DailyRunStore
+	runLogModel   *model.RunLogModel
+	linkModel     *model.SharedLinkModel
 	config        *config.Summary
 	ctx           context.Context
 	cancel        context.CancelFunc
 	mu            sync.Mutex
+	jobs          []*jobEntry
+	calendar      *calendar
+	formatter     *display.Formatter
 }
 
 // locUTC UTC 标准时间（UTC）
 var locUTC = time.UTC
 
+// errTaskTimeout 单个群组任务处理超过 TaskTimeout
+var errTaskTimeout = errors.New("任务处理超时")
+
+// EditSummary 拒绝修改时返回的错误，调用方据此回复对应的提示
+var (
+	ErrEmptySummary     = errors.New("摘要内容不能为空")
+	ErrTaskNotFound     = errors.New("任务不存在")
+	ErrTaskProcessing   = errors.New("任务正在处理中")
+	ErrNoPendingSummary = errors.New("任务没有待发送的摘要")
+)
+
 func NewScheduler(
 	summarizer *summarizer.Summarizer,
 	notifier *notify.Notifier,
-	messageModel *model.MessageModel,
-	taskModel *model.TaskModel,
-	dailyRunModel *model.DailyRunModel,
+	alerter *alert.Alerter,
+	messageModel storage.MessageStore,
+	chatModel *model.ChatModel,
+	taskModel storage.TaskStore,
+	revisionModel *model.SummaryRevisionModel,
+	dailyRunModel storage.DailyRunStore,
+	runLogModel *model.RunLogModel,
 	cfg *config.Summary,
 ) *Scheduler {
 	return &Scheduler{
 		cron:          cron.New(cron.WithLocation(locUTC)),
 		summarizer:    summarizer,
 		notifier:      notifier,
+		alerter:       alerter,
 		messageModel:  messageModel,
+		chatModel:     chatModel,
 		taskModel:     taskModel,
+		revisionModel: revisionModel,
 		dailyRunModel: dailyRunModel,
+		runLogModel:   runLogModel,
 		config:        cfg,
 	}
 }
 
+// SetLinkModel 设置分享链接的存储：清理过期消息时同时删除过期的链接
+func (s *Scheduler) SetLinkModel(linkModel *model.SharedLinkModel) {
+	s.linkModel = linkModel
+}
+
 // Start 启动调度器
 func (s *Scheduler) Start() error {
 	s.mu.Lock()
 	s.ctx, s.cancel = context.WithCancel(context.Background())
+	ctx := s.ctx
 	s.mu.Unlock()
 
-	// 注册每日总结任务
-	_, err := s.cron.AddFunc(s.config.Cron, s.runDailySummary)
-	if err != nil {
-		return fmt.Errorf("注册每日总结任务失败: %w", err)
+	if err := s.prepare(); err != nil {
+		return err
+	}
+
+	// 注册总结任务，每个窗口一个
+	for _, w := range s.summaryWindows() {
+		run := func(ctx context.Context) error { return s.runSummaryWindow(ctx, w) }
+		if err := s.addJob(w.jobName(), w.spec, run); err != nil {
+			return fmt.Errorf("注册总结任务 %s 失败: %w", w.name, err)
+		}
+	}
+
+	// 注册软删除消息的清除任务
+	if err := s.addJob("message_purge", s.config.PurgeCron, s.purgeMessages); err != nil {
+		return fmt.Errorf("注册消息清除任务失败: %w", err)
 	}
 
 	s.cron.Start()
-	logger.Infof("[Scheduler] 调度器已启动，每日总结任务: %s", s.config.Cron)
+	logger.Infof("[Scheduler] 调度器已启动")
+	for _, job := range s.NextRuns() {
+		logger.Infof("[Scheduler] 任务 %s (%s) 下次执行时间: %s", job.Name, job.Spec, job.NextRun.Format("2006-01-02 15:04:05 MST"))
+	}
 
 	// 启动时恢复未完成的任务
-	go s.recoverDailySummary()
+	go s.recoverDailySummary(ctx)
+
+	if s.config.SelfTestOnStartup {
+		go s.runSelfTest()
+	}
 
 	return nil
 }
 
+// prepare 加载展示格式和跳过规则，并校验配置中的总结引擎均已注册（Start 和 RunOnce 共用）
+func (s *Scheduler) prepare() error {
+	// 总结头部的展示格式
+	formatter, err := display.NewFormatter(&s.config.Display)
+	if err != nil {
+		return err
+	}
+	s.formatter = formatter
+
+	// 加载跳过规则（周末、节假日）
+	cal, err := loadCalendar(s.config.WeekdaysOnly, s.config.HolidayFile)
+	if err != nil {
+		return fmt.Errorf("加载节假日文件失败: %w", err)
+	}
+	s.calendar = cal
+
+	// 校验配置中的总结引擎均已注册
+	for _, name := range s.configuredEngines() {
+		if !s.summarizer.HasEngine(name) {
+			return fmt.Errorf("未知的总结引擎: %s", name)
+		}
+	}
+	return nil
+}
+
 // Stop 停止调度器
 func (s *Scheduler) Stop() {
 	s.mu.Lock()
@@ -86,12 +173,17 @@ func (s *Scheduler) Stop() {
 	logger.Infof("[Scheduler] 调度器已停止")
 }
 
-// recoverDailySummary 恢复每日总结（未完成的 DailyRun、缺失的当日、未完成的 Task）
-func (s *Scheduler) recoverDailySummary() {
-	s.mu.Lock()
-	ctx := s.ctx
-	s.mu.Unlock()
+// Recover 不启动 cron，同步执行一次启动时的恢复流程后返回，用于端到端测试恢复路径
+func (s *Scheduler) Recover(ctx context.Context) error {
+	if err := s.prepare(); err != nil {
+		return err
+	}
+	s.recoverDailySummary(ctx)
+	return nil
+}
 
+// recoverDailySummary 恢复每日总结（未完成的 DailyRun、缺失的当日、未完成的 Task）
+func (s *Scheduler) recoverDailySummary(ctx context.Context) {
 	logger.Infof("[Scheduler] 开始恢复每日总结")
 
 	// 1. 恢复未完成的 DailyRun
@@ -106,39 +198,36 @@ func (s *Scheduler) recoverDailySummary() {
 				return
 			default:
 			}
-			logger.Infof("[Scheduler] 恢复未完成 DailyRun: startTime=%s, endTime=%s", run.StartTime.Format("2006-01-02"), run.EndTime.Format("2006-01-02"))
-			if err := s.executeDailySummaryForRange(ctx, run.StartTime, run.EndTime); err != nil {
+			logger.Infof("[Scheduler] 恢复未完成 DailyRun: window=%s, 区间: %s", run.Window, formatRange(run.StartTime, run.EndTime))
+			if _, err := s.executeDailyRun(ctx, run); errors.Is(err, errRunLocked) {
+				logger.Infof("[Scheduler] DailyRun 正由其他流程执行，跳过恢复 (runID=%d)", run.ID)
+			} else if err != nil {
 				logger.Errorf("[Scheduler] 恢复 DailyRun 失败: %v", err)
-				_ = s.dailyRunModel.MarkFailed(ctx, run.ID, err.Error())
-			} else {
-				_ = s.dailyRunModel.MarkCompleted(ctx, run.ID)
 			}
 		}
 	}
 
-	// 2. 检查缺失的当日：若当日区间无 DailyRun 记录，视为漏跑并执行
-	rangeDays := s.config.RangeDays
-	if rangeDays <= 0 {
-		rangeDays = 1
-	}
+	// 2. 检查缺失的当日窗口：区间已结束但无 DailyRun 记录，视为漏跑并执行
 	now := time.Now().In(locUTC)
-	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, locUTC)
-	endTime := todayStart
-	startTime := todayStart.AddDate(0, 0, -rangeDays)
-
-	_, err = s.dailyRunModel.GetByDateRange(ctx, startTime, endTime)
-	if err != nil && ent.IsNotFound(err) {
-		logger.Infof("[Scheduler] 当日无 DailyRun 记录，补跑: %s ~ %s", startTime.Format("2006-01-02"), endTime.Format("2006-01-02"))
-		run, createErr := s.dailyRunModel.Create(ctx, startTime, endTime, dailyrun.StatusInProgress)
+	for _, w := range s.summaryWindows() {
+		startTime, endTime, skip := s.windowRange(w, now)
+		if skip || endTime.After(now) {
+			continue
+		}
+		_, err = s.dailyRunModel.GetByDateRange(ctx, startTime, endTime)
+		if err == nil || !ent.IsNotFound(err) {
+			continue
+		}
+		logger.Infof("[Scheduler] 窗口 %s 当日无 DailyRun 记录，补跑: %s", w.name, formatRange(startTime, endTime))
+		run, createErr := s.dailyRunModel.Create(ctx, w.name, startTime, endTime, dailyrun.StatusInProgress)
 		if createErr != nil {
 			logger.Errorf("[Scheduler] 创建 DailyRun 失败: %v", createErr)
-		} else {
-			if execErr := s.executeDailySummaryForRange(ctx, startTime, endTime); execErr != nil {
-				logger.Errorf("[Scheduler] 补跑 DailyRun 失败: %v", execErr)
-				_ = s.dailyRunModel.MarkFailed(ctx, run.ID, execErr.Error())
-			} else {
-				_ = s.dailyRunModel.MarkCompleted(ctx, run.ID)
-			}
+			continue
+		}
+		if _, execErr := s.executeDailyRun(ctx, run); errors.Is(execErr, errRunLocked) {
+			logger.Infof("[Scheduler] DailyRun 正由其他流程执行，跳过补跑 (runID=%d)", run.ID)
+		} else if execErr != nil {
+			logger.Errorf("[Scheduler] 补跑 DailyRun 失败: %v", execErr)
 		}
 	}
 
@@ -183,92 +272,158 @@ func (s *Scheduler) recoverPendingTasks(ctx context.Context) {
 		// 若已有待发送摘要（程序曾在发送阶段退出），只重试发送通知
 		if t.SummaryContent != "" {
 			logger.Infof("[Scheduler] 恢复任务仅重试发送通知: chatID=%d, taskID=%d", t.ChatID, t.ID)
-			sent, sendErr := s.sendTaskNotification(ctx, t.SummaryContent, t.ChatID)
+			// 幂等键与首次发送一致，已发送的分段会被跳过
+			sendCtx := s.taskSendContext(notify.WithTask(ctx, t.ID), t)
+			sent, sendErr := s.sendTaskNotification(sendCtx, t.SummaryContent, t.ChatID)
+			switch errs.Classify(sendErr) {
+			case errs.ActionFailFast:
+				return
+			case errs.ActionSkip:
+				logger.Warnf("[Scheduler] 恢复任务无法发送通知，标记为已阻止 (chatID=%d): %v", t.ChatID, sendErr)
+				_ = s.taskModel.MarkTaskBlocked(ctx, t.ID, sendErr.Error())
+				s.recordEvent(ctx, t.ID, t.ChatID, runlog.EventTaskBlocked, sendErr.Error())
+				continue
+			}
 			if sendErr != nil {
 				logger.Errorf("[Scheduler] 恢复发送通知失败 (chatID=%d): %v", t.ChatID, sendErr)
 				_ = s.taskModel.MarkTaskFailed(ctx, t.ID, sendErr.Error())
+				s.recordEvent(ctx, t.ID, t.ChatID, runlog.EventTaskFailed, sendErr.Error())
 				continue
 			}
 			if sent {
-				_ = s.taskModel.ClearSummaryContent(ctx, t.ID)
+				s.recordEvent(ctx, t.ID, t.ChatID, runlog.EventNotifySent, "")
+				s.clearTaskSendState(ctx, t.ID)
 			}
 			_ = s.taskModel.MarkTaskCompleted(ctx, t.ID)
 			continue
 		}
-		logger.Infof("[Scheduler] 恢复处理任务: chatID=%d, startTime=%s, endTime=%s", t.ChatID, t.StartTime.Format("2006-01-02"), t.EndTime.Format("2006-01-02"))
-		if err := s.processTask(ctx, t.ChatID, t.StartTime, t.EndTime, t.ID); err != nil {
+		logger.Infof("[Scheduler] 恢复处理任务: chatID=%d, 区间: %s", t.ChatID, formatRange(t.StartTime, t.EndTime))
+		if err := s.processTask(ctx, t.ChatID, t.StartTime, t.EndTime, t.ID, nil); err != nil {
+			switch errs.Classify(err) {
+			case errs.ActionFailFast:
+				// 保持处理中状态，下次启动时继续恢复
+				return
+			case errs.ActionSkip:
+				logger.Warnf("[Scheduler] 恢复任务无法发送通知，标记为已阻止 (chatID=%d): %v", t.ChatID, err)
+				_ = s.taskModel.MarkTaskBlocked(ctx, t.ID, err.Error())
+				s.recordEvent(ctx, t.ID, t.ChatID, runlog.EventTaskBlocked, err.Error())
+				continue
+			}
 			logger.Errorf("[Scheduler] 恢复处理任务失败 (chatID=%d): %v", t.ChatID, err)
 			_ = s.taskModel.MarkTaskFailed(ctx, t.ID, err.Error())
+			s.recordEvent(ctx, t.ID, t.ChatID, runlog.EventTaskFailed, err.Error())
 			continue
 		}
 		_ = s.taskModel.MarkTaskCompleted(ctx, t.ID)
 	}
 }
 
-// runDailySummary 执行每日总结任务（cron 触发）
-func (s *Scheduler) runDailySummary() {
-	s.mu.Lock()
-	ctx := s.ctx
-	s.mu.Unlock()
-
-	select {
-	case <-ctx.Done():
-		logger.Infof("[Scheduler] 任务已取消，退出")
-		return
-	default:
-	}
-
-	rangeDays := s.config.RangeDays
-	if rangeDays <= 0 {
-		rangeDays = 1
+// runSummaryWindow 执行总结窗口对应的任务（cron 触发）
+func (s *Scheduler) runSummaryWindow(ctx context.Context, w summaryWindow) error {
+	startTime, endTime, skip := s.windowRange(w, time.Now())
+	if skip {
+		logger.Infof("[Scheduler] 今日为跳过日（周末或节假日），跳过总结任务 %s", w.name)
+		return nil
 	}
-	now := time.Now().In(locUTC)
-	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, locUTC)
-	endTime := todayStart
-	startTime := todayStart.AddDate(0, 0, -rangeDays)
-
-	dateRange := fmt.Sprintf("%s ~ %s", startTime.Format("2006-01-02"), endTime.AddDate(0, 0, -1).Format("2006-01-02"))
-	logger.Infof("[Scheduler] 开始执行每日总结任务，区间: %s", dateRange)
+	logger.Infof("[Scheduler] 开始执行总结任务 %s，区间: %s", w.name, formatRange(startTime, endTime))
 
 	// 在查询前创建 DailyRun 记录，便于崩溃恢复
-	run, err := s.dailyRunModel.GetOrCreate(ctx, startTime, endTime, dailyrun.StatusInProgress)
+	run, err := s.dailyRunModel.GetOrCreate(ctx, w.name, startTime, endTime, dailyrun.StatusInProgress)
 	if err != nil {
-		logger.Errorf("[Scheduler] 获取或创建 DailyRun 失败: %v", err)
-		return
+		return fmt.Errorf("获取或创建 DailyRun 失败: %w", err)
 	}
 	// 若已存在且完成，跳过
 	if run.Status == dailyrun.StatusCompleted {
-		logger.Infof("[Scheduler] 当日 DailyRun 已完成，跳过")
-		return
+		logger.Infof("[Scheduler] 窗口 %s 的 DailyRun 已完成，跳过", w.name)
+		return nil
 	}
 
-	if err := s.executeDailySummaryForRange(ctx, startTime, endTime); err != nil {
-		logger.Errorf("[Scheduler] 每日总结执行失败: %v", err)
-		_ = s.dailyRunModel.MarkFailed(ctx, run.ID, err.Error())
-		return
+	if _, err := s.executeDailyRun(ctx, run); errors.Is(err, errRunLocked) {
+		logger.Infof("[Scheduler] 总结任务 %s 正由恢复流程执行，跳过", w.name)
+		return nil
+	} else if err != nil {
+		return err
 	}
-	_ = s.dailyRunModel.MarkCompleted(ctx, run.ID)
-	logger.Infof("[Scheduler] 每日总结任务完成")
+	logger.Infof("[Scheduler] 总结任务 %s 完成", w.name)
+	return nil
 }
 
-// executeDailySummaryForRange 对指定日期区间执行完整总结流程（查询、创建任务、处理、清理）
-func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime, endTime time.Time) error {
-	retryTimes := s.config.RetryTimes
-	if retryTimes <= 0 {
-		retryTimes = 3
+// executeDailyRun 执行 DailyRun 对应区间的总结，保存运行统计并标记最终状态。
+// 执行前获取租约，避免恢复流程与 cron 同时执行同一 DailyRun 导致重复发送；已被占用时返回 errRunLocked
+func (s *Scheduler) executeDailyRun(ctx context.Context, run *ent.DailyRun) (*model.RunStats, error) {
+	release, err := s.holdLease(ctx, run.ID)
+	if err != nil {
+		return nil, err
 	}
-	retryInterval := time.Duration(s.config.RetryInterval) * time.Second
-	if retryInterval <= 0 {
-		retryInterval = 60 * time.Second
+	defer release()
+
+	// 获取租约后重新读取状态，可能已由另一流程完成
+	if latest, err := s.dailyRunModel.Get(ctx, run.ID); err == nil {
+		if latest.Status == dailyrun.StatusCompleted {
+			logger.Infof("[Scheduler] DailyRun 已由其他流程完成，跳过 (runID=%d)", run.ID)
+			return nil, nil
+		}
+		run = latest
 	}
 
+	// 运行期间记录的事件均关联到该 DailyRun
+	ctx = withRunID(ctx, run.ID)
+	s.recordEvent(ctx, 0, 0, runlog.EventRunStarted, fmt.Sprintf("窗口 %s，区间 %s", run.Window, formatRange(run.StartTime, run.EndTime)))
+
+	stats := newRunStats()
+	execErr := s.executeDailySummaryForRange(llm.WithUsage(ctx, stats.usage), run.StartTime, run.EndTime, stats)
+
+	result := stats.toModel()
+	if err := s.dailyRunModel.SaveStats(ctx, run.ID, result); err != nil {
+		logger.Warnf("[Scheduler] 保存 DailyRun 运行统计失败 (runID=%d): %v", run.ID, err)
+	}
+	logger.Infof("[Scheduler] DailyRun 运行统计: 耗时 %s，成功 %d 个群组，失败 %d 个，消息 %d 条，tokens %d，费用 %.4f",
+		result.Duration.Round(time.Second), result.ChatsProcessed, result.ChatsFailed, result.MessagesSummarized, result.TotalTokens, result.Cost)
+
+	if execErr != nil {
+		_ = s.dailyRunModel.MarkFailed(ctx, run.ID, execErr.Error())
+		s.recordEvent(ctx, 0, 0, runlog.EventRunFailed, execErr.Error())
+		s.alerter.Alert(ctx, s.formatter.T(display.TextAlertRunFailed),
+			s.formatter.Tf(display.TextAlertRunFailedDetail, run.Window, formatRange(run.StartTime, run.EndTime), execErr))
+	} else {
+		_ = s.dailyRunModel.MarkCompleted(ctx, run.ID)
+		s.recordEvent(ctx, 0, 0, runlog.EventRunCompleted, fmt.Sprintf("成功 %d 个群组，失败 %d 个", result.ChatsProcessed, result.ChatsFailed))
+	}
+
+	if s.config.AdminReport {
+		if err := s.notifier.NotifyAdmins(ctx, formatRunReport(run, result, stats.budgets, execErr, s.formatter)); err != nil {
+			logger.Warnf("[Scheduler] 发送运行报告失败: %v", err)
+		}
+	}
+	return result, execErr
+}
+
+// allowedChats 过滤掉 IncludeChatIds / ExcludeChatIds 不允许总结的群组（配置修改前已保存的消息）
+func (s *Scheduler) allowedChats(chatIDs []int64) []int64 {
+	allowed := chatIDs[:0]
+	for _, chatID := range chatIDs {
+		if s.config.AllowsChat(chatID) {
+			allowed = append(allowed, chatID)
+		}
+	}
+	if skipped := len(chatIDs) - len(allowed); skipped > 0 {
+		logger.Infof("[Scheduler] 跳过 %d 个未允许总结的群组", skipped)
+	}
+	return allowed
+}
+
+// executeDailySummaryForRange 对指定日期区间执行完整总结流程（查询、创建任务、处理、清理）
+func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime, endTime time.Time, stats *runStats) error {
+	retryTimes := s.config.Retry.DB.Times
+	retryInterval := s.config.Retry.DB.Delay()
+
 	// 1. 查询 chatIDs（带重试）
 	var chatIDs []int64
 	var err error
 	for attempt := 1; attempt <= retryTimes; attempt++ {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("任务已取消")
+			return errs.ErrCancelled
 		default:
 		}
 		chatIDs, err = s.messageModel.GetChatIDsByDateRange(ctx, startTime, endTime)
@@ -279,7 +434,7 @@ func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime,
 		if attempt < retryTimes {
 			select {
 			case <-ctx.Done():
-				return fmt.Errorf("任务已取消")
+				return errs.ErrCancelled
 			case <-time.After(retryInterval):
 			}
 		}
@@ -290,17 +445,19 @@ func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime,
 
 	select {
 	case <-ctx.Done():
-		return fmt.Errorf("任务已取消")
+		return errs.ErrCancelled
 	default:
 	}
 
+	chatIDs = s.allowedChats(chatIDs)
 	if len(chatIDs) == 0 {
 		logger.Infof("[Scheduler] 区间内无消息，跳过总结")
-		s.cleanupMessages(ctx)
+		stats.setCleaned(s.cleanupMessages(ctx))
 		return nil
 	}
 
 	logger.Infof("[Scheduler] 找到 %d 个群组需要处理", len(chatIDs))
+	chatIDs = s.orderChats(ctx, chatIDs, startTime, endTime)
 
 	// 2. 批量创建任务
 	successCount := 0
@@ -309,10 +466,10 @@ func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime,
 	for _, chatID := range chatIDs {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("任务已取消")
+			return errs.ErrCancelled
 		default:
 		}
-		taskRecord, err := s.taskModel.GetOrCreateTask(ctx, chatID, startTime, endTime, task.StatusPending)
+		taskRecord, err := s.taskModel.GetOrCreateTask(ctx, chatID, startTime, endTime, task.KindScheduled, task.StatusPending)
 		if err != nil {
 			logger.Errorf("[Scheduler] 创建任务失败 (chatID=%d): %v", chatID, err)
 			failCount++
@@ -329,16 +486,30 @@ func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime,
 	for _, taskRecord := range tasksToProcess {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("任务已取消")
+			return errs.ErrCancelled
 		default:
 		}
 		if err := s.taskModel.UpdateTaskStatus(ctx, taskRecord.ID, task.StatusProcessing, nil); err != nil {
 			failCount++
 			continue
 		}
-		if err := s.processTask(ctx, taskRecord.ChatID, taskRecord.StartTime, taskRecord.EndTime, taskRecord.ID); err != nil {
+		if err := s.processTask(ctx, taskRecord.ChatID, taskRecord.StartTime, taskRecord.EndTime, taskRecord.ID, stats); err != nil {
+			switch errs.Classify(err) {
+			case errs.ActionFailFast:
+				// 保持处理中状态，重启后由恢复流程继续
+				return errs.ErrCancelled
+			case errs.ActionSkip:
+				// 无权在群组发言不是群组本身的问题，不计入连续失败
+				logger.Warnf("[Scheduler] 群组 %d: 无法发送通知，任务标记为已阻止: %v", taskRecord.ChatID, err)
+				_ = s.taskModel.MarkTaskBlocked(ctx, taskRecord.ID, err.Error())
+				s.recordEvent(ctx, taskRecord.ID, taskRecord.ChatID, runlog.EventTaskBlocked, err.Error())
+				failCount++
+				continue
+			}
 			_ = s.taskModel.MarkTaskFailed(ctx, taskRecord.ID, err.Error())
+			s.recordEvent(ctx, taskRecord.ID, taskRecord.ChatID, runlog.EventTaskFailed, err.Error())
 			failCount++
+			s.checkChatFailureStreak(ctx, taskRecord.ChatID, err)
 			continue
 		}
 		if err := s.taskModel.MarkTaskCompleted(ctx, taskRecord.ID); err == nil {
@@ -347,87 +518,183 @@ func (s *Scheduler) executeDailySummaryForRange(ctx context.Context, startTime,
 	}
 
 	logger.Infof("[Scheduler] 群组处理完成: 成功 %d 个，失败 %d 个", successCount, failCount)
+	stats.setChats(successCount, failCount)
 
 	select {
 	case <-ctx.Done():
-		return fmt.Errorf("任务已取消")
+		return errs.ErrCancelled
 	default:
 	}
-	s.cleanupMessages(ctx)
+	stats.setCleaned(s.cleanupMessages(ctx))
 	return nil
 }
 
-// generateSummaryForTask 阶段一：生成总结。内含摘要重试循环；无消息或空内容时返回 summary=="" 且 err==nil 表示跳过通知。
-func (s *Scheduler) generateSummaryForTask(ctx context.Context, chatID int64, startTime, endTime time.Time) (summary string, err error) {
-	startDate := startTime.Format("2006-01-02")
-	endDate := endTime.AddDate(0, 0, -1).Format("2006-01-02")
+// checkChatFailureStreak 群组任务失败后检查连续失败的天数，恰好达到阈值时告警（避免每天重复告警）。
+// 一天有多个总结窗口时按被总结的日期计数，同一天的其他窗口失败不重复告警
+func (s *Scheduler) checkChatFailureStreak(ctx context.Context, chatID int64, lastErr error) {
+	if !s.alerter.Enabled() {
+		return
+	}
+	threshold := s.alerter.ChatFailureThreshold()
+	tasks, err := s.taskModel.GetRecentTasksByChat(ctx, chatID, (threshold+1)*max(len(s.config.Windows), 1))
+	if err != nil {
+		logger.Warnf("[Scheduler] 查询群组 %d 最近任务失败: %v", chatID, err)
+		return
+	}
 
-	retryTimes := s.config.RetryTimes
-	if retryTimes <= 0 {
-		retryTimes = 3
+	streak, newDay := failureStreak(tasks)
+	if streak == threshold && newDay {
+		s.alerter.Alert(ctx, s.formatter.T(display.TextAlertChatFailing), s.formatter.Tf(display.TextAlertChatFailingDetail, s.chatLabel(ctx, chatID), streak, lastErr))
 	}
-	retryInterval := time.Duration(s.config.RetryInterval) * time.Second
-	if retryInterval <= 0 {
-		retryInterval = 60 * time.Second
+}
+
+// failureStreak 统计最近的任务（按开始时间倒序）连续失败的天数，按被总结的日期（区间的最后一天）去重；
+// newDay 表示最近的任务是所在日期的第一个失败任务，即连续失败的天数因该任务增加
+func failureStreak(tasks []*ent.Task) (days int, newDay bool) {
+	dates := make(map[string]int)
+	for _, t := range tasks {
+		if t.Status != task.StatusFailed {
+			break
+		}
+		dates[t.EndTime.Add(-time.Nanosecond).In(locUTC).Format(time.DateOnly)]++
 	}
+	if len(tasks) == 0 || tasks[0].Status != task.StatusFailed {
+		return len(dates), false
+	}
+	return len(dates), dates[tasks[0].EndTime.Add(-time.Nanosecond).In(locUTC).Format(time.DateOnly)] == 1
+}
 
-	var result *summarizer.SummaryResult
+// chatLabel 群组的展示名称：已记录名称时为「名称[ID]」，否则为群组ID
+func (s *Scheduler) chatLabel(ctx context.Context, chatID int64) string {
+	title, err := s.chatModel.GetTitle(ctx, chatID)
+	if err != nil {
+		logger.Warnf("[Scheduler] 群组 %d: 获取群聊名称失败: %v", chatID, err)
+	}
+	if title == "" {
+		return strconv.FormatInt(chatID, 10)
+	}
+	return fmt.Sprintf("%s[%d]", title, chatID)
+}
+
+// generateSummaryForTask 阶段一：生成总结，同时返回结构化结果。内含摘要重试循环；无消息或空内容时返回 summary=="" 且 err==nil 表示跳过通知。
+func (s *Scheduler) generateSummaryForTask(ctx context.Context, chatID int64, startTime, endTime time.Time, stats *runStats) (summary string, result *summarizer.SummaryResult, err error) {
+	retryTimes := s.config.Retry.LLM.Times
+	retryInterval := s.config.Retry.LLM.Delay()
+
+	engine := s.engineFor(chatID, s.chatTypeFor(ctx, chatID))
 	for attempt := 1; attempt <= retryTimes; attempt++ {
 		select {
 		case <-ctx.Done():
-			return "", fmt.Errorf("任务已取消")
+			return "", nil, errs.ErrCancelled
 		default:
 		}
 
 		logger.Debugf("[Scheduler] 群组 %d: 尝试生成摘要 (第 %d/%d 次)", chatID, attempt, retryTimes)
-		result, err = s.summarizer.SummarizeRange(ctx, chatID, startTime, endTime)
+		result, err = s.summarizer.SummarizeRangeWith(ctx, engine, chatID, startTime, endTime)
 		if err == nil {
 			logger.Infof("[Scheduler] 群组 %d: 摘要生成成功", chatID)
 			break
 		}
 
 		logger.Warnf("[Scheduler] 群组 %d: 摘要生成失败 (第 %d/%d 次): %v", chatID, attempt, retryTimes, err)
+		if errs.Classify(err) == errs.ActionFailFast {
+			return "", nil, err
+		}
 		if attempt < retryTimes {
-			logger.Debugf("[Scheduler] 群组 %d: %v 后进行重试...", chatID, retryInterval)
+			delay := retryDelay(err, retryInterval)
+			logger.Debugf("[Scheduler] 群组 %d: %v 后进行重试...", chatID, delay)
 			select {
 			case <-ctx.Done():
-				return "", fmt.Errorf("任务已取消")
-			case <-time.After(retryInterval):
+				return "", nil, errs.ErrCancelled
+			case <-time.After(delay):
 			}
 		}
 	}
 
 	if err != nil {
-		return "", fmt.Errorf("摘要生成失败，已重试 %d 次: %w", retryTimes, err)
+		// 所选引擎重试耗尽，依次尝试降级引擎
+		var fallbackErr error
+		result, fallbackErr = s.summarizeWithFallback(ctx, engine, chatID, startTime, endTime)
+		if fallbackErr != nil {
+			return "", nil, fmt.Errorf("摘要生成失败，已重试 %d 次: %w", retryTimes, err)
+		}
 	}
 
 	if result == nil {
 		logger.Infof("[Scheduler] 群组 %d: 区间内无消息，跳过通知", chatID)
-		return "", nil
+		return "", nil, nil
 	}
+	stats.addMessages(result.MessageCount)
+	stats.addLanguages(result.Languages)
+	stats.addBudget(chatID, result.PromptBudget)
+	logPromptBudget(chatID, result.PromptBudget)
 
-	summary = summarizer.FormatSummaryForDisplay(result, chatID, startDate, endDate)
+	title, titleErr := s.chatModel.GetTitle(ctx, chatID)
+	if titleErr != nil {
+		logger.Warnf("[Scheduler] 群组 %d: 获取群聊名称失败: %v", chatID, titleErr)
+	}
+	result.ChatTitle = title
+	if !isAdhoc(ctx) {
+		result.Anomalies = s.checkActivity(ctx, chatID, startTime, endTime, result)
+	}
+	// 单个子项拆分后仍会超过单条消息长度时先压缩，避免通知被截断
+	s.summarizer.CompressOversized(ctx, result, chatID, notify.MaxItemLength)
+
+	summary = summarizer.FormatSummaryForDisplay(result, chatID, startTime, endTime, s.formatter.ForChat(chatID))
 	if summary == "" {
+		// 仍返回结构化结果，以便记录活跃度（如消息均过短被过滤）
 		logger.Infof("[Scheduler] 群组 %d: 总结内容为空，跳过通知", chatID)
-		return "", nil
+		return "", result, nil
 	}
 
-	return summary, nil
+	return summary, result, nil
 }
 
-// sendTaskNotification 阶段二：发送通知。仅重试 Notify，不会重新生成总结；通知失败不影响任务完成状态。
-// 返回 (sent, err)：sent 表示是否发送成功，err 表示是否应中止（如 ctx 取消）。
-func (s *Scheduler) sendTaskNotification(ctx context.Context, summary string, chatID int64) (sent bool, err error) {
-	retryInterval := time.Duration(s.config.RetryInterval) * time.Second
-	if retryInterval <= 0 {
-		retryInterval = 60 * time.Second
+// logPromptBudget 记录群组本次总结的 prompt token 构成，便于调整过滤规则
+func logPromptBudget(chatID int64, b summarizer.PromptBudget) {
+	logger.Infof("[Scheduler] 群组 %d: prompt tokens 构成: 原始消息 %d 条 (~%d)，过滤后 %d 条 (~%d)，请求 %d 次（消息 ~%d，额外 ~%d），实际输入 %d",
+		chatID, b.RawMessages, b.RawTokens, b.FilteredMessages, b.FilteredTokens, b.Chunks, b.MessageTokens, b.OverheadTokens, b.PromptTokens)
+}
+
+// savePromptBudget 保存任务最近一次总结的 prompt token 构成
+func (s *Scheduler) savePromptBudget(ctx context.Context, taskID int, b summarizer.PromptBudget) {
+	data, err := json.Marshal(b)
+	if err == nil {
+		err = s.taskModel.SetPromptBudget(ctx, taskID, string(data))
+	}
+	if err != nil {
+		logger.Warnf("[Scheduler] 保存 prompt tokens 构成失败 (taskID=%d): %v", taskID, err)
 	}
+}
 
-	notifyRetryTimes := 2
+// progressReporter 返回记录群组总结进度的回调：分块总结时写入日志，taskID > 0 时保存到任务，
+// 使长时间运行的总结可以观察到仍在推进
+func (s *Scheduler) progressReporter(ctx context.Context, chatID int64, taskID int) llm.ProgressFunc {
+	return func(p llm.Progress) {
+		if p.Chunks > 1 {
+			logger.Infof("[Scheduler] 群组 %d: 总结进度 %d/%d 个 chunk，约 %d/%d tokens", chatID, p.Chunk, p.Chunks, p.Tokens, p.TotalTokens)
+			if p.Chunk > 0 {
+				s.recordEvent(ctx, taskID, chatID, runlog.EventChunkCompleted, fmt.Sprintf("%d/%d 个 chunk，约 %d/%d tokens", p.Chunk, p.Chunks, p.Tokens, p.TotalTokens))
+			}
+		}
+		if taskID <= 0 {
+			return
+		}
+		if err := s.taskModel.SetProgress(ctx, taskID, p.Chunk, p.Chunks, p.Tokens); err != nil {
+			logger.Warnf("[Scheduler] 保存总结进度失败 (taskID=%d): %v", taskID, err)
+		}
+	}
+}
+
+// sendTaskNotification 阶段二：发送通知。仅重试 Notify，不会重新生成总结；通知失败不影响任务完成状态。
+// 返回 (sent, err)：sent 表示是否发送成功，err 表示是否应中止（如 ctx 取消、无权在群组发言）。
+func (s *Scheduler) sendTaskNotification(ctx context.Context, summary string, chatID int64) (sent bool, err error) {
+	notifyRetryTimes := s.config.Retry.Telegram.Times
+	retryInterval := s.config.Retry.Telegram.Delay()
 	for attempt := 1; attempt <= notifyRetryTimes; attempt++ {
 		select {
 		case <-ctx.Done():
-			return false, fmt.Errorf("任务已取消")
+			return false, errs.ErrCancelled
 		default:
 		}
 
@@ -437,11 +704,14 @@ func (s *Scheduler) sendTaskNotification(ctx context.Context, summary string, ch
 			return true, nil
 		}
 		logger.Warnf("[Scheduler] 群组 %d: 通知发送失败 (第 %d/%d 次): %v", chatID, attempt, notifyRetryTimes, notifyErr)
+		if action := errs.Classify(notifyErr); action == errs.ActionFailFast || action == errs.ActionSkip {
+			return false, notifyErr
+		}
 		if attempt < notifyRetryTimes {
 			select {
 			case <-ctx.Done():
-				return false, fmt.Errorf("任务已取消")
-			case <-time.After(retryInterval / 2):
+				return false, errs.ErrCancelled
+			case <-time.After(retryDelay(notifyErr, retryInterval)):
 			}
 		}
 	}
@@ -451,49 +721,237 @@ func (s *Scheduler) sendTaskNotification(ctx context.Context, summary string, ch
 	return false, nil
 }
 
-// processTask 处理单个任务：先生成总结，再发送通知；通知重试仅重试发送，不重试总结。
+// processTask 在 TaskTimeout 内处理单个任务，避免个别群组（如消息量异常、LLM 长时间无响应）拖住整个运行；
+// 超时返回 errTaskTimeout，与运行取消区分，调用方将该任务标记为失败后继续处理其他群组
+func (s *Scheduler) processTask(ctx context.Context, chatID int64, startTime, endTime time.Time, taskID int, stats *runStats) error {
+	timeout := time.Duration(s.config.TaskTimeout) * time.Second
+	taskCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := s.runTask(taskCtx, chatID, startTime, endTime, taskID, stats)
+	if err != nil && ctx.Err() == nil && errors.Is(taskCtx.Err(), context.DeadlineExceeded) {
+		logger.Errorf("[Scheduler] 群组 %d: 任务处理超过 %s，放弃", chatID, timeout)
+		return fmt.Errorf("%w（%s）: %v", errTaskTimeout, timeout, err)
+	}
+	return err
+}
+
+// runTask 处理单个任务：先生成总结，再发送通知；通知重试仅重试发送，不重试总结。
 // taskID > 0 时在发送前将摘要持久化到任务，程序在发送期间退出后恢复时只会重试发送；发送成功后清除。
-func (s *Scheduler) processTask(ctx context.Context, chatID int64, startTime, endTime time.Time, taskID int) error {
-	dateRange := fmt.Sprintf("%s ~ %s", startTime.Format("2006-01-02"), endTime.AddDate(0, 0, -1).Format("2006-01-02"))
-	logger.Infof("[Scheduler] 处理群组 %d，区间: %s", chatID, dateRange)
+func (s *Scheduler) runTask(ctx context.Context, chatID int64, startTime, endTime time.Time, taskID int, stats *runStats) error {
+	logger.Infof("[Scheduler] 处理群组 %d，区间: %s", chatID, formatRange(startTime, endTime))
+	s.recordEvent(ctx, taskID, chatID, runlog.EventTaskStarted, formatRange(startTime, endTime))
 
 	// 阶段一：生成总结
-	summary, err := s.generateSummaryForTask(ctx, chatID, startTime, endTime)
+	progressCtx := llm.WithProgress(ctx, s.progressReporter(ctx, chatID, taskID))
+	summary, result, err := s.generateSummaryForTask(progressCtx, chatID, startTime, endTime, stats)
 	if err != nil {
 		return err
 	}
 	if summary == "" {
+		if taskID > 0 && result != nil {
+			if err := s.taskModel.SetActivity(ctx, taskID, result.MessageCount, result.ParticipantCount); err != nil {
+				logger.Warnf("[Scheduler] 保存活跃度失败 (taskID=%d): %v", taskID, err)
+			}
+			s.savePromptBudget(ctx, taskID, result.PromptBudget)
+		}
 		return nil
 	}
 
 	// 发送前持久化摘要：之后无论首次发送还是重试时崩溃，重启后都只重试发送，不会重新生成摘要
+	// 涉及多个论坛话题时各话题的总结分别发送到所属的论坛话题
+	sendCtx := notify.WithThreadParts(notify.WithThread(ctx, result.ThreadID), s.threadParts(result, chatID, startTime, endTime))
 	if taskID > 0 {
+		// 新生成的摘要需完整发送，清除该任务之前的分段发送记录
+		if err := s.notifier.ClearSent(ctx, notify.TaskIdempotencyKey(taskID)); err != nil {
+			logger.Warnf("[Scheduler] 清除发送记录失败 (taskID=%d): %v", taskID, err)
+		}
 		if err := s.taskModel.SetSummaryContent(ctx, taskID, summary); err != nil {
 			logger.Warnf("[Scheduler] 保存摘要内容失败 (taskID=%d): %v，继续发送", taskID, err)
 		}
+		// 每次（重新）生成都保留一个版本，便于对比历史草稿与最终发送的内容
+		if err := s.revisionModel.Record(ctx, taskID, chatID, summary, summaryrevision.SourceGenerated); err != nil {
+			logger.Warnf("[Scheduler] 保存摘要版本失败 (taskID=%d): %v", taskID, err)
+		}
+		if err := s.taskModel.SetActivity(ctx, taskID, result.MessageCount, result.ParticipantCount); err != nil {
+			logger.Warnf("[Scheduler] 保存活跃度失败 (taskID=%d): %v", taskID, err)
+		}
+		s.savePromptBudget(ctx, taskID, result.PromptBudget)
+		// 结构化结果长期保留，供机器人模式的话题展开按钮使用
+		if data, err := json.Marshal(result); err != nil {
+			logger.Warnf("[Scheduler] 序列化总结结果失败 (taskID=%d): %v", taskID, err)
+		} else if err := s.taskModel.SetSummaryJSON(ctx, taskID, string(data)); err != nil {
+			logger.Warnf("[Scheduler] 保存总结结果失败 (taskID=%d): %v", taskID, err)
+		}
+		sendCtx = notify.WithTask(sendCtx, taskID)
 	}
 
-	// 阶段二：发送通知（仅重试发送，不重新生成总结）
-	sent, err := s.sendTaskNotification(ctx, summary, chatID)
+	// 阶段二：发送通知（仅重试发送，不重新生成总结；已发送的分段按幂等键跳过）
+	sent, err := s.sendTaskNotification(sendCtx, summary, chatID)
 	if err != nil {
 		return err
 	}
+	if sent {
+		s.recordEvent(ctx, taskID, chatID, runlog.EventNotifySent, "")
+	}
 	if sent && taskID > 0 {
-		_ = s.taskModel.ClearSummaryContent(ctx, taskID)
+		s.clearTaskSendState(ctx, taskID)
 	}
 	return nil
 }
 
-// cleanupMessages 执行消息清理
-func (s *Scheduler) cleanupMessages(ctx context.Context) {
+// EditSummary 以人工修改的内容替换任务未发送成功的摘要并立即发送，返回是否发送成功。
+// 仅允许修改尚未发送完成的摘要；修改后清除分段发送记录，使新内容完整发送。
+// 处理中的任务可能正在发送，为避免重复发送不允许修改。
+func (s *Scheduler) EditSummary(ctx context.Context, taskID int, content string) (bool, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return false, ErrEmptySummary
+	}
+
+	t, err := s.taskModel.GetTask(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, fmt.Errorf("任务 %d: %w", taskID, ErrTaskNotFound)
+		}
+		return false, err
+	}
+	if t.Status == task.StatusPending || t.Status == task.StatusProcessing {
+		return false, fmt.Errorf("任务 %d: %w", taskID, ErrTaskProcessing)
+	}
+	ok, err := s.taskModel.ReplacePendingSummaryContent(ctx, taskID, content)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, fmt.Errorf("任务 %d: %w", taskID, ErrNoPendingSummary)
+	}
+
+	if err := s.notifier.ClearSent(ctx, notify.TaskIdempotencyKey(taskID)); err != nil {
+		logger.Warnf("[Scheduler] 清除发送记录失败 (taskID=%d): %v", taskID, err)
+	}
+	if err := s.revisionModel.Record(ctx, taskID, t.ChatID, content, summaryrevision.SourceEdited); err != nil {
+		logger.Warnf("[Scheduler] 保存摘要版本失败 (taskID=%d): %v", taskID, err)
+	}
+	logger.Infof("[Scheduler] 任务摘要已人工修改: chatID=%d, taskID=%d", t.ChatID, taskID)
+
+	// 人工修改的内容无法按论坛话题拆分，原总结涉及多个论坛话题时整体发送到 General
+	sent, err := s.sendTaskNotification(notify.WithThread(notify.WithTask(ctx, taskID), summaryThread(t)), content, t.ChatID)
+	if err != nil {
+		return false, err
+	}
+	if sent {
+		s.recordEvent(ctx, taskID, t.ChatID, runlog.EventNotifySent, "人工修改后发送")
+		s.clearTaskSendState(ctx, taskID)
+		// 发送失败的任务在人工修改后发送成功，标记完成，避免重试时重新生成并再次发送
+		if err := s.taskModel.MarkTaskCompleted(ctx, taskID); err != nil {
+			logger.Warnf("[Scheduler] 标记任务完成失败 (taskID=%d): %v", taskID, err)
+		}
+	}
+	return sent, nil
+}
+
+// clearTaskSendState 通知全部发送成功后清除待发送摘要及分段发送记录，并将最新版本标记为已发送
+func (s *Scheduler) clearTaskSendState(ctx context.Context, taskID int) {
+	if err := s.revisionModel.MarkLatestSent(ctx, taskID, time.Now()); err != nil {
+		logger.Warnf("[Scheduler] 标记摘要版本已发送失败 (taskID=%d): %v", taskID, err)
+	}
+	_ = s.taskModel.ClearSummaryContent(ctx, taskID)
+	if err := s.notifier.ClearSent(ctx, notify.TaskIdempotencyKey(taskID)); err != nil {
+		logger.Warnf("[Scheduler] 清除发送记录失败 (taskID=%d): %v", taskID, err)
+	}
+}
+
+// threadParts 总结涉及多个论坛话题时按话题拆分并格式化，各部分分别发送到所属的论坛话题；否则返回 nil
+func (s *Scheduler) threadParts(result *summarizer.SummaryResult, chatID int64, startTime, endTime time.Time) []notify.ThreadPart {
+	var parts []notify.ThreadPart
+	for _, part := range summarizer.SplitByThread(result) {
+		content := summarizer.FormatSummaryForDisplay(part, chatID, startTime, endTime, s.formatter.ForChat(chatID))
+		if content != "" {
+			parts = append(parts, notify.ThreadPart{ThreadID: part.ThreadID, Content: content})
+		}
+	}
+	return parts
+}
+
+// taskSendContext 返回重新发送任务已保存摘要使用的 ctx：按保存的总结结果发送到所属的论坛话题，
+// 涉及多个论坛话题时按保存的结果重新拆分后分别发送
+func (s *Scheduler) taskSendContext(ctx context.Context, t *ent.Task) context.Context {
+	result := savedSummary(t)
+	if result == nil {
+		return ctx
+	}
+	ctx = notify.WithThread(ctx, result.ThreadID)
+	if summarizer.SplitByThread(result) == nil {
+		return ctx
+	}
+	if title, err := s.chatModel.GetTitle(ctx, t.ChatID); err == nil {
+		result.ChatTitle = title
+	}
+	return notify.WithThreadParts(ctx, s.threadParts(result, t.ChatID, t.StartTime, t.EndTime))
+}
+
+// summaryThread 返回任务保存的总结结果所属的论坛话题，未保存或解析失败时返回 0（发送到 General）
+func summaryThread(t *ent.Task) int64 {
+	if result := savedSummary(t); result != nil {
+		return result.ThreadID
+	}
+	return 0
+}
+
+// savedSummary 解析任务保存的结构化总结结果，未保存或解析失败时返回 nil
+func savedSummary(t *ent.Task) *summarizer.SummaryResult {
+	if t.SummaryJSON == "" {
+		return nil
+	}
+	var result summarizer.SummaryResult
+	if err := json.Unmarshal([]byte(t.SummaryJSON), &result); err != nil {
+		return nil
+	}
+	return &result
+}
+
+// cleanupMessages 执行消息清理（分批软删除，由 purgeMessages 分批物理删除），返回清理的消息数
+func (s *Scheduler) cleanupMessages(ctx context.Context) int {
 	cutoffDate := time.Now().In(locUTC).AddDate(0, 0, -s.config.RetentionDays-1)
 	cutoffDate = time.Date(cutoffDate.Year(), cutoffDate.Month(), cutoffDate.Day(), 0, 0, 0, 0, locUTC)
 
 	logger.Infof("[Scheduler] 开始清理 %s 之前的消息", cutoffDate.Format("2006-01-02"))
-	deleted, err := s.messageModel.DeleteBefore(ctx, cutoffDate)
+	deleted, err := s.runBatches(ctx, "软删除过期消息", func(ctx context.Context, limit int) (int, error) {
+		return s.messageModel.SoftDeleteBefore(ctx, cutoffDate, limit)
+	})
 	if err != nil {
-		logger.Errorf("[Scheduler] 清理消息失败: %v", err)
-	} else {
-		logger.Infof("[Scheduler] 已清理 %d 条消息", deleted)
+		logger.Errorf("[Scheduler] 清理消息失败（已软删除 %d 条）: %v", deleted, err)
+		return deleted
 	}
+	logger.Infof("[Scheduler] 已软删除 %d 条消息，等待清除任务物理删除", deleted)
+	if s.linkModel != nil {
+		links, err := s.linkModel.DeleteBefore(ctx, cutoffDate)
+		if err != nil {
+			logger.Errorf("[Scheduler] 清理过期链接失败: %v", err)
+		} else if links > 0 {
+			logger.Infof("[Scheduler] 已删除 %d 条过期链接", links)
+		}
+	}
+	if s.runLogModel != nil {
+		events, err := s.runLogModel.DeleteBefore(ctx, cutoffDate)
+		if err != nil {
+			logger.Errorf("[Scheduler] 清理过期运行事件失败: %v", err)
+		} else if events > 0 {
+			logger.Infof("[Scheduler] 已删除 %d 条过期运行事件", events)
+		}
+	}
+	return deleted
+}
+
+// purgeMessages 清除任务：分批物理删除已软删除的消息，避免一次删除大量数据时长时间持有 SQLite 写锁
+func (s *Scheduler) purgeMessages(ctx context.Context) error {
+	purged, err := s.runBatches(ctx, "物理删除消息", s.messageModel.PurgeDeleted)
+	if purged > 0 {
+		logger.Infof("[Scheduler] 已物理删除 %d 条软删除的消息", purged)
+	}
+	if err != nil {
+		return fmt.Errorf("清除软删除消息失败: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// selfTestChatID 自检使用的群组ID，不对应真实群组，不生成消息链接
+const selfTestChatID = 0
+
+// selfTestConversation 自检使用的示例对话：发送者ID、昵称、内容
+var selfTestConversation = []struct {
+	senderID   int64
+	senderName string
+	text       string
+}{
+	{1, "Alice", "下周三的版本发布时间定了吗？"},
+	{2, "Bob", "定了，周三晚上 8 点发布，发布前一天冻结代码"},
+	{3, "Carol", "测试环境今天下午会重新部署，大家先别往上推新分支"},
+	{1, "Alice", "好的，那回归测试周二上午开始，我来安排人手"},
+	{2, "Bob", "另外文档站的域名下个月到期，需要有人续费"},
+	{3, "Carol", "我来处理续费，顺便把证书也更新一下"},
+}
+
+// cannedMessages 固定的消息列表（实现 summarizer.MessageProvider）
+type cannedMessages []*ent.Message
+
+func (m cannedMessages) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	var messages []*ent.Message
+	for _, msg := range m {
+		if msg.ChatID == chatID && !msg.SentAt.Before(startTime) && msg.SentAt.Before(endTime) {
+			copied := *msg
+			messages = append(messages, &copied)
+		}
+	}
+	return messages, nil
+}
+
+// selfTestMessages 生成从 start 开始每分钟一条的示例对话
+func selfTestMessages(start time.Time) cannedMessages {
+	messages := make(cannedMessages, 0, len(selfTestConversation))
+	for i, m := range selfTestConversation {
+		messages = append(messages, &ent.Message{
+			MessageID:  int64(i + 1),
+			ChatID:     selfTestChatID,
+			SenderID:   m.senderID,
+			SenderName: m.senderName,
+			Text:       m.text,
+			Lang:       "zh",
+			SentAt:     start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	return messages
+}
+
+// SelfTest 以内置的示例对话走一遍完整流程：总结引擎（默认为 LLM）、格式化及通知演练（拆分并解析 HTML，不实际发送），
+// 返回总结的话题数和通知分段数。用于启动时尽早发现 API 密钥失效等问题，而不是等到夜间运行才失败；需在 Start 之后调用
+func (s *Scheduler) SelfTest(ctx context.Context) (topics, parts int, err error) {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+	startTime := endTime.Add(-time.Hour)
+
+	sum := s.summarizer.WithMessages(selfTestMessages(startTime))
+	result, err := sum.SummarizeRangeWith(ctx, s.engineFor(selfTestChatID, ""), selfTestChatID, startTime, endTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("生成总结失败: %w", err)
+	}
+	if result == nil || len(result.Topics) == 0 {
+		return 0, 0, fmt.Errorf("总结未包含任何话题")
+	}
+
+	content := summarizer.FormatSummaryForDisplay(result, selfTestChatID, startTime, endTime, s.formatter)
+	parts, err = s.notifier.DryRun(content)
+	if err != nil {
+		return 0, 0, fmt.Errorf("通知演练失败: %w", err)
+	}
+	return len(result.Topics), parts, nil
+}
+
+// runSelfTest 启动时执行自检并将结果私聊发送给管理员
+func (s *Scheduler) runSelfTest() {
+	s.mu.Lock()
+	ctx := s.ctx
+	s.mu.Unlock()
+
+	logger.Infof("[Scheduler] 开始启动自检")
+	started := time.Now()
+	topics, parts, err := s.SelfTest(ctx)
+	elapsed := time.Since(started).Round(time.Millisecond)
+
+	var report string
+	if err != nil {
+		logger.Errorf("[Scheduler] 启动自检失败: %v", err)
+		report = s.formatter.Tf(display.TextSelfTestFailed, s.engineFor(selfTestChatID, ""), elapsed, err)
+	} else {
+		logger.Infof("[Scheduler] 启动自检通过: %d 个话题，%d 段消息，耗时 %s", topics, parts, elapsed)
+		report = s.formatter.Tf(display.TextSelfTestPassed, s.engineFor(selfTestChatID, ""), elapsed, topics, parts)
+	}
+	if err := s.notifier.NotifyAdmins(ctx, report); err != nil {
+		logger.Warnf("[Scheduler] 发送自检结果失败: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// runStats 单次 DailyRun 执行过程中累计的统计；nil 表示不统计（如单独恢复的 Task）
+type runStats struct {
+	startedAt          time.Time
+	chatsProcessed     int
+	chatsFailed        int
+	messagesSummarized int
+	messagesCleaned    int
+	languages          map[string]int
+	budgets            map[int64]summarizer.PromptBudget
+	usage              *llm.Usage
+}
+
+func newRunStats() *runStats {
+	return &runStats{
+		startedAt: time.Now(),
+		languages: make(map[string]int),
+		budgets:   make(map[int64]summarizer.PromptBudget),
+		usage:     &llm.Usage{},
+	}
+}
+
+// addMessages 累计参与总结的消息数
+func (st *runStats) addMessages(n int) {
+	if st == nil {
+		return
+	}
+	st.messagesSummarized += n
+}
+
+// addLanguages 累计参与总结的消息语言分布
+func (st *runStats) addLanguages(languages map[string]int) {
+	if st == nil {
+		return
+	}
+	for code, n := range languages {
+		st.languages[code] += n
+	}
+}
+
+// addBudget 记录群组的 prompt token 构成
+func (st *runStats) addBudget(chatID int64, budget summarizer.PromptBudget) {
+	if st == nil {
+		return
+	}
+	st.budgets[chatID] = budget
+}
+
+// setChats 记录群组处理结果
+func (st *runStats) setChats(processed, failed int) {
+	if st == nil {
+		return
+	}
+	st.chatsProcessed = processed
+	st.chatsFailed = failed
+}
+
+// setCleaned 记录清理的过期消息数
+func (st *runStats) setCleaned(n int) {
+	if st == nil {
+		return
+	}
+	st.messagesCleaned = n
+}
+
+// toModel 转换为持久化用的运行统计
+func (st *runStats) toModel() *model.RunStats {
+	usage := st.usage.Snapshot()
+	return &model.RunStats{
+		Duration:           time.Since(st.startedAt),
+		ChatsProcessed:     st.chatsProcessed,
+		ChatsFailed:        st.chatsFailed,
+		MessagesSummarized: st.messagesSummarized,
+		PromptTokens:       usage.PromptTokens,
+		CompletionTokens:   usage.CompletionTokens,
+		TotalTokens:        usage.TotalTokens,
+		Cost:               usage.Cost,
+		MessagesCleaned:    st.messagesCleaned,
+		Languages:          st.languages,
+	}
+}
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"time"
+)
+
+// defaultWindowName 未配置 Summary.Windows 时的默认窗口名称
+const defaultWindowName = "daily"
+
+// summaryWindow 总结窗口：cron 触发后总结 [触发当日 0 点 + startOffset, 触发当日 0 点 + endOffset)
+type summaryWindow struct {
+	name        string
+	spec        string
+	startOffset time.Duration
+	endOffset   time.Duration
+}
+
+// summaryWindows 返回配置的总结窗口；未配置 Windows 时由 Cron + RangeDays 生成单个每日窗口
+func (s *Scheduler) summaryWindows() []summaryWindow {
+	if len(s.config.Windows) == 0 {
+		return []summaryWindow{{
+			name:        defaultWindowName,
+			spec:        s.config.Cron,
+			startOffset: -time.Duration(s.config.RangeDays) * 24 * time.Hour,
+			endOffset:   0,
+		}}
+	}
+
+	windows := make([]summaryWindow, 0, len(s.config.Windows))
+	for i := range s.config.Windows {
+		w := &s.config.Windows[i]
+		start, end, _ := w.Offsets() // 已在配置加载时校验
+		windows = append(windows, summaryWindow{
+			name:        w.Name,
+			spec:        w.Cron,
+			startOffset: start,
+			endOffset:   end,
+		})
+	}
+	return windows
+}
+
+// jobName 定时任务名称，默认窗口沿用 daily_summary
+func (w summaryWindow) jobName() string {
+	if w.name == defaultWindowName {
+		return "daily_summary"
+	}
+	return "summary_" + w.name
+}
+
+// rangeAt 计算窗口在 now 所在日（UTC）的区间。
+// cron 表达式精确到分钟，now 先取整到最近的分钟：时钟偏差导致 0 点任务在 23:59:59 提前触发时仍按当日计算
+func (w summaryWindow) rangeAt(now time.Time) (startTime, endTime time.Time) {
+	now = now.In(locUTC).Round(time.Minute)
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, locUTC)
+	return dayStart.Add(w.startOffset), dayStart.Add(w.endOffset)
+}
+
+// windowRange 计算窗口在 now 所在日的实际区间：被总结的日期（区间的最后一天，而不是触发日）为跳过日时 skip=true；
+// 开启 CoverSkippedDays 时，区间开始向前扩展覆盖之前连续被跳过的天数
+func (s *Scheduler) windowRange(w summaryWindow, now time.Time) (startTime, endTime time.Time, skip bool) {
+	startTime, endTime = w.rangeAt(now)
+	if s.calendar.skipped(endTime.Add(-time.Nanosecond)) {
+		return time.Time{}, time.Time{}, true
+	}
+	if s.config.CoverSkippedDays {
+		startTime = startTime.AddDate(0, 0, -s.calendar.skippedDaysBefore(startTime))
+	}
+	return startTime, endTime, false
+}
+
+// formatRange 返回区间的日志文本，如 "2025-02-10 ~ 2025-02-11"：整天区间显示日期（结束日为包含），否则精确到分钟
+func formatRange(startTime, endTime time.Time) string {
+	if isMidnight(startTime) && isMidnight(endTime) {
+		return startTime.Format("2006-01-02") + " ~ " + endTime.AddDate(0, 0, -1).Format("2006-01-02")
+	}
+	return startTime.Format("2006-01-02 15:04") + " ~ " + endTime.Format("2006-01-02 15:04")
+}
+
+func isMidnight(t time.Time) bool {
+	t = t.In(locUTC)
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryWindows_Default(t *testing.T) {
+	s := &Scheduler{config: &config.Summary{Cron: "0 0 * * *", RangeDays: 2}}
+	windows := s.summaryWindows()
+	if assert.Len(t, windows, 1) {
+		w := windows[0]
+		assert.Equal(t, "daily_summary", w.jobName())
+
+		start, end := w.rangeAt(time.Date(2025, 2, 12, 0, 5, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC), end)
+	}
+}
+
+func TestSummaryWindows_Configured(t *testing.T) {
+	s := &Scheduler{config: &config.Summary{Windows: []config.SummaryWindow{
+		{Name: "morning", Cron: "0 12 * * *", StartOffset: "0h", EndOffset: "12h"},
+		{Name: "afternoon", Cron: "0 18 * * *", StartOffset: "12h", EndOffset: "18h"},
+	}}}
+	windows := s.summaryWindows()
+	if assert.Len(t, windows, 2) {
+		assert.Equal(t, "summary_morning", windows[0].jobName())
+
+		start, end := windows[1].rangeAt(time.Date(2025, 2, 12, 18, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2025, 2, 12, 12, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2025, 2, 12, 18, 0, 0, 0, time.UTC), end)
+	}
+}
+
+func TestFormatRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  string
+	}{
+		{"整天区间", time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC), "2025-02-10 ~ 2025-02-11"},
+		{"半天区间", time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 12, 12, 0, 0, 0, time.UTC), "2025-02-12 00:00 ~ 2025-02-12 12:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatRange(tt.start, tt.end))
+		})
+	}
+}
+
+func TestRangeAt_RangeDays(t *testing.T) {
+	s := &Scheduler{config: &config.Summary{Cron: "0 0 * * *", RangeDays: 7}}
+	w := s.summaryWindows()[0]
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		start time.Time
+	}{
+		{"准时触发", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"延迟触发", time.Date(2025, 3, 10, 0, 0, 42, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"时钟偏差提前触发", time.Date(2025, 3, 9, 23, 59, 58, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"跨夏令时切换日（UTC 不受影响）", time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC), time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := w.rangeAt(tt.now)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, 7*24*time.Hour, end.Sub(start), "RangeDays=7 应恰好覆盖 7 个整天")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Package shutdown 跨平台的退出信号处理与带超时的优雅关闭。
+package shutdown
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// Wait 阻塞直到收到当前平台的退出信号，返回收到的信号
+func Wait() os.Signal {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	defer signal.Stop(ch)
+	return <-ch
+}
+
+// Graceful 执行关闭流程 fn，fn 应在 ctx 结束前返回。
+// 超时或关闭过程中再次收到退出信号时不再等待，返回 false，由调用方强制退出
+func Graceful(timeout time.Duration, fn func(ctx context.Context)) bool {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	defer signal.Stop(ch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		fn(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		logger.Errorf("[Shutdown] 关闭超时 (%v)，强制退出", timeout)
+		return false
+	case sig := <-ch:
+		logger.Warnf("[Shutdown] 关闭过程中再次收到信号 %v，强制退出", sig)
+		return false
+	}
+}
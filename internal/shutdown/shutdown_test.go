@@ -0,0 +1,25 @@
+package shutdown
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraceful(t *testing.T) {
+	t.Run("按时完成", func(t *testing.T) {
+		called := false
+		ok := Graceful(time.Second, func(ctx context.Context) { called = true })
+		assert.True(t, ok)
+		assert.True(t, called)
+	})
+
+	t.Run("超时返回 false", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		ok := Graceful(20*time.Millisecond, func(ctx context.Context) { <-release })
+		assert.False(t, ok)
+	})
+}
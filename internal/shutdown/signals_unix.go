@@ -0,0 +1,11 @@
+//go:build !windows
+
+package shutdown
+
+import (
+	"os"
+	"syscall"
+)
+
+// signals 退出信号：Ctrl+C (SIGINT)、kill/systemd/docker stop (SIGTERM)
+var signals = []os.Signal{syscall.SIGINT, syscall.SIGTERM}
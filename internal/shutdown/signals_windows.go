@@ -0,0 +1,12 @@
+//go:build windows
+
+package shutdown
+
+import (
+	"os"
+	"syscall"
+)
+
+// signals 退出信号：Ctrl+C/Ctrl+Break 对应 os.Interrupt；
+// 关闭控制台窗口、注销、关机（CTRL_CLOSE/LOGOFF/SHUTDOWN_EVENT）由 Go 运行时转换为 SIGTERM
+var signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
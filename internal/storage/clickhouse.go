@@ -0,0 +1,502 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// clickHouseTimeFormat ClickHouse DateTime64(3) 参数和 JSONEachRow 输入使用的时间格式（UTC）
+const clickHouseTimeFormat = "2006-01-02 15:04:05.000"
+
+// clickHouseColumns 查询消息时返回的列，发送时间转为毫秒时间戳，避免依赖服务端的时间输出格式
+const clickHouseColumns = `message_id, server_message_id, chat_id, sender_id, sender_name, sender_username, text, lang,
+	toUnixTimestamp64Milli(sent_at) AS sent_at_ms, toUnixTimestamp64Milli(created_at) AS created_at_ms,
+	toUnixTimestamp64Milli(edited_at) AS edited_at_ms, message_thread_id, reply_to_message_id, reaction_count, sender_type, poll, content_type, account_id`
+
+// clickHouseNotEvent 排除事件（model.EventContentTypes）的查询条件
+var clickHouseNotEvent = "content_type NOT IN ('" + strings.Join(model.EventContentTypes, "', '") + "')"
+
+// ClickHouseMessageStore 通过 ClickHouse HTTP 接口保存消息，适用于每日消息量很大的部署（实现 MessageStore）。
+// 消息按群组和发送时间排序存储；ClickHouse 没有自增ID，返回消息的 ID 字段恒为 0。
+// 软删除直接执行轻量删除（DELETE FROM），数据由 ClickHouse 在后台合并时物理删除，因此 PurgeDeleted 无需处理
+type ClickHouseMessageStore struct {
+	config     *config.ClickHouse
+	table      string
+	httpClient *http.Client
+}
+
+func NewClickHouseMessageStore(cfg *config.ClickHouse) *ClickHouseMessageStore {
+	return &ClickHouseMessageStore{
+		config:     cfg,
+		table:      cfg.Database + "." + cfg.Table,
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+// Init 创建消息表，已存在时补充后续新增的列
+func (s *ClickHouseMessageStore) Init(ctx context.Context) error {
+	ddl := `CREATE TABLE IF NOT EXISTS ` + s.table + ` (
+	message_id Int64,
+	server_message_id Int64 DEFAULT 0,
+	chat_id Int64,
+	sender_id Int64,
+	sender_name String,
+	sender_username String DEFAULT '',
+	text String,
+	lang LowCardinality(String) DEFAULT '',
+	sent_at DateTime64(3, 'UTC'),
+	created_at DateTime64(3, 'UTC') DEFAULT now64(3),
+	edited_at DateTime64(3, 'UTC') DEFAULT 0,
+	message_thread_id Int64 DEFAULT 0,
+	reply_to_message_id Int64 DEFAULT 0,
+	reaction_count Int64 DEFAULT 0,
+	sender_type LowCardinality(String) DEFAULT '',
+	poll String DEFAULT '',
+	content_type LowCardinality(String) DEFAULT '',
+	account_id Int64 DEFAULT 0
+) ENGINE = MergeTree
+PARTITION BY toYYYYMM(sent_at)
+ORDER BY (chat_id, sent_at)`
+	if _, err := s.do(ctx, ddl, nil, nil, false); err != nil {
+		return err
+	}
+	// edited_at 为 0 表示未编辑；message_thread_id 为 0 表示非论坛话题消息；reply_to_message_id 为 0 表示未回复
+	for _, column := range []string{
+		"edited_at DateTime64(3, 'UTC') DEFAULT 0",
+		"message_thread_id Int64 DEFAULT 0",
+		"reply_to_message_id Int64 DEFAULT 0",
+		"reaction_count Int64 DEFAULT 0",
+		"sender_type LowCardinality(String) DEFAULT ''",
+		"poll String DEFAULT ''",
+		"content_type LowCardinality(String) DEFAULT ''",
+		"account_id Int64 DEFAULT 0",
+	} {
+		if _, err := s.do(ctx, "ALTER TABLE "+s.table+" ADD COLUMN IF NOT EXISTS "+column, nil, nil, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clickHouseMessage ClickHouse 中的一行消息（JSONEachRow）
+type clickHouseMessage struct {
+	MessageID        int64  `json:"message_id"`
+	ServerMessageID  int64  `json:"server_message_id"`
+	ChatID           int64  `json:"chat_id"`
+	SenderID         int64  `json:"sender_id"`
+	SenderName       string `json:"sender_name"`
+	SenderUsername   string `json:"sender_username"`
+	Text             string `json:"text"`
+	Lang             string `json:"lang"`
+	SentAt           string `json:"sent_at,omitempty"`
+	SentAtMs         int64  `json:"sent_at_ms,omitempty"`
+	CreatedAtMs      int64  `json:"created_at_ms,omitempty"`
+	EditedAtMs       int64  `json:"edited_at_ms,omitempty"`
+	MessageThreadID  int64  `json:"message_thread_id,omitempty"`
+	ReplyToMessageID int64  `json:"reply_to_message_id,omitempty"`
+	ReactionCount    int64  `json:"reaction_count,omitempty"`
+	SenderType       string `json:"sender_type,omitempty"`
+	Poll             string `json:"poll,omitempty"`
+	ContentType      string `json:"content_type,omitempty"`
+	AccountID        int64  `json:"account_id,omitempty"`
+}
+
+// toEnt 转换为 ent 实体，与 model.MessageModel 的返回值保持一致
+func (m *clickHouseMessage) toEnt() *ent.Message {
+	msg := &ent.Message{
+		CreateTime:       time.UnixMilli(m.CreatedAtMs),
+		UpdateTime:       time.UnixMilli(m.CreatedAtMs),
+		MessageID:        m.MessageID,
+		ServerMessageID:  m.ServerMessageID,
+		ChatID:           m.ChatID,
+		SenderID:         m.SenderID,
+		SenderName:       m.SenderName,
+		SenderUsername:   m.SenderUsername,
+		Text:             m.Text,
+		SentAt:           time.UnixMilli(m.SentAtMs),
+		Lang:             m.Lang,
+		MessageThreadID:  m.MessageThreadID,
+		ReplyToMessageID: m.ReplyToMessageID,
+		ReactionCount:    m.ReactionCount,
+		SenderType:       m.SenderType,
+		Poll:             m.Poll,
+		ContentType:      m.ContentType,
+		AccountID:        m.AccountID,
+	}
+	if m.EditedAtMs > 0 {
+		editedAt := time.UnixMilli(m.EditedAtMs)
+		msg.EditedAt = &editedAt
+	}
+	return msg
+}
+
+// clickHouseTime 格式化为 DateTime64(3, 'UTC') 参数
+func clickHouseTime(t time.Time) string {
+	return t.UTC().Format(clickHouseTimeFormat)
+}
+
+// do 执行一条语句：params 以查询参数（{name:Type}）传入，避免拼接 SQL；data 非空时作为 INSERT 的数据；
+// mutation 为 true 时等待 UPDATE/DELETE 执行完成后再返回。耗时及失败次数记录到 /metrics
+func (s *ClickHouseMessageStore) do(ctx context.Context, query string, params map[string]any, data []byte, mutation bool) (respBody []byte, err error) {
+	op := "query"
+	switch {
+	case data != nil:
+		op = "insert"
+	case mutation:
+		op = "mutation"
+	}
+	defer model.ObserveQuery("clickhouse", s.table, op, time.Now(), &err)
+
+	values := url.Values{}
+	values.Set("database", s.config.Database)
+	values.Set("output_format_json_quote_64bit_integers", "0")
+	if mutation {
+		values.Set("mutations_sync", "1")
+	}
+	for name, value := range params {
+		values.Set("param_"+name, fmt.Sprint(value))
+	}
+
+	body := io.Reader(strings.NewReader(query))
+	if data != nil {
+		values.Set("query", query)
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(s.config.URL, "/")+"/?"+values.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-ClickHouse-User", s.config.User)
+	if s.config.Password != "" {
+		req.Header.Set("X-ClickHouse-Key", s.config.Password)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求 ClickHouse 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取 ClickHouse 响应失败: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ClickHouse 返回 %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
+
+// query 执行查询，按 JSONEachRow 逐行解析为 T
+func query[T any](ctx context.Context, s *ClickHouseMessageStore, q string, params map[string]any) ([]T, error) {
+	data, err := s.do(ctx, q+" FORMAT JSONEachRow", params, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []T
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var row T
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			return nil, fmt.Errorf("解析 ClickHouse 响应失败: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, scanner.Err()
+}
+
+// queryMessages 查询满足条件的消息
+func (s *ClickHouseMessageStore) queryMessages(ctx context.Context, where, orderBy string, params map[string]any) ([]*ent.Message, error) {
+	rows, err := query[clickHouseMessage](ctx, s, "SELECT "+clickHouseColumns+" FROM "+s.table+" WHERE "+where+" ORDER BY "+orderBy, params)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*ent.Message, len(rows))
+	for i := range rows {
+		messages[i] = rows[i].toEnt()
+	}
+	return messages, nil
+}
+
+// count 统计满足条件的消息数
+func (s *ClickHouseMessageStore) count(ctx context.Context, where string, params map[string]any) (int, error) {
+	rows, err := query[struct {
+		N int `json:"n"`
+	}](ctx, s, "SELECT count() AS n FROM "+s.table+" WHERE "+where, params)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	return rows[0].N, nil
+}
+
+// deleteWhere 删除满足条件的消息，返回删除的数量
+func (s *ClickHouseMessageStore) deleteWhere(ctx context.Context, where string, params map[string]any) (int, error) {
+	n, err := s.count(ctx, where, params)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	if _, err := s.do(ctx, "DELETE FROM "+s.table+" WHERE "+where, params, nil, true); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Create 保存消息
+func (s *ClickHouseMessageStore) Create(ctx context.Context, data *model.MessageData) (*ent.Message, error) {
+	row := clickHouseMessage{
+		MessageID:        data.MessageID,
+		ServerMessageID:  data.ServerMessageID,
+		ChatID:           data.ChatID,
+		SenderID:         data.SenderID,
+		SenderName:       data.SenderName,
+		Text:             data.Text,
+		Lang:             data.Lang,
+		SentAt:           clickHouseTime(data.SentAt),
+		MessageThreadID:  data.MessageThreadID,
+		ReplyToMessageID: data.ReplyToMessageID,
+		ReactionCount:    data.ReactionCount,
+		SenderType:       data.SenderType,
+		ContentType:      data.ContentType,
+		AccountID:        data.AccountID,
+	}
+	if data.SenderUsername != nil {
+		row.SenderUsername = *data.SenderUsername
+	}
+	if data.Poll != nil {
+		poll, err := json.Marshal(data.Poll)
+		if err != nil {
+			return nil, err
+		}
+		row.Poll = string(poll)
+	}
+	line, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.do(ctx, "INSERT INTO "+s.table+" FORMAT JSONEachRow", nil, line, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	row.SentAtMs, row.CreatedAtMs = data.SentAt.UnixMilli(), now.UnixMilli()
+	return row.toEnt(), nil
+}
+
+// Exists 判断群组中是否已保存该 TDLib 消息ID 的消息，用于入库去重
+func (s *ClickHouseMessageStore) Exists(ctx context.Context, chatID, messageID int64) (bool, error) {
+	n, err := s.count(ctx, "chat_id = {chat_id:Int64} AND message_id = {message_id:Int64}",
+		map[string]any{"chat_id": chatID, "message_id": messageID})
+	return n > 0, err
+}
+
+// UpdateMessageID 消息发送成功后将临时消息ID更新为正式的 TDLib 消息ID 和服务器消息ID，返回更新的数量
+func (s *ClickHouseMessageStore) UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error) {
+	where := "chat_id = {chat_id:Int64} AND message_id = {old_message_id:Int64}"
+	params := map[string]any{"chat_id": chatID, "old_message_id": oldMessageID, "message_id": messageID, "server_message_id": serverMessageID}
+	n, err := s.count(ctx, where, params)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	set := "message_id = {message_id:Int64}"
+	if serverMessageID != 0 {
+		set += ", server_message_id = {server_message_id:Int64}"
+	}
+	if _, err := s.do(ctx, "ALTER TABLE "+s.table+" UPDATE "+set+" WHERE "+where, params, nil, true); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// UpdateContent 消息被编辑后更新已保存的文本、语言、内容类型和编辑时间，返回更新的数量
+func (s *ClickHouseMessageStore) UpdateContent(ctx context.Context, data *model.MessageData) (int, error) {
+	where := "chat_id = {chat_id:Int64} AND message_id = {message_id:Int64}"
+	params := map[string]any{"chat_id": data.ChatID, "message_id": data.MessageID, "text": data.Text, "lang": data.Lang}
+	n, err := s.count(ctx, where, params)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+
+	set := "text = {text:String}, lang = {lang:String}"
+	if data.ContentType != "" {
+		set += ", content_type = {content_type:String}"
+		params["content_type"] = data.ContentType
+	}
+	if data.EditedAt != nil {
+		set += ", edited_at = {edited_at:DateTime64(3, 'UTC')}"
+		params["edited_at"] = clickHouseTime(*data.EditedAt)
+	}
+	if _, err := s.do(ctx, "ALTER TABLE "+s.table+" UPDATE "+set+" WHERE "+where, params, nil, true); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// UpdateReactionCount 消息的回应变化后更新已保存的回应总数，返回更新的数量
+func (s *ClickHouseMessageStore) UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error) {
+	where := "chat_id = {chat_id:Int64} AND message_id = {message_id:Int64}"
+	params := map[string]any{"chat_id": chatID, "message_id": messageID, "reaction_count": count}
+	n, err := s.count(ctx, where, params)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	if _, err := s.do(ctx, "ALTER TABLE "+s.table+" UPDATE reaction_count = {reaction_count:Int64} WHERE "+where, params, nil, true); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// UpdatePoll 投票的得票情况变化后更新已保存的投票，返回更新的数量
+func (s *ClickHouseMessageStore) UpdatePoll(ctx context.Context, chatID, messageID int64, poll *model.Poll) (int, error) {
+	data, err := json.Marshal(poll)
+	if err != nil {
+		return 0, err
+	}
+	where := "chat_id = {chat_id:Int64} AND message_id = {message_id:Int64}"
+	params := map[string]any{"chat_id": chatID, "message_id": messageID, "poll": string(data)}
+	n, err := s.count(ctx, where, params)
+	if err != nil || n == 0 {
+		return 0, err
+	}
+	if _, err := s.do(ctx, "ALTER TABLE "+s.table+" UPDATE poll = {poll:String} WHERE "+where, params, nil, true); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// GetByDateRangeAndChat 查询时间区间内所有消息（不含事件）
+func (s *ClickHouseMessageStore) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return s.queryMessages(ctx,
+		"chat_id = {chat_id:Int64} AND sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND "+clickHouseNotEvent,
+		"sent_at",
+		map[string]any{"chat_id": chatID, "start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+}
+
+// GetEventsByDateRangeAndChat 查询时间区间内记录的事件（非文字消息）
+func (s *ClickHouseMessageStore) GetEventsByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return s.queryMessages(ctx,
+		"chat_id = {chat_id:Int64} AND sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND NOT "+clickHouseNotEvent,
+		"sent_at",
+		map[string]any{"chat_id": chatID, "start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+}
+
+// GetBySenderAndDateRange 获取指定发送者在时间区间内于所有群组的消息
+func (s *ClickHouseMessageStore) GetBySenderAndDateRange(ctx context.Context, senderID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return s.queryMessages(ctx,
+		"sender_id = {sender_id:Int64} AND sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND "+clickHouseNotEvent,
+		"sent_at",
+		map[string]any{"sender_id": senderID, "start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+}
+
+// GetMentionCandidates 获取时间区间内其他成员发送的、包含 keyword（不区分大小写）的消息，按发送时间倒序，最多 limit 条
+func (s *ClickHouseMessageStore) GetMentionCandidates(ctx context.Context, senderID int64, keyword string, startTime, endTime time.Time, limit int) ([]*ent.Message, error) {
+	return s.queryMessages(ctx,
+		"sender_id != {sender_id:Int64} AND positionCaseInsensitiveUTF8(text, {keyword:String}) > 0"+
+			" AND sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND "+clickHouseNotEvent,
+		fmt.Sprintf("sent_at DESC LIMIT %d", limit),
+		map[string]any{"sender_id": senderID, "keyword": keyword, "start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+}
+
+// GetChatIDsByDateRange 查询指定时间区间内有消息（不含事件）的所有群组ID
+func (s *ClickHouseMessageStore) GetChatIDsByDateRange(ctx context.Context, startTime, endTime time.Time) ([]int64, error) {
+	rows, err := query[struct {
+		ChatID int64 `json:"chat_id"`
+	}](ctx, s, "SELECT DISTINCT chat_id FROM "+s.table+
+		" WHERE sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND "+clickHouseNotEvent,
+		map[string]any{"start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+	if err != nil {
+		return nil, err
+	}
+	chatIDs := make([]int64, len(rows))
+	for i, row := range rows {
+		chatIDs[i] = row.ChatID
+	}
+	return chatIDs, nil
+}
+
+// CountByChat 查询指定时间区间内各群组的消息数（不含事件）
+func (s *ClickHouseMessageStore) CountByChat(ctx context.Context, startTime, endTime time.Time) (map[int64]int, error) {
+	rows, err := query[struct {
+		ChatID int64 `json:"chat_id"`
+		N      int   `json:"n"`
+	}](ctx, s, "SELECT chat_id, count() AS n FROM "+s.table+
+		" WHERE sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')} AND "+clickHouseNotEvent+" GROUP BY chat_id",
+		map[string]any{"start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.ChatID] = row.N
+	}
+	return counts, nil
+}
+
+// GetSenderIDs 查询所有消息的发送用户ID（去重，不含以群组或频道身份发送的消息）
+func (s *ClickHouseMessageStore) GetSenderIDs(ctx context.Context) ([]int64, error) {
+	rows, err := query[struct {
+		SenderID int64 `json:"sender_id"`
+	}](ctx, s, "SELECT DISTINCT sender_id FROM "+s.table+" WHERE sender_id > 0", nil)
+	if err != nil {
+		return nil, err
+	}
+	senderIDs := make([]int64, len(rows))
+	for i, row := range rows {
+		senderIDs[i] = row.SenderID
+	}
+	return senderIDs, nil
+}
+
+// SoftDeleteBefore 删除指定日期之前的消息。ClickHouse 的删除不支持按条数分批，一次删除全部，返回删除的数量
+func (s *ClickHouseMessageStore) SoftDeleteBefore(ctx context.Context, cutoffDate time.Time, limit int) (int, error) {
+	return s.deleteWhere(ctx, "sent_at < {cutoff:DateTime64(3, 'UTC')}", map[string]any{"cutoff": clickHouseTime(cutoffDate)})
+}
+
+// SoftDeleteByChat 删除群组的全部消息（群组退出数据收集时调用），返回删除的数量
+func (s *ClickHouseMessageStore) SoftDeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	return s.deleteWhere(ctx, "chat_id = {chat_id:Int64}", map[string]any{"chat_id": chatID})
+}
+
+// SoftDeleteByChatRange 删除群组在 [startTime, endTime) 内发送的消息，返回删除的数量
+func (s *ClickHouseMessageStore) SoftDeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error) {
+	return s.deleteWhere(ctx,
+		"chat_id = {chat_id:Int64} AND sent_at >= {start:DateTime64(3, 'UTC')} AND sent_at < {end:DateTime64(3, 'UTC')}",
+		map[string]any{"chat_id": chatID, "start": clickHouseTime(startTime), "end": clickHouseTime(endTime)})
+}
+
+// SoftDeleteByMessageIDs 删除群组中指定 TDLib 消息ID 的消息，返回删除的数量
+func (s *ClickHouseMessageStore) SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error) {
+	if len(messageIDs) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, len(messageIDs))
+	for i, id := range messageIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	return s.deleteWhere(ctx, "chat_id = {chat_id:Int64} AND has({message_ids:Array(Int64)}, message_id)",
+		map[string]any{"chat_id": chatID, "message_ids": "[" + strings.Join(ids, ",") + "]"})
+}
+
+// PurgeDeleted 已删除的消息由 ClickHouse 在后台合并时物理删除，无需处理
+func (s *ClickHouseMessageStore) PurgeDeleted(ctx context.Context, limit int) (int, error) {
+	return 0, nil
+}
+
+var _ MessageStore = (*ClickHouseMessageStore)(nil)
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clickHouseRequest 模拟服务端收到的请求
+type clickHouseRequest struct {
+	query  string
+	params map[string]string
+	body   string
+	user   string
+	key    string
+}
+
+// newFakeClickHouse 启动模拟的 ClickHouse HTTP 接口，依次返回 responses 并记录请求
+func newFakeClickHouse(t *testing.T, responses ...string) (*ClickHouseMessageStore, *[]clickHouseRequest) {
+	var requests []clickHouseRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := clickHouseRequest{query: r.URL.Query().Get("query"), params: map[string]string{}, user: r.Header.Get("X-ClickHouse-User"), key: r.Header.Get("X-ClickHouse-Key")}
+		if req.query == "" {
+			req.query = string(body)
+		} else {
+			req.body = string(body)
+		}
+		for name, values := range r.URL.Query() {
+			req.params[name] = values[0]
+		}
+		requests = append(requests, req)
+
+		if len(requests) > len(responses) {
+			http.Error(w, "Code: 62. DB::Exception: Syntax error", http.StatusBadRequest)
+			return
+		}
+		_, _ = io.WriteString(w, responses[len(requests)-1])
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.ClickHouse{URL: server.URL, Database: "db", Table: "messages", User: "bot", Password: "secret"}
+	return NewClickHouseMessageStore(cfg), &requests
+}
+
+func TestClickHouseMessageStore_Query(t *testing.T) {
+	ctx := context.Background()
+	store, requests := newFakeClickHouse(t,
+		`{"message_id":5242880,"server_message_id":5,"chat_id":-100,"sender_id":10,"sender_name":"Alice","sender_username":"@alice","text":"早","lang":"zh","sent_at_ms":1739149200000,"created_at_ms":1739149201000}`+"\n")
+
+	start := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	messages, err := store.GetByDateRangeAndChat(ctx, -100, start, start.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, int64(5), messages[0].ServerMessageID)
+	assert.Equal(t, "@alice", messages[0].SenderUsername)
+	assert.True(t, messages[0].SentAt.Equal(start.Add(time.Hour)))
+
+	req := (*requests)[0]
+	assert.Contains(t, req.query, "FROM db.messages WHERE chat_id = {chat_id:Int64}")
+	assert.Contains(t, req.query, "AND content_type NOT IN ('sticker', 'animation',", "不返回事件")
+	assert.True(t, strings.HasSuffix(req.query, "FORMAT JSONEachRow"))
+	assert.Equal(t, "-100", req.params["param_chat_id"], "参数通过查询参数传入，不拼接到 SQL 中")
+	assert.Equal(t, "2025-02-10 00:00:00.000", req.params["param_start"])
+	assert.Equal(t, "db", req.params["database"])
+	assert.Equal(t, "bot", req.user)
+	assert.Equal(t, "secret", req.key)
+}
+
+func TestClickHouseMessageStore_Create(t *testing.T) {
+	store, requests := newFakeClickHouse(t, "")
+	username := "@alice"
+	sentAt := time.Date(2025, 2, 10, 1, 0, 0, 0, time.UTC)
+
+	msg, err := store.Create(context.Background(), &model.MessageData{MessageID: 1, ChatID: -100, SenderID: 10, SenderName: "Alice", SenderUsername: &username, Text: "早", SentAt: sentAt})
+	require.NoError(t, err)
+	assert.True(t, msg.SentAt.Equal(sentAt))
+
+	req := (*requests)[0]
+	assert.Equal(t, "INSERT INTO db.messages FORMAT JSONEachRow", req.query)
+	assert.JSONEq(t, `{"message_id":1,"server_message_id":0,"chat_id":-100,"sender_id":10,"sender_name":"Alice","sender_username":"@alice","text":"早","lang":"","sent_at":"2025-02-10 01:00:00.000"}`, req.body)
+}
+
+func TestClickHouseMessageStore_UpdateContent(t *testing.T) {
+	ctx := context.Background()
+	store, requests := newFakeClickHouse(t, `{"n":1}`+"\n", "")
+	editedAt := time.Date(2025, 2, 10, 2, 0, 0, 0, time.UTC)
+
+	n, err := store.UpdateContent(ctx, &model.MessageData{MessageID: 1, ChatID: -100, Text: "改到周五发布", Lang: "zh", EditedAt: &editedAt})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, *requests, 2)
+	req := (*requests)[1]
+	assert.Equal(t, "ALTER TABLE db.messages UPDATE text = {text:String}, lang = {lang:String}, edited_at = {edited_at:DateTime64(3, 'UTC')} WHERE chat_id = {chat_id:Int64} AND message_id = {message_id:Int64}", req.query)
+	assert.Equal(t, "改到周五发布", req.params["param_text"])
+	assert.Equal(t, "2025-02-10 02:00:00.000", req.params["param_edited_at"])
+	assert.Equal(t, "1", req.params["mutations_sync"])
+
+	store, requests = newFakeClickHouse(t, `{"message_id":1,"chat_id":-100,"text":"改到周五发布","sent_at_ms":1739149200000,"created_at_ms":1739149201000,"edited_at_ms":1739152800000}`+"\n"+
+		`{"message_id":2,"chat_id":-100,"text":"收到","sent_at_ms":1739149300000,"created_at_ms":1739149301000,"edited_at_ms":0}`+"\n")
+	messages, err := store.GetByDateRangeAndChat(ctx, -100, editedAt.Add(-2*time.Hour), editedAt)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.NotNil(t, messages[0].EditedAt)
+	assert.True(t, messages[0].EditedAt.Equal(editedAt))
+	assert.Nil(t, messages[1].EditedAt, "0 表示未编辑")
+	assert.Contains(t, (*requests)[0].query, "toUnixTimestamp64Milli(edited_at) AS edited_at_ms")
+}
+
+func TestClickHouseMessageStore_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("无匹配消息时不执行删除", func(t *testing.T) {
+		store, requests := newFakeClickHouse(t, `{"n":0}`+"\n")
+		n, err := store.SoftDeleteByChat(ctx, -100)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("返回删除前统计的数量", func(t *testing.T) {
+		store, requests := newFakeClickHouse(t, `{"n":3}`+"\n", "")
+		n, err := store.SoftDeleteByChat(ctx, -100)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		require.Len(t, *requests, 2)
+		assert.Equal(t, "DELETE FROM db.messages WHERE chat_id = {chat_id:Int64}", (*requests)[1].query)
+		assert.Equal(t, "1", (*requests)[1].params["mutations_sync"], "等待删除完成")
+	})
+
+	t.Run("按消息ID删除", func(t *testing.T) {
+		store, requests := newFakeClickHouse(t, `{"n":2}`+"\n", "")
+		n, err := store.SoftDeleteByMessageIDs(ctx, -100, []int64{1048576, 2097152})
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		require.Len(t, *requests, 2)
+		assert.Equal(t, "DELETE FROM db.messages WHERE chat_id = {chat_id:Int64} AND has({message_ids:Array(Int64)}, message_id)", (*requests)[1].query)
+		assert.Equal(t, "[1048576,2097152]", (*requests)[1].params["param_message_ids"])
+	})
+
+	t.Run("服务端错误", func(t *testing.T) {
+		store, _ := newFakeClickHouse(t)
+		_, err := store.SoftDeleteByChat(ctx, -100)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Syntax error")
+	})
+}
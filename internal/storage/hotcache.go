@@ -0,0 +1,323 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// HotCache 在 MessageStore 之上缓存各群组当天（UTC）的消息（实现 MessageStore）。
+// 群组首次查询当天区间时从底层存储加载当天全部消息，之后新消息写入底层存储后同时追加到缓存，
+// 编辑、回应、投票及删除只更新缓存中对应的消息，同一天内的后续查询直接从内存返回。每个群组最多缓存 maxPerChat 条，超出时丢弃最早写入的消息，
+// 查询区间早于缓存覆盖范围时仍查询底层存储。其他方法直接转发到底层存储。
+// 查询的命中情况（hit/miss/bypass）及因缓存已满丢弃的消息数记录到 /metrics
+type HotCache struct {
+	MessageStore
+	maxPerChat int
+	now        func() time.Time
+
+	mu    sync.Mutex
+	day   time.Time
+	chats map[int64]*chatRing
+}
+
+// chatRing 单个群组当天消息的环形缓冲区
+type chatRing struct {
+	mu     sync.Mutex
+	loaded bool
+	from   time.Time // 缓存完整覆盖 [from, 次日 0 点) 内的消息
+	buf    []*ent.Message
+	start  int // 最早写入的消息在 buf 中的位置
+	size   int
+	ids    map[int64]bool // 已缓存的 TDLib 消息ID，避免加载与写入并发时重复缓存
+}
+
+func NewHotCache(store MessageStore, maxPerChat int) *HotCache {
+	return &HotCache{
+		MessageStore: store,
+		maxPerChat:   maxPerChat,
+		now:          time.Now,
+		chats:        make(map[int64]*chatRing),
+	}
+}
+
+// today 返回当天 0 点（UTC）
+func (c *HotCache) today() time.Time {
+	now := c.now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ring 返回群组的缓存，跨天时清空全部缓存；create 为 false 且不存在时返回 nil
+func (c *HotCache) ring(chatID int64, create bool) (*chatRing, time.Time) {
+	day := c.today()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.day.Equal(day) {
+		c.day = day
+		c.chats = make(map[int64]*chatRing)
+	}
+	r := c.chats[chatID]
+	if r == nil && create {
+		r = &chatRing{from: day, buf: make([]*ent.Message, c.maxPerChat), ids: make(map[int64]bool)}
+		c.chats[chatID] = r
+	}
+	return r, day
+}
+
+// invalidate 丢弃群组的缓存，下次查询时重新加载
+func (c *HotCache) invalidate(chatID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.chats, chatID)
+}
+
+// invalidateAll 丢弃全部缓存
+func (c *HotCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.chats = make(map[int64]*chatRing)
+}
+
+// add 追加消息，缓冲区已满时丢弃最早写入的消息并收窄覆盖范围
+func (r *chatRing) add(m *ent.Message) {
+	if r.ids[m.MessageID] || len(r.buf) == 0 {
+		return
+	}
+	if r.size == len(r.buf) {
+		metrics.Inc("message_cache_evictions_total")
+		oldest := r.buf[r.start]
+		delete(r.ids, oldest.MessageID)
+		if after := oldest.SentAt.Add(time.Nanosecond); after.After(r.from) {
+			r.from = after
+		}
+		r.buf[r.start] = m
+		r.start = (r.start + 1) % len(r.buf)
+	} else {
+		r.buf[(r.start+r.size)%len(r.buf)] = m
+		r.size++
+	}
+	r.ids[m.MessageID] = true
+}
+
+// update 对缓存中的指定消息执行 fn，消息未缓存时忽略
+func (r *chatRing) update(messageID int64, fn func(m *ent.Message)) {
+	if !r.ids[messageID] {
+		return
+	}
+	for i := range r.size {
+		if m := r.buf[(r.start+i)%len(r.buf)]; m.MessageID == messageID {
+			fn(m)
+		}
+	}
+}
+
+// remove 移除缓存中的指定消息，保留其余消息的写入顺序；缓存覆盖范围不变
+func (r *chatRing) remove(messageIDs []int64) {
+	kept := make([]*ent.Message, 0, r.size)
+	for i := range r.size {
+		m := r.buf[(r.start+i)%len(r.buf)]
+		if slices.Contains(messageIDs, m.MessageID) {
+			delete(r.ids, m.MessageID)
+			continue
+		}
+		kept = append(kept, m)
+	}
+	clear(r.buf)
+	copy(r.buf, kept)
+	r.start, r.size = 0, len(kept)
+}
+
+// between 返回发送时间在 [startTime, endTime) 内的消息副本，按发送时间排序
+func (r *chatRing) between(startTime, endTime time.Time) []*ent.Message {
+	var messages []*ent.Message
+	for i := range r.size {
+		m := r.buf[(r.start+i)%len(r.buf)]
+		if !m.SentAt.Before(startTime) && m.SentAt.Before(endTime) {
+			copied := *m
+			messages = append(messages, &copied)
+		}
+	}
+	slices.SortStableFunc(messages, func(a, b *ent.Message) int { return a.SentAt.Compare(b.SentAt) })
+	return messages
+}
+
+// Create 写入底层存储后追加到已加载的当天缓存（事件不缓存）
+func (c *HotCache) Create(ctx context.Context, data *model.MessageData) (*ent.Message, error) {
+	m, err := c.MessageStore.Create(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if model.IsEvent(m.ContentType) {
+		return m, nil
+	}
+	if r, day := c.ring(m.ChatID, false); r != nil && !m.SentAt.Before(day) {
+		r.mu.Lock()
+		if r.loaded {
+			copied := *m
+			r.add(&copied)
+		}
+		r.mu.Unlock()
+	}
+	return m, nil
+}
+
+// GetByDateRangeAndChat 查询区间在缓存覆盖范围内时从内存返回，否则查询底层存储
+func (c *HotCache) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	if startTime.Before(c.today()) {
+		metrics.Inc(metrics.Name("message_cache_requests_total", "result", "bypass"))
+		return c.MessageStore.GetByDateRangeAndChat(ctx, chatID, startTime, endTime)
+	}
+
+	r, day := c.ring(chatID, true)
+	r.mu.Lock()
+	result := "hit"
+	if !r.loaded {
+		result = "miss"
+		messages, err := c.MessageStore.GetByDateRangeAndChat(ctx, chatID, day, day.AddDate(0, 0, 1))
+		if err != nil {
+			r.mu.Unlock()
+			return nil, err
+		}
+		for _, m := range messages {
+			r.add(m)
+		}
+		r.loaded = true
+	}
+	if startTime.Before(r.from) {
+		r.mu.Unlock()
+		metrics.Inc(metrics.Name("message_cache_requests_total", "result", "bypass"))
+		return c.MessageStore.GetByDateRangeAndChat(ctx, chatID, startTime, endTime)
+	}
+	metrics.Inc(metrics.Name("message_cache_requests_total", "result", result))
+	messages := r.between(startTime, endTime)
+	r.mu.Unlock()
+	return messages, nil
+}
+
+// UpdateMessageID 更新底层存储后同步更新缓存中的消息ID
+func (c *HotCache) UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error) {
+	n, err := c.MessageStore.UpdateMessageID(ctx, chatID, oldMessageID, messageID, serverMessageID)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	r, _ := c.ring(chatID, false)
+	if r == nil {
+		return n, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.size {
+		m := r.buf[(r.start+i)%len(r.buf)]
+		if m.MessageID != oldMessageID {
+			continue
+		}
+		delete(r.ids, oldMessageID)
+		m.MessageID = messageID
+		if serverMessageID != 0 {
+			m.ServerMessageID = serverMessageID
+		}
+		r.ids[messageID] = true
+	}
+	return n, nil
+}
+
+// updateCached 对群组缓存中的指定消息执行 fn，该群组未缓存时忽略
+func (c *HotCache) updateCached(chatID, messageID int64, fn func(m *ent.Message)) {
+	r, _ := c.ring(chatID, false)
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.update(messageID, fn)
+}
+
+// UpdateContent 更新底层存储后同步更新缓存中该消息的内容；编辑后变为事件时（不缓存）丢弃该群组的缓存
+func (c *HotCache) UpdateContent(ctx context.Context, data *model.MessageData) (int, error) {
+	n, err := c.MessageStore.UpdateContent(ctx, data)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	if model.IsEvent(data.ContentType) {
+		c.invalidate(data.ChatID)
+		return n, nil
+	}
+	c.updateCached(data.ChatID, data.MessageID, func(m *ent.Message) {
+		m.Text, m.Lang, m.EditedAt = data.Text, data.Lang, data.EditedAt
+		if data.ContentType != "" {
+			m.ContentType = data.ContentType
+		}
+	})
+	return n, nil
+}
+
+// UpdateReactionCount 更新底层存储后同步更新缓存中该消息的回应总数
+func (c *HotCache) UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error) {
+	n, err := c.MessageStore.UpdateReactionCount(ctx, chatID, messageID, count)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	c.updateCached(chatID, messageID, func(m *ent.Message) { m.ReactionCount = count })
+	return n, nil
+}
+
+// UpdatePoll 更新底层存储后同步更新缓存中该消息的投票
+func (c *HotCache) UpdatePoll(ctx context.Context, chatID, messageID int64, poll *model.Poll) (int, error) {
+	n, err := c.MessageStore.UpdatePoll(ctx, chatID, messageID, poll)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	encoded, err := model.EncodePoll(poll)
+	if err != nil {
+		c.invalidate(chatID)
+		return n, nil
+	}
+	c.updateCached(chatID, messageID, func(m *ent.Message) { m.Poll = encoded })
+	return n, nil
+}
+
+// SoftDeleteBefore 删除早于 cutoffDate 的消息，截止时间晚于当天 0 点时丢弃全部缓存
+func (c *HotCache) SoftDeleteBefore(ctx context.Context, cutoffDate time.Time, limit int) (int, error) {
+	n, err := c.MessageStore.SoftDeleteBefore(ctx, cutoffDate, limit)
+	if n > 0 && cutoffDate.After(c.today()) {
+		c.invalidateAll()
+	}
+	return n, err
+}
+
+// SoftDeleteByChat 删除群组的全部消息并丢弃该群组的缓存
+func (c *HotCache) SoftDeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	n, err := c.MessageStore.SoftDeleteByChat(ctx, chatID)
+	c.invalidate(chatID)
+	return n, err
+}
+
+// SoftDeleteByChatRange 删除群组区间内的消息并丢弃该群组的缓存
+func (c *HotCache) SoftDeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error) {
+	n, err := c.MessageStore.SoftDeleteByChatRange(ctx, chatID, startTime, endTime)
+	c.invalidate(chatID)
+	return n, err
+}
+
+// SoftDeleteByMessageIDs 删除群组中的指定消息，并从缓存中移除这些消息
+func (c *HotCache) SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error) {
+	n, err := c.MessageStore.SoftDeleteByMessageIDs(ctx, chatID, messageIDs)
+	if err != nil || n == 0 {
+		return n, err
+	}
+	if r, _ := c.ring(chatID, false); r != nil {
+		r.mu.Lock()
+		r.remove(messageIDs)
+		r.mu.Unlock()
+	}
+	return n, nil
+}
+
+var _ MessageStore = (*HotCache)(nil)
@@ -0,0 +1,218 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore 记录查询次数的内存消息存储，只实现缓存用到的方法
+type memoryStore struct {
+	MessageStore
+	messages []*ent.Message
+	queries  int
+}
+
+func (s *memoryStore) Create(ctx context.Context, data *model.MessageData) (*ent.Message, error) {
+	m := &ent.Message{MessageID: data.MessageID, ChatID: data.ChatID, Text: data.Text, SentAt: data.SentAt}
+	s.messages = append(s.messages, m)
+	copied := *m
+	return &copied, nil
+}
+
+func (s *memoryStore) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	s.queries++
+	var messages []*ent.Message
+	for _, m := range s.messages {
+		if m.ChatID == chatID && !m.SentAt.Before(startTime) && m.SentAt.Before(endTime) {
+			copied := *m
+			messages = append(messages, &copied)
+		}
+	}
+	return messages, nil
+}
+
+func (s *memoryStore) UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error) {
+	n := 0
+	for _, m := range s.messages {
+		if m.ChatID == chatID && m.MessageID == oldMessageID {
+			m.MessageID = messageID
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *memoryStore) SoftDeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	kept := s.messages[:0]
+	for _, m := range s.messages {
+		if m.ChatID != chatID {
+			kept = append(kept, m)
+		}
+	}
+	n := len(s.messages) - len(kept)
+	s.messages = kept
+	return n, nil
+}
+
+// updateMessage 对群组中的指定消息执行 fn，返回更新的条数
+func (s *memoryStore) updateMessage(chatID, messageID int64, fn func(m *ent.Message)) int {
+	n := 0
+	for _, m := range s.messages {
+		if m.ChatID == chatID && m.MessageID == messageID {
+			fn(m)
+			n++
+		}
+	}
+	return n
+}
+
+func (s *memoryStore) UpdateContent(ctx context.Context, data *model.MessageData) (int, error) {
+	return s.updateMessage(data.ChatID, data.MessageID, func(m *ent.Message) { m.Text = data.Text }), nil
+}
+
+func (s *memoryStore) UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error) {
+	return s.updateMessage(chatID, messageID, func(m *ent.Message) { m.ReactionCount = count }), nil
+}
+
+func (s *memoryStore) SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error) {
+	kept := s.messages[:0]
+	for _, m := range s.messages {
+		if m.ChatID != chatID || !slices.Contains(messageIDs, m.MessageID) {
+			kept = append(kept, m)
+		}
+	}
+	n := len(s.messages) - len(kept)
+	s.messages = kept
+	return n, nil
+}
+
+func messageIDs(messages []*ent.Message) []int64 {
+	ids := make([]int64, len(messages))
+	for i, m := range messages {
+		ids[i] = m.MessageID
+	}
+	return ids
+}
+
+func TestHotCache(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	now := day.Add(12 * time.Hour)
+
+	newCache := func(maxPerChat int) (*HotCache, *memoryStore) {
+		store := &memoryStore{}
+		cache := NewHotCache(store, maxPerChat)
+		cache.now = func() time.Time { return now }
+		for i, offset := range []time.Duration{-time.Hour, time.Hour, 2 * time.Hour} {
+			_, err := cache.Create(ctx, &model.MessageData{MessageID: int64(i + 1), ChatID: -100, SentAt: day.Add(offset)})
+			require.NoError(t, err)
+		}
+		return cache, store
+	}
+
+	t.Run("当天区间首次加载后从内存返回", func(t *testing.T) {
+		cache, store := newCache(10)
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, messageIDs(messages))
+
+		_, err = cache.Create(ctx, &model.MessageData{MessageID: 4, ChatID: -100, SentAt: day.Add(90 * time.Minute)})
+		require.NoError(t, err)
+		messages, err = cache.GetByDateRangeAndChat(ctx, -100, day.Add(time.Hour), day.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 4, 3}, messageIDs(messages), "按发送时间排序")
+		assert.Equal(t, 1, store.queries, "新消息写入时追加到缓存，无需再次查询")
+	})
+
+	t.Run("区间早于当天时查询底层存储", func(t *testing.T) {
+		cache, store := newCache(10)
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day.AddDate(0, 0, -1), day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Len(t, messages, 3)
+		assert.Equal(t, 1, store.queries)
+	})
+
+	t.Run("超出容量后只覆盖保留的消息", func(t *testing.T) {
+		cache, store := newCache(1)
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day.Add(90*time.Minute), day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, messageIDs(messages))
+		assert.Equal(t, 1, store.queries)
+
+		messages, err = cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, messageIDs(messages), "已丢弃的区间回退到底层存储")
+		assert.Equal(t, 2, store.queries)
+	})
+
+	t.Run("更新消息ID和删除同步到缓存", func(t *testing.T) {
+		cache, store := newCache(10)
+		_, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+
+		_, err = cache.UpdateMessageID(ctx, -100, 3, 30, 0)
+		require.NoError(t, err)
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 30}, messageIDs(messages))
+
+		_, err = cache.SoftDeleteByChat(ctx, -100)
+		require.NoError(t, err)
+		messages, err = cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Empty(t, messages)
+		assert.Equal(t, 2, store.queries, "删除后重新加载")
+	})
+
+	t.Run("编辑、回应和删除只更新对应的消息", func(t *testing.T) {
+		cache, store := newCache(3)
+		_, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		_, err = cache.Create(ctx, &model.MessageData{MessageID: 4, ChatID: -100, SentAt: day.Add(3 * time.Hour)})
+		require.NoError(t, err)
+
+		_, err = cache.UpdateContent(ctx, &model.MessageData{MessageID: 2, ChatID: -100, Text: "编辑后"})
+		require.NoError(t, err)
+		_, err = cache.UpdateReactionCount(ctx, -100, 4, 5)
+		require.NoError(t, err)
+		_, err = cache.UpdateContent(ctx, &model.MessageData{MessageID: 9, ChatID: -100, Text: "未保存"})
+		require.NoError(t, err)
+		_, err = cache.SoftDeleteByMessageIDs(ctx, -100, []int64{3})
+		require.NoError(t, err)
+
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 4}, messageIDs(messages))
+		assert.Equal(t, "编辑后", messages[0].Text)
+		assert.Equal(t, int64(5), messages[1].ReactionCount)
+		assert.Equal(t, 1, store.queries, "无需重新加载")
+
+		_, err = cache.Create(ctx, &model.MessageData{MessageID: 5, ChatID: -100, SentAt: day.Add(4 * time.Hour)})
+		require.NoError(t, err)
+		messages, err = cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 4, 5}, messageIDs(messages), "删除后腾出的空间继续缓存新消息")
+		assert.Equal(t, 1, store.queries)
+	})
+
+	t.Run("跨天后清空缓存", func(t *testing.T) {
+		cache, store := newCache(10)
+		_, err := cache.GetByDateRangeAndChat(ctx, -100, day, day.AddDate(0, 0, 1))
+		require.NoError(t, err)
+
+		cache.now = func() time.Time { return now.AddDate(0, 0, 1) }
+		_, err = cache.Create(ctx, &model.MessageData{MessageID: 5, ChatID: -100, SentAt: day.AddDate(0, 0, 1)})
+		require.NoError(t, err)
+		messages, err := cache.GetByDateRangeAndChat(ctx, -100, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5}, messageIDs(messages))
+		assert.Equal(t, 2, store.queries)
+	})
+}
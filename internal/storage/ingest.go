@@ -0,0 +1,452 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+const (
+	ingestRetryTimes   = 5                      // 写入遇到锁冲突时的最多尝试次数
+	ingestRetryBackoff = 200 * time.Millisecond // 首次重试前的等待时间，之后每次翻倍
+	ingestEnqueueWait  = 200 * time.Millisecond // 队列已满时入队的最长等待时间，超时丢弃
+)
+
+// ErrIngestQueueClosed 队列已关闭，不再接受新消息
+var ErrIngestQueueClosed = errors.New("入库队列已关闭")
+
+// ErrIngestQueueFull 队列已满且等待超时，本次入队被丢弃
+var ErrIngestQueueFull = errors.New("入库队列已满")
+
+// IngestQueue 有界的消息入库队列：TDLib 更新循环只负责入队，由单个写入协程顺序写入底层存储，
+// 避免每日运行集中读取消息时与消息写入争用 SQLite 锁而超时。队列已满时入队最多等待 ingestEnqueueWait，
+// 超时丢弃并返回 ErrIngestQueueFull，不让 TDLib 更新循环阻塞在数据库写入上；写入遇到锁冲突时退避重试。TDLib 可能重复推送同一条消息，写入前检查是否已保存。
+// 消息的编辑（EditedAt 非空）、删除、消息ID更新、回应数和投票结果的变化，以及群聊退出收集、排除区间时的批量删除
+// 同样经过队列，保证在此前入队的消息写入之后执行。
+// 队列深度、入队等待次数及等待时间、丢弃数、锁冲突重试次数记录到 /metrics
+type IngestQueue struct {
+	store   MessageStore
+	links   LinkStore
+	sampler *IngestSampler
+	ch      chan *ingestOp
+	done    chan struct{}
+	backoff time.Duration
+	wait    time.Duration // 队列已满时入队的最长等待时间
+
+	seqMu    sync.Mutex
+	nextSeq  uint64              // 下一个入队操作的序号，按开始入队的顺序递增
+	inflight map[uint64]struct{} // 已开始入队但尚未写入完成（或放弃入队）的操作序号
+
+	mu      sync.RWMutex   // 保护 closed，登记入队者时持有读锁，关闭时持有写锁
+	closed  bool           // 已开始关闭，不再登记新的入队者
+	closing chan struct{}  // 开始关闭时关闭，唤醒等待空位的入队者
+	senders sync.WaitGroup // 已登记、尚未返回的入队者；全部返回后才关闭 ch，避免向已关闭的 channel 发送
+}
+
+// LinkStore 消息中分享的链接的存储，默认实现为 model.SharedLinkModel
+type LinkStore interface {
+	// CreateForMessage 保存消息中分享的链接（MessageData.Links），返回保存的数量
+	CreateForMessage(ctx context.Context, data *model.MessageData) (int, error)
+	// ReplaceForMessage 消息被编辑后以 MessageData.Links 替换该消息已保存的链接，返回保存的数量
+	ReplaceForMessage(ctx context.Context, data *model.MessageData) (int, error)
+	// DeleteByChat 删除群组分享的全部链接
+	DeleteByChat(ctx context.Context, chatID int64) (int, error)
+	// DeleteByChatRange 删除群组时间区间 [startTime, endTime) 内分享的链接
+	DeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error)
+}
+
+// ingestOp 队列中的一项：data 非空时写入或编辑消息，reactions 非空时更新回应数，poll 非空时更新投票结果，
+// renumber 非空时更新消息ID，purge 非空时删除群组（区间内）的全部消息及链接，否则删除 chatID 群组中的 deleteIDs
+type ingestOp struct {
+	data      *model.MessageData
+	reactions *reactionUpdate
+	poll      *pollUpdate
+	renumber  *messageIDUpdate
+	purge     *purgeRequest
+	chatID    int64
+	deleteIDs []int64
+	allowed   bool   // 新消息已通过每小时上限的检查，写入重试时不再重复计数
+	seq       uint64 // 入队序号，Drain 据此只等待开始时已入队的操作
+}
+
+// messageIDUpdate 消息发送成功后临时消息ID更新为正式ID
+type messageIDUpdate struct {
+	oldMessageID    int64
+	messageID       int64
+	serverMessageID int64
+}
+
+// purgeRequest 删除群组的全部消息（start、end 为零值）或区间 [start, end) 内的消息，结果写入 done
+type purgeRequest struct {
+	start, end time.Time
+	deleted    int
+	done       chan error
+}
+
+// reactionUpdate 消息回应总数的变化
+type reactionUpdate struct {
+	messageID int64
+	count     int64
+}
+
+// pollUpdate 投票消息得票情况的变化
+type pollUpdate struct {
+	messageID int64
+	poll      *model.Poll
+}
+
+// NewIngestQueue 创建容量为 size 的入库队列，需调用 Start 启动写入协程
+func NewIngestQueue(store MessageStore, size int) *IngestQueue {
+	metrics.Set("ingest_queue_capacity", float64(size))
+	return &IngestQueue{
+		store:    store,
+		ch:       make(chan *ingestOp, size),
+		done:     make(chan struct{}),
+		backoff:  ingestRetryBackoff,
+		wait:     ingestEnqueueWait,
+		closing:  make(chan struct{}),
+		inflight: make(map[uint64]struct{}),
+	}
+}
+
+// SetLinkStore 启用链接保存：新消息写入后保存其中分享的链接，需在 Start 之前调用
+func (q *IngestQueue) SetLinkStore(links LinkStore) {
+	q.links = links
+}
+
+// SetSampler 启用每个群组每小时保存消息数的上限（Ingest.RateLimit），需在 Start 之前调用
+func (q *IngestQueue) SetSampler(sampler *IngestSampler) {
+	q.sampler = sampler
+}
+
+// Start 启动写入协程
+func (q *IngestQueue) Start() {
+	go q.run()
+}
+
+// Enqueue 将消息加入队列，队列已满时最多等待 ingestEnqueueWait，超时返回 ErrIngestQueueFull
+func (q *IngestQueue) Enqueue(ctx context.Context, data *model.MessageData) error {
+	return q.enqueue(ctx, &ingestOp{data: data})
+}
+
+// EnqueueDelete 将群组中已被删除的消息（TDLib 消息ID）加入队列，写入时软删除，不再参与总结
+func (q *IngestQueue) EnqueueDelete(ctx context.Context, chatID int64, messageIDs []int64) error {
+	return q.enqueue(ctx, &ingestOp{chatID: chatID, deleteIDs: messageIDs})
+}
+
+// EnqueueMessageID 将消息ID的更新（本账号发送的消息发送成功后，临时ID更新为正式ID）加入队列，
+// 写入时更新已保存的消息，保证在临时消息写入之后执行
+func (q *IngestQueue) EnqueueMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) error {
+	return q.enqueue(ctx, &ingestOp{chatID: chatID, renumber: &messageIDUpdate{
+		oldMessageID:    oldMessageID,
+		messageID:       messageID,
+		serverMessageID: serverMessageID,
+	}})
+}
+
+// Purge 经队列软删除群组的全部消息（start、end 为零值）或区间 [start, end) 内的消息，并删除其中分享的链接，
+// 等待删除完成后返回删除的消息数。此前入队的消息（及链接）先写入再删除，避免刚删除的消息又被写入
+func (q *IngestQueue) Purge(ctx context.Context, chatID int64, start, end time.Time) (int, error) {
+	req := &purgeRequest{start: start, end: end, done: make(chan error, 1)}
+	if err := q.enqueue(ctx, &ingestOp{chatID: chatID, purge: req}); err != nil {
+		return 0, err
+	}
+	select {
+	case err := <-req.done:
+		return req.deleted, err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// EnqueueReactions 将消息回应总数的变化加入队列，写入时更新已保存的消息，消息未保存时忽略
+func (q *IngestQueue) EnqueueReactions(ctx context.Context, chatID, messageID, count int64) error {
+	return q.enqueue(ctx, &ingestOp{chatID: chatID, reactions: &reactionUpdate{messageID: messageID, count: count}})
+}
+
+// EnqueuePoll 将投票得票情况的变化加入队列，写入时更新已保存的投票消息，消息未保存时忽略
+func (q *IngestQueue) EnqueuePoll(ctx context.Context, chatID, messageID int64, poll *model.Poll) error {
+	return q.enqueue(ctx, &ingestOp{chatID: chatID, poll: &pollUpdate{messageID: messageID, poll: poll}})
+}
+
+func (q *IngestQueue) enqueue(ctx context.Context, op *ingestOp) error {
+	q.mu.RLock()
+	if q.closed {
+		q.mu.RUnlock()
+		return ErrIngestQueueClosed
+	}
+	q.senders.Add(1)
+	q.mu.RUnlock()
+	defer q.senders.Done()
+
+	op.seq = q.begin()
+	select {
+	case q.ch <- op:
+		metrics.Set("ingest_queue_depth", float64(len(q.ch)))
+		return nil
+	default:
+	}
+
+	// 队列已满：短暂等待写入协程腾出空位，超时、ctx 结束或开始关闭时丢弃
+	metrics.Inc("ingest_queue_full_total")
+	start := time.Now()
+	defer func() {
+		metrics.Observe("ingest_queue_wait_seconds", time.Since(start).Seconds())
+	}()
+	timer := time.NewTimer(q.wait)
+	defer timer.Stop()
+	var err error
+	select {
+	case q.ch <- op:
+		metrics.Set("ingest_queue_depth", float64(len(q.ch)))
+		return nil
+	case <-timer.C:
+		err = ErrIngestQueueFull
+	case <-q.closing:
+		err = ErrIngestQueueClosed
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	q.finish(op.seq)
+	metrics.Inc("ingest_queue_dropped_total")
+	return err
+}
+
+// Drain 等待调用时已入队的操作全部写入完成，期间新入队的操作不等待，持续写入时也能返回；ctx 结束时返回其错误
+func (q *IngestQueue) Drain(ctx context.Context) error {
+	q.seqMu.Lock()
+	target := q.nextSeq
+	q.seqMu.Unlock()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		remaining := q.inflightBefore(target)
+		if remaining == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("入库队列仍有 %d 条消息未写入: %w", remaining, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// begin 分配入队序号并记为未完成
+func (q *IngestQueue) begin() uint64 {
+	q.seqMu.Lock()
+	defer q.seqMu.Unlock()
+	seq := q.nextSeq
+	q.nextSeq++
+	q.inflight[seq] = struct{}{}
+	return seq
+}
+
+// finish 操作写入完成或放弃入队
+func (q *IngestQueue) finish(seq uint64) {
+	q.seqMu.Lock()
+	delete(q.inflight, seq)
+	q.seqMu.Unlock()
+}
+
+// inflightBefore 序号小于 target 且尚未完成的操作数
+func (q *IngestQueue) inflightBefore(target uint64) int {
+	q.seqMu.Lock()
+	defer q.seqMu.Unlock()
+	n := 0
+	for seq := range q.inflight {
+		if seq < target {
+			n++
+		}
+	}
+	return n
+}
+
+// Close 停止接受新消息，等待队列中剩余的消息写入完成或 ctx 结束
+func (q *IngestQueue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.closing)
+		q.mu.Unlock()
+		// 等待空位的入队者因 closing 立即返回，之后不会再向 ch 发送
+		q.senders.Wait()
+		close(q.ch)
+	} else {
+		q.mu.Unlock()
+	}
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("入库队列仍有 %d 条消息未写入: %w", len(q.ch), ctx.Err())
+	}
+}
+
+// run 顺序写入队列中的消息，直到队列关闭且全部写入
+func (q *IngestQueue) run() {
+	defer close(q.done)
+	for op := range q.ch {
+		metrics.Set("ingest_queue_depth", float64(len(q.ch)))
+		err := q.write(op)
+		if op.purge != nil {
+			op.purge.done <- err
+		}
+		if err != nil {
+			metrics.Inc("ingest_write_errors_total")
+			switch {
+			case op.data != nil:
+				logger.Errorf("[Ingest] 保存消息失败, chat: %d, message: %d, %v", op.data.ChatID, op.data.MessageID, err)
+			case op.reactions != nil:
+				logger.Errorf("[Ingest] 更新回应数失败, chat: %d, message: %d, %v", op.chatID, op.reactions.messageID, err)
+			case op.poll != nil:
+				logger.Errorf("[Ingest] 更新投票失败, chat: %d, message: %d, %v", op.chatID, op.poll.messageID, err)
+			case op.renumber != nil:
+				logger.Errorf("[Ingest] 更新消息ID失败, chat: %d, %d -> %d, %v", op.chatID, op.renumber.oldMessageID, op.renumber.messageID, err)
+			case op.purge != nil:
+				logger.Errorf("[Ingest] 删除群组消息失败, chat: %d, %v", op.chatID, err)
+			default:
+				logger.Errorf("[Ingest] 删除消息失败, chat: %d, messages: %v, %v", op.chatID, op.deleteIDs, err)
+			}
+		}
+		q.finish(op.seq)
+	}
+}
+
+// write 执行队列中的一项；遇到锁冲突时退避重试
+func (q *IngestQueue) write(op *ingestOp) error {
+	ctx := context.Background()
+	backoff := q.backoff
+	var err error
+	for attempt := 1; attempt <= ingestRetryTimes; attempt++ {
+		err = q.apply(ctx, op)
+		if err == nil || !model.IsLockContention(err) {
+			return err
+		}
+		metrics.Inc("ingest_lock_retries_total")
+		if attempt < ingestRetryTimes {
+			logger.Debugf("[Ingest] 数据库锁冲突，%v 后重试 (第 %d/%d 次): %v", backoff, attempt, ingestRetryTimes, err)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return err
+}
+
+// apply 删除、编辑已保存的消息或更新其回应数、投票结果、消息ID，其他写入新消息
+func (q *IngestQueue) apply(ctx context.Context, op *ingestOp) error {
+	if op.renumber != nil {
+		n, err := q.store.UpdateMessageID(ctx, op.chatID, op.renumber.oldMessageID, op.renumber.messageID, op.renumber.serverMessageID)
+		if err == nil && n > 0 {
+			logger.Debugf("[Ingest] 更新消息ID: chat: %d, %d -> %d", op.chatID, op.renumber.oldMessageID, op.renumber.messageID)
+		}
+		return err
+	}
+	if op.purge != nil {
+		return q.purge(ctx, op.chatID, op.purge)
+	}
+	if op.reactions != nil {
+		_, err := q.store.UpdateReactionCount(ctx, op.chatID, op.reactions.messageID, op.reactions.count)
+		return err
+	}
+	if op.poll != nil {
+		_, err := q.store.UpdatePoll(ctx, op.chatID, op.poll.messageID, op.poll.poll)
+		return err
+	}
+	data := op.data
+	if data == nil {
+		n, err := q.store.SoftDeleteByMessageIDs(ctx, op.chatID, op.deleteIDs)
+		if err == nil && n > 0 {
+			logger.Debugf("[Ingest] 删除消息: %d -> %v, 删除 %d 条", op.chatID, op.deleteIDs, n)
+		}
+		return err
+	}
+	if data.EditedAt == nil {
+		return q.create(ctx, op)
+	}
+	n, err := q.store.UpdateContent(ctx, data)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		logger.Debugf("[Ingest] 编辑的消息未保存, 跳过: %d -> %d", data.ChatID, data.MessageID)
+		return nil
+	}
+	if q.links != nil {
+		// 消息已更新，链接替换失败只记录日志，不重试
+		if _, err := q.links.ReplaceForMessage(ctx, data); err != nil {
+			logger.Warnf("[Ingest] 更新链接失败, chat: %d, message: %d, %v", data.ChatID, data.MessageID, err)
+		}
+	}
+	return nil
+}
+
+// create 检查消息是否已保存，未保存且未超过每小时上限时写入消息及其中分享的链接
+func (q *IngestQueue) create(ctx context.Context, op *ingestOp) error {
+	data := op.data
+	exists, err := q.store.Exists(ctx, data.ChatID, data.MessageID)
+	if err != nil {
+		return fmt.Errorf("查询消息失败: %w", err)
+	}
+	if exists {
+		logger.Debugf("[Ingest] 消息已保存, 跳过: %d -> %d", data.ChatID, data.MessageID)
+		return nil
+	}
+	// 事件不计入每小时上限
+	if q.sampler != nil && !op.allowed && !model.IsEvent(data.ContentType) {
+		if q.sampler.NeedsSeed(data.SentAt) {
+			hour := data.SentAt.Truncate(time.Hour)
+			counts, err := q.store.CountByChat(ctx, hour, hour.Add(time.Hour))
+			if err != nil {
+				return fmt.Errorf("查询消息数失败: %w", err)
+			}
+			q.sampler.Seed(hour, counts)
+		}
+		if !q.sampler.Allow(data.ChatID, data.SentAt) {
+			return nil
+		}
+		op.allowed = true
+	}
+	if _, err := q.store.Create(ctx, data); err != nil {
+		return err
+	}
+	if q.links != nil && len(data.Links) > 0 {
+		// 消息已写入，重试时会因消息已保存而跳过，链接保存失败只记录日志
+		if _, err := q.links.CreateForMessage(ctx, data); err != nil {
+			logger.Warnf("[Ingest] 保存链接失败, chat: %d, message: %d, %v", data.ChatID, data.MessageID, err)
+		}
+	}
+	return nil
+}
+
+// purge 删除群组（区间内）分享的链接并软删除消息；先删除链接，锁冲突重试时消息的删除数不会被重试覆盖为 0
+func (q *IngestQueue) purge(ctx context.Context, chatID int64, req *purgeRequest) error {
+	whole := req.start.IsZero() && req.end.IsZero()
+	if q.links != nil {
+		var err error
+		if whole {
+			_, err = q.links.DeleteByChat(ctx, chatID)
+		} else {
+			_, err = q.links.DeleteByChatRange(ctx, chatID, req.start, req.end)
+		}
+		if err != nil {
+			return fmt.Errorf("删除链接失败: %w", err)
+		}
+	}
+
+	var err error
+	if whole {
+		req.deleted, err = q.store.SoftDeleteByChat(ctx, chatID)
+	} else {
+		req.deleted, err = q.store.SoftDeleteByChatRange(ctx, chatID, req.start, req.end)
+	}
+	return err
+}
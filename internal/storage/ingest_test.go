@@ -0,0 +1,389 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ingestStore 入库队列测试用的消息存储：前 busy 次写入返回锁冲突，gate 非空时每次写入前等待
+type ingestStore struct {
+	MessageStore
+	gate chan struct{}
+
+	mu        sync.Mutex
+	busy      int
+	saved     []int64
+	edited    []string
+	deleted   []int64
+	reactions map[int64]int64
+	polls     map[int64]*model.Poll
+	counts    map[int64]int
+}
+
+func (s *ingestStore) CountByChat(ctx context.Context, startTime, endTime time.Time) (map[int64]int, error) {
+	return s.counts, nil
+}
+
+func (s *ingestStore) Exists(ctx context.Context, chatID, messageID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range s.saved {
+		if id == messageID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *ingestStore) Create(ctx context.Context, data *model.MessageData) (*ent.Message, error) {
+	if s.gate != nil {
+		<-s.gate
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.busy > 0 {
+		s.busy--
+		return nil, sqlite3.Error{Code: sqlite3.ErrBusy}
+	}
+	s.saved = append(s.saved, data.MessageID)
+	return &ent.Message{MessageID: data.MessageID, ChatID: data.ChatID}, nil
+}
+
+func (s *ingestStore) UpdateContent(ctx context.Context, data *model.MessageData) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range s.saved {
+		if id == data.MessageID {
+			s.edited = append(s.edited, data.Text)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (s *ingestStore) UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !slices.Contains(s.saved, messageID) {
+		return 0, nil
+	}
+	if s.reactions == nil {
+		s.reactions = make(map[int64]int64)
+	}
+	s.reactions[messageID] = count
+	return 1, nil
+}
+
+func (s *ingestStore) UpdatePoll(ctx context.Context, chatID, messageID int64, poll *model.Poll) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !slices.Contains(s.saved, messageID) {
+		return 0, nil
+	}
+	if s.polls == nil {
+		s.polls = make(map[int64]*model.Poll)
+	}
+	s.polls[messageID] = poll
+	return 1, nil
+}
+
+func (s *ingestStore) SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, id := range messageIDs {
+		if slices.Contains(s.saved, id) {
+			s.deleted = append(s.deleted, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (s *ingestStore) UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := slices.Index(s.saved, oldMessageID)
+	if i < 0 {
+		return 0, nil
+	}
+	s.saved[i] = messageID
+	return 1, nil
+}
+
+func (s *ingestStore) SoftDeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, s.saved...)
+	return len(s.saved), nil
+}
+
+func (s *ingestStore) savedIDs() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64(nil), s.saved...)
+}
+
+func TestIngestQueue(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{busy: 2}
+	q := NewIngestQueue(store, 10)
+	q.backoff = time.Millisecond
+	q.Start()
+
+	for _, id := range []int64{1, 2, 1} {
+		require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: id}))
+	}
+	require.NoError(t, q.Close(ctx))
+	assert.Equal(t, []int64{1, 2}, store.savedIDs(), "锁冲突时重试，重复推送的消息只保存一次")
+	assert.Equal(t, float64(2), metrics.Snapshot()["ingest_lock_retries_total"])
+
+	assert.ErrorIs(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 3}), ErrIngestQueueClosed)
+}
+
+func TestIngestQueue_Edit(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+
+	editedAt := time.Now()
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Text: "周四发布"}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Text: "改到周五发布", EditedAt: &editedAt}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 2, Text: "未保存", EditedAt: &editedAt}))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, []int64{1}, store.savedIDs(), "编辑不写入新消息")
+	assert.Equal(t, []string{"改到周五发布"}, store.edited, "按入队顺序在原消息写入之后更新")
+}
+
+func TestIngestQueue_Delete(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 2}))
+	require.NoError(t, q.EnqueueDelete(ctx, -100, []int64{1, 3}))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, []int64{1}, store.deleted, "按入队顺序在原消息写入之后删除")
+}
+
+func TestIngestQueue_MessageID(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1}))
+	require.NoError(t, q.EnqueueMessageID(ctx, -100, 1, 1<<20, 1))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, []int64{1 << 20}, store.savedIDs(), "按入队顺序在临时消息写入之后更新消息ID")
+}
+
+func TestIngestQueue_Purge(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{gate: make(chan struct{})}
+	links := &ingestLinks{}
+	q := NewIngestQueue(store, 10)
+	q.SetLinkStore(links)
+	q.Start()
+	defer q.Close(ctx)
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Links: []string{"https://go.dev"}}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 2}))
+	close(store.gate)
+
+	deleted, err := q.Purge(ctx, -100, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted, "已入队的消息先写入再删除")
+	assert.Equal(t, []int64{1, 2}, store.deleted)
+	assert.Empty(t, links.saved, "已入队消息中的链接同样先保存再删除")
+}
+
+func TestIngestQueue_Reactions(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1}))
+	require.NoError(t, q.EnqueueReactions(ctx, -100, 1, 3))
+	require.NoError(t, q.EnqueueReactions(ctx, -100, 1, 5))
+	require.NoError(t, q.EnqueueReactions(ctx, -100, 2, 1))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, map[int64]int64{1: 5}, store.reactions, "按入队顺序更新，未保存的消息忽略")
+}
+
+func TestIngestQueue_Poll(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+
+	closed := &model.Poll{Question: "周会时间", Options: []model.PollOption{{Text: "周三", Voters: 3}}, TotalVoters: 3, Closed: true}
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1}))
+	require.NoError(t, q.EnqueuePoll(ctx, -100, 1, &model.Poll{Question: "周会时间", TotalVoters: 1}))
+	require.NoError(t, q.EnqueuePoll(ctx, -100, 1, closed))
+	require.NoError(t, q.EnqueuePoll(ctx, -100, 2, closed))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, map[int64]*model.Poll{1: closed}, store.polls, "按入队顺序更新，未保存的消息忽略")
+}
+
+// ingestLinks 记录保存链接的 LinkStore
+type ingestLinks struct {
+	saved map[int64][]string
+}
+
+func (l *ingestLinks) CreateForMessage(ctx context.Context, data *model.MessageData) (int, error) {
+	if l.saved == nil {
+		l.saved = make(map[int64][]string)
+	}
+	l.saved[data.MessageID] = data.Links
+	return len(data.Links), nil
+}
+
+func (l *ingestLinks) DeleteByChat(ctx context.Context, chatID int64) (int, error) {
+	n := len(l.saved)
+	l.saved = nil
+	return n, nil
+}
+
+func (l *ingestLinks) DeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error) {
+	return l.DeleteByChat(ctx, chatID)
+}
+
+func (l *ingestLinks) ReplaceForMessage(ctx context.Context, data *model.MessageData) (int, error) {
+	delete(l.saved, data.MessageID)
+	if len(data.Links) == 0 {
+		return 0, nil
+	}
+	return l.CreateForMessage(ctx, data)
+}
+
+func TestIngestQueue_Links(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{}
+	links := &ingestLinks{}
+	q := NewIngestQueue(store, 10)
+	q.SetLinkStore(links)
+	q.Start()
+
+	editedAt := time.Now()
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Links: []string{"https://go.dev"}}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Links: []string{"https://go.dev"}}))
+	require.NoError(t, q.Drain(ctx))
+	assert.Equal(t, map[int64][]string{1: {"https://go.dev"}}, links.saved, "重复推送不重复保存")
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, Links: []string{"https://example.com"}, EditedAt: &editedAt}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 2, Links: []string{"https://example.org"}, EditedAt: &editedAt}))
+	require.NoError(t, q.Drain(ctx))
+	assert.Equal(t, map[int64][]string{1: {"https://example.com"}}, links.saved, "编辑后替换已保存消息的链接，未保存的消息不保存链接")
+
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, EditedAt: &editedAt}))
+	require.NoError(t, q.Close(ctx))
+	assert.Empty(t, links.saved, "编辑后不再包含链接时删除")
+}
+
+func TestIngestQueue_Drain(t *testing.T) {
+	store := &ingestStore{gate: make(chan struct{})}
+	q := NewIngestQueue(store, 10)
+	q.Start()
+	ctx := context.Background()
+
+	// 写入协程阻塞在第 1 条
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{MessageID: 1}))
+	drained := make(chan error, 1)
+	go func() { drained <- q.Drain(ctx) }()
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{MessageID: 2}))
+
+	store.gate <- struct{}{}
+	select {
+	case err := <-drained:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Drain 等待了开始之后入队的消息")
+	}
+	assert.Equal(t, []int64{1}, store.savedIDs())
+
+	close(store.gate)
+	require.NoError(t, q.Close(ctx))
+	assert.Equal(t, []int64{1, 2}, store.savedIDs())
+}
+
+func TestIngestQueue_Backpressure(t *testing.T) {
+	store := &ingestStore{gate: make(chan struct{})}
+	q := NewIngestQueue(store, 1)
+	q.Start()
+
+	// 写入协程阻塞在第 1 条，第 2 条占满队列
+	require.NoError(t, q.Enqueue(context.Background(), &model.MessageData{MessageID: 1}))
+	require.Eventually(t, func() bool { return len(q.ch) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Enqueue(context.Background(), &model.MessageData{MessageID: 2}))
+
+	// ctx 不会结束（与 TDLib 更新循环相同），等待超时后丢弃
+	q.wait = 20 * time.Millisecond
+	start := time.Now()
+	assert.ErrorIs(t, q.Enqueue(context.Background(), &model.MessageData{MessageID: 3}), ErrIngestQueueFull, "队列已满时等待超时后丢弃")
+	assert.Less(t, time.Since(start), time.Second, "不会无限期阻塞调用方")
+
+	q.wait = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.Enqueue(ctx, &model.MessageData{MessageID: 4}), context.DeadlineExceeded, "ctx 先结束时返回其错误")
+
+	close(store.gate)
+	require.NoError(t, q.Drain(context.Background()), "等待已入队的消息写入完成")
+	assert.Equal(t, []int64{1, 2}, store.savedIDs())
+	require.NoError(t, q.Close(context.Background()))
+
+	snapshot := metrics.Snapshot()
+	assert.GreaterOrEqual(t, snapshot["ingest_queue_full_total"], float64(1))
+	assert.GreaterOrEqual(t, snapshot["ingest_queue_dropped_total"], float64(1))
+	assert.GreaterOrEqual(t, snapshot["ingest_queue_wait_seconds_count"], float64(1))
+}
+
+func TestIngestQueue_CloseWhileFull(t *testing.T) {
+	store := &ingestStore{gate: make(chan struct{})}
+	q := NewIngestQueue(store, 1)
+	q.wait = time.Minute
+	q.Start()
+	defer close(store.gate)
+
+	require.NoError(t, q.Enqueue(context.Background(), &model.MessageData{MessageID: 1}))
+	require.Eventually(t, func() bool { return len(q.ch) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Enqueue(context.Background(), &model.MessageData{MessageID: 2}))
+
+	// 第 3 条等待空位时开始关闭
+	enqueued := make(chan error, 1)
+	go func() { enqueued <- q.Enqueue(context.Background(), &model.MessageData{MessageID: 3}) }()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.ErrorIs(t, q.Close(ctx), context.DeadlineExceeded, "写入协程仍阻塞，等待到 ctx 结束")
+	assert.Less(t, time.Since(start), time.Second, "等待空位的入队者不阻塞关闭")
+	select {
+	case err := <-enqueued:
+		assert.ErrorIs(t, err, ErrIngestQueueClosed)
+	case <-time.After(time.Second):
+		t.Fatal("关闭后入队者仍在等待")
+	}
+}
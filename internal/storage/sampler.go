@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+)
+
+// IngestSampler 限制每个群组每小时（按消息发送时间）保存的新消息数（Ingest.RateLimit）。
+// first 模式保留每小时最先的 maxPerHour 条；sample 模式按上一小时的消息量计算间隔，每隔若干条保留一条，
+// 使保存的消息分布在整个小时内，仍不超过 maxPerHour 条。计数保存在内存中，每个小时首次计数前由入库队列以数据库中
+// 已保存的消息数补齐（Seed），重启后不会重新计数；仅由入库队列的写入协程调用
+type IngestSampler struct {
+	maxPerHour int
+	sample     bool
+
+	hours  map[chatHour]*hourCount
+	seeded map[time.Time]bool // 已补齐计数的小时
+	latest time.Time          // 已计数的最新小时，用于清理过期的计数
+}
+
+// chatHour 群组及小时（整点）
+type chatHour struct {
+	chatID int64
+	hour   time.Time
+}
+
+// hourCount 群组在一小时内收到及保存的新消息数
+type hourCount struct {
+	seen int
+	kept int
+}
+
+// NewIngestSampler 创建每个群组每小时最多保存 maxPerHour 条消息的采样器，mode 为 first 或 sample
+func NewIngestSampler(maxPerHour int, mode string) *IngestSampler {
+	return &IngestSampler{
+		maxPerHour: maxPerHour,
+		sample:     mode == "sample",
+		hours:      make(map[chatHour]*hourCount),
+		seeded:     make(map[time.Time]bool),
+	}
+}
+
+// NeedsSeed 判断 sentAt 所在的小时是否尚未补齐计数
+func (s *IngestSampler) NeedsSeed(sentAt time.Time) bool {
+	return !s.seeded[sentAt.Truncate(time.Hour)]
+}
+
+// Seed 以数据库中已保存的消息数（群组ID => 消息数）补齐 sentAt 所在小时的计数。
+// 被舍弃的消息未保存，因此补齐后 sample 模式的采样间隔只按已保存的消息数计算
+func (s *IngestSampler) Seed(sentAt time.Time, counts map[int64]int) {
+	hour := sentAt.Truncate(time.Hour)
+	s.seeded[hour] = true
+	for chatID, n := range counts {
+		key := chatHour{chatID: chatID, hour: hour}
+		if s.hours[key] == nil {
+			s.hours[key] = &hourCount{seen: n, kept: n}
+		}
+	}
+}
+
+// Allow 判断群组在 sentAt 发送的新消息是否保存，每条新消息只调用一次
+func (s *IngestSampler) Allow(chatID int64, sentAt time.Time) bool {
+	key := chatHour{chatID: chatID, hour: sentAt.Truncate(time.Hour)}
+	count := s.hours[key]
+	if count == nil {
+		s.prune(key.hour)
+		count = &hourCount{}
+		s.hours[key] = count
+	}
+	count.seen++
+
+	keep := count.kept < s.maxPerHour && (count.seen-1)%s.stride(key) == 0
+	if !keep {
+		metrics.Inc("ingest_sampled_out_total")
+		if count.seen-count.kept == 1 {
+			logger.Infof("[Ingest] 群组 %d 在 %s 的消息超过每小时 %d 条的上限，超出的消息不再全部保存",
+				chatID, key.hour.Local().Format("2006-01-02 15:04"), s.maxPerHour)
+		}
+		return false
+	}
+	count.kept++
+	return true
+}
+
+// stride sample 模式下的采样间隔：上一小时的消息量除以上限（向上取整），上一小时未超过上限或无记录时为 1
+func (s *IngestSampler) stride(key chatHour) int {
+	if !s.sample {
+		return 1
+	}
+	prev := s.hours[chatHour{chatID: key.chatID, hour: key.hour.Add(-time.Hour)}]
+	if prev == nil || prev.seen <= s.maxPerHour {
+		return 1
+	}
+	return (prev.seen + s.maxPerHour - 1) / s.maxPerHour
+}
+
+// prune 清理两小时前的计数（补录的历史消息按小时集中写入，其计数在之后新的小时开始时清理）
+func (s *IngestSampler) prune(hour time.Time) {
+	if hour.After(s.latest) {
+		s.latest = hour
+	}
+	expire := s.latest.Add(-2 * time.Hour)
+	for key := range s.hours {
+		if key.hour.Before(expire) {
+			delete(s.hours, key)
+		}
+	}
+	for hour := range s.seeded {
+		if hour.Before(expire) {
+			delete(s.seeded, hour)
+		}
+	}
+}
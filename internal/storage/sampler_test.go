@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngestSampler(t *testing.T) {
+	hour := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	kept := func(s *IngestSampler, chatID int64, start time.Time, n int) []int {
+		var ids []int
+		for i := 0; i < n; i++ {
+			if s.Allow(chatID, start.Add(time.Duration(i)*time.Second)) {
+				ids = append(ids, i)
+			}
+		}
+		return ids
+	}
+
+	t.Run("保留最先的消息", func(t *testing.T) {
+		s := NewIngestSampler(3, "first")
+		assert.Equal(t, []int{0, 1, 2}, kept(s, -100, hour, 10))
+		assert.Equal(t, []int{0, 1, 2}, kept(s, -200, hour, 5), "每个群组分别计数")
+		assert.Equal(t, []int{0, 1, 2}, kept(s, -100, hour.Add(time.Hour), 10), "每小时重新计数")
+	})
+
+	t.Run("按上一小时的消息量采样", func(t *testing.T) {
+		s := NewIngestSampler(3, "sample")
+		assert.Equal(t, []int{0, 1, 2}, kept(s, -100, hour, 9), "没有上一小时的记录时保留最先的消息")
+		assert.Equal(t, []int{0, 3, 6}, kept(s, -100, hour.Add(time.Hour), 10), "上一小时 9 条，每 3 条保留 1 条")
+		assert.Equal(t, []int{0, 4, 8}, kept(s, -100, hour.Add(2*time.Hour), 10))
+		assert.Equal(t, []int{0, 1}, kept(s, -100, hour.Add(4*time.Hour), 2), "上一小时无记录")
+	})
+
+	t.Run("清理过期的计数", func(t *testing.T) {
+		s := NewIngestSampler(3, "first")
+		kept(s, -100, hour, 1)
+		kept(s, -100, hour.Add(3*time.Hour), 1)
+		assert.Len(t, s.hours, 1)
+	})
+
+	t.Run("以已保存的消息数补齐计数", func(t *testing.T) {
+		s := NewIngestSampler(3, "first")
+		assert.True(t, s.NeedsSeed(hour.Add(10*time.Minute)))
+		s.Seed(hour, map[int64]int{-100: 2})
+		assert.False(t, s.NeedsSeed(hour.Add(10*time.Minute)))
+		assert.Equal(t, []int{0}, kept(s, -100, hour, 5), "重启前已保存 2 条")
+		assert.Equal(t, []int{0, 1, 2}, kept(s, -200, hour, 5))
+	})
+}
+
+func TestIngestQueue_Sampler(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{busy: 1}
+	q := NewIngestQueue(store, 10)
+	q.backoff = time.Millisecond
+	q.SetSampler(NewIngestSampler(2, "first"))
+	q.Start()
+
+	sentAt := time.Now()
+	for _, id := range []int64{1, 1, 2, 3} {
+		require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: id, SentAt: sentAt}))
+	}
+	require.NoError(t, q.Close(ctx))
+	assert.Equal(t, []int64{1, 2}, store.savedIDs(), "重试及重复推送不重复计数")
+}
+
+func TestIngestQueue_SamplerSeed(t *testing.T) {
+	ctx := context.Background()
+	store := &ingestStore{counts: map[int64]int{-100: 1}}
+	q := NewIngestQueue(store, 10)
+	q.SetSampler(NewIngestSampler(2, "first"))
+	q.Start()
+
+	sentAt := time.Now()
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 1, SentAt: sentAt}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 2, SentAt: sentAt, ContentType: "sticker"}))
+	require.NoError(t, q.Enqueue(ctx, &model.MessageData{ChatID: -100, MessageID: 3, SentAt: sentAt}))
+	require.NoError(t, q.Close(ctx))
+	assert.Equal(t, []int64{1, 2}, store.savedIDs(), "重启前已保存 1 条，事件不计数")
+}
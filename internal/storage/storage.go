@@ -0,0 +1,133 @@
+// Package storage 定义消息、总结任务和运行记录的存储接口，默认实现为 model 包中基于 ent（SQLite）的模型。
+// 接入其他后端（如消息使用 ClickHouse、任务队列状态使用 Redis）时实现对应接口，
+// 并在 svc.ServiceContext 中替换即可，调用方无需修改
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/ent/dailyrun"
+	"github.com/fachebot/talk-trace-bot/internal/ent/task"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// MessageStore 群聊消息存储：入库、按区间查询、过期软删除及物理清除
+type MessageStore interface {
+	// Create 保存消息
+	Create(ctx context.Context, data *model.MessageData) (*ent.Message, error)
+	// Exists 判断消息是否已保存
+	Exists(ctx context.Context, chatID, messageID int64) (bool, error)
+	// UpdateMessageID 消息发送成功后将临时消息ID更新为正式ID，返回更新的条数
+	UpdateMessageID(ctx context.Context, chatID, oldMessageID, messageID, serverMessageID int64) (int, error)
+	// UpdateContent 消息被编辑后更新已保存的文本、语言、内容类型和编辑时间，返回更新的条数
+	UpdateContent(ctx context.Context, data *model.MessageData) (int, error)
+	// UpdateReactionCount 消息的回应变化后更新已保存的回应总数，返回更新的条数
+	UpdateReactionCount(ctx context.Context, chatID, messageID, count int64) (int, error)
+	// UpdatePoll 投票的得票情况变化后更新已保存的投票，返回更新的条数
+	UpdatePoll(ctx context.Context, chatID, messageID int64, poll *model.Poll) (int, error)
+
+	// GetByDateRangeAndChat 查询群组时间区间 [startTime, endTime) 内的消息（不含事件），按发送时间排序
+	GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error)
+	// GetEventsByDateRangeAndChat 查询群组时间区间内记录的事件（Ingest.RecordEvents 记录的非文字消息），按发送时间排序。
+	// 事件只有发送者、类型（ContentType）和时间，其他查询均不返回事件
+	GetEventsByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error)
+	// GetBySenderAndDateRange 查询发送者在时间区间内于所有群组的消息
+	GetBySenderAndDateRange(ctx context.Context, senderID int64, startTime, endTime time.Time) ([]*ent.Message, error)
+	// GetMentionCandidates 查询时间区间内其他成员发送的、包含 keyword（不区分大小写）的消息，按发送时间倒序
+	GetMentionCandidates(ctx context.Context, senderID int64, keyword string, startTime, endTime time.Time, limit int) ([]*ent.Message, error)
+	// GetChatIDsByDateRange 查询时间区间内有消息的群组ID
+	GetChatIDsByDateRange(ctx context.Context, startTime, endTime time.Time) ([]int64, error)
+	// CountByChat 查询时间区间内各群组的消息数：群组ID => 消息数
+	CountByChat(ctx context.Context, startTime, endTime time.Time) (map[int64]int, error)
+	// GetSenderIDs 查询所有发言用户ID
+	GetSenderIDs(ctx context.Context) ([]int64, error)
+
+	// SoftDeleteBefore 软删除发送时间早于 cutoffDate 的消息，每次最多 limit 条
+	SoftDeleteBefore(ctx context.Context, cutoffDate time.Time, limit int) (int, error)
+	// SoftDeleteByChat 软删除群组的全部消息
+	SoftDeleteByChat(ctx context.Context, chatID int64) (int, error)
+	// SoftDeleteByChatRange 软删除群组时间区间 [startTime, endTime) 内的消息
+	SoftDeleteByChatRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (int, error)
+	// SoftDeleteByMessageIDs 软删除群组中指定 TDLib 消息ID 的消息（消息在 Telegram 中被删除）
+	SoftDeleteByMessageIDs(ctx context.Context, chatID int64, messageIDs []int64) (int, error)
+	// PurgeDeleted 物理删除已软删除的消息，每次最多 limit 条
+	PurgeDeleted(ctx context.Context, limit int) (int, error)
+}
+
+// TaskStore 群组总结任务存储：任务状态流转、待发送摘要及结构化总结结果
+type TaskStore interface {
+	// GetOrCreateTask 获取群组日期范围指定类型的任务，不存在时以 status 创建
+	GetOrCreateTask(ctx context.Context, chatID int64, startTime, endTime time.Time, kind task.Kind, status task.Status) (*ent.Task, error)
+	// GetTask 按ID获取任务，不存在时返回 *ent.NotFoundError
+	GetTask(ctx context.Context, taskID int) (*ent.Task, error)
+	// GetPendingOrProcessingTasks 查询未完成的任务（启动恢复）
+	GetPendingOrProcessingTasks(ctx context.Context) ([]*ent.Task, error)
+	// GetRecentTasksByChat 查询群组最近的任务，按开始时间倒序
+	GetRecentTasksByChat(ctx context.Context, chatID int64, limit int) ([]*ent.Task, error)
+	// GetActivityHistory 查询群组在 [since, before) 内开始、已记录活跃度的任务，按开始时间倒序，用于活跃度基线
+	GetActivityHistory(ctx context.Context, chatID int64, since, before time.Time) ([]*ent.Task, error)
+	// GetSummarizedByRange 查询开始时间在区间内、已保存结构化总结结果的任务
+	GetSummarizedByRange(ctx context.Context, startTime, endTime time.Time) ([]*ent.Task, error)
+	// GetSummarizedByChatAndRange 查询群组开始时间在区间内、已保存结构化总结结果的任务
+	GetSummarizedByChatAndRange(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Task, error)
+
+	// UpdateTaskStatus 更新任务状态及错误信息
+	UpdateTaskStatus(ctx context.Context, taskID int, status task.Status, errorMsg *string) error
+	// MarkTaskCompleted 标记任务完成
+	MarkTaskCompleted(ctx context.Context, taskID int) error
+	// MarkTaskFailed 标记任务失败
+	MarkTaskFailed(ctx context.Context, taskID int, errorMsg string) error
+	// MarkTaskBlocked 标记任务因无权在群组发送消息而放弃，不再重试
+	MarkTaskBlocked(ctx context.Context, taskID int, errorMsg string) error
+	// ResetTaskToPending 将任务重置为待处理
+	ResetTaskToPending(ctx context.Context, taskID int) error
+
+	// SetSummaryContent 保存待发送的摘要内容
+	SetSummaryContent(ctx context.Context, taskID int, content string) error
+	// ReplacePendingSummaryContent 替换尚未发送成功的摘要内容，返回是否替换
+	ReplacePendingSummaryContent(ctx context.Context, taskID int, content string) (bool, error)
+	// ClearSummaryContent 发送成功后清除摘要内容
+	ClearSummaryContent(ctx context.Context, taskID int) error
+	// SetSummaryJSON 保存结构化总结结果（JSON）
+	SetSummaryJSON(ctx context.Context, taskID int, data string) error
+	// SetPromptBudget 保存最近一次总结的 prompt token 构成（JSON）
+	SetPromptBudget(ctx context.Context, taskID int, data string) error
+	// SetProgress 保存总结进度：已完成的 chunk 数、chunk 总数及已完成部分的估算 tokens
+	SetProgress(ctx context.Context, taskID int, chunk, chunks, tokens int) error
+	// SetActivity 保存参与总结的消息数和发言人数
+	SetActivity(ctx context.Context, taskID int, messages, participants int) error
+}
+
+// DailyRunStore 总结运行记录存储：运行状态、统计及多实例互斥租约
+type DailyRunStore interface {
+	// Create 创建运行记录
+	Create(ctx context.Context, window string, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error)
+	// GetOrCreate 获取窗口日期范围的运行记录，不存在时以 status 创建
+	GetOrCreate(ctx context.Context, window string, startTime, endTime time.Time, status dailyrun.Status) (*ent.DailyRun, error)
+	// Get 按ID获取运行记录
+	Get(ctx context.Context, id int) (*ent.DailyRun, error)
+	// GetByDateRange 按日期范围获取运行记录，不存在时返回 *ent.NotFoundError
+	GetByDateRange(ctx context.Context, startTime, endTime time.Time) (*ent.DailyRun, error)
+	// GetIncompleteRuns 查询未完成的运行记录（启动恢复）
+	GetIncompleteRuns(ctx context.Context) ([]*ent.DailyRun, error)
+
+	// MarkCompleted 标记运行完成
+	MarkCompleted(ctx context.Context, id int) error
+	// MarkFailed 标记运行失败
+	MarkFailed(ctx context.Context, id int, errorMsg string) error
+	// SaveStats 保存运行统计
+	SaveStats(ctx context.Context, id int, stats *model.RunStats) error
+
+	// AcquireLease 获取运行租约：无人持有、租约已过期或已由 owner 持有时成功（同时续期）
+	AcquireLease(ctx context.Context, id int, owner string, ttl time.Duration) (bool, error)
+	// ReleaseLease 释放运行租约
+	ReleaseLease(ctx context.Context, id int, owner string) error
+}
+
+var (
+	_ MessageStore  = (*model.MessageModel)(nil)
+	_ TaskStore     = (*model.TaskModel)(nil)
+	_ DailyRunStore = (*model.DailyRunModel)(nil)
+)
@@ -0,0 +1,75 @@
+package summarizer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+)
+
+// itemLength 子项格式化后的长度（字节），与拆分通知时的计算方式一致
+func itemLength(item TopicSubItem, chatID int64) int {
+	var sb strings.Builder
+	writeItem(&sb, item, chatID)
+	return sb.Len()
+}
+
+// CompressOversized 压缩格式化后超过 limit 字节的子项描述，使通知拆分后每段都不超过单条消息长度。
+// 未设置压缩器、压缩失败或压缩后仍过长时截断描述并以省略号结尾，返回被压缩或截断的子项数
+func (s *Summarizer) CompressOversized(ctx context.Context, result *SummaryResult, chatID int64, limit int) int {
+	if result == nil {
+		return 0
+	}
+	g := s.glossaryFor(chatID)
+	if g != nil {
+		ctx = llm.WithGlossary(ctx, g.terms)
+	}
+
+	count := 0
+	for i := range result.Topics {
+		for j := range result.Topics[i].Items {
+			item := &result.Topics[i].Items[j]
+			if itemLength(*item, chatID) <= limit {
+				continue
+			}
+			count++
+
+			if s.compressor != nil {
+				compressed, err := s.compressor.CompressText(ctx, item.Description, s.compressRunes)
+				switch {
+				case err != nil:
+					logger.Warnf("[Summarizer] 群组 %d: 压缩过长的子项失败: %v", chatID, err)
+				case compressed != "":
+					if g != nil {
+						compressed = g.normalize(compressed)
+					}
+					item.Description = compressed
+				}
+			}
+
+			if itemLength(*item, chatID) > limit {
+				item.Description = truncateToFit(*item, chatID, limit)
+				logger.Warnf("[Summarizer] 群组 %d: 话题「%s」的子项仍超过单条消息长度，已截断", chatID, result.Topics[i].Title)
+			} else {
+				logger.Infof("[Summarizer] 群组 %d: 已压缩话题「%s」中过长的子项", chatID, result.Topics[i].Title)
+			}
+		}
+	}
+	return count
+}
+
+// truncateToFit 逐步截短子项描述并以省略号结尾，直到格式化后不超过 limit 字节（消息链接和引用本身过长时描述仅剩省略号）
+func truncateToFit(item TopicSubItem, chatID int64, limit int) string {
+	runes := []rune(item.Description)
+	for n := len(runes); n > 0; {
+		over := itemLength(item, chatID) - limit
+		if over <= 0 {
+			break
+		}
+		// 每个字转义后最多占 6 字节，按超出量估算本轮去掉的字数
+		n = max(n-max(1, over/6), 0)
+		item.Description = string(runes[:n]) + "…"
+	}
+	return item.Description
+}
@@ -0,0 +1,71 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCompressor struct {
+	text     string
+	err      error
+	maxRunes int
+	calls    int
+}
+
+func (m *mockCompressor) CompressText(ctx context.Context, text string, maxRunes int) (string, error) {
+	m.calls++
+	m.maxRunes = maxRunes
+	return m.text, m.err
+}
+
+func TestCompressOversized(t *testing.T) {
+	const limit = 300
+	long := strings.Repeat("讨论了发布流程", 30)
+
+	tests := []struct {
+		name       string
+		compressor *mockCompressor
+		want       string // 为空时只检查长度和省略号
+		wantCount  int
+	}{
+		{"压缩后不超过上限", &mockCompressor{text: "建议周三发布"}, "建议周三发布", 1},
+		{"压缩失败时截断", &mockCompressor{err: errors.New("timeout")}, "", 1},
+		{"压缩后仍过长时截断", &mockCompressor{text: long + long}, "", 1},
+		{"未设置压缩器时截断", nil, "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{}
+			if tt.compressor != nil {
+				s.SetCompressor(tt.compressor, 100)
+			}
+			result := &SummaryResult{Topics: []TopicItem{{
+				Title: "发布",
+				Items: []TopicSubItem{
+					{SenderName: "Alice", Description: "同意"},
+					{SenderName: "Bob", Description: long},
+				},
+			}}}
+
+			count := s.CompressOversized(context.Background(), result, -1001234567890, limit)
+			assert.Equal(t, tt.wantCount, count)
+			assert.Equal(t, "同意", result.Topics[0].Items[0].Description, "未超过上限的子项不变")
+
+			item := result.Topics[0].Items[1]
+			assert.LessOrEqual(t, itemLength(item, -1001234567890), limit)
+			if tt.want != "" {
+				assert.Equal(t, tt.want, item.Description)
+			} else {
+				assert.True(t, strings.HasSuffix(item.Description, "…"), "截断时以省略号结尾")
+			}
+			if tt.compressor != nil {
+				assert.Equal(t, 1, tt.compressor.calls)
+				assert.Equal(t, 100, tt.compressor.maxRunes, "提示词使用配置的字数上限")
+			}
+		})
+	}
+}
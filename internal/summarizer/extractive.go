@@ -0,0 +1,157 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+)
+
+// ExtractiveEngine 本地抽取式总结引擎名称，LLM 不可用时作为降级
+const ExtractiveEngine = "extractive"
+
+const (
+	extractiveMaxMessages = 10  // 最多摘录的消息数
+	extractiveMaxRunes    = 120 // 单条摘录的最大字数
+	extractiveMinRunes    = 4   // 过短的消息（如 "ok"、"好的"）不参与摘录
+)
+
+// extractiveStopWords 常见无意义词，不计入词频
+var extractiveStopWords = map[string]bool{
+	"the": true, "a": true, "an": true, "is": true, "are": true, "to": true, "of": true, "and": true, "in": true, "it": true,
+	"我们": true, "你们": true, "他们": true, "这个": true, "那个": true, "就是": true, "可以": true, "没有": true, "什么": true, "一个": true,
+}
+
+// extractiveSummarizer 基于词频的抽取式总结：按消息所含高频词的平均得分挑选最具代表性的消息，
+// 按发言者归组输出为单个话题，格式与 LLM 返回的 JSON 一致
+type extractiveSummarizer struct{}
+
+// NewExtractiveSummarizer 创建本地抽取式总结引擎
+func NewExtractiveSummarizer() SummaryEngine {
+	return extractiveSummarizer{}
+}
+
+func (extractiveSummarizer) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	// 统计词频
+	freq := make(map[string]int)
+	tokens := make([][]string, len(messages))
+	for i, m := range messages {
+		if len([]rune(strings.TrimSpace(m.Text))) < extractiveMinRunes {
+			continue
+		}
+		tokens[i] = tokenize(m.Text)
+		for _, t := range tokens[i] {
+			freq[t]++
+		}
+	}
+
+	// 消息得分：所含词的平均词频（同一消息内去重），避免长消息天然占优
+	type scored struct {
+		index int
+		score float64
+	}
+	candidates := make([]scored, 0, len(messages))
+	for i, ts := range tokens {
+		if len(ts) == 0 {
+			continue
+		}
+		seen := make(map[string]bool, len(ts))
+		total := 0
+		for _, t := range ts {
+			if !seen[t] {
+				seen[t] = true
+				total += freq[t]
+			}
+		}
+		candidates = append(candidates, scored{index: i, score: float64(total) / float64(len(seen))})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	if len(candidates) > extractiveMaxMessages {
+		candidates = candidates[:extractiveMaxMessages]
+	}
+	// 恢复时间顺序
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].index < candidates[j].index })
+
+	// 按发言者归组
+	topic := TopicItem{Title: "消息摘录", Items: []TopicSubItem{}}
+	senderIdx := make(map[string]int)
+	for _, c := range candidates {
+		m := messages[c.index]
+		excerpt := truncateRunes(strings.Join(strings.Fields(m.Text), " "), extractiveMaxRunes)
+		if idx, ok := senderIdx[m.SenderName]; ok {
+			item := &topic.Items[idx]
+			item.Description += "；" + excerpt
+			item.MessageIDs = append(item.MessageIDs, m.MessageID)
+			continue
+		}
+		senderIdx[m.SenderName] = len(topic.Items)
+		topic.Items = append(topic.Items, TopicSubItem{
+			SenderName:  m.SenderName,
+			Description: excerpt,
+			MessageIDs:  []int64{m.MessageID},
+		})
+	}
+
+	result := SummaryResult{Topics: []TopicItem{}}
+	if len(topic.Items) > 0 {
+		result.Topics = append(result.Topics, topic)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// tokenize 分词：英文/数字按单词（小写），中文按相邻两字（bigram），去除停用词
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	var han []rune
+
+	flushWord := func() {
+		if len(word) > 1 {
+			if w := strings.ToLower(string(word)); !extractiveStopWords[w] {
+				tokens = append(tokens, w)
+			}
+		}
+		word = word[:0]
+	}
+	flushHan := func() {
+		for i := 0; i+1 < len(han); i++ {
+			if bigram := string(han[i : i+2]); !extractiveStopWords[bigram] {
+				tokens = append(tokens, bigram)
+			}
+		}
+		han = han[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flushWord()
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushHan()
+		}
+	}
+	flushWord()
+	flushHan()
+	return tokens
+}
+
+// truncateRunes 按字数截断文本，超出部分以省略号结尾
+func truncateRunes(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit]) + "…"
+}
@@ -0,0 +1,44 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractiveSummarizer(t *testing.T) {
+	messages := []llm.ChatMessage{
+		{MessageID: 1, SenderName: "Alice", Text: "明天上午部署新版本到生产环境"},
+		{MessageID: 2, SenderName: "Bob", Text: "好的"},
+		{MessageID: 3, SenderName: "Bob", Text: "部署前需要先备份生产数据库"},
+		{MessageID: 4, SenderName: "Alice", Text: "部署脚本已经更新，生产环境配置也改好了"},
+	}
+
+	jsonStr, err := NewExtractiveSummarizer().SummarizeChat(context.Background(), messages)
+	require.NoError(t, err)
+
+	var result SummaryResult
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &result))
+	require.Len(t, result.Topics, 1)
+
+	items := result.Topics[0].Items
+	require.Len(t, items, 2)
+	assert.Equal(t, "Alice", items[0].SenderName)
+	assert.Equal(t, []int64{1, 4}, items[0].MessageIDs)
+	assert.Equal(t, "Bob", items[1].SenderName)
+	assert.Equal(t, []int64{3}, items[1].MessageIDs, "过短的消息不参与摘录")
+}
+
+func TestExtractiveSummarizer_NoContent(t *testing.T) {
+	jsonStr, err := NewExtractiveSummarizer().SummarizeChat(context.Background(), []llm.ChatMessage{{MessageID: 1, SenderName: "Bob", Text: "ok"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"topics":[]}`, jsonStr)
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"部署", "署脚", "脚本", "deploy", "v2"}, tokenize("部署脚本 deploy the v2!"))
+}
@@ -0,0 +1,90 @@
+package summarizer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// FilterTopics 返回标题或子项内容包含任一关键词的话题（保持原顺序），无匹配时返回 nil。
+// 关键词不区分大小写；纯英文/数字关键词按整词匹配（"go" 不匹配 "google"），其他按子串匹配
+func FilterTopics(result *SummaryResult, keywords []string) *SummaryResult {
+	if result == nil || len(keywords) == 0 {
+		return nil
+	}
+
+	var topics []TopicItem
+	for _, topic := range result.Topics {
+		if topicMatches(topic, keywords) {
+			topics = append(topics, topic)
+		}
+	}
+	if len(topics) == 0 {
+		return nil
+	}
+
+	filtered := *result
+	filtered.Topics = topics
+	return &filtered
+}
+
+// topicMatches 话题标题、发言者描述中是否包含任一关键词
+func topicMatches(topic TopicItem, keywords []string) bool {
+	texts := make([]string, 0, len(topic.Items)+1)
+	texts = append(texts, strings.ToLower(topic.Title))
+	for _, item := range topic.Items {
+		texts = append(texts, strings.ToLower(item.Description))
+	}
+
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			continue
+		}
+		for _, text := range texts {
+			if containsKeyword(text, keyword) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsKeyword 判断 text 是否包含 keyword；keyword 为纯英文/数字时要求两侧不是字母或数字
+func containsKeyword(text, keyword string) bool {
+	if !isWordKeyword(keyword) {
+		return strings.Contains(text, keyword)
+	}
+	for offset := 0; ; {
+		idx := strings.Index(text[offset:], keyword)
+		if idx < 0 {
+			return false
+		}
+		start := offset + idx
+		end := start + len(keyword)
+		if !isWordByteBefore(text, start) && !isWordByteAt(text, end) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isWordKeyword(keyword string) bool {
+	for _, r := range keyword {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordByteBefore(text string, i int) bool {
+	return i > 0 && isASCIIWordByte(text[i-1])
+}
+
+func isWordByteAt(text string, i int) bool {
+	return i < len(text) && isASCIIWordByte(text[i])
+}
+
+func isASCIIWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+}
@@ -0,0 +1,47 @@
+package summarizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterTopics(t *testing.T) {
+	result := &SummaryResult{
+		ChatTitle: "技术交流群",
+		Topics: []TopicItem{
+			{Title: "Kubernetes 升级", Items: []TopicSubItem{{SenderName: "A", Description: "讨论了集群升级步骤"}}},
+			{Title: "午饭吃什么", Items: []TopicSubItem{{SenderName: "B", Description: "推荐了 Google 附近的餐厅"}}},
+			{Title: "语言选型", Items: []TopicSubItem{{SenderName: "C", Description: "认为 Go 适合写服务端"}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		keywords []string
+		want     []string
+	}{
+		{"标题匹配且不区分大小写", []string{"kubernetes"}, []string{"Kubernetes 升级"}},
+		{"描述匹配", []string{"集群"}, []string{"Kubernetes 升级"}},
+		{"英文关键词按整词匹配", []string{"go"}, []string{"语言选型"}},
+		{"多个关键词保持原顺序", []string{"go", "餐厅"}, []string{"午饭吃什么", "语言选型"}},
+		{"无匹配", []string{"rust"}, nil},
+		{"无关键词", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := FilterTopics(result, tt.keywords)
+			if tt.want == nil {
+				assert.Nil(t, filtered)
+				return
+			}
+			var titles []string
+			for _, topic := range filtered.Topics {
+				titles = append(titles, topic.Title)
+			}
+			assert.Equal(t, tt.want, titles)
+			assert.Equal(t, "技术交流群", filtered.ChatTitle, "保留头部信息")
+		})
+	}
+	assert.Len(t, result.Topics, 3, "不修改原结果")
+}
@@ -0,0 +1,119 @@
+package summarizer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+)
+
+// glossary 术语表：提供给 LLM 的术语列表及总结结果的写法统一规则
+type glossary struct {
+	terms []config.GlossaryTerm
+	rules []glossaryRule
+}
+
+// glossaryRule 将匹配的写法替换为标准写法
+type glossaryRule struct {
+	pattern *regexp.Regexp
+	term    string
+}
+
+// newGlossary 创建术语表，terms 为空时返回 nil。
+// 标准写法及其他写法均不区分大小写匹配，纯英文/数字的写法按整词匹配（"eth" 不匹配 "ethereum"），较长的写法优先
+func newGlossary(terms []config.GlossaryTerm) *glossary {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	type spelling struct{ text, term string }
+	var spellings []spelling
+	for _, term := range terms {
+		spellings = append(spellings, spelling{term.Term, term.Term})
+		for _, alias := range term.Aliases {
+			spellings = append(spellings, spelling{alias, term.Term})
+		}
+	}
+	sort.SliceStable(spellings, func(i, j int) bool {
+		return len(spellings[i].text) > len(spellings[j].text)
+	})
+
+	g := &glossary{terms: terms}
+	for _, sp := range spellings {
+		expr := regexp.QuoteMeta(strings.TrimSpace(sp.text))
+		if isWordKeyword(strings.ReplaceAll(sp.text, " ", "")) {
+			expr = `\b` + expr + `\b`
+		}
+		g.rules = append(g.rules, glossaryRule{pattern: regexp.MustCompile("(?i)" + expr), term: sp.term})
+	}
+	return g
+}
+
+// mergeGlossary 合并全局术语表和群组术语表，群组中同名（不区分大小写）的术语覆盖全局术语
+func mergeGlossary(global, chat []config.GlossaryTerm) []config.GlossaryTerm {
+	overridden := make(map[string]bool, len(chat))
+	for _, term := range chat {
+		overridden[strings.ToLower(term.Term)] = true
+	}
+
+	merged := make([]config.GlossaryTerm, 0, len(global)+len(chat))
+	for _, term := range global {
+		if !overridden[strings.ToLower(term.Term)] {
+			merged = append(merged, term)
+		}
+	}
+	return append(merged, chat...)
+}
+
+// apply 将话题标题和子项描述中的术语统一为标准写法
+func (g *glossary) apply(result *SummaryResult) {
+	for i := range result.Topics {
+		topic := &result.Topics[i]
+		topic.Title = g.normalize(topic.Title)
+		for j := range topic.Items {
+			topic.Items[j].Description = g.normalize(topic.Items[j].Description)
+		}
+	}
+}
+
+// glossaryEdit 待替换的片段 text[start:end]
+type glossaryEdit struct {
+	start, end int
+	term       string
+}
+
+// normalize 依次应用替换规则（较长的写法优先），与已匹配片段重叠的匹配被忽略
+func (g *glossary) normalize(text string) string {
+	var edits []glossaryEdit
+	overlaps := func(start, end int) bool {
+		for _, e := range edits {
+			if start < e.end && e.start < end {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, rule := range g.rules {
+		for _, loc := range rule.pattern.FindAllStringIndex(text, -1) {
+			if !overlaps(loc[0], loc[1]) {
+				edits = append(edits, glossaryEdit{start: loc[0], end: loc[1], term: rule.term})
+			}
+		}
+	}
+	if len(edits) == 0 {
+		return text
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	var sb strings.Builder
+	last := 0
+	for _, e := range edits {
+		sb.WriteString(text[last:e.start])
+		sb.WriteString(e.term)
+		last = e.end
+	}
+	sb.WriteString(text[last:])
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+package summarizer
+
+import (
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlossaryNormalize(t *testing.T) {
+	g := newGlossary([]config.GlossaryTerm{
+		{Term: "Kubernetes", Aliases: []string{"k8s", "kube"}},
+		{Term: "BTC", Aliases: []string{"比特币"}},
+		{Term: "ETH"},
+		{Term: "C++", Aliases: []string{"cpp"}},
+	})
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"替换别名", "讨论了 k8s 集群升级", "讨论了 Kubernetes 集群升级"},
+		{"统一大小写", "btc 和 Eth 价格波动", "BTC 和 ETH 价格波动"},
+		{"中文别名", "比特币突破新高", "BTC突破新高"},
+		{"整词匹配", "ethereum 与 kubectl 不替换", "ethereum 与 kubectl 不替换"},
+		{"标准写法不重复替换", "Kubernetes 又称 K8S", "Kubernetes 又称 Kubernetes"},
+		{"含符号的写法", "用 cpp 还是 c++ 重写", "用 C++ 还是 C++ 重写"},
+		{"无术语", "今天天气不错", "今天天气不错"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, g.normalize(tt.text))
+		})
+	}
+}
+
+func TestGlossaryApply(t *testing.T) {
+	g := newGlossary([]config.GlossaryTerm{{Term: "Kubernetes", Aliases: []string{"k8s"}}})
+	result := &SummaryResult{Topics: []TopicItem{{
+		Title: "k8s 升级",
+		Items: []TopicSubItem{{SenderName: "k8s-bot", Description: "完成了 k8s 升级"}},
+	}}}
+
+	g.apply(result)
+	assert.Equal(t, "Kubernetes 升级", result.Topics[0].Title)
+	assert.Equal(t, "完成了 Kubernetes 升级", result.Topics[0].Items[0].Description)
+	assert.Equal(t, "k8s-bot", result.Topics[0].Items[0].SenderName, "发言者名称保持原样")
+}
+
+func TestSummarizerGlossaryFor(t *testing.T) {
+	s := &Summarizer{}
+	assert.Nil(t, s.glossaryFor(-100), "未配置术语表")
+
+	s.SetGlossary(
+		[]config.GlossaryTerm{{Term: "BTC"}, {Term: "SOL", Explanation: "Solana 代币"}},
+		map[int64][]config.GlossaryTerm{-100: {{Term: "sol", Explanation: "Sol 游戏服务器"}}},
+	)
+	assert.Equal(t, []config.GlossaryTerm{{Term: "BTC"}, {Term: "SOL", Explanation: "Solana 代币"}}, s.glossaryFor(-200).terms)
+	assert.Equal(t, []config.GlossaryTerm{{Term: "BTC"}, {Term: "sol", Explanation: "Sol 游戏服务器"}}, s.glossaryFor(-100).terms, "群组术语覆盖同名全局术语")
+}
@@ -0,0 +1,189 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+)
+
+// shadowTimeout 对比模型单次总结的超时时间
+const shadowTimeout = 10 * time.Minute
+
+// ShadowStore 保存模型对比结果，默认实现为 model.ShadowRunModel
+type ShadowStore interface {
+	Create(ctx context.Context, data *model.ShadowRunData) error
+}
+
+// shadowRunner 对比模式：抽样群组在主模型总结的同时用对比模型总结，比较后保存两者输出
+type shadowRunner struct {
+	engine       SummaryEngine
+	sampleRate   float64
+	primaryModel string
+	shadowModel  string
+	store        ShadowStore
+}
+
+// shadowOutput 对比模型的总结结果
+type shadowOutput struct {
+	content string
+	tokens  int
+	err     error
+}
+
+// SetShadow 启用对比模式：按 sampleRate 抽样的群组使用默认引擎总结时，同时用 engine 总结并将两者的输出及差异保存到 store
+func (s *Summarizer) SetShadow(engine SummaryEngine, sampleRate float64, primaryModel, shadowModel string, store ShadowStore) {
+	s.shadow = &shadowRunner{
+		engine:       engine,
+		sampleRate:   sampleRate,
+		primaryModel: primaryModel,
+		shadowModel:  shadowModel,
+		store:        store,
+	}
+}
+
+// sampled 按群组ID哈希固定抽样，同一群组每次的抽样结果相同，便于连续对比
+func (r *shadowRunner) sampled(chatID int64) bool {
+	h := fnv.New32a()
+	_ = binary.Write(h, binary.BigEndian, chatID)
+	return float64(h.Sum32()%10000) < r.sampleRate*10000
+}
+
+// start 在后台用对比模型总结：不随本次总结取消，用量单独统计，不计入本次运行
+func (r *shadowRunner) start(ctx context.Context, messages []llm.ChatMessage) <-chan shadowOutput {
+	ch := make(chan shadowOutput, 1)
+	usage := &llm.Usage{}
+	// 对比模型的进度不计入任务进度
+	ctx = llm.WithProgress(llm.WithUsage(context.WithoutCancel(ctx), usage), nil)
+	ctx, cancel := context.WithTimeout(ctx, shadowTimeout)
+	go func() {
+		defer cancel()
+		content, err := r.engine.SummarizeChat(ctx, messages)
+		ch <- shadowOutput{content: content, tokens: usage.Snapshot().TotalTokens, err: err}
+	}()
+	return ch
+}
+
+// record 等待对比模型完成，与主模型的结果比较后保存，在后台执行
+func (r *shadowRunner) record(chatID int64, startTime, endTime time.Time, primaryContent string, primary []TopicItem, ch <-chan shadowOutput) {
+	out := <-ch
+	data := &model.ShadowRunData{
+		ChatID:         chatID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		PrimaryModel:   r.primaryModel,
+		ShadowModel:    r.shadowModel,
+		PrimaryContent: primaryContent,
+		ShadowContent:  out.content,
+		ShadowTokens:   out.tokens,
+		PrimaryTopics:  len(primary),
+	}
+
+	var shadow SummaryResult
+	if out.err == nil {
+		out.err = json.Unmarshal([]byte(out.content), &shadow)
+	}
+	if out.err != nil {
+		data.ShadowError = out.err.Error()
+		logger.Warnf("[Summarizer] 群组 %d: 对比模型 %s 总结失败: %v", chatID, r.shadowModel, out.err)
+	} else {
+		data.ShadowTopics = len(shadow.Topics)
+		data.MatchedTopics, data.MessageOverlap = compareTopics(primary, shadow.Topics)
+		logger.Infof("[Summarizer] 群组 %d: 模型对比完成，话题数 %d/%d，匹配 %d 个，消息重合度 %.2f",
+			chatID, data.PrimaryTopics, data.ShadowTopics, data.MatchedTopics, data.MessageOverlap)
+	}
+
+	if err := r.store.Create(context.Background(), data); err != nil {
+		logger.Warnf("[Summarizer] 群组 %d: 保存模型对比结果失败: %v", chatID, err)
+	}
+}
+
+// compareTopics 比较两组话题：matched 为 primary 中与 shadow 某个话题引用了相同消息的话题数，
+// overlap 为两者引用的全部消息ID的 Jaccard 相似度
+func compareTopics(primary, shadow []TopicItem) (matched int, overlap float64) {
+	shadowIDs := make(map[int64]bool)
+	for _, topic := range shadow {
+		for id := range topicMessageIDs(topic) {
+			shadowIDs[id] = true
+		}
+	}
+
+	primaryIDs := make(map[int64]bool)
+	for _, topic := range primary {
+		hit := false
+		for id := range topicMessageIDs(topic) {
+			primaryIDs[id] = true
+			hit = hit || shadowIDs[id]
+		}
+		if hit {
+			matched++
+		}
+	}
+
+	intersection := 0
+	for id := range primaryIDs {
+		if shadowIDs[id] {
+			intersection++
+		}
+	}
+	if union := len(primaryIDs) + len(shadowIDs) - intersection; union > 0 {
+		overlap = float64(intersection) / float64(union)
+	}
+	return matched, overlap
+}
+
+// topicMessageIDs 话题各子项引用的消息ID集合
+func topicMessageIDs(topic TopicItem) map[int64]bool {
+	ids := make(map[int64]bool)
+	for _, item := range topic.Items {
+		for _, id := range item.MessageIDs {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+// ShadowStatsProvider 各群组模型对比结果的统计，默认实现为 model.ShadowRunModel
+type ShadowStatsProvider interface {
+	StatsSince(ctx context.Context, since time.Time) ([]model.ShadowStats, error)
+}
+
+// ChatTitleProvider 查询群聊名称，默认实现为 model.ChatModel
+type ChatTitleProvider interface {
+	GetTitle(ctx context.Context, chatID int64) (string, error)
+}
+
+// ShadowStatsText 最近 days 天各群组主模型与对比模型的差异统计（/shadow），shadowModel 为空表示未启用对比，文本按 f 的语言输出
+func ShadowStatsText(ctx context.Context, runs ShadowStatsProvider, chats ChatTitleProvider, days int, primaryModel, shadowModel string, f *display.Formatter) (string, error) {
+	stats, err := runs.StatsSince(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	if shadowModel == "" {
+		sb.WriteString(f.T(display.TextShadowDisabled) + "\n")
+	} else {
+		sb.WriteString(f.Tf(display.TextShadowTitle, days, primaryModel, shadowModel) + "\n")
+	}
+	if len(stats) == 0 {
+		sb.WriteString(f.T(display.TextShadowEmpty) + "\n")
+	}
+	for _, st := range stats {
+		title, err := chats.GetTitle(ctx, st.ChatID)
+		if err != nil || title == "" {
+			title = strconv.FormatInt(st.ChatID, 10)
+		}
+		sb.WriteString(f.Tf(display.TextShadowEntry,
+			title, st.Runs, st.Failures, st.PrimaryTopics, st.ShadowTopics, st.MatchedRatio*100, st.MessageOverlap*100) + "\n")
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,154 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockShadowStore 将保存的对比结果发送到 channel，便于等待后台保存完成
+type mockShadowStore struct {
+	runs chan *model.ShadowRunData
+}
+
+func (m *mockShadowStore) Create(ctx context.Context, data *model.ShadowRunData) error {
+	m.runs <- data
+	return nil
+}
+
+func topic(title string, ids ...int64) TopicItem {
+	return TopicItem{Title: title, Items: []TopicSubItem{{SenderName: "A", MessageIDs: ids}}}
+}
+
+func TestCompareTopics(t *testing.T) {
+	tests := []struct {
+		name        string
+		primary     []TopicItem
+		shadow      []TopicItem
+		wantMatched int
+		wantOverlap float64
+	}{
+		{"完全相同", []TopicItem{topic("部署", 1, 2), topic("发布", 3)}, []TopicItem{topic("部署上线", 1, 2), topic("发布计划", 3)}, 2, 1},
+		{"部分重合", []TopicItem{topic("部署", 1, 2), topic("发布", 3)}, []TopicItem{topic("部署", 2, 4)}, 1, 1.0 / 4},
+		{"对比模型无话题", []TopicItem{topic("部署", 1)}, nil, 0, 0},
+		{"均无话题", nil, nil, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, overlap := compareTopics(tt.primary, tt.shadow)
+			assert.Equal(t, tt.wantMatched, matched)
+			assert.InDelta(t, tt.wantOverlap, overlap, 1e-9)
+		})
+	}
+}
+
+func TestShadowSampled(t *testing.T) {
+	none := &shadowRunner{sampleRate: 0}
+	all := &shadowRunner{sampleRate: 1}
+	half := &shadowRunner{sampleRate: 0.5}
+
+	sampled := 0
+	for chatID := int64(-1001000000000); chatID > -1001000001000; chatID-- {
+		assert.False(t, none.sampled(chatID))
+		assert.True(t, all.sampled(chatID))
+		if half.sampled(chatID) {
+			sampled++
+		}
+		assert.Equal(t, half.sampled(chatID), half.sampled(chatID), "同一群组抽样结果固定")
+	}
+	assert.InDelta(t, 500, sampled, 100)
+}
+
+func TestSummarizeRange_Shadow(t *testing.T) {
+	now := time.Now()
+	primary := `{"topics":[{"title":"部署","items":[{"sender_name":"张三","description":"完成部署","message_ids":[100]}]}]}`
+	shadowResp := `{"topics":[{"title":"部署上线","items":[{"sender_name":"张三","description":"部署完成","message_ids":[100]}]},{"title":"闲聊","items":[{"sender_name":"李四","description":"聊天","message_ids":[101]}]}]}`
+
+	tests := []struct {
+		name   string
+		engine *mockSummaryEngine
+		check  func(t *testing.T, run *model.ShadowRunData)
+	}{
+		{"保存两者输出及差异", &mockSummaryEngine{jsonResp: shadowResp}, func(t *testing.T, run *model.ShadowRunData) {
+			assert.Equal(t, primary, run.PrimaryContent)
+			assert.Equal(t, shadowResp, run.ShadowContent)
+			assert.Equal(t, 1, run.PrimaryTopics)
+			assert.Equal(t, 2, run.ShadowTopics)
+			assert.Equal(t, 1, run.MatchedTopics)
+			assert.InDelta(t, 0.5, run.MessageOverlap, 1e-9)
+		}},
+		{"对比模型失败不影响主模型", &mockSummaryEngine{err: errors.New("timeout")}, func(t *testing.T, run *model.ShadowRunData) {
+			assert.Equal(t, "timeout", run.ShadowError)
+			assert.Empty(t, run.ShadowContent)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockShadowStore{runs: make(chan *model.ShadowRunData, 1)}
+			s := &Summarizer{
+				messageModel: &mockMessageProvider{messages: []*ent.Message{
+					mustEntMessage(100, 1, "张三", "部署完成", now),
+					mustEntMessage(101, 2, "李四", "吃了吗", now),
+				}},
+				engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: primary}},
+			}
+			s.SetShadow(tt.engine, 1, "gpt-4o", "deepseek-chat", store)
+
+			result, err := s.SummarizeRange(context.Background(), -1001427755127, now.Add(-time.Hour), now)
+			require.NoError(t, err)
+			assert.Len(t, result.Topics, 1, "返回主模型的结果")
+
+			select {
+			case run := <-store.runs:
+				assert.Equal(t, int64(-1001427755127), run.ChatID)
+				assert.Equal(t, "gpt-4o", run.PrimaryModel)
+				assert.Equal(t, "deepseek-chat", run.ShadowModel)
+				tt.check(t, run)
+			case <-time.After(time.Second):
+				t.Fatal("未保存对比结果")
+			}
+		})
+	}
+}
+
+type fakeShadowStats []model.ShadowStats
+
+func (f fakeShadowStats) StatsSince(ctx context.Context, since time.Time) ([]model.ShadowStats, error) {
+	return f, nil
+}
+
+type fakeChatTitles map[int64]string
+
+func (f fakeChatTitles) GetTitle(ctx context.Context, chatID int64) (string, error) {
+	return f[chatID], nil
+}
+
+func TestShadowStatsText(t *testing.T) {
+	ctx := context.Background()
+	titles := fakeChatTitles{-100: "产品讨论群"}
+
+	t.Run("平均值保留一位小数，比例换算为百分比", func(t *testing.T) {
+		stats := fakeShadowStats{
+			{ChatID: -100, Runs: 3, Failures: 1, PrimaryTopics: 5, ShadowTopics: 20.0 / 3, MatchedRatio: 0.9, MessageOverlap: 0.6},
+			{ChatID: -200, Runs: 1, Failures: 1, PrimaryTopics: 2},
+		}
+		text, err := ShadowStatsText(ctx, stats, titles, 7, "gpt-4o", "deepseek-chat", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "⚖️ 近 7 天模型对比: gpt-4o vs deepseek-chat\n"+
+			"产品讨论群: 对比 3 次（失败 1），话题数 5.0 → 6.7，话题覆盖 90%，引用消息重合 60%\n"+
+			"-200: 对比 1 次（失败 1），话题数 2.0 → 0.0，话题覆盖 0%，引用消息重合 0%\n", text)
+	})
+
+	t.Run("未启用对比", func(t *testing.T) {
+		text, err := ShadowStatsText(ctx, fakeShadowStats{}, titles, 7, "gpt-4o", "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "⚖️ 未启用模型对比（LLM.Shadow.Model）\n暂无对比记录\n", text)
+	})
+}
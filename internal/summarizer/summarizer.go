@@ -4,38 +4,216 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"sort"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
 	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
+	"github.com/fachebot/talk-trace-bot/internal/lang"
 	"github.com/fachebot/talk-trace-bot/internal/llm"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
 	"github.com/fachebot/talk-trace-bot/internal/model"
 )
 
-// messageProvider 获取时间区间内的消息（便于测试注入 mock）
-type messageProvider interface {
+// MessageProvider 获取时间区间内的消息（storage.MessageStore 的子集，默认实现为 model.MessageModel）
+type MessageProvider interface {
 	GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error)
 }
 
-// llmSummarizer 调用 LLM 总结群聊（便于测试注入 mock）
-type llmSummarizer interface {
+// EventProvider 获取区间内记录的事件（非文字消息），用于统计成员活跃度
+type EventProvider interface {
+	GetEventsByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error)
+}
+
+// LinkProvider 获取区间内分享的链接（默认实现为 model.SharedLinkModel）
+type LinkProvider interface {
+	GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.SharedLink, error)
+}
+
+// SummaryEngine 总结引擎：将群聊消息总结为话题分组 JSON（默认引擎为 llm.Client）
+type SummaryEngine interface {
 	SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error)
 }
 
+// ChatContextProvider 获取群聊背景信息（群简介、置顶消息），默认实现为 teleapp.TeleApp
+type ChatContextProvider interface {
+	ChatContext(ctx context.Context, chatID int64) (llm.ChatContext, error)
+}
+
+// Compressor 将过长的文本压缩到指定字数以内（默认实现为 llm.Client）
+type Compressor interface {
+	CompressText(ctx context.Context, text string, maxRunes int) (string, error)
+}
+
+// DefaultEngine 默认总结引擎名称（LLM）
+const DefaultEngine = "llm"
+
 type Summarizer struct {
-	llmClient    llmSummarizer
-	messageModel messageProvider
+	enginesMu    sync.RWMutex
+	engines      map[string]SummaryEngine
+	messageModel MessageProvider
+	language     string
+	chatContext  ChatContextProvider
+	glossary     *glossary
+	chatGlossary map[int64]*glossary
+	quoteRunes   int
+	minRunes     int
+	shadow       *shadowRunner
+
+	compressor    Compressor
+	compressRunes int
+
+	events EventProvider
+	links  LinkProvider
 }
 
-func NewSummarizer(llmClient *llm.Client, messageModel *model.MessageModel) *Summarizer {
+func NewSummarizer(llmClient SummaryEngine, messageModel MessageProvider) *Summarizer {
 	return &Summarizer{
-		llmClient:    llmClient,
+		engines: map[string]SummaryEngine{
+			DefaultEngine:    llmClient,
+			ExtractiveEngine: NewExtractiveSummarizer(),
+			TopPostsEngine:   NewTopPostsSummarizer(),
+		},
 		messageModel: messageModel,
 	}
 }
 
-// toLinkMessageID 将 TDLib 的 message_id 转为 t.me 链接用逻辑 ID（大 ID >>20，小 ID 不变）
+// WithMessages 返回从 provider 获取消息的 Summarizer：引擎、语言、术语表等设置相同，
+// 不获取群聊背景、不运行对比模型，用于以示例对话自检
+func (s *Summarizer) WithMessages(provider MessageProvider) *Summarizer {
+	s.enginesMu.RLock()
+	engines := maps.Clone(s.engines)
+	s.enginesMu.RUnlock()
+	return &Summarizer{
+		engines:      engines,
+		messageModel: provider,
+		language:     s.language,
+		glossary:     s.glossary,
+		chatGlossary: s.chatGlossary,
+		quoteRunes:   s.quoteRunes,
+		minRunes:     s.minRunes,
+
+		compressor:    s.compressor,
+		compressRunes: s.compressRunes,
+	}
+}
+
+// RegisterEngine 注册总结引擎，同名引擎会被替换
+func (s *Summarizer) RegisterEngine(name string, engine SummaryEngine) {
+	s.enginesMu.Lock()
+	s.engines[name] = engine
+	s.enginesMu.Unlock()
+}
+
+// SetLanguage 设置总结的输出语言：空字符串使用默认（中文），"auto" 使用群聊中占比最高的语言，其他值为语言代码（如 "en"）
+func (s *Summarizer) SetLanguage(language string) {
+	s.language = language
+}
+
+// SetChatContextProvider 启用群聊背景注入：总结时将群简介和置顶消息作为背景提供给总结引擎
+func (s *Summarizer) SetChatContextProvider(provider ChatContextProvider) {
+	s.chatContext = provider
+}
+
+// SetGlossary 设置术语表：总结时提供给总结引擎，并将结果中的术语统一为标准写法；chats 中的群组使用合并后的术语表
+func (s *Summarizer) SetGlossary(global []config.GlossaryTerm, chats map[int64][]config.GlossaryTerm) {
+	s.glossary = newGlossary(global)
+	s.chatGlossary = make(map[int64]*glossary, len(chats))
+	for chatID, terms := range chats {
+		s.chatGlossary[chatID] = newGlossary(mergeGlossary(global, terms))
+	}
+}
+
+// SetQuoteMaxRunes 启用原文引用：每个子项下附上第一条关键消息的原文，超过 n 个字截断；n 为 0 时不引用
+func (s *Summarizer) SetQuoteMaxRunes(n int) {
+	s.quoteRunes = n
+}
+
+// SetCompressor 设置子项过长时使用的压缩器，maxRunes 为提示词中要求的字数上限
+func (s *Summarizer) SetCompressor(compressor Compressor, maxRunes int) {
+	s.compressor = compressor
+	s.compressRunes = maxRunes
+}
+
+// SetMinMessageRunes 设置提交给总结引擎的消息最短字数：更短的消息（如“哈哈”“+1”）不参与总结，但仍计入统计；n 为 0 时不过滤
+func (s *Summarizer) SetMinMessageRunes(n int) {
+	s.minRunes = n
+}
+
+// SetEventProvider 启用成员活跃度统计：总结末尾按消息数与事件数之和列出最活跃的成员，
+// 避免只发贴纸、动图的成员在统计中被忽略
+func (s *Summarizer) SetEventProvider(provider EventProvider) {
+	s.events = provider
+}
+
+// SetLinkProvider 启用链接汇总：总结末尾列出区间内分享的链接
+func (s *Summarizer) SetLinkProvider(provider LinkProvider) {
+	s.links = provider
+}
+
+// tooShort 判断消息去除首尾空白后的字数是否少于最短字数
+func (s *Summarizer) tooShort(text string) bool {
+	return s.minRunes > 0 && utf8.RuneCountInString(strings.TrimSpace(text)) < s.minRunes
+}
+
+// quoteRunesFor 返回群组引用原文的最大字数：无法生成消息链接的群组（非超级群组）始终引用，否则子项只剩描述
+func (s *Summarizer) quoteRunesFor(chatID int64) int {
+	if s.quoteRunes == 0 && !linkable(chatID) {
+		return defaultUnlinkableQuoteRunes
+	}
+	return s.quoteRunes
+}
+
+// glossaryFor 返回群组使用的术语表，未配置时返回 nil
+func (s *Summarizer) glossaryFor(chatID int64) *glossary {
+	if g, ok := s.chatGlossary[chatID]; ok {
+		return g
+	}
+	return s.glossary
+}
+
+// outputLanguage 根据设置及消息语言分布确定输出语言代码，空字符串表示使用默认
+func (s *Summarizer) outputLanguage(languages map[string]int) string {
+	if s.language != "auto" {
+		return s.language
+	}
+	code, _ := lang.Dominant(languages)
+	return code
+}
+
+// HasEngine 检查总结引擎是否已注册
+func (s *Summarizer) HasEngine(name string) bool {
+	s.enginesMu.RLock()
+	defer s.enginesMu.RUnlock()
+	_, ok := s.engines[name]
+	return ok
+}
+
+// linkMessageID 返回消息的 t.me 链接用 ID：优先使用入库时记录的服务器消息ID，旧数据按 TDLib ID 推算
+func linkMessageID(msg *ent.Message) int64 {
+	if msg.ServerMessageID != 0 {
+		return msg.ServerMessageID
+	}
+	return toLinkMessageID(msg.MessageID)
+}
+
+// LinkMessageID 返回消息在总结结果 message_ids 中使用的 ID
+func LinkMessageID(msg *ent.Message) int64 {
+	return linkMessageID(msg)
+}
+
+// MessageLink 返回消息的 t.me 链接，非超级群组返回空字符串
+func MessageLink(msg *ent.Message) string {
+	return buildMessageLink(msg.ChatID, linkMessageID(msg))
+}
+
+// toLinkMessageID 将 TDLib 的 message_id 转为 t.me 链接用逻辑 ID（大 ID >>20，小 ID 不变），仅用于未记录服务器消息ID的旧数据
 const tdlibInternalIDThreshold = 1 << 30
 
 func toLinkMessageID(messageID int64) int64 {
@@ -55,8 +233,13 @@ func escapeHTML(text string) string {
 	return result
 }
 
-// SummarizeRange 生成指定时间区间的群聊总结
+// SummarizeRange 使用默认引擎生成指定时间区间的群聊总结
 func (s *Summarizer) SummarizeRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (*SummaryResult, error) {
+	return s.SummarizeRangeWith(ctx, DefaultEngine, chatID, startTime, endTime)
+}
+
+// SummarizeRangeWith 使用指定引擎生成指定时间区间的群聊总结
+func (s *Summarizer) SummarizeRangeWith(ctx context.Context, engineName string, chatID int64, startTime, endTime time.Time) (*SummaryResult, error) {
 	startStr := startTime.Format("2006-01-02")
 	endStr := endTime.Format("2006-01-02")
 	logger.Infof("[Summarizer] 开始生成 %s ~ %s 的群聊总结", startStr, endStr)
@@ -72,36 +255,416 @@ func (s *Summarizer) SummarizeRange(ctx context.Context, chatID int64, startTime
 	}
 
 	logger.Infof("[Summarizer] 找到 %d 条消息", len(messages))
+	activity, participants := s.senderActivity(ctx, chatID, messages, startTime, endTime)
 
-	// 转换为结构化消息数组；提交给 LLM 前将 message_id 转为链接用短 ID
-	chatMsgs := make([]llm.ChatMessage, len(messages))
-	for i, msg := range messages {
-		chatMsgs[i] = llm.ChatMessage{
-			MessageID:  toLinkMessageID(msg.MessageID),
+	// 转换为结构化消息数组；提交给 LLM 前将 message_id 转为链接用 ID。过短的消息只计入统计
+	byID := make(map[int64]*ent.Message, len(messages))
+	for _, msg := range messages {
+		byID[msg.MessageID] = msg
+	}
+	allMsgs := make([]llm.ChatMessage, 0, len(messages))
+	chatMsgs := make([]llm.ChatMessage, 0, len(messages))
+	languages := make(map[string]int)
+	for _, msg := range messages {
+		if msg.Lang != "" {
+			languages[msg.Lang]++
+		}
+		chatMsg := llm.ChatMessage{
+			MessageID:  linkMessageID(msg),
 			SenderID:   msg.SenderID,
 			SenderName: msg.SenderName,
 			Text:       msg.Text,
+			SentAt:     msg.SentAt,
+			ThreadID:   msg.MessageThreadID,
+			Reactions:  msg.ReactionCount,
 		}
+		// 被回复的消息在区间内时附上回复关系，帮助 LLM 将回答归入正确的讨论
+		if replied, ok := byID[msg.ReplyToMessageID]; ok && msg.ReplyToMessageID != 0 {
+			chatMsg.ReplyToID = linkMessageID(replied)
+			chatMsg.ReplyToName = replied.SenderName
+		}
+		allMsgs = append(allMsgs, chatMsg)
+		if !s.tooShort(msg.Text) {
+			chatMsgs = append(chatMsgs, chatMsg)
+		}
+	}
+	// 开启了论坛话题的群组按话题分组，避免不同话题中交错进行的讨论被混在一起
+	chatMsgs = groupByThread(chatMsgs)
+	budget := PromptBudget{
+		RawMessages:      len(allMsgs),
+		RawTokens:        llm.EstimateMessageTokens(allMsgs),
+		FilteredMessages: len(chatMsgs),
+		FilteredTokens:   llm.EstimateMessageTokens(chatMsgs),
+	}
+	if skipped := len(messages) - len(chatMsgs); skipped > 0 {
+		logger.Infof("[Summarizer] 过滤 %d 条少于 %d 字的消息", skipped, s.minRunes)
+	}
+	if len(chatMsgs) == 0 {
+		// 保留活跃度统计，无话题时不发送通知
+		logger.Infof("[Summarizer] 区间内的消息均过短，跳过总结")
+		return &SummaryResult{
+			MessageCount:     len(messages),
+			ParticipantCount: participants,
+			Languages:        languages,
+			Engine:           engineName,
+			PromptBudget:     budget,
+			Activity:         activity,
+		}, nil
+	}
+
+	// 按语言分布选择输出语言
+	if code := s.outputLanguage(languages); code != "" {
+		logger.Debugf("[Summarizer] 群组 %d: 总结输出语言 %s", chatID, code)
+		ctx = llm.WithLanguage(ctx, lang.Name(code))
 	}
 
-	// 调用 LLM 总结
-	jsonStr, err := s.llmClient.SummarizeChat(ctx, chatMsgs)
+	// 附加群聊背景，获取失败不影响总结
+	if s.chatContext != nil {
+		chatContext, err := s.chatContext.ChatContext(ctx, chatID)
+		if err != nil {
+			logger.Warnf("[Summarizer] 群组 %d: 获取群聊背景失败: %v", chatID, err)
+		} else {
+			ctx = llm.WithChatContext(ctx, chatContext)
+		}
+	}
+
+	g := s.glossaryFor(chatID)
+	if g != nil {
+		ctx = llm.WithGlossary(ctx, g.terms)
+	}
+
+	// 回应最多的消息作为高关注消息提供给总结引擎
+	highlights := topReacted(chatMsgs, highlightsMax)
+	if len(highlights) > 0 {
+		ctx = llm.WithHighlights(ctx, highlights)
+	}
+
+	// 调用总结引擎
+	s.enginesMu.RLock()
+	engine, ok := s.engines[engineName]
+	s.enginesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("未知的总结引擎: %s", engineName)
+	}
+	var shadow <-chan shadowOutput
+	if s.shadow != nil && engineName == DefaultEngine && s.shadow.sampled(chatID) {
+		shadow = s.shadow.start(ctx, chatMsgs)
+	}
+	promptBudget := &llm.PromptBudget{}
+	jsonStr, err := engine.SummarizeChat(llm.WithPromptBudget(ctx, promptBudget), chatMsgs)
 	if err != nil {
-		return nil, fmt.Errorf("LLM 总结失败: %w", err)
+		if engineName == DefaultEngine {
+			return nil, fmt.Errorf("LLM 总结失败: %w", err)
+		}
+		return nil, fmt.Errorf("总结引擎 %s 失败: %w", engineName, err)
 	}
 
 	var result SummaryResult
 	if err := json.Unmarshal([]byte(jsonStr), &result); err != nil {
 		logger.Debugf("[Summarizer] 解析 LLM 返回的 JSON 失败: %s", jsonStr)
-		return nil, fmt.Errorf("解析 LLM 返回的 JSON 失败: %w", err)
+		return nil, fmt.Errorf("%w: %w", errs.ErrParse, err)
+	}
+
+	if g != nil {
+		g.apply(&result)
+	}
+	if quoteRunes := s.quoteRunesFor(chatID); quoteRunes > 0 {
+		attachQuotes(&result, chatMsgs, quoteRunes)
+	}
+	assignThreads(&result, chatMsgs)
+	if shadow != nil {
+		go s.shadow.record(chatID, startTime, endTime, jsonStr, result.Topics, shadow)
 	}
 
+	result.MessageCount = len(messages)
+	result.ParticipantCount = participants
+	result.Languages = languages
+	result.Engine = engineName
+	result.ThreadID = singleThread(messages)
+	result.Highlights = toHighlights(highlights)
+	result.Polls = summarizePolls(messages)
+	result.Activity = activity
+	result.Links, result.LinksOmitted = s.sharedLinks(ctx, chatID, byID, startTime, endTime)
+	requests := promptBudget.Snapshot()
+	budget.Chunks = requests.Chunks
+	budget.MessageTokens = requests.MessageTokens
+	budget.OverheadTokens = requests.OverheadTokens
+	budget.PromptTokens = requests.PromptTokens
+	result.PromptBudget = budget
 	logger.Infof("[Summarizer] 完成总结，共 %d 个话题", len(result.Topics))
 	return &result, nil
 }
 
+// groupByThread 按论坛话题分组排列消息：各话题按首条消息的先后排列，话题内保持原有顺序；
+// 只有一个话题（或群组未开启话题）时原样返回
+func groupByThread(msgs []llm.ChatMessage) []llm.ChatMessage {
+	order := make(map[int64]int)
+	for _, msg := range msgs {
+		if _, ok := order[msg.ThreadID]; !ok {
+			order[msg.ThreadID] = len(order)
+		}
+	}
+	if len(order) <= 1 {
+		return msgs
+	}
+
+	grouped := make([]llm.ChatMessage, len(msgs))
+	copy(grouped, msgs)
+	sort.SliceStable(grouped, func(i, j int) bool { return order[grouped[i].ThreadID] < order[grouped[j].ThreadID] })
+	return grouped
+}
+
+const (
+	highlightsMax       = 3  // 最多列出的回应最多的消息数
+	highlightQuoteRunes = 80 // 回应最多的消息摘录的最大字数
+)
+
+// topReacted 返回回应数最多的至多 n 条消息，按回应数倒序（相同时按原有顺序），没有回应的消息不计入
+func topReacted(msgs []llm.ChatMessage, n int) []llm.ChatMessage {
+	var reacted []llm.ChatMessage
+	for _, msg := range msgs {
+		if msg.Reactions > 0 {
+			reacted = append(reacted, msg)
+		}
+	}
+	sort.SliceStable(reacted, func(i, j int) bool { return reacted[i].Reactions > reacted[j].Reactions })
+	if len(reacted) > n {
+		reacted = reacted[:n]
+	}
+	return reacted
+}
+
+// toHighlights 将回应最多的消息转为总结结果中的摘录，空白折叠为单个空格后按字数截断
+func toHighlights(msgs []llm.ChatMessage) []Highlight {
+	if len(msgs) == 0 {
+		return nil
+	}
+	highlights := make([]Highlight, len(msgs))
+	for i, msg := range msgs {
+		highlights[i] = Highlight{
+			SenderName: msg.SenderName,
+			Text:       truncateRunes(strings.Join(strings.Fields(msg.Text), " "), highlightQuoteRunes),
+			MessageID:  msg.MessageID,
+			Reactions:  msg.Reactions,
+		}
+	}
+	return highlights
+}
+
+// activityMax 成员活跃度最多列出的人数
+const activityMax = 10
+
+// senderActivity 统计区间内各成员的消息数和事件数，按两者之和倒序（相同时按首次发言的先后），最多 activityMax 人；
+// participants 为发言人数（含只发送了非文字消息的成员）。未启用事件记录或获取事件失败时不统计活跃度
+func (s *Summarizer) senderActivity(ctx context.Context, chatID int64, messages []*ent.Message, startTime, endTime time.Time) (activity []SenderActivity, participants int) {
+	bySender := make(map[int64]*SenderActivity)
+	first := make(map[int64]time.Time)
+	var order []int64
+	count := func(msg *ent.Message) *SenderActivity {
+		a, ok := bySender[msg.SenderID]
+		if !ok {
+			a = &SenderActivity{SenderName: msg.SenderName}
+			bySender[msg.SenderID] = a
+			order = append(order, msg.SenderID)
+		}
+		if t, ok := first[msg.SenderID]; !ok || msg.SentAt.Before(t) {
+			first[msg.SenderID] = msg.SentAt
+		}
+		return a
+	}
+	for _, msg := range messages {
+		count(msg).Messages++
+	}
+	if s.events == nil {
+		return nil, len(bySender)
+	}
+	events, err := s.events.GetEventsByDateRangeAndChat(ctx, chatID, startTime, endTime)
+	if err != nil {
+		logger.Warnf("[Summarizer] 群组 %d: 获取事件失败: %v", chatID, err)
+		return nil, len(bySender)
+	}
+	for _, event := range events {
+		count(event).Events++
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := bySender[order[i]], bySender[order[j]]
+		if a.Messages+a.Events != b.Messages+b.Events {
+			return a.Messages+a.Events > b.Messages+b.Events
+		}
+		return first[order[i]].Before(first[order[j]])
+	})
+	if len(order) > activityMax {
+		order = order[:activityMax]
+	}
+	activity = make([]SenderActivity, len(order))
+	for i, senderID := range order {
+		activity[i] = *bySender[senderID]
+	}
+	return activity, len(bySender)
+}
+
+// linksMax 最多列出的分享链接数
+const linksMax = 20
+
+// sharedLinks 查询区间内分享的链接，只保留所属消息仍保存着的（未被删除），同一链接只保留首次分享；
+// 最多 linksMax 个，同时返回未列出的数量。未启用或查询失败时返回 nil
+func (s *Summarizer) sharedLinks(ctx context.Context, chatID int64, byID map[int64]*ent.Message, startTime, endTime time.Time) ([]SharedLink, int) {
+	if s.links == nil {
+		return nil, 0
+	}
+	rows, err := s.links.GetByDateRangeAndChat(ctx, chatID, startTime, endTime)
+	if err != nil {
+		logger.Warnf("[Summarizer] 群组 %d: 获取分享的链接失败: %v", chatID, err)
+		return nil, 0
+	}
+
+	var links []SharedLink
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		msg, ok := byID[row.MessageID]
+		if !ok || seen[row.URL] {
+			continue
+		}
+		seen[row.URL] = true
+		links = append(links, SharedLink{URL: row.URL, SenderName: row.SenderName, MessageID: linkMessageID(msg)})
+	}
+	if len(links) > linksMax {
+		return links[:linksMax], len(links) - linksMax
+	}
+	return links, 0
+}
+
+// summarizePolls 汇总区间内的投票消息：问题、得票最多的选项及参与人数，无法解析的投票跳过
+func summarizePolls(messages []*ent.Message) []PollSummary {
+	var polls []PollSummary
+	for _, msg := range messages {
+		poll, err := model.DecodePoll(msg.Poll)
+		if err != nil {
+			logger.Warnf("[Summarizer] 消息 %d: %v", msg.MessageID, err)
+			continue
+		}
+		if poll == nil {
+			continue
+		}
+		summary := PollSummary{
+			Question:    poll.Question,
+			TotalVoters: poll.TotalVoters,
+			Closed:      poll.Closed,
+			MessageID:   linkMessageID(msg),
+		}
+		for _, option := range poll.Options {
+			switch {
+			case option.Voters <= 0 || option.Voters < summary.Votes:
+			case option.Voters > summary.Votes:
+				summary.Winners, summary.Votes = []string{option.Text}, option.Voters
+			default:
+				summary.Winners = append(summary.Winners, option.Text)
+			}
+		}
+		polls = append(polls, summary)
+	}
+	return polls
+}
+
+// singleThread 区间内的消息均来自同一个论坛话题时返回其消息线程ID，否则返回 0（总结发送到 General）
+func singleThread(messages []*ent.Message) int64 {
+	if len(messages) == 0 {
+		return 0
+	}
+	threadID := messages[0].MessageThreadID
+	for _, msg := range messages[1:] {
+		if msg.MessageThreadID != threadID {
+			return 0
+		}
+	}
+	return threadID
+}
+
+// assignThreads 将每个话题归入其关键消息最多来自的论坛话题（数量相同时取先达到该数量的），找不到关键消息时为 0（General）
+func assignThreads(result *SummaryResult, messages []llm.ChatMessage) {
+	threads := make(map[int64]int64, len(messages))
+	for _, msg := range messages {
+		threads[msg.MessageID] = msg.ThreadID
+	}
+	for i := range result.Topics {
+		counts := make(map[int64]int)
+		threadID, most := int64(0), 0
+		for _, item := range result.Topics[i].Items {
+			for _, msgID := range item.MessageIDs {
+				id, ok := threads[msgID]
+				if !ok {
+					continue
+				}
+				counts[id]++
+				if counts[id] > most {
+					threadID, most = id, counts[id]
+				}
+			}
+		}
+		result.Topics[i].ThreadID = threadID
+	}
+}
+
+// SplitByThread 按话题所属的论坛话题拆分总结结果，各部分分别发送到对应的论坛话题：按话题首次出现的先后排列，
+// 高关注消息、投票、链接和活跃度只保留在第一部分。话题均属于同一个论坛话题时返回 nil
+func SplitByThread(result *SummaryResult) []*SummaryResult {
+	var order []int64
+	byThread := make(map[int64][]TopicItem)
+	for _, topic := range result.Topics {
+		if _, ok := byThread[topic.ThreadID]; !ok {
+			order = append(order, topic.ThreadID)
+		}
+		byThread[topic.ThreadID] = append(byThread[topic.ThreadID], topic)
+	}
+	if len(order) <= 1 {
+		return nil
+	}
+
+	parts := make([]*SummaryResult, len(order))
+	for i, threadID := range order {
+		part := *result
+		part.Topics = byThread[threadID]
+		part.ThreadID = threadID
+		if i > 0 {
+			part.Highlights, part.Polls, part.Activity = nil, nil, nil
+			part.Links, part.LinksOmitted = nil, 0
+		}
+		parts[i] = &part
+	}
+	return parts
+}
+
+// attachQuotes 为每个子项附上第一条能找到原文的关键消息摘录，空白折叠为单个空格后按字数截断
+func attachQuotes(result *SummaryResult, messages []llm.ChatMessage, maxRunes int) {
+	texts := make(map[int64]string, len(messages))
+	for _, msg := range messages {
+		texts[msg.MessageID] = msg.Text
+	}
+	for i := range result.Topics {
+		items := result.Topics[i].Items
+		for j := range items {
+			for _, msgID := range items[j].MessageIDs {
+				text := strings.Join(strings.Fields(texts[msgID]), " ")
+				if text != "" {
+					items[j].Quote = truncateRunes(text, maxRunes)
+					break
+				}
+			}
+		}
+	}
+}
+
+// defaultUnlinkableQuoteRunes 未配置引用时，无法生成消息链接的群组引用原文的最大字数
+const defaultUnlinkableQuoteRunes = 80
+
+// linkable 判断群组能否生成消息链接
+func linkable(chatID int64) bool {
+	return buildMessageLink(chatID, 1) != ""
+}
+
 // buildMessageLink 构造 Telegram 超级群组消息链接
-// 调用方应传入已转换的链接用短 message_id（参见 toLinkMessageID）
+// 调用方应传入已转换的链接用 message_id（参见 linkMessageID）
 // TDLib 超级群组 chat_id 格式为 -100XXXXXXXXXX，channel_id = -chat_id - 1000000000000
 func buildMessageLink(chatID int64, messageID int64) string {
 	channelID := -chatID - 1000000000000
@@ -113,32 +676,203 @@ func buildMessageLink(chatID int64, messageID int64) string {
 }
 
 // FormatSummaryForDisplay 将 SummaryResult 格式化为目标样式的 HTML 文本
-// 使用 Telegram HTML 语法：<b>粗体</b>、<a href="url">link</a>
-func FormatSummaryForDisplay(result *SummaryResult, chatID int64, startDate, endDate string) string {
+// 使用 Telegram HTML 语法：<b>粗体</b>、<a href="url">link</a>；formatter 为 nil 时使用默认展示配置
+func FormatSummaryForDisplay(result *SummaryResult, chatID int64, startTime, endTime time.Time, formatter *display.Formatter) string {
 	if result == nil || len(result.Topics) == 0 {
 		return ""
 	}
 
 	var sb strings.Builder
-
-	// 头部
-	sb.WriteString("📊 <b>群组总结</b>\n")
-	sb.WriteString(fmt.Sprintf("📅 %s 至 %s (UTC)\n", escapeHTML(startDate), escapeHTML(endDate)))
+	writeHeader(&sb, result, startTime, endTime, formatter)
 
 	// 话题列表（用户内容需 HTML 转义）
 	for i, topic := range result.Topics {
-		sb.WriteString(fmt.Sprintf("\n%d. %s\n", i+1, escapeHTML(topic.Title)))
-		for _, item := range topic.Items {
-			sb.WriteString(fmt.Sprintf("- <b>%s</b> %s", escapeHTML(item.SenderName), escapeHTML(item.Description)))
-			for _, msgID := range item.MessageIDs {
-				link := buildMessageLink(chatID, msgID)
-				if link != "" {
-					sb.WriteString(fmt.Sprintf(" [<a href=\"%s\">link</a>]", escapeHTML(link)))
-				}
-			}
-			sb.WriteString("\n")
-		}
+		writeTopic(&sb, i, topic, chatID)
+	}
+	writeHighlights(&sb, result.Highlights, chatID, formatter)
+	writePolls(&sb, result.Polls, chatID, formatter)
+	writeLinks(&sb, result.Links, result.LinksOmitted, chatID, formatter)
+	writeActivity(&sb, result.Activity, formatter)
+	writeFooter(&sb, formatter)
+
+	return sb.String()
+}
+
+// FormatDigest 精简总结：仅列出话题标题，详情通过话题按钮私聊查看（机器人模式的群聊消息）
+func FormatDigest(result *SummaryResult, startTime, endTime time.Time, formatter *display.Formatter) string {
+	if result == nil || len(result.Topics) == 0 {
+		return ""
 	}
 
+	var sb strings.Builder
+	writeHeader(&sb, result, startTime, endTime, formatter)
+	sb.WriteString("\n")
+	for i, topic := range result.Topics {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, escapeHTML(topic.Title)))
+	}
+	writePolls(&sb, result.Polls, 0, formatter)
+	sb.WriteString("\n" + formatter.T(display.TextDigestHint) + "\n")
+	writeFooter(&sb, formatter)
 	return sb.String()
 }
+
+// FormatTopicDetail 单个话题的完整内容（所有子项及消息链接）；index 为 -1 时返回完整总结，越界时返回空字符串
+func FormatTopicDetail(result *SummaryResult, chatID int64, index int, startTime, endTime time.Time, formatter *display.Formatter) string {
+	if index == -1 {
+		return FormatSummaryForDisplay(result, chatID, startTime, endTime, formatter)
+	}
+	if result == nil || index < 0 || index >= len(result.Topics) {
+		return ""
+	}
+
+	var sb strings.Builder
+	writeHeader(&sb, result, startTime, endTime, formatter)
+	writeTopic(&sb, index, result.Topics[index], chatID)
+	writeFooter(&sb, formatter)
+	return sb.String()
+}
+
+// writeHeader 写入总结头部：标题、日期区间及降级提示
+func writeHeader(sb *strings.Builder, result *SummaryResult, startTime, endTime time.Time, formatter *display.Formatter) {
+	title := formatter.T(display.TextSummaryTitle)
+	if result.ChatTitle != "" {
+		title = fmt.Sprintf(formatter.T(display.TextSummaryTitleChat), escapeHTML(result.ChatTitle))
+	}
+	sb.WriteString(fmt.Sprintf("%s<b>%s</b>\n", emojiPrefix(formatter.TitleEmoji()), title))
+	sb.WriteString(fmt.Sprintf("%s%s\n", emojiPrefix(formatter.DateEmoji()), escapeHTML(formatter.DateRange(startTime, endTime))))
+	for _, anomaly := range result.Anomalies {
+		sb.WriteString(formatAnomaly(anomaly, formatter) + "\n")
+	}
+	if result.Engine == ExtractiveEngine {
+		sb.WriteString(formatter.T(display.TextExtractiveNotice) + "\n")
+	}
+}
+
+// writeFooter 写入自定义页脚（与界面文本一样可包含 HTML 标签），未配置时不写入
+func writeFooter(sb *strings.Builder, formatter *display.Formatter) {
+	if footer := formatter.Footer(); footer != "" {
+		sb.WriteString("\n" + footer + "\n")
+	}
+}
+
+// emojiPrefix emoji 及其后的空格，emoji 为空时返回空字符串
+func emojiPrefix(emoji string) string {
+	if emoji == "" {
+		return ""
+	}
+	return emoji + " "
+}
+
+// formatAnomaly 活跃度异常提示，如 "⚡ 消息量是平日的 5.0 倍"
+func formatAnomaly(anomaly ActivityAnomaly, formatter *display.Formatter) string {
+	metric := formatter.T(display.TextMetricMessages)
+	if anomaly.Metric == MetricParticipants {
+		metric = formatter.T(display.TextMetricParticipants)
+	}
+	if anomaly.Ratio >= 1 {
+		return fmt.Sprintf(formatter.T(display.TextAnomalyHigh), metric, anomaly.Ratio)
+	}
+	return fmt.Sprintf(formatter.T(display.TextAnomalyLow), metric, anomaly.Ratio*100)
+}
+
+// writeTopic 写入第 index 个话题及其子项，子项后附消息链接
+func writeTopic(sb *strings.Builder, index int, topic TopicItem, chatID int64) {
+	sb.WriteString(fmt.Sprintf("\n%d. %s\n", index+1, escapeHTML(topic.Title)))
+	for _, item := range topic.Items {
+		writeItem(sb, item, chatID)
+	}
+}
+
+// writeHighlights 写入回应最多的消息：发言者、摘录、回应数及消息链接，没有时不写入
+func writeHighlights(sb *strings.Builder, highlights []Highlight, chatID int64, formatter *display.Formatter) {
+	if len(highlights) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n<b>%s</b>\n", formatter.T(display.TextMostReacted)))
+	for _, h := range highlights {
+		sb.WriteString(fmt.Sprintf("- <b>%s</b> %s (%d)", escapeHTML(h.SenderName), escapeHTML(h.Text), h.Reactions))
+		if link := buildMessageLink(chatID, h.MessageID); link != "" {
+			sb.WriteString(fmt.Sprintf(" [<a href=\"%s\">link</a>]", escapeHTML(link)))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// writePolls 写入区间内的投票：问题及领先选项，能生成链接时附消息链接；没有投票时不写入
+func writePolls(sb *strings.Builder, polls []PollSummary, chatID int64, formatter *display.Formatter) {
+	if len(polls) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n<b>%s</b>\n", formatter.T(display.TextPolls)))
+	for _, poll := range polls {
+		question := escapeHTML(poll.Question)
+		if len(poll.Winners) == 0 {
+			sb.WriteString("- " + fmt.Sprintf(formatter.T(display.TextPollNoResult), question, poll.TotalVoters))
+		} else {
+			winners := escapeHTML(strings.Join(poll.Winners, formatter.T(display.TextListSeparator)))
+			sb.WriteString("- " + fmt.Sprintf(formatter.T(display.TextPollWinner), question, winners, poll.Votes, poll.TotalVoters))
+		}
+		if link := buildMessageLink(chatID, poll.MessageID); link != "" {
+			sb.WriteString(fmt.Sprintf(" [<a href=\"%s\">link</a>]", escapeHTML(link)))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// linkLabelRunes 链接显示文字的最大字数
+const linkLabelRunes = 60
+
+// writeLinks 写入区间内分享的链接及分享者，能生成链接时附分享消息的链接；没有链接时不写入
+func writeLinks(sb *strings.Builder, links []SharedLink, omitted int, chatID int64, formatter *display.Formatter) {
+	if len(links) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("\n<b>%s</b>\n", formatter.T(display.TextLinks)))
+	for _, link := range links {
+		label := link.URL
+		if i := strings.Index(label, "://"); i >= 0 {
+			label = label[i+3:]
+		}
+		label = truncateRunes(strings.TrimSuffix(label, "/"), linkLabelRunes)
+		sb.WriteString(fmt.Sprintf("- <a href=\"%s\">%s</a> (%s)", escapeHTML(link.URL), escapeHTML(label), escapeHTML(link.SenderName)))
+		if msgLink := buildMessageLink(chatID, link.MessageID); msgLink != "" {
+			sb.WriteString(fmt.Sprintf(" [<a href=\"%s\">link</a>]", escapeHTML(msgLink)))
+		}
+		sb.WriteString("\n")
+	}
+	if omitted > 0 {
+		sb.WriteString(fmt.Sprintf(formatter.T(display.TextLinksOmitted), omitted) + "\n")
+	}
+}
+
+// writeActivity 在一行中写入最活跃的成员及其消息数，含非文字消息时注明其数量；未统计活跃度时不写入
+func writeActivity(sb *strings.Builder, activity []SenderActivity, formatter *display.Formatter) {
+	if len(activity) == 0 {
+		return
+	}
+	entries := make([]string, len(activity))
+	for i, a := range activity {
+		name := escapeHTML(a.SenderName)
+		if a.Events > 0 {
+			entries[i] = fmt.Sprintf(formatter.T(display.TextActivityWithEvents), name, a.Messages+a.Events, a.Events)
+		} else {
+			entries[i] = fmt.Sprintf(formatter.T(display.TextActivityEntry), name, a.Messages)
+		}
+	}
+	sb.WriteString(fmt.Sprintf("\n<b>%s</b>\n%s\n", formatter.T(display.TextActivity), strings.Join(entries, formatter.T(display.TextListSeparator))))
+}
+
+// writeItem 写入话题的单个子项、消息链接及原文引用
+func writeItem(sb *strings.Builder, item TopicSubItem, chatID int64) {
+	sb.WriteString(fmt.Sprintf("- <b>%s</b> %s", escapeHTML(item.SenderName), escapeHTML(item.Description)))
+	for _, msgID := range item.MessageIDs {
+		link := buildMessageLink(chatID, msgID)
+		if link != "" {
+			sb.WriteString(fmt.Sprintf(" [<a href=\"%s\">link</a>]", escapeHTML(link)))
+		}
+	}
+	sb.WriteString("\n")
+	if item.Quote != "" {
+		sb.WriteString(fmt.Sprintf("  <i>“%s”</i>\n", escapeHTML(item.Quote)))
+	}
+}
@@ -3,15 +3,21 @@ package summarizer
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
 	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/errs"
 	"github.com/fachebot/talk-trace-bot/internal/llm"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-// mockMessageProvider 用于测试的 messageProvider mock
+// mockMessageProvider 用于测试的 MessageProvider mock
 type mockMessageProvider struct {
 	messages []*ent.Message
 	err      error
@@ -24,13 +30,33 @@ func (m *mockMessageProvider) GetByDateRangeAndChat(ctx context.Context, chatID
 	return m.messages, nil
 }
 
-// mockLLMSummarizer 用于测试的 llmSummarizer mock
-type mockLLMSummarizer struct {
+// mockEventProvider 用于测试的 EventProvider mock
+type mockEventProvider struct {
+	events []*ent.Message
+	err    error
+}
+
+func (m *mockEventProvider) GetEventsByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	return m.events, m.err
+}
+
+// mockLinkProvider 用于测试的 LinkProvider mock
+type mockLinkProvider struct {
+	links []*ent.SharedLink
+	err   error
+}
+
+func (m *mockLinkProvider) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.SharedLink, error) {
+	return m.links, m.err
+}
+
+// mockSummaryEngine 用于测试的 SummaryEngine mock
+type mockSummaryEngine struct {
 	jsonResp string
 	err      error
 }
 
-func (m *mockLLMSummarizer) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+func (m *mockSummaryEngine) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
 	if m.err != nil {
 		return "", m.err
 	}
@@ -55,24 +81,24 @@ func TestFormatSummaryForDisplay(t *testing.T) {
 		name      string
 		result    *SummaryResult
 		chatID    int64
-		startDate string
-		endDate   string
+		startTime time.Time
+		endTime   time.Time
 		want      string
 	}{
 		{
 			name:      "nil result 返回空字符串",
 			result:    nil,
 			chatID:    chatID,
-			startDate: "2026-02-11",
-			endDate:   "2026-02-11",
+			startTime: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
 			want:      "",
 		},
 		{
 			name:      "空结果返回空字符串",
 			result:    &SummaryResult{},
 			chatID:    chatID,
-			startDate: "2026-02-11",
-			endDate:   "2026-02-11",
+			startTime: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
 			want:      "",
 		},
 		{
@@ -97,8 +123,8 @@ func TestFormatSummaryForDisplay(t *testing.T) {
 				},
 			},
 			chatID:    chatID,
-			startDate: "2026-02-11",
-			endDate:   "2026-02-11",
+			startTime: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
 			want: "📊 <b>群组总结</b>\n📅 2026-02-11 至 2026-02-11 (UTC)\n\n" +
 				"1. 技术方案讨论\n" +
 				"- <b>张三</b> 分享了技术方案 [<a href=\"https://t.me/c/1427755127/100\">link</a>] [<a href=\"https://t.me/c/1427755127/101\">link</a>]\n" +
@@ -123,24 +149,127 @@ func TestFormatSummaryForDisplay(t *testing.T) {
 				},
 			},
 			chatID:    chatID,
-			startDate: "2026-02-10",
-			endDate:   "2026-02-11",
+			startTime: time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
 			want: "📊 <b>群组总结</b>\n📅 2026-02-10 至 2026-02-11 (UTC)\n\n" +
 				"1. 话题一\n" +
 				"- <b>A</b> 说了什么 [<a href=\"https://t.me/c/1427755127/1\">link</a>]\n\n" +
 				"2. 话题二\n" +
 				"- <b>B</b> 做了什么 [<a href=\"https://t.me/c/1427755127/2\">link</a>]\n",
 		},
+		{
+			name: "头部显示群聊名称并转义",
+			result: &SummaryResult{
+				ChatTitle: "Go <夜读>",
+				Topics: []TopicItem{
+					{Title: "话题", Items: []TopicSubItem{{SenderName: "A", Description: "说了什么", MessageIDs: []int64{1}}}},
+				},
+			},
+			chatID:    chatID,
+			startTime: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
+			want: "📊 <b>群组总结：Go &lt;夜读&gt;</b>\n📅 2026-02-11 至 2026-02-11 (UTC)\n\n" +
+				"1. 话题\n" +
+				"- <b>A</b> 说了什么 [<a href=\"https://t.me/c/1427755127/1\">link</a>]\n",
+		},
+		{
+			name: "头部显示活跃度异常",
+			result: &SummaryResult{
+				Anomalies: []ActivityAnomaly{{Metric: MetricMessages, Ratio: 5}, {Metric: MetricParticipants, Ratio: 0.2}},
+				Topics: []TopicItem{
+					{Title: "话题", Items: []TopicSubItem{{SenderName: "A", Description: "说了什么", MessageIDs: []int64{1}}}},
+				},
+			},
+			chatID:    chatID,
+			startTime: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC),
+			want: "📊 <b>群组总结</b>\n📅 2026-02-11 至 2026-02-11 (UTC)\n" +
+				"⚡ 消息量是平日的 5.0 倍\n📉 发言人数仅为平日的 20%\n\n" +
+				"1. 话题\n" +
+				"- <b>A</b> 说了什么 [<a href=\"https://t.me/c/1427755127/1\">link</a>]\n",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := FormatSummaryForDisplay(tt.result, tt.chatID, tt.startDate, tt.endDate)
+			got := FormatSummaryForDisplay(tt.result, tt.chatID, tt.startTime, tt.endTime, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestFormatSummaryForDisplay_Branding(t *testing.T) {
+	emoji := "🛰"
+	empty := ""
+	formatter, err := display.NewFormatter(&config.Display{
+		TitleEmoji: &emoji,
+		DateEmoji:  &empty,
+		Footer:     "generated by talk-trace-bot",
+		Texts:      map[string]string{"summary_title_chat": "Acme 日报 · %s"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	result := &SummaryResult{
+		ChatTitle: "研发群",
+		Topics:    []TopicItem{{Title: "发布计划", Items: []TopicSubItem{{SenderName: "张三", Description: "确认了发布时间"}}}},
+	}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), formatter)
+	assert.True(t, strings.HasPrefix(got, "🛰 <b>Acme 日报 · 研发群</b>\n2026-02-11 至 2026-02-11 (UTC)\n"), got)
+	assert.True(t, strings.HasSuffix(got, "\ngenerated by talk-trace-bot\n"), got)
+
+	digest := FormatDigest(result, start, start.AddDate(0, 0, 1), formatter)
+	assert.True(t, strings.HasSuffix(digest, "\ngenerated by talk-trace-bot\n"), digest)
+}
+
+func TestFormatDigest(t *testing.T) {
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC)
+	result := &SummaryResult{
+		Topics: []TopicItem{
+			{Title: "话题<一>", Items: []TopicSubItem{{SenderName: "A", Description: "说了什么", MessageIDs: []int64{1}}}},
+			{Title: "话题二", Items: []TopicSubItem{{SenderName: "B", Description: "做了什么", MessageIDs: []int64{2}}}},
+		},
+	}
+
+	assert.Equal(t, "", FormatDigest(nil, start, end, nil))
+	assert.Equal(t, "📊 <b>群组总结</b>\n📅 2026-02-11 至 2026-02-11 (UTC)\n\n"+
+		"1. 话题&lt;一&gt;\n"+
+		"2. 话题二\n\n"+
+		"👇 点击话题按钮，私聊查看完整内容和消息链接\n", FormatDigest(result, start, end, nil))
+}
+
+func TestFormatTopicDetail(t *testing.T) {
+	chatID := int64(-1001427755127)
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC)
+	result := &SummaryResult{
+		Topics: []TopicItem{
+			{Title: "话题一", Items: []TopicSubItem{{SenderName: "A", Description: "说了什么", MessageIDs: []int64{1}}}},
+			{Title: "话题二", Items: []TopicSubItem{{SenderName: "B", Description: "做了什么", MessageIDs: []int64{2}}}},
+		},
+	}
+	header := "📊 <b>群组总结</b>\n📅 2026-02-11 至 2026-02-11 (UTC)\n"
+
+	tests := []struct {
+		name  string
+		index int
+		want  string
+	}{
+		{"单个话题保留序号", 1, header + "\n2. 话题二\n- <b>B</b> 做了什么 [<a href=\"https://t.me/c/1427755127/2\">link</a>]\n"},
+		{"-1 返回完整总结", -1, FormatSummaryForDisplay(result, chatID, start, end, nil)},
+		{"越界返回空字符串", 2, ""},
+		{"负数越界返回空字符串", -2, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatTopicDetail(result, chatID, tt.index, start, end, nil))
+		})
+	}
+}
+
 func TestToLinkMessageID(t *testing.T) {
 	tests := []struct {
 		name string
@@ -159,6 +288,11 @@ func TestToLinkMessageID(t *testing.T) {
 	}
 }
 
+func TestLinkMessageID(t *testing.T) {
+	assert.Equal(t, int64(26829), linkMessageID(&ent.Message{MessageID: 28132245504, ServerMessageID: 26829}), "优先使用服务器消息ID")
+	assert.Equal(t, int64(26829), linkMessageID(&ent.Message{MessageID: 28132245504}), "旧数据按 TDLib ID 推算")
+}
+
 func TestBuildMessageLink(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -235,7 +369,7 @@ func TestSummarizeRange_LLMError(t *testing.T) {
 				mustEntMessage(100, 1, "张三", "你好", now),
 			},
 		},
-		llmClient: &mockLLMSummarizer{err: errors.New("api error")},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{err: errors.New("api error")}},
 	}
 	ctx := context.Background()
 	start := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
@@ -255,7 +389,7 @@ func TestSummarizeRange_InvalidJSON(t *testing.T) {
 				mustEntMessage(100, 1, "张三", "你好", now),
 			},
 		},
-		llmClient: &mockLLMSummarizer{jsonResp: "not valid json"},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: "not valid json"}},
 	}
 	ctx := context.Background()
 	start := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
@@ -265,6 +399,7 @@ func TestSummarizeRange_InvalidJSON(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "解析")
+	assert.ErrorIs(t, err, errs.ErrParse)
 }
 
 func TestSummarizeRange_Success(t *testing.T) {
@@ -278,7 +413,7 @@ func TestSummarizeRange_Success(t *testing.T) {
 	llmResp := `{"topics":[{"title":"技术讨论","items":[{"sender_name":"张三","description":"分享了技术方案","message_ids":[100]},{"sender_name":"李四","description":"汇报了进展","message_ids":[101]}]}]}`
 	s := &Summarizer{
 		messageModel: msgProvider,
-		llmClient:    &mockLLMSummarizer{jsonResp: llmResp},
+		engines:      map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: llmResp}},
 	}
 	ctx := context.Background()
 	start := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
@@ -307,7 +442,7 @@ func TestSummarizeRange_PassesStructuredMessages(t *testing.T) {
 		},
 	}
 	var capturedMsgs []llm.ChatMessage
-	llmMock := &mockLLMSummarizer{
+	llmMock := &mockSummaryEngine{
 		jsonResp: `{"topics":[{"title":"Greetings","items":[{"sender_name":"Alice","description":"said hello","message_ids":[500]},{"sender_name":"Bob","description":"said hi","message_ids":[501]}]}]}`,
 	}
 	llmWrapper := &capturingLLM{
@@ -316,7 +451,7 @@ func TestSummarizeRange_PassesStructuredMessages(t *testing.T) {
 	}
 	s := &Summarizer{
 		messageModel: msgProvider,
-		llmClient:    llmWrapper,
+		engines:      map[string]SummaryEngine{DefaultEngine: llmWrapper},
 	}
 	ctx := context.Background()
 	start := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
@@ -337,7 +472,7 @@ func TestSummarizeRange_PassesStructuredMessages(t *testing.T) {
 
 // capturingLLM 用于在测试中捕获传给 SummarizeChat 的消息数组
 type capturingLLM struct {
-	inner   llmSummarizer
+	inner   SummaryEngine
 	capture func([]llm.ChatMessage)
 }
 
@@ -345,3 +480,514 @@ func (c *capturingLLM) SummarizeChat(ctx context.Context, messages []llm.ChatMes
 	c.capture(messages)
 	return c.inner.SummarizeChat(ctx, messages)
 }
+
+func TestSummarizeRange_Languages(t *testing.T) {
+	now := time.Now()
+	zh := mustEntMessage(100, 1, "张三", "今天讨论了部署", now)
+	zh.Lang = "zh"
+	en := mustEntMessage(101, 2, "Bob", "deploy is done", now)
+	en.Lang = "en"
+	en2 := mustEntMessage(102, 2, "Bob", "see you tomorrow", now)
+	en2.Lang = "en"
+	msgProvider := &mockMessageProvider{
+		messages: []*ent.Message{zh, en, en2, mustEntMessage(103, 3, "王五", "👍", now)},
+	}
+	s := &Summarizer{
+		messageModel: msgProvider,
+		engines:      map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, map[string]int{"zh": 1, "en": 2}, result.Languages)
+	}
+}
+
+func TestSummarizeRange_MinMessageRunes(t *testing.T) {
+	now := time.Now()
+	messages := []*ent.Message{
+		mustEntMessage(100, 1, "张三", "哈哈", now),
+		mustEntMessage(101, 2, "Bob", " +1 ", now),
+		mustEntMessage(102, 3, "王五", "👍", now),
+		mustEntMessage(103, 1, "张三", "明天发版", now),
+	}
+
+	var captured []llm.ChatMessage
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: messages},
+		engines: map[string]SummaryEngine{DefaultEngine: &capturingLLM{
+			inner:   &mockSummaryEngine{jsonResp: `{"topics":[]}`},
+			capture: func(msgs []llm.ChatMessage) { captured = msgs },
+		}},
+	}
+	s.SetMinMessageRunes(3)
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	require.Len(t, captured, 1, "过短的消息不提交给总结引擎")
+	assert.Equal(t, "明天发版", captured[0].Text)
+	assert.Equal(t, 4, result.MessageCount, "过短的消息仍计入消息数")
+	assert.Equal(t, 3, result.ParticipantCount)
+	assert.Equal(t, 4, result.PromptBudget.RawMessages)
+	assert.Equal(t, 1, result.PromptBudget.FilteredMessages)
+	assert.Less(t, result.PromptBudget.FilteredTokens, result.PromptBudget.RawTokens)
+
+	t.Run("消息均过短时保留统计", func(t *testing.T) {
+		captured = nil
+		s.messageModel = &mockMessageProvider{messages: messages[:3]}
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Nil(t, captured, "不调用总结引擎")
+		if assert.NotNil(t, result) {
+			assert.Empty(t, result.Topics)
+			assert.Equal(t, 3, result.MessageCount)
+			assert.Equal(t, 3, result.ParticipantCount)
+			assert.Equal(t, 3, result.PromptBudget.RawMessages)
+			assert.Zero(t, result.PromptBudget.FilteredTokens)
+		}
+	})
+}
+
+func TestSummarizeRange_ForumThreads(t *testing.T) {
+	now := time.Now()
+	inThread := func(msg *ent.Message, threadID int64) *ent.Message {
+		msg.MessageThreadID = threadID
+		return msg
+	}
+	var captured []llm.ChatMessage
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{
+			inThread(mustEntMessage(100, 1, "张三", "周五发布新版本", now), 10),
+			inThread(mustEntMessage(101, 2, "Bob", "周末团建去爬山", now), 20),
+			inThread(mustEntMessage(102, 3, "王五", "发布说明我来写", now), 10),
+			inThread(mustEntMessage(103, 2, "Bob", "爬山记得带水", now), 20),
+		}},
+		engines: map[string]SummaryEngine{DefaultEngine: &capturingLLM{
+			inner: &mockSummaryEngine{jsonResp: `{"topics":[
+				{"title":"发布","items":[{"sender_name":"张三","description":"周五发布","message_ids":[100,102]}]},
+				{"title":"团建","items":[{"sender_name":"Bob","description":"周末爬山","message_ids":[101,103]}]}]}`},
+			capture: func(msgs []llm.ChatMessage) { captured = msgs },
+		}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	ids := make([]int64, len(captured))
+	for i, msg := range captured {
+		ids[i] = msg.MessageID
+	}
+	assert.Equal(t, []int64{100, 102, 101, 103}, ids, "按论坛话题分组，话题内保持时间顺序")
+	assert.Equal(t, int64(10), captured[0].ThreadID)
+	assert.Zero(t, result.ThreadID, "消息来自多个论坛话题时发送到 General")
+	if assert.Len(t, result.Topics, 2) {
+		assert.Equal(t, int64(10), result.Topics[0].ThreadID, "话题归入关键消息所在的论坛话题")
+		assert.Equal(t, int64(20), result.Topics[1].ThreadID)
+	}
+
+	t.Run("按论坛话题拆分", func(t *testing.T) {
+		result.Highlights = []Highlight{{SenderName: "Bob", Text: "爬山记得带水", MessageID: 103, Reactions: 3}}
+		parts := SplitByThread(result)
+		require.Len(t, parts, 2)
+		assert.Equal(t, int64(10), parts[0].ThreadID)
+		assert.Equal(t, "发布", parts[0].Topics[0].Title)
+		assert.Len(t, parts[0].Highlights, 1, "高关注消息只保留在第一部分")
+		assert.Equal(t, int64(20), parts[1].ThreadID)
+		assert.Equal(t, "团建", parts[1].Topics[0].Title)
+		assert.Empty(t, parts[1].Highlights)
+
+		single := &SummaryResult{Topics: []TopicItem{{Title: "发布", ThreadID: 10}, {Title: "回滚", ThreadID: 10}}}
+		assert.Nil(t, SplitByThread(single), "话题均属于同一个论坛话题时不拆分")
+	})
+
+	t.Run("消息均来自同一个论坛话题", func(t *testing.T) {
+		s.messageModel = &mockMessageProvider{messages: []*ent.Message{
+			inThread(mustEntMessage(100, 1, "张三", "周五发布新版本", now), 10),
+			inThread(mustEntMessage(102, 3, "王五", "发布说明我来写", now), 10),
+		}}
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), result.ThreadID)
+	})
+}
+
+func TestSummarizeRange_ReplyContext(t *testing.T) {
+	now := time.Now()
+	question := mustEntMessage(5<<20, 1, "张三", "周五能发布吗", now)
+	question.ServerMessageID = 5
+	answer := mustEntMessage(6<<20, 2, "李四", "可以，测试已经通过", now)
+	answer.ServerMessageID = 6
+	answer.ReplyToMessageID = 5 << 20
+	outside := mustEntMessage(7<<20, 3, "王五", "回复区间外的消息", now)
+	outside.ReplyToMessageID = 1 << 20
+
+	var captured []llm.ChatMessage
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{question, answer, outside}},
+		engines: map[string]SummaryEngine{DefaultEngine: &capturingLLM{
+			inner:   &mockSummaryEngine{jsonResp: `{"topics":[]}`},
+			capture: func(msgs []llm.ChatMessage) { captured = msgs },
+		}},
+	}
+
+	_, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	require.Len(t, captured, 3)
+	assert.Zero(t, captured[0].ReplyToID)
+	assert.Equal(t, int64(5), captured[1].ReplyToID, "回复关系使用链接用 ID")
+	assert.Equal(t, "张三", captured[1].ReplyToName)
+	assert.Zero(t, captured[2].ReplyToID, "被回复的消息不在区间内时不附带")
+}
+
+func TestSummarizeRange_Highlights(t *testing.T) {
+	now := time.Now()
+	reacted := func(msg *ent.Message, n int64) *ent.Message {
+		msg.ReactionCount = n
+		return msg
+	}
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{
+			reacted(mustEntMessage(100, 1, "张三", "周五发布新版本", now), 2),
+			mustEntMessage(101, 2, "李四", "发布说明我来写", now),
+			reacted(mustEntMessage(102, 3, "王五", "新版本\n支持   暗色模式", now), 9),
+			reacted(mustEntMessage(103, 1, "张三", "下周一复盘", now), 2),
+			reacted(mustEntMessage(104, 2, "李四", "收到", now), 1),
+		}},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, []Highlight{
+		{SenderName: "王五", Text: "新版本 支持 暗色模式", MessageID: 102, Reactions: 9},
+		{SenderName: "张三", Text: "周五发布新版本", MessageID: 100, Reactions: 2},
+		{SenderName: "张三", Text: "下周一复盘", MessageID: 103, Reactions: 2},
+	}, result.Highlights, "按回应数倒序，相同时按时间顺序，最多 3 条")
+
+	t.Run("没有回应时不列出", func(t *testing.T) {
+		s.messageModel = &mockMessageProvider{messages: []*ent.Message{mustEntMessage(100, 1, "张三", "周五发布新版本", now)}}
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Nil(t, result.Highlights)
+	})
+}
+
+func TestFormatSummaryForDisplay_Highlights(t *testing.T) {
+	result := &SummaryResult{
+		Topics:     []TopicItem{{Title: "发布计划", Items: []TopicSubItem{{SenderName: "张三", Description: "确认了发布时间"}}}},
+		Highlights: []Highlight{{SenderName: "王五", Text: "支持<暗色>模式", MessageID: 102, Reactions: 9}},
+	}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil)
+	assert.Contains(t, got, "\n<b>🔥 最多回应</b>\n- <b>王五</b> 支持&lt;暗色&gt;模式 (9) [<a href=\"https://t.me/c/1427755127/102\">link</a>]\n")
+	assert.Less(t, strings.Index(got, "发布计划"), strings.Index(got, "最多回应"), "列在话题之后")
+
+	result.Highlights = nil
+	assert.NotContains(t, FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil), "最多回应")
+}
+
+func TestSummarizeRange_Polls(t *testing.T) {
+	now := time.Now()
+	withPoll := func(msg *ent.Message, poll string) *ent.Message {
+		msg.Poll = poll
+		return msg
+	}
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{
+			withPoll(mustEntMessage(100, 1, "张三", "周会时间", now), `{"question":"周会时间","options":[{"text":"周三","voters":4},{"text":"周四","voters":4},{"text":"周五","voters":1}],"total_voters":9,"closed":true}`),
+			mustEntMessage(101, 2, "李四", "我都可以", now),
+			withPoll(mustEntMessage(102, 3, "王五", "团建地点", now), `{"question":"团建地点","options":[{"text":"爬山"},{"text":"露营"}],"total_voters":5}`),
+			withPoll(mustEntMessage(103, 1, "张三", "损坏的投票", now), `{`),
+		}},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, []PollSummary{
+		{Question: "周会时间", Winners: []string{"周三", "周四"}, Votes: 4, TotalVoters: 9, Closed: true, MessageID: 100},
+		{Question: "团建地点", TotalVoters: 5, MessageID: 102},
+	}, result.Polls, "并列时列出全部领先选项，得票数未公开时没有领先选项，无法解析的投票跳过")
+}
+
+func TestFormatSummaryForDisplay_Polls(t *testing.T) {
+	result := &SummaryResult{
+		Topics: []TopicItem{{Title: "周会安排", Items: []TopicSubItem{{SenderName: "张三", Description: "发起了投票"}}}},
+		Polls: []PollSummary{
+			{Question: "周会<时间>", Winners: []string{"周三", "周四"}, Votes: 4, TotalVoters: 9, MessageID: 100},
+			{Question: "团建地点", TotalVoters: 5, MessageID: 102},
+		},
+	}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil)
+	assert.Contains(t, got, "\n<b>🗳 投票</b>\n"+
+		"- 周会&lt;时间&gt; → 周三；周四（4 票，共 9 人参与） [<a href=\"https://t.me/c/1427755127/100\">link</a>]\n"+
+		"- 团建地点（5 人参与，结果尚未公开） [<a href=\"https://t.me/c/1427755127/102\">link</a>]\n")
+
+	digest := FormatDigest(result, start, start.AddDate(0, 0, 1), nil)
+	assert.Contains(t, digest, "\n<b>🗳 投票</b>\n- 周会&lt;时间&gt; → 周三；周四（4 票，共 9 人参与）\n", "摘要中不附消息链接")
+
+	result.Polls = nil
+	assert.NotContains(t, FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil), "投票</b>")
+}
+
+func TestSummarizeRange_Activity(t *testing.T) {
+	now := time.Now()
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{
+			mustEntMessage(100, 1, "张三", "周五发布新版本", now.Add(-50*time.Minute)),
+			mustEntMessage(101, 2, "李四", "发布说明我来写", now.Add(-40*time.Minute)),
+			mustEntMessage(102, 1, "张三", "好的", now.Add(-30*time.Minute)),
+		}},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Nil(t, result.Activity, "未记录事件时不统计活跃度")
+	assert.Equal(t, 2, result.ParticipantCount)
+
+	s.SetEventProvider(&mockEventProvider{events: []*ent.Message{
+		{SenderID: 3, SenderName: "王五", SentAt: now.Add(-45 * time.Minute), ContentType: "sticker"},
+		{SenderID: 3, SenderName: "王五", SentAt: now.Add(-35 * time.Minute), ContentType: "animation"},
+		{SenderID: 2, SenderName: "李四", SentAt: now.Add(-20 * time.Minute), ContentType: "sticker"},
+	}})
+	result, err = s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, []SenderActivity{
+		{SenderName: "张三", Messages: 2},
+		{SenderName: "王五", Events: 2},
+		{SenderName: "李四", Messages: 1, Events: 1},
+	}, result.Activity, "按消息数与事件数之和倒序，相同时按首次发言的先后")
+	assert.Equal(t, 3, result.ParticipantCount, "只发送了非文字消息的成员计入发言人数")
+	assert.Equal(t, 3, result.MessageCount, "事件不计入消息数")
+
+	t.Run("获取事件失败时不统计", func(t *testing.T) {
+		s.SetEventProvider(&mockEventProvider{err: errors.New("连接失败")})
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Nil(t, result.Activity)
+		assert.Equal(t, 2, result.ParticipantCount)
+	})
+}
+
+func TestFormatSummaryForDisplay_Activity(t *testing.T) {
+	result := &SummaryResult{
+		Topics:   []TopicItem{{Title: "发布计划", Items: []TopicSubItem{{SenderName: "张三", Description: "确认了发布时间"}}}},
+		Activity: []SenderActivity{{SenderName: "张<三>", Messages: 2}, {SenderName: "王五", Events: 2}, {SenderName: "李四", Messages: 1, Events: 1}},
+	}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil)
+	assert.True(t, strings.HasSuffix(got, "\n<b>👥 活跃成员</b>\n张&lt;三&gt; 2 条；王五 2 条（含非文字 2 条）；李四 2 条（含非文字 1 条）\n"), got)
+
+	result.Activity = nil
+	assert.NotContains(t, FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil), "活跃成员")
+}
+
+func TestSummarizeRange_Links(t *testing.T) {
+	now := time.Now()
+	s := &Summarizer{
+		messageModel: &mockMessageProvider{messages: []*ent.Message{
+			mustEntMessage(100, 1, "张三", "文档见 https://go.dev/doc", now.Add(-50*time.Minute)),
+			mustEntMessage(101, 2, "李四", "还有这个 https://go.dev/doc", now.Add(-40*time.Minute)),
+			mustEntMessage(102, 2, "李四", "https://example.com/issue/1", now.Add(-30*time.Minute)),
+		}},
+		engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+	}
+
+	result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Nil(t, result.Links, "未启用时不汇总链接")
+
+	s.SetLinkProvider(&mockLinkProvider{links: []*ent.SharedLink{
+		{MessageID: 100, SenderName: "张三", URL: "https://go.dev/doc"},
+		{MessageID: 101, SenderName: "李四", URL: "https://go.dev/doc"},
+		{MessageID: 99, SenderName: "王五", URL: "https://deleted.example.com"},
+		{MessageID: 102, SenderName: "李四", URL: "https://example.com/issue/1"},
+	}})
+	result, err = s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, []SharedLink{
+		{URL: "https://go.dev/doc", SenderName: "张三", MessageID: 100},
+		{URL: "https://example.com/issue/1", SenderName: "李四", MessageID: 102},
+	}, result.Links, "同一链接只保留首次分享，消息已删除的链接跳过")
+	assert.Zero(t, result.LinksOmitted)
+
+	t.Run("超出上限", func(t *testing.T) {
+		var links []*ent.SharedLink
+		for i := 0; i < linksMax+3; i++ {
+			links = append(links, &ent.SharedLink{MessageID: 100, SenderName: "张三", URL: fmt.Sprintf("https://example.com/%d", i)})
+		}
+		s.SetLinkProvider(&mockLinkProvider{links: links})
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Len(t, result.Links, linksMax)
+		assert.Equal(t, 3, result.LinksOmitted)
+	})
+
+	t.Run("获取链接失败时不汇总", func(t *testing.T) {
+		s.SetLinkProvider(&mockLinkProvider{err: errors.New("连接失败")})
+		result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+		require.NoError(t, err)
+		assert.Nil(t, result.Links)
+	})
+}
+
+func TestFormatSummaryForDisplay_Links(t *testing.T) {
+	result := &SummaryResult{
+		Topics: []TopicItem{{Title: "文档", Items: []TopicSubItem{{SenderName: "张三", Description: "分享了文档"}}}},
+		Links: []SharedLink{
+			{URL: "https://go.dev/doc/", SenderName: "张<三>", MessageID: 100},
+			{URL: "https://example.com/search?q=a&lang=" + strings.Repeat("x", 60), SenderName: "李四", MessageID: 102},
+		},
+		LinksOmitted: 2,
+	}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil)
+	longURL := "https://example.com/search?q=a&amp;lang=" + strings.Repeat("x", 60)
+	assert.Contains(t, got, "\n<b>🔗 今日链接</b>\n"+
+		"- <a href=\"https://go.dev/doc/\">go.dev/doc</a> (张&lt;三&gt;) [<a href=\"https://t.me/c/1427755127/100\">link</a>]\n"+
+		"- <a href=\""+longURL+"\">example.com/search?q=a&amp;lang="+strings.Repeat("x", 32)+"…</a> (李四) [<a href=\"https://t.me/c/1427755127/102\">link</a>]\n"+
+		"……另有 2 个链接\n")
+
+	result.Links = nil
+	assert.NotContains(t, FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil), "今日链接")
+}
+
+func TestOutputLanguage(t *testing.T) {
+	languages := map[string]int{"zh": 3, "en": 7}
+	tests := []struct {
+		name     string
+		setting  string
+		expected string
+	}{
+		{"默认中文", "", ""},
+		{"指定语言", "ja", "ja"},
+		{"自动选择占比最高的语言", "auto", "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{}
+			s.SetLanguage(tt.setting)
+			assert.Equal(t, tt.expected, s.outputLanguage(languages))
+		})
+	}
+
+	t.Run("自动但无语言信息", func(t *testing.T) {
+		s := &Summarizer{language: "auto"}
+		assert.Equal(t, "", s.outputLanguage(map[string]int{}))
+	})
+}
+
+// mockChatContextProvider 用于测试的 ChatContextProvider mock
+type mockChatContextProvider struct {
+	chatContext llm.ChatContext
+	err         error
+	calls       int
+}
+
+func (m *mockChatContextProvider) ChatContext(ctx context.Context, chatID int64) (llm.ChatContext, error) {
+	m.calls++
+	return m.chatContext, m.err
+}
+
+func TestSummarizeRange_ChatContext(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		provider *mockChatContextProvider
+	}{
+		{"获取成功", &mockChatContextProvider{chatContext: llm.ChatContext{Description: "运维交流"}}},
+		{"获取失败不影响总结", &mockChatContextProvider{err: errors.New("chat not found")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{
+				messageModel: &mockMessageProvider{messages: []*ent.Message{mustEntMessage(100, 1, "张三", "重启了集群", now)}},
+				engines:      map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{jsonResp: `{"topics":[]}`}},
+			}
+			s.SetChatContextProvider(tt.provider)
+
+			result, err := s.SummarizeRange(context.Background(), 123, now.Add(-time.Hour), now)
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, 1, tt.provider.calls)
+		})
+	}
+}
+
+func TestWithMessages(t *testing.T) {
+	now := time.Now()
+	chatContext := &mockChatContextProvider{}
+	s := NewSummarizer(&mockSummaryEngine{jsonResp: `{"topics":[{"title":"发布"}]}`}, &mockMessageProvider{err: errors.New("不应查询")})
+	s.SetChatContextProvider(chatContext)
+	s.SetMinMessageRunes(2)
+
+	canned := s.WithMessages(&mockMessageProvider{messages: []*ent.Message{
+		mustEntMessage(1, 1, "Alice", "周三发布", now),
+		mustEntMessage(2, 2, "Bob", "好", now),
+	}})
+	result, err := canned.SummarizeRange(context.Background(), 0, now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	assert.Len(t, result.Topics, 1)
+	assert.Equal(t, 1, result.PromptBudget.FilteredMessages, "沿用过滤设置")
+	assert.Zero(t, chatContext.calls, "不获取群聊背景")
+}
+
+func TestAttachQuotes(t *testing.T) {
+	messages := []llm.ChatMessage{
+		{MessageID: 1, Text: "  第一行\n第二行  "},
+		{MessageID: 2, Text: ""},
+		{MessageID: 3, Text: "这是一条很长的消息内容"},
+	}
+	result := &SummaryResult{Topics: []TopicItem{{Title: "话题", Items: []TopicSubItem{
+		{SenderName: "A", MessageIDs: []int64{1}},
+		{SenderName: "B", MessageIDs: []int64{2, 3}},
+		{SenderName: "C", MessageIDs: []int64{99}},
+	}}}}
+
+	attachQuotes(result, messages, 7)
+	items := result.Topics[0].Items
+	assert.Equal(t, "第一行 第二行", items[0].Quote, "空白折叠为单个空格")
+	assert.Equal(t, "这是一条很长的…", items[1].Quote, "跳过无原文的消息并截断")
+	assert.Empty(t, items[2].Quote, "找不到原文时不引用")
+}
+
+func TestFormatSummaryForDisplay_Quote(t *testing.T) {
+	result := &SummaryResult{Topics: []TopicItem{{Title: "发布计划", Items: []TopicSubItem{
+		{SenderName: "张三", Description: "确认了发布时间", MessageIDs: []int64{100}, Quote: "周五<晚上>发布"},
+	}}}}
+	start := time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)
+	got := FormatSummaryForDisplay(result, -1001427755127, start, start.AddDate(0, 0, 1), nil)
+	assert.Contains(t, got, "- <b>张三</b> 确认了发布时间 [<a href=\"https://t.me/c/1427755127/100\">link</a>]\n  <i>“周五&lt;晚上&gt;发布”</i>\n")
+}
+
+func TestSummarizeRange_QuotesForUnlinkableChat(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		chatID     int64
+		quoteRunes int
+		wantQuote  string
+	}{
+		{"超级群组未配置引用", -1001427755127, 0, ""},
+		{"普通群组自动引用", -123456, 0, "部署已经完成了"},
+		{"普通群组使用配置的字数", -123456, 2, "部署…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Summarizer{
+				messageModel: &mockMessageProvider{messages: []*ent.Message{mustEntMessage(100, 1, "张三", "部署已经完成了", now)}},
+				engines: map[string]SummaryEngine{DefaultEngine: &mockSummaryEngine{
+					jsonResp: `{"topics":[{"title":"部署","items":[{"sender_name":"张三","description":"完成部署","message_ids":[100]}]}]}`,
+				}},
+			}
+			s.SetQuoteMaxRunes(tt.quoteRunes)
+
+			result, err := s.SummarizeRange(context.Background(), tt.chatID, now.Add(-time.Hour), now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuote, result.Topics[0].Items[0].Quote)
+		})
+	}
+}
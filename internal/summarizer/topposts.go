@@ -0,0 +1,72 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+)
+
+// TopPostsEngine 精选帖子引擎名称：不合并话题，每条入选的帖子单独列出，适合频道
+const TopPostsEngine = "top_posts"
+
+const (
+	topPostsMax        = 10  // 最多列出的帖子数
+	topPostsTitleRunes = 40  // 标题（帖子首行）的最大字数
+	topPostsBodyRunes  = 200 // 帖子摘录的最大字数
+)
+
+// topPostsSummarizer 按内容挑选区间内的精选帖子：帖子所含不同词越多得分越高（消息未保存浏览量和回应数），
+// 每条帖子作为一个话题，以首行为标题，格式与 LLM 返回的 JSON 一致
+type topPostsSummarizer struct{}
+
+// NewTopPostsSummarizer 创建精选帖子引擎
+func NewTopPostsSummarizer() SummaryEngine {
+	return topPostsSummarizer{}
+}
+
+func (topPostsSummarizer) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	type scored struct {
+		index int
+		score int
+	}
+	candidates := make([]scored, 0, len(messages))
+	for i, m := range messages {
+		seen := make(map[string]bool)
+		for _, t := range tokenize(m.Text) {
+			seen[t] = true
+		}
+		if len(seen) == 0 {
+			continue
+		}
+		candidates = append(candidates, scored{index: i, score: len(seen)})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	if len(candidates) > topPostsMax {
+		candidates = candidates[:topPostsMax]
+	}
+	// 恢复时间顺序
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].index < candidates[j].index })
+
+	result := SummaryResult{Topics: []TopicItem{}}
+	for _, c := range candidates {
+		m := messages[c.index]
+		text := strings.TrimSpace(m.Text)
+		title, _, _ := strings.Cut(text, "\n")
+		result.Topics = append(result.Topics, TopicItem{
+			Title: truncateRunes(strings.TrimSpace(title), topPostsTitleRunes),
+			Items: []TopicSubItem{{
+				SenderName:  m.SenderName,
+				Description: truncateRunes(strings.Join(strings.Fields(text), " "), topPostsBodyRunes),
+				MessageIDs:  []int64{m.MessageID},
+			}},
+		})
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
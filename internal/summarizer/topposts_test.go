@@ -0,0 +1,54 @@
+package summarizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopPostsSummarizer(t *testing.T) {
+	messages := []llm.ChatMessage{
+		{MessageID: 1, SenderName: "科技频道", Text: "新版本发布\n本次更新支持多语言总结、频道精选帖子和启动自检"},
+		{MessageID: 2, SenderName: "科技频道", Text: "👍"},
+		{MessageID: 3, SenderName: "科技频道", Text: "周末维护通知：数据库迁移期间服务暂停两小时"},
+	}
+	for i := range topPostsMax {
+		messages = append(messages, llm.ChatMessage{MessageID: int64(10 + i), SenderName: "科技频道", Text: fmt.Sprintf("短讯 %d", i)})
+	}
+
+	jsonStr, err := NewTopPostsSummarizer().SummarizeChat(context.Background(), messages)
+	require.NoError(t, err)
+
+	var result SummaryResult
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &result))
+	require.Len(t, result.Topics, topPostsMax)
+
+	first := result.Topics[0]
+	assert.Equal(t, "新版本发布", first.Title, "以首行为标题")
+	require.Len(t, first.Items, 1)
+	assert.Equal(t, "科技频道", first.Items[0].SenderName)
+	assert.Equal(t, "新版本发布 本次更新支持多语言总结、频道精选帖子和启动自检", first.Items[0].Description)
+	assert.Equal(t, []int64{1}, first.Items[0].MessageIDs)
+	assert.Equal(t, []int64{3}, result.Topics[1].Items[0].MessageIDs, "内容丰富的帖子优先入选，按时间顺序列出")
+	for _, topic := range result.Topics {
+		assert.NotEqual(t, []int64{2}, topic.Items[0].MessageIDs, "无文字内容的帖子不入选")
+	}
+}
+
+func TestTopPostsSummarizer_Truncate(t *testing.T) {
+	jsonStr, err := NewTopPostsSummarizer().SummarizeChat(context.Background(), []llm.ChatMessage{
+		{MessageID: 1, SenderName: "频道", Text: strings.Repeat("长标题", 30)},
+	})
+	require.NoError(t, err)
+
+	var result SummaryResult
+	require.NoError(t, json.Unmarshal([]byte(jsonStr), &result))
+	require.Len(t, result.Topics, 1)
+	assert.Len(t, []rune(result.Topics[0].Title), topPostsTitleRunes+1, "超长标题截断并以省略号结尾")
+}
@@ -5,15 +5,87 @@ type TopicSubItem struct {
 	SenderName  string  `json:"sender_name"`
 	Description string  `json:"description"`
 	MessageIDs  []int64 `json:"message_ids"`
+	Quote       string  `json:"quote,omitempty"` // 关键消息原文摘录，未启用引用时为空
 }
 
 // TopicItem 单个话题
 type TopicItem struct {
-	Title string         `json:"title"`
-	Items []TopicSubItem `json:"items"`
+	Title    string         `json:"title"`
+	Items    []TopicSubItem `json:"items"`
+	ThreadID int64          `json:"thread_id,omitempty"` // 关键消息所在的论坛话题，总结涉及多个论坛话题时该话题发送到这里
+}
+
+// 活跃度指标
+const (
+	MetricMessages     = "messages"     // 消息量
+	MetricParticipants = "participants" // 发言人数
+)
+
+// ActivityAnomaly 活跃度异常：本期指标与近期均值之比
+type ActivityAnomaly struct {
+	Metric string  // MetricMessages / MetricParticipants
+	Ratio  float64 // 本期值 / 近期均值，大于 1 表示高于平日
+}
+
+// PromptBudget 单个群组一次总结的 prompt token 构成，除 PromptTokens 外均为估算值。
+// 用于评估过滤规则的效果，以及定位 token 消耗大的群组
+type PromptBudget struct {
+	RawMessages      int `json:"raw_messages"`      // 区间内的消息数
+	RawTokens        int `json:"raw_tokens"`        // 区间内全部消息的 tokens
+	FilteredMessages int `json:"filtered_messages"` // 过滤过短消息后提交给总结引擎的消息数
+	FilteredTokens   int `json:"filtered_tokens"`   // 过滤后消息的 tokens
+	Chunks           int `json:"chunks"`            // LLM 总结请求数，消息过长时每个 chunk 一次
+	MessageTokens    int `json:"message_tokens"`    // 各请求中的消息内容（含发送时间）
+	OverheadTokens   int `json:"overhead_tokens"`   // 各请求中的 system prompt、群聊背景、上一轮话题总结等
+	PromptTokens     int `json:"prompt_tokens"`     // API 返回的实际输入 tokens，非 LLM 引擎为 0
+}
+
+// Highlight 区间内回应最多的一条消息
+type Highlight struct {
+	SenderName string `json:"sender_name"`
+	Text       string `json:"text"`       // 消息摘录
+	MessageID  int64  `json:"message_id"` // 链接用消息ID
+	Reactions  int64  `json:"reactions"`  // 回应总数
+}
+
+// PollSummary 区间内发起的一个投票及其领先选项
+type PollSummary struct {
+	Question    string   `json:"question"`
+	Winners     []string `json:"winners,omitempty"` // 得票最多的选项（并列时多个），得票数未公开时为空
+	Votes       int32    `json:"votes,omitempty"`   // 领先选项的得票数
+	TotalVoters int32    `json:"total_voters"`      // 参与投票的人数
+	Closed      bool     `json:"closed,omitempty"`  // 投票是否已结束
+	MessageID   int64    `json:"message_id"`        // 链接用消息ID
+}
+
+// SharedLink 区间内分享的一个链接
+type SharedLink struct {
+	URL        string `json:"url"`
+	SenderName string `json:"sender_name"` // 首次分享者
+	MessageID  int64  `json:"message_id"`  // 首次分享的链接用消息ID
+}
+
+// SenderActivity 成员在区间内的活跃度
+type SenderActivity struct {
+	SenderName string `json:"sender_name"`
+	Messages   int    `json:"messages"`         // 保存了内容的消息数
+	Events     int    `json:"events,omitempty"` // 只记录为事件的贴纸、动图等非文字消息数
 }
 
 // SummaryResult 总结结果，按话题分组
 type SummaryResult struct {
-	Topics []TopicItem `json:"topics"`
+	Topics           []TopicItem       `json:"topics"`
+	MessageCount     int               `json:"-"`                       // 参与总结的消息数
+	ParticipantCount int               `json:"-"`                       // 区间内的发言人数（记录事件时含只发送了非文字消息的成员）
+	Languages        map[string]int    `json:"-"`                       // 消息语言分布：语言代码 => 消息数（无法识别的消息不计入）
+	Engine           string            `json:"-"`                       // 生成该结果的总结引擎
+	ChatTitle        string            `json:"-"`                       // 群聊名称，为空时头部不显示
+	Anomalies        []ActivityAnomaly `json:"-"`                       // 活跃度异常，显示在头部
+	PromptBudget     PromptBudget      `json:"-"`                       // prompt token 构成
+	ThreadID         int64             `json:"thread_id,omitempty"`     // 消息均来自同一个论坛话题时为其消息线程ID，总结发送到该话题（涉及多个时见 SplitByThread）
+	Highlights       []Highlight       `json:"highlights,omitempty"`    // 回应最多的消息，按回应数倒序
+	Polls            []PollSummary     `json:"polls,omitempty"`         // 区间内发起的投票，按发起时间排序
+	Activity         []SenderActivity  `json:"activity,omitempty"`      // 最活跃的成员，按消息数与事件数之和倒序，仅记录事件时统计
+	Links            []SharedLink      `json:"links,omitempty"`         // 区间内分享的链接（同一链接只列出首次分享），按分享时间排序
+	LinksOmitted     int               `json:"links_omitted,omitempty"` // 超出列出上限未列出的链接数
 }
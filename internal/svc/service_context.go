@@ -3,39 +3,61 @@ package svc
 import (
 	"context"
 	"crypto/tls"
+	"database/sql"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/dbmigrate"
 	"github.com/fachebot/talk-trace-bot/internal/ent"
 	"github.com/fachebot/talk-trace-bot/internal/llm"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
 	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/storage"
 
 	_ "github.com/mattn/go-sqlite3"
 	"golang.org/x/net/proxy"
 )
 
+const (
+	// DatabasePath 数据库文件路径
+	DatabasePath = "data/sqlite.db"
+)
+
 type ServiceContext struct {
 	Config         *config.Config
 	DbClient       *ent.Client
 	TransportProxy *http.Transport
-	MessageModel   *model.MessageModel
+	MessageModel   storage.MessageStore
+	IngestQueue    *storage.IngestQueue
+	ChatModel      *model.ChatModel
+	BlackoutModel  *model.BlackoutModel
 	SummaryModel   *model.SummaryModel
-	TaskModel      *model.TaskModel
-	DailyRunModel  *model.DailyRunModel
+	TaskModel      storage.TaskStore
+	DailyRunModel  storage.DailyRunStore
+	SentPartModel  *model.SentPartModel
+	ViewModel      *model.SummaryViewModel
+	RevisionModel  *model.SummaryRevisionModel
+	FollowModel    *model.FollowModel
+	LinkModel      *model.SharedLinkModel
+	UserModel      *model.UserModel
+	ShadowRunModel *model.ShadowRunModel
+	RunLogModel    *model.RunLogModel
 	LLMClient      *llm.Client
 }
 
 func NewServiceContext(c *config.Config) *ServiceContext {
 	// 创建数据库连接
-	client, err := ent.Open("sqlite3", "file:data/sqlite.db?mode=rwc&_journal_mode=WAL&_fk=1")
+	db, err := OpenDatabase()
 	if err != nil {
 		logger.Fatalf("打开数据库失败, %v", err)
 	}
-	if err := client.Schema.Create(context.Background()); err != nil {
-		logger.Fatalf("创建数据库Schema失败, %v", err)
+	if err := checkMigrations(db); err != nil {
+		logger.Fatalf("%v", err)
 	}
+	client := dbmigrate.OpenClient(db)
+	model.Instrument(client)
 
 	// 创建SOCKS5代理
 	var transportProxy *http.Transport
@@ -52,19 +74,82 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 		}
 	}
 
+	messageModel := model.NewMessageModel(client.Message)
+	if c.MessageCompression.Enable && c.ClickHouse.URL == "" {
+		messageModel.EnableCompression(c.MessageCompression.MinBytes)
+		logger.Infof("消息文本压缩: 不短于 %d 字节的消息使用 zstd 压缩保存", c.MessageCompression.MinBytes)
+	}
+
 	svcCtx := &ServiceContext{
 		Config:         c,
 		DbClient:       client,
 		TransportProxy: transportProxy,
-		MessageModel:   model.NewMessageModel(client.Message),
+		MessageModel:   messageModel,
+		ChatModel:      model.NewChatModel(client.Chat),
+		BlackoutModel:  model.NewBlackoutModel(client.Blackout),
 		SummaryModel:   model.NewSummaryModel(client.Summary),
 		TaskModel:      model.NewTaskModel(client.Task),
 		DailyRunModel:  model.NewDailyRunModel(client.DailyRun),
+		SentPartModel:  model.NewSentPartModel(client.SentPart),
+		ViewModel:      model.NewSummaryViewModel(client.SummaryView),
+		RevisionModel:  model.NewSummaryRevisionModel(client.SummaryRevision),
+		FollowModel:    model.NewFollowModel(client.Follow),
+		LinkModel:      model.NewSharedLinkModel(client.SharedLink),
+		UserModel:      model.NewUserModel(client.User),
+		ShadowRunModel: model.NewShadowRunModel(client.ShadowRun),
+		RunLogModel:    model.NewRunLogModel(client.RunLog),
 		LLMClient:      llm.NewClient(&c.LLM),
 	}
+
+	// 消息量很大时消息改为保存到 ClickHouse，任务和运行记录仍保存在 SQLite
+	if c.ClickHouse.URL != "" {
+		store := storage.NewClickHouseMessageStore(&c.ClickHouse)
+		if err := store.Init(context.Background()); err != nil {
+			logger.Fatalf("初始化 ClickHouse 消息表失败, %v", err)
+		}
+		svcCtx.MessageModel = store
+		logger.Infof("消息存储: ClickHouse %s（%s.%s）", c.ClickHouse.URL, c.ClickHouse.Database, c.ClickHouse.Table)
+	}
+	if c.MessageCache.Enable {
+		svcCtx.MessageModel = storage.NewHotCache(svcCtx.MessageModel, c.MessageCache.MaxMessagesPerChat)
+	}
+	svcCtx.IngestQueue = storage.NewIngestQueue(svcCtx.MessageModel, *c.Ingest.QueueSize)
+	svcCtx.IngestQueue.SetLinkStore(svcCtx.LinkModel)
+	if limit := c.Ingest.RateLimit; limit.MaxPerHour > 0 {
+		svcCtx.IngestQueue.SetSampler(storage.NewIngestSampler(limit.MaxPerHour, limit.Mode))
+	}
+	svcCtx.IngestQueue.Start()
 	return svcCtx
 }
 
+// OpenDatabase 打开数据库连接
+func OpenDatabase() (*sql.DB, error) {
+	return dbmigrate.Open(DatabasePath)
+}
+
+// checkMigrations 检查数据库迁移状态：新数据库直接执行全部迁移；已有数据库存在待执行的迁移时
+// 要求先通过 migrate 子命令显式执行（执行前自动备份），避免升级后静默修改生产数据库
+func checkMigrations(db *sql.DB) error {
+	ctx := context.Background()
+	status, err := dbmigrate.GetStatus(ctx, db)
+	if err != nil {
+		return fmt.Errorf("查询数据库迁移状态失败, %w", err)
+	}
+	switch {
+	case status.Current == "" && !status.Legacy:
+		n, err := dbmigrate.Up(ctx, db, 0)
+		if err != nil {
+			return fmt.Errorf("初始化数据库失败, %w", err)
+		}
+		logger.Infof("[Migrate] 新数据库已执行 %d 个迁移", n)
+	case status.Legacy:
+		return fmt.Errorf("数据库尚未纳入版本化迁移，请先执行 migrate up 子命令")
+	case len(status.Pending) > 0:
+		return fmt.Errorf("数据库有 %d 个待执行的迁移（%s），请先执行 migrate up 子命令", len(status.Pending), strings.Join(status.Pending, ", "))
+	}
+	return nil
+}
+
 func (svcCtx *ServiceContext) Close() {
 	if err := svcCtx.DbClient.Close(); err != nil {
 		logger.Errorf("关闭数据库失败, %v", err)
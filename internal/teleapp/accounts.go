@@ -0,0 +1,196 @@
+package teleapp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// Accounts 同时登录的多个账号（主账号及 TelegramApp.Accounts），共享同一个消息存储。
+// 每个群组由首个收到其消息（或补录、加入该群组）的账号负责：保存消息、响应群聊命令及发送总结，
+// 同在该群组的其他账号忽略其更新，避免同一条消息被重复保存、命令被重复回复。
+// 负责的账号退出或被移出群组、登录失效后不再负责，由下一个收到其消息的账号接替；
+// 已退出收集的群聊及排除区间由各账号共享，接替后仍然生效。
+// 实现 notify.MessageSender、notify.SendWaiter、notify.MembershipChecker、summarizer.ChatContextProvider
+// 及 scheduler.Backfiller，按群组转发给负责的账号
+type Accounts struct {
+	apps []*TeleApp
+
+	mu     sync.Mutex
+	owners map[int64]*TeleApp // 群组ID -> 负责的账号
+}
+
+// NewAccounts 组合已创建的账号，apps[0] 为主账号：负责私聊（管理员命令、私信通知）及心跳。
+// 各账号改为共享主账号的已退出收集的群聊及排除区间（均从同一数据库加载）
+func NewAccounts(apps ...*TeleApp) *Accounts {
+	a := &Accounts{apps: apps, owners: make(map[int64]*TeleApp)}
+	for _, app := range apps {
+		app.accounts = a
+		app.filters = apps[0].filters
+	}
+	return a
+}
+
+// Apps 全部账号，首个为主账号
+func (a *Accounts) Apps() []*TeleApp {
+	return a.apps
+}
+
+// claim 群组尚无账号负责或负责的账号已登录失效时由 app 负责，返回负责该群组的账号
+func (a *Accounts) claim(chatID int64, app *TeleApp) *TeleApp {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	owner, ok := a.owners[chatID]
+	if !ok || (owner != app && owner.loggedOut.Load()) {
+		a.owners[chatID] = app
+		if len(a.apps) > 1 {
+			logger.Infof("[TeleApp] 群组 %d 由账号 %d 负责", chatID, app.user.Id)
+		}
+		return app
+	}
+	return owner
+}
+
+// release app 不再负责该群组（退出或被移出群组），由下一个收到其消息的账号接替
+func (a *Accounts) release(chatID int64, app *TeleApp) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.owners[chatID] != app {
+		return
+	}
+	delete(a.owners, chatID)
+	if len(a.apps) > 1 {
+		logger.Infof("[TeleApp] 账号 %d 已不在群组 %d，不再负责该群组", app.user.Id, chatID)
+	}
+}
+
+// releaseAll app 不再负责任何群组（登录失效），返回释放的群组数量
+func (a *Accounts) releaseAll(app *TeleApp) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	n := 0
+	for chatID, owner := range a.owners {
+		if owner == app {
+			delete(a.owners, chatID)
+			n++
+		}
+	}
+	return n
+}
+
+// owner 返回负责该群组的账号，尚无账号负责时返回 nil
+func (a *Accounts) owner(chatID int64) *TeleApp {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.owners[chatID]
+}
+
+// appFor 返回负责 chatID 的账号：私聊（chatID > 0）由主账号负责；尚无账号负责的群组由首个可以发言的账号负责，
+// 没有账号可以发言时返回主账号
+func (a *Accounts) appFor(chatID int64) *TeleApp {
+	if len(a.apps) == 1 || chatID > 0 {
+		return a.apps[0]
+	}
+	if owner := a.owner(chatID); owner != nil {
+		return owner
+	}
+	for _, app := range a.apps {
+		if app.loggedOut.Load() {
+			continue
+		}
+		if ok, err := app.CanPost(context.Background(), chatID); err == nil && ok {
+			return a.claim(chatID, app)
+		}
+	}
+	return a.apps[0]
+}
+
+// owns 判断当前账号是否负责该群组，群组尚无账号负责时由当前账号负责；单账号时始终为 true
+func (app *TeleApp) owns(chatID int64) bool {
+	return app.accounts == nil || len(app.accounts.apps) == 1 || app.accounts.claim(chatID, app) == app
+}
+
+// onMembership 当前账号在群组中的状态变化（updateSupergroup、updateBasicGroup）：已不是成员（退出、被移出或封禁）时
+// 不再负责该群组
+func (app *TeleApp) onMembership(chatID int64, status client.ChatMemberStatus) {
+	if app.accounts == nil || notify.IsMemberStatus(status) {
+		return
+	}
+	app.accounts.release(chatID, app)
+}
+
+// supergroupChatID 超级群组对应的 chat_id（-100XXXXXXXXXX）
+func supergroupChatID(supergroupID int64) int64 {
+	return -1000000000000 - supergroupID
+}
+
+// ownedByOther 判断群组是否已由其他账号负责，不改变群组的归属
+func (app *TeleApp) ownedByOther(chatID int64) bool {
+	if app.accounts == nil {
+		return false
+	}
+	owner := app.accounts.owner(chatID)
+	return owner != nil && owner != app
+}
+
+// SendMessage 由负责该聊天的账号发送消息，实现 notify.MessageSender
+func (a *Accounts) SendMessage(req *client.SendMessageRequest) (*client.Message, error) {
+	return a.appFor(req.ChatId).tdClient.SendMessage(req)
+}
+
+// WaitSent 等待负责该聊天的账号发送的消息发送完成，实现 notify.SendWaiter
+func (a *Accounts) WaitSent(ctx context.Context, chatID, messageID int64) (int64, error) {
+	return a.appFor(chatID).WaitSent(ctx, chatID, messageID)
+}
+
+// IsChatMember 由负责该群组的账号查询用户是否为群组成员，实现 notify.MembershipChecker
+func (a *Accounts) IsChatMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	return a.appFor(chatID).IsChatMember(ctx, chatID, userID)
+}
+
+// CanPost 查询负责该群组的账号能否在群组发送消息，实现 notify.MembershipChecker
+func (a *Accounts) CanPost(ctx context.Context, chatID int64) (bool, error) {
+	return a.appFor(chatID).CanPost(ctx, chatID)
+}
+
+// ChatContext 由负责该群组的账号获取群聊背景信息，实现 summarizer.ChatContextProvider
+func (a *Accounts) ChatContext(ctx context.Context, chatID int64) (llm.ChatContext, error) {
+	return a.appFor(chatID).ChatContext(ctx, chatID)
+}
+
+// Backfill 各账号依次补录 since 之后的群聊历史消息，每个群组只由负责的账号补录，返回入队的消息总数，
+// 实现 scheduler.Backfiller
+func (a *Accounts) Backfill(ctx context.Context, since time.Time) (int, error) {
+	total := 0
+	for _, app := range a.apps {
+		n, err := app.Backfill(ctx, since)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// RefreshMetadata 各账号依次刷新群聊和用户信息：已由其他账号负责的群组跳过，已被其他账号刷新的用户不再获取
+func (a *Accounts) RefreshMetadata(ctx context.Context, maxAge time.Duration) error {
+	var errs []error
+	for _, app := range a.apps {
+		if err := app.RefreshMetadata(ctx, maxAge); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BackfillChat 由负责该群组的账号补录其 since 之后的历史消息
+func (a *Accounts) BackfillChat(ctx context.Context, chatID int64, since time.Time) (int, error) {
+	return a.appFor(chatID).BackfillChat(ctx, chatID, since)
+}
@@ -0,0 +1,64 @@
+package teleapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestAccounts(t *testing.T) {
+	primary := &TeleApp{user: &client.User{Id: 1}, filters: newChatFilters(0)}
+	second := &TeleApp{user: &client.User{Id: 2}, filters: newChatFilters(0)}
+	accounts := NewAccounts(primary, second)
+
+	assert.True(t, second.owns(-100), "首个收到群组消息的账号负责该群组")
+	assert.False(t, primary.owns(-100), "同在该群组的其他账号忽略其更新")
+	assert.True(t, second.owns(-100))
+	assert.True(t, primary.owns(-200))
+
+	assert.Same(t, second, accounts.appFor(-100), "由负责的账号发送")
+	assert.Same(t, primary, accounts.appFor(-200))
+	assert.Same(t, primary, accounts.appFor(42), "私聊由主账号发送")
+	assert.True(t, primary.ownedByOther(-100))
+	assert.False(t, second.ownedByOther(-100))
+	assert.False(t, second.ownedByOther(-300), "尚无账号负责")
+	assert.Nil(t, accounts.owner(-300), "查询不改变群组的归属")
+
+	t.Run("退出群组后由其他账号接替", func(t *testing.T) {
+		second.onMembership(-200, &client.ChatMemberStatusLeft{})
+		assert.True(t, primary.owns(-200), "非负责账号的状态变化不影响归属")
+
+		primary.onMembership(-200, &client.ChatMemberStatusBanned{})
+		assert.Nil(t, accounts.owner(-200))
+		assert.True(t, second.owns(-200), "由下一个收到其消息的账号接替")
+
+		second.onMembership(-200, &client.ChatMemberStatusMember{})
+		assert.True(t, second.owns(-200), "仍是成员时不释放")
+	})
+
+	t.Run("登录失效后由其他账号接替", func(t *testing.T) {
+		ctx := context.Background()
+		second.onAuthorizationState(ctx, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateClosed{}})
+		assert.Nil(t, accounts.owner(-100))
+		assert.Nil(t, accounts.owner(-200))
+		assert.True(t, primary.owns(-100))
+
+		second.onAuthorizationState(ctx, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateReady{}})
+		assert.False(t, second.owns(-100), "重新登录后不抢回已接替的群组")
+	})
+
+	t.Run("共享退出收集状态", func(t *testing.T) {
+		primary.setOptedOut(-100, true)
+		assert.True(t, second.isOptedOut(-100), "群组改由其他账号负责后仍然生效")
+	})
+
+	t.Run("单账号", func(t *testing.T) {
+		app := &TeleApp{user: &client.User{Id: 1}}
+		accounts := NewAccounts(app)
+		assert.True(t, app.owns(-100))
+		assert.Same(t, app, accounts.appFor(-300))
+		assert.True(t, (&TeleApp{}).owns(-100), "未组合账号时视为单账号")
+	})
+}
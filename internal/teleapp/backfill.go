@@ -0,0 +1,162 @@
+package teleapp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+const (
+	backfillMaxChats    = 1000 // 最多补录的聊天数
+	backfillPageSize    = 100  // 每次获取的历史消息数（TDLib 上限）
+	backfillDefaultDays = 7    // 补录参数未指定天数时补录的天数
+)
+
+// BackfillSpec 命令行 -backfill 参数：补录指定群聊（ChatID 为 0 时为全部群聊）最近 Days 天的历史消息
+type BackfillSpec struct {
+	ChatID int64
+	Days   int
+}
+
+// ParseBackfillSpec 解析 "chatID=-100123,days=7" 格式的补录参数，两项均可省略，天数默认 7
+func ParseBackfillSpec(spec string) (BackfillSpec, error) {
+	result := BackfillSpec{Days: backfillDefaultDays}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return BackfillSpec{}, fmt.Errorf("参数格式错误: %s", item)
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "chatid":
+			chatID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return BackfillSpec{}, fmt.Errorf("无效的群组ID: %s", value)
+			}
+			result.ChatID = chatID
+		case "days":
+			days, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || days <= 0 {
+				return BackfillSpec{}, fmt.Errorf("无效的天数: %s", value)
+			}
+			result.Days = days
+		default:
+			return BackfillSpec{}, fmt.Errorf("未知的参数: %s", key)
+		}
+	}
+	return result, nil
+}
+
+// Backfill 补录 since 之后的群聊历史消息：遍历主聊天列表中的群组和频道，按页向前获取历史消息，
+// 经与实时消息相同的过滤（内容类型、退出收集、排除区间）后写入入库队列，已保存的消息由队列去重。
+// 命令消息（以 "/" 开头的文本）不执行也不保存。用于单次运行模式在总结前补齐离线期间的消息，
+// 等待入队的消息写入完成后返回入队的消息数（实现 scheduler.Backfiller）
+func (app *TeleApp) Backfill(ctx context.Context, since time.Time) (int, error) {
+	chats, err := app.tdClient.GetChats(&client.GetChatsRequest{ChatList: &client.ChatListMain{}, Limit: backfillMaxChats})
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, chatID := range chats.ChatIds {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		chat, err := app.getChat(chatID)
+		if err != nil {
+			logger.Warnf("[TeleApp] 补录时获取聊天信息失败, id: %d, %v", chatID, err)
+			continue
+		}
+		if !app.backfillAllowed(chat) {
+			continue
+		}
+
+		n, err := app.backfillChat(ctx, chat, since)
+		if err != nil {
+			logger.Warnf("[TeleApp] 补录群聊 %s[%d] 失败，已入队 %d 条: %v", chat.Title, chat.Id, n, err)
+		} else if n > 0 {
+			logger.Infof("[TeleApp] 补录群聊 %s[%d]: %d 条消息", chat.Title, chat.Id, n)
+		}
+		total += n
+	}
+	return total, app.svcCtx.IngestQueue.Drain(ctx)
+}
+
+// BackfillChat 补录单个群聊 since 之后的历史消息，过滤规则与 Backfill 相同，
+// 用于部署后首次总结前补齐机器人运行之前的消息；等待入队的消息写入完成后返回入队的消息数
+func (app *TeleApp) BackfillChat(ctx context.Context, chatID int64, since time.Time) (int, error) {
+	chat, err := app.getChat(chatID)
+	if err != nil {
+		return 0, fmt.Errorf("获取聊天信息失败: %w", err)
+	}
+	if !app.backfillAllowed(chat) {
+		return 0, fmt.Errorf("聊天 %s[%d] 不是群组或频道，或不在收集范围内", chat.Title, chat.Id)
+	}
+
+	n, err := app.backfillChat(ctx, chat, since)
+	if err != nil {
+		return n, err
+	}
+	logger.Infof("[TeleApp] 补录群聊 %s[%d]: %d 条消息", chat.Title, chat.Id, n)
+	return n, app.svcCtx.IngestQueue.Drain(ctx)
+}
+
+// backfillAllowed 判断聊天是否需要补录：群组或频道，允许收集且未退出收集，多账号时由当前账号负责
+func (app *TeleApp) backfillAllowed(chat *client.Chat) bool {
+	return chatTypeOf(chat) != "" && app.svcCtx.Config.Summary.AllowsChat(chat.Id) && !app.isOptedOut(chat.Id) && app.owns(chat.Id)
+}
+
+// backfillChat 从最新消息开始向前分页获取群聊历史，直到早于 since 或没有更早的消息
+func (app *TeleApp) backfillChat(ctx context.Context, chat *client.Chat, since time.Time) (int, error) {
+	n := 0
+	fromID := int64(0)
+	for {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		batch, err := app.tdClient.GetChatHistory(&client.GetChatHistoryRequest{
+			ChatId:        chat.Id,
+			FromMessageId: fromID,
+			Limit:         backfillPageSize,
+		})
+		if err != nil {
+			return n, err
+		}
+		if len(batch.Messages) == 0 {
+			return n, nil
+		}
+
+		prevFromID := fromID
+		for _, m := range batch.Messages {
+			if time.Unix(int64(m.Date), 0).Before(since) {
+				return n, nil
+			}
+			fromID = m.Id
+			contentType, text := ingestContent(m.Content)
+			if backfillable(contentType, text, app.svcCtx.Config.Ingest.Accepts) && app.ingestMessage(ctx, m, chat, contentType, text) {
+				n++
+			}
+		}
+		// 没有更早的消息时结束，避免重复获取同一页
+		if fromID == prevFromID {
+			return n, nil
+		}
+	}
+}
+
+// backfillable 判断历史消息是否需要补录：内容类型已配置且有文本，命令消息不补录
+func backfillable(contentType, text string, accepts func(contentType string) bool) bool {
+	if text == "" || !accepts(contentType) {
+		return false
+	}
+	return contentType != "text" || !strings.HasPrefix(text, "/")
+}
@@ -0,0 +1,49 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackfillable(t *testing.T) {
+	textOnly := func(contentType string) bool { return contentType == "text" }
+
+	tests := []struct {
+		name        string
+		contentType string
+		text        string
+		want        bool
+	}{
+		{"文本消息", "text", "周三发布", true},
+		{"命令消息不补录", "text", "/tldr", false},
+		{"未配置的内容类型", "caption", "图片说明", false},
+		{"无文本", "text", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, backfillable(tt.contentType, tt.text, textOnly))
+		})
+	}
+	assert.True(t, backfillable("caption", "/path 说明", func(string) bool { return true }), "仅文本消息识别命令")
+}
+
+func TestParseBackfillSpec(t *testing.T) {
+	spec, err := ParseBackfillSpec("chatID=-100123,days=3")
+	require.NoError(t, err)
+	assert.Equal(t, BackfillSpec{ChatID: -100123, Days: 3}, spec)
+
+	spec, err = ParseBackfillSpec("days=14")
+	require.NoError(t, err)
+	assert.Equal(t, BackfillSpec{Days: 14}, spec, "省略群组ID时补录全部群聊")
+
+	spec, err = ParseBackfillSpec("chatid=-1")
+	require.NoError(t, err)
+	assert.Equal(t, BackfillSpec{ChatID: -1, Days: 7}, spec, "天数默认 7")
+
+	for _, bad := range []string{"chatID", "chatID=abc", "days=0", "days=-1", "limit=10"} {
+		_, err := ParseBackfillSpec(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,210 @@
+package teleapp
+
+import (
+	"context"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// saveChatTitle 记录群聊名称和类型，供总结头部展示及按类型选择总结引擎；私聊和密聊不记录
+func (app *TeleApp) saveChatTitle(chat *client.Chat) {
+	switch chat.Type.ChatTypeType() {
+	case client.TypeChatTypePrivate, client.TypeChatTypeSecret:
+		return
+	}
+
+	if err := app.svcCtx.ChatModel.Save(context.Background(), chat.Id, chat.Title, chatTypeOf(chat)); err != nil {
+		logger.Warnf("[TeleApp] 保存群聊名称失败, id: %d, %v", chat.Id, err)
+	}
+}
+
+// updateChatTitle 群聊改名后同步缓存和数据库
+func (app *TeleApp) updateChatTitle(update *client.UpdateChatTitle) {
+	app.chatsMu.Lock()
+	cached, ok := app.chatsCache[update.ChatId]
+	var chat *client.Chat
+	if ok {
+		// 复制后替换，避免修改其他协程正在读取的对象
+		updated := *cached
+		updated.Title = update.Title
+		chat = &updated
+		app.chatsCache[update.ChatId] = chat
+	}
+	app.chatsMu.Unlock()
+
+	if ok {
+		logger.Infof("[TeleApp] 群聊名称变更: %s[%d]", update.Title, update.ChatId)
+		app.saveChatTitle(chat)
+		return
+	}
+	// 未缓存的聊天只更新已记录的名称，未记录的在首次收到消息时再记录
+	if n, err := app.svcCtx.ChatModel.UpdateTitle(context.Background(), update.ChatId, update.Title); err != nil {
+		logger.Warnf("[TeleApp] 更新群聊名称失败, id: %d, %v", update.ChatId, err)
+	} else if n > 0 {
+		logger.Infof("[TeleApp] 群聊名称变更: %s[%d]", update.Title, update.ChatId)
+	}
+}
+
+// RefreshChats 重新获取所有已记录群聊的名称、类型、用户名和成员数，单个群聊失败不影响其他群聊；
+// 多账号时跳过由其他账号负责的群聊
+func (app *TeleApp) RefreshChats(ctx context.Context) error {
+	chatIDs, err := app.svcCtx.ChatModel.ChatIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	refreshed, failed := 0, 0
+	for _, chatID := range chatIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if app.ownedByOther(chatID) {
+			continue
+		}
+
+		chat, err := app.tdClient.GetChat(&client.GetChatRequest{ChatId: chatID})
+		if err != nil {
+			logger.Warnf("[TeleApp] 刷新群聊信息失败, id: %d, %v", chatID, err)
+			failed++
+			continue
+		}
+		app.chatsMu.Lock()
+		app.chatsCache[chatID] = chat
+		app.chatsMu.Unlock()
+
+		username, memberCount, err := app.chatMembers(chat)
+		if err != nil {
+			logger.Warnf("[TeleApp] 获取群聊成员数失败, id: %d, %v", chatID, err)
+			failed++
+			continue
+		}
+		if err := app.svcCtx.ChatModel.SaveInfo(ctx, chatID, chat.Title, chatTypeOf(chat), username, memberCount); err != nil {
+			logger.Warnf("[TeleApp] 保存群聊信息失败, id: %d, %v", chatID, err)
+			failed++
+			continue
+		}
+		refreshed++
+	}
+
+	logger.Infof("[TeleApp] 群聊信息刷新完成: 成功 %d 个，失败 %d 个", refreshed, failed)
+	return nil
+}
+
+// chatMembers 获取群组的用户名（含 "@"，私有群组为空）和成员数
+func (app *TeleApp) chatMembers(chat *client.Chat) (username string, memberCount int, err error) {
+	switch t := chat.Type.(type) {
+	case *client.ChatTypeSupergroup:
+		supergroup, err := app.tdClient.GetSupergroup(&client.GetSupergroupRequest{SupergroupId: t.SupergroupId})
+		if err != nil {
+			return "", 0, err
+		}
+		if supergroup.Usernames != nil && len(supergroup.Usernames.ActiveUsernames) > 0 {
+			username = "@" + supergroup.Usernames.ActiveUsernames[0]
+		}
+		memberCount = int(supergroup.MemberCount)
+		if memberCount == 0 {
+			// 成员数未知时从完整信息中获取
+			info, err := app.tdClient.GetSupergroupFullInfo(&client.GetSupergroupFullInfoRequest{SupergroupId: t.SupergroupId})
+			if err != nil {
+				return "", 0, err
+			}
+			memberCount = int(info.MemberCount)
+		}
+	case *client.ChatTypeBasicGroup:
+		group, err := app.tdClient.GetBasicGroup(&client.GetBasicGroupRequest{BasicGroupId: t.BasicGroupId})
+		if err != nil {
+			return "", 0, err
+		}
+		memberCount = int(group.MemberCount)
+	}
+	return username, memberCount, nil
+}
+
+// ChatContext 获取群简介和当前置顶消息的文字，作为总结的背景信息（实现 summarizer.ChatContextProvider）
+func (app *TeleApp) ChatContext(ctx context.Context, chatID int64) (llm.ChatContext, error) {
+	chat, err := app.getChat(chatID)
+	if err != nil {
+		return llm.ChatContext{}, err
+	}
+
+	var chatContext llm.ChatContext
+	switch t := chat.Type.(type) {
+	case *client.ChatTypeSupergroup:
+		info, err := app.tdClient.GetSupergroupFullInfo(&client.GetSupergroupFullInfoRequest{SupergroupId: t.SupergroupId})
+		if err != nil {
+			return llm.ChatContext{}, err
+		}
+		chatContext.Description = info.Description
+	case *client.ChatTypeBasicGroup:
+		info, err := app.tdClient.GetBasicGroupFullInfo(&client.GetBasicGroupFullInfoRequest{BasicGroupId: t.BasicGroupId})
+		if err != nil {
+			return llm.ChatContext{}, err
+		}
+		chatContext.Description = info.Description
+	}
+
+	// 没有置顶消息时返回错误，忽略即可
+	pinned, err := app.tdClient.GetChatPinnedMessage(&client.GetChatPinnedMessageRequest{ChatId: chatID})
+	if err != nil {
+		logger.Debugf("[TeleApp] 群组 %d 无置顶消息: %v", chatID, err)
+	} else {
+		chatContext.PinnedMessage = messageText(pinned)
+	}
+	return chatContext, nil
+}
+
+// IsChatMember 查询用户是否为群组成员，实现 notify.MembershipChecker
+func (app *TeleApp) IsChatMember(ctx context.Context, chatID, userID int64) (bool, error) {
+	member, err := app.tdClient.GetChatMember(&client.GetChatMemberRequest{
+		ChatId:   chatID,
+		MemberId: &client.MessageSenderUser{UserId: userID},
+	})
+	if err != nil {
+		return false, err
+	}
+	return notify.IsMemberStatus(member.Status), nil
+}
+
+// CanPost 查询当前账号是否可以在群组发送文字消息，实现 notify.MembershipChecker
+func (app *TeleApp) CanPost(ctx context.Context, chatID int64) (bool, error) {
+	member, err := app.tdClient.GetChatMember(&client.GetChatMemberRequest{
+		ChatId:   chatID,
+		MemberId: &client.MessageSenderUser{UserId: app.user.Id},
+	})
+	if err != nil {
+		return false, err
+	}
+	chat, err := app.tdClient.GetChat(&client.GetChatRequest{ChatId: chatID})
+	if err != nil {
+		return false, err
+	}
+	return canPost(member.Status, chat.Permissions), nil
+}
+
+// canPost 根据成员状态和群组默认权限判断能否发送文字消息：普通成员受群组默认权限约束，受限成员使用自身权限
+func canPost(status client.ChatMemberStatus, perms *client.ChatPermissions) bool {
+	switch s := status.(type) {
+	case *client.ChatMemberStatusCreator:
+		return s.IsMember
+	case *client.ChatMemberStatusAdministrator:
+		return true
+	case *client.ChatMemberStatusMember:
+		return perms == nil || perms.CanSendBasicMessages
+	case *client.ChatMemberStatusRestricted:
+		return s.IsMember && s.Permissions != nil && s.Permissions.CanSendBasicMessages
+	}
+	return false
+}
+
+// messageText 消息的文字内容：文本消息的正文或图片、视频、文件等的说明文字，其他类型返回空字符串
+func messageText(message *client.Message) string {
+	switch contentType, text := ingestContent(message.Content); contentType {
+	case "text", "caption":
+		return text
+	}
+	return ""
+}
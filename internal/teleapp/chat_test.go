@@ -0,0 +1,34 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestCanPost(t *testing.T) {
+	allowed := &client.ChatPermissions{CanSendBasicMessages: true}
+	muted := &client.ChatPermissions{}
+	tests := []struct {
+		name   string
+		status client.ChatMemberStatus
+		perms  *client.ChatPermissions
+		want   bool
+	}{
+		{"普通成员", &client.ChatMemberStatusMember{}, allowed, true},
+		{"全员禁言的普通成员", &client.ChatMemberStatusMember{}, muted, false},
+		{"全员禁言的管理员", &client.ChatMemberStatusAdministrator{}, muted, true},
+		{"群主", &client.ChatMemberStatusCreator{IsMember: true}, muted, true},
+		{"已退出的群主", &client.ChatMemberStatusCreator{}, allowed, false},
+		{"被禁言", &client.ChatMemberStatusRestricted{IsMember: true, Permissions: muted}, allowed, false},
+		{"受限但可发文字", &client.ChatMemberStatusRestricted{IsMember: true, Permissions: allowed}, allowed, true},
+		{"已退出", &client.ChatMemberStatusLeft{}, allowed, false},
+		{"被封禁", &client.ChatMemberStatusBanned{}, allowed, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canPost(tt.status, tt.perms))
+		})
+	}
+}
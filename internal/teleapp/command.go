@@ -0,0 +1,115 @@
+package teleapp
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// Command 解析后的命令
+type Command struct {
+	Name      string   // 命令名（不含 "/" 和 "@bot" 后缀）
+	Args      []string // 命令参数
+	Text      string   // 命令名之后的原始文本（保留换行），用于携带多行内容的命令
+	ChatID    int64    // 命令所在聊天
+	SenderID  int64    // 命令发送者
+	MessageID int64    // 命令消息ID
+	ThreadID  int64    // 命令所在的论坛话题，0 表示非话题消息
+}
+
+// CommandHandler 命令处理函数，返回的文本将作为回复发送
+type CommandHandler func(ctx context.Context, cmd *Command) (string, error)
+
+// RegisterCommand 注册命令处理函数，name 不含 "/"
+func (app *TeleApp) RegisterCommand(name string, handler CommandHandler) {
+	app.commandsMu.Lock()
+	app.commands[name] = handler
+	app.commandsMu.Unlock()
+}
+
+// parseCommand 解析 "/name@bot arg1 arg2" 格式的命令，非命令返回 nil
+func parseCommand(text string) *Command {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return nil
+	}
+	name := strings.TrimPrefix(fields[0], "/")
+	if idx := strings.Index(name, "@"); idx >= 0 {
+		name = name[:idx]
+	}
+	if name == "" {
+		return nil
+	}
+	rest := strings.TrimSpace(text)
+	if idx := strings.IndexFunc(rest, unicode.IsSpace); idx >= 0 {
+		rest = strings.TrimSpace(rest[idx:])
+	} else {
+		rest = ""
+	}
+	return &Command{Name: name, Args: fields[1:], Text: rest}
+}
+
+// isAdmin 判断用户是否为管理员
+func (app *TeleApp) isAdmin(userID int64) bool {
+	return slices.Contains(app.svcCtx.Config.AdminUserIds, userID)
+}
+
+// handleCommand 处理私聊中管理员发送的命令，返回 true 表示消息已作为命令处理
+func (app *TeleApp) handleCommand(ctx context.Context, message *client.Message, text string) bool {
+	cmd := parseCommand(text)
+	if cmd == nil {
+		return false
+	}
+
+	sender, ok := message.SenderId.(*client.MessageSenderUser)
+	if !ok || !app.isAdmin(sender.UserId) {
+		return false
+	}
+
+	app.commandsMu.RLock()
+	handler, ok := app.commands[cmd.Name]
+	app.commandsMu.RUnlock()
+	if !ok {
+		return false
+	}
+
+	cmd.ChatID = message.ChatId
+	cmd.SenderID = sender.UserId
+	cmd.MessageID = message.Id
+	cmd.ThreadID = forumThreadID(message)
+	logger.Infof("[TeleApp] 收到命令: /%s %v, chatID=%d, senderID=%d", cmd.Name, cmd.Args, cmd.ChatID, cmd.SenderID)
+
+	go func() {
+		reply, err := handler(ctx, cmd)
+		if err != nil {
+			logger.Warnf("[TeleApp] 执行命令 /%s 失败: %v", cmd.Name, err)
+			reply = app.formatter.T(display.TextCommandFailed)
+		}
+		if reply == "" {
+			return
+		}
+		if err := app.replyText(cmd.ChatID, cmd.ThreadID, cmd.MessageID, reply); err != nil {
+			logger.Warnf("[TeleApp] 回复命令 /%s 失败: %v", cmd.Name, err)
+		}
+	}()
+	return true
+}
+
+// replyText 以纯文本回复指定消息，threadID 不为 0 时回复到该论坛话题
+func (app *TeleApp) replyText(chatID, threadID, replyToMessageID int64, text string) error {
+	_, err := app.tdClient.SendMessage(&client.SendMessageRequest{
+		ChatId:          chatID,
+		MessageThreadId: threadID,
+		ReplyTo:         &client.InputMessageReplyToMessage{MessageId: replyToMessageID},
+		InputMessageContent: &client.InputMessageText{
+			Text: &client.FormattedText{Text: text},
+		},
+	})
+	return err
+}
@@ -0,0 +1,40 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		wantNil  bool
+		wantName string
+		wantArgs []string
+		wantText string
+	}{
+		{"普通消息", "hello", true, "", nil, ""},
+		{"空消息", "", true, "", nil, ""},
+		{"仅斜杠", "/", true, "", nil, ""},
+		{"无参数命令", "/status", false, "status", []string{}, ""},
+		{"带 bot 后缀", "/status@my_bot", false, "status", []string{}, ""},
+		{"带参数", "/summary 2025-02-10 2025-02-12", false, "summary", []string{"2025-02-10", "2025-02-12"}, "2025-02-10 2025-02-12"},
+		{"多行内容保留换行", "/edit 12\n第一行\n\n第二行\n", false, "edit", []string{"12", "第一行", "第二行"}, "12\n第一行\n\n第二行"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseCommand(tt.text)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.wantName, got.Name)
+				assert.Equal(t, tt.wantArgs, got.Args)
+				assert.Equal(t, tt.wantText, got.Text)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package teleapp
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// defaultBackfillThreshold 未启用连接中断告警（Alert.DisconnectThreshold 为 0）时，中断超过该时长才补录中断期间的消息
+const defaultBackfillThreshold = 10 * time.Minute
+
+// Alerter 发送故障告警（默认实现为 alert.Alerter）
+type Alerter interface {
+	Alert(ctx context.Context, title, message string)
+}
+
+// SetAlerter 启用连接中断及登录失效告警
+func (app *TeleApp) SetAlerter(alerter Alerter) {
+	app.connMu.Lock()
+	defer app.connMu.Unlock()
+	app.alerter = alerter
+}
+
+// alert 发送告警，未设置 Alerter 时只记录日志
+func (app *TeleApp) alert(ctx context.Context, title, message string) {
+	app.connMu.Lock()
+	alerter := app.alerter
+	app.connMu.Unlock()
+	if alerter == nil {
+		logger.Warnf("[TeleApp] %s: %s", title, message)
+		return
+	}
+	alerter.Alert(ctx, title, message)
+}
+
+// onConnectionState 连接状态变化：离开 Ready 时记录中断开始时间，恢复 Ready 后重新加载聊天列表，
+// 中断超过 Alert.DisconnectThreshold 时补录中断期间的消息。Updating 表示已连接、正在同步离线期间的更新
+func (app *TeleApp) onConnectionState(ctx context.Context, update *client.UpdateConnectionState) {
+	switch update.State.(type) {
+	case *client.ConnectionStateReady:
+		if since, ok := app.markConnected(ctx, time.Now()); ok {
+			go app.afterReconnect(ctx, since, time.Since(since) >= app.backfillThreshold())
+		}
+	case *client.ConnectionStateUpdating:
+	default:
+		app.markDisconnected(update.State.ConnectionStateType(), time.Now())
+	}
+}
+
+// markDisconnected 记录连接中断的开始时间，已处于中断状态时只更新连接状态
+func (app *TeleApp) markDisconnected(state string, now time.Time) {
+	app.connMu.Lock()
+	defer app.connMu.Unlock()
+	app.connState = state
+	if !app.disconnectedAt.IsZero() {
+		return
+	}
+	app.disconnectedAt = now
+	app.setConnected(0)
+	metrics.Inc(metrics.Name("teleapp_disconnects_total", "account", app.accountLabel()))
+	logger.Warnf("[TeleApp] 账号 %d 连接中断: %s", app.user.Id, state)
+}
+
+// markConnected 连接恢复：返回中断的开始时间，此前未中断时返回 false；中断期间已告警时发送恢复告警
+func (app *TeleApp) markConnected(ctx context.Context, now time.Time) (time.Time, bool) {
+	app.connMu.Lock()
+	since, alerted := app.disconnectedAt, app.disconnectAlerted
+	app.disconnectedAt, app.disconnectAlerted, app.connState = time.Time{}, false, ""
+	app.connMu.Unlock()
+
+	app.setConnected(1)
+	if since.IsZero() {
+		return since, false
+	}
+	downtime := now.Sub(since).Round(time.Second)
+	logger.Infof("[TeleApp] 账号 %d 连接已恢复，中断 %s", app.user.Id, downtime)
+	if alerted {
+		app.alert(ctx, app.formatter.T(display.TextAlertOnline), app.formatter.Tf(display.TextAlertOnlineDetail, app.user.Id, downtime))
+	}
+	return since, true
+}
+
+// accountLabel 指标的 account 标签：账号的用户ID
+func (app *TeleApp) accountLabel() string {
+	return strconv.FormatInt(app.user.Id, 10)
+}
+
+// setConnected 更新账号的连接状态指标 teleapp_connected，多账号时各账号分别记录
+func (app *TeleApp) setConnected(value float64) {
+	metrics.Set(metrics.Name("teleapp_connected", "account", app.accountLabel()), value)
+}
+
+// backfillThreshold 恢复连接后补录中断期间消息的中断时长阈值
+func (app *TeleApp) backfillThreshold() time.Duration {
+	if app.disconnectThreshold == 0 {
+		return defaultBackfillThreshold
+	}
+	return app.disconnectThreshold
+}
+
+// checkDisconnect 连接中断超过 Alert.DisconnectThreshold 时告警，每次中断只告警一次；阈值为 0 时不告警
+func (app *TeleApp) checkDisconnect(ctx context.Context, now time.Time) {
+	app.connMu.Lock()
+	since, state := app.disconnectedAt, app.connState
+	due := app.disconnectThreshold > 0 && !since.IsZero() && !app.disconnectAlerted && now.Sub(since) >= app.disconnectThreshold
+	if due {
+		app.disconnectAlerted = true
+	}
+	app.connMu.Unlock()
+	if !due {
+		return
+	}
+
+	downtime := now.Sub(since).Round(time.Second)
+	logger.Errorf("[TeleApp] 账号 %d 已 %s 未连接到 Telegram: %s", app.user.Id, downtime, state)
+	app.alert(ctx, app.formatter.T(display.TextAlertOffline), app.formatter.Tf(display.TextAlertOfflineDetail, app.user.Id, downtime, state))
+}
+
+// monitorConnection 定期检查连接中断的时长
+func (app *TeleApp) monitorConnection(ctx context.Context) {
+	interval := app.disconnectThreshold / 4
+	if interval < 10*time.Second {
+		interval = 10 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			app.checkDisconnect(ctx, now)
+		}
+	}
+}
+
+// afterReconnect 恢复连接后重新加载聊天列表（断线期间加入、改名的群聊），backfill 为 true 时补录 since 之后的消息
+func (app *TeleApp) afterReconnect(ctx context.Context, since time.Time, backfill bool) {
+	app.loadChatList()
+	if !backfill {
+		return
+	}
+	n, err := app.Backfill(ctx, since)
+	if err != nil {
+		logger.Warnf("[TeleApp] 补录连接中断期间的消息失败，已入队 %d 条: %v", n, err)
+		return
+	}
+	logger.Infof("[TeleApp] 已补录连接中断期间的消息 %d 条", n)
+}
+
+// onAuthorizationState 登录后授权状态不再是 Ready（如在其他设备上终止了会话）时告警，
+// 多账号时该账号负责的群组改由其他账号接替；主动关闭时不处理
+func (app *TeleApp) onAuthorizationState(ctx context.Context, update *client.UpdateAuthorizationState) {
+	if ctx.Err() != nil {
+		return
+	}
+	if update.AuthorizationState.AuthorizationStateType() == client.TypeAuthorizationStateReady {
+		app.loggedOut.Store(false)
+		return
+	}
+	state := update.AuthorizationState.AuthorizationStateType()
+	logger.Errorf("[TeleApp] 账号 %d 登录状态变为 %s，需要重新登录", app.user.Id, state)
+	app.loggedOut.Store(true)
+	if app.accounts != nil {
+		if n := app.accounts.releaseAll(app); n > 0 {
+			logger.Warnf("[TeleApp] 账号 %d 负责的 %d 个群组改由其他账号接替", app.user.Id, n)
+		}
+	}
+	app.setConnected(0)
+	app.alert(ctx, app.formatter.T(display.TextAlertLoggedOut), app.formatter.Tf(display.TextAlertLoggedOutDetail, app.user.Id, state))
+}
@@ -0,0 +1,88 @@
+package teleapp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// recordingAlerter 记录发送的告警标题
+type recordingAlerter struct {
+	titles []string
+}
+
+func (a *recordingAlerter) Alert(ctx context.Context, title, message string) {
+	a.titles = append(a.titles, title)
+}
+
+func TestConnectionMonitor(t *testing.T) {
+	ctx := context.Background()
+	alerter := &recordingAlerter{}
+	app := &TeleApp{user: &client.User{Id: 7}, disconnectThreshold: 10 * time.Minute}
+	app.SetAlerter(alerter)
+
+	start := time.Date(2026, 2, 11, 8, 0, 0, 0, time.UTC)
+	_, ok := app.markConnected(ctx, start)
+	assert.False(t, ok, "此前未中断")
+
+	app.markDisconnected(client.TypeConnectionStateWaitingForNetwork, start)
+	app.markDisconnected(client.TypeConnectionStateConnecting, start.Add(time.Minute))
+	app.checkDisconnect(ctx, start.Add(5*time.Minute))
+	assert.Empty(t, alerter.titles, "未超过阈值")
+
+	app.checkDisconnect(ctx, start.Add(10*time.Minute))
+	app.checkDisconnect(ctx, start.Add(20*time.Minute))
+	require.Equal(t, []string{"Telegram 连接中断"}, alerter.titles, "从首次中断开始计时，每次中断只告警一次")
+
+	since, ok := app.markConnected(ctx, start.Add(30*time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, start, since)
+	assert.Equal(t, []string{"Telegram 连接中断", "Telegram 连接已恢复"}, alerter.titles)
+
+	t.Run("短暂中断不告警", func(t *testing.T) {
+		alerter.titles = nil
+		app.markDisconnected(client.TypeConnectionStateConnecting, start)
+		app.checkDisconnect(ctx, start.Add(time.Minute))
+		_, ok := app.markConnected(ctx, start.Add(2*time.Minute))
+		assert.True(t, ok)
+		assert.Empty(t, alerter.titles)
+	})
+
+	t.Run("登录失效", func(t *testing.T) {
+		alerter.titles = nil
+		app.onAuthorizationState(ctx, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateReady{}})
+		app.onAuthorizationState(ctx, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateWaitPhoneNumber{}})
+		assert.Equal(t, []string{"Telegram 账号登录失效"}, alerter.titles)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		app.onAuthorizationState(canceled, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateClosed{}})
+		assert.Len(t, alerter.titles, 1, "主动关闭时不告警")
+	})
+
+	t.Run("阈值为 0 时不告警", func(t *testing.T) {
+		alerter := &recordingAlerter{}
+		app := &TeleApp{user: &client.User{Id: 8}}
+		app.SetAlerter(alerter)
+		app.markDisconnected(client.TypeConnectionStateConnecting, start)
+		app.checkDisconnect(ctx, start.Add(time.Hour))
+		_, ok := app.markConnected(ctx, start.Add(2*time.Hour))
+		assert.True(t, ok)
+		assert.Empty(t, alerter.titles)
+		assert.Equal(t, defaultBackfillThreshold, app.backfillThreshold())
+	})
+
+	t.Run("按账号记录连接状态", func(t *testing.T) {
+		other := &TeleApp{user: &client.User{Id: 9}}
+		other.markDisconnected(client.TypeConnectionStateConnecting, start)
+		app.markConnected(ctx, start)
+		snapshot := metrics.Snapshot()
+		assert.Equal(t, 0.0, snapshot[`teleapp_connected{account="9"}`])
+		assert.Equal(t, 1.0, snapshot[`teleapp_connected{account="7"}`])
+	})
+}
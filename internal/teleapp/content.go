@@ -0,0 +1,192 @@
+package teleapp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// ingestContent 将消息内容转为保存的文本，返回内容类型（对应 Ingest.ContentTypes）和文本；
+// 不支持的类型或没有文字的内容返回空字符串
+func ingestContent(content client.MessageContent) (contentType, text string) {
+	switch c := content.(type) {
+	case *client.MessageText:
+		return "text", formattedText(c.Text)
+	case *client.MessagePhoto:
+		return "caption", formattedText(c.Caption)
+	case *client.MessageVideo:
+		return "caption", formattedText(c.Caption)
+	case *client.MessageDocument:
+		return "caption", formattedText(c.Caption)
+	case *client.MessageAnimation:
+		return "caption", formattedText(c.Caption)
+	case *client.MessageAudio:
+		return "caption", formattedText(c.Caption)
+	case *client.MessageVoiceNote:
+		return "caption", formattedText(c.Caption)
+	case *client.MessagePoll:
+		return "poll", pollText(c.Poll)
+	case *client.MessageContact:
+		return "contact", contactText(c.Contact)
+	case *client.MessageLocation:
+		return "location", locationText(c)
+	case *client.MessageVenue:
+		return "venue", venueText(c.Venue)
+	}
+	return "", ""
+}
+
+// eventType 非文字消息记录为事件（Ingest.RecordEvents）时的内容类型（model.EventContentTypes），
+// 不记录的类型返回空字符串
+func eventType(content client.MessageContent) string {
+	switch content.(type) {
+	case *client.MessageSticker:
+		return "sticker"
+	case *client.MessageAnimation:
+		return "animation"
+	case *client.MessagePhoto:
+		return "photo"
+	case *client.MessageVideo:
+		return "video"
+	case *client.MessageVideoNote:
+		return "video_note"
+	case *client.MessageVoiceNote:
+		return "voice_note"
+	case *client.MessageAudio:
+		return "audio"
+	case *client.MessageDocument:
+		return "document"
+	case *client.MessageDice:
+		return "dice"
+	}
+	return ""
+}
+
+// messageLinks 消息正文或说明文字中分享的 http(s) 链接（URL 实体及文字链接），按出现顺序去重；
+// 没有协议的 URL 实体（如 example.com/a）补全为 http://
+func messageLinks(content client.MessageContent) []string {
+	var text *client.FormattedText
+	switch c := content.(type) {
+	case *client.MessageText:
+		text = c.Text
+	case *client.MessagePhoto:
+		text = c.Caption
+	case *client.MessageVideo:
+		text = c.Caption
+	case *client.MessageDocument:
+		text = c.Caption
+	case *client.MessageAnimation:
+		text = c.Caption
+	case *client.MessageAudio:
+		text = c.Caption
+	case *client.MessageVoiceNote:
+		text = c.Caption
+	}
+	if text == nil || len(text.Entities) == 0 {
+		return nil
+	}
+
+	// 实体的偏移和长度以 UTF-16 码元计
+	units := utf16.Encode([]rune(text.Text))
+	var links []string
+	for _, entity := range text.Entities {
+		var link string
+		switch t := entity.Type.(type) {
+		case *client.TextEntityTypeUrl:
+			start, end := int(entity.Offset), int(entity.Offset)+int(entity.Length)
+			if start < 0 || start >= end || end > len(units) {
+				continue
+			}
+			link = string(utf16.Decode(units[start:end]))
+			if !strings.Contains(link, "://") {
+				link = "http://" + link
+			}
+		case *client.TextEntityTypeTextUrl:
+			link = t.Url
+		}
+		lower := strings.ToLower(link)
+		if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+			continue
+		}
+		if !slices.Contains(links, link) {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+func formattedText(text *client.FormattedText) string {
+	if text == nil {
+		return ""
+	}
+	return text.Text
+}
+
+// pollText 投票的问题和选项，如 "发起了投票：周五聚餐去哪？ / 火锅 / 烧烤"
+func pollText(poll *client.Poll) string {
+	if poll == nil {
+		return ""
+	}
+	parts := []string{"发起了投票：" + formattedText(poll.Question)}
+	for _, option := range poll.Options {
+		parts = append(parts, formattedText(option.Text))
+	}
+	return strings.Join(parts, " / ")
+}
+
+// pollData 投票的问题、选项及得票情况，非投票内容返回 nil
+func pollData(content client.MessageContent) *model.Poll {
+	c, ok := content.(*client.MessagePoll)
+	if !ok || c.Poll == nil {
+		return nil
+	}
+	poll := &model.Poll{
+		Question:    formattedText(c.Poll.Question),
+		Options:     make([]model.PollOption, len(c.Poll.Options)),
+		TotalVoters: c.Poll.TotalVoterCount,
+		Closed:      c.Poll.IsClosed,
+	}
+	for i, option := range c.Poll.Options {
+		poll.Options[i] = model.PollOption{Text: formattedText(option.Text), Voters: option.VoterCount}
+	}
+	return poll
+}
+
+// contactText 联系人的姓名，不保存电话号码
+func contactText(contact *client.Contact) string {
+	if contact == nil {
+		return ""
+	}
+	name := strings.TrimSpace(contact.FirstName + " " + contact.LastName)
+	if name == "" {
+		return ""
+	}
+	return "分享了联系人：" + name
+}
+
+// locationText 位置的经纬度，实时位置附加说明
+func locationText(location *client.MessageLocation) string {
+	if location.Location == nil {
+		return ""
+	}
+	text := fmt.Sprintf("分享了位置：纬度 %.5f，经度 %.5f", location.Location.Latitude, location.Location.Longitude)
+	if location.LivePeriod > 0 {
+		text += "（实时位置）"
+	}
+	return text
+}
+
+// venueText 地点的名称和地址
+func venueText(venue *client.Venue) string {
+	if venue == nil || venue.Title == "" {
+		return ""
+	}
+	if venue.Address == "" {
+		return "分享了地点：" + venue.Title
+	}
+	return fmt.Sprintf("分享了地点：%s（%s）", venue.Title, venue.Address)
+}
@@ -0,0 +1,91 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestIngestContent(t *testing.T) {
+	text := func(s string) *client.FormattedText { return &client.FormattedText{Text: s} }
+	tests := []struct {
+		name     string
+		content  client.MessageContent
+		wantType string
+		wantText string
+	}{
+		{"文本", &client.MessageText{Text: text("早上好")}, "text", "早上好"},
+		{"图片说明", &client.MessagePhoto{Caption: text("发布截图")}, "caption", "发布截图"},
+		{"无说明的文件", &client.MessageDocument{}, "caption", ""},
+		{"投票", &client.MessagePoll{Poll: &client.Poll{
+			Question: text("周五聚餐去哪？"),
+			Options:  []*client.PollOption{{Text: text("火锅")}, {Text: text("烧烤")}},
+		}}, "poll", "发起了投票：周五聚餐去哪？ / 火锅 / 烧烤"},
+		{"联系人不含电话", &client.MessageContact{Contact: &client.Contact{FirstName: "Alice", LastName: "Wang", PhoneNumber: "+8613800000000"}}, "contact", "分享了联系人：Alice Wang"},
+		{"位置", &client.MessageLocation{Location: &client.Location{Latitude: 31.2304, Longitude: 121.4737}}, "location", "分享了位置：纬度 31.23040，经度 121.47370"},
+		{"实时位置", &client.MessageLocation{Location: &client.Location{Latitude: 31.2304, Longitude: 121.4737}, LivePeriod: 900}, "location", "分享了位置：纬度 31.23040，经度 121.47370（实时位置）"},
+		{"地点", &client.MessageVenue{Venue: &client.Venue{Title: "会议室 A", Address: "3 楼"}}, "venue", "分享了地点：会议室 A（3 楼）"},
+		{"不支持的类型", &client.MessageSticker{}, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, text := ingestContent(tt.content)
+			assert.Equal(t, tt.wantType, contentType)
+			assert.Equal(t, tt.wantText, text)
+		})
+	}
+}
+
+func TestPollData(t *testing.T) {
+	text := func(s string) *client.FormattedText { return &client.FormattedText{Text: s} }
+	assert.Nil(t, pollData(&client.MessageText{Text: text("周五聚餐")}), "非投票内容")
+
+	got := pollData(&client.MessagePoll{Poll: &client.Poll{
+		Question:        text("周五聚餐去哪？"),
+		Options:         []*client.PollOption{{Text: text("火锅"), VoterCount: 5}, {Text: text("烧烤"), VoterCount: 3}},
+		TotalVoterCount: 8,
+		IsClosed:        true,
+	}})
+	assert.Equal(t, &model.Poll{
+		Question:    "周五聚餐去哪？",
+		Options:     []model.PollOption{{Text: "火锅", Voters: 5}, {Text: "烧烤", Voters: 3}},
+		TotalVoters: 8,
+		Closed:      true,
+	}, got)
+}
+
+func TestEventType(t *testing.T) {
+	assert.Equal(t, "sticker", eventType(&client.MessageSticker{}))
+	assert.Equal(t, "animation", eventType(&client.MessageAnimation{}))
+	assert.Equal(t, "voice_note", eventType(&client.MessageVoiceNote{}))
+	assert.Empty(t, eventType(&client.MessageText{}), "文本消息不记录为事件")
+	assert.Empty(t, eventType(&client.MessagePoll{}), "投票不记录为事件")
+	for _, content := range []client.MessageContent{&client.MessageSticker{}, &client.MessagePhoto{}, &client.MessageVideoNote{}, &client.MessageDice{}} {
+		assert.True(t, model.IsEvent(eventType(content)), "事件类型均在 model.EventContentTypes 中")
+	}
+}
+
+func TestMessageLinks(t *testing.T) {
+	url := func(offset, length int32) *client.TextEntity {
+		return &client.TextEntity{Offset: offset, Length: length, Type: &client.TextEntityTypeUrl{}}
+	}
+	textURL := func(offset, length int32, link string) *client.TextEntity {
+		return &client.TextEntity{Offset: offset, Length: length, Type: &client.TextEntityTypeTextUrl{Url: link}}
+	}
+
+	// "😀" 占两个 UTF-16 码元
+	got := messageLinks(&client.MessageText{Text: &client.FormattedText{
+		Text:     "😀 文档 https://go.dev/doc 和 example.com/a 见说明",
+		Entities: []*client.TextEntity{url(6, 18), url(27, 13), textURL(41, 2, "https://wiki.example.com/x"), url(6, 18), textURL(0, 2, "tg://user?id=1")},
+	}})
+	assert.Equal(t, []string{"https://go.dev/doc", "http://example.com/a", "https://wiki.example.com/x"}, got, "按出现顺序去重，只保留 http(s) 链接")
+
+	got = messageLinks(&client.MessagePhoto{Caption: &client.FormattedText{Text: "go.dev", Entities: []*client.TextEntity{url(0, 6)}}})
+	assert.Equal(t, []string{"http://go.dev"}, got, "说明文字中的链接")
+
+	assert.Nil(t, messageLinks(&client.MessageText{Text: &client.FormattedText{Text: "没有链接"}}))
+	assert.Nil(t, messageLinks(&client.MessageText{Text: &client.FormattedText{Text: "go", Entities: []*client.TextEntity{url(0, 10)}}}), "越界的实体跳过")
+	assert.Nil(t, messageLinks(&client.MessageSticker{}))
+}
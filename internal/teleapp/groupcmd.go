@@ -0,0 +1,272 @@
+package teleapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+var (
+	// errRedactUsage /redact 参数格式错误
+	errRedactUsage = errors.New("参数格式错误")
+	// errRedactInvalid /redact 结束时间不晚于开始时间
+	errRedactInvalid = errors.New("结束时间需晚于开始时间")
+)
+
+// groupCommandHandler 群聊命令处理函数，返回的文本将作为回复发送
+type groupCommandHandler func(ctx context.Context, userID int64) (string, error)
+
+// handleGroupCommand 处理群聊中的命令：/optout、/optin、/redact 仅群管理员及机器人管理员可用，/tldr、/summary 启用后所有成员可用。
+// 返回 true 表示消息已作为命令处理
+func (app *TeleApp) handleGroupCommand(ctx context.Context, message *client.Message, c *client.Chat, text string) bool {
+	cmd := parseCommand(text)
+	if cmd == nil {
+		return false
+	}
+
+	var handler groupCommandHandler
+	adminOnly := true
+	switch cmd.Name {
+	case "tldr":
+		if !app.svcCtx.Config.TLDR.Enable {
+			return false
+		}
+		adminOnly = false
+		handler = func(ctx context.Context, userID int64) (string, error) {
+			return app.tldr(ctx, message, c)
+		}
+	case "summary":
+		if !app.svcCtx.Config.OnDemand.Enable || app.rangeSummarizer == nil {
+			return false
+		}
+		adminOnly = false
+		cooldown := time.Duration(app.svcCtx.Config.OnDemand.CooldownSeconds) * time.Second
+		handler = func(ctx context.Context, userID int64) (string, error) {
+			return app.summarize(ctx, c.Id, cmd.Args, cooldown, func(html string) error {
+				return app.replyHTML(c.Id, forumThreadID(message), message.Id, html)
+			})
+		}
+	case "optout", "optin":
+		handler = func(ctx context.Context, userID int64) (string, error) {
+			return app.setChatConsent(ctx, c, userID, cmd.Name == "optout")
+		}
+	case "redact":
+		handler = func(ctx context.Context, userID int64) (string, error) {
+			return app.redact(ctx, message, c, cmd.Args, userID)
+		}
+	default:
+		return false
+	}
+
+	sender, ok := message.SenderId.(*client.MessageSenderUser)
+	if !ok {
+		return false
+	}
+	logger.Infof("[TeleApp] 收到群聊命令: /%s %v, chatID=%d, senderID=%d", cmd.Name, cmd.Args, c.Id, sender.UserId)
+
+	go func() {
+		reply, err := app.runGroupCommand(ctx, c.Id, sender.UserId, adminOnly, handler)
+		if err != nil {
+			logger.Warnf("[TeleApp] 执行群聊命令 /%s 失败: %v", cmd.Name, err)
+			reply = app.formatter.ForChat(c.Id).T(display.TextCommandFailed)
+		}
+		if reply == "" {
+			return
+		}
+		if err := app.replyText(c.Id, forumThreadID(message), message.Id, reply); err != nil {
+			logger.Warnf("[TeleApp] 回复群聊命令 /%s 失败: %v", cmd.Name, err)
+		}
+	}()
+	return true
+}
+
+// runGroupCommand 校验权限后执行群聊命令，adminOnly 为 false 时所有成员可用
+func (app *TeleApp) runGroupCommand(ctx context.Context, chatID, userID int64, adminOnly bool, handler groupCommandHandler) (string, error) {
+	if adminOnly && !app.isAdmin(userID) {
+		admin, err := app.isChatAdmin(chatID, userID)
+		if err != nil {
+			return "", fmt.Errorf("查询成员权限失败: %w", err)
+		}
+		if !admin {
+			return app.formatter.ForChat(chatID).T(display.TextAdminOnly), nil
+		}
+	}
+	return handler(ctx, userID)
+}
+
+// isChatAdmin 判断用户是否为群聊的创建者或管理员
+func (app *TeleApp) isChatAdmin(chatID, userID int64) (bool, error) {
+	member, err := app.tdClient.GetChatMember(&client.GetChatMemberRequest{
+		ChatId:   chatID,
+		MemberId: &client.MessageSenderUser{UserId: userID},
+	})
+	if err != nil {
+		return false, err
+	}
+	switch member.Status.(type) {
+	case *client.ChatMemberStatusCreator, *client.ChatMemberStatusAdministrator:
+		return true, nil
+	}
+	return false, nil
+}
+
+// timeRange 时间区间 [start, end)
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// parseRedactRange 解析 /redact 参数："14:00-15:00"（当天）或 "2025-02-10 14:00-15:00"，时间按 loc 解释；
+// 格式错误时返回 errRedactUsage，结束时间不晚于开始时间时返回 errRedactInvalid
+func parseRedactRange(args []string, now time.Time, loc *time.Location) (timeRange, error) {
+	var date, clock string
+	switch len(args) {
+	case 1:
+		date, clock = now.In(loc).Format("2006-01-02"), args[0]
+	case 2:
+		date, clock = args[0], args[1]
+	default:
+		return timeRange{}, errRedactUsage
+	}
+
+	startClock, endClock, ok := strings.Cut(clock, "-")
+	if !ok {
+		return timeRange{}, errRedactUsage
+	}
+	start, err := time.ParseInLocation("2006-01-02 15:04", date+" "+startClock, loc)
+	if err != nil {
+		return timeRange{}, errRedactUsage
+	}
+	end, err := time.ParseInLocation("2006-01-02 15:04", date+" "+endClock, loc)
+	if err != nil {
+		return timeRange{}, errRedactUsage
+	}
+	if !end.After(start) {
+		return timeRange{}, errRedactInvalid
+	}
+	return timeRange{start: start, end: end}, nil
+}
+
+// redactRange 确定 /redact 的排除区间：带参数时按参数解析；回复某条消息时为该消息至命令发送时刻，
+// 既无参数也未回复消息时返回 errRedactUsage
+func (app *TeleApp) redactRange(message *client.Message, args []string, loc *time.Location) (timeRange, error) {
+	if len(args) > 0 {
+		return parseRedactRange(args, time.Now(), loc)
+	}
+
+	replyTo, ok := message.ReplyTo.(*client.MessageReplyToMessage)
+	if !ok || replyTo.MessageId == 0 {
+		return timeRange{}, errRedactUsage
+	}
+	replied, err := app.tdClient.GetMessage(&client.GetMessageRequest{ChatId: message.ChatId, MessageId: replyTo.MessageId})
+	if err != nil {
+		return timeRange{}, fmt.Errorf("获取被回复的消息失败: %w", err)
+	}
+	// 结束时间为命令发送时刻之后 1 秒，包含与命令同一秒发送的消息
+	return timeRange{
+		start: time.Unix(int64(replied.Date), 0),
+		end:   time.Unix(int64(message.Date), 0).Add(time.Second),
+	}, nil
+}
+
+// redact 排除群聊一段时间内的消息：删除已保存的消息，并记录区间使之后收到的区间内消息也不再保存
+func (app *TeleApp) redact(ctx context.Context, message *client.Message, c *client.Chat, args []string, userID int64) (string, error) {
+	loc, err := app.svcCtx.Config.Summary.Display.Location()
+	if err != nil {
+		return "", err
+	}
+	formatter := app.formatter.ForChat(c.Id)
+	r, err := app.redactRange(message, args, loc)
+	switch {
+	case errors.Is(err, errRedactUsage):
+		return formatter.T(display.TextRedactUsage), nil
+	case errors.Is(err, errRedactInvalid):
+		return formatter.T(display.TextRedactInvalid), nil
+	case err != nil:
+		return "", err
+	}
+
+	if _, err := app.svcCtx.BlackoutModel.Create(ctx, c.Id, r.start, r.end, userID); err != nil {
+		return "", err
+	}
+	app.addBlackout(c.Id, r)
+	// 经入库队列删除消息及链接：已入队的区间内消息先写入再删除，之后收到的区间内消息因排除区间不再入队
+	deleted, err := app.svcCtx.IngestQueue.Purge(ctx, c.Id, r.start, r.end)
+	if err != nil {
+		logger.Warnf("[TeleApp] 群聊 %s[%d] 已记录排除区间，但删除已保存的消息失败: %v", c.Title, c.Id, err)
+		return formatter.T(display.TextRedactPurgeFailed), nil
+	}
+
+	rangeText := r.start.In(loc).Format("01-02 15:04") + " ~ " + r.end.In(loc).Format("01-02 15:04")
+	logger.Infof("[TeleApp] 群聊 %s[%d] 排除区间 %s, 删除 %d 条消息, 操作人: %d", c.Title, c.Id, rangeText, deleted, userID)
+	return formatter.Tf(display.TextRedacted, rangeText, deleted), nil
+}
+
+// loadBlackouts 从数据库加载消息保留期内的排除区间（/tldr 读取历史消息时同样需要排除）
+func (app *TeleApp) loadBlackouts(ctx context.Context) {
+	since := time.Now().AddDate(0, 0, -app.svcCtx.Config.Summary.RetentionDays-1)
+	blackouts, err := app.svcCtx.BlackoutModel.EndingAfter(ctx, since)
+	if err != nil {
+		logger.Errorf("[TeleApp] 加载排除区间失败: %v", err)
+		return
+	}
+	for _, b := range blackouts {
+		app.addBlackout(b.ChatID, timeRange{start: b.StartTime, end: b.EndTime})
+	}
+}
+
+// addBlackout 缓存群聊的排除区间，同时移除已超出消息保留期的区间
+func (app *TeleApp) addBlackout(chatID int64, r timeRange) {
+	f := app.filters
+	f.blackoutsMu.Lock()
+	defer f.blackoutsMu.Unlock()
+	f.pruneBlackouts(time.Now())
+	f.blackouts[chatID] = append(f.blackouts[chatID], r)
+}
+
+// inBlackout 判断消息发送时间是否落在群聊的排除区间内；该群聊有已超出消息保留期的区间时将其移除
+func (app *TeleApp) inBlackout(chatID int64, sentAt time.Time) bool {
+	f := app.filters
+	now := time.Now()
+	f.blackoutsMu.RLock()
+	matched, expired := false, false
+	for _, r := range f.blackouts[chatID] {
+		if !sentAt.Before(r.start) && sentAt.Before(r.end) {
+			matched = true
+		}
+		expired = expired || f.blackoutExpired(r, now)
+	}
+	f.blackoutsMu.RUnlock()
+
+	if expired {
+		f.blackoutsMu.Lock()
+		f.pruneBlackouts(now)
+		f.blackoutsMu.Unlock()
+	}
+	return matched
+}
+
+// blackoutExpired 排除区间的结束时间是否已超出消息保留期：更早的消息已被清理，补录时也无需排除
+func (f *chatFilters) blackoutExpired(r timeRange, now time.Time) bool {
+	return f.blackoutRetention > 0 && r.end.Before(now.Add(-f.blackoutRetention))
+}
+
+// pruneBlackouts 移除各群聊已超出消息保留期的排除区间，调用方需持有 blackoutsMu 写锁
+func (f *chatFilters) pruneBlackouts(now time.Time) {
+	for chatID, ranges := range f.blackouts {
+		ranges = slices.DeleteFunc(ranges, func(r timeRange) bool { return f.blackoutExpired(r, now) })
+		if len(ranges) == 0 {
+			delete(f.blackouts, chatID)
+		} else {
+			f.blackouts[chatID] = ranges
+		}
+	}
+}
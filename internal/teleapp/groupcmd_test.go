@@ -0,0 +1,102 @@
+package teleapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRedactRange(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2025, 2, 10, 20, 0, 0, 0, time.UTC) // 北京时间 2025-02-11 04:00
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   error
+	}{
+		{"当天区间按展示时区", []string{"14:00-15:00"}, time.Date(2025, 2, 11, 14, 0, 0, 0, loc), time.Date(2025, 2, 11, 15, 0, 0, 0, loc), nil},
+		{"指定日期", []string{"2025-02-09", "09:30-10:00"}, time.Date(2025, 2, 9, 9, 30, 0, 0, loc), time.Date(2025, 2, 9, 10, 0, 0, 0, loc), nil},
+		{"缺少分隔符", []string{"14:00"}, time.Time{}, time.Time{}, errRedactUsage},
+		{"时间格式错误", []string{"14-15"}, time.Time{}, time.Time{}, errRedactUsage},
+		{"结束早于开始", []string{"15:00-14:00"}, time.Time{}, time.Time{}, errRedactInvalid},
+		{"参数过多", []string{"2025-02-09", "09:30-10:00", "x"}, time.Time{}, time.Time{}, errRedactUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRedactRange(tt.args, now, loc)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.wantStart.Equal(r.start), "start=%s", r.start)
+			assert.True(t, tt.wantEnd.Equal(r.end), "end=%s", r.end)
+		})
+	}
+}
+
+func TestParseSummaryRange(t *testing.T) {
+	loc := time.FixedZone("CST", 8*3600)
+	now := time.Date(2025, 2, 12, 2, 0, 0, 0, time.UTC) // 北京时间 2025-02-12 10:00
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   error
+	}{
+		{"今天截至当前", []string{"today"}, time.Date(2025, 2, 12, 0, 0, 0, 0, loc), now, nil},
+		{"昨天", []string{"Yesterday"}, time.Date(2025, 2, 11, 0, 0, 0, 0, loc), time.Date(2025, 2, 12, 0, 0, 0, 0, loc), nil},
+		{"单日", []string{"2025-02-10"}, time.Date(2025, 2, 10, 0, 0, 0, 0, loc), time.Date(2025, 2, 11, 0, 0, 0, 0, loc), nil},
+		{"多日包含结束日期", []string{"2025-02-05", "2025-02-11"}, time.Date(2025, 2, 5, 0, 0, 0, 0, loc), time.Date(2025, 2, 12, 0, 0, 0, 0, loc), nil},
+		{"结束日期为今天时截至当前", []string{"2025-02-11", "2025-02-12"}, time.Date(2025, 2, 11, 0, 0, 0, 0, loc), now, nil},
+		{"超过天数上限", []string{"2025-02-04", "2025-02-11"}, time.Time{}, time.Time{}, errSummaryTooLong},
+		{"结束早于开始", []string{"2025-02-11", "2025-02-10"}, time.Time{}, time.Time{}, errSummaryUsage},
+		{"未来日期", []string{"2025-02-13"}, time.Time{}, time.Time{}, errSummaryUsage},
+		{"日期格式错误", []string{"02-10"}, time.Time{}, time.Time{}, errSummaryUsage},
+		{"缺少参数", nil, time.Time{}, time.Time{}, errSummaryUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseSummaryRange(tt.args, now, loc, 7)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.wantStart.Equal(r.start), "start=%s", r.start)
+			assert.True(t, tt.wantEnd.Equal(r.end), "end=%s", r.end)
+		})
+	}
+}
+
+func TestInBlackout(t *testing.T) {
+	app := &TeleApp{filters: newChatFilters(0)}
+	start := time.Date(2025, 2, 10, 14, 0, 0, 0, time.UTC)
+	app.addBlackout(-100, timeRange{start: start, end: start.Add(time.Hour)})
+
+	assert.True(t, app.inBlackout(-100, start), "包含开始时间")
+	assert.True(t, app.inBlackout(-100, start.Add(30*time.Minute)))
+	assert.False(t, app.inBlackout(-100, start.Add(time.Hour)), "不包含结束时间")
+	assert.False(t, app.inBlackout(-200, start.Add(30*time.Minute)), "其他群聊不受影响")
+
+	t.Run("移除超出保留期的区间", func(t *testing.T) {
+		app := &TeleApp{filters: newChatFilters(48 * time.Hour)}
+		now := time.Now()
+		expired := timeRange{start: now.Add(-5 * 24 * time.Hour), end: now.Add(-4 * 24 * time.Hour)}
+		app.addBlackout(-100, expired)
+		app.addBlackout(-200, timeRange{start: now.Add(-time.Hour), end: now})
+		assert.NotContains(t, app.filters.blackouts, int64(-100), "添加区间时移除已过期的区间")
+
+		app.addBlackout(-100, expired)
+		assert.False(t, app.inBlackout(-100, now))
+		assert.NotContains(t, app.filters.blackouts, int64(-100), "查询时移除该群聊已过期的区间")
+		assert.True(t, app.inBlackout(-200, now.Add(-time.Minute)), "保留期内结束的区间仍然生效")
+	})
+}
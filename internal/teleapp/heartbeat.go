@@ -0,0 +1,87 @@
+package teleapp
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// UpdateHeartbeat 在收藏夹（Saved Messages）中维护一条状态消息：首次发送，之后原地编辑。
+// 消息 ID 持久化到数据目录，重启后继续编辑同一条消息；消息被删除或无法编辑时重新发送
+func (app *TeleApp) UpdateHeartbeat(text string) error {
+	if app.user == nil {
+		return errors.New("尚未登录")
+	}
+
+	app.heartbeatMu.Lock()
+	defer app.heartbeatMu.Unlock()
+
+	chatID := app.user.Id
+	content := &client.InputMessageText{Text: &client.FormattedText{Text: text}}
+
+	if app.heartbeatMsgID == 0 {
+		app.heartbeatMsgID = app.loadHeartbeatMessageID()
+	}
+	if app.heartbeatMsgID != 0 {
+		_, err := app.tdClient.EditMessageText(&client.EditMessageTextRequest{
+			ChatId:              chatID,
+			MessageId:           app.heartbeatMsgID,
+			InputMessageContent: content,
+		})
+		if err == nil {
+			return nil
+		}
+		logger.Warnf("[TeleApp] 编辑心跳消息失败，重新发送: %v", err)
+	}
+
+	// 确保收藏夹会话已加载
+	if _, err := app.tdClient.CreatePrivateChat(&client.CreatePrivateChatRequest{UserId: chatID}); err != nil {
+		return err
+	}
+	message, err := app.tdClient.SendMessage(&client.SendMessageRequest{
+		ChatId:              chatID,
+		InputMessageContent: content,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 此时为临时 ID，发送成功后在 onMessageSendSucceeded 中替换为正式 ID
+	app.heartbeatMsgID = message.Id
+	return nil
+}
+
+// onMessageSendSucceeded 心跳消息发送成功后记录服务端分配的正式消息 ID
+func (app *TeleApp) onMessageSendSucceeded(update *client.UpdateMessageSendSucceeded) {
+	app.heartbeatMu.Lock()
+	defer app.heartbeatMu.Unlock()
+
+	if app.heartbeatMsgID == 0 || update.OldMessageId != app.heartbeatMsgID {
+		return
+	}
+	app.heartbeatMsgID = update.Message.Id
+	if err := os.WriteFile(app.heartbeatFile, []byte(strconv.FormatInt(update.Message.Id, 10)), 0644); err != nil {
+		logger.Warnf("[TeleApp] 保存心跳消息 ID 失败: %v", err)
+	}
+}
+
+// WaitSent 等待消息发送完成，返回正式消息ID（实现 notify.SendWaiter）
+func (app *TeleApp) WaitSent(ctx context.Context, chatID, messageID int64) (int64, error) {
+	return app.sends.Wait(ctx, chatID, messageID)
+}
+
+// loadHeartbeatMessageID 读取上次保存的心跳消息 ID，不存在时返回 0
+func (app *TeleApp) loadHeartbeatMessageID() int64 {
+	data, err := os.ReadFile(app.heartbeatFile)
+	if err != nil {
+		return 0
+	}
+	id, _ := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	return id
+}
@@ -0,0 +1,231 @@
+package teleapp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// ErrInteractiveLoginRequired 登录需要输入的信息既不能在终端输入，也未在 TelegramApp.Login 中配置
+var ErrInteractiveLoginRequired = errors.New("需要交互式登录，请在终端中运行一次完成登录，或配置 TelegramApp.Login 使用非交互式登录")
+
+// loginCodePollInterval 等待验证码时检查验证码文件的间隔
+const loginCodePollInterval = 2 * time.Second
+
+// SetLogin 设置该账号的非交互式登录配置（默认使用 TelegramApp.Login），需在 Login 之前调用
+func (app *TeleApp) SetLogin(login *config.TelegramLogin) {
+	app.login = login
+}
+
+// authorizer 返回登录使用的授权处理：启用了非交互式登录或标准输入不是终端时使用非交互式登录，否则在终端输入
+func (app *TeleApp) authorizer() client.AuthorizationStateHandler {
+	if !app.login.Enabled() && isTerminal(os.Stdin) {
+		authorizer := client.ClientAuthorizer(app.parameters)
+		go client.CliInteractor(authorizer)
+		return authorizer
+	}
+
+	codeFile := app.login.CodeFile
+	if codeFile == "" {
+		codeFile = app.loginCodeFile
+	}
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	if app.svcCtx.TransportProxy != nil {
+		httpClient.Transport = app.svcCtx.TransportProxy
+	}
+	return &loginAuthorizer{
+		parameters:   app.parameters,
+		login:        app.login,
+		codeFile:     codeFile,
+		codeTimeout:  time.Duration(app.login.CodeTimeout) * time.Second,
+		pollInterval: loginCodePollInterval,
+		httpClient:   httpClient,
+		formatter:    app.formatter,
+	}
+}
+
+// isTerminal 判断文件是否为终端（字符设备）
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// loginAuthorizer 非交互式登录：手机号和两步验证密码取自配置，验证码从 codeFile 读取，或开启 QRCode 时扫码登录；
+// 需要其他信息（邮箱验证、注册新账号等）时返回 ErrInteractiveLoginRequired
+type loginAuthorizer struct {
+	parameters   *client.SetTdlibParametersRequest
+	login        *config.TelegramLogin
+	codeFile     string
+	codeTimeout  time.Duration
+	pollInterval time.Duration
+	httpClient   *http.Client
+	formatter    *display.Formatter // 推送到 Webhook 的文本
+
+	qrLink     string    // 最近一次输出的二维码登录链接
+	qrDeadline time.Time // 等待扫码的截止时间
+}
+
+// qrWebhookPayload 二维码登录链接推送到 Webhook 的 JSON 内容，与告警的格式相同并附带链接
+type qrWebhookPayload struct {
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	Link    string    `json:"link"`
+	Time    time.Time `json:"time"`
+}
+
+// Handle 处理登录过程中的授权状态，实现 client.AuthorizationStateHandler
+func (a *loginAuthorizer) Handle(c *client.Client, state client.AuthorizationState) error {
+	switch state.AuthorizationStateType() {
+	case client.TypeAuthorizationStateWaitTdlibParameters:
+		_, err := c.SetTdlibParameters(a.parameters)
+		return err
+
+	case client.TypeAuthorizationStateWaitPhoneNumber:
+		if a.login.QRCode {
+			logger.Infof("[TeleApp] 会话不存在或已失效，使用二维码登录")
+			_, err := c.RequestQrCodeAuthentication(&client.RequestQrCodeAuthenticationRequest{})
+			return err
+		}
+		if a.login.PhoneNumber == "" {
+			return fmt.Errorf("%w: 会话不存在或已失效，未配置 PhoneNumber 或 QRCode", ErrInteractiveLoginRequired)
+		}
+		// 删除上次登录遗留的验证码，避免误用
+		_ = os.Remove(a.codeFile)
+		logger.Infof("[TeleApp] 会话不存在或已失效，使用配置的手机号登录")
+		_, err := c.SetAuthenticationPhoneNumber(&client.SetAuthenticationPhoneNumberRequest{
+			PhoneNumber: a.login.PhoneNumber,
+			Settings:    &client.PhoneNumberAuthenticationSettings{},
+		})
+		return err
+
+	case client.TypeAuthorizationStateWaitCode:
+		deadline := time.Now().Add(a.codeTimeout)
+		logger.Warnf("[TeleApp] 等待登录验证码：请在 %s 内将 Telegram 发送的验证码写入 %s", a.codeTimeout, a.codeFile)
+		for {
+			code, err := a.waitCode(deadline)
+			if err != nil {
+				return err
+			}
+			_, err = c.CheckAuthenticationCode(&client.CheckAuthenticationCodeRequest{Code: code})
+			if err == nil {
+				return nil
+			}
+			logger.Warnf("[TeleApp] 验证码错误，请重新写入 %s: %v", a.codeFile, err)
+		}
+
+	case client.TypeAuthorizationStateWaitOtherDeviceConfirmation:
+		// 链接过期后 TDLib 会更新为新的链接，每个链接只输出一次；等待状态变化，避免重复处理同一状态
+		link := state.(*client.AuthorizationStateWaitOtherDeviceConfirmation).Link
+		if a.qrDeadline.IsZero() {
+			a.qrDeadline = time.Now().Add(a.codeTimeout)
+		}
+		if link != a.qrLink {
+			a.qrLink = link
+			a.showQRLink(context.Background(), link)
+		}
+		return a.waitStateChange(c.GetAuthorizationState, link)
+
+	case client.TypeAuthorizationStateWaitPassword:
+		if a.login.Password == "" {
+			return fmt.Errorf("%w: 账号开启了两步验证，未配置 Password", ErrInteractiveLoginRequired)
+		}
+		_, err := c.CheckAuthenticationPassword(&client.CheckAuthenticationPasswordRequest{Password: a.login.Password})
+		return err
+
+	case client.TypeAuthorizationStateReady, client.TypeAuthorizationStateClosing, client.TypeAuthorizationStateClosed:
+		return nil
+	}
+	return fmt.Errorf("%w: 不支持的登录状态 %s", ErrInteractiveLoginRequired, state.AuthorizationStateType())
+}
+
+// Close 登录结束，实现 client.AuthorizationStateHandler
+func (a *loginAuthorizer) Close() {}
+
+// waitCode 等待验证码写入 codeFile，读取后删除该文件；超过 deadline 仍未写入时返回错误
+func (a *loginAuthorizer) waitCode(deadline time.Time) (string, error) {
+	for {
+		if data, err := os.ReadFile(a.codeFile); err == nil {
+			_ = os.Remove(a.codeFile)
+			if code := strings.TrimSpace(string(data)); code != "" {
+				return code, nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("等待验证码超时，未在 %s 内写入 %s", a.codeTimeout, a.codeFile)
+		}
+		time.Sleep(a.pollInterval)
+	}
+}
+
+// showQRLink 输出二维码登录链接，配置了 QRWebhookURL 时同时推送，推送失败只记录日志
+func (a *loginAuthorizer) showQRLink(ctx context.Context, link string) {
+	logger.Warnf("[TeleApp] 等待扫码登录：请在 %s 内用已登录的 Telegram 设备（设置 → 设备 → 连接桌面设备）扫描该链接生成的二维码: %s",
+		time.Until(a.qrDeadline).Round(time.Second), link)
+	if a.login.QRWebhookURL == "" {
+		return
+	}
+	if err := a.postQRLink(ctx, link); err != nil {
+		logger.Errorf("[TeleApp] 推送二维码登录链接到 Webhook 失败: %v", err)
+	}
+}
+
+// postQRLink 以 JSON 格式 POST 二维码登录链接到 QRWebhookURL
+func (a *loginAuthorizer) postQRLink(ctx context.Context, link string) error {
+	body, err := json.Marshal(qrWebhookPayload{
+		Title:   a.formatter.T(display.TextQRLoginTitle),
+		Message: a.formatter.T(display.TextQRLoginDetail),
+		Link:    link,
+		Time:    time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.login.QRWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 返回状态码 %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// waitStateChange 等待授权状态离开扫码等待或二维码链接更新；超过 qrDeadline 仍未扫码时返回错误
+func (a *loginAuthorizer) waitStateChange(getState func() (client.AuthorizationState, error), link string) error {
+	for {
+		if time.Now().After(a.qrDeadline) {
+			return fmt.Errorf("等待扫码超时，未在 %s 内完成扫码登录", a.codeTimeout)
+		}
+		time.Sleep(a.pollInterval)
+
+		state, err := getState()
+		if err != nil {
+			return err
+		}
+		waiting, ok := state.(*client.AuthorizationStateWaitOtherDeviceConfirmation)
+		if !ok || waiting.Link != link {
+			return nil
+		}
+	}
+}
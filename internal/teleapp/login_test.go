@@ -0,0 +1,93 @@
+package teleapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestLoginAuthorizer_WaitCode(t *testing.T) {
+	a := &loginAuthorizer{
+		login:        &config.TelegramLogin{},
+		codeFile:     filepath.Join(t.TempDir(), "login_code"),
+		codeTimeout:  time.Second,
+		pollInterval: 10 * time.Millisecond,
+	}
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		_ = os.WriteFile(a.codeFile, []byte(" 12345\n"), 0o600)
+	}()
+	code, err := a.waitCode(time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, "12345", code)
+	assert.NoFileExists(t, a.codeFile, "读取后删除验证码文件")
+
+	t.Run("超时", func(t *testing.T) {
+		_, err := a.waitCode(time.Now().Add(30 * time.Millisecond))
+		assert.Error(t, err)
+	})
+}
+
+func TestLoginAuthorizer_Handle(t *testing.T) {
+	a := &loginAuthorizer{login: &config.TelegramLogin{}, codeFile: filepath.Join(t.TempDir(), "login_code")}
+
+	for name, state := range map[string]client.AuthorizationState{
+		"未配置手机号":    &client.AuthorizationStateWaitPhoneNumber{},
+		"未配置两步验证密码": &client.AuthorizationStateWaitPassword{},
+		"需要注册新账号":   &client.AuthorizationStateWaitRegistration{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := a.Handle(nil, state)
+			assert.True(t, errors.Is(err, ErrInteractiveLoginRequired), "%v", err)
+		})
+	}
+	assert.NoError(t, a.Handle(nil, &client.AuthorizationStateReady{}))
+}
+
+func TestLoginAuthorizer_WaitStateChange(t *testing.T) {
+	a := &loginAuthorizer{codeTimeout: time.Second, qrDeadline: time.Now().Add(time.Second), pollInterval: time.Millisecond}
+
+	states := []client.AuthorizationState{
+		&client.AuthorizationStateWaitOtherDeviceConfirmation{Link: "tg://login?token=a"},
+		&client.AuthorizationStateWaitOtherDeviceConfirmation{Link: "tg://login?token=b"},
+	}
+	calls := 0
+	getState := func() (client.AuthorizationState, error) {
+		state := states[calls]
+		calls++
+		return state, nil
+	}
+	require.NoError(t, a.waitStateChange(getState, "tg://login?token=a"))
+	assert.Equal(t, 2, calls, "链接更新后返回")
+
+	t.Run("超时", func(t *testing.T) {
+		a.qrDeadline = time.Now().Add(-time.Second)
+		assert.Error(t, a.waitStateChange(getState, "tg://login?token=b"))
+	})
+}
+
+func TestLoginAuthorizer_PostQRLink(t *testing.T) {
+	var payload qrWebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	a := &loginAuthorizer{login: &config.TelegramLogin{QRWebhookURL: server.URL}, httpClient: server.Client()}
+	require.NoError(t, a.postQRLink(context.Background(), "tg://login?token=a"))
+	assert.Equal(t, "tg://login?token=a", payload.Link)
+	assert.NotEmpty(t, payload.Title)
+}
@@ -0,0 +1,172 @@
+package teleapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/lang"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// serverMessageIDShift TDLib 中服务器消息的 ID 为服务器消息ID左移 20 位，本地消息（如发送中）低 20 位非零
+const serverMessageIDShift = 20
+
+// serverMessageID 返回 TDLib 消息ID 对应的服务器消息ID，本地消息返回 0
+func serverMessageID(messageID int64) int64 {
+	if messageID <= 0 || messageID&(1<<serverMessageIDShift-1) != 0 {
+		return 0
+	}
+	return messageID >> serverMessageIDShift
+}
+
+// forumThreadID 返回论坛话题消息所在话题的消息线程ID，非话题消息（含 General 中的消息）返回 0
+func forumThreadID(m *client.Message) int64 {
+	if !m.IsTopicMessage {
+		return 0
+	}
+	return m.MessageThreadId
+}
+
+// senderChatName 以聊天身份发送的消息的发送者名：聊天名称，消息带作者签名（匿名管理员的头衔、频道文章的署名）时附在其后
+func senderChatName(chat *client.Chat, signature string) string {
+	if signature == "" {
+		return chat.Title
+	}
+	return chat.Title + " (" + signature + ")"
+}
+
+// reactionCacheMax 记录的消息回应总数上限，超过时清空重新记录
+const reactionCacheMax = 10000
+
+// messageKey 群聊中的一条消息
+type messageKey struct {
+	chatID    int64
+	messageID int64
+}
+
+// reactionCount 返回消息收到的回应总数（各回应的次数之和），没有回应时返回 0
+func reactionCount(info *client.MessageInteractionInfo) int64 {
+	if info == nil || info.Reactions == nil {
+		return 0
+	}
+	var n int64
+	for _, r := range info.Reactions.Reactions {
+		n += int64(r.TotalCount)
+	}
+	return n
+}
+
+// updateMessageID 本账号发送的群消息入库时为临时ID，发送成功后经入库队列更新为正式ID（保证在临时消息写入之后执行）
+func (app *TeleApp) updateMessageID(ctx context.Context, update *client.UpdateMessageSendSucceeded) {
+	chatID := update.Message.ChatId
+	if err := app.svcCtx.IngestQueue.EnqueueMessageID(ctx, chatID, update.OldMessageId, update.Message.Id, serverMessageID(update.Message.Id)); err != nil {
+		logger.Warnf("[TeleApp] 消息ID更新入队失败, chat: %d, %d -> %d, %v", chatID, update.OldMessageId, update.Message.Id, err)
+	}
+}
+
+// onMessageEdited 消息被编辑后获取编辑后的内容，经入库队列更新已保存的消息及其中分享的链接（保证在原消息写入之后执行），
+// 避免总结引用编辑前的旧内容。编辑后的内容类型不在 Ingest.ContentTypes 中或没有文字时不更新
+func (app *TeleApp) onMessageEdited(ctx context.Context, update *client.UpdateMessageEdited) {
+	if app.isOptedOut(update.ChatId) || !app.owns(update.ChatId) {
+		return
+	}
+
+	// updateMessageEdited 不含消息内容，内容变化已由此前的 updateMessageContent 同步到 TDLib 本地
+	message, err := app.tdClient.GetMessage(&client.GetMessageRequest{ChatId: update.ChatId, MessageId: update.MessageId})
+	if err != nil {
+		logger.Warnf("[TeleApp] 获取编辑后的消息失败, chat: %d, message: %d, %v", update.ChatId, update.MessageId, err)
+		return
+	}
+	contentType, text := ingestContent(message.Content)
+	if text == "" || !app.svcCtx.Config.Ingest.Accepts(contentType) {
+		return
+	}
+
+	// 发送者和发送时间只用于重新保存链接，不更新已保存的消息
+	sender, ok := app.messageSender(message)
+	if !ok {
+		return
+	}
+	editedAt := time.Unix(int64(update.EditDate), 0)
+	data := &model.MessageData{
+		MessageID:   update.MessageId,
+		ChatID:      update.ChatId,
+		SenderID:    sender.SenderID,
+		SenderName:  sender.SenderName,
+		Text:        text,
+		Lang:        lang.Detect(text),
+		ContentType: contentType,
+		SentAt:      time.Unix(int64(message.Date), 0),
+		EditedAt:    &editedAt,
+		Links:       messageLinks(message.Content),
+	}
+	if err := app.svcCtx.IngestQueue.Enqueue(ctx, data); err != nil {
+		logger.Errorf("[TeleApp] 编辑消息入队失败, %v", err)
+		return
+	}
+	logger.Debugf("[TeleApp] 消息已编辑: chat: %d, message: %d -> %s", update.ChatId, update.MessageId, text)
+}
+
+// onMessagesDeleted 消息在 Telegram 中被删除后，经入库队列软删除已保存的消息，使其不再参与总结。
+// TDLib 仅从本地缓存移除（FromCache）或非永久删除时不处理
+func (app *TeleApp) onMessagesDeleted(ctx context.Context, update *client.UpdateDeleteMessages) {
+	if !update.IsPermanent || update.FromCache || len(update.MessageIds) == 0 || !app.owns(update.ChatId) {
+		return
+	}
+	if err := app.svcCtx.IngestQueue.EnqueueDelete(ctx, update.ChatId, update.MessageIds); err != nil {
+		logger.Errorf("[TeleApp] 删除消息入队失败, chat: %d, %v", update.ChatId, err)
+		return
+	}
+	logger.Debugf("[TeleApp] 消息已删除: chat: %d, messages: %v", update.ChatId, update.MessageIds)
+}
+
+// onMessageInteractionInfo 消息的回应变化后经入库队列更新已保存消息的回应总数，总结时据此选出最多回应的消息。
+// 互动信息还包含浏览量、转发数，回应总数未变化时不更新；消息未保存（如私聊、不支持的内容类型）时由存储忽略
+func (app *TeleApp) onMessageInteractionInfo(ctx context.Context, update *client.UpdateMessageInteractionInfo) {
+	if app.isOptedOut(update.ChatId) || !app.svcCtx.Config.Summary.AllowsChat(update.ChatId) || !app.owns(update.ChatId) {
+		return
+	}
+	count := reactionCount(update.InteractionInfo)
+	if !app.reactionChanged(messageKey{update.ChatId, update.MessageId}, count) {
+		return
+	}
+	if err := app.svcCtx.IngestQueue.EnqueueReactions(ctx, update.ChatId, update.MessageId, count); err != nil {
+		logger.Errorf("[TeleApp] 回应数入队失败, chat: %d, message: %d, %v", update.ChatId, update.MessageId, err)
+		return
+	}
+	logger.Debugf("[TeleApp] 消息回应数变化: chat: %d, message: %d -> %d", update.ChatId, update.MessageId, count)
+}
+
+// reactionChanged 记录消息的回应总数，返回是否与上次不同；首次出现且没有回应的消息视为未变化（入库时已为 0）
+func (app *TeleApp) reactionChanged(key messageKey, count int64) bool {
+	app.reactionsMu.Lock()
+	defer app.reactionsMu.Unlock()
+	last, ok := app.reactions[key]
+	if (ok && last == count) || (!ok && count == 0) {
+		return false
+	}
+	if len(app.reactions) >= reactionCacheMax {
+		clear(app.reactions)
+	}
+	app.reactions[key] = count
+	return true
+}
+
+// onMessageContent 投票的得票情况变化（有人投票、投票结束）后经入库队列更新已保存的投票结果。
+// updatePoll 仅推送给机器人账号，用户账号通过消息内容更新获得投票的变化；其他内容的变化由 onMessageEdited 处理
+func (app *TeleApp) onMessageContent(ctx context.Context, update *client.UpdateMessageContent) {
+	poll := pollData(update.NewContent)
+	if poll == nil || !app.svcCtx.Config.Ingest.Accepts("poll") {
+		return
+	}
+	if app.isOptedOut(update.ChatId) || !app.svcCtx.Config.Summary.AllowsChat(update.ChatId) || !app.owns(update.ChatId) {
+		return
+	}
+	if err := app.svcCtx.IngestQueue.EnqueuePoll(ctx, update.ChatId, update.MessageId, poll); err != nil {
+		logger.Errorf("[TeleApp] 投票结果入队失败, chat: %d, message: %d, %v", update.ChatId, update.MessageId, err)
+		return
+	}
+	logger.Debugf("[TeleApp] 投票结果变化: chat: %d, message: %d, %d 人参与", update.ChatId, update.MessageId, poll.TotalVoters)
+}
@@ -0,0 +1,49 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestServerMessageID(t *testing.T) {
+	tests := []struct {
+		name      string
+		messageID int64
+		want      int64
+	}{
+		{"服务器消息", 12345 << 20, 12345},
+		{"发送中的本地消息", 12345<<20 + 1, 0},
+		{"无效ID", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, serverMessageID(tt.messageID))
+		})
+	}
+}
+
+func TestReactionCount(t *testing.T) {
+	assert.Zero(t, reactionCount(nil))
+	assert.Zero(t, reactionCount(&client.MessageInteractionInfo{ViewCount: 100}), "只有浏览量")
+	assert.Equal(t, int64(7), reactionCount(&client.MessageInteractionInfo{
+		Reactions: &client.MessageReactions{Reactions: []*client.MessageReaction{{TotalCount: 5}, {TotalCount: 2}}},
+	}))
+}
+
+func TestReactionChanged(t *testing.T) {
+	app := &TeleApp{reactions: make(map[messageKey]int64)}
+	key := messageKey{chatID: -100, messageID: 1 << 20}
+
+	assert.False(t, app.reactionChanged(key, 0), "没有回应的新消息无需更新")
+	assert.True(t, app.reactionChanged(key, 3))
+	assert.False(t, app.reactionChanged(key, 3), "回应数未变化（如浏览量变化）")
+	assert.True(t, app.reactionChanged(key, 0), "回应被全部撤回")
+}
+
+func TestSenderChatName(t *testing.T) {
+	chat := &client.Chat{Title: "Go 夜读"}
+	assert.Equal(t, "Go 夜读", senderChatName(chat, ""), "匿名管理员未设置头衔")
+	assert.Equal(t, "Go 夜读 (主持人)", senderChatName(chat, "主持人"))
+}
@@ -0,0 +1,159 @@
+package teleapp
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// joinedChat 判断消息是否为自己加入群聊的服务消息（被拉入群、通过链接或申请加入、自己创建群组）。
+// 启动时 TDLib 会为所有已有聊天推送 updateNewChat，无法据此判断新加入，因此以服务消息为准
+func joinedChat(message *client.Message, selfID int64) bool {
+	switch content := message.Content.(type) {
+	case *client.MessageChatAddMembers:
+		return slices.Contains(content.MemberUserIds, selfID)
+	case *client.MessageChatJoinByLink, *client.MessageChatJoinByRequest,
+		*client.MessageBasicGroupChatCreate, *client.MessageSupergroupChatCreate:
+		sender, ok := message.SenderId.(*client.MessageSenderUser)
+		return ok && sender.UserId == selfID
+	}
+	return false
+}
+
+// isGroupChat 判断是否为群组（普通群或超级群，不含频道）
+func isGroupChat(c *client.Chat) bool {
+	switch t := c.Type.(type) {
+	case *client.ChatTypeBasicGroup:
+		return true
+	case *client.ChatTypeSupergroup:
+		return !t.IsChannel
+	}
+	return false
+}
+
+// chatTypeOf 返回群聊的类型（普通群、超级群或频道），私聊和密聊返回空字符串
+func chatTypeOf(c *client.Chat) chat.ChatType {
+	switch t := c.Type.(type) {
+	case *client.ChatTypeBasicGroup:
+		return chat.ChatTypeGroup
+	case *client.ChatTypeSupergroup:
+		if t.IsChannel {
+			return chat.ChatTypeChannel
+		}
+		return chat.ChatTypeSupergroup
+	}
+	return ""
+}
+
+// loadOptedOut 从数据库加载已退出数据收集的群聊
+func (app *TeleApp) loadOptedOut(ctx context.Context) {
+	chatIDs, err := app.svcCtx.ChatModel.OptedOutChatIDs(ctx)
+	if err != nil {
+		logger.Errorf("[TeleApp] 加载已退出收集的群聊失败: %v", err)
+		return
+	}
+
+	app.filters.optedOutMu.Lock()
+	defer app.filters.optedOutMu.Unlock()
+	for _, chatID := range chatIDs {
+		app.filters.optedOut[chatID] = true
+	}
+}
+
+// isOptedOut 判断群聊是否已退出数据收集
+func (app *TeleApp) isOptedOut(chatID int64) bool {
+	app.filters.optedOutMu.RLock()
+	defer app.filters.optedOutMu.RUnlock()
+	return app.filters.optedOut[chatID]
+}
+
+// setOptedOut 更新群聊的退出状态
+func (app *TeleApp) setOptedOut(chatID int64, optedOut bool) {
+	app.filters.optedOutMu.Lock()
+	defer app.filters.optedOutMu.Unlock()
+	if optedOut {
+		app.filters.optedOut[chatID] = true
+	} else {
+		delete(app.filters.optedOut, chatID)
+	}
+}
+
+// onboardChat 加入新群组后发送介绍消息并记录告知状态；曾经告知过或已退出的群组不再重复发送
+func (app *TeleApp) onboardChat(ctx context.Context, chatID int64) {
+	c, err := app.getChat(chatID)
+	if err != nil {
+		logger.Warnf("[TeleApp] 获取聊天信息失败, id: %d, %v", chatID, err)
+		return
+	}
+	if !isGroupChat(c) {
+		return
+	}
+	logger.Infof("[TeleApp] 已加入群聊: %s[%d]", c.Title, c.Id)
+	if !app.owns(chatID) {
+		logger.Infof("[TeleApp] 群聊 %s[%d] 已由其他账号负责，不发送介绍消息", c.Title, c.Id)
+		return
+	}
+	if !app.svcCtx.Config.Summary.AllowsChat(chatID) {
+		logger.Infof("[TeleApp] 群聊 %s[%d] 不在允许收集的范围内，不发送介绍消息", c.Title, c.Id)
+		return
+	}
+
+	cfg := app.svcCtx.Config.Onboarding
+	if !cfg.Enable {
+		return
+	}
+	consent, err := app.svcCtx.ChatModel.GetConsent(ctx, chatID)
+	if err != nil {
+		logger.Warnf("[TeleApp] 查询群聊告知状态失败, id: %d, %v", chatID, err)
+		return
+	}
+	if consent != chat.ConsentUnknown {
+		logger.Infof("[TeleApp] 群聊 %s[%d] 告知状态为 %s，不再发送介绍消息", c.Title, c.Id, consent)
+		return
+	}
+
+	_, err = app.tdClient.SendMessage(&client.SendMessageRequest{
+		ChatId: chatID,
+		InputMessageContent: &client.InputMessageText{
+			Text: &client.FormattedText{Text: cfg.Text},
+		},
+	})
+	if err != nil {
+		logger.Warnf("[TeleApp] 发送介绍消息失败, id: %d, %v", chatID, err)
+		return
+	}
+	if err := app.svcCtx.ChatModel.SetConsent(ctx, chatID, c.Title, chat.ConsentNotified); err != nil {
+		logger.Warnf("[TeleApp] 保存群聊告知状态失败, id: %d, %v", chatID, err)
+	}
+}
+
+// setChatConsent 群聊退出或恢复数据收集；退出时同时删除已保存的消息
+func (app *TeleApp) setChatConsent(ctx context.Context, c *client.Chat, userID int64, optOut bool) (string, error) {
+	if !optOut {
+		if err := app.svcCtx.ChatModel.SetConsent(ctx, c.Id, c.Title, chat.ConsentNotified); err != nil {
+			return "", err
+		}
+		app.setOptedOut(c.Id, false)
+		logger.Infof("[TeleApp] 群聊 %s[%d] 已恢复数据收集, 操作人: %d", c.Title, c.Id, userID)
+		return app.formatter.ForChat(c.Id).T(display.TextOptedIn), nil
+	}
+
+	if err := app.svcCtx.ChatModel.SetConsent(ctx, c.Id, c.Title, chat.ConsentOptedOut); err != nil {
+		return "", err
+	}
+	app.setOptedOut(c.Id, true)
+	// 经入库队列删除消息及链接：已入队的消息先写入再删除，之后收到的消息因已退出收集不再入队
+	deleted, err := app.svcCtx.IngestQueue.Purge(ctx, c.Id, time.Time{}, time.Time{})
+	if err != nil {
+		logger.Warnf("[TeleApp] 群聊 %s[%d] 已退出数据收集，但删除已保存的消息失败: %v", c.Title, c.Id, err)
+		return app.formatter.ForChat(c.Id).T(display.TextOptOutPurgeFailed), nil
+	}
+	logger.Infof("[TeleApp] 群聊 %s[%d] 已退出数据收集, 删除 %d 条消息, 操作人: %d", c.Title, c.Id, deleted, userID)
+	return app.formatter.ForChat(c.Id).Tf(display.TextOptedOut, deleted), nil
+}
@@ -0,0 +1,50 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent/chat"
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func TestJoinedChat(t *testing.T) {
+	const selfID = 42
+	self := &client.MessageSenderUser{UserId: selfID}
+	other := &client.MessageSenderUser{UserId: 7}
+
+	tests := []struct {
+		name    string
+		sender  client.MessageSender
+		content client.MessageContent
+		want    bool
+	}{
+		{"被他人拉入群", other, &client.MessageChatAddMembers{MemberUserIds: []int64{3, selfID}}, true},
+		{"他人拉入其他成员", other, &client.MessageChatAddMembers{MemberUserIds: []int64{3}}, false},
+		{"自己通过链接加入", self, &client.MessageChatJoinByLink{}, true},
+		{"他人通过链接加入", other, &client.MessageChatJoinByLink{}, false},
+		{"自己申请加入获批", self, &client.MessageChatJoinByRequest{}, true},
+		{"自己创建群组", self, &client.MessageSupergroupChatCreate{Title: "新群"}, true},
+		{"普通消息", self, &client.MessageText{Text: &client.FormattedText{Text: "hi"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := &client.Message{SenderId: tt.sender, Content: tt.content}
+			assert.Equal(t, tt.want, joinedChat(message, selfID))
+		})
+	}
+}
+
+func TestIsGroupChat(t *testing.T) {
+	assert.True(t, isGroupChat(&client.Chat{Type: &client.ChatTypeBasicGroup{}}))
+	assert.True(t, isGroupChat(&client.Chat{Type: &client.ChatTypeSupergroup{}}))
+	assert.False(t, isGroupChat(&client.Chat{Type: &client.ChatTypeSupergroup{IsChannel: true}}), "频道不是群组")
+	assert.False(t, isGroupChat(&client.Chat{Type: &client.ChatTypePrivate{}}))
+}
+
+func TestChatTypeOf(t *testing.T) {
+	assert.Equal(t, chat.ChatTypeGroup, chatTypeOf(&client.Chat{Type: &client.ChatTypeBasicGroup{}}))
+	assert.Equal(t, chat.ChatTypeSupergroup, chatTypeOf(&client.Chat{Type: &client.ChatTypeSupergroup{}}))
+	assert.Equal(t, chat.ChatTypeChannel, chatTypeOf(&client.Chat{Type: &client.ChatTypeSupergroup{IsChannel: true}}))
+	assert.Empty(t, chatTypeOf(&client.Chat{Type: &client.ChatTypePrivate{}}))
+}
@@ -0,0 +1,49 @@
+package teleapp
+
+import (
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// OptimizeStorage 按配置清理 TDLib 文件缓存，并记录数据库大小。
+// TDLib 的 optimizeStorage 只清理文件，消息和用户、群组信息数据库（UseMessageDatabase、UseChatInfoDatabase）
+// 无法按大小或时间裁剪，只能通过关闭对应的数据库开关控制体积
+func (app *TeleApp) OptimizeStorage(cfg *config.TDLibStorage) error {
+	stats, err := app.tdClient.OptimizeStorage(optimizeStorageRequest(cfg))
+	if err != nil {
+		return err
+	}
+
+	metrics.Set("tdlib_storage_files_bytes", float64(stats.Size))
+	metrics.Set("tdlib_storage_files_count", float64(stats.Count))
+	logger.Infof("[TeleApp] TDLib 存储清理完成, 剩余文件: %d 个, %.2f MB", stats.Count, float64(stats.Size)/1024/1024)
+
+	fast, err := app.tdClient.GetStorageStatisticsFast()
+	if err != nil {
+		logger.Warnf("[TeleApp] 获取 TDLib 数据库大小失败: %v", err)
+		return nil
+	}
+	metrics.Set("tdlib_storage_database_bytes", float64(fast.DatabaseSize))
+	logger.Infof("[TeleApp] TDLib 数据库大小: %.2f MB（不受清理影响）", float64(fast.DatabaseSize)/1024/1024)
+	return nil
+}
+
+// optimizeStorageRequest 按配置构造清理请求，未配置的限制使用 TDLib 默认值（-1）
+func optimizeStorageRequest(cfg *config.TDLibStorage) *client.OptimizeStorageRequest {
+	req := &client.OptimizeStorageRequest{
+		Size:          -1,
+		Ttl:           -1,
+		Count:         -1,
+		ImmunityDelay: -1,
+	}
+	if cfg.MaxSizeMB > 0 {
+		req.Size = cfg.MaxSizeMB * 1024 * 1024
+	}
+	if cfg.TTLDays > 0 {
+		req.Ttl = int32(cfg.TTLDays * 24 * 60 * 60)
+	}
+	return req
+}
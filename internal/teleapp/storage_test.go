@@ -0,0 +1,20 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptimizeStorageRequest(t *testing.T) {
+	req := optimizeStorageRequest(&config.TDLibStorage{MaxSizeMB: 1024, TTLDays: 7})
+	assert.Equal(t, int64(1024*1024*1024), req.Size)
+	assert.Equal(t, int32(7*24*60*60), req.Ttl)
+	assert.Equal(t, int32(-1), req.Count, "文件数不限制，使用 TDLib 默认值")
+	assert.Equal(t, int32(-1), req.ImmunityDelay)
+
+	req = optimizeStorageRequest(&config.TDLibStorage{})
+	assert.Equal(t, int64(-1), req.Size, "未配置时使用 TDLib 默认值")
+	assert.Equal(t, int32(-1), req.Ttl)
+}
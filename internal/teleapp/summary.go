@@ -0,0 +1,151 @@
+package teleapp
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// parseSummaryRange 返回的错误，summarize 将其转换为对应的本地化提示，不直接回复给用户
+var (
+	// errSummaryUsage /summary 参数格式错误，回复 display.TextSummaryUsage
+	errSummaryUsage = errors.New("参数格式错误")
+	// errSummaryTooLong /summary 区间超过天数上限，回复 display.TextSummaryTooLong
+	errSummaryTooLong = errors.New("区间超过天数上限")
+)
+
+// RangeSummarizer 按需总结群组一段时间内的消息，返回 HTML 格式的总结内容，没有可总结的消息时返回空字符串
+type RangeSummarizer interface {
+	SummarizeRange(ctx context.Context, chatID int64, startTime, endTime time.Time) (string, error)
+}
+
+// SetRangeSummarizer 设置 /summary 使用的总结器并注册管理员私聊命令 /summary，未设置时群聊中的 /summary 不处理
+func (app *TeleApp) SetRangeSummarizer(summarizer RangeSummarizer) {
+	app.rangeSummarizer = summarizer
+	app.RegisterCommand("summary", app.summaryCommand)
+}
+
+// parseSummaryRange 解析 /summary 参数："today"（今天零点至当前）、"yesterday"、"2025-02-10"（当天），
+// 或 "2025-02-10 2025-02-12"（包含首尾两天），日期按 loc 解释；区间超过 maxDays 天时返回 errSummaryTooLong，结束时间不晚于 now
+func parseSummaryRange(args []string, now time.Time, loc *time.Location, maxDays int) (timeRange, error) {
+	y, m, d := now.In(loc).Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	var first, last time.Time
+	switch {
+	case len(args) == 1 && strings.EqualFold(args[0], "today"):
+		first, last = today, today
+	case len(args) == 1 && strings.EqualFold(args[0], "yesterday"):
+		first, last = today.AddDate(0, 0, -1), today.AddDate(0, 0, -1)
+	case len(args) == 1 || len(args) == 2:
+		var err error
+		if first, err = time.ParseInLocation("2006-01-02", args[0], loc); err != nil {
+			return timeRange{}, errSummaryUsage
+		}
+		last = first
+		if len(args) == 2 {
+			if last, err = time.ParseInLocation("2006-01-02", args[1], loc); err != nil {
+				return timeRange{}, errSummaryUsage
+			}
+		}
+	default:
+		return timeRange{}, errSummaryUsage
+	}
+
+	end := last.AddDate(0, 0, 1)
+	if last.Before(first) || first.After(now) {
+		return timeRange{}, errSummaryUsage
+	}
+	if maxDays > 0 && first.AddDate(0, 0, maxDays).Before(end) {
+		return timeRange{}, errSummaryTooLong
+	}
+	if end.After(now) {
+		end = now
+	}
+	return timeRange{start: first, end: end}, nil
+}
+
+// allowSummary 检查群聊的 /summary 冷却时间，允许时记录本次时间
+func (app *TeleApp) allowSummary(chatID int64, cooldown time.Duration) (time.Duration, bool) {
+	app.summaryMu.Lock()
+	defer app.summaryMu.Unlock()
+	if wait := cooldown - time.Since(app.summaryLast[chatID]); wait > 0 {
+		return wait, false
+	}
+	app.summaryLast[chatID] = time.Now()
+	return 0, true
+}
+
+// summarize 按需总结群聊指定日期的消息：生成成功时由 reply 以 HTML 回复总结内容并返回空字符串，
+// 否则返回需回复的提示文本；cooldown 为 0 时不限制频率
+func (app *TeleApp) summarize(ctx context.Context, chatID int64, args []string, cooldown time.Duration, reply func(html string) error) (string, error) {
+	formatter := app.formatter.ForChat(chatID)
+	if app.isOptedOut(chatID) {
+		return formatter.T(display.TextSummaryOptedOut), nil
+	}
+	loc, err := app.svcCtx.Config.Summary.Display.Location()
+	if err != nil {
+		return "", err
+	}
+	maxDays := app.svcCtx.Config.OnDemand.MaxDays
+	r, err := parseSummaryRange(args, time.Now(), loc, maxDays)
+	if errors.Is(err, errSummaryTooLong) {
+		return formatter.Tf(display.TextSummaryTooLong, maxDays), nil
+	}
+	if err != nil {
+		return formatter.T(display.TextSummaryUsage), nil
+	}
+	if wait, ok := app.allowSummary(chatID, cooldown); !ok {
+		return formatter.Tf(display.TextSummaryCooldown, int(wait.Seconds())+1), nil
+	}
+
+	summary, err := app.rangeSummarizer.SummarizeRange(ctx, chatID, r.start, r.end)
+	if err != nil {
+		return "", err
+	}
+	if summary == "" {
+		return formatter.T(display.TextSummaryEmpty), nil
+	}
+	logger.Infof("[TeleApp] 群聊 %d /summary 总结区间 %s ~ %s", chatID, r.start.In(loc).Format("2006-01-02 15:04"), r.end.In(loc).Format("2006-01-02 15:04"))
+	return "", reply(summary)
+}
+
+// summaryCommand 管理员私聊命令 /summary <群组ID> <日期>：总结指定群组，结果回复到私聊，不受冷却限制
+func (app *TeleApp) summaryCommand(ctx context.Context, cmd *Command) (string, error) {
+	if len(cmd.Args) < 2 {
+		return app.formatter.T(display.TextSummaryAdminUsage), nil
+	}
+	chatID, err := strconv.ParseInt(cmd.Args[0], 10, 64)
+	if err != nil {
+		return app.formatter.Tf(display.TextInvalidChatID, cmd.Args[0]), nil
+	}
+	return app.summarize(ctx, chatID, cmd.Args[1:], 0, func(html string) error {
+		return app.replyHTML(cmd.ChatID, cmd.ThreadID, cmd.MessageID, html)
+	})
+}
+
+// replyHTML 以 HTML 格式回复指定消息，超长时拆分为多段依次回复，threadID 不为 0 时回复到该论坛话题
+func (app *TeleApp) replyHTML(chatID, threadID, replyToMessageID int64, html string) error {
+	for _, part := range notify.SplitNumbered(html) {
+		_, err := app.tdClient.SendMessage(&client.SendMessageRequest{
+			ChatId:          chatID,
+			MessageThreadId: threadID,
+			ReplyTo:         &client.InputMessageReplyToMessage{MessageId: replyToMessageID},
+			InputMessageContent: &client.InputMessageText{
+				Text: notify.ParseHTMLText(part),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package teleapp
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// TDLib 日志行格式: [ 2][t 1][1700000000.123456789][Session.cpp:123][#1][!Session]	message
+var tdlibLogLinePattern = regexp.MustCompile(`^\[\s*(\d+)\]\[t\s*\d+\]\[[^\]]*\](?:\[[^\]]*\])*\s*(.*)$`)
+
+const tdlibLogMaxFileSize = 10 * 1024 * 1024
+
+// setupTdlibLog 将 TDLib 内部日志写入文件，并转发到 logger
+func setupTdlibLog(dataDir string) {
+	path := filepath.Join(dataDir, ".tdlib", "tdlib.log")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		logger.Warnf("[TeleApp] 创建 TDLib 日志目录失败: %v", err)
+		return
+	}
+
+	_, err := client.SetLogStream(&client.SetLogStreamRequest{
+		LogStream: &client.LogStreamFile{
+			Path:        path,
+			MaxFileSize: tdlibLogMaxFileSize,
+		},
+	})
+	if err != nil {
+		logger.Warnf("[TeleApp] 设置 TDLib 日志输出失败: %v", err)
+		return
+	}
+
+	go tailTdlibLog(path)
+}
+
+// parseTdlibLogLine 解析 TDLib 日志行，返回日志级别和消息内容
+func parseTdlibLogLine(line string) (int, string, bool) {
+	matches := tdlibLogLinePattern.FindStringSubmatch(line)
+	if matches == nil {
+		return 0, "", false
+	}
+	level, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, "", false
+	}
+	return level, strings.TrimSpace(matches[2]), true
+}
+
+// logTdlib 按 TDLib 日志级别写入 logger：0-1 错误，2 警告，3 信息，其余调试
+func logTdlib(level int, message string) {
+	switch {
+	case level <= 1:
+		logger.Errorf("[TDLib] %s", message)
+	case level == 2:
+		logger.Warnf("[TDLib] %s", message)
+	case level == 3:
+		logger.Infof("[TDLib] %s", message)
+	default:
+		logger.Debugf("[TDLib] %s", message)
+	}
+}
+
+// tailTdlibLog 持续读取 TDLib 日志文件的新内容，文件轮转后重新打开
+func tailTdlibLog(path string) {
+	var (
+		file   *os.File
+		reader *bufio.Reader
+		offset int64
+		level  = 3
+	)
+
+	for {
+		if file == nil {
+			f, err := os.Open(path)
+			if err != nil {
+				time.Sleep(time.Second)
+				continue
+			}
+			file, reader, offset = f, bufio.NewReader(f), 0
+		}
+
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 && strings.HasSuffix(line, "\n") {
+			offset += int64(len(line))
+			line = strings.TrimRight(line, "\r\n")
+			if lineLevel, message, ok := parseTdlibLogLine(line); ok {
+				level = lineLevel
+				logTdlib(level, message)
+			} else if strings.TrimSpace(line) != "" {
+				// 多行日志的后续行沿用上一行的级别
+				logTdlib(level, line)
+			}
+			continue
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			logger.Warnf("[TeleApp] 读取 TDLib 日志失败: %v", err)
+		}
+
+		// 未读完的半行退回，等待写入完成后再读取
+		if len(line) > 0 {
+			if _, err := file.Seek(offset, io.SeekStart); err == nil {
+				reader.Reset(file)
+			}
+		}
+		time.Sleep(500 * time.Millisecond)
+
+		// TDLib 轮转日志时会将旧文件重命名，此时重新打开新文件
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		current, err := file.Stat()
+		if err != nil || !os.SameFile(info, current) || info.Size() < offset {
+			file.Close()
+			file = nil
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTdlibLogLine(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantOk      bool
+		wantLevel   int
+		wantMessage string
+	}{
+		{"警告日志", "[ 2][t 1][1700000000.123456789][Session.cpp:123][#1][!Session]\tConnection failed", true, 2, "Connection failed"},
+		{"错误日志", "[ 1][t 0][1700000000.1][Td.cpp:10]\tFailed to open database", true, 1, "Failed to open database"},
+		{"两位数级别", "[10][t 3][1700000000.1][Foo.cpp:1][&tag]\tverbose", true, 10, "verbose"},
+		{"续行", "  at frame 2", false, 0, ""},
+		{"空行", "", false, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, message, ok := parseTdlibLogLine(tt.line)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantLevel, level)
+				assert.Equal(t, tt.wantMessage, message)
+			}
+		})
+	}
+}
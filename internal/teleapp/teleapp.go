@@ -4,10 +4,16 @@ import (
 	"context"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/lang"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
 	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
 	"github.com/fachebot/talk-trace-bot/internal/svc"
 
 	"github.com/zelenin/go-tdlib/client"
@@ -15,19 +21,80 @@ import (
 
 type TeleApp struct {
 	svcCtx     *svc.ServiceContext
+	formatter  *display.Formatter // 群聊命令回复的本地化文本
 	user       *client.User
 	tdClient   *client.Client
-	listener   *client.Listener
+	listener   updateSource
+	loop       *updateLoop         // 当前的更新循环
+	restarting bool                // 正在等待旧的更新循环退出后重启
+	listenerMu sync.Mutex          // 保护 listener、loop 和 restarting
+	listen     func() updateSource // 创建监听器，为 nil 时使用 tdClient.GetListener
 	parameters *client.SetTdlibParametersRequest
+	login      *config.TelegramLogin
 	usersMu    sync.RWMutex
 	usersCache map[int64]*client.User
 	chatsMu    sync.RWMutex
 	chatsCache map[int64]*client.Chat
+	commandsMu sync.RWMutex
+	commands   map[string]CommandHandler
 	ctx        context.Context
 	cancel     context.CancelFunc
 	ctxMu      sync.Mutex
+
+	lastUpdateAt atomic.Int64 // 最近一次收到更新的时间（UnixNano），供 watchdog 使用
+
+	sends *notify.SendTracker // 消息发送结果，供分段发送等待确认
+
+	filters *chatFilters // 已退出数据收集的群聊及排除区间，多账号时各账号共享
+
+	reactionsMu sync.Mutex
+	reactions   map[messageKey]int64 // 最近更新过的消息回应总数，过滤回应数未变化的互动更新（如浏览量变化）
+
+	tldrMu   sync.Mutex
+	tldrLast map[int64]time.Time // 各群聊最近一次 /tldr 的时间，用于冷却
+
+	rangeSummarizer RangeSummarizer // /summary 使用的总结器，为 nil 时不处理 /summary
+	summaryMu       sync.Mutex
+	summaryLast     map[int64]time.Time // 各群聊最近一次 /summary 的时间，用于冷却
+
+	heartbeatMu    sync.Mutex
+	heartbeatMsgID int64  // 收藏夹中心跳消息的 ID
+	heartbeatFile  string // 心跳消息 ID 的持久化文件
+	loginCodeFile  string // 未配置 TelegramLogin.CodeFile 时使用的验证码文件
+
+	accounts  *Accounts   // 多账号时各群组由哪个账号负责，为 nil 表示单账号
+	loggedOut atomic.Bool // 登录状态不再是 Ready（如会话被终止），不再负责任何群组
+
+	connMu              sync.Mutex
+	connState           string        // 连接中断时 TDLib 的连接状态，如 connectionStateConnecting
+	disconnectedAt      time.Time     // 连接中断的开始时间，为零表示已连接
+	disconnectAlerted   bool          // 本次中断是否已告警
+	disconnectThreshold time.Duration // 连接中断超过该时长时告警，恢复后补录中断期间的消息（Alert.DisconnectThreshold），为 0 表示不告警
+	alerter             Alerter
+}
+
+// chatFilters 群聊的数据收集状态：已退出收集的群聊及排除区间。多账号时由各账号共享，
+// 群组改由其他账号负责后，此前的退出或排除仍然生效
+type chatFilters struct {
+	optedOutMu sync.RWMutex
+	optedOut   map[int64]bool // 已退出数据收集的群聊，不保存其消息
+
+	blackoutsMu       sync.RWMutex
+	blackouts         map[int64][]timeRange // 群聊的排除区间（/redact），区间内的消息不保存
+	blackoutRetention time.Duration         // 排除区间结束后仍保留的时长（消息保留期），之后从缓存中移除，为 0 表示不移除
 }
 
+func newChatFilters(blackoutRetention time.Duration) *chatFilters {
+	return &chatFilters{
+		optedOut:          make(map[int64]bool),
+		blackouts:         make(map[int64][]timeRange),
+		blackoutRetention: blackoutRetention,
+	}
+}
+
+// tdlibLogOnce TDLib 日志输出为进程级设置，多账号时只写入首个账号的数据目录
+var tdlibLogOnce sync.Once
+
 func NewApp(svcCtx *svc.ServiceContext, apiId int32, apiHash, dataDir string) *TeleApp {
 	_, err := client.SetLogVerbosityLevel(&client.SetLogVerbosityLevelRequest{
 		NewVerbosityLevel: 1,
@@ -35,14 +102,16 @@ func NewApp(svcCtx *svc.ServiceContext, apiId int32, apiHash, dataDir string) *T
 	if err != nil {
 		logger.Fatalf("[TeleApp] 设置日志级别错误, %s", err)
 	}
+	tdlibLogOnce.Do(func() { setupTdlibLog(dataDir) })
 
+	useFileDatabase, useChatInfoDatabase, useMessageDatabase := svcCtx.Config.TDLibStorage.Databases()
 	parameters := &client.SetTdlibParametersRequest{
 		UseTestDc:           false,
 		DatabaseDirectory:   filepath.Join(dataDir, ".tdlib", "database"),
 		FilesDirectory:      filepath.Join(dataDir, ".tdlib", "files"),
-		UseFileDatabase:     true,
-		UseChatInfoDatabase: true,
-		UseMessageDatabase:  true,
+		UseFileDatabase:     useFileDatabase,
+		UseChatInfoDatabase: useChatInfoDatabase,
+		UseMessageDatabase:  useMessageDatabase,
 		UseSecretChats:      false,
 		ApiId:               apiId,
 		ApiHash:             apiHash,
@@ -57,6 +126,19 @@ func NewApp(svcCtx *svc.ServiceContext, apiId int32, apiHash, dataDir string) *T
 		parameters: parameters,
 		chatsCache: make(map[int64]*client.Chat),
 		usersCache: make(map[int64]*client.User),
+		commands:   make(map[string]CommandHandler),
+		sends:      notify.NewSendTracker(),
+		filters:    newChatFilters(time.Duration(svcCtx.Config.Summary.RetentionDays+1) * 24 * time.Hour),
+		reactions:  make(map[messageKey]int64),
+		tldrLast:   make(map[int64]time.Time),
+
+		summaryLast: make(map[int64]time.Time),
+
+		heartbeatFile: filepath.Join(dataDir, ".tdlib", "heartbeat_message_id"),
+		loginCodeFile: filepath.Join(dataDir, "login_code"),
+		login:         &svcCtx.Config.TelegramApp.Login,
+
+		disconnectThreshold: time.Duration(*svcCtx.Config.Alert.DisconnectThreshold) * time.Minute,
 	}
 	return app
 }
@@ -66,10 +148,13 @@ func (app *TeleApp) Login(options ...client.Option) (*client.User, error) {
 		return app.user, nil
 	}
 
-	authorizer := client.ClientAuthorizer(app.parameters)
-	go client.CliInteractor(authorizer)
+	formatter, err := display.NewFormatter(&app.svcCtx.Config.Summary.Display)
+	if err != nil {
+		return nil, err
+	}
+	app.formatter = formatter
 
-	tdlibClient, err := client.NewClient(authorizer, options...)
+	tdlibClient, err := client.NewClient(app.authorizer(), options...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,33 +166,50 @@ func (app *TeleApp) Login(options ...client.Option) (*client.User, error) {
 
 	app.user = me
 	app.tdClient = tdlibClient
-
-	chats, err := app.tdClient.GetChats(&client.GetChatsRequest{Limit: 100})
-	if err != nil {
-		logger.Warnf("[TeleApp] 获取聊天列表失败: %v", err)
-	} else {
-		for _, chatId := range chats.ChatIds {
-			chat, err := app.tdClient.GetChat(&client.GetChatRequest{ChatId: chatId})
-			if err != nil {
-				logger.Warnf("[TeleApp] 获取聊天信息失败, id: %d, %v", chatId, err)
-				continue
-			}
-			logger.Infof("[TeleApp] 聊天列表: %s[%d]", chat.Title, chat.Id)
-		}
-	}
-
-	listener := tdlibClient.GetListener()
-	app.listener = listener
+	app.loadChatList()
 
 	app.ctxMu.Lock()
 	app.ctx, app.cancel = context.WithCancel(context.Background())
 	app.ctxMu.Unlock()
 
-	go app.getUpdates(listener)
+	app.loadOptedOut(app.ctx)
+	app.loadBlackouts(app.ctx)
+	app.touchUpdate()
+	app.listenerMu.Lock()
+	app.listener = tdListener{tdlibClient.GetListener()}
+	app.startLoop()
+	app.listenerMu.Unlock()
+
+	if timeout := app.svcCtx.Config.TelegramApp.WatchdogTimeout; timeout > 0 {
+		go app.watchdog(time.Duration(timeout) * time.Second)
+	}
+	if app.disconnectThreshold > 0 {
+		go app.monitorConnection(app.ctx)
+	}
 
 	return me, nil
 }
 
+// loadChatList 加载主聊天列表并更新聊天信息缓存，登录及恢复连接后调用
+func (app *TeleApp) loadChatList() {
+	chats, err := app.tdClient.GetChats(&client.GetChatsRequest{Limit: 100})
+	if err != nil {
+		logger.Warnf("[TeleApp] 获取聊天列表失败: %v", err)
+		return
+	}
+	for _, chatId := range chats.ChatIds {
+		chat, err := app.tdClient.GetChat(&client.GetChatRequest{ChatId: chatId})
+		if err != nil {
+			logger.Warnf("[TeleApp] 获取聊天信息失败, id: %d, %v", chatId, err)
+			continue
+		}
+		app.chatsMu.Lock()
+		app.chatsCache[chatId] = chat
+		app.chatsMu.Unlock()
+		logger.Infof("[TeleApp] 聊天列表: %s[%d]", chat.Title, chat.Id)
+	}
+}
+
 func (app *TeleApp) Client() *client.Client {
 	return app.tdClient
 }
@@ -123,9 +225,11 @@ func (app *TeleApp) Close() error {
 	}
 	app.ctxMu.Unlock()
 
-	if app.listener != nil {
+	app.listenerMu.Lock()
+	if app.listener != nil && app.listener.IsActive() {
 		app.listener.Close()
 	}
+	app.listenerMu.Unlock()
 
 	_, err := app.tdClient.Close()
 	return err
@@ -136,6 +240,7 @@ func (app *TeleApp) getChat(chatId int64) (*client.Chat, error) {
 	app.chatsMu.RLock()
 	chat, ok := app.chatsCache[chatId]
 	app.chatsMu.RUnlock()
+	recordCacheLookup("chat", ok)
 	if ok {
 		return chat, nil
 	}
@@ -149,7 +254,10 @@ func (app *TeleApp) getChat(chatId int64) (*client.Chat, error) {
 	// 写锁更新缓存
 	app.chatsMu.Lock()
 	app.chatsCache[chatId] = chat
+	metrics.Set(metrics.Name("teleapp_cache_entries", "cache", "chat"), float64(len(app.chatsCache)))
 	app.chatsMu.Unlock()
+
+	app.saveChatTitle(chat)
 	return chat, nil
 }
 
@@ -158,6 +266,7 @@ func (app *TeleApp) getUser(userId int64) (*client.User, error) {
 	app.usersMu.RLock()
 	user, ok := app.usersCache[userId]
 	app.usersMu.RUnlock()
+	recordCacheLookup("user", ok)
 	if ok {
 		return user, nil
 	}
@@ -171,36 +280,105 @@ func (app *TeleApp) getUser(userId int64) (*client.User, error) {
 	// 写锁更新缓存
 	app.usersMu.Lock()
 	app.usersCache[userId] = user
+	metrics.Set(metrics.Name("teleapp_cache_entries", "cache", "user"), float64(len(app.usersCache)))
 	app.usersMu.Unlock()
+
+	app.saveUser(user)
 	return user, nil
 }
 
-func (app *TeleApp) getUpdates(listener *client.Listener) {
+// recordCacheLookup 记录用户、群聊缓存的命中情况，导出为 teleapp_cache_requests_total{cache, result}
+func recordCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.Inc(metrics.Name("teleapp_cache_requests_total", "cache", cache, "result", result))
+}
+
+// getUpdates 从 loop 的监听器读取并处理更新，直到 ctx 结束、loop.stop 关闭或监听器关闭，退出时关闭 loop.done
+func (app *TeleApp) getUpdates(loop *updateLoop) {
+	defer close(loop.done)
 	app.ctxMu.Lock()
 	ctx := app.ctx
 	app.ctxMu.Unlock()
 
-	for listener.IsActive() {
+	updates := loop.source.Chan()
+	for {
 		select {
 		case <-ctx.Done():
 			logger.Infof("[TeleApp] 更新循环已取消，退出")
 			return
-		case update := <-listener.Updates:
+		case <-loop.stop:
+			return
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			app.touchUpdate()
+			switch u := update.(type) {
+			case *client.UpdateChatTitle:
+				app.updateChatTitle(u)
+				continue
+			case *client.UpdateUser:
+				app.updateUser(u)
+				continue
+			case *client.UpdateSupergroup:
+				app.onMembership(supergroupChatID(u.Supergroup.Id), u.Supergroup.Status)
+				continue
+			case *client.UpdateBasicGroup:
+				app.onMembership(-u.BasicGroup.Id, u.BasicGroup.Status)
+				continue
+			case *client.UpdateMessageSendSucceeded:
+				app.onMessageSendSucceeded(u)
+				app.updateMessageID(ctx, u)
+				app.sends.Succeeded(u.Message.ChatId, u.OldMessageId, u.Message.Id)
+				continue
+			case *client.UpdateMessageSendFailed:
+				app.sends.Failed(u.Message.ChatId, u.OldMessageId, notify.SendError(u.Error))
+				continue
+			case *client.UpdateMessageEdited:
+				app.onMessageEdited(ctx, u)
+				continue
+			case *client.UpdateDeleteMessages:
+				app.onMessagesDeleted(ctx, u)
+				continue
+			case *client.UpdateMessageInteractionInfo:
+				app.onMessageInteractionInfo(ctx, u)
+				continue
+			case *client.UpdateMessageContent:
+				app.onMessageContent(ctx, u)
+				continue
+			case *client.UpdateConnectionState:
+				app.onConnectionState(ctx, u)
+				continue
+			case *client.UpdateAuthorizationState:
+				app.onAuthorizationState(ctx, u)
+				continue
+			}
 			if update.GetType() != "updateNewMessage" {
 				continue
 			}
 
-			// 仅处理文本消息
+			// 仅处理 Ingest.ContentTypes 中配置的内容类型
 			updateNewMessage := update.(*client.UpdateNewMessage)
 			message := updateNewMessage.Message
-			if message.Content.MessageContentType() != "messageText" {
+			if joinedChat(message, app.user.Id) {
+				go app.onboardChat(ctx, message.ChatId)
 				continue
 			}
-
-			text := message.Content.(*client.MessageText)
-			if text.Text == nil || text.Text.Text == "" {
-				continue
+			contentType, text := ingestContent(message.Content)
+			if text == "" || !app.svcCtx.Config.Ingest.Accepts(contentType) {
+				// 不保存内容的贴纸、动图等只记录为事件，用于统计成员活跃度
+				if !app.svcCtx.Config.Ingest.RecordEvents {
+					continue
+				}
+				if contentType, text = eventType(message.Content), ""; contentType == "" {
+					continue
+				}
 			}
+			// 命令仅来自文本消息
+			isText := contentType == "text"
 
 			// 获取来源Chat信息
 			chat, err := app.getChat(message.ChatId)
@@ -209,57 +387,103 @@ func (app *TeleApp) getUpdates(listener *client.Listener) {
 				continue
 			}
 
-			logger.Debugf("[TeleApp] 接收消息: %s[%d] -> %s(%d)", chat.Title, chat.Id, text.Text.Text, message.Id)
+			logger.Debugf("[TeleApp] 接收消息: %s[%d] -> %s(%d)", chat.Title, chat.Id, text, message.Id)
+
+			// 私聊中的管理员命令
+			if isText && chat.Type.ChatTypeType() == client.TypeChatTypePrivate {
+				app.handleCommand(ctx, message, text)
+			}
 
 			// 过滤私聊和密聊
 			switch chat.Type.ChatTypeType() {
 			case client.TypeChatTypePrivate, client.TypeChatTypeSecret:
 				continue
 			}
-
-			// 获取发送者信息
-			senderID := int64(0)
-			var senderName string
-			var senderUsername *string
-
-			if message.SenderId != nil {
-				switch sender := message.SenderId.(type) {
-				case *client.MessageSenderUser:
-					senderID = sender.UserId
-					user, err := app.getUser(sender.UserId)
-					if err != nil {
-						logger.Warnf("[TeleApp] 获取用户信息失败, id: %d, %v", sender.UserId, err)
-						continue
-					}
-					senderName = user.FirstName
-					if user.LastName != "" {
-						senderName += " " + user.LastName
-					}
-					if user.Usernames != nil && len(user.Usernames.ActiveUsernames) > 0 {
-						username := "@" + user.Usernames.ActiveUsernames[0]
-						senderUsername = &username
-					}
-				}
-			}
-
-			// 保存消息到数据库
-			msgData := &model.MessageData{
-				MessageID:      message.Id,
-				ChatID:         message.ChatId,
-				SenderID:       senderID,
-				SenderName:     senderName,
-				SenderUsername: senderUsername,
-				Text:           text.Text.Text,
-				SentAt:         time.Unix(int64(message.Date), 0),
+			// 多账号同在一个群组时只由负责的账号处理
+			if !app.owns(chat.Id) {
+				continue
 			}
 
-			_, err = app.svcCtx.MessageModel.Create(ctx, msgData)
-			if err != nil {
-				logger.Errorf("[TeleApp] 保存消息失败, %v", err)
+			// 群管理员的数据收集命令，命令消息本身不保存
+			if isText && app.handleGroupCommand(ctx, message, chat, text) {
 				continue
 			}
+			app.ingestMessage(ctx, message, chat, contentType, text)
+		}
+	}
+}
 
-			logger.Debugf("[TeleApp] 保存消息: %s[%d] -> %s: %s", chat.Title, chat.Id, senderName, text.Text.Text)
+// messageSender 获取消息发送者的ID、名称、用户名和类型（只填充 MessageData 的发送者字段），获取失败时记录日志并返回 false
+func (app *TeleApp) messageSender(message *client.Message) (model.MessageData, bool) {
+	var data model.MessageData
+	switch sender := message.SenderId.(type) {
+	case *client.MessageSenderUser:
+		data.SenderID, data.SenderType = sender.UserId, model.SenderTypeUser
+		user, err := app.getUser(sender.UserId)
+		if err != nil {
+			logger.Warnf("[TeleApp] 获取用户信息失败, id: %d, %v", sender.UserId, err)
+			return data, false
+		}
+		var username string
+		data.SenderName, username = userNames(user)
+		if username != "" {
+			data.SenderUsername = &username
+		}
+	case *client.MessageSenderChat:
+		// 匿名管理员（发送者为群组本身）、关联频道等以聊天身份发送的消息，以聊天名称作为发送者名
+		data.SenderID, data.SenderType = sender.ChatId, model.SenderTypeChat
+		senderChat, err := app.getChat(sender.ChatId)
+		if err != nil {
+			logger.Warnf("[TeleApp] 获取发送者聊天信息失败, id: %d, %v", sender.ChatId, err)
+			return data, false
 		}
+		data.SenderName = senderChatName(senderChat, message.AuthorSignature)
 	}
+	return data, true
+}
+
+// ingestMessage 将群聊消息写入入库队列，返回是否已入队：未允许收集（Summary.IncludeChatIds / ExcludeChatIds）
+// 或已退出数据收集的群聊、排除区间内的消息不保存。
+// contentType 和 text 为 ingestContent 的返回值
+func (app *TeleApp) ingestMessage(ctx context.Context, message *client.Message, chat *client.Chat, contentType, text string) bool {
+	if !app.svcCtx.Config.Summary.AllowsChat(chat.Id) || app.isOptedOut(chat.Id) || app.inBlackout(chat.Id, time.Unix(int64(message.Date), 0)) {
+		return false
+	}
+
+	sender, ok := app.messageSender(message)
+	if !ok {
+		return false
+	}
+
+	msgData := &model.MessageData{
+		MessageID:        message.Id,
+		ServerMessageID:  serverMessageID(message.Id),
+		ChatID:           message.ChatId,
+		SenderID:         sender.SenderID,
+		SenderName:       sender.SenderName,
+		SenderUsername:   sender.SenderUsername,
+		SenderType:       sender.SenderType,
+		Text:             text,
+		SentAt:           time.Unix(int64(message.Date), 0),
+		Lang:             lang.Detect(text),
+		ContentType:      contentType,
+		MessageThreadID:  forumThreadID(message),
+		ReplyToMessageID: replyParent(message),
+		ReactionCount:    reactionCount(message.InteractionInfo),
+		Poll:             pollData(message.Content),
+		AccountID:        app.user.Id,
+	}
+	// 事件不保存内容，其中的链接同样不保存
+	if text != "" {
+		msgData.Links = messageLinks(message.Content)
+	}
+
+	// 由入库队列写入数据库（含去重），队列已满时短暂等待后丢弃，不阻塞更新循环
+	if err := app.svcCtx.IngestQueue.Enqueue(ctx, msgData); err != nil {
+		logger.Errorf("[TeleApp] 消息入队失败, %v", err)
+		return false
+	}
+
+	logger.Debugf("[TeleApp] 消息入队: %s[%d] -> %s: %s", chat.Title, chat.Id, sender.SenderName, text)
+	return true
 }
@@ -0,0 +1,205 @@
+package teleapp
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+const (
+	tldrMaxDepth  = 50   // 向上查找回复链起点的最大层数
+	tldrMaxScan   = 2000 // 最多向前翻阅的历史消息数
+	tldrMinThread = 3    // 回复链少于该条数时，改为总结被回复消息之后的全部消息
+)
+
+// replyParent 返回消息回复的同一聊天内的消息ID，未回复时返回 0
+func replyParent(m *client.Message) int64 {
+	r, ok := m.ReplyTo.(*client.MessageReplyToMessage)
+	if !ok || (r.ChatId != 0 && r.ChatId != m.ChatId) {
+		return 0
+	}
+	return r.MessageId
+}
+
+// threadMessages 从按时间升序的历史消息中选出以 rootID 为起点的回复树；
+// 回复树过小（讨论未使用回复）时，返回 repliedID 及之后、与被回复消息同一论坛话题的全部消息
+func threadMessages(history []*client.Message, rootID, repliedID int64) []*client.Message {
+	inThread := map[int64]bool{rootID: true}
+	var thread []*client.Message
+	var forumThread int64
+	for _, m := range history {
+		if m.Id == rootID || inThread[replyParent(m)] {
+			inThread[m.Id] = true
+			thread = append(thread, m)
+		}
+		if m.Id == repliedID {
+			forumThread = forumThreadID(m)
+		}
+	}
+	if len(thread) >= tldrMinThread {
+		return thread
+	}
+
+	thread = thread[:0]
+	for _, m := range history {
+		if m.Id >= repliedID && forumThreadID(m) == forumThread {
+			thread = append(thread, m)
+		}
+	}
+	return thread
+}
+
+// threadRoot 沿回复链向上查找讨论起点，链中消息已删除或无法获取时以最后获取到的消息为起点
+func (app *TeleApp) threadRoot(replied *client.Message) *client.Message {
+	root := replied
+	for range tldrMaxDepth {
+		parentID := replyParent(root)
+		if parentID == 0 {
+			break
+		}
+		parent, err := app.tdClient.GetMessage(&client.GetMessageRequest{ChatId: root.ChatId, MessageId: parentID})
+		if err != nil {
+			break
+		}
+		root = parent
+	}
+	return root
+}
+
+// historySince 获取 root 至 before（不含）之间的历史消息，按时间升序
+func (app *TeleApp) historySince(root *client.Message, before int64) ([]*client.Message, error) {
+	var history []*client.Message
+	fromID := before
+	for len(history) < tldrMaxScan {
+		batch, err := app.tdClient.GetChatHistory(&client.GetChatHistoryRequest{
+			ChatId:        root.ChatId,
+			FromMessageId: fromID,
+			Limit:         100,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// 没有更早的消息时结束，避免重复获取同一页
+		prevFromID := fromID
+		reached := len(batch.Messages) == 0
+		for _, m := range batch.Messages {
+			if m.Id <= root.Id {
+				reached = true
+				break
+			}
+			if m.Id < before {
+				history = append(history, m)
+			}
+			fromID = m.Id
+		}
+		if reached || fromID == prevFromID {
+			break
+		}
+	}
+
+	history = append(history, root)
+	slices.SortFunc(history, func(a, b *client.Message) int { return cmp.Compare(a.Id, b.Id) })
+	return history, nil
+}
+
+// threadChatMessages 将讨论中的文本消息转换为总结引擎的输入，跳过命令和排除区间内的消息；
+// 被回复的消息也在输入中时附上回复关系
+func (app *TeleApp) threadChatMessages(thread []*client.Message) []llm.ChatMessage {
+	var msgs []llm.ChatMessage
+	names := make(map[int64]string)
+	for _, m := range thread {
+		content, ok := m.Content.(*client.MessageText)
+		if !ok || content.Text == nil || content.Text.Text == "" || strings.HasPrefix(content.Text.Text, "/") {
+			continue
+		}
+		sentAt := time.Unix(int64(m.Date), 0)
+		if app.inBlackout(m.ChatId, sentAt) {
+			continue
+		}
+
+		msg := llm.ChatMessage{MessageID: m.Id, Text: content.Text.Text, SentAt: sentAt}
+		switch sender := m.SenderId.(type) {
+		case *client.MessageSenderUser:
+			msg.SenderID = sender.UserId
+			if user, err := app.getUser(sender.UserId); err == nil {
+				msg.SenderName, _ = userNames(user)
+			}
+		case *client.MessageSenderChat:
+			msg.SenderID = sender.ChatId
+			if c, err := app.getChat(sender.ChatId); err == nil {
+				msg.SenderName = senderChatName(c, m.AuthorSignature)
+			}
+		}
+		if parentID := replyParent(m); parentID != 0 {
+			if name, ok := names[parentID]; ok {
+				msg.ReplyToID, msg.ReplyToName = parentID, name
+			}
+		}
+		names[m.Id] = msg.SenderName
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// allowTLDR 检查群聊的 /tldr 冷却时间，允许时记录本次时间
+func (app *TeleApp) allowTLDR(chatID int64, cooldown time.Duration) (time.Duration, bool) {
+	app.tldrMu.Lock()
+	defer app.tldrMu.Unlock()
+	if wait := cooldown - time.Since(app.tldrLast[chatID]); wait > 0 {
+		return wait, false
+	}
+	app.tldrLast[chatID] = time.Now()
+	return 0, true
+}
+
+// tldr 总结被回复消息所在的讨论：沿回复链找到起点，收集起点之后回复该讨论的消息
+func (app *TeleApp) tldr(ctx context.Context, message *client.Message, c *client.Chat) (string, error) {
+	formatter := app.formatter.ForChat(c.Id)
+	if app.isOptedOut(c.Id) {
+		return formatter.T(display.TextTLDROptedOut), nil
+	}
+	repliedID := replyParent(message)
+	if repliedID == 0 {
+		return formatter.T(display.TextTLDRUsage), nil
+	}
+	cfg := app.svcCtx.Config.TLDR
+	if wait, ok := app.allowTLDR(c.Id, time.Duration(cfg.CooldownSeconds)*time.Second); !ok {
+		return formatter.Tf(display.TextTLDRCooldown, int(wait.Seconds())+1), nil
+	}
+
+	replied, err := app.tdClient.GetMessage(&client.GetMessageRequest{ChatId: c.Id, MessageId: repliedID})
+	if err != nil {
+		return "", fmt.Errorf("获取被回复的消息失败: %w", err)
+	}
+	root := app.threadRoot(replied)
+	history, err := app.historySince(root, message.Id)
+	if err != nil {
+		return "", fmt.Errorf("获取聊天记录失败: %w", err)
+	}
+
+	msgs := app.threadChatMessages(threadMessages(history, root.Id, replied.Id))
+	if len(msgs) == 0 {
+		return formatter.T(display.TextTLDREmpty), nil
+	}
+	if len(msgs) > cfg.MaxMessages {
+		// 保留讨论起点和最新的消息
+		msgs = append(msgs[:1], msgs[len(msgs)-cfg.MaxMessages+1:]...)
+	}
+
+	summary, err := app.svcCtx.LLMClient.SummarizeThread(ctx, msgs)
+	if err != nil {
+		return "", err
+	}
+	logger.Infof("[TeleApp] 群聊 %s[%d] /tldr 总结 %d 条消息", c.Title, c.Id, len(msgs))
+	return formatter.Tf(display.TextTLDRTitle, len(msgs)) + "\n\n" + summary, nil
+}
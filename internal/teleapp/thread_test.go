@@ -0,0 +1,81 @@
+package teleapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+func threadMessage(id, replyTo int64) *client.Message {
+	m := &client.Message{Id: id, ChatId: -100}
+	if replyTo != 0 {
+		m.ReplyTo = &client.MessageReplyToMessage{ChatId: -100, MessageId: replyTo}
+	}
+	return m
+}
+
+func forumMessage(id, threadID int64) *client.Message {
+	m := threadMessage(id, 0)
+	m.IsTopicMessage = threadID != 0
+	m.MessageThreadId = threadID
+	return m
+}
+
+func messageIDs(msgs []*client.Message) []int64 {
+	ids := make([]int64, len(msgs))
+	for i, m := range msgs {
+		ids[i] = m.Id
+	}
+	return ids
+}
+
+func TestReplyParent(t *testing.T) {
+	assert.Equal(t, int64(0), replyParent(threadMessage(1, 0)), "未回复")
+	assert.Equal(t, int64(5), replyParent(threadMessage(6, 5)))
+
+	other := threadMessage(6, 0)
+	other.ReplyTo = &client.MessageReplyToMessage{ChatId: -200, MessageId: 5}
+	assert.Equal(t, int64(0), replyParent(other), "回复其他聊天的消息")
+}
+
+func TestThreadMessages(t *testing.T) {
+	tests := []struct {
+		name      string
+		history   []*client.Message
+		rootID    int64
+		repliedID int64
+		want      []int64
+	}{
+		{
+			name: "收集回复树，跳过无关消息",
+			history: []*client.Message{
+				threadMessage(10, 0), threadMessage(11, 0), threadMessage(12, 10),
+				threadMessage(13, 11), threadMessage(14, 12), threadMessage(15, 10),
+			},
+			rootID: 10, repliedID: 14,
+			want: []int64{10, 12, 14, 15},
+		},
+		{
+			name: "回复树过小时使用被回复消息之后的上下文",
+			history: []*client.Message{
+				threadMessage(10, 0), threadMessage(11, 0), threadMessage(12, 0), threadMessage(13, 0),
+			},
+			rootID: 11, repliedID: 11,
+			want: []int64{11, 12, 13},
+		},
+		{
+			name: "回复树过小时只使用同一论坛话题的上下文",
+			history: []*client.Message{
+				forumMessage(10, 0), forumMessage(11, 7), forumMessage(12, 8), forumMessage(13, 7),
+			},
+			rootID: 11, repliedID: 11,
+			want: []int64{11, 13},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, messageIDs(threadMessages(tt.history, tt.rootID, tt.repliedID)))
+		})
+	}
+}
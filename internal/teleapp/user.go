@@ -0,0 +1,106 @@
+package teleapp
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// refreshBatchSize 每次刷新最多请求的用户数，避免触发 Telegram 限流
+const refreshBatchSize = 200
+
+// userNames 用户的显示名称及当前用户名（含 "@"，未设置时为空字符串）
+func userNames(user *client.User) (name, username string) {
+	name = user.FirstName
+	if user.LastName != "" {
+		name += " " + user.LastName
+	}
+	if user.Usernames != nil && len(user.Usernames.ActiveUsernames) > 0 {
+		username = "@" + user.Usernames.ActiveUsernames[0]
+	}
+	return name, username
+}
+
+// profilePhotoFileID 用户当前头像（160x160）的远程文件ID，未设置头像或头像不可见时为空字符串
+func profilePhotoFileID(user *client.User) string {
+	if user.ProfilePhoto == nil || user.ProfilePhoto.Small == nil || user.ProfilePhoto.Small.Remote == nil {
+		return ""
+	}
+	return user.ProfilePhoto.Small.Remote.Id
+}
+
+// saveUser 记录用户名称、当前用户名及头像，供每周回顾识别和显示提及使用
+func (app *TeleApp) saveUser(user *client.User) {
+	name, username := userNames(user)
+	if err := app.svcCtx.UserModel.Save(context.Background(), user.Id, name, username, profilePhotoFileID(user)); err != nil {
+		logger.Warnf("[TeleApp] 保存用户信息失败, id: %d, %v", user.Id, err)
+	}
+}
+
+// updateUser 用户信息变更（改名、修改用户名）后同步缓存和数据库
+func (app *TeleApp) updateUser(update *client.UpdateUser) {
+	if update.User == nil {
+		return
+	}
+
+	app.usersMu.Lock()
+	_, ok := app.usersCache[update.User.Id]
+	if ok {
+		app.usersCache[update.User.Id] = update.User
+	}
+	app.usersMu.Unlock()
+
+	// 只同步发过言的用户，未缓存的用户在首次发言时再记录
+	if ok {
+		app.saveUser(update.User)
+	}
+}
+
+// RefreshMetadata 刷新所有已记录群聊的信息及发言用户的用户名，供定期任务调用
+func (app *TeleApp) RefreshMetadata(ctx context.Context, maxAge time.Duration) error {
+	if err := app.RefreshChats(ctx); err != nil {
+		return err
+	}
+	return app.RefreshUsers(ctx, maxAge)
+}
+
+// RefreshUsers 补全和刷新发言用户的用户名：未记录的用户及超过 maxAge 未更新的用户重新从 Telegram 获取
+func (app *TeleApp) RefreshUsers(ctx context.Context, maxAge time.Duration) error {
+	senderIDs, err := app.svcCtx.MessageModel.GetSenderIDs(ctx)
+	if err != nil {
+		return err
+	}
+	stale, err := app.svcCtx.UserModel.StaleIDs(ctx, senderIDs, time.Now().Add(-maxAge), refreshBatchSize)
+	if err != nil {
+		return err
+	}
+
+	refreshed, failed := 0, 0
+	for _, userID := range stale {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		user, err := app.tdClient.GetUser(&client.GetUserRequest{UserId: userID})
+		if err != nil {
+			logger.Warnf("[TeleApp] 刷新用户信息失败, id: %d, %v", userID, err)
+			failed++
+			continue
+		}
+
+		app.usersMu.Lock()
+		app.usersCache[userID] = user
+		app.usersMu.Unlock()
+
+		app.saveUser(user)
+		refreshed++
+	}
+
+	if len(stale) > 0 {
+		logger.Infof("[TeleApp] 用户信息刷新完成: 成功 %d 个，失败 %d 个", refreshed, failed)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package teleapp
+
+import (
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// updateSource 更新循环读取的监听器，默认为 TDLib 的监听器（tdListener）
+type updateSource interface {
+	IsActive() bool
+	Close()
+	Chan() <-chan client.Type
+}
+
+// tdListener TDLib 的监听器
+type tdListener struct {
+	*client.Listener
+}
+
+func (l tdListener) Chan() <-chan client.Type {
+	return l.Updates
+}
+
+// updateLoop 一个更新循环：关闭 stop 后在处理完当前更新时退出，退出后关闭 done
+type updateLoop struct {
+	source updateSource
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// exited 更新循环是否已退出
+func (l *updateLoop) exited() bool {
+	select {
+	case <-l.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// startLoop 在当前监听器上启动新的更新循环，调用方需持有 listenerMu
+func (app *TeleApp) startLoop() {
+	loop := &updateLoop{source: app.listener, stop: make(chan struct{}), done: make(chan struct{})}
+	app.loop = loop
+	go app.getUpdates(loop)
+}
+
+// touchUpdate 记录最近一次收到 TDLib 更新的时间
+func (app *TeleApp) touchUpdate() {
+	now := time.Now()
+	app.lastUpdateAt.Store(now.UnixNano())
+	metrics.Set("teleapp_last_update_timestamp_seconds", float64(now.Unix()))
+}
+
+// watchdog 监控更新循环：超过 timeout 未收到任何更新时探测连接。探测失败或更新循环已退出时重启更新循环；连接正常且监听器仍在运行时只是群组暂无消息，不做处理
+func (app *TeleApp) watchdog(timeout time.Duration) {
+	app.ctxMu.Lock()
+	ctx := app.ctx
+	app.ctxMu.Unlock()
+
+	interval := timeout / 4
+	if interval < 10*time.Second {
+		interval = 10 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		idle := time.Since(time.Unix(0, app.lastUpdateAt.Load()))
+		if idle < timeout {
+			continue
+		}
+		logger.Warnf("[TeleApp] 已 %s 未收到任何更新，检查连接状态", idle.Round(time.Second))
+
+		// 主动请求探测连接；失败时让 TDLib 重新建立网络连接
+		_, probeErr := app.tdClient.GetMe()
+		if probeErr != nil {
+			logger.Warnf("[TeleApp] 连接探测失败，触发重连: %v", probeErr)
+			metrics.Inc("teleapp_watchdog_reconnects_total")
+			if _, err := app.tdClient.SetNetworkType(&client.SetNetworkTypeRequest{Type: &client.NetworkTypeOther{}}); err != nil {
+				logger.Errorf("[TeleApp] 触发重连失败: %v", err)
+			}
+		}
+
+		app.listenerMu.Lock()
+		running := app.loop != nil && !app.loop.exited()
+		app.listenerMu.Unlock()
+		if !needsRestart(probeErr, running) {
+			// 连接正常，只是暂无更新：重新计时，避免每次检查都重复探测
+			logger.Infof("[TeleApp] 连接正常，暂无新的更新")
+			app.touchUpdate()
+			continue
+		}
+		app.restartListener()
+	}
+}
+
+// needsRestart 判断空闲超时后是否需要重启更新循环：连接探测失败，或更新循环已退出
+func needsRestart(probeErr error, loopRunning bool) bool {
+	return probeErr != nil || !loopRunning
+}
+
+// restartListener 停止当前更新循环，待其处理完当前更新退出后在同一监听器上启动新的更新循环，监听器已关闭时新建。
+// 不关闭仍在使用的监听器：TDLib 的接收协程可能正阻塞在向其发送更新，关闭会导致 panic；缓冲中的更新由新的循环继续处理。
+// 旧循环退出前不会启动新循环，也不重复重启，同一时刻只有一个更新循环
+func (app *TeleApp) restartListener() {
+	app.listenerMu.Lock()
+	if app.restarting {
+		app.listenerMu.Unlock()
+		logger.Warnf("[TeleApp] 更新循环仍在处理当前更新，等待其退出后重启")
+		return
+	}
+	app.restarting = true
+	old := app.loop
+	app.listenerMu.Unlock()
+
+	if old != nil {
+		close(old.stop)
+	}
+	go func() {
+		if old != nil {
+			<-old.done
+		}
+		app.ctxMu.Lock()
+		ctx := app.ctx
+		app.ctxMu.Unlock()
+
+		app.listenerMu.Lock()
+		defer app.listenerMu.Unlock()
+		app.restarting = false
+		if ctx.Err() != nil {
+			return
+		}
+		if app.listener == nil || !app.listener.IsActive() {
+			app.listener = app.newListener()
+		}
+		app.startLoop()
+		app.touchUpdate()
+		metrics.Inc("teleapp_listener_restarts_total")
+		logger.Warnf("[TeleApp] 已重启更新循环")
+	}()
+}
+
+// newListener 创建新的 TDLib 监听器
+func (app *TeleApp) newListener() updateSource {
+	if app.listen != nil {
+		return app.listen()
+	}
+	return tdListener{app.tdClient.GetListener()}
+}
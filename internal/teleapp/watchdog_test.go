@@ -0,0 +1,130 @@
+package teleapp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// fakeListener 模拟 TDLib 监听器，记录是否被关闭
+type fakeListener struct {
+	mu      sync.Mutex
+	updates chan client.Type
+	closed  bool
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{updates: make(chan client.Type, 10)}
+}
+
+func (l *fakeListener) IsActive() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return !l.closed
+}
+
+func (l *fakeListener) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.closed = true
+	close(l.updates)
+}
+
+func (l *fakeListener) Chan() <-chan client.Type {
+	return l.updates
+}
+
+func newWatchdogApp(t *testing.T, listener updateSource) *TeleApp {
+	app := &TeleApp{listener: listener}
+	app.ctx, app.cancel = context.WithCancel(context.Background())
+	t.Cleanup(app.cancel)
+	return app
+}
+
+// currentLoop 当前的更新循环，重启尚未完成时返回 nil
+func (app *TeleApp) currentLoop() *updateLoop {
+	app.listenerMu.Lock()
+	defer app.listenerMu.Unlock()
+	if app.restarting {
+		return nil
+	}
+	return app.loop
+}
+
+func TestRestartListener(t *testing.T) {
+	t.Run("在同一监听器上重启且不关闭监听器", func(t *testing.T) {
+		listener := newFakeListener()
+		app := newWatchdogApp(t, listener)
+		app.listenerMu.Lock()
+		app.startLoop()
+		old := app.loop
+		app.listenerMu.Unlock()
+
+		app.restartListener()
+		require.Eventually(t, func() bool {
+			loop := app.currentLoop()
+			return loop != nil && loop != old
+		}, time.Second, time.Millisecond)
+		assert.True(t, old.exited(), "启动新循环前旧循环已退出")
+		assert.True(t, listener.IsActive(), "不关闭仍在使用的监听器")
+		assert.Same(t, listener, app.listener)
+
+		// 新循环继续读取同一监听器的更新
+		listener.updates <- &client.UpdateOption{}
+		require.Eventually(t, func() bool { return len(listener.updates) == 0 }, time.Second, time.Millisecond)
+		assert.False(t, app.currentLoop().exited())
+	})
+
+	t.Run("旧循环退出前不启动新循环也不重复重启", func(t *testing.T) {
+		app := newWatchdogApp(t, newFakeListener())
+		// 模拟卡在处理某条更新的旧循环
+		stuck := &updateLoop{source: app.listener, stop: make(chan struct{}), done: make(chan struct{})}
+		app.loop = stuck
+
+		app.restartListener()
+		app.restartListener()
+		time.Sleep(20 * time.Millisecond)
+		assert.Nil(t, app.currentLoop(), "等待旧循环退出")
+
+		close(stuck.done)
+		require.Eventually(t, func() bool { return app.currentLoop() != nil }, time.Second, time.Millisecond)
+		assert.NotSame(t, stuck, app.loop)
+	})
+
+	t.Run("监听器已关闭时新建", func(t *testing.T) {
+		closed := newFakeListener()
+		app := newWatchdogApp(t, closed)
+		app.listenerMu.Lock()
+		app.startLoop()
+		app.listenerMu.Unlock()
+		closed.Close()
+		require.Eventually(t, func() bool { return app.loop.exited() }, time.Second, time.Millisecond, "监听器关闭后更新循环退出")
+
+		fresh := newFakeListener()
+		app.listen = func() updateSource { return fresh }
+		app.restartListener()
+		require.Eventually(t, func() bool {
+			loop := app.currentLoop()
+			return loop != nil && !loop.exited()
+		}, time.Second, time.Millisecond)
+		assert.Same(t, fresh, app.listener)
+	})
+
+	t.Run("已关闭时不再重启", func(t *testing.T) {
+		app := newWatchdogApp(t, newFakeListener())
+		app.listenerMu.Lock()
+		app.startLoop()
+		old := app.loop
+		app.listenerMu.Unlock()
+		app.cancel()
+
+		app.restartListener()
+		require.Eventually(t, func() bool { return app.currentLoop() != nil }, time.Second, time.Millisecond)
+		assert.Same(t, old, app.loop)
+	})
+}
@@ -0,0 +1,33 @@
+package testkit
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "用实际输出更新 testdata/*.golden 快照文件")
+
+// AssertGolden 将 got 与 testdata/<name>.golden 比较；使用 -update 运行时写入实际输出，
+// 如 go test ./internal/scheduler -update
+func AssertGolden(t testing.TB, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("创建快照目录失败: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("写入快照 %s 失败: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("读取快照 %s 失败（首次运行请加 -update 生成）: %v", path, err)
+	}
+	assert.Equal(t, string(want), got, "输出与快照 %s 不一致，确认变更后用 -update 更新", path)
+}
@@ -0,0 +1,119 @@
+package testkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/alert"
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/model"
+	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/scheduler"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// Harness 组装好的调度器及其依赖：数据保存在内存 SQLite，通知发送到 FakeTelegram，总结由 ScriptedLLM 按脚本返回
+type Harness struct {
+	Client    *ent.Client
+	Config    *config.Summary
+	LLM       *ScriptedLLM
+	Telegram  *FakeTelegram
+	Messages  *model.MessageModel
+	Chats     *model.ChatModel
+	Tasks     *model.TaskModel
+	DailyRuns *model.DailyRunModel
+	RunLogs   *model.RunLogModel
+	Notifier  *notify.Notifier
+	Scheduler *scheduler.Scheduler
+
+	nextMessageID int64
+}
+
+// DefaultConfig 返回适合测试的总结配置：单个每日窗口总结昨天，群聊通知，重试不等待，不降级
+func DefaultConfig() *config.Summary {
+	compressMaxRunes := 800
+	return &config.Summary{
+		Cron:          "0 0 * * *",
+		RangeDays:     1,
+		RetentionDays: 30,
+		NotifyMode:    "group",
+		Retry: config.Retry{
+			LLM:      config.RetryPolicy{Times: 3},
+			Telegram: config.RetryPolicy{Times: 2},
+			DB:       config.RetryPolicy{Times: 3},
+		},
+		TaskTimeout:      60,
+		Engine:           summarizer.DefaultEngine,
+		FallbackEngines:  []string{},
+		PurgeCron:        "30 * * * *",
+		CleanupBatchSize: 1000,
+		CompressMaxRunes: &compressMaxRunes,
+	}
+}
+
+// New 创建测试环境，cfg 为 nil 时使用 DefaultConfig
+func New(t testing.TB, cfg *config.Summary) *Harness {
+	t.Helper()
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+
+	client := NewEntClient(t)
+	h := &Harness{
+		Client:    client,
+		Config:    cfg,
+		LLM:       NewScriptedLLM(),
+		Telegram:  NewFakeTelegram(),
+		Messages:  model.NewMessageModel(client.Message),
+		Chats:     model.NewChatModel(client.Chat),
+		Tasks:     model.NewTaskModel(client.Task),
+		DailyRuns: model.NewDailyRunModel(client.DailyRun),
+		RunLogs:   model.NewRunLogModel(client.RunLog),
+	}
+
+	h.Notifier = notify.NewNotifier(h.Telegram, cfg, nil, model.NewSentPartModel(client.SentPart))
+	h.Scheduler = scheduler.NewScheduler(
+		summarizer.NewSummarizer(h.LLM, h.Messages),
+		h.Notifier,
+		alert.NewAlerter(&config.Alert{}, h.Notifier, nil),
+		h.Messages,
+		h.Chats,
+		h.Tasks,
+		model.NewSummaryRevisionModel(client.SummaryRevision),
+		h.DailyRuns,
+		h.RunLogs,
+		cfg,
+	)
+	return h
+}
+
+// AddMessage 保存一条群聊消息，返回分配的消息ID
+func (h *Harness) AddMessage(t testing.TB, chatID, senderID int64, senderName, text string, sentAt time.Time) int64 {
+	t.Helper()
+	h.nextMessageID++
+	_, err := h.Messages.Create(context.Background(), &model.MessageData{
+		MessageID:       h.nextMessageID,
+		ServerMessageID: h.nextMessageID,
+		ChatID:          chatID,
+		SenderID:        senderID,
+		SenderName:      senderName,
+		Text:            text,
+		SentAt:          sentAt,
+	})
+	if err != nil {
+		t.Fatalf("保存消息失败: %v", err)
+	}
+	return h.nextMessageID
+}
+
+// RunOnce 以单次运行模式执行区间已结束的总结窗口（不补录消息）
+func (h *Harness) RunOnce(ctx context.Context) error {
+	return h.Scheduler.RunOnce(ctx, "", nil)
+}
+
+// Recover 执行一次启动时的恢复流程
+func (h *Harness) Recover(ctx context.Context) error {
+	return h.Scheduler.Recover(ctx)
+}
@@ -0,0 +1,88 @@
+package testkit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// ErrScriptExhausted ScriptedLLM 的脚本已用完
+var ErrScriptExhausted = errors.New("LLM 脚本已用完")
+
+// Reply 脚本中的一次 LLM 返回：Err 非空时返回错误，否则返回 JSON
+type Reply struct {
+	JSON string
+	Err  error
+}
+
+// ScriptedLLM 按脚本依次返回结果的总结引擎（实现 summarizer.SummaryEngine），记录每次收到的消息
+type ScriptedLLM struct {
+	mu      sync.Mutex
+	replies []Reply
+	calls   [][]llm.ChatMessage
+}
+
+func NewScriptedLLM(replies ...Reply) *ScriptedLLM {
+	return &ScriptedLLM{replies: replies}
+}
+
+// Script 在脚本末尾追加返回结果
+func (s *ScriptedLLM) Script(replies ...Reply) {
+	s.mu.Lock()
+	s.replies = append(s.replies, replies...)
+	s.mu.Unlock()
+}
+
+// SummarizeChat 返回脚本中的下一个结果，脚本用完时返回 ErrScriptExhausted
+func (s *ScriptedLLM) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, messages)
+	if len(s.replies) == 0 {
+		return "", ErrScriptExhausted
+	}
+	reply := s.replies[0]
+	s.replies = s.replies[1:]
+	return reply.JSON, reply.Err
+}
+
+// Calls 返回调用次数
+func (s *ScriptedLLM) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.calls)
+}
+
+// Remaining 返回脚本中尚未使用的结果数
+func (s *ScriptedLLM) Remaining() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.replies)
+}
+
+// Topics 返回以 topics 为内容的成功结果
+func Topics(topics ...summarizer.TopicItem) Reply {
+	data, err := json.Marshal(summarizer.SummaryResult{Topics: topics})
+	if err != nil {
+		panic(err)
+	}
+	return Reply{JSON: string(data)}
+}
+
+// Topic 构造只有一个子项的话题
+func Topic(title, senderName, description string, messageIDs ...int64) summarizer.TopicItem {
+	return summarizer.TopicItem{
+		Title: title,
+		Items: []summarizer.TopicSubItem{{SenderName: senderName, Description: description, MessageIDs: messageIDs}},
+	}
+}
+
+// Fail 返回失败结果
+func Fail(err error) Reply {
+	return Reply{Err: err}
+}
@@ -0,0 +1,77 @@
+package testkit
+
+import (
+	"sync"
+
+	"github.com/zelenin/go-tdlib/client"
+)
+
+// SentMessage 模拟发送的一条消息
+type SentMessage struct {
+	ChatID    int64
+	MessageID int64
+	ReplyTo   int64
+	ThreadID  int64 // 发送到的论坛话题，0 表示 General
+	Text      string
+}
+
+// FakeTelegram 模拟的 TDLib 客户端（实现 notify.MessageSender）：记录发送的消息，
+// 可按顺序注入发送失败，用于测试通知重试和恢复时只重试发送的路径
+type FakeTelegram struct {
+	mu     sync.Mutex
+	nextID int64
+	errs   []error
+	sent   []SentMessage
+}
+
+func NewFakeTelegram() *FakeTelegram {
+	return &FakeTelegram{}
+}
+
+// FailNext 之后的发送依次返回 errs 中的错误，用完后恢复正常发送
+func (f *FakeTelegram) FailNext(errs ...error) {
+	f.mu.Lock()
+	f.errs = append(f.errs, errs...)
+	f.mu.Unlock()
+}
+
+// SendMessage 记录发送的消息并返回分配的消息ID；注入了错误时返回错误且不记录
+func (f *FakeTelegram) SendMessage(req *client.SendMessageRequest) (*client.Message, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+
+	f.nextID++
+	msg := SentMessage{ChatID: req.ChatId, MessageID: f.nextID, ThreadID: req.MessageThreadId}
+	if content, ok := req.InputMessageContent.(*client.InputMessageText); ok && content.Text != nil {
+		msg.Text = content.Text.Text
+	}
+	if replyTo, ok := req.ReplyTo.(*client.InputMessageReplyToMessage); ok {
+		msg.ReplyTo = replyTo.MessageId
+	}
+	f.sent = append(f.sent, msg)
+	return &client.Message{Id: msg.MessageID, ChatId: msg.ChatID}, nil
+}
+
+// Sent 返回已成功发送的消息
+func (f *FakeTelegram) Sent() []SentMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]SentMessage(nil), f.sent...)
+}
+
+// SentTo 返回发送到 chatID 的消息
+func (f *FakeTelegram) SentTo(chatID int64) []SentMessage {
+	var sent []SentMessage
+	for _, msg := range f.Sent() {
+		if msg.ChatID == chatID {
+			sent = append(sent, msg)
+		}
+	}
+	return sent
+}
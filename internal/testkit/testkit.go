@@ -0,0 +1,39 @@
+// Package testkit 端到端测试工具：内存 SQLite 的 ent 客户端、模拟的 TDLib 发送和按脚本返回的 LLM，
+// 用于在不依赖外部服务的情况下测试调度器的恢复、重试等流程
+package testkit
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var dbSeq atomic.Int64
+
+// NewEntClient 创建内存 SQLite 的 ent 客户端并建表，测试结束时关闭；每次调用使用独立的数据库
+func NewEntClient(t testing.TB) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:testkit%d?mode=memory&cache=shared&_fk=1", dbSeq.Add(1))
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("打开内存数据库失败: %v", err)
+	}
+	if err := client.Schema.Create(context.Background()); err != nil {
+		client.Close()
+		t.Fatalf("创建数据库表失败: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// Today 返回当日 0 点（UTC），默认的每日窗口总结 [Today()-RangeDays, Today())
+func Today() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -4,15 +4,27 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"os"
-	"os/signal"
-	"syscall"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/fachebot/talk-trace-bot/internal/alert"
+	"github.com/fachebot/talk-trace-bot/internal/botapp"
 	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/dbmigrate"
+	"github.com/fachebot/talk-trace-bot/internal/display"
+	"github.com/fachebot/talk-trace-bot/internal/httpapi"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
 	"github.com/fachebot/talk-trace-bot/internal/logger"
+	"github.com/fachebot/talk-trace-bot/internal/metrics"
 	"github.com/fachebot/talk-trace-bot/internal/notify"
+	"github.com/fachebot/talk-trace-bot/internal/review"
 	"github.com/fachebot/talk-trace-bot/internal/scheduler"
+	"github.com/fachebot/talk-trace-bot/internal/shutdown"
 	"github.com/fachebot/talk-trace-bot/internal/summarizer"
 	"github.com/fachebot/talk-trace-bot/internal/svc"
 	"github.com/fachebot/talk-trace-bot/internal/teleapp"
@@ -22,6 +34,8 @@ import (
 
 var configFile = flag.String("f", "etc/config.yaml", "the config file")
 
+var backfillFlag = flag.String("backfill", "", "backfill history and exit, e.g. chatID=-100123,days=7 (omit chatID for all chats)")
+
 func main() {
 	flag.Parse()
 
@@ -39,6 +53,14 @@ func main() {
 		}
 	}
 
+	// 子命令：migrate [status|up [n]|restore <备份文件>]
+	if flag.Arg(0) == "migrate" {
+		if err := dbmigrate.Run(context.Background(), svc.DatabasePath, flag.Args()[1:], os.Stdout); err != nil {
+			logger.Fatalf("[Migrate] %s", err)
+		}
+		return
+	}
+
 	// 创建服务上下文
 	svcCtx := svc.NewServiceContext(c)
 
@@ -53,49 +75,342 @@ func main() {
 		}))
 	}
 
-	// 创建TeleApp
+	// 创建TeleApp：主账号及 TelegramApp.Accounts 中的额外账号依次登录
 	app := teleapp.NewApp(svcCtx, c.TelegramApp.ApiId, c.TelegramApp.ApiHash, "data")
 	user, err := app.Login(options...)
 	if err != nil {
 		logger.Fatalf("[TeleApp] 用户登录失败, %s", err)
 	}
 	logger.Infof("[TeleApp] 用户 <%s %s>(%d) 登录成功", user.FirstName, user.LastName, user.Id)
+	apps := []*teleapp.TeleApp{app}
+	for _, account := range c.TelegramApp.Accounts {
+		if err := os.MkdirAll(account.DataDir, 0755); err != nil {
+			logger.Fatalf("创建数据目录失败, %s", err)
+		}
+		apiId, apiHash := c.TelegramApp.Credentials(account)
+		accountApp := teleapp.NewApp(svcCtx, apiId, apiHash, account.DataDir)
+		accountApp.SetLogin(&account.Login)
+		user, err := accountApp.Login(options...)
+		if err != nil {
+			logger.Fatalf("[TeleApp] 账号 %s 登录失败, %s", account.DataDir, err)
+		}
+		logger.Infof("[TeleApp] 账号 %s: 用户 <%s %s>(%d) 登录成功", account.DataDir, user.FirstName, user.LastName, user.Id)
+		apps = append(apps, accountApp)
+	}
+	accounts := teleapp.NewAccounts(apps...)
+
+	// -backfill：补录机器人运行之前的历史消息后退出
+	if *backfillFlag != "" {
+		os.Exit(runBackfill(accounts, svcCtx, *backfillFlag))
+	}
+
+	// prompt 中的消息时间使用展示时区，配置已在加载时校验
+	loc, _ := c.Summary.Display.Location()
+	svcCtx.LLMClient.SetLocation(loc)
+	// 心跳消息及管理员命令回复的本地化文本
+	formatter, err := display.NewFormatter(&c.Summary.Display)
+	if err != nil {
+		logger.Fatalf("[Display] 加载展示配置失败: %s", err)
+	}
 
 	// 创建总结器和通知器
 	summarizerInstance := summarizer.NewSummarizer(
 		svcCtx.LLMClient,
 		svcCtx.MessageModel,
 	)
+	summarizerInstance.SetLanguage(c.Summary.Language)
+	summarizerInstance.SetGlossary(c.Summary.Glossary, c.Summary.ChatGlossaries)
+	summarizerInstance.SetQuoteMaxRunes(c.Summary.QuoteMaxRunes)
+	summarizerInstance.SetMinMessageRunes(c.Summary.MinMessageRunes)
+	if c.Ingest.RecordEvents {
+		summarizerInstance.SetEventProvider(svcCtx.MessageModel)
+	}
+	if maxRunes := *c.Summary.CompressMaxRunes; maxRunes > 0 {
+		summarizerInstance.SetCompressor(svcCtx.LLMClient, maxRunes)
+	}
+	summarizerInstance.SetLinkProvider(svcCtx.LinkModel)
+	if c.LLM.Shadow.Model != "" {
+		shadowClient := llm.NewClient(c.LLM.ShadowLLM())
+		shadowClient.SetLocation(loc)
+		summarizerInstance.SetShadow(shadowClient, c.LLM.Shadow.SampleRate, c.LLM.Model, c.LLM.Shadow.Model, svcCtx.ShadowRunModel)
+		logger.Infof("[Summarizer] 已启用模型对比: %s vs %s，抽样比例 %.0f%%", c.LLM.Model, c.LLM.Shadow.Model, c.LLM.Shadow.SampleRate*100)
+	}
+	if c.Summary.ChatContext {
+		summarizerInstance.SetChatContextProvider(accounts)
+	}
 	notifierInstance := notify.NewNotifier(
-		app.Client(),
+		accounts,
 		&c.Summary,
+		c.AdminUserIds,
+		svcCtx.SentPartModel,
 	)
+	notifierInstance.SetSendWaiter(accounts)
+	notifierInstance.SetMembershipChecker(accounts)
+
+	// 机器人模式：群聊改由机器人发送带话题按钮的精简总结，并推送成员关注的话题
+	var bot *botapp.BotApp
+	if c.Bot.Token != "" {
+		bot = botapp.NewApp(svcCtx, c.TelegramApp.ApiId, c.TelegramApp.ApiHash, "data")
+		botUser, err := bot.Login(c.Bot.Token, options...)
+		if err != nil {
+			logger.Fatalf("[BotApp] 机器人登录失败, %s", err)
+		}
+		logger.Infof("[BotApp] 机器人 @%s(%d) 登录成功", bot.Username(), botUser.Id)
+		notifierInstance.SetDigestSender(bot)
+		notifierInstance.SetFollowSender(bot)
+	}
+
+	// 创建告警器
+	alerter := alert.NewAlerter(&c.Alert, notifierInstance, svcCtx.TransportProxy)
+	for _, app := range accounts.Apps() {
+		app.SetAlerter(alerter)
+	}
 
 	// 创建并启动调度器
 	schedulerInstance := scheduler.NewScheduler(
 		summarizerInstance,
 		notifierInstance,
+		alerter,
 		svcCtx.MessageModel,
+		svcCtx.ChatModel,
 		svcCtx.TaskModel,
+		svcCtx.RevisionModel,
 		svcCtx.DailyRunModel,
+		svcCtx.RunLogModel,
 		&c.Summary,
 	)
+	schedulerInstance.SetLinkModel(svcCtx.LinkModel)
+	if c.TDLibStorage.OptimizeCron != "" {
+		err := schedulerInstance.AddJob("tdlib_storage", c.TDLibStorage.OptimizeCron, func(ctx context.Context) error {
+			var errs []error
+			for _, app := range accounts.Apps() {
+				if err := app.OptimizeStorage(&c.TDLibStorage); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		})
+		if err != nil {
+			logger.Fatalf("[Scheduler] 注册 TDLib 存储清理任务失败: %s", err)
+		}
+	}
+	if c.Heartbeat.Cron != "" {
+		err := schedulerInstance.AddJob("heartbeat", c.Heartbeat.Cron, func(ctx context.Context) error {
+			text := formatter.Tf(display.TextHeartbeat,
+				time.Now().UTC().Format("2006-01-02 15:04:05 MST"), schedulerInstance.StatusText())
+			return app.UpdateHeartbeat(text)
+		})
+		if err != nil {
+			logger.Fatalf("[Scheduler] 注册心跳任务失败: %s", err)
+		}
+	}
+	if c.MetadataRefresh.Cron != "" {
+		maxAge := time.Duration(c.MetadataRefresh.MaxAgeHours) * time.Hour
+		err := schedulerInstance.AddJob("metadata_refresh", c.MetadataRefresh.Cron, func(ctx context.Context) error {
+			return accounts.RefreshMetadata(ctx, maxAge)
+		})
+		if err != nil {
+			logger.Fatalf("[Scheduler] 注册群聊和用户信息刷新任务失败: %s", err)
+		}
+	}
+	if c.WeeklyReview.Cron != "" {
+		reviewer := review.NewReviewer(&c.WeeklyReview, svcCtx, notifierInstance, loc)
+		err := schedulerInstance.AddJob("weekly_review", c.WeeklyReview.Cron, reviewer.Run)
+		if err != nil {
+			logger.Fatalf("[Scheduler] 注册每周回顾任务失败: %s", err)
+		}
+	}
+	// 子命令：once [窗口名称]，补录消息、执行区间已结束的总结窗口后退出
+	if flag.Arg(0) == "once" {
+		os.Exit(runOnce(schedulerInstance, accounts, bot, svcCtx, flag.Arg(1)))
+	}
 	if err := schedulerInstance.Start(); err != nil {
 		logger.Fatalf("[Scheduler] 启动调度器失败: %s", err)
 	}
 
+	// 注册管理员命令
+	app.RegisterCommand("status", func(ctx context.Context, cmd *teleapp.Command) (string, error) {
+		return schedulerInstance.StatusText(), nil
+	})
+	app.RegisterCommand("views", func(ctx context.Context, cmd *teleapp.Command) (string, error) {
+		return botapp.ViewStatsText(ctx, svcCtx.ViewModel, svcCtx.ChatModel, 7, formatter)
+	})
+	app.RegisterCommand("shadow", func(ctx context.Context, cmd *teleapp.Command) (string, error) {
+		return summarizer.ShadowStatsText(ctx, svcCtx.ShadowRunModel, svcCtx.ChatModel, 7, c.LLM.Model, c.LLM.Shadow.Model, formatter)
+	})
+	app.RegisterCommand("revisions", func(ctx context.Context, cmd *teleapp.Command) (string, error) {
+		if len(cmd.Args) != 1 {
+			return formatter.T(display.TextRevisionsUsage), nil
+		}
+		id, err := strconv.ParseInt(cmd.Args[0], 10, 64)
+		if err != nil {
+			return formatter.Tf(display.TextInvalidID, cmd.Args[0]), nil
+		}
+		// 群组ID为负数，取该群组最近一次任务
+		if id < 0 {
+			tasks, err := svcCtx.TaskModel.GetRecentTasksByChat(ctx, id, 1)
+			if err != nil {
+				return "", err
+			}
+			if len(tasks) == 0 {
+				return formatter.Tf(display.TextChatNoTasks, id), nil
+			}
+			id = int64(tasks[0].ID)
+		}
+		revisions, err := svcCtx.RevisionModel.ListByTask(ctx, int(id))
+		if err != nil {
+			return "", err
+		}
+		return formatter.RevisionsText(int(id), revisions), nil
+	})
+	app.RegisterCommand("edit", func(ctx context.Context, cmd *teleapp.Command) (string, error) {
+		if len(cmd.Args) < 2 {
+			return formatter.T(display.TextEditUsage), nil
+		}
+		taskID, err := strconv.Atoi(cmd.Args[0])
+		if err != nil {
+			return formatter.Tf(display.TextInvalidTaskID, cmd.Args[0]), nil
+		}
+		content := strings.TrimPrefix(cmd.Text, cmd.Args[0])
+		sent, err := schedulerInstance.EditSummary(ctx, taskID, content)
+		switch {
+		case errors.Is(err, scheduler.ErrEmptySummary):
+			return formatter.T(display.TextEditEmpty), nil
+		case errors.Is(err, scheduler.ErrTaskNotFound):
+			return formatter.Tf(display.TextTaskNotFound, taskID), nil
+		case errors.Is(err, scheduler.ErrTaskProcessing):
+			return formatter.Tf(display.TextTaskProcessing, taskID), nil
+		case errors.Is(err, scheduler.ErrNoPendingSummary):
+			return formatter.Tf(display.TextEditNotPending, taskID), nil
+		case err != nil:
+			return "", err
+		}
+		if !sent {
+			return formatter.Tf(display.TextEditSendFailed, taskID), nil
+		}
+		return formatter.Tf(display.TextEditSent, taskID), nil
+	})
+	// 按需总结：群聊中及管理员私聊的 /summary
+	if c.OnDemand.Enable {
+		for _, app := range accounts.Apps() {
+			app.SetRangeSummarizer(schedulerInstance)
+		}
+	}
+
+	// 启动 HTTP 服务
+	var httpServer *httpapi.Server
+	if c.HTTPServer.Enable {
+		httpServer = httpapi.NewServer(&c.HTTPServer)
+		httpServer.HandleFunc("/health", httpapi.HealthHandler(schedulerInstance))
+		httpServer.HandleFunc("/metrics", metrics.Handler())
+		httpServer.HandleFunc("/llm/stats", httpapi.LLMStatsHandler(svcCtx.LLMClient))
+		httpServer.HandleFunc("/v1/topics", httpapi.TopicsHandler(svcCtx.TaskModel, svcCtx.ChatModel, c.HTTPServer.Token))
+		httpServer.HandleFunc("/v1/runlogs", httpapi.RunLogsHandler(svcCtx.RunLogModel, svcCtx.ChatModel, c.HTTPServer.Token))
+		httpServer.Start()
+	}
+
 	// 等待程序退出
-	ch := make(chan os.Signal, 2)
-	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
-	<-ch
-
-	// 优雅关闭
-	logger.Infof("正在关闭服务...")
-	schedulerInstance.Stop()
-	err = app.Close()
-	if err != nil {
-		logger.Infof("[TeleApp] 关闭失败, %v", err)
+	sig := shutdown.Wait()
+
+	// 优雅关闭，超时或再次收到退出信号时强制退出
+	logger.Infof("收到信号 %v，正在关闭服务...", sig)
+	ok := shutdown.Graceful(time.Duration(c.ShutdownTimeout)*time.Second, func(ctx context.Context) {
+		if httpServer != nil {
+			if err := httpServer.Stop(ctx); err != nil {
+				logger.Infof("[HTTP] 关闭失败, %v", err)
+			}
+		}
+		schedulerInstance.Stop()
+		closeApps(ctx, bot, accounts, svcCtx)
+	})
+	if !ok {
+		os.Exit(1)
 	}
-	svcCtx.Close()
 	logger.Infof("服务已停止")
 }
+
+// closeApps 依次关闭机器人、各账号的 TeleApp，等待入库队列写入完成后关闭数据库
+func closeApps(ctx context.Context, bot *botapp.BotApp, accounts *teleapp.Accounts, svcCtx *svc.ServiceContext) {
+	if bot != nil {
+		if err := bot.Close(); err != nil {
+			logger.Infof("[BotApp] 关闭失败, %v", err)
+		}
+	}
+	for _, app := range accounts.Apps() {
+		if err := app.Close(); err != nil {
+			logger.Infof("[TeleApp] 关闭失败, %v", err)
+		}
+	}
+	if err := svcCtx.IngestQueue.Close(ctx); err != nil {
+		logger.Errorf("[Ingest] %v", err)
+	}
+	svcCtx.Close()
+}
+
+// runOnce 单次运行模式：补录离线期间的消息，执行区间已结束的总结窗口并发送通知后关闭服务，
+// 返回进程退出码：0 表示全部成功，1 表示有窗口或群组失败。运行中收到退出信号时取消运行
+func runOnce(s *scheduler.Scheduler, accounts *teleapp.Accounts, bot *botapp.BotApp, svcCtx *svc.ServiceContext, window string) int {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sig := shutdown.Wait()
+		logger.Infof("收到信号 %v，取消单次运行", sig)
+		cancel()
+	}()
+
+	code := 0
+	if err := s.RunOnce(ctx, window, accounts); err != nil {
+		logger.Errorf("[Once] 单次运行失败: %v", err)
+		code = 1
+	} else {
+		logger.Infof("[Once] 单次运行完成")
+	}
+
+	timeout := time.Duration(svcCtx.Config.ShutdownTimeout) * time.Second
+	if !shutdown.Graceful(timeout, func(ctx context.Context) { closeApps(ctx, bot, accounts, svcCtx) }) {
+		return 1
+	}
+	return code
+}
+
+// runBackfill 补录模式：按 -backfill 参数补录指定群聊（或全部群聊）最近若干天的历史消息后关闭服务，
+// 返回进程退出码。运行中收到退出信号时取消补录
+func runBackfill(accounts *teleapp.Accounts, svcCtx *svc.ServiceContext, spec string) int {
+	s, err := teleapp.ParseBackfillSpec(spec)
+	if err != nil {
+		logger.Errorf("[Backfill] -backfill 参数错误: %v", err)
+		return 1
+	}
+	if retention := svcCtx.Config.Summary.RetentionDays + 1; s.Days > retention {
+		logger.Warnf("[Backfill] 补录天数 %d 超过消息保留期 %d 天，超出部分将在下次清理时删除", s.Days, retention)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sig := shutdown.Wait()
+		logger.Infof("收到信号 %v，取消补录", sig)
+		cancel()
+	}()
+
+	since := time.Now().AddDate(0, 0, -s.Days)
+	var n int
+	if s.ChatID != 0 {
+		n, err = accounts.BackfillChat(ctx, s.ChatID, since)
+	} else {
+		n, err = accounts.Backfill(ctx, since)
+	}
+	code := 0
+	if err != nil {
+		logger.Errorf("[Backfill] 补录失败，已入队 %d 条消息: %v", n, err)
+		code = 1
+	} else {
+		logger.Infof("[Backfill] 补录完成，共 %d 条消息", n)
+	}
+
+	timeout := time.Duration(svcCtx.Config.ShutdownTimeout) * time.Second
+	if !shutdown.Graceful(timeout, func(ctx context.Context) { closeApps(ctx, nil, accounts, svcCtx) }) {
+		return 1
+	}
+	return code
+}
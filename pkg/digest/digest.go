@@ -0,0 +1,202 @@
+// Package digest 对外暴露的群聊总结引擎，供其他 Go 程序嵌入使用，无需运行完整的 Telegram Bot。
+//
+// 调用方通过接口提供消息存储、LLM 和通知渠道：
+//
+//	engine := digest.New(store, digest.NewOpenAI(digest.OpenAIConfig{...}), notifier)
+//	result, err := engine.Run(ctx, startTime, endTime)
+package digest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/talk-trace-bot/internal/config"
+	"github.com/fachebot/talk-trace-bot/internal/ent"
+	"github.com/fachebot/talk-trace-bot/internal/llm"
+	"github.com/fachebot/talk-trace-bot/internal/summarizer"
+)
+
+// Result 单个群组的总结结果，按话题分组
+type Result = summarizer.SummaryResult
+
+// Topic 单个话题
+type Topic = summarizer.TopicItem
+
+// TopicEntry 话题下某个发言者的贡献
+type TopicEntry = summarizer.TopicSubItem
+
+// Message 待总结的群聊消息
+type Message struct {
+	MessageID  int64
+	SenderID   int64
+	SenderName string
+	Text       string
+	SentAt     time.Time
+}
+
+// MessageStore 消息存储
+type MessageStore interface {
+	// ChatIDs 返回区间 [startTime, endTime) 内有消息的群组
+	ChatIDs(ctx context.Context, startTime, endTime time.Time) ([]int64, error)
+	// Messages 返回群组在区间 [startTime, endTime) 内的消息，按时间升序
+	Messages(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]Message, error)
+}
+
+// ChatTitler 可选接口：MessageStore 实现该接口时，总结头部显示群聊名称
+type ChatTitler interface {
+	ChatTitle(ctx context.Context, chatID int64) (string, error)
+}
+
+// LLM 将消息总结为话题分组 JSON：{"topics":[{"title":"","items":[{"sender_name":"","description":"","message_ids":[]}]}]}
+type LLM interface {
+	Summarize(ctx context.Context, messages []Message) (string, error)
+}
+
+// Notifier 发送总结内容（Telegram HTML 格式）
+type Notifier interface {
+	Notify(ctx context.Context, chatID int64, content string) error
+}
+
+// ChatResult 单个群组的运行结果
+type ChatResult struct {
+	ChatID  int64
+	Result  *Result // 区间内无消息时为 nil
+	Content string  // 格式化后的通知内容，为空表示未发送
+	Err     error
+}
+
+// Engine 总结引擎：查询消息、调用 LLM 生成总结、格式化并发送通知
+type Engine struct {
+	store      MessageStore
+	notifier   Notifier
+	summarizer *summarizer.Summarizer
+}
+
+// New 创建总结引擎，notifier 为 nil 时只生成总结不发送
+func New(store MessageStore, model LLM, notifier Notifier) *Engine {
+	return &Engine{
+		store:      store,
+		notifier:   notifier,
+		summarizer: summarizer.NewSummarizer(llmAdapter{model}, storeAdapter{store}),
+	}
+}
+
+// SummarizeChat 生成单个群组在区间内的总结，无消息时返回 nil
+func (e *Engine) SummarizeChat(ctx context.Context, chatID int64, startTime, endTime time.Time) (*Result, error) {
+	result, err := e.summarizer.SummarizeRange(ctx, chatID, startTime, endTime)
+	if err != nil || result == nil {
+		return result, err
+	}
+	if titler, ok := e.store.(ChatTitler); ok {
+		if result.ChatTitle, err = titler.ChatTitle(ctx, chatID); err != nil {
+			return nil, fmt.Errorf("查询群聊名称失败: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// Run 对区间内所有有消息的群组生成总结并发送通知；单个群组失败不影响其他群组，错误记录在 ChatResult.Err
+func (e *Engine) Run(ctx context.Context, startTime, endTime time.Time) ([]ChatResult, error) {
+	chatIDs, err := e.store.ChatIDs(ctx, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("查询群组列表失败: %w", err)
+	}
+
+	results := make([]ChatResult, 0, len(chatIDs))
+	for _, chatID := range chatIDs {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results = append(results, e.runChat(ctx, chatID, startTime, endTime))
+	}
+	return results, nil
+}
+
+func (e *Engine) runChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ChatResult {
+	cr := ChatResult{ChatID: chatID}
+	cr.Result, cr.Err = e.SummarizeChat(ctx, chatID, startTime, endTime)
+	if cr.Err != nil || cr.Result == nil {
+		return cr
+	}
+
+	cr.Content = Format(cr.Result, chatID, startTime, endTime)
+	if cr.Content != "" && e.notifier != nil {
+		if err := e.notifier.Notify(ctx, chatID, cr.Content); err != nil {
+			cr.Err = fmt.Errorf("发送通知失败: %w", err)
+		}
+	}
+	return cr
+}
+
+// Format 将总结结果格式化为 Telegram HTML 文本（区间按 UTC 日期展示，结束日为包含）
+func Format(result *Result, chatID int64, startTime, endTime time.Time) string {
+	return summarizer.FormatSummaryForDisplay(result, chatID, startTime, endTime, nil)
+}
+
+// OpenAIConfig 兼容 OpenAI API 的 LLM 配置
+type OpenAIConfig struct {
+	BaseURL   string
+	APIKey    string
+	Model     string
+	MaxTokens int // 模型上下文窗口大小
+}
+
+// NewOpenAI 创建使用内置 prompt 和分块合并策略的 OpenAI 兼容 LLM
+func NewOpenAI(cfg OpenAIConfig) LLM {
+	return openAILLM{client: llm.NewClient(&config.LLM{
+		BaseURL:   cfg.BaseURL,
+		APIKey:    cfg.APIKey,
+		Model:     cfg.Model,
+		MaxTokens: cfg.MaxTokens,
+	})}
+}
+
+type openAILLM struct {
+	client *llm.Client
+}
+
+func (o openAILLM) Summarize(ctx context.Context, messages []Message) (string, error) {
+	chatMsgs := make([]llm.ChatMessage, len(messages))
+	for i, m := range messages {
+		chatMsgs[i] = llm.ChatMessage{MessageID: m.MessageID, SenderID: m.SenderID, SenderName: m.SenderName, Text: m.Text, SentAt: m.SentAt}
+	}
+	return o.client.SummarizeChat(ctx, chatMsgs)
+}
+
+// storeAdapter 将 MessageStore 适配为 summarizer.MessageProvider
+type storeAdapter struct {
+	store MessageStore
+}
+
+func (a storeAdapter) GetByDateRangeAndChat(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]*ent.Message, error) {
+	messages, err := a.store.Messages(ctx, chatID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*ent.Message, len(messages))
+	for i, m := range messages {
+		result[i] = &ent.Message{
+			ChatID:     chatID,
+			MessageID:  m.MessageID,
+			SenderID:   m.SenderID,
+			SenderName: m.SenderName,
+			Text:       m.Text,
+			SentAt:     m.SentAt,
+		}
+	}
+	return result, nil
+}
+
+// llmAdapter 将 LLM 适配为 summarizer.SummaryEngine
+type llmAdapter struct {
+	model LLM
+}
+
+func (a llmAdapter) SummarizeChat(ctx context.Context, messages []llm.ChatMessage) (string, error) {
+	result := make([]Message, len(messages))
+	for i, m := range messages {
+		result[i] = Message{MessageID: m.MessageID, SenderID: m.SenderID, SenderName: m.SenderName, Text: m.Text}
+	}
+	return a.model.Summarize(ctx, result)
+}
@@ -0,0 +1,77 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	messages map[int64][]Message
+}
+
+func (s *memoryStore) ChatIDs(ctx context.Context, startTime, endTime time.Time) ([]int64, error) {
+	ids := make([]int64, 0, len(s.messages))
+	for id := range s.messages {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (s *memoryStore) Messages(ctx context.Context, chatID int64, startTime, endTime time.Time) ([]Message, error) {
+	return s.messages[chatID], nil
+}
+
+type stubLLM struct {
+	resp string
+	err  error
+}
+
+func (l *stubLLM) Summarize(ctx context.Context, messages []Message) (string, error) {
+	return l.resp, l.err
+}
+
+type recordingNotifier struct {
+	sent map[int64]string
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, chatID int64, content string) error {
+	n.sent[chatID] = content
+	return nil
+}
+
+func TestEngine_Run(t *testing.T) {
+	store := &memoryStore{messages: map[int64][]Message{
+		-1001427755127: {{MessageID: 1, SenderID: 10, SenderName: "Alice", Text: "明天发布"}},
+	}}
+	model := &stubLLM{resp: `{"topics":[{"title":"发布计划","items":[{"sender_name":"Alice","description":"提到明天发布","message_ids":[1]}]}]}`}
+	notifier := &recordingNotifier{sent: make(map[int64]string)}
+
+	start := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	results, err := New(store, model, notifier).Run(context.Background(), start, start.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, 1, results[0].Result.MessageCount)
+	assert.Contains(t, notifier.sent[-1001427755127], "📅 2025-02-10 至 2025-02-10 (UTC)")
+	assert.Contains(t, notifier.sent[-1001427755127], "发布计划")
+}
+
+func TestEngine_RunChatError(t *testing.T) {
+	store := &memoryStore{messages: map[int64][]Message{
+		-100: {{MessageID: 1, SenderName: "Alice", Text: "hi"}},
+	}}
+	notifier := &recordingNotifier{sent: make(map[int64]string)}
+
+	start := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+	results, err := New(store, &stubLLM{err: errors.New("quota exceeded")}, notifier).Run(context.Background(), start, start.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Error(t, results[0].Err)
+	assert.Empty(t, notifier.sent)
+}